@@ -10,6 +10,7 @@ import (
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres"
 	reviewrepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/review"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/redis"
 	gen "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/generated/review"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware"
 	grpcmw "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/grpc"
@@ -46,8 +47,18 @@ func main() {
 	// Инициализация репозиториев
 	reviewRepo := reviewrepo.NewReviewRepository(db)
 
+	// Кэш сводок по отзывам. Без Redis сервис продолжает работать, считая сводки по запросу
+	var summaryCache cs.IReviewSummaryCache
+	redisClient, err := redis.NewClient(conf.SearchRedisConfig)
+	if err != nil {
+		log.Printf("review summary cache disabled: %v", err)
+	} else {
+		summaryCache = redis.NewReviewSummaryRepository(redisClient, conf.ReviewConfig.SummaryCacheTTL)
+	}
+
 	// Инициализация usecase
-	reviewUsecase := cs.NewReviewUsecase(reviewRepo)
+	screening := cs.NewDefaultScreening(reviewRepo, conf.ReviewConfig.MaxReviewsPerWindow, conf.ReviewConfig.RateWindow)
+	reviewUsecase := cs.NewReviewUsecase(reviewRepo, conf.ReviewConfig, screening, summaryCache)
 
 	// Создаем gRPC хендлер
 	handler := review.NewReviewGRPCServer(reviewUsecase)
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/joho/godotenv"
 	"os"
 	"strconv"
@@ -12,15 +13,27 @@ import (
 
 // Config объединяет все конфигурационные настройки приложения.
 type Config struct {
-	MinioConfig       *MinioConfig
-	DBConfig          *DBConfig
-	ServerConfig      *ServerConfig
-	JWTConfig         *JWTConfig
-	MigrationsConfig  *MigrationsConfig
-	GeoapifyConfig    *GeoapifyConfig
-	CSRFConfig        *CSRFConfig
-	AuthRedisConfig   *RedisConfig
-	SearchRedisConfig *RedisConfig
+	MinioConfig          *MinioConfig
+	DBConfig             *DBConfig
+	ServerConfig         *ServerConfig
+	JWTConfig            *JWTConfig
+	MigrationsConfig     *MigrationsConfig
+	GeocoderConfig       *GeocoderConfig
+	GeoapifyConfig       *GeoapifyConfig
+	CSRFConfig           *CSRFConfig
+	AuthRedisConfig      *RedisConfig
+	SearchRedisConfig    *RedisConfig
+	ReviewConfig         *ReviewConfig
+	RecommendationConfig *RecommendationConfig
+	DeliveryConfig       *DeliveryConfig
+	GuestBasketConfig    *GuestBasketConfig
+	ReservationConfig    *ReservationConfig
+	InvoiceConfig        *InvoiceConfig
+	CatalogImportConfig  *CatalogImportConfig
+	InventoryConfig      *InventoryConfig
+	AnalyticsConfig      *AnalyticsConfig
+	PayoutConfig         *PayoutConfig
+	CurrencyConfig       *CurrencyConfig
 }
 
 // NewConfig загружает переменные окружения и инициализирует все компоненты конфига.
@@ -54,7 +67,12 @@ func NewConfig() (*Config, error) {
 		return nil, err
 	}
 
-	geoapifyConfig, err := newGeoapifyConfig()
+	geocoderConfig, err := newGeocoderConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	geoapifyConfig, err := newGeoapifyConfig(geocoderConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -74,16 +92,56 @@ func NewConfig() (*Config, error) {
 		return nil, err
 	}
 
+	reviewConfig, err := newReviewConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	recommendationConfig := newRecommendationConfig()
+
+	deliveryConfig, err := newDeliveryConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	guestBasketConfig := newGuestBasketConfig(csrfConfig)
+
+	reservationConfig := newReservationConfig()
+
+	invoiceConfig := newInvoiceConfig()
+
+	catalogImportConfig := newCatalogImportConfig()
+
+	inventoryConfig := newInventoryConfig()
+
+	analyticsConfig := newAnalyticsConfig()
+
+	payoutConfig := newPayoutConfig()
+
+	currencyConfig := newCurrencyConfig()
+
 	return &Config{
-		MinioConfig:       minioConf,
-		DBConfig:          dbConfig,
-		ServerConfig:      serverConfig,
-		JWTConfig:         jwtConfig,
-		MigrationsConfig:  migrationsConfig,
-		GeoapifyConfig:    geoapifyConfig,
-		CSRFConfig:        csrfConfig,
-		AuthRedisConfig:   authRedisConfig,
-		SearchRedisConfig: searchRedisConfig,
+		MinioConfig:          minioConf,
+		DBConfig:             dbConfig,
+		ServerConfig:         serverConfig,
+		JWTConfig:            jwtConfig,
+		MigrationsConfig:     migrationsConfig,
+		GeocoderConfig:       geocoderConfig,
+		GeoapifyConfig:       geoapifyConfig,
+		CSRFConfig:           csrfConfig,
+		AuthRedisConfig:      authRedisConfig,
+		SearchRedisConfig:    searchRedisConfig,
+		ReviewConfig:         reviewConfig,
+		RecommendationConfig: recommendationConfig,
+		DeliveryConfig:       deliveryConfig,
+		GuestBasketConfig:    guestBasketConfig,
+		ReservationConfig:    reservationConfig,
+		InvoiceConfig:        invoiceConfig,
+		CatalogImportConfig:  catalogImportConfig,
+		InventoryConfig:      inventoryConfig,
+		AnalyticsConfig:      analyticsConfig,
+		PayoutConfig:         payoutConfig,
+		CurrencyConfig:       currencyConfig,
 	}, nil
 }
 
@@ -206,7 +264,7 @@ func newServerConfig() (*ServerConfig, error) {
 	}
 
 	allowMethods := getEnvWithDefault("ALLOW_METHODS", "POST,GET,PUT,DELETE,OPTIONS")
-	allowHeaders := getEnvWithDefault("ALLOW_HEADERS", "Content-Type,X-CSRF-Token")
+	allowHeaders := getEnvWithDefault("ALLOW_HEADERS", "Content-Type,X-CSRF-Token,X-Currency")
 	allowCredentials := getEnvWithDefault("ALLOW_CREDENTIALS", "true")
 
 	writeTimeout := getEnvAsDuration("SERVER_WRITE_TIMEOUT", 10*time.Second)
@@ -272,17 +330,60 @@ func newMigrationsConfig() (*MigrationsConfig, error) {
 	}, nil
 }
 
+const (
+	GeocoderProviderGeoapify = "geoapify"
+	GeocoderProviderStub     = "stub"
+)
+
+type GeocoderConfig struct {
+	// Provider — реализация геокодера: "geoapify" или детерминированная заглушка "stub"
+	// для тестов и запуска без доступа к сети
+	Provider string
+	// CacheTTL — сколько ответ геокодера хранится в Redis
+	CacheTTL time.Duration
+}
+
+func newGeocoderConfig() (*GeocoderConfig, error) {
+	provider := getEnvWithDefault("GEOCODER_PROVIDER", GeocoderProviderGeoapify)
+	if provider != GeocoderProviderGeoapify && provider != GeocoderProviderStub {
+		return nil, fmt.Errorf("unknown GEOCODER_PROVIDER %q", provider)
+	}
+
+	return &GeocoderConfig{
+		Provider: provider,
+		CacheTTL: getEnvAsDuration("GEOCODER_CACHE_TTL", 30*24*time.Hour),
+	}, nil
+}
+
 type GeoapifyConfig struct {
-	APIKey string
+	APIKey  string
+	BaseURL string
+	Timeout time.Duration
+	// MinImportance — минимальная значимость найденного здания;
+	// менее значимые совпадения считаются ненайденным адресом
+	MinImportance float64
 }
 
-func newGeoapifyConfig() (*GeoapifyConfig, error) {
+// newGeoapifyConfig читает настройки Geoapify. Ключ API обязателен,
+// только если Geoapify выбран провайдером геокодера.
+func newGeoapifyConfig(geocoder *GeocoderConfig) (*GeoapifyConfig, error) {
 	apiKey, exists := os.LookupEnv("GEOAPIFY_API_KEY")
-	if !exists {
+	if !exists && geocoder.Provider == GeocoderProviderGeoapify {
 		return nil, errors.New("GEOAPIFY_API_KEY is not set")
 	}
+
+	minImportance := 0.2
+	if val, exists := os.LookupEnv("GEOAPIFY_MIN_IMPORTANCE"); exists {
+		if parsed, err := strconv.ParseFloat(val, 64); err == nil {
+			minImportance = parsed
+		}
+	}
+
 	return &GeoapifyConfig{
-		APIKey: apiKey,
+		APIKey:        apiKey,
+		BaseURL:       getEnvWithDefault("GEOAPIFY_BASE_URL", "https://api.geoapify.com/v1/geocode/search"),
+		Timeout:       getEnvAsDuration("GEOAPIFY_TIMEOUT", 5*time.Second),
+		MinImportance: minImportance,
 	}, nil
 }
 
@@ -358,6 +459,288 @@ func newRedisConfig(redisType string) (*RedisConfig, error) {
 	}, nil
 }
 
+type ReviewConfig struct {
+	// RequireVerifiedPurchase запрещает отзывы от пользователей, не получивших товар.
+	// Если выключено, такие отзывы сохраняются без отметки о покупке.
+	RequireVerifiedPurchase bool
+	// MaxReviewsPerWindow — сколько отзывов пользователь может оставить за RateWindow,
+	// прежде чем новые отзывы начнут уходить на ручную модерацию.
+	MaxReviewsPerWindow int
+	RateWindow          time.Duration
+	// SummaryRecentCount — сколько последних отзывов попадает в сводку по товару
+	SummaryRecentCount int
+	SummaryCacheTTL    time.Duration
+}
+
+func newReviewConfig() (*ReviewConfig, error) {
+	requireVerified, err := strconv.ParseBool(getEnvWithDefault("REVIEW_REQUIRE_VERIFIED_PURCHASE", "false"))
+	if err != nil {
+		return nil, errors.New("invalid REVIEW_REQUIRE_VERIFIED_PURCHASE value")
+	}
+
+	maxReviews := 5
+	if val, exists := os.LookupEnv("REVIEW_MAX_PER_WINDOW"); exists {
+		if parsed, err := strconv.Atoi(val); err == nil {
+			maxReviews = parsed
+		}
+	}
+
+	summaryRecent := 3
+	if val, exists := os.LookupEnv("REVIEW_SUMMARY_RECENT"); exists {
+		if parsed, err := strconv.Atoi(val); err == nil {
+			summaryRecent = parsed
+		}
+	}
+
+	return &ReviewConfig{
+		RequireVerifiedPurchase: requireVerified,
+		MaxReviewsPerWindow:     maxReviews,
+		RateWindow:              getEnvAsDuration("REVIEW_RATE_WINDOW", time.Hour),
+		SummaryRecentCount:      summaryRecent,
+		SummaryCacheTTL:         getEnvAsDuration("REVIEW_SUMMARY_CACHE_TTL", 10*time.Minute),
+	}, nil
+}
+
+type RecommendationConfig struct {
+	// RefreshInterval — период пересчёта таблиц похожести и популярности
+	RefreshInterval time.Duration
+	CacheTTL        time.Duration
+	// SimilarPerProduct — сколько похожих товаров хранится для каждого товара
+	SimilarPerProduct int
+}
+
+func newRecommendationConfig() *RecommendationConfig {
+	similarPerProduct := 20
+	if val, exists := os.LookupEnv("RECOMMENDATION_SIMILAR_PER_PRODUCT"); exists {
+		if parsed, err := strconv.Atoi(val); err == nil {
+			similarPerProduct = parsed
+		}
+	}
+
+	return &RecommendationConfig{
+		RefreshInterval:   getEnvAsDuration("RECOMMENDATION_REFRESH_INTERVAL", time.Hour),
+		CacheTTL:          getEnvAsDuration("RECOMMENDATION_CACHE_TTL", 15*time.Minute),
+		SimilarPerProduct: similarPerProduct,
+	}
+}
+
+type DeliveryConfig struct {
+	// Cost — стоимость доставки заказа
+	Cost models.Money
+	// FreeThreshold — сумма заказа после скидок, начиная с которой доставка бесплатна.
+	// Ноль отключает бесплатную доставку.
+	FreeThreshold models.Money
+	// DefaultLeadTime — срок доставки для адресов, которым не подошла ни одна зона
+	DefaultLeadTime time.Duration
+	// SlotHorizon — на сколько вперёд от ближайшей возможной даты показываются интервалы доставки
+	SlotHorizon time.Duration
+}
+
+func newDeliveryConfig() (*DeliveryConfig, error) {
+	cost, err := models.ParseMoney(getEnvWithDefault("DELIVERY_COST", "0"))
+	if err != nil || cost.IsNegative() {
+		return nil, errors.New("invalid DELIVERY_COST value")
+	}
+
+	freeThreshold, err := models.ParseMoney(getEnvWithDefault("DELIVERY_FREE_THRESHOLD", "0"))
+	if err != nil || freeThreshold.IsNegative() {
+		return nil, errors.New("invalid DELIVERY_FREE_THRESHOLD value")
+	}
+
+	return &DeliveryConfig{
+		Cost:            cost,
+		FreeThreshold:   freeThreshold,
+		DefaultLeadTime: getEnvAsDuration("DELIVERY_DEFAULT_LEAD_TIME", 5*24*time.Hour),
+		SlotHorizon:     getEnvAsDuration("DELIVERY_SLOT_HORIZON", 7*24*time.Hour),
+	}, nil
+}
+
+type GuestBasketConfig struct {
+	// SecretKey подписывает куку с идентификатором гостевой корзины
+	SecretKey string
+	// TTL — сколько хранится гостевая корзина после последнего обращения
+	TTL time.Duration
+}
+
+// newGuestBasketConfig читает настройки гостевой корзины.
+// Если GUEST_BASKET_SECRET_KEY не задан, используется ключ CSRF.
+func newGuestBasketConfig(csrf *CSRFConfig) *GuestBasketConfig {
+	return &GuestBasketConfig{
+		SecretKey: getEnvWithDefault("GUEST_BASKET_SECRET_KEY", csrf.SecretKey),
+		TTL:       getEnvAsDuration("GUEST_BASKET_TTL", 7*24*time.Hour),
+	}
+}
+
+type ReservationConfig struct {
+	// TTL — сколько товар остаётся в резерве после начала оформления заказа
+	TTL time.Duration
+	// SweepInterval — период возврата просроченных резервов в остаток.
+	// Неположительное значение отключает фоновую очистку.
+	SweepInterval time.Duration
+	// SweepBatch — сколько резервов освобождается за один проход
+	SweepBatch int
+}
+
+func newReservationConfig() *ReservationConfig {
+	sweepBatch := 500
+	if val, exists := os.LookupEnv("RESERVATION_SWEEP_BATCH"); exists {
+		if parsed, err := strconv.Atoi(val); err == nil && parsed > 0 {
+			sweepBatch = parsed
+		}
+	}
+
+	return &ReservationConfig{
+		TTL:           getEnvAsDuration("RESERVATION_TTL", 15*time.Minute),
+		SweepInterval: getEnvAsDuration("RESERVATION_SWEEP_INTERVAL", time.Minute),
+		SweepBatch:    sweepBatch,
+	}
+}
+
+type InvoiceConfig struct {
+	// FontPath и BoldFontPath — TTF-шрифты с кириллицей для PDF-счетов
+	FontPath     string
+	BoldFontPath string
+}
+
+func newInvoiceConfig() *InvoiceConfig {
+	return &InvoiceConfig{
+		FontPath:     getEnvWithDefault("INVOICE_FONT_PATH", "/usr/share/fonts/truetype/dejavu/DejaVuSans.ttf"),
+		BoldFontPath: getEnvWithDefault("INVOICE_BOLD_FONT_PATH", "/usr/share/fonts/truetype/dejavu/DejaVuSans-Bold.ttf"),
+	}
+}
+
+type CatalogImportConfig struct {
+	// PollInterval — как часто обработчик проверяет очередь импорта.
+	// Неположительное значение отключает фоновую обработку.
+	PollInterval time.Duration
+	// StaleAfter — через сколько задача в статусе processing считается
+	// брошенной и забирается повторно
+	StaleAfter time.Duration
+	// MaxFileSize — предельный размер загружаемого файла в байтах
+	MaxFileSize int64
+	// MaxRows — предельное число товаров в одном файле
+	MaxRows int
+}
+
+func newCatalogImportConfig() *CatalogImportConfig {
+	maxFileSize := int64(10 << 20)
+	if val, exists := os.LookupEnv("CATALOG_IMPORT_MAX_FILE_SIZE"); exists {
+		if parsed, err := strconv.ParseInt(val, 10, 64); err == nil && parsed > 0 {
+			maxFileSize = parsed
+		}
+	}
+
+	maxRows := 5000
+	if val, exists := os.LookupEnv("CATALOG_IMPORT_MAX_ROWS"); exists {
+		if parsed, err := strconv.Atoi(val); err == nil && parsed > 0 {
+			maxRows = parsed
+		}
+	}
+
+	return &CatalogImportConfig{
+		PollInterval: getEnvAsDuration("CATALOG_IMPORT_POLL_INTERVAL", 10*time.Second),
+		StaleAfter:   getEnvAsDuration("CATALOG_IMPORT_STALE_AFTER", 15*time.Minute),
+		MaxFileSize:  maxFileSize,
+		MaxRows:      maxRows,
+	}
+}
+
+type InventoryConfig struct {
+	// AlertInterval — как часто продавцам рассылаются уведомления о низком остатке.
+	// Неположительное значение отключает рассылку.
+	AlertInterval time.Duration
+	// AlertBatch — сколько уведомлений отправляется за один проход
+	AlertBatch int
+}
+
+func newInventoryConfig() *InventoryConfig {
+	alertBatch := 100
+	if val, exists := os.LookupEnv("INVENTORY_ALERT_BATCH"); exists {
+		if parsed, err := strconv.Atoi(val); err == nil && parsed > 0 {
+			alertBatch = parsed
+		}
+	}
+
+	return &InventoryConfig{
+		AlertInterval: getEnvAsDuration("INVENTORY_ALERT_INTERVAL", time.Minute),
+		AlertBatch:    alertBatch,
+	}
+}
+
+type AnalyticsConfig struct {
+	// RollupInterval — период пересчёта дневных показателей для аналитики продавцов.
+	// Неположительное значение отключает пересчёт.
+	RollupInterval time.Duration
+	// LookbackDays — за сколько последних дней показатели пересчитываются заново,
+	// чтобы учесть отмены и возвраты по недавним заказам
+	LookbackDays int
+}
+
+func newAnalyticsConfig() *AnalyticsConfig {
+	lookbackDays := 30
+	if val, exists := os.LookupEnv("SELLER_ANALYTICS_LOOKBACK_DAYS"); exists {
+		if parsed, err := strconv.Atoi(val); err == nil && parsed >= 0 {
+			lookbackDays = parsed
+		}
+	}
+
+	return &AnalyticsConfig{
+		RollupInterval: getEnvAsDuration("SELLER_ANALYTICS_ROLLUP_INTERVAL", time.Hour),
+		LookbackDays:   lookbackDays,
+	}
+}
+
+type PayoutConfig struct {
+	// SettlementInterval — период расчётов с продавцами: начисления, снятие холда
+	// и формирование выписок. Неположительное значение отключает расчёты.
+	SettlementInterval time.Duration
+	// ReturnWindow — сколько после доставки начисление удерживается на случай возврата
+	ReturnWindow time.Duration
+	// StatementPeriod — как часто продавцу формируется выписка к выплате
+	StatementPeriod time.Duration
+	// DefaultCommissionBps — комиссия площадки в базисных пунктах для товаров,
+	// у категорий которых комиссия не задана
+	DefaultCommissionBps int
+}
+
+func newPayoutConfig() *PayoutConfig {
+	defaultCommission := 1000
+	if val, exists := os.LookupEnv("PAYOUT_DEFAULT_COMMISSION_BPS"); exists {
+		if parsed, err := strconv.Atoi(val); err == nil && parsed >= 0 && parsed <= 10000 {
+			defaultCommission = parsed
+		}
+	}
+
+	return &PayoutConfig{
+		SettlementInterval:   getEnvAsDuration("PAYOUT_SETTLEMENT_INTERVAL", time.Hour),
+		ReturnWindow:         getEnvAsDuration("PAYOUT_RETURN_WINDOW", 14*24*time.Hour),
+		StatementPeriod:      getEnvAsDuration("PAYOUT_STATEMENT_PERIOD", 7*24*time.Hour),
+		DefaultCommissionBps: defaultCommission,
+	}
+}
+
+type CurrencyConfig struct {
+	// RatesCacheTTL — сколько курс валюты хранится в памяти, прежде чем его
+	// перечитают из базы; изменение курса на другой реплике видно не позже
+	RatesCacheTTL time.Duration
+	// MaxImportRows — наибольшее число курсов в одном файле
+	MaxImportRows int
+}
+
+func newCurrencyConfig() *CurrencyConfig {
+	maxRows := 500
+	if val, exists := os.LookupEnv("CURRENCY_MAX_IMPORT_ROWS"); exists {
+		if parsed, err := strconv.Atoi(val); err == nil && parsed > 0 {
+			maxRows = parsed
+		}
+	}
+
+	return &CurrencyConfig{
+		RatesCacheTTL: getEnvAsDuration("CURRENCY_RATES_CACHE_TTL", time.Minute),
+		MaxImportRows: maxRows,
+	}
+}
+
 func getEnvAsBool(key string) (bool, error) {
 	valueStr, ok := os.LookupEnv(key)
 	if !ok {
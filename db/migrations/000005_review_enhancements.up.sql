@@ -0,0 +1,90 @@
+-- Счётчики голосов за полезность отзыва
+ALTER TABLE bazaar.review
+    ADD COLUMN IF NOT EXISTS helpful_count   INT NOT NULL DEFAULT 0 CHECK (helpful_count >= 0),
+    ADD COLUMN IF NOT EXISTS unhelpful_count INT NOT NULL DEFAULT 0 CHECK (unhelpful_count >= 0);
+
+-- Фотографии к отзывам
+CREATE TABLE IF NOT EXISTS bazaar.review_photo
+(
+    id         UUID PRIMARY KEY,
+    review_id  UUID NOT NULL REFERENCES bazaar.review (id) ON DELETE CASCADE,
+    image_url  TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now()
+);
+
+-- Голоса "полезно / бесполезно"
+CREATE TABLE IF NOT EXISTS bazaar.review_vote
+(
+    id         UUID PRIMARY KEY,
+    review_id  UUID    NOT NULL REFERENCES bazaar.review (id) ON DELETE CASCADE,
+    user_id    UUID    NOT NULL REFERENCES bazaar."user" (id) ON DELETE CASCADE,
+    is_helpful BOOLEAN NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now(),
+    UNIQUE (review_id, user_id)
+);
+
+-- Официальный ответ продавца (не более одного на отзыв)
+CREATE TABLE IF NOT EXISTS bazaar.review_reply
+(
+    id         UUID PRIMARY KEY,
+    review_id  UUID NOT NULL REFERENCES bazaar.review (id) ON DELETE CASCADE UNIQUE,
+    seller_id  UUID NOT NULL REFERENCES bazaar."user" (id) ON DELETE CASCADE,
+    text       TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_product_created
+    ON bazaar.review (product_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_review_photo_review
+    ON bazaar.review_photo (review_id);
+
+CREATE TRIGGER update_review_vote_updated_at
+    BEFORE UPDATE
+    ON bazaar.review_vote
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at();
+
+CREATE TRIGGER update_review_reply_updated_at
+    BEFORE UPDATE
+    ON bazaar.review_reply
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at();
+
+-- Рейтинг товара пересчитывается при изменении и удалении отзыва
+CREATE TRIGGER after_review_update
+AFTER UPDATE OF rating, product_id ON bazaar.review
+FOR EACH ROW
+EXECUTE FUNCTION update_product_review_stats();
+
+CREATE TRIGGER after_review_delete
+AFTER DELETE ON bazaar.review
+FOR EACH ROW
+EXECUTE FUNCTION update_product_review_stats();
+
+CREATE OR REPLACE FUNCTION update_review_vote_counts()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE bazaar.review r
+    SET
+        helpful_count = subquery.helpful,
+        unhelpful_count = subquery.unhelpful
+    FROM (
+        SELECT
+            COUNT(*) FILTER (WHERE is_helpful) as helpful,
+            COUNT(*) FILTER (WHERE NOT is_helpful) as unhelpful
+        FROM bazaar.review_vote
+        WHERE review_id = COALESCE(NEW.review_id, OLD.review_id)
+    ) subquery
+    WHERE r.id = COALESCE(NEW.review_id, OLD.review_id);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER after_review_vote_change
+AFTER INSERT OR UPDATE OR DELETE ON bazaar.review_vote
+FOR EACH ROW
+EXECUTE FUNCTION update_review_vote_counts();
@@ -0,0 +1,17 @@
+-- Отметка о подтверждённой покупке
+ALTER TABLE bazaar.review
+    ADD COLUMN IF NOT EXISTS verified_purchase BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE bazaar.review r
+SET verified_purchase = TRUE
+WHERE EXISTS (
+    SELECT 1
+    FROM bazaar.order_item oi
+    JOIN bazaar."order" o ON o.id = oi.order_id
+    WHERE o.user_id = r.user_id
+      AND oi.product_id = r.product_id
+      AND o.status = 'delivered'
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_user_status
+    ON bazaar."order" (user_id, status);
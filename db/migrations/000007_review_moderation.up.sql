@@ -0,0 +1,46 @@
+-- Статус модерации отзыва
+CREATE TYPE bazaar.review_status AS ENUM (
+    'pending',   -- Ожидает проверки модератором
+    'published', -- Опубликован
+    'rejected'   -- Отклонён
+);
+
+-- Существующие отзывы уже видны покупателям, поэтому считаем их опубликованными
+ALTER TABLE bazaar.review
+    ADD COLUMN IF NOT EXISTS status            bazaar.review_status NOT NULL DEFAULT 'published',
+    ADD COLUMN IF NOT EXISTS moderation_reason TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_review_status_created
+    ON bazaar.review (status, created_at);
+
+CREATE INDEX IF NOT EXISTS idx_review_user_created
+    ON bazaar.review (user_id, created_at DESC);
+
+-- В рейтинге товара учитываются только опубликованные отзывы
+CREATE OR REPLACE FUNCTION update_product_review_stats()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE bazaar.product p
+    SET
+        reviews_count = subquery.review_count,
+        rating = subquery.avg_rating
+    FROM (
+        SELECT
+            COUNT(*) as review_count,
+            COALESCE(AVG(rating), 0) as avg_rating
+        FROM bazaar.review
+        WHERE product_id = COALESCE(NEW.product_id, OLD.product_id)
+          AND status = 'published'
+    ) subquery
+    WHERE p.id = COALESCE(NEW.product_id, OLD.product_id);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS after_review_update ON bazaar.review;
+
+CREATE TRIGGER after_review_update
+AFTER UPDATE OF rating, product_id, status ON bazaar.review
+FOR EACH ROW
+EXECUTE FUNCTION update_product_review_stats();
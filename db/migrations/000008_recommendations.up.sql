@@ -0,0 +1,30 @@
+-- Предрасчитанная похожесть товаров ("с этим товаром покупают").
+-- Таблица целиком пересобирается фоновой задачей.
+CREATE TABLE IF NOT EXISTS bazaar.product_similarity
+(
+    product_id         UUID             NOT NULL REFERENCES bazaar.product (id) ON DELETE CASCADE,
+    similar_product_id UUID             NOT NULL REFERENCES bazaar.product (id) ON DELETE CASCADE,
+    score              DOUBLE PRECISION NOT NULL,
+    updated_at         TIMESTAMPTZ DEFAULT now(),
+    PRIMARY KEY (product_id, similar_product_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_similarity_score
+    ON bazaar.product_similarity (product_id, score DESC);
+
+-- Популярность товаров для холодного старта
+CREATE TABLE IF NOT EXISTS bazaar.product_popularity
+(
+    product_id UUID PRIMARY KEY REFERENCES bazaar.product (id) ON DELETE CASCADE,
+    score      DOUBLE PRECISION NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_popularity_score
+    ON bazaar.product_popularity (score DESC);
+
+CREATE INDEX IF NOT EXISTS idx_order_item_product
+    ON bazaar.order_item (product_id);
+
+CREATE INDEX IF NOT EXISTS idx_basket_item_product
+    ON bazaar.basket_item (product_id);
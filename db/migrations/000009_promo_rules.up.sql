@@ -0,0 +1,53 @@
+-- Правила промокодов: тип скидки, ограничения использования, минимальная сумма,
+-- область действия и совместимость со скидками на товары
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'promo_discount_type') THEN
+        CREATE TYPE bazaar.promo_discount_type AS ENUM (
+            'percent', -- процент от суммы подходящих товаров
+            'fixed'    -- фиксированная сумма
+        );
+    END IF;
+
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'promo_scope_type') THEN
+        CREATE TYPE bazaar.promo_scope_type AS ENUM (
+            'category', -- категория или подкатегория
+            'seller',   -- продавец
+            'product'   -- конкретный товар
+        );
+    END IF;
+END
+$$;
+
+ALTER TABLE bazaar.promo_code
+    ADD COLUMN IF NOT EXISTS discount_type       bazaar.promo_discount_type NOT NULL DEFAULT 'percent',
+    ADD COLUMN IF NOT EXISTS amount               NUMERIC(12, 2) NOT NULL DEFAULT 0 CHECK (amount >= 0),
+    ADD COLUMN IF NOT EXISTS min_order_amount     NUMERIC(12, 2) NOT NULL DEFAULT 0 CHECK (min_order_amount >= 0),
+    ADD COLUMN IF NOT EXISTS max_discount         NUMERIC(12, 2) CHECK (max_discount > 0),
+    ADD COLUMN IF NOT EXISTS usage_limit          INT CHECK (usage_limit > 0),
+    ADD COLUMN IF NOT EXISTS per_user_limit       INT CHECK (per_user_limit > 0),
+    ADD COLUMN IF NOT EXISTS first_order_only     BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS stack_with_discounts BOOLEAN NOT NULL DEFAULT TRUE;
+
+-- Область действия промокода. Если записей нет, промокод действует на все товары.
+CREATE TABLE IF NOT EXISTS bazaar.promo_code_scope
+(
+    promo_id   UUID                    NOT NULL REFERENCES bazaar.promo_code (id) ON DELETE CASCADE,
+    scope_type bazaar.promo_scope_type NOT NULL,
+    target_id  UUID                    NOT NULL,
+    PRIMARY KEY (promo_id, scope_type, target_id)
+);
+
+-- Использования промокодов; запись создаётся в транзакции оформления заказа
+CREATE TABLE IF NOT EXISTS bazaar.promo_redemption
+(
+    id         UUID PRIMARY KEY,
+    promo_id   UUID           NOT NULL REFERENCES bazaar.promo_code (id) ON DELETE CASCADE,
+    user_id    UUID           NOT NULL REFERENCES bazaar."user" (id) ON DELETE CASCADE,
+    order_id   UUID           NOT NULL UNIQUE REFERENCES bazaar."order" (id) ON DELETE CASCADE,
+    discount   NUMERIC(12, 2) NOT NULL CHECK (discount >= 0),
+    created_at TIMESTAMPTZ DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_promo_redemption_promo_user
+    ON bazaar.promo_redemption (promo_id, user_id);
@@ -0,0 +1,20 @@
+-- Кампании промокодов: массовая генерация одноразовых кодов с общими правилами
+CREATE TABLE IF NOT EXISTS bazaar.promo_campaign
+(
+    id          UUID PRIMARY KEY,
+    name        TEXT        NOT NULL,
+    prefix      TEXT        NOT NULL DEFAULT '',
+    alphabet    TEXT        NOT NULL,
+    code_length INT         NOT NULL CHECK (code_length > 0),
+    codes_count INT         NOT NULL CHECK (codes_count > 0),
+    is_active   BOOLEAN     NOT NULL DEFAULT TRUE,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+ALTER TABLE bazaar.promo_code
+    ADD COLUMN IF NOT EXISTS campaign_id UUID REFERENCES bazaar.promo_campaign (id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS is_active   BOOLEAN NOT NULL DEFAULT TRUE;
+
+CREATE INDEX IF NOT EXISTS idx_promo_code_campaign
+    ON bazaar.promo_code (campaign_id)
+    WHERE campaign_id IS NOT NULL;
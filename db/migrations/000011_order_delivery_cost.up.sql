@@ -0,0 +1,4 @@
+-- Стоимость доставки заказа. total_price_discount остаётся суммой за товары
+-- после всех скидок, к оплате — total_price_discount + delivery_cost.
+ALTER TABLE bazaar."order"
+    ADD COLUMN IF NOT EXISTS delivery_cost NUMERIC(12, 2) NOT NULL DEFAULT 0 CHECK (delivery_cost >= 0);
@@ -0,0 +1,23 @@
+-- Резервы товара на время оформления заказа. Остаток товара уменьшается
+-- при резервировании; подтверждённый резерв переходит в заказ, а просроченный
+-- возвращается в остаток фоновой задачей.
+CREATE TABLE IF NOT EXISTS bazaar.stock_reservation
+(
+    id         UUID PRIMARY KEY,
+    user_id    UUID        NOT NULL REFERENCES bazaar."user" (id) ON DELETE CASCADE,
+    product_id UUID        NOT NULL REFERENCES bazaar.product (id) ON DELETE CASCADE,
+    quantity   INT         NOT NULL CHECK (quantity > 0),
+    status     TEXT        NOT NULL DEFAULT 'held' CHECK (status IN ('held', 'confirmed', 'released')),
+    order_id   UUID REFERENCES bazaar."order" (id) ON DELETE SET NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_reservation_held_expires
+    ON bazaar.stock_reservation (expires_at)
+    WHERE status = 'held';
+
+CREATE INDEX IF NOT EXISTS idx_stock_reservation_held_user
+    ON bazaar.stock_reservation (user_id, product_id)
+    WHERE status = 'held';
@@ -0,0 +1,49 @@
+-- Отправления: часть заказа, которую собирает и отправляет один продавец.
+-- Сумма заказа и промокод остаются на уровне заказа, у отправления — только его товары.
+CREATE TABLE IF NOT EXISTS bazaar.order_shipment
+(
+    id                   UUID PRIMARY KEY,
+    order_id             UUID                                             NOT NULL REFERENCES bazaar."order" (id) ON DELETE CASCADE,
+    seller_id            UUID                                             REFERENCES bazaar."user" (id) ON DELETE SET NULL,
+    status               bazaar.order_status                              NOT NULL DEFAULT 'awaiting_confirmation',
+    total_price          NUMERIC(12, 2) CHECK (total_price >= 0)          NOT NULL,
+    total_price_discount NUMERIC(12, 2) CHECK (total_price_discount >= 0) NOT NULL,
+    tracking_number      TEXT,
+    expected_delivery_at TIMESTAMPTZ,
+    created_at           TIMESTAMPTZ DEFAULT now(),
+    updated_at           TIMESTAMPTZ DEFAULT now(),
+    UNIQUE (order_id, seller_id)
+);
+
+CREATE INDEX IF NOT EXISTS order_shipment_seller_idx
+    ON bazaar.order_shipment (seller_id, created_at DESC);
+
+ALTER TABLE bazaar.order_item
+    ADD COLUMN IF NOT EXISTS shipment_id UUID REFERENCES bazaar.order_shipment (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS order_item_shipment_idx
+    ON bazaar.order_item (shipment_id);
+
+-- Существующие заказы раскладываются по продавцам. Идентификатор отправления
+-- детерминированно выводится из заказа и продавца.
+INSERT INTO bazaar.order_shipment (id, order_id, seller_id, status, total_price, total_price_discount,
+                                   expected_delivery_at, created_at)
+SELECT md5(o.id::text || coalesce(p.seller_id::text, ''))::uuid,
+       o.id,
+       p.seller_id,
+       o.status,
+       SUM(p.price * oi.quantity),
+       SUM(oi.price * oi.quantity),
+       o.expected_delivery_at,
+       o.created_at
+FROM bazaar."order" o
+         JOIN bazaar.order_item oi ON oi.order_id = o.id
+         JOIN bazaar.product p ON p.id = oi.product_id
+GROUP BY o.id, p.seller_id
+ON CONFLICT DO NOTHING;
+
+UPDATE bazaar.order_item oi
+SET shipment_id = md5(oi.order_id::text || coalesce(p.seller_id::text, ''))::uuid
+FROM bazaar.product p
+WHERE p.id = oi.product_id
+  AND oi.shipment_id IS NULL;
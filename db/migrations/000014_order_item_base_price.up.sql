@@ -0,0 +1,5 @@
+-- Цена товара без скидки на момент заказа: нужна, чтобы показать в счёте
+-- применённую скидку по каждой позиции. У старых позиций её нет, для них
+-- цена без скидки считается равной цене покупки.
+ALTER TABLE bazaar.order_item
+    ADD COLUMN IF NOT EXISTS base_price NUMERIC(12, 2) CHECK (base_price >= 0);
@@ -0,0 +1,39 @@
+-- Зоны доставки. Для адреса выбирается зона с совпадающим городом, затем
+-- с совпадающим регионом; зона без региона и города подходит любому адресу.
+CREATE TABLE IF NOT EXISTS bazaar.delivery_zone
+(
+    id             UUID PRIMARY KEY,
+    name           TEXT NOT NULL,
+    region         TEXT,
+    city           TEXT,
+    lead_time_days INT  NOT NULL CHECK (lead_time_days >= 0),
+    created_at     TIMESTAMPTZ DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS delivery_zone_place_idx
+    ON bazaar.delivery_zone (lower(coalesce(region, '')), lower(coalesce(city, '')));
+
+-- Интервалы доставки с ограниченным числом заказов. reserved увеличивается
+-- в транзакции оформления заказа и не может превысить capacity.
+CREATE TABLE IF NOT EXISTS bazaar.delivery_slot
+(
+    id         UUID PRIMARY KEY,
+    zone_id    UUID        NOT NULL REFERENCES bazaar.delivery_zone (id) ON DELETE CASCADE,
+    starts_at  TIMESTAMPTZ NOT NULL,
+    ends_at    TIMESTAMPTZ NOT NULL,
+    capacity   INT         NOT NULL CHECK (capacity > 0),
+    reserved   INT         NOT NULL DEFAULT 0 CHECK (reserved >= 0),
+    created_at TIMESTAMPTZ DEFAULT now(),
+    CHECK (ends_at > starts_at),
+    CHECK (reserved <= capacity),
+    UNIQUE (zone_id, starts_at)
+);
+
+ALTER TABLE bazaar."order"
+    ADD COLUMN IF NOT EXISTS delivery_slot_id UUID REFERENCES bazaar.delivery_slot (id) ON DELETE SET NULL;
+
+INSERT INTO bazaar.delivery_zone (id, name, region, city, lead_time_days)
+VALUES ('7b0f3c1e-0000-4000-8000-000000000001', 'Вся Россия', NULL, NULL, 5),
+       ('7b0f3c1e-0000-4000-8000-000000000002', 'Москва', NULL, 'Москва', 1),
+       ('7b0f3c1e-0000-4000-8000-000000000003', 'Санкт-Петербург', NULL, 'Санкт-Петербург', 2)
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,23 @@
+-- Название и часы работы ПВЗ
+ALTER TABLE bazaar.pickup_point
+    ADD COLUMN IF NOT EXISTS name      TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS opens_at  TIME NOT NULL DEFAULT '09:00',
+    ADD COLUMN IF NOT EXISTS closes_at TIME NOT NULL DEFAULT '21:00';
+
+ALTER TABLE bazaar.pickup_point
+    ADD CONSTRAINT pickup_point_hours_check CHECK (closes_at > opens_at);
+
+-- Избранный ПВЗ сохраняется пользователем один раз
+DELETE FROM bazaar.user_pickup_point a
+    USING bazaar.user_pickup_point b
+WHERE a.user_id = b.user_id
+  AND a.pickup_point_id = b.pickup_point_id
+  AND a.id > b.id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS user_pickup_point_user_point_idx
+    ON bazaar.user_pickup_point (user_id, pickup_point_id);
+
+-- Заказ с самовывозом доставляется по адресу ПВЗ: address_id указывает на него,
+-- а pickup_point_id отличает такой заказ от курьерской доставки
+ALTER TABLE bazaar."order"
+    ADD COLUMN IF NOT EXISTS pickup_point_id UUID REFERENCES bazaar.pickup_point (id) ON DELETE SET NULL;
@@ -0,0 +1,76 @@
+-- Дерево категорий произвольной глубины. Подкатегории переносятся в bazaar.category
+-- с прежними id, поэтому привязки товаров сохраняются, а bazaar.subcategory
+-- остаётся представлением для запросов, работающих с двухуровневым каталогом.
+
+-- Транслитерация названия в URL-slug: "Умные часы и браслеты" → "umnye-chasy-i-braslety"
+CREATE OR REPLACE FUNCTION bazaar.slugify(value TEXT) RETURNS TEXT AS
+$$
+DECLARE
+    translit CONSTANT JSONB := '{
+        "а": "a", "б": "b", "в": "v", "г": "g", "д": "d", "е": "e", "ё": "e", "ж": "zh",
+        "з": "z", "и": "i", "й": "y", "к": "k", "л": "l", "м": "m", "н": "n", "о": "o",
+        "п": "p", "р": "r", "с": "s", "т": "t", "у": "u", "ф": "f", "х": "kh", "ц": "ts",
+        "ч": "ch", "ш": "sh", "щ": "shch", "ъ": "", "ы": "y", "ь": "", "э": "e", "ю": "yu",
+        "я": "ya"
+    }';
+    source TEXT := lower(value);
+    result TEXT := '';
+    ch     TEXT;
+BEGIN
+    FOR i IN 1..char_length(source)
+        LOOP
+            ch := substr(source, i, 1);
+            result := result || COALESCE(translit ->> ch, ch);
+        END LOOP;
+
+    RETURN trim(BOTH '-' FROM regexp_replace(result, '[^a-z0-9]+', '-', 'g'));
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+
+-- Названия уникальны только среди соседних узлов
+ALTER TABLE bazaar.category
+    DROP CONSTRAINT IF EXISTS category_name_key,
+    ADD COLUMN IF NOT EXISTS parent_id UUID REFERENCES bazaar.category (id) ON DELETE RESTRICT,
+    ADD COLUMN IF NOT EXISTS slug      TEXT,
+    ADD COLUMN IF NOT EXISTS image_url TEXT,
+    ADD COLUMN IF NOT EXISTS position  INT NOT NULL DEFAULT 0;
+
+INSERT INTO bazaar.category (id, name, parent_id)
+SELECT id, name, category_id
+FROM bazaar.subcategory;
+
+-- Товар привязывается к любому узлу дерева; непустую категорию удалить нельзя
+ALTER TABLE bazaar.product_subcategory
+    DROP CONSTRAINT IF EXISTS product_subcategory_subcategory_id_fkey,
+    ADD CONSTRAINT product_subcategory_subcategory_id_fkey
+        FOREIGN KEY (subcategory_id) REFERENCES bazaar.category (id) ON DELETE RESTRICT;
+
+DROP TABLE bazaar.subcategory;
+
+CREATE VIEW bazaar.subcategory AS
+SELECT id, name, parent_id AS category_id
+FROM bazaar.category
+WHERE parent_id IS NOT NULL;
+
+UPDATE bazaar.category c
+SET position = ranked.position
+FROM (SELECT id, row_number() OVER (PARTITION BY parent_id ORDER BY name) - 1 AS position
+      FROM bazaar.category) ranked
+WHERE ranked.id = c.id;
+
+-- Совпадающие slug-и получают суффикс: корневая категория сохраняет slug без суффикса
+UPDATE bazaar.category c
+SET slug = ranked.slug || CASE WHEN ranked.n > 1 THEN '-' || ranked.n ELSE '' END
+FROM (SELECT id,
+             bazaar.slugify(name)                                                                   AS slug,
+             row_number() OVER (PARTITION BY bazaar.slugify(name) ORDER BY parent_id NULLS FIRST, name) AS n
+      FROM bazaar.category) ranked
+WHERE ranked.id = c.id;
+
+ALTER TABLE bazaar.category
+    ALTER COLUMN slug SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_category_slug ON bazaar.category (slug);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_category_sibling_name
+    ON bazaar.category (COALESCE(parent_id, '00000000-0000-0000-0000-000000000000'), lower(name));
+CREATE INDEX IF NOT EXISTS idx_category_parent ON bazaar.category (parent_id, position);
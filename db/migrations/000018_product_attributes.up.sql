@@ -0,0 +1,47 @@
+-- Характеристики товаров: схема атрибутов задаётся на узле дерева категорий
+-- и наследуется всеми его потомками
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'attribute_type') THEN
+        CREATE TYPE bazaar.attribute_type AS ENUM (
+            'enum',    -- одно значение из списка options
+            'number',  -- число в единицах unit
+            'boolean', -- да/нет
+            'text'     -- произвольная строка
+        );
+    END IF;
+END
+$$;
+
+CREATE TABLE IF NOT EXISTS bazaar.category_attribute
+(
+    id          UUID PRIMARY KEY,
+    category_id UUID                  NOT NULL REFERENCES bazaar.category (id) ON DELETE CASCADE,
+    name        TEXT                  NOT NULL CHECK (name <> ''),
+    type        bazaar.attribute_type NOT NULL,
+    unit        TEXT,
+    options     TEXT[]                NOT NULL DEFAULT '{}',
+    required    BOOLEAN               NOT NULL DEFAULT FALSE,
+    filterable  BOOLEAN               NOT NULL DEFAULT TRUE,
+    position    INT                   NOT NULL DEFAULT 0,
+    CHECK (type = 'enum' OR cardinality(options) = 0),
+    CHECK (type = 'number' OR unit IS NULL)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_category_attribute_name
+    ON bazaar.category_attribute (category_id, lower(name));
+
+-- Значение хранится в колонке своего типа; значение enum хранится в value_text
+CREATE TABLE IF NOT EXISTS bazaar.product_attribute
+(
+    product_id   UUID NOT NULL REFERENCES bazaar.product (id) ON DELETE CASCADE,
+    attribute_id UUID NOT NULL REFERENCES bazaar.category_attribute (id) ON DELETE CASCADE,
+    value_text   TEXT,
+    value_number DOUBLE PRECISION,
+    value_bool   BOOLEAN,
+    PRIMARY KEY (product_id, attribute_id),
+    CHECK (num_nonnulls(value_text, value_number, value_bool) = 1)
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_attribute_text ON bazaar.product_attribute (attribute_id, value_text);
+CREATE INDEX IF NOT EXISTS idx_product_attribute_number ON bazaar.product_attribute (attribute_id, value_number);
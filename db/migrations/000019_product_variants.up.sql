@@ -0,0 +1,72 @@
+-- Варианты товара (размер, цвет и т.п.): у каждого SKU свои значения опций,
+-- цена, остаток и изображения. Отзывы и рейтинг остаются у родительского товара
+CREATE TABLE IF NOT EXISTS bazaar.product_variant
+(
+    id         UUID PRIMARY KEY,
+    product_id UUID           NOT NULL REFERENCES bazaar.product (id) ON DELETE CASCADE,
+    sku        TEXT           NOT NULL UNIQUE CHECK (sku <> ''),
+    options    JSONB          NOT NULL DEFAULT '{}',
+    price      NUMERIC(12, 2) NOT NULL CHECK (price > 0),
+    quantity   INT            NOT NULL DEFAULT 0 CHECK (quantity >= 0),
+    images     TEXT[]         NOT NULL DEFAULT '{}',
+    position   INT            NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ    NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ    NOT NULL DEFAULT now(),
+    UNIQUE (product_id, options)
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_variant_product ON bazaar.product_variant (product_id, position);
+
+DROP TRIGGER IF EXISTS update_product_variant_updated_at ON bazaar.product_variant;
+CREATE TRIGGER update_product_variant_updated_at
+    BEFORE UPDATE
+    ON bazaar.product_variant
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at();
+
+-- Цена родителя — минимальная цена варианта, остаток — сумма остатков вариантов,
+-- поэтому каталог, поиск и сортировки продолжают работать по bazaar.product.
+-- После удаления последнего варианта цена сохраняется, а остаток обнуляется
+CREATE OR REPLACE FUNCTION bazaar.sync_product_from_variants() RETURNS TRIGGER AS
+$$
+DECLARE
+    parent_id UUID := COALESCE(NEW.product_id, OLD.product_id);
+BEGIN
+    UPDATE bazaar.product p
+    SET price    = COALESCE(v.min_price, p.price),
+        quantity = COALESCE(v.total_quantity, 0)
+    FROM (SELECT MIN(price) AS min_price, SUM(quantity) AS total_quantity
+          FROM bazaar.product_variant
+          WHERE product_id = parent_id) v
+    WHERE p.id = parent_id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_sync_product_from_variants ON bazaar.product_variant;
+CREATE TRIGGER trg_sync_product_from_variants
+    AFTER INSERT OR UPDATE OF price, quantity OR DELETE
+    ON bazaar.product_variant
+    FOR EACH ROW
+EXECUTE FUNCTION bazaar.sync_product_from_variants();
+
+-- Позиции корзины, заказа, резервы и скидки ссылаются на конкретный SKU;
+-- NULL означает товар без вариантов (скидка без варианта действует на все SKU)
+ALTER TABLE bazaar.basket_item
+    ADD COLUMN IF NOT EXISTS variant_id UUID REFERENCES bazaar.product_variant (id) ON DELETE CASCADE;
+ALTER TABLE bazaar.order_item
+    ADD COLUMN IF NOT EXISTS variant_id UUID REFERENCES bazaar.product_variant (id) ON DELETE SET NULL;
+ALTER TABLE bazaar.stock_reservation
+    ADD COLUMN IF NOT EXISTS variant_id UUID REFERENCES bazaar.product_variant (id) ON DELETE CASCADE;
+ALTER TABLE bazaar.discount
+    ADD COLUMN IF NOT EXISTS variant_id UUID REFERENCES bazaar.product_variant (id) ON DELETE CASCADE;
+
+ALTER TABLE bazaar.basket_item DROP CONSTRAINT IF EXISTS basket_item_basket_id_product_id_key;
+ALTER TABLE bazaar.basket_item
+    ADD CONSTRAINT basket_item_basket_id_product_id_variant_id_key
+        UNIQUE NULLS NOT DISTINCT (basket_id, product_id, variant_id);
+
+ALTER TABLE bazaar.order_item DROP CONSTRAINT IF EXISTS order_item_order_id_product_id_key;
+ALTER TABLE bazaar.order_item
+    ADD CONSTRAINT order_item_order_id_product_id_variant_id_key
+        UNIQUE NULLS NOT DISTINCT (order_id, product_id, variant_id);
@@ -0,0 +1,45 @@
+-- Артикул продавца. По нему импорт каталога находит товар для обновления;
+-- у разных продавцов артикулы могут совпадать.
+ALTER TABLE bazaar.product
+    ADD COLUMN IF NOT EXISTS sku TEXT CHECK (sku <> '');
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_product_seller_sku
+    ON bazaar.product (seller_id, sku)
+    WHERE sku IS NOT NULL;
+
+-- Задачи импорта каталога. Файл лежит в MinIO, задачу забирает фоновый
+-- обработчик; зависшая в processing задача забирается повторно.
+CREATE TABLE IF NOT EXISTS bazaar.catalog_import
+(
+    id           UUID PRIMARY KEY,
+    seller_id    UUID        NOT NULL REFERENCES bazaar."user" (id) ON DELETE CASCADE,
+    format       TEXT        NOT NULL CHECK (format IN ('csv', 'xlsx')),
+    file_name    TEXT        NOT NULL,
+    file_key     TEXT        NOT NULL,
+    status       TEXT        NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'processing', 'done', 'failed')),
+    total_rows   INT         NOT NULL DEFAULT 0 CHECK (total_rows >= 0),
+    created_rows INT         NOT NULL DEFAULT 0 CHECK (created_rows >= 0),
+    updated_rows INT         NOT NULL DEFAULT 0 CHECK (updated_rows >= 0),
+    failed_rows  INT         NOT NULL DEFAULT 0 CHECK (failed_rows >= 0),
+    error        TEXT,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    started_at   TIMESTAMPTZ,
+    finished_at  TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_catalog_import_seller
+    ON bazaar.catalog_import (seller_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_catalog_import_queue
+    ON bazaar.catalog_import (created_at)
+    WHERE status IN ('pending', 'processing');
+
+-- Ошибки строк импорта для отчёта продавцу
+CREATE TABLE IF NOT EXISTS bazaar.catalog_import_error
+(
+    import_id  UUID NOT NULL REFERENCES bazaar.catalog_import (id) ON DELETE CASCADE,
+    row_number INT  NOT NULL,
+    sku        TEXT NOT NULL DEFAULT '',
+    message    TEXT NOT NULL,
+    PRIMARY KEY (import_id, row_number)
+);
@@ -0,0 +1,157 @@
+-- Порог остатка, при достижении которого продавец получает уведомление.
+-- Для товара с вариантами порог действует на каждый SKU.
+ALTER TABLE bazaar.product
+    ADD COLUMN IF NOT EXISTS low_stock_threshold INT CHECK (low_stock_threshold >= 0);
+
+-- Журнал движения остатков. Единица учёта — товар без вариантов (variant_id IS NULL)
+-- или SKU; остаток единицы равен сумме delta её движений. Записи создают триггеры,
+-- поэтому в журнал попадает любое изменение остатка. Вид движения, автора, заказ
+-- и причину код передаёт через локальные настройки транзакции bazaar.stock_*.
+-- variant_id без внешнего ключа: история удалённого SKU сохраняется.
+CREATE TABLE IF NOT EXISTS bazaar.stock_movement
+(
+    id         BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    product_id UUID        NOT NULL REFERENCES bazaar.product (id) ON DELETE CASCADE,
+    variant_id UUID,
+    delta      INT         NOT NULL CHECK (delta <> 0),
+    balance    INT         NOT NULL CHECK (balance >= 0),
+    kind       TEXT        NOT NULL CHECK (kind IN ('initial', 'reserve', 'release', 'sale', 'cancel_restore',
+                                                    'return', 'adjustment', 'import')),
+    actor_id   UUID REFERENCES bazaar."user" (id) ON DELETE SET NULL,
+    order_id   UUID REFERENCES bazaar."order" (id) ON DELETE SET NULL,
+    reason     TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_movement_unit
+    ON bazaar.stock_movement (product_id, variant_id, id);
+
+-- Уведомления о низком остатке. Запись появляется, когда остаток опускается
+-- до порога; фоновая задача превращает её в уведомление продавцу.
+CREATE TABLE IF NOT EXISTS bazaar.low_stock_alert
+(
+    id          UUID PRIMARY KEY,
+    product_id  UUID        NOT NULL REFERENCES bazaar.product (id) ON DELETE CASCADE,
+    variant_id  UUID REFERENCES bazaar.product_variant (id) ON DELETE CASCADE,
+    quantity    INT         NOT NULL,
+    threshold   INT         NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    notified_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_low_stock_alert_pending
+    ON bazaar.low_stock_alert (created_at)
+    WHERE notified_at IS NULL;
+
+CREATE OR REPLACE FUNCTION bazaar.record_stock_movement(
+    unit_product_id UUID,
+    unit_variant_id UUID,
+    quantity_before INT,
+    quantity_after INT,
+    default_kind TEXT,
+    default_actor UUID,
+    check_threshold BOOLEAN
+) RETURNS VOID AS
+$$
+DECLARE
+    threshold INT;
+BEGIN
+    IF quantity_after = quantity_before THEN
+        RETURN;
+    END IF;
+
+    INSERT INTO bazaar.stock_movement (product_id, variant_id, delta, balance, kind, actor_id, order_id, reason)
+    VALUES (unit_product_id,
+            unit_variant_id,
+            quantity_after - quantity_before,
+            quantity_after,
+            COALESCE(NULLIF(current_setting('bazaar.stock_kind', true), ''), default_kind),
+            COALESCE(NULLIF(current_setting('bazaar.stock_actor', true), '')::UUID, default_actor),
+            NULLIF(current_setting('bazaar.stock_order', true), '')::UUID,
+            NULLIF(current_setting('bazaar.stock_reason', true), ''));
+
+    IF NOT check_threshold THEN
+        RETURN;
+    END IF;
+
+    SELECT low_stock_threshold INTO threshold FROM bazaar.product WHERE id = unit_product_id;
+    IF threshold IS NOT NULL AND quantity_before > threshold AND quantity_after <= threshold THEN
+        INSERT INTO bazaar.low_stock_alert (id, product_id, variant_id, quantity, threshold)
+        VALUES (gen_random_uuid(), unit_product_id, unit_variant_id, quantity_after, threshold);
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Остаток товара с вариантами пересчитывает триггер из остатков SKU, такие
+-- изменения не пишутся. Предыдущий остаток берётся из журнала: так учитывается
+-- и обнуление товара после удаления последнего SKU.
+CREATE OR REPLACE FUNCTION bazaar.log_product_stock() RETURNS TRIGGER AS
+$$
+DECLARE
+    quantity_before INT;
+BEGIN
+    IF EXISTS (SELECT 1 FROM bazaar.product_variant WHERE product_id = NEW.id) THEN
+        RETURN NULL;
+    END IF;
+
+    SELECT balance INTO quantity_before
+    FROM bazaar.stock_movement
+    WHERE product_id = NEW.id AND variant_id IS NULL
+    ORDER BY id DESC
+    LIMIT 1;
+
+    IF TG_OP = 'INSERT' THEN
+        PERFORM bazaar.record_stock_movement(NEW.id, NULL, COALESCE(quantity_before, 0), NEW.quantity,
+                                             'initial', NEW.seller_id, false);
+    ELSE
+        PERFORM bazaar.record_stock_movement(NEW.id, NULL, COALESCE(quantity_before, 0), NEW.quantity,
+                                             'adjustment', NULL, true);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_log_product_stock ON bazaar.product;
+CREATE TRIGGER trg_log_product_stock
+    AFTER INSERT OR UPDATE OF quantity
+    ON bazaar.product
+    FOR EACH ROW
+EXECUTE FUNCTION bazaar.log_product_stock();
+
+CREATE OR REPLACE FUNCTION bazaar.log_variant_stock() RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        PERFORM bazaar.record_stock_movement(NEW.product_id, NEW.id, 0, NEW.quantity, 'initial',
+                                             (SELECT seller_id FROM bazaar.product WHERE id = NEW.product_id),
+                                             false);
+    ELSIF TG_OP = 'UPDATE' THEN
+        PERFORM bazaar.record_stock_movement(NEW.product_id, NEW.id, OLD.quantity, NEW.quantity,
+                                             'adjustment', NULL, true);
+    -- При удалении самого товара его журнал удаляется каскадно
+    ELSIF EXISTS (SELECT 1 FROM bazaar.product WHERE id = OLD.product_id) THEN
+        PERFORM bazaar.record_stock_movement(OLD.product_id, OLD.id, OLD.quantity, 0,
+                                             'adjustment', NULL, false);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_log_variant_stock ON bazaar.product_variant;
+CREATE TRIGGER trg_log_variant_stock
+    AFTER INSERT OR UPDATE OF quantity OR DELETE
+    ON bazaar.product_variant
+    FOR EACH ROW
+EXECUTE FUNCTION bazaar.log_variant_stock();
+
+-- Начальные остатки уже заведённых товаров
+INSERT INTO bazaar.stock_movement (product_id, variant_id, delta, balance, kind, reason)
+SELECT p.id, NULL, p.quantity, p.quantity, 'initial', 'opening balance'
+FROM bazaar.product p
+WHERE p.quantity > 0
+  AND NOT EXISTS (SELECT 1 FROM bazaar.product_variant v WHERE v.product_id = p.id);
+
+INSERT INTO bazaar.stock_movement (product_id, variant_id, delta, balance, kind, reason)
+SELECT v.product_id, v.id, v.quantity, v.quantity, 'initial', 'opening balance'
+FROM bazaar.product_variant v
+WHERE v.quantity > 0;
@@ -0,0 +1,69 @@
+-- Склады. По координате адреса склада заказ направляется на ближайший склад,
+-- который может его собрать.
+CREATE TABLE IF NOT EXISTS bazaar.warehouse
+(
+    id         UUID PRIMARY KEY,
+    name       TEXT        NOT NULL CHECK (name <> ''),
+    address_id UUID        NOT NULL REFERENCES bazaar.address (id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Физический остаток на складе. Единица учёта та же, что в журнале остатков:
+-- товар без вариантов (variant_id IS NULL) или SKU. product.quantity по-прежнему
+-- остаётся общим остатком, доступным к продаже.
+CREATE TABLE IF NOT EXISTS bazaar.warehouse_stock
+(
+    warehouse_id UUID        NOT NULL REFERENCES bazaar.warehouse (id) ON DELETE CASCADE,
+    product_id   UUID        NOT NULL REFERENCES bazaar.product (id) ON DELETE CASCADE,
+    variant_id   UUID REFERENCES bazaar.product_variant (id) ON DELETE CASCADE,
+    quantity     INT         NOT NULL CHECK (quantity >= 0),
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_warehouse_stock_unit
+    ON bazaar.warehouse_stock (warehouse_id, product_id, variant_id) NULLS NOT DISTINCT;
+
+-- Склад, к которому прикреплён работник склада
+ALTER TABLE bazaar."user"
+    ADD COLUMN IF NOT EXISTS warehouse_id UUID REFERENCES bazaar.warehouse (id) ON DELETE SET NULL;
+
+-- Склад, собирающий заказ. NULL — заказ ещё не удалось направить ни на один склад
+ALTER TABLE bazaar."order"
+    ADD COLUMN IF NOT EXISTS warehouse_id UUID REFERENCES bazaar.warehouse (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_order_warehouse_placed
+    ON bazaar."order" (warehouse_id, created_at)
+    WHERE status = 'placed';
+
+-- До появления складов вся система работала как один склад: он становится
+-- основным, получает текущие остатки, работников склада и оформленные заказы
+INSERT INTO bazaar.address (id, region, city, address_string, coordinate)
+VALUES ('550e8400-e29b-41d4-a716-446655440250', 'Москва', 'Москва', 'Основной склад', '55.751244,37.618423')
+ON CONFLICT (address_string, coordinate) DO NOTHING;
+
+INSERT INTO bazaar.warehouse (id, name, address_id)
+SELECT '550e8400-e29b-41d4-a716-446655440251', 'Основной склад', a.id
+FROM bazaar.address a
+WHERE a.address_string = 'Основной склад' AND a.coordinate = '55.751244,37.618423'
+ON CONFLICT (id) DO NOTHING;
+
+INSERT INTO bazaar.warehouse_stock (warehouse_id, product_id, variant_id, quantity)
+SELECT '550e8400-e29b-41d4-a716-446655440251', p.id, NULL, p.quantity
+FROM bazaar.product p
+WHERE p.quantity > 0
+  AND NOT EXISTS (SELECT 1 FROM bazaar.product_variant v WHERE v.product_id = p.id)
+ON CONFLICT DO NOTHING;
+
+INSERT INTO bazaar.warehouse_stock (warehouse_id, product_id, variant_id, quantity)
+SELECT '550e8400-e29b-41d4-a716-446655440251', v.product_id, v.id, v.quantity
+FROM bazaar.product_variant v
+WHERE v.quantity > 0
+ON CONFLICT DO NOTHING;
+
+UPDATE bazaar."user"
+SET warehouse_id = '550e8400-e29b-41d4-a716-446655440251'
+WHERE role = 'warehouseman' AND warehouse_id IS NULL;
+
+UPDATE bazaar."order"
+SET warehouse_id = '550e8400-e29b-41d4-a716-446655440251'
+WHERE status = 'placed' AND warehouse_id IS NULL;
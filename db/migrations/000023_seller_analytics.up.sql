@@ -0,0 +1,62 @@
+-- Добавления товаров в корзину по дням. Позиции корзины удаляются при оформлении
+-- заказа, поэтому для конверсии счётчик ведёт триггер в момент добавления.
+CREATE TABLE IF NOT EXISTS bazaar.product_basket_add
+(
+    product_id UUID NOT NULL REFERENCES bazaar.product (id) ON DELETE CASCADE,
+    day        DATE NOT NULL,
+    adds       INT  NOT NULL DEFAULT 0 CHECK (adds >= 0),
+    PRIMARY KEY (product_id, day)
+);
+
+CREATE OR REPLACE FUNCTION bazaar.count_basket_add() RETURNS TRIGGER AS
+$$
+BEGIN
+    INSERT INTO bazaar.product_basket_add (product_id, day, adds)
+    VALUES (NEW.product_id, current_date, 1)
+    ON CONFLICT (product_id, day) DO UPDATE SET adds = bazaar.product_basket_add.adds + 1;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_count_basket_add ON bazaar.basket_item;
+CREATE TRIGGER trg_count_basket_add
+    AFTER INSERT
+    ON bazaar.basket_item
+    FOR EACH ROW
+EXECUTE FUNCTION bazaar.count_basket_add();
+
+-- Позиции, которые уже лежат в корзинах
+INSERT INTO bazaar.product_basket_add (product_id, day, adds)
+SELECT bi.product_id, COALESCE(bi.updated_at, now())::date, COUNT(*)
+FROM bazaar.basket_item bi
+WHERE bi.product_id IS NOT NULL
+GROUP BY 1, 2
+ON CONFLICT (product_id, day) DO NOTHING;
+
+-- Дневные показатели товаров для аналитики продавца. Таблицу пересчитывает
+-- фоновая задача за последние дни; более старые дни не меняются.
+-- Выручка и проданные штуки учитывают и заказы, по которым оформлен возврат.
+CREATE TABLE IF NOT EXISTS bazaar.seller_product_daily
+(
+    product_id     UUID           NOT NULL REFERENCES bazaar.product (id) ON DELETE CASCADE,
+    day            DATE           NOT NULL,
+    seller_id      UUID           NOT NULL REFERENCES bazaar."user" (id) ON DELETE CASCADE,
+    revenue        NUMERIC(14, 2) NOT NULL DEFAULT 0,
+    units          INT            NOT NULL DEFAULT 0,
+    orders         INT            NOT NULL DEFAULT 0,
+    returned_units INT            NOT NULL DEFAULT 0,
+    basket_adds    INT            NOT NULL DEFAULT 0,
+    rating_sum     INT            NOT NULL DEFAULT 0,
+    rating_count   INT            NOT NULL DEFAULT 0,
+    stock_out      BOOLEAN        NOT NULL DEFAULT false,
+    PRIMARY KEY (product_id, day)
+);
+
+CREATE INDEX IF NOT EXISTS idx_seller_product_daily_seller
+    ON bazaar.seller_product_daily (seller_id, day);
+
+CREATE INDEX IF NOT EXISTS idx_order_created
+    ON bazaar."order" (created_at);
+
+CREATE INDEX IF NOT EXISTS idx_stock_movement_created
+    ON bazaar.stock_movement (product_id, created_at);
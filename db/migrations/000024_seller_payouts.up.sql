@@ -0,0 +1,62 @@
+-- Комиссия площадки в базисных пунктах (1000 = 10%). Значение задаётся на узле
+-- дерева категорий и наследуется потомками; NULL — взять комиссию ближайшего предка.
+ALTER TABLE bazaar.category
+    ADD COLUMN IF NOT EXISTS commission_bps INT CHECK (commission_bps BETWEEN 0 AND 10000);
+
+CREATE TYPE bazaar.earning_status AS ENUM (
+    'held', -- Заказ доставлен, идёт окно возврата
+    'available', -- Доступно к выплате
+    'settled', -- Включено в выписку
+    'reversed' -- Отменено возвратом
+    );
+
+CREATE TYPE bazaar.payout_status AS ENUM (
+    'pending', -- Ожидает выплаты
+    'paid' -- Выплачено
+    );
+
+-- Выписка к выплате продавцу за период
+CREATE TABLE IF NOT EXISTS bazaar.payout_statement
+(
+    id           UUID PRIMARY KEY,
+    seller_id    UUID                  NOT NULL REFERENCES bazaar."user" (id) ON DELETE RESTRICT,
+    period_start TIMESTAMPTZ           NOT NULL,
+    period_end   TIMESTAMPTZ           NOT NULL,
+    gross        NUMERIC(14, 2)        NOT NULL CHECK (gross >= 0),
+    commission   NUMERIC(14, 2)        NOT NULL CHECK (commission >= 0),
+    net          NUMERIC(14, 2)        NOT NULL CHECK (net >= 0),
+    status       bazaar.payout_status  NOT NULL DEFAULT 'pending',
+    paid_at      TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ           NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_payout_statement_seller
+    ON bazaar.payout_statement (seller_id, period_end DESC);
+
+-- Начисление продавцу за позицию доставленного заказа. Комиссия фиксируется
+-- в момент доставки, поэтому её последующее изменение не меняет начисленное.
+CREATE TABLE IF NOT EXISTS bazaar.seller_earning
+(
+    id             UUID PRIMARY KEY,
+    order_item_id  UUID                  NOT NULL UNIQUE REFERENCES bazaar.order_item (id) ON DELETE RESTRICT,
+    order_id       UUID                  NOT NULL REFERENCES bazaar."order" (id) ON DELETE RESTRICT,
+    product_id     UUID                  NOT NULL REFERENCES bazaar.product (id) ON DELETE RESTRICT,
+    seller_id      UUID                  NOT NULL REFERENCES bazaar."user" (id) ON DELETE RESTRICT,
+    quantity       INT                   NOT NULL CHECK (quantity > 0),
+    gross          NUMERIC(14, 2)        NOT NULL CHECK (gross >= 0),
+    commission_bps INT                   NOT NULL CHECK (commission_bps BETWEEN 0 AND 10000),
+    commission     NUMERIC(14, 2)        NOT NULL CHECK (commission >= 0),
+    net            NUMERIC(14, 2)        NOT NULL CHECK (net >= 0),
+    status         bazaar.earning_status NOT NULL DEFAULT 'held',
+    available_at   TIMESTAMPTZ           NOT NULL,
+    statement_id   UUID REFERENCES bazaar.payout_statement (id) ON DELETE RESTRICT,
+    created_at     TIMESTAMPTZ           NOT NULL DEFAULT now(),
+    CHECK (gross = commission + net)
+);
+
+CREATE INDEX IF NOT EXISTS idx_seller_earning_seller
+    ON bazaar.seller_earning (seller_id, status, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_seller_earning_held
+    ON bazaar.seller_earning (available_at) WHERE status = 'held';
+CREATE INDEX IF NOT EXISTS idx_seller_earning_statement
+    ON bazaar.seller_earning (statement_id);
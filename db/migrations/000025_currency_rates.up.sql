@@ -0,0 +1,18 @@
+-- Курсы валют для показа цен. Цены хранятся и списываются в рублях;
+-- курс — сколько рублей стоит единица валюты.
+CREATE TABLE IF NOT EXISTS bazaar.currency_rate
+(
+    currency   CHAR(3)        PRIMARY KEY CHECK (currency ~ '^[A-Z]{3}$' AND currency <> 'RUB'),
+    rate       NUMERIC(18, 6) NOT NULL CHECK (rate > 0),
+    updated_at TIMESTAMPTZ    NOT NULL DEFAULT now()
+);
+
+-- Валюта и курс, в которых покупатель видел заказ при оформлении. История
+-- заказов показывается по этому курсу, а не по текущему; NULL — рубли.
+ALTER TABLE bazaar."order"
+    ADD COLUMN IF NOT EXISTS display_currency CHAR(3)        NOT NULL DEFAULT 'RUB',
+    ADD COLUMN IF NOT EXISTS display_rate     NUMERIC(18, 6) CHECK (display_rate > 0);
+
+ALTER TABLE bazaar."order"
+    ADD CONSTRAINT order_display_rate_check
+        CHECK ((display_currency = 'RUB') = (display_rate IS NULL));
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
@@ -25,42 +26,76 @@ import (
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres"
 	addressrepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/address"
 	adminrepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/admin"
+	analyticsrepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/analytics"
+	attributerepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/attribute"
 	basketrepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/basket"
+	catalogrepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/catalog"
 	categoryrepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/category"
+	deliveryrepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/delivery"
+	inventoryrepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/inventory"
 	promorepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/promo"
 	orderrepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/order"
+	payoutrepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/payout"
+	currencyrepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/currency"
+	pickuprepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/pickup"
 	productrepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/product"
 	recrepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/recommendation"
+	reservationrepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/reservation"
 	searchrepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/search"
 	sellerrepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/seller"
 	suggestionrepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/suggestions"
+	warehouserepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/warehouse"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/address"
 	admint "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/admin"
+	analyticst "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/analytics"
+	attributet "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/attribute"
 	baskett "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/basket"
+	catalogt "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/catalog"
 	categoryt "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/category"
 	csatt "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/csat/http"
+	deliveryt "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/delivery"
+	inventoryt "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/inventory"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/jwt"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/order"
+	payoutt "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/payout"
+	currencyt "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/currency"
+	pickupt "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/pickup"
 	producttr "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/product"
 	promot "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/promo"
+	reservationt "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/reservation"
 	notificationt "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/notification"
 	notificationuc "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/notification"
 	motificationrepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/notification"
 	reviewt "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/review/http"
 	sellert "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/seller"
 	usert "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/user/http"
+	warehouset "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/warehouse"
 	addressus "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/address"
 	adminuc "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/admin"
+	analyticsuc "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/analytics"
 	promouc "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/promo"
+	attributeuc "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/attribute"
 	basketuc "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/basket"
+	cataloguc "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/catalog"
 	categoryuc "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/category"
+	deliveryuc "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/delivery"
+	inventoryuc "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/inventory"
 	orderus "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/order"
+	payoutuc "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/payout"
+	currencyuc "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/currency"
+	pickupuc "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/pickup"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/invoice"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/geocoder"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/pricing"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/product"
 	recus "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/recommendation"
+	reservationuc "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/reservation"
 	searchus "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/search"
 	selleruc "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/seller"
 	suggestionsus "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/suggestions"
+	warehouseuc "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/warehouse"
 	"github.com/gorilla/mux"
 	"github.com/sirupsen/logrus"
 	httpSwagger "github.com/swaggo/http-swagger"
@@ -72,6 +107,13 @@ type App struct {
 	logger *logrus.Logger
 	db     *sql.DB
 	router *mux.Router
+
+	recommendationUsecase *recus.RecommendationUsecase
+	reservationUsecase    *reservationuc.ReservationUsecase
+	catalogUsecase        *cataloguc.CatalogUsecase
+	inventoryUsecase      *inventoryuc.InventoryUsecase
+	analyticsUsecase      *analyticsuc.AnalyticsUsecase
+	payoutUsecase         *payoutuc.PayoutUsecase
 }
 
 func OptionsRequest(w http.ResponseWriter, r *http.Request) {
@@ -150,46 +192,74 @@ func NewApp(conf *config.Config) (*App, error) {
 	}
 	reviewClient := review.NewReviewServiceClient(reviewConn)
 
-	reviewHandler := reviewt.NewReviewHandler(reviewClient)
+	reviewHandler := reviewt.NewReviewHandler(reviewClient, minioClient)
 
 	// Инициализация репозиториев и use-case-ов.
 	tokenator := jwt.NewTokenator(conf.JWTConfig)
-	authHandler := http2.NewAuthHandler(authClient, conf)
+
+	// Адреса нормализуются геокодером; ответы Geoapify кэшируются в Redis
+	var addressGeocoder geocoder.IGeocoder = geocoder.NewStubGeocoder()
+	if conf.GeocoderConfig.Provider == config.GeocoderProviderGeoapify {
+		addressGeocoder = geocoder.NewCachedGeocoder(
+			geocoder.NewGeoapifyGeocoder(conf.GeoapifyConfig),
+			redis.NewGeocodeRepository(redisSearchClient, conf.GeocoderConfig.CacheTTL),
+		)
+	}
 
 	addressRepo := addressrepo.NewAddressRepository(db)
-	addressUsecase := addressus.NewAddressUsecase(addressRepo)
-	addressService := address.NewAddressHandler(addressUsecase, conf.GeoapifyConfig.APIKey)
+	addressUsecase := addressus.NewAddressUsecase(addressRepo, addressGeocoder)
+	addressService := address.NewAddressHandler(addressUsecase)
 
 	productRepo := productrepo.NewProductRepository(db)
 	productUsecase := product.NewProductUsecase(productRepo)
 	ProductService := producttr.NewProductService(productUsecase, minioClient)
 
+	promoRepo := promorepo.NewPromoRepository(db)
+	orderRepo := orderrepo.NewOrderRepository(db)
+	pricingEngine := pricing.NewEngine(orderRepo, promoRepo, conf.DeliveryConfig)
+
 	basketRepo := basketrepo.NewBasketRepository(db)
-	basketUsecase := basketuc.NewBasketUsecase(basketRepo)
+	basketUsecase := basketuc.NewBasketUsecase(basketRepo, pricingEngine)
 	basketService := baskett.NewBasketService(basketUsecase)
 
+	// Гостевая корзина хранится в Redis и переносится в корзину пользователя при входе
+	guestBasketRepo := redis.NewGuestBasketRepository(redisSearchClient, basketRepo, conf.GuestBasketConfig.TTL)
+	guestBasketUsecase := basketuc.NewBasketUsecase(guestBasketRepo, pricingEngine)
+	guestBasketService := baskett.NewBasketService(guestBasketUsecase)
+	guestBasketMerger := basketuc.NewGuestBasketMerger(guestBasketRepo, basketRepo, pricingEngine)
+
+	authHandler := http2.NewAuthHandler(authClient, conf, tokenator, guestBasketMerger)
+
 	categoryRepo := categoryrepo.NewCategoryRepository(db)
 	categoryUsecase := categoryuc.NewCategoryUsecase(categoryRepo)
-	categoryService := categoryt.NewCategoryService(categoryUsecase)
+	categoryService := categoryt.NewCategoryService(categoryUsecase, minioClient)
+
+	attributeRepo := attributerepo.NewAttributeRepository(db)
+	attributeUsecase := attributeuc.NewAttributeUsecase(attributeRepo)
+	attributeService := attributet.NewAttributeService(attributeUsecase)
 
 	suggestionsRepo := suggestionrepo.NewSuggestionsRepository(db)
 	suggestionsUsecase := suggestionsus.NewSuggestionsUsecase(suggestionsRepo, redisSearchRepo)
 	suggestionsService := suggestions.NewSuggestionsService(suggestionsUsecase)
 
 	adminRepo := adminrepo.NewAdminRepository(db)
-	adminUsecase := adminuc.NewAdminUsecase(adminRepo, redisSearchRepo, productRepo)
+	reviewSummaryCache := redis.NewReviewSummaryRepository(redisSearchClient, conf.ReviewConfig.SummaryCacheTTL)
+	adminUsecase := adminuc.NewAdminUsecase(adminRepo, redisSearchRepo, productRepo, reviewSummaryCache)
 	adminService := admint.NewAdminService(adminUsecase)
 
 	sellerRepo := sellerrepo.NewSellerRepository(db)
-	sellerUsecase := selleruc.NewSellerUsecase(sellerRepo)
+	sellerUsecase := selleruc.NewSellerUsecase(sellerRepo, attributeRepo)
 	sellerService := sellert.NewSellerHandler(sellerUsecase, minioClient)
 
+	catalogRepo := catalogrepo.NewCatalogRepository(db)
+	catalogUsecase := cataloguc.NewCatalogUsecase(catalogRepo, attributeRepo, minioClient, conf.CatalogImportConfig)
+	catalogService := catalogt.NewCatalogService(catalogUsecase, conf.CatalogImportConfig)
+
 	searchRepo := searchrepo.NewSearchRepository(db)
 	searchUsecase := searchus.NewSearchUsecase(searchRepo)
 	searchService := search.NewSearchService(searchUsecase, suggestionsUsecase)
 
 
-	promoRepo := promorepo.NewPromoRepository(db)
 	promoUsecase := promouc.NewPromoUsecase(promoRepo)
 	promoService := promot.NewPromoService(promoUsecase)
 
@@ -197,12 +267,50 @@ func NewApp(conf *config.Config) (*App, error) {
 	notificationUsecase := notificationuc.NewNotificationUsecase(notificationRepo)
 	notificationService := notificationt.NewNotificationService(notificationUsecase)
 
-	orderRepo := orderrepo.NewOrderRepository(db)
-	orderUsecase := orderus.NewOrderUsecase(orderRepo, promoRepo, notificationRepo)
+	inventoryRepo := inventoryrepo.NewInventoryRepository(db)
+	inventoryUsecase := inventoryuc.NewInventoryUsecase(inventoryRepo, notificationRepo, conf.InventoryConfig)
+	inventoryService := inventoryt.NewInventoryService(inventoryUsecase)
+
+	analyticsRepo := analyticsrepo.NewAnalyticsRepository(db)
+	analyticsUsecase := analyticsuc.NewAnalyticsUsecase(analyticsRepo, conf.AnalyticsConfig)
+	analyticsService := analyticst.NewAnalyticsService(analyticsUsecase)
+
+	payoutRepo := payoutrepo.NewPayoutRepository(db)
+	payoutUsecase := payoutuc.NewPayoutUsecase(payoutRepo, conf.PayoutConfig)
+	payoutService := payoutt.NewPayoutService(payoutUsecase)
+
+	currencyRepo := currencyrepo.NewCurrencyRepository(db)
+	currencyUsecase := currencyuc.NewCurrencyUsecase(currencyRepo, conf.CurrencyConfig)
+	currencyService := currencyt.NewCurrencyService(currencyUsecase)
+
+	pickupRepo := pickuprepo.NewPickupRepository(db)
+	pickupUsecase := pickupuc.NewPickupUsecase(pickupRepo)
+	pickupService := pickupt.NewPickupService(pickupUsecase)
+
+	deliveryRepo := deliveryrepo.NewDeliveryRepository(db)
+	deliveryUsecase := deliveryuc.NewDeliveryUsecase(deliveryRepo, conf.DeliveryConfig)
+	deliveryService := deliveryt.NewDeliveryService(deliveryUsecase)
+
+	warehouseRepo := warehouserepo.NewWarehouseRepository(db)
+	warehouseUsecase := warehouseuc.NewWarehouseUsecase(warehouseRepo)
+	warehouseService := warehouset.NewWarehouseService(warehouseUsecase)
+
+	invoiceGenerator := invoice.NewGenerator(conf.InvoiceConfig)
+	orderUsecase := orderus.NewOrderUsecase(orderRepo, pricingEngine, notificationRepo, invoiceGenerator, deliveryUsecase, warehouseUsecase, payoutUsecase)
 	orderService := order.NewOrderService(orderUsecase)
 
+	reservationRepo := reservationrepo.NewReservationRepository(db)
+	reservationUsecase := reservationuc.NewReservationUsecase(reservationRepo, conf.ReservationConfig)
+	reservationService := reservationt.NewReservationService(reservationUsecase)
+
 	recommendationRepo := recrepo.NewRecommendationRepository(db)
-	recommendationUsecase := recus.NewRecommendationUsecase(productUsecase, recommendationRepo)
+	recommendationCache := redis.NewRecommendationRepository(redisSearchClient, conf.RecommendationConfig.CacheTTL)
+	recommendationUsecase := recus.NewRecommendationUsecase(
+		productUsecase,
+		recommendationRepo,
+		recommendationCache,
+		conf.RecommendationConfig,
+	)
 	recommendationServise := recommendation.NewRecommendationService(recommendationUsecase)
 
 
@@ -224,6 +332,10 @@ func NewApp(conf *config.Config) (*App, error) {
 	metricsMw.Register(middleware.ServiceMainName)
 	apiRouter.PathPrefix("/metrics").Handler(promhttp.Handler())
 	apiRouter.Use(metricsMw.LogMetrics)
+	// Курс валюты покупателя нужен всем ответам с ценами
+	apiRouter.Use(func(next http.Handler) http.Handler {
+		return middleware.CurrencyMiddleware(currencyUsecase, next)
+	})
 
 	// Маршруты для продуктов.
 	productsRouter := apiRouter.PathPrefix("").Subrouter()
@@ -235,6 +347,7 @@ func NewApp(conf *config.Config) (*App, error) {
 			)).Methods(http.MethodPost)
 		productsRouter.HandleFunc("/products/{offset}", ProductService.GetAllProducts).Methods(http.MethodGet)
 		productsRouter.HandleFunc("/product/{id}", ProductService.GetProductByID).Methods(http.MethodGet)
+		productsRouter.HandleFunc("/product/{id}/attributes", attributeService.GetProductAttributes).Methods(http.MethodGet)
 		productsRouter.HandleFunc("/products/category/{id}/{offset}", ProductService.GetProductsByCategory).Methods(http.MethodGet)
 
 		productsRouter.Handle("/add",
@@ -246,6 +359,10 @@ func NewApp(conf *config.Config) (*App, error) {
 	catalogRouter := apiRouter.PathPrefix("/categories").Subrouter()
 	{
 		catalogRouter.HandleFunc("", categoryService.GetAllCategories).Methods(http.MethodGet)
+		catalogRouter.HandleFunc("/tree", categoryService.GetCategoryTree).Methods(http.MethodGet)
+		catalogRouter.HandleFunc("/slug/{slug}", categoryService.GetCategoryBySlug).Methods(http.MethodGet)
+		catalogRouter.HandleFunc("/{id}/breadcrumbs", categoryService.GetBreadcrumbs).Methods(http.MethodGet)
+		catalogRouter.HandleFunc("/{id}/attributes", attributeService.GetCategoryAttributes).Methods(http.MethodGet)
 		catalogRouter.HandleFunc("/{id}", categoryService.GetAllSubcategories).Methods(http.MethodGet)
 	}
 
@@ -264,37 +381,43 @@ func NewApp(conf *config.Config) (*App, error) {
 		searchRouter.HandleFunc("/sort/{offset}", searchService.SearchWithFilterAndSort).Methods(http.MethodPost)
 	}
 
+	// Авторизованные пользователи работают с корзиной в БД, анонимные — с гостевой
+	basketHandler := func(user, guest http.HandlerFunc) http.Handler {
+		return middleware.GuestBasketMiddleware(conf.GuestBasketConfig,
+			middleware.TokenValidator(authClient, tokenator),
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator, user),
+				conf.CSRFConfig,
+			),
+			guest,
+		)
+	}
+
 	basketRouter := apiRouter.PathPrefix("/basket").Subrouter()
 	{
-		basketRouter.Handle("", middleware.JWTMiddleware(
-			authClient,
-			tokenator,
-			http.HandlerFunc(basketService.Get)),
+		basketRouter.Handle("",
+			basketHandler(basketService.Get, guestBasketService.Get),
 		).Methods(http.MethodGet)
 
+		basketRouter.Handle("/quote",
+			basketHandler(basketService.Quote, guestBasketService.Quote),
+		).Methods(http.MethodPost)
+
 		basketRouter.Handle("/{id}",
-			middleware.CSRFMiddleware(tokenator,
-				middleware.JWTMiddleware(authClient, tokenator, http.HandlerFunc(basketService.Add)),
-				conf.CSRFConfig,
-			)).Methods(http.MethodPost)
+			basketHandler(basketService.Add, guestBasketService.Add),
+		).Methods(http.MethodPost)
 
 		basketRouter.Handle("/{id}",
-			middleware.CSRFMiddleware(tokenator,
-				middleware.JWTMiddleware(authClient, tokenator, http.HandlerFunc(basketService.Delete)),
-				conf.CSRFConfig,
-			)).Methods(http.MethodDelete)
+			basketHandler(basketService.Delete, guestBasketService.Delete),
+		).Methods(http.MethodDelete)
 
 		basketRouter.Handle("/{id}",
-			middleware.CSRFMiddleware(tokenator,
-				middleware.JWTMiddleware(authClient, tokenator, http.HandlerFunc(basketService.UpdateQuantity)),
-				conf.CSRFConfig,
-			)).Methods(http.MethodPatch)
+			basketHandler(basketService.UpdateQuantity, guestBasketService.UpdateQuantity),
+		).Methods(http.MethodPatch)
 
 		basketRouter.Handle("",
-			middleware.CSRFMiddleware(tokenator,
-				middleware.JWTMiddleware(authClient, tokenator, http.HandlerFunc(basketService.Clear)),
-				conf.CSRFConfig,
-			)).Methods(http.MethodDelete)
+			basketHandler(basketService.Clear, guestBasketService.Clear),
+		).Methods(http.MethodDelete)
 	}
 
 	productCoverRouter := apiRouter.PathPrefix("/cover").Subrouter()
@@ -353,6 +476,11 @@ func NewApp(conf *config.Config) (*App, error) {
 
 	orderRouter := apiRouter.PathPrefix("/orders").Subrouter()
 	{
+		orderRouter.Handle("/checkout",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator, http.HandlerFunc(reservationService.Hold)),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPost)
 		orderRouter.Handle("",
 			middleware.CSRFMiddleware(tokenator,
 				middleware.JWTMiddleware(authClient, tokenator, http.HandlerFunc(orderService.CreateOrder)),
@@ -363,6 +491,50 @@ func NewApp(conf *config.Config) (*App, error) {
 			tokenator,
 			http.HandlerFunc(orderService.GetOrders),
 		)).Methods(http.MethodGet)
+		orderRouter.Handle("/{id}", middleware.JWTMiddleware(
+			authClient,
+			tokenator,
+			http.HandlerFunc(orderService.GetOrder),
+		)).Methods(http.MethodGet)
+		orderRouter.Handle("/{id}/invoice.pdf", middleware.JWTMiddleware(
+			authClient,
+			tokenator,
+			http.HandlerFunc(orderService.GetInvoice),
+		)).Methods(http.MethodGet)
+	}
+
+	deliveryRouter := apiRouter.PathPrefix("/delivery").Subrouter()
+	{
+		deliveryRouter.Handle("/options", middleware.JWTMiddleware(
+			authClient,
+			tokenator,
+			http.HandlerFunc(deliveryService.GetOptions),
+		)).Methods(http.MethodGet)
+	}
+
+	currencyRouter := apiRouter.PathPrefix("/currencies").Subrouter()
+	{
+		currencyRouter.HandleFunc("", currencyService.GetRates).Methods(http.MethodGet)
+	}
+
+	pickupRouter := apiRouter.PathPrefix("/pickup-points").Subrouter()
+	{
+		pickupRouter.HandleFunc("/nearest", pickupService.GetNearest).Methods(http.MethodGet)
+		pickupRouter.Handle("/favorites", middleware.JWTMiddleware(
+			authClient,
+			tokenator,
+			http.HandlerFunc(pickupService.GetFavorites),
+		)).Methods(http.MethodGet)
+		pickupRouter.Handle("/{id}/favorite",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator, http.HandlerFunc(pickupService.AddFavorite)),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPost)
+		pickupRouter.Handle("/{id}/favorite",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator, http.HandlerFunc(pickupService.RemoveFavorite)),
+				conf.CSRFConfig,
+			)).Methods(http.MethodDelete)
 	}
 
 	addressRouter := apiRouter.PathPrefix("/addresses").Subrouter()
@@ -410,12 +582,47 @@ func NewApp(conf *config.Config) (*App, error) {
 	reviewRouter := apiRouter.PathPrefix("/review").Subrouter()
 	{
 		reviewRouter.HandleFunc("", reviewHandler.Get).Methods(http.MethodPost)
+		reviewRouter.HandleFunc("/summary/{id}", reviewHandler.Summary).Methods(http.MethodGet)
 
 		reviewRouter.Handle("/add",
 			middleware.CSRFMiddleware(tokenator,
 				middleware.JWTMiddleware(authClient, tokenator, http.HandlerFunc(reviewHandler.Add)),
 				conf.CSRFConfig,
 			)).Methods(http.MethodPost)
+
+		reviewRouter.Handle("/{id}",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator, http.HandlerFunc(reviewHandler.Update)),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPut)
+
+		reviewRouter.Handle("/{id}",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator, http.HandlerFunc(reviewHandler.Delete)),
+				conf.CSRFConfig,
+			)).Methods(http.MethodDelete)
+
+		reviewRouter.Handle("/{id}/photo",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator, http.HandlerFunc(reviewHandler.UploadPhoto)),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPost)
+
+		reviewRouter.Handle("/{id}/vote",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator, http.HandlerFunc(reviewHandler.Vote)),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPost)
+
+		reviewRouter.Handle("/{id}/reply",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("seller")(
+						http.HandlerFunc(reviewHandler.Reply),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPost)
 	}
 
 	notificationRouter := apiRouter.PathPrefix("/notification").Subrouter()
@@ -472,6 +679,254 @@ func NewApp(conf *config.Config) (*App, error) {
 				),
 				conf.CSRFConfig,
 			)).Methods(http.MethodPost)
+
+		adminRouter.Handle("/reviews/{offset}",
+			middleware.JWTMiddleware(authClient, tokenator,
+				middleware.RoleMiddleware("admin")(
+					http.HandlerFunc(adminService.GetPendingReviews),
+				),
+			),
+		).Methods(http.MethodGet)
+
+		adminRouter.Handle("/review/update",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("admin")(
+						http.HandlerFunc(adminService.UpdateReviewStatus),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPost)
+	}
+
+	adminCategoryRouter := adminRouter.PathPrefix("/categories").Subrouter()
+	{
+		adminCategoryRouter.Handle("",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("admin")(
+						http.HandlerFunc(categoryService.CreateCategory),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPost)
+
+		adminCategoryRouter.Handle("/{id}",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("admin")(
+						http.HandlerFunc(categoryService.UpdateCategory),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPut)
+
+		adminCategoryRouter.Handle("/{id}",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("admin")(
+						http.HandlerFunc(categoryService.DeleteCategory),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodDelete)
+
+		adminCategoryRouter.Handle("/{id}/move",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("admin")(
+						http.HandlerFunc(categoryService.MoveCategory),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPost)
+
+		adminCategoryRouter.Handle("/{id}/image",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("admin")(
+						http.HandlerFunc(categoryService.UploadCategoryImage),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPost)
+
+		adminCategoryRouter.Handle("/{id}/attributes",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("admin")(
+						http.HandlerFunc(attributeService.CreateAttribute),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPost)
+
+		adminCategoryRouter.Handle("/{id}/commission",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("admin")(
+						http.HandlerFunc(payoutService.SetCategoryCommission),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPut)
+	}
+
+	adminAttributeRouter := adminRouter.PathPrefix("/attributes").Subrouter()
+	{
+		adminAttributeRouter.Handle("/{id}",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("admin")(
+						http.HandlerFunc(attributeService.UpdateAttribute),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPut)
+
+		adminAttributeRouter.Handle("/{id}",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("admin")(
+						http.HandlerFunc(attributeService.DeleteAttribute),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodDelete)
+	}
+
+	adminPickupRouter := adminRouter.PathPrefix("/pickup-points").Subrouter()
+	{
+		adminPickupRouter.Handle("",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("admin")(
+						http.HandlerFunc(pickupService.CreatePickupPoint),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPost)
+
+		adminPickupRouter.Handle("/{id}",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("admin")(
+						http.HandlerFunc(pickupService.UpdatePickupPoint),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPut)
+
+		adminPickupRouter.Handle("/{id}",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("admin")(
+						http.HandlerFunc(pickupService.DeletePickupPoint),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodDelete)
+	}
+
+	adminWarehouseRouter := adminRouter.PathPrefix("/warehouses").Subrouter()
+	{
+		adminWarehouseRouter.Handle("",
+			middleware.JWTMiddleware(authClient, tokenator,
+				middleware.RoleMiddleware("admin")(
+					http.HandlerFunc(warehouseService.GetWarehouses),
+				),
+			)).Methods(http.MethodGet)
+
+		adminWarehouseRouter.Handle("",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("admin")(
+						http.HandlerFunc(warehouseService.CreateWarehouse),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPost)
+
+		adminWarehouseRouter.Handle("/staff/{id}",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("admin")(
+						http.HandlerFunc(warehouseService.AssignStaff),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPut)
+	}
+
+	adminOrderRouter := adminRouter.PathPrefix("/orders").Subrouter()
+	{
+		adminOrderRouter.Handle("/{id}/warehouse",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("admin")(
+						http.HandlerFunc(warehouseService.RouteOrder),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPost)
+	}
+
+	adminPayoutRouter := adminRouter.PathPrefix("/payouts").Subrouter()
+	{
+		adminPayoutRouter.Handle("/{id}/paid",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("admin")(
+						http.HandlerFunc(payoutService.MarkStatementPaid),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPost)
+	}
+
+	adminCurrencyRouter := adminRouter.PathPrefix("/currencies").Subrouter()
+	{
+		adminCurrencyRouter.Handle("/import",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("admin")(
+						http.HandlerFunc(currencyService.ImportRates),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPost)
+		adminCurrencyRouter.Handle("/{code}",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("admin")(
+						http.HandlerFunc(currencyService.SetRate),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPut)
+	}
+
+	adminDeliveryRouter := adminRouter.PathPrefix("/delivery").Subrouter()
+	{
+		adminDeliveryRouter.Handle("/zones",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("admin")(
+						http.HandlerFunc(deliveryService.CreateZone),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPost)
+
+		adminDeliveryRouter.Handle("/slots",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("admin")(
+						http.HandlerFunc(deliveryService.CreateSlot),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPost)
 	}
 
 	promoRouter := apiRouter.PathPrefix("/promo").Subrouter()
@@ -486,6 +941,40 @@ func NewApp(conf *config.Config) (*App, error) {
 				conf.CSRFConfig,
 			)).Methods(http.MethodPost)
 
+		promoRouter.Handle("/campaigns",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("admin")(
+						http.HandlerFunc(promoService.CreateCampaign),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPost)
+
+		promoRouter.Handle("/campaigns/{id}/codes",
+			middleware.JWTMiddleware(authClient, tokenator,
+				middleware.RoleMiddleware("admin")(
+					http.HandlerFunc(promoService.ExportCampaignCodes),
+				),
+			)).Methods(http.MethodGet)
+
+		promoRouter.Handle("/campaigns/{id}/stats",
+			middleware.JWTMiddleware(authClient, tokenator,
+				middleware.RoleMiddleware("admin")(
+					http.HandlerFunc(promoService.GetCampaignStats),
+				),
+			)).Methods(http.MethodGet)
+
+		promoRouter.Handle("/campaigns/{id}/deactivate",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("admin")(
+						http.HandlerFunc(promoService.DeactivateCampaign),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPost)
+
 		promoRouter.Handle("/{offset}",
 				middleware.JWTMiddleware(authClient, tokenator,
 					middleware.RoleMiddleware("admin")(
@@ -520,6 +1009,30 @@ func NewApp(conf *config.Config) (*App, error) {
 				),
 				conf.CSRFConfig,
 			)).Methods(http.MethodPost)
+
+		warehouseRouter.Handle("/pick-list",
+			middleware.JWTMiddleware(authClient, tokenator,
+				middleware.RoleMiddleware("warehouseman")(
+					http.HandlerFunc(warehouseService.GetPickList),
+				),
+			)).Methods(http.MethodGet)
+
+		warehouseRouter.Handle("/stock",
+			middleware.JWTMiddleware(authClient, tokenator,
+				middleware.RoleMiddleware("warehouseman")(
+					http.HandlerFunc(warehouseService.GetStock),
+				),
+			)).Methods(http.MethodGet)
+
+		warehouseRouter.Handle("/stock",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("warehouseman")(
+						http.HandlerFunc(warehouseService.SetStock),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPut)
 	}
 
 	sellerRouter := apiRouter.PathPrefix("/seller").Subrouter()
@@ -544,6 +1057,36 @@ func NewApp(conf *config.Config) (*App, error) {
 				conf.CSRFConfig,
 			)).Methods(http.MethodPost)
 
+		sellerRouter.Handle("/products/{id}/variants",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("seller")(
+						http.HandlerFunc(sellerService.AddVariant),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPost)
+
+		sellerRouter.Handle("/variants/{id}",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("seller")(
+						http.HandlerFunc(sellerService.UpdateVariant),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPut)
+
+		sellerRouter.Handle("/variants/{id}",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("seller")(
+						http.HandlerFunc(sellerService.DeleteVariant),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodDelete)
+
 		sellerRouter.Handle("/products/{offset}",
 			middleware.JWTMiddleware(authClient, tokenator,
 				middleware.RoleMiddleware("seller")(
@@ -551,10 +1094,159 @@ func NewApp(conf *config.Config) (*App, error) {
 				),
 			),
 		).Methods(http.MethodGet)
+
+		sellerRouter.Handle("/catalog/imports",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("seller")(
+						http.HandlerFunc(catalogService.StartImport),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPost)
+
+		sellerRouter.Handle("/catalog/imports/{id}",
+			middleware.JWTMiddleware(authClient, tokenator,
+				middleware.RoleMiddleware("seller")(
+					http.HandlerFunc(catalogService.GetImport),
+				),
+			),
+		).Methods(http.MethodGet)
+
+		sellerRouter.Handle("/catalog/imports/{id}/report",
+			middleware.JWTMiddleware(authClient, tokenator,
+				middleware.RoleMiddleware("seller")(
+					http.HandlerFunc(catalogService.GetImportReport),
+				),
+			),
+		).Methods(http.MethodGet)
+
+		sellerRouter.Handle("/catalog/export",
+			middleware.JWTMiddleware(authClient, tokenator,
+				middleware.RoleMiddleware("seller")(
+					http.HandlerFunc(catalogService.Export),
+				),
+			),
+		).Methods(http.MethodGet)
+
+		sellerRouter.Handle("/products/{id}/stock/adjustments",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("seller")(
+						http.HandlerFunc(inventoryService.AdjustStock),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPost)
+
+		sellerRouter.Handle("/products/{id}/stock/movements",
+			middleware.JWTMiddleware(authClient, tokenator,
+				middleware.RoleMiddleware("seller")(
+					http.HandlerFunc(inventoryService.GetMovements),
+				),
+			),
+		).Methods(http.MethodGet)
+
+		sellerRouter.Handle("/products/{id}/low-stock-threshold",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("seller")(
+						http.HandlerFunc(inventoryService.SetLowStockThreshold),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPut)
+
+		sellerRouter.Handle("/stock/reconciliation",
+			middleware.JWTMiddleware(authClient, tokenator,
+				middleware.RoleMiddleware("seller")(
+					http.HandlerFunc(inventoryService.GetDiscrepancies),
+				),
+			),
+		).Methods(http.MethodGet)
+
+		sellerRouter.Handle("/analytics/summary",
+			middleware.JWTMiddleware(authClient, tokenator,
+				middleware.RoleMiddleware("seller")(
+					http.HandlerFunc(analyticsService.GetSummary),
+				),
+			),
+		).Methods(http.MethodGet)
+
+		sellerRouter.Handle("/analytics/top-products",
+			middleware.JWTMiddleware(authClient, tokenator,
+				middleware.RoleMiddleware("seller")(
+					http.HandlerFunc(analyticsService.GetTopProducts),
+				),
+			),
+		).Methods(http.MethodGet)
+
+		sellerRouter.Handle("/analytics/stock-outs",
+			middleware.JWTMiddleware(authClient, tokenator,
+				middleware.RoleMiddleware("seller")(
+					http.HandlerFunc(analyticsService.GetStockOuts),
+				),
+			),
+		).Methods(http.MethodGet)
+
+		sellerRouter.Handle("/balance",
+			middleware.JWTMiddleware(authClient, tokenator,
+				middleware.RoleMiddleware("seller")(
+					http.HandlerFunc(payoutService.GetBalance),
+				),
+			),
+		).Methods(http.MethodGet)
+
+		sellerRouter.Handle("/payouts",
+			middleware.JWTMiddleware(authClient, tokenator,
+				middleware.RoleMiddleware("seller")(
+					http.HandlerFunc(payoutService.GetStatements),
+				),
+			),
+		).Methods(http.MethodGet)
+
+		sellerRouter.Handle("/payouts/{id}",
+			middleware.JWTMiddleware(authClient, tokenator,
+				middleware.RoleMiddleware("seller")(
+					http.HandlerFunc(payoutService.GetStatement),
+				),
+			),
+		).Methods(http.MethodGet)
+
+		sellerRouter.Handle("/orders/{offset}",
+			middleware.JWTMiddleware(authClient, tokenator,
+				middleware.RoleMiddleware("seller")(
+					http.HandlerFunc(orderService.GetSellerOrders),
+				),
+			),
+		).Methods(http.MethodGet)
+
+		sellerRouter.Handle("/orders/{id}/confirm",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("seller")(
+						http.HandlerFunc(orderService.ConfirmShipment),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPost)
+
+		sellerRouter.Handle("/orders/{id}/cancel",
+			middleware.CSRFMiddleware(tokenator,
+				middleware.JWTMiddleware(authClient, tokenator,
+					middleware.RoleMiddleware("seller")(
+						http.HandlerFunc(orderService.CancelShipment),
+					),
+				),
+				conf.CSRFConfig,
+			)).Methods(http.MethodPost)
 	}
 
 	recommendationRouter := apiRouter.PathPrefix("/recommendation").Subrouter()
 	{
+		recommendationRouter.Handle("/me",
+			middleware.JWTMiddleware(authClient, tokenator, http.HandlerFunc(recommendationServise.GetPersonalRecommendations)),
+		).Methods(http.MethodGet)
 		recommendationRouter.HandleFunc("/{id}", recommendationServise.GetRecommendations).Methods(http.MethodGet)
 	}
 
@@ -563,6 +1255,13 @@ func NewApp(conf *config.Config) (*App, error) {
 		logger: logger,
 		db:     db,
 		router: router,
+
+		recommendationUsecase: recommendationUsecase,
+		reservationUsecase:    reservationUsecase,
+		catalogUsecase:        catalogUsecase,
+		inventoryUsecase:      inventoryUsecase,
+		analyticsUsecase:      analyticsUsecase,
+		payoutUsecase:         payoutUsecase,
 	}
 
 	return app, nil
@@ -570,6 +1269,19 @@ func NewApp(conf *config.Config) (*App, error) {
 
 // Run запускает HTTP-сервер.
 func (a *App) Run() {
+	// Фоновый пересчёт похожести товаров для рекомендаций
+	refresherCtx := logctx.WithLogger(context.Background(), logrus.NewEntry(a.logger))
+	go a.recommendationUsecase.RunRefresher(refresherCtx)
+	// Возврат в остаток просроченных резервов оформления заказа
+	go a.reservationUsecase.RunSweeper(refresherCtx)
+	// Обработка очереди импорта каталогов продавцов
+	go a.catalogUsecase.RunWorker(refresherCtx)
+	// Уведомления продавцам о низком остатке товаров
+	go a.inventoryUsecase.RunAlerts(refresherCtx)
+	// Дневные показатели для аналитики продавцов
+	go a.analyticsUsecase.RunRollup(refresherCtx)
+	// Начисления продавцам, снятие холда и выписки к выплате
+	go a.payoutUsecase.RunSettlement(refresherCtx)
 
 	server := &http.Server{
 		Handler:      a.router,
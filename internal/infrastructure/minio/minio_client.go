@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"io"
 
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
 	"github.com/google/uuid"
@@ -17,9 +18,9 @@ import (
 type Provider interface {
 	CreateOne(context.Context, FileData) (*dto.UploadResponse, error)
 	// CreateMany(context.Context, map[string]FileData) ([]string, error)
-	// GetOne(context.Context, string) ([]byte, error)
+	GetOne(context.Context, string) ([]byte, error)
 	// GetMany(context.Context, []string) ([]string, error)
-	// DeleteOne(context.Context, string) error
+	DeleteOne(context.Context, string) error
 	// DeleteMany(context.Context, []string) error
 }
 
@@ -106,7 +107,7 @@ func (m *minioProvider) CreateOne(ctx context.Context, file FileData) (*dto.Uplo
 		objectID,
 		reader,
 		int64(len(file.Data)),
-		minio.PutObjectOptions{ContentType: "image/jpeg"},
+		minio.PutObjectOptions{ContentType: file.contentType()},
 	)
 	if err != nil {
 		m.log.WithFields(logFields).WithError(err).Error("failed to upload file to MinIO")
@@ -214,36 +215,29 @@ func (m *minioProvider) CreateOne(ctx context.Context, file FileData) (*dto.Uplo
 
 // GetOne получает один объект из бакета Minio по его идентификатору.
 // Он принимает строку `objectID` в качестве параметра и возвращает срез байт данных объекта и ошибку, если такая возникает.
-// func (m *minioProvider) GetOne(ctx context.Context, objectID string) ([]byte, error) {
-// 	logFields := logrus.Fields{
-// 		"object_id": objectID,
-// 	}
+func (m *minioProvider) GetOne(ctx context.Context, objectID string) ([]byte, error) {
+	logFields := logrus.Fields{
+		"object_id": objectID,
+	}
 
-// 	m.log.WithFields(logFields).Debug("attempting to get file URL from MinIO")
+	m.log.WithFields(logFields).Debug("attempting to get file from MinIO")
 
-// 	reader, err := m.mc.GetObject(ctx, m.config.BucketName, objectID, minio.GetObjectOptions{})
-//     if err != nil {
-//         m.log.WithFields(logFields).WithError(err).Error("failed to get object from MinIO")
-//         return nil, fmt.Errorf("failed to get object: %v", err)
-//     }
-// 	defer reader.Close()
-// 	if err != nil {
-// 		m.log.Fatal(err)
-// 	}
+	reader, err := m.mc.GetObject(ctx, m.config.BucketName, objectID, minio.GetObjectOptions{})
+	if err != nil {
+		m.log.WithFields(logFields).WithError(err).Error("failed to get object from MinIO")
+		return nil, fmt.Errorf("failed to get object: %v", err)
+	}
+	defer reader.Close()
 
-// 	data, err := io.ReadAll(reader)
-//     if err != nil {
-//         m.log.WithFields(logFields).WithError(err).Error("failed to read object data")
-//         return nil, fmt.Errorf("failed to read object data: %v", err)
-//     }
-// 	if len(data) == 0 {
-//         m.log.WithFields(logFields).Warn("object data is empty")
-//         return nil, fmt.Errorf("object data is empty")
-//     }
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		m.log.WithFields(logFields).WithError(err).Error("failed to read object data")
+		return nil, fmt.Errorf("failed to read object data: %v", err)
+	}
 
-//     m.log.WithFields(logFields).Debug("successfully retrieved file data")
-// 	return data, nil
-// }
+	m.log.WithFields(logFields).Debug("successfully retrieved file data")
+	return data, nil
+}
 
 // GetMany получает несколько объектов из бакета Minio по их идентификаторам.
 // func (m *minioProvider) GetMany(ctx context.Context, objectIDs []string) ([]string, error) {
@@ -306,23 +300,23 @@ func (m *minioProvider) CreateOne(ctx context.Context, file FileData) (*dto.Uplo
 // }
 
 // DeleteOne удаляет один объект из бакета Minio по его идентификатору.
-// func (m *minioProvider) DeleteOne(ctx context.Context, objectID string) error {
-// 	logFields := logrus.Fields{
-// 		"object_id": objectID,
-// 	}
+func (m *minioProvider) DeleteOne(ctx context.Context, objectID string) error {
+	logFields := logrus.Fields{
+		"object_id": objectID,
+	}
 
-// 	m.log.WithFields(logFields).Debug("attempting to delete file from MinIO")
+	m.log.WithFields(logFields).Debug("attempting to delete file from MinIO")
 
-// 	// Удаление объекта из бакета Minio.
-// 	if err := m.mc.RemoveObject(ctx, m.config.BucketName, objectID, minio.RemoveObjectOptions{}); err != nil {
-// 		err = fmt.Errorf("failed to delete object %s: %w", objectID, err)
-// 		m.log.WithFields(logFields).WithError(err).Error("failed to delete file")
-// 		return err
-// 	}
+	// Удаление объекта из бакета Minio.
+	if err := m.mc.RemoveObject(ctx, m.config.BucketName, objectID, minio.RemoveObjectOptions{}); err != nil {
+		err = fmt.Errorf("failed to delete object %s: %w", objectID, err)
+		m.log.WithFields(logFields).WithError(err).Error("failed to delete file")
+		return err
+	}
 
-// 	m.log.WithFields(logFields).Info("successfully deleted file")
-// 	return nil
-// }
+	m.log.WithFields(logFields).Info("successfully deleted file")
+	return nil
+}
 
 // // DeleteMany удаляет несколько объектов из бакета Minio по их идентификаторам с использованием горутин.
 // func (m *minioProvider) DeleteMany(ctx context.Context, objectIDs []string) error {
@@ -378,6 +372,15 @@ func (m *minioProvider) CreateOne(ctx context.Context, file FileData) (*dto.Uplo
 type FileData struct {
 	Name string
 	Data []byte
+	// ContentType — MIME-тип объекта; по умолчанию image/jpeg
+	ContentType string
+}
+
+func (f FileData) contentType() string {
+	if f.ContentType == "" {
+		return "image/jpeg"
+	}
+	return f.ContentType
 }
 
 type OperationError struct {
@@ -3,9 +3,11 @@ package admin
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
 	"github.com/google/uuid"
 )
@@ -66,6 +68,37 @@ const (
 		u.role = 'pending'
 	LIMIT 20 OFFSET $1`
 
+	queryGetPendingReviews = `
+		SELECT
+			r.id,
+			r.product_id,
+			p.name,
+			r.user_id,
+			u.name,
+			r.rating,
+			COALESCE(r.comment, ''),
+			COALESCE(r.moderation_reason, ''),
+			r.created_at
+		FROM
+			bazaar.review r
+		JOIN
+			bazaar.product p ON p.id = r.product_id
+		JOIN
+			bazaar."user" u ON u.id = r.user_id
+		WHERE
+			r.status = 'pending'
+		ORDER BY r.created_at
+		LIMIT 20 OFFSET $1`
+
+	queryUpdateStatusReview = `
+		UPDATE bazaar.review
+		SET
+			status = $1,
+			updated_at = now()
+		WHERE
+			id = $2 AND status = 'pending'
+		RETURNING product_id`
+
 	queryUpdateRoleUser = `
 		UPDATE bazaar."user"
 		SET 
@@ -100,7 +133,7 @@ func (r *AdminRepository) GetPendingProducts(ctx context.Context, offset int) ([
 		var product models.Product
 		var seller models.Seller
 		var sellerID uuid.NullUUID
-		var priceDiscount sql.NullFloat64
+		var priceDiscount models.NullMoney
 		err := rows.Scan(
 			&product.ID,
 			&product.SellerID,
@@ -126,7 +159,7 @@ func (r *AdminRepository) GetPendingProducts(ctx context.Context, offset int) ([
 			seller.ID = sellerID.UUID
 			product.Seller = &seller
 		}
-		product.PriceDiscount = priceDiscount.Float64
+		product.PriceDiscount = priceDiscount.Money
 		products = append(products, &product)
 	}
 
@@ -216,3 +249,66 @@ func (r *AdminRepository) UpdateUserRole(ctx context.Context, userID uuid.UUID,
 
 	return nil
 }
+
+// GetPendingReviews возвращает отзывы, ожидающие модерации, начиная с самых старых
+func (r *AdminRepository) GetPendingReviews(ctx context.Context, offset int) ([]*models.ModerationReview, error) {
+	const op = "AdminRepository.GetPendingReviews"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	reviews := make([]*models.ModerationReview, 0)
+
+	rows, err := r.db.QueryContext(ctx, queryGetPendingReviews, offset)
+	if err != nil {
+		logger.WithError(err).Error("failed to query pending reviews")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var review models.ModerationReview
+		err := rows.Scan(
+			&review.ID,
+			&review.ProductID,
+			&review.ProductName,
+			&review.UserID,
+			&review.UserName,
+			&review.Rating,
+			&review.Comment,
+			&review.Reason,
+			&review.CreatedAt,
+		)
+		if err != nil {
+			logger.WithError(err).Error("failed to scan review row")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		reviews = append(reviews, &review)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return reviews, nil
+}
+
+// UpdateReviewStatus публикует или отклоняет отзыв из очереди модерации и
+// возвращает товар отзыва
+func (r *AdminRepository) UpdateReviewStatus(ctx context.Context, reviewID uuid.UUID, status models.ReviewStatus) (uuid.UUID, error) {
+	const op = "AdminRepository.UpdateReviewStatus"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("review_id", reviewID)
+
+	var productID uuid.UUID
+	err := r.db.QueryRowContext(ctx, queryUpdateStatusReview, status, reviewID).Scan(&productID)
+	if errors.Is(err, sql.ErrNoRows) {
+		logger.Warn("pending review not found")
+		return uuid.Nil, fmt.Errorf("%s: %w", op, errs.NewNotFoundError("pending review not found"))
+	}
+	if err != nil {
+		logger.WithError(err).Error("update review status")
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return productID, nil
+}
@@ -0,0 +1,289 @@
+package analytics
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/google/uuid"
+)
+
+const stockOutsPageSize = 20
+
+const (
+	// rollupLockID — ключ advisory-блокировки, чтобы пересчёт выполняла только одна реплика
+	rollupLockID = 7302
+
+	queryTryRollupLock = `SELECT pg_try_advisory_xact_lock($1)`
+
+	// Пересчёт начинается за $1 дней до последнего посчитанного дня, чтобы
+	// учесть отмены и возвраты по недавним заказам. Первый запуск считает всю историю.
+	queryGetRollupStart = `
+		SELECT COALESCE(
+			(SELECT LEAST(MAX(day), current_date) - $1::int
+				FROM bazaar.seller_product_daily
+				HAVING MAX(day) IS NOT NULL),
+			LEAST(
+				(SELECT MIN(created_at)::date FROM bazaar."order"),
+				(SELECT MIN(day) FROM bazaar.product_basket_add),
+				(SELECT MIN(created_at)::date FROM bazaar.review)
+			),
+			current_date)`
+
+	queryClearRollup = `DELETE FROM bazaar.seller_product_daily WHERE day >= $1::date`
+
+	// Продажи считаются в день оформления заказа без отменённых отправлений;
+	// статус позиции берётся из отправления, а для старых заказов — из заказа.
+	// Возвратом считается возврат отправления или всего заказа, как и в выплатах.
+	// День без наличия — день, в конце которого остаток товара был нулевым:
+	// у товара с вариантами смотрятся остатки SKU, у остальных — остаток товара.
+	queryFillRollup = `
+		INSERT INTO bazaar.seller_product_daily (product_id, day, seller_id, revenue, units, orders,
+			returned_units, basket_adds, rating_sum, rating_count, stock_out)
+		SELECT f.product_id, f.day, p.seller_id, SUM(f.revenue), SUM(f.units), SUM(f.orders),
+			SUM(f.returned_units), SUM(f.basket_adds), SUM(f.rating_sum), SUM(f.rating_count), bool_or(f.stock_out)
+		FROM (
+			SELECT oi.product_id, o.created_at::date AS day,
+				SUM(oi.price * oi.quantity) AS revenue,
+				SUM(oi.quantity) AS units,
+				COUNT(DISTINCT o.id) AS orders,
+				COALESCE(SUM(oi.quantity) FILTER (WHERE
+					s.status IN ('return_requested', 'return_processed', 'return_initiated', 'return_completed')
+					OR o.status IN ('return_requested', 'return_processed', 'return_initiated', 'return_completed')), 0) AS returned_units,
+				0 AS basket_adds, 0 AS rating_sum, 0 AS rating_count, false AS stock_out
+			FROM bazaar.order_item oi
+			JOIN bazaar."order" o ON o.id = oi.order_id
+			LEFT JOIN bazaar.order_shipment s ON s.id = oi.shipment_id
+			WHERE o.created_at >= $1::date
+				AND COALESCE(s.status, o.status) NOT IN
+					('canceled', 'canceled_by_user', 'canceled_by_seller', 'canceled_due_to_payment_error', 'payment_failed')
+			GROUP BY oi.product_id, o.created_at::date
+			UNION ALL
+			SELECT ba.product_id, ba.day, 0, 0, 0, 0, ba.adds, 0, 0, false
+			FROM bazaar.product_basket_add ba
+			WHERE ba.day >= $1::date
+			UNION ALL
+			SELECT r.product_id, r.created_at::date, 0, 0, 0, 0, 0, SUM(r.rating), COUNT(*), false
+			FROM bazaar.review r
+			WHERE r.created_at >= $1::date AND r.status = 'published'
+			GROUP BY r.product_id, r.created_at::date
+			UNION ALL
+			SELECT p.id, d.day::date, 0, 0, 0, 0, 0, 0, 0, true
+			FROM bazaar.product p
+			CROSS JOIN generate_series($1::date, current_date, interval '1 day') AS d(day)
+			CROSS JOIN LATERAL (
+				SELECT bool_or(last.balance > 0) AS in_stock
+				FROM (
+					SELECT DISTINCT ON (m.variant_id) m.balance
+					FROM bazaar.stock_movement m
+					WHERE m.product_id = p.id
+						AND m.created_at < d.day + interval '1 day'
+						AND (m.variant_id IS NOT NULL) =
+							EXISTS (SELECT 1 FROM bazaar.product_variant v WHERE v.product_id = p.id)
+					ORDER BY m.variant_id, m.id DESC
+				) last
+			) stock
+			WHERE NOT stock.in_stock
+		) f
+		JOIN bazaar.product p ON p.id = f.product_id
+		WHERE p.seller_id IS NOT NULL
+		GROUP BY f.product_id, f.day, p.seller_id`
+
+	queryGetSalesStats = `
+		SELECT date_trunc($4::text, d.day::timestamp)::date AS period,
+			SUM(d.revenue), SUM(d.units), SUM(d.orders), SUM(d.returned_units),
+			SUM(d.basket_adds), SUM(d.rating_sum), SUM(d.rating_count)
+		FROM bazaar.seller_product_daily d
+		WHERE d.seller_id = $1 AND d.day BETWEEN $2::date AND $3::date
+		GROUP BY period
+		ORDER BY period`
+
+	queryGetTopProducts = `
+		SELECT d.product_id, p.name, SUM(d.revenue), SUM(d.units)
+		FROM bazaar.seller_product_daily d
+		JOIN bazaar.product p ON p.id = d.product_id
+		WHERE d.seller_id = $1 AND d.day BETWEEN $2::date AND $3::date
+		GROUP BY d.product_id, p.name
+		HAVING SUM(d.units) > 0
+		ORDER BY SUM(d.revenue) DESC, SUM(d.units) DESC, p.name
+		LIMIT $4`
+
+	queryGetStockOuts = `
+		SELECT d.product_id, p.name, COUNT(*), p.quantity
+		FROM bazaar.seller_product_daily d
+		JOIN bazaar.product p ON p.id = d.product_id
+		WHERE d.seller_id = $1 AND d.day BETWEEN $2::date AND $3::date AND d.stock_out
+		GROUP BY d.product_id, p.name, p.quantity
+		ORDER BY COUNT(*) DESC, p.name
+		LIMIT $4 OFFSET $5`
+)
+
+type AnalyticsRepository struct {
+	db *sql.DB
+}
+
+func NewAnalyticsRepository(db *sql.DB) *AnalyticsRepository {
+	return &AnalyticsRepository{
+		db: db,
+	}
+}
+
+// RefreshRollup пересчитывает дневные показатели товаров за последние
+// lookbackDays дней. Возвращает false, если пересчёт уже выполняет другая реплика.
+func (r *AnalyticsRepository) RefreshRollup(ctx context.Context, lookbackDays int) (bool, error) {
+	const op = "AnalyticsRepository.RefreshRollup"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.WithError(err).Error("begin transaction")
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var locked bool
+	if err = tx.QueryRowContext(ctx, queryTryRollupLock, rollupLockID).Scan(&locked); err != nil {
+		logger.WithError(err).Error("acquire rollup lock")
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	if !locked {
+		return false, nil
+	}
+
+	var start time.Time
+	if err = tx.QueryRowContext(ctx, queryGetRollupStart, lookbackDays).Scan(&start); err != nil {
+		logger.WithError(err).Error("get rollup start")
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, queryClearRollup, start); err != nil {
+		logger.WithError(err).Error("clear rollup")
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	if _, err = tx.ExecContext(ctx, queryFillRollup, start); err != nil {
+		logger.WithError(err).Error("fill rollup")
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.WithError(err).Error("commit transaction")
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return true, nil
+}
+
+// GetSalesStats суммирует показатели товаров продавца по периодам bucket.
+// Периоды без данных не возвращаются.
+func (r *AnalyticsRepository) GetSalesStats(
+	ctx context.Context,
+	sellerID uuid.UUID,
+	from, to time.Time,
+	bucket models.AnalyticsBucket,
+) ([]models.SalesStats, error) {
+	const op = "AnalyticsRepository.GetSalesStats"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	rows, err := r.db.QueryContext(ctx, queryGetSalesStats, sellerID, from, to, string(bucket))
+	if err != nil {
+		logger.WithError(err).Error("query sales stats")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var stats []models.SalesStats
+	for rows.Next() {
+		var s models.SalesStats
+		if err = rows.Scan(
+			&s.Period,
+			&s.Revenue,
+			&s.Units,
+			&s.Orders,
+			&s.ReturnedUnits,
+			&s.BasketAdds,
+			&s.RatingSum,
+			&s.RatingCount,
+		); err != nil {
+			logger.WithError(err).Error("scan sales stats")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		stats = append(stats, s)
+	}
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return stats, nil
+}
+
+func (r *AnalyticsRepository) GetTopProducts(
+	ctx context.Context,
+	sellerID uuid.UUID,
+	from, to time.Time,
+	limit int,
+) ([]models.TopProduct, error) {
+	const op = "AnalyticsRepository.GetTopProducts"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	rows, err := r.db.QueryContext(ctx, queryGetTopProducts, sellerID, from, to, limit)
+	if err != nil {
+		logger.WithError(err).Error("query top products")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	products := make([]models.TopProduct, 0, limit)
+	for rows.Next() {
+		var p models.TopProduct
+		if err = rows.Scan(&p.ProductID, &p.Name, &p.Revenue, &p.Units); err != nil {
+			logger.WithError(err).Error("scan top product")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		products = append(products, p)
+	}
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return products, nil
+}
+
+// GetStockOuts возвращает товары продавца, которых не было в наличии за период,
+// по убыванию числа таких дней, по 20 записей
+func (r *AnalyticsRepository) GetStockOuts(
+	ctx context.Context,
+	sellerID uuid.UUID,
+	from, to time.Time,
+	offset int,
+) ([]models.StockOutProduct, error) {
+	const op = "AnalyticsRepository.GetStockOuts"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	rows, err := r.db.QueryContext(ctx, queryGetStockOuts, sellerID, from, to, stockOutsPageSize, offset)
+	if err != nil {
+		logger.WithError(err).Error("query stock outs")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	products := make([]models.StockOutProduct, 0, stockOutsPageSize)
+	for rows.Next() {
+		var p models.StockOutProduct
+		if err = rows.Scan(&p.ProductID, &p.Name, &p.Days, &p.Quantity); err != nil {
+			logger.WithError(err).Error("scan stock out product")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		products = append(products, p)
+	}
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return products, nil
+}
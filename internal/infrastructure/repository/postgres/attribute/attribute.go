@@ -0,0 +1,285 @@
+package attribute
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const (
+	attributeColumns = `ca.id, ca.category_id, ca.name, ca.type, ca.unit, ca.options, ca.required, ca.filterable, ca.position`
+
+	// Схема категории вместе с характеристиками всех её предков: сначала
+	// характеристики корня, затем по глубине до самой категории
+	queryGetCategoryAttributes = `
+		WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id, 0 AS depth FROM bazaar.category WHERE id = $1
+			UNION ALL
+			SELECT c.id, c.parent_id, a.depth + 1
+			FROM bazaar.category c
+			JOIN ancestors a ON c.id = a.parent_id
+		)
+		SELECT ` + attributeColumns + `
+		FROM bazaar.category_attribute ca
+		JOIN ancestors a ON a.id = ca.category_id
+		ORDER BY a.depth DESC, ca.position, ca.name`
+
+	queryGetAttribute = `
+		SELECT ` + attributeColumns + `
+		FROM bazaar.category_attribute ca
+		WHERE ca.id = $1`
+
+	// Новая характеристика добавляется в конец схемы категории
+	queryCreateAttribute = `
+		INSERT INTO bazaar.category_attribute (id, category_id, name, type, unit, options, required, filterable, position)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, (
+			SELECT COALESCE(MAX(position) + 1, 0) FROM bazaar.category_attribute WHERE category_id = $2
+		))
+		RETURNING position`
+
+	queryUpdateAttribute = `
+		UPDATE bazaar.category_attribute
+		SET name = $2, unit = $3, options = $4, required = $5, filterable = $6, position = $7
+		WHERE id = $1`
+
+	queryDeleteAttribute = `DELETE FROM bazaar.category_attribute WHERE id = $1`
+
+	queryGetUsedAttributeValues = `
+		SELECT DISTINCT value_text
+		FROM bazaar.product_attribute
+		WHERE attribute_id = $1 AND value_text IS NOT NULL`
+
+	queryGetProductAttributes = `
+		SELECT ` + attributeColumns + `, pa.value_text, pa.value_number, pa.value_bool
+		FROM bazaar.product_attribute pa
+		JOIN bazaar.category_attribute ca ON ca.id = pa.attribute_id
+		WHERE pa.product_id = $1
+		ORDER BY ca.position, ca.name`
+)
+
+type AttributeRepository struct {
+	db *sql.DB
+}
+
+func NewAttributeRepository(db *sql.DB) *AttributeRepository {
+	return &AttributeRepository{db: db}
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func attributeFields(attribute *models.CategoryAttribute) []interface{} {
+	return []interface{}{
+		&attribute.ID,
+		&attribute.CategoryID,
+		&attribute.Name,
+		&attribute.Type,
+		&attribute.Unit,
+		(*pq.StringArray)(&attribute.Options),
+		&attribute.Required,
+		&attribute.Filterable,
+		&attribute.Position,
+	}
+}
+
+func scanAttribute(row scanner) (*models.CategoryAttribute, error) {
+	var attribute models.CategoryAttribute
+	if err := row.Scan(attributeFields(&attribute)...); err != nil {
+		return nil, err
+	}
+	return &attribute, nil
+}
+
+// GetCategoryAttributes возвращает действующую схему категории с учётом унаследованных характеристик
+func (r *AttributeRepository) GetCategoryAttributes(ctx context.Context, categoryID uuid.UUID) ([]models.CategoryAttribute, error) {
+	const op = "AttributeRepository.GetCategoryAttributes"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("category_id", categoryID)
+
+	rows, err := r.db.QueryContext(ctx, queryGetCategoryAttributes, categoryID)
+	if err != nil {
+		logger.WithError(err).Error("query category attributes")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	attributes := []models.CategoryAttribute{}
+	for rows.Next() {
+		attribute, err := scanAttribute(rows)
+		if err != nil {
+			logger.WithError(err).Error("scan category attribute")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		attributes = append(attributes, *attribute)
+	}
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return attributes, nil
+}
+
+func (r *AttributeRepository) GetAttribute(ctx context.Context, id uuid.UUID) (*models.CategoryAttribute, error) {
+	const op = "AttributeRepository.GetAttribute"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("attribute_id", id)
+
+	attribute, err := scanAttribute(r.db.QueryRowContext(ctx, queryGetAttribute, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, errs.NewNotFoundError("attribute not found"))
+		}
+		logger.WithError(err).Error("get attribute")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return attribute, nil
+}
+
+func (r *AttributeRepository) CreateAttribute(ctx context.Context, attribute *models.CategoryAttribute) error {
+	const op = "AttributeRepository.CreateAttribute"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("category_id", attribute.CategoryID)
+
+	err := r.db.QueryRowContext(ctx, queryCreateAttribute,
+		attribute.ID,
+		attribute.CategoryID,
+		attribute.Name,
+		attribute.Type,
+		attribute.Unit,
+		pq.Array(attribute.Options),
+		attribute.Required,
+		attribute.Filterable,
+	).Scan(&attribute.Position)
+	if err != nil {
+		logger.WithError(err).Error("create attribute")
+		return fmt.Errorf("%s: %w", op, mapAttributeError(err))
+	}
+
+	return nil
+}
+
+func (r *AttributeRepository) UpdateAttribute(ctx context.Context, attribute *models.CategoryAttribute) error {
+	const op = "AttributeRepository.UpdateAttribute"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("attribute_id", attribute.ID)
+
+	res, err := r.db.ExecContext(ctx, queryUpdateAttribute,
+		attribute.ID,
+		attribute.Name,
+		attribute.Unit,
+		pq.Array(attribute.Options),
+		attribute.Required,
+		attribute.Filterable,
+		attribute.Position,
+	)
+	if err != nil {
+		logger.WithError(err).Error("update attribute")
+		return fmt.Errorf("%s: %w", op, mapAttributeError(err))
+	}
+
+	return requireAffected(res, op)
+}
+
+// DeleteAttribute удаляет характеристику вместе с её значениями у товаров
+func (r *AttributeRepository) DeleteAttribute(ctx context.Context, id uuid.UUID) error {
+	const op = "AttributeRepository.DeleteAttribute"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("attribute_id", id)
+
+	res, err := r.db.ExecContext(ctx, queryDeleteAttribute, id)
+	if err != nil {
+		logger.WithError(err).Error("delete attribute")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return requireAffected(res, op)
+}
+
+// GetUsedAttributeValues возвращает строковые значения характеристики, указанные у товаров
+func (r *AttributeRepository) GetUsedAttributeValues(ctx context.Context, id uuid.UUID) ([]string, error) {
+	const op = "AttributeRepository.GetUsedAttributeValues"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("attribute_id", id)
+
+	rows, err := r.db.QueryContext(ctx, queryGetUsedAttributeValues, id)
+	if err != nil {
+		logger.WithError(err).Error("query used attribute values")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	values := []string{}
+	for rows.Next() {
+		var value string
+		if err = rows.Scan(&value); err != nil {
+			logger.WithError(err).Error("scan attribute value")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		values = append(values, value)
+	}
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return values, nil
+}
+
+func (r *AttributeRepository) GetProductAttributes(ctx context.Context, productID uuid.UUID) ([]models.ProductSpecification, error) {
+	const op = "AttributeRepository.GetProductAttributes"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("product_id", productID)
+
+	rows, err := r.db.QueryContext(ctx, queryGetProductAttributes, productID)
+	if err != nil {
+		logger.WithError(err).Error("query product attributes")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	specifications := []models.ProductSpecification{}
+	for rows.Next() {
+		var spec models.ProductSpecification
+		dest := append(attributeFields(&spec.Attribute), &spec.Value.Text, &spec.Value.Number, &spec.Value.Bool)
+		if err = rows.Scan(dest...); err != nil {
+			logger.WithError(err).Error("scan product attribute")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		spec.Value.AttributeID = spec.Attribute.ID
+		specifications = append(specifications, spec)
+	}
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return specifications, nil
+}
+
+// mapAttributeError переводит нарушения ограничений схемы в доменные ошибки
+func mapAttributeError(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok {
+		switch pqErr.Code {
+		case "23505":
+			return errs.NewAlreadyExistsError("attribute with this name already exists in category")
+		case "23503":
+			return errs.NewNotFoundError("category not found")
+		}
+	}
+	return err
+}
+
+func requireAffected(res sql.Result, op string) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, errs.NewNotFoundError("attribute not found"))
+	}
+
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 const(
@@ -20,13 +21,21 @@ const(
 			FROM bazaar.basket WHERE id = $1
 	`
 
+	// Товар с вариантами добавляется только конкретным SKU этого товара,
+	// товар без вариантов — без SKU; иначе строка не вставляется
 	queryAddProductInBasket = `
-		INSERT INTO bazaar.basket_item (id, basket_id, product_id, quantity)
-			VALUES ($1, $2, $3, 1)
-			ON CONFLICT (basket_id, product_id) 
+		INSERT INTO bazaar.basket_item (id, basket_id, product_id, variant_id, quantity)
+			SELECT $1, $2, p.id, $4::uuid, 1
+			FROM bazaar.product p
+			WHERE p.id = $3
+				AND CASE WHEN $4::uuid IS NULL
+					THEN NOT EXISTS (SELECT 1 FROM bazaar.product_variant WHERE product_id = p.id)
+					ELSE EXISTS (SELECT 1 FROM bazaar.product_variant WHERE id = $4::uuid AND product_id = p.id)
+				END
+			ON CONFLICT ON CONSTRAINT basket_item_basket_id_product_id_variant_id_key
 			DO UPDATE SET 
 				quantity = basket_item.quantity + 1
-			RETURNING id, basket_id, product_id, quantity, updated_at
+			RETURNING id, basket_id, product_id, variant_id, quantity, updated_at
 	`
 
 	queryGetProductsInBasket = `
@@ -34,17 +43,21 @@ const(
 			bi.id, 
 			bi.basket_id, 
 			bi.product_id, 
+			bi.variant_id,
+			v.options,
 			bi.quantity AS basket_quantity, 
 			bi.updated_at,
 			p.name,
-			p.price,
-			p.preview_image_url,
+			COALESCE(v.price, p.price),
+			COALESCE(v.images[1], p.preview_image_url),
 			d.discounted_price,
-			p.quantity AS available_quantity
+			COALESCE(v.quantity, p.quantity) AS available_quantity
 		FROM 
 			bazaar.basket_item bi
 		JOIN 
 			bazaar.product p ON bi.product_id = p.id
+		LEFT JOIN
+			bazaar.product_variant v ON v.id = bi.variant_id
 		LEFT JOIN LATERAL (
 			SELECT 
 				discounted_price
@@ -52,6 +65,7 @@ const(
 				bazaar.discount
 			WHERE 
 				product_id = bi.product_id
+				AND (variant_id IS NULL OR variant_id = bi.variant_id)
 				AND now() BETWEEN start_date AND end_date
 			ORDER BY 
 				start_date DESC
@@ -60,27 +74,95 @@ const(
 		WHERE 
 			bi.basket_id = $1
 			AND p.status = 'approved'
+			-- позиции без SKU у товара, который стал продаваться вариантами, не показываются
+			AND (bi.variant_id IS NOT NULL
+				OR NOT EXISTS (SELECT 1 FROM bazaar.product_variant WHERE product_id = p.id))
     `
 
 	queryDelProductFromBasket = `
 		DELETE FROM bazaar.basket_item
-		WHERE basket_id = $1 AND product_id = $2
+		WHERE basket_id = $1 AND product_id = $2 AND variant_id IS NOT DISTINCT FROM $3
 		RETURNING id
 	`
 
 	queryUpdateProductQuantity = `
 		UPDATE bazaar.basket_item
 		SET quantity = $1
-		WHERE basket_id = $2 AND product_id = $3
-		RETURNING id, basket_id, product_id, quantity, updated_at
+		WHERE basket_id = $2 AND product_id = $3 AND variant_id IS NOT DISTINCT FROM $4
+		RETURNING id, basket_id, product_id, variant_id, quantity, updated_at
 	`
 
 	queryGetQuantityProduct = `SELECT quantity FROM bazaar.product WHERE id = $1`
+	queryGetQuantityVariant = `SELECT quantity FROM bazaar.product_variant WHERE id = $1 AND product_id = $2`
 
 	queryClearBasket = `
 		DELETE FROM bazaar.basket_item
 		WHERE basket_id = $1
 	`
+
+	queryUpdateBasketTotals = `
+		UPDATE bazaar.basket
+		SET total_price = $1, total_price_discount = $2
+		WHERE user_id = $3
+	`
+
+	// Позиции передаются парами массивов «товар — SKU»; пустая строка означает товар без SKU
+	queryGetBasketItems = `
+		SELECT
+			p.id,
+			v.id,
+			v.options,
+			p.name,
+			COALESCE(v.price, p.price),
+			COALESCE(v.images[1], p.preview_image_url, ''),
+			d.discounted_price,
+			COALESCE(v.quantity, p.quantity)
+		FROM
+			unnest($1::uuid[], $2::text[]) AS k(product_id, variant_id)
+		JOIN
+			bazaar.product p ON p.id = k.product_id
+		LEFT JOIN
+			bazaar.product_variant v ON v.id = NULLIF(k.variant_id, '')::uuid AND v.product_id = p.id
+		LEFT JOIN LATERAL (
+			SELECT
+				discounted_price
+			FROM
+				bazaar.discount
+			WHERE
+				product_id = p.id
+				AND (variant_id IS NULL OR variant_id = v.id)
+				AND now() BETWEEN start_date AND end_date
+			ORDER BY
+				start_date DESC
+			LIMIT 1
+		) d ON true
+		WHERE
+			p.status = 'approved'
+			AND (k.variant_id = '') = (v.id IS NULL)
+	`
+
+	// Количество суммируется с уже лежащим в корзине и не превышает остаток товара
+	// или SKU. Товар с вариантами без SKU и чужой SKU пропускаются
+	queryMergeProductInBasket = `
+		INSERT INTO bazaar.basket_item (id, basket_id, product_id, variant_id, quantity)
+			SELECT $1, $2, p.id, v.id, LEAST($4::int, COALESCE(v.quantity, p.quantity))
+			FROM bazaar.product p
+			LEFT JOIN bazaar.product_variant v ON v.id = $5::uuid AND v.product_id = p.id
+			WHERE p.id = $3 AND p.status = 'approved' AND COALESCE(v.quantity, p.quantity) > 0
+				AND CASE WHEN $5::uuid IS NULL
+					THEN NOT EXISTS (SELECT 1 FROM bazaar.product_variant WHERE product_id = p.id)
+					ELSE v.id IS NOT NULL
+				END
+			ON CONFLICT ON CONSTRAINT basket_item_basket_id_product_id_variant_id_key
+			DO UPDATE SET
+				quantity = LEAST(
+					basket_item.quantity + $4::int,
+					COALESCE(
+						(SELECT quantity FROM bazaar.product_variant WHERE id = $5::uuid),
+						(SELECT quantity FROM bazaar.product WHERE id = $3)
+					)
+				)
+	`
 )
 
 type BasketRepository struct{
@@ -112,13 +194,18 @@ func (r *BasketRepository) getBasket(ctx context.Context, userID uuid.UUID) (uui
 	return basketID, nil
 }
 
-func (r*BasketRepository) getQuantityProduct(ctx context.Context, productID uuid.UUID) (uint, error) {
+// getQuantityProduct возвращает остаток товара, а для SKU — остаток варианта
+func (r*BasketRepository) getQuantityProduct(ctx context.Context, productID uuid.UUID, variantID uuid.NullUUID) (uint, error) {
 	const op = "BasketRepository.getQuantityProduct"
     logger := logctx.GetLogger(ctx).WithField("op", op).WithField("product_id", productID)
 
 	var quantity uint
 
-	err := r.DB.QueryRowContext(ctx, queryGetQuantityProduct, productID).Scan(&quantity)
+	row := r.DB.QueryRowContext(ctx, queryGetQuantityProduct, productID)
+	if variantID.Valid {
+		row = r.DB.QueryRowContext(ctx, queryGetQuantityVariant, variantID.UUID, productID)
+	}
+	err := row.Scan(&quantity)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
             logger.Warn("basket not found")
@@ -156,12 +243,14 @@ func (r *BasketRepository) Get(ctx context.Context, userID uuid.UUID) ([]*models
 
 	for rows.Next() {
 		item := &models.BasketItem{}
-		var priceDiscount sql.NullFloat64
+		var priceDiscount models.NullMoney
 		var quantity int
 		err = rows.Scan(
 			&item.ID,
 			&item.BasketID,
 			&item.ProductID,
+			&item.VariantID,
+			&item.VariantOptions,
 			&item.Quantity,
 			&item.UpdatedAt,
 			&item.ProductName,
@@ -174,7 +263,7 @@ func (r *BasketRepository) Get(ctx context.Context, userID uuid.UUID) ([]*models
 			logger.WithError(err).Error("scan basket item")
             return nil, fmt.Errorf("%s: %w", op, err)
 		}
-		item.PriceDiscount = priceDiscount.Float64
+		item.PriceDiscount = priceDiscount.Money
 		item.QuantityRemain = quantity - item.Quantity
 		productsList = append(productsList, item)
 	}
@@ -187,7 +276,7 @@ func (r *BasketRepository) Get(ctx context.Context, userID uuid.UUID) ([]*models
 	return productsList, nil
 }
 
-func (r *BasketRepository) Add(ctx context.Context, userID uuid.UUID, productID uuid.UUID) (*models.BasketItem, error){
+func (r *BasketRepository) Add(ctx context.Context, userID uuid.UUID, productID uuid.UUID, variantID uuid.NullUUID) (*models.BasketItem, error){
 	const op = "BasketRepository.Add"
     logger := logctx.GetLogger(ctx).WithField("op", op).
         WithField("user_id", userID).
@@ -202,10 +291,11 @@ func (r *BasketRepository) Add(ctx context.Context, userID uuid.UUID, productID
 	item := &models.BasketItem{}
 	newItemID := uuid.New()
 
-	err = r.DB.QueryRowContext(ctx, queryAddProductInBasket, newItemID, basketID, productID).Scan(
+	err = r.DB.QueryRowContext(ctx, queryAddProductInBasket, newItemID, basketID, productID, variantID).Scan(
 		&item.ID,
 		&item.BasketID,
 		&item.ProductID,
+		&item.VariantID,
 		&item.Quantity,
 		&item.UpdatedAt,
 	)
@@ -221,7 +311,7 @@ func (r *BasketRepository) Add(ctx context.Context, userID uuid.UUID, productID
 	return item, nil
 }
 
-func (r *BasketRepository) Delete(ctx context.Context, userID uuid.UUID, productID uuid.UUID) error {
+func (r *BasketRepository) Delete(ctx context.Context, userID uuid.UUID, productID uuid.UUID, variantID uuid.NullUUID) error {
 	const op = "BasketRepository.Delete"
     logger := logctx.GetLogger(ctx).WithField("op", op).
         WithField("user_id", userID).
@@ -234,7 +324,7 @@ func (r *BasketRepository) Delete(ctx context.Context, userID uuid.UUID, product
     }
 
 	var deletedID uuid.UUID
-	err = r.DB.QueryRowContext(ctx, queryDelProductFromBasket, basketID, productID).Scan(&deletedID)
+	err = r.DB.QueryRowContext(ctx, queryDelProductFromBasket, basketID, productID, variantID).Scan(&deletedID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
             logger.Warn("product not found in basket")
@@ -247,14 +337,14 @@ func (r *BasketRepository) Delete(ctx context.Context, userID uuid.UUID, product
 	return nil
 }
 
-func (r *BasketRepository) UpdateQuantity(ctx context.Context, userID uuid.UUID, productID uuid.UUID, quantity int) (*models.BasketItem, error) {
+func (r *BasketRepository) UpdateQuantity(ctx context.Context, userID uuid.UUID, productID uuid.UUID, variantID uuid.NullUUID, quantity int) (*models.BasketItem, error) {
 	const op = "BasketRepository.UpdateQuantity"
     logger := logctx.GetLogger(ctx).WithField("op", op).
         WithField("user_id", userID).
         WithField("product_id", productID).
         WithField("quantity", quantity)
 
-	quantityProduct, err := r.getQuantityProduct(ctx, productID)
+	quantityProduct, err := r.getQuantityProduct(ctx, productID, variantID)
 	if err != nil {
 		logger.WithError(err).Error("failed to get basket ID")
         return nil, fmt.Errorf("%s: %w", op, err)
@@ -272,10 +362,11 @@ func (r *BasketRepository) UpdateQuantity(ctx context.Context, userID uuid.UUID,
     }
 
 	item := &models.BasketItem{}
-	err = r.DB.QueryRowContext(ctx, queryUpdateProductQuantity, quantity, basketID, productID).Scan(
+	err = r.DB.QueryRowContext(ctx, queryUpdateProductQuantity, quantity, basketID, productID, variantID).Scan(
 		&item.ID,
 		&item.BasketID,
 		&item.ProductID,
+		&item.VariantID,
 		&item.Quantity,
 		&item.UpdatedAt,
 	)
@@ -311,4 +402,124 @@ func (r *BasketRepository) Clear(ctx context.Context, userID uuid.UUID) error {
     }
 
 	return nil
-}
\ No newline at end of file
+}
+
+// UpdateTotals сохраняет суммы корзины, посчитанные движком цен
+func (r *BasketRepository) UpdateTotals(ctx context.Context, userID uuid.UUID, totalPrice, totalPriceDiscount models.Money) error {
+	const op = "BasketRepository.UpdateTotals"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("user_id", userID)
+
+	res, err := r.DB.ExecContext(ctx, queryUpdateBasketTotals, totalPrice, totalPriceDiscount, userID)
+	if err != nil {
+		logger.WithError(err).Error("update basket totals")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		logger.WithError(err).Error("get rows affected")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, errs.NewNotFoundError(op))
+	}
+
+	return nil
+}
+
+// GetBasketItems возвращает позиции с данными одобренных товаров, их SKU и
+// действующей скидкой. Используется гостевой корзиной, которая хранит только
+// количество; недоступные позиции и SKU чужих товаров пропускаются.
+func (r *BasketRepository) GetBasketItems(ctx context.Context, items []models.PricingItem) ([]*models.BasketItem, error) {
+	const op = "BasketRepository.GetBasketItems"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	productIDs := make([]string, 0, len(items))
+	variantIDs := make([]string, 0, len(items))
+	quantities := make(map[models.StockKey]uint, len(items))
+	for _, item := range items {
+		productIDs = append(productIDs, item.ProductID.String())
+		variantID := ""
+		if item.VariantID.Valid {
+			variantID = item.VariantID.UUID.String()
+		}
+		variantIDs = append(variantIDs, variantID)
+		quantities[item.Key()] = item.Quantity
+	}
+
+	rows, err := r.DB.QueryContext(ctx, queryGetBasketItems, pq.Array(productIDs), pq.Array(variantIDs))
+	if err != nil {
+		logger.WithError(err).Error("query basket items")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	result := make([]*models.BasketItem, 0, len(items))
+	for rows.Next() {
+		item := &models.BasketItem{}
+		var (
+			priceDiscount models.NullMoney
+			stock         int
+		)
+		if err = rows.Scan(
+			&item.ProductID,
+			&item.VariantID,
+			&item.VariantOptions,
+			&item.ProductName,
+			&item.Price,
+			&item.ProductImage,
+			&priceDiscount,
+			&stock,
+		); err != nil {
+			logger.WithError(err).Error("scan basket item")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		item.PriceDiscount = priceDiscount.Money
+		item.Quantity = int(quantities[models.StockKey{ProductID: item.ProductID, VariantID: item.VariantID}])
+		item.QuantityRemain = stock - item.Quantity
+		result = append(result, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// Merge добавляет позиции в корзину пользователя, складывая количество с уже
+// лежащим в корзине. Количество ограничивается остатком, недоступные товары пропускаются.
+func (r *BasketRepository) Merge(ctx context.Context, userID uuid.UUID, items []models.PricingItem) error {
+	const op = "BasketRepository.Merge"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("user_id", userID)
+
+	basketID, err := r.getBasket(ctx, userID)
+	if err != nil {
+		logger.WithError(err).Error("get basket ID")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		logger.WithError(err).Error("begin transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	for _, item := range items {
+		if _, err = tx.ExecContext(ctx, queryMergeProductInBasket,
+			uuid.New(), basketID, item.ProductID, item.Quantity, item.VariantID,
+		); err != nil {
+			logger.WithError(err).WithField("product_id", item.ProductID).Error("merge product into basket")
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.WithError(err).Error("commit transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,496 @@
+package catalog
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/inventory"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const (
+	importColumns = `id, seller_id, format, file_name, file_key, status, total_rows, created_rows,
+		updated_rows, failed_rows, COALESCE(error, ''), created_at, started_at, finished_at`
+
+	queryCreateImport = `
+		INSERT INTO bazaar.catalog_import (id, seller_id, format, file_name, file_key, status)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING created_at`
+
+	queryGetImport = `
+		SELECT ` + importColumns + `
+		FROM bazaar.catalog_import
+		WHERE id = $1 AND seller_id = $2`
+
+	// Забирает самую старую задачу из очереди. Задача, которая слишком долго
+	// остаётся в processing, считается брошенной упавшим обработчиком.
+	// SKIP LOCKED позволяет нескольким экземплярам приложения разбирать очередь параллельно.
+	queryClaimImport = `
+		UPDATE bazaar.catalog_import
+		SET status = 'processing', started_at = now()
+		WHERE id = (
+			SELECT id FROM bazaar.catalog_import
+			WHERE status = 'pending'
+				OR (status = 'processing' AND started_at < now() - make_interval(secs => $1))
+			ORDER BY created_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + importColumns
+
+	queryDeleteImportErrors = `DELETE FROM bazaar.catalog_import_error WHERE import_id = $1`
+
+	queryAddImportErrors = `
+		INSERT INTO bazaar.catalog_import_error (import_id, row_number, sku, message)
+		SELECT $1, e.row_number, e.sku, e.message
+		FROM unnest($2::int[], $3::text[], $4::text[]) AS e(row_number, sku, message)`
+
+	queryFinishImport = `
+		UPDATE bazaar.catalog_import
+		SET status = $2, total_rows = $3, created_rows = $4, updated_rows = $5, failed_rows = $6,
+			error = NULLIF($7, ''), finished_at = now()
+		WHERE id = $1`
+
+	queryGetImportErrors = `
+		SELECT row_number, sku, message
+		FROM bazaar.catalog_import_error
+		WHERE import_id = $1
+		ORDER BY row_number`
+
+	// Товар можно привязать только к подкатегории; названия сравниваются без учёта регистра
+	queryFindCategories = `
+		SELECT id, lower(name)
+		FROM bazaar.subcategory
+		WHERE lower(name) = ANY($1)`
+
+	queryGetProductsBySKU = `
+		SELECT p.sku, ps.subcategory_id
+		FROM bazaar.product p
+		LEFT JOIN bazaar.product_subcategory ps ON ps.product_id = p.id
+		WHERE p.seller_id = $1 AND p.sku = ANY($2)`
+
+	// Новый товар уходит на модерацию, у существующего статус не меняется.
+	// Цену и остаток товара с вариантами задают его SKU, поэтому они не перезаписываются.
+	queryUpsertProduct = `
+		INSERT INTO bazaar.product AS p (
+			id, seller_id, sku, name, description, status, price, quantity, rating, reviews_count
+		) VALUES ($1, $2, $3, $4, $5, 'pending', $6, $7, 0, 0)
+		ON CONFLICT (seller_id, sku) WHERE sku IS NOT NULL DO UPDATE
+		SET name = EXCLUDED.name,
+			description = EXCLUDED.description,
+			price = CASE WHEN EXISTS (SELECT 1 FROM bazaar.product_variant v WHERE v.product_id = p.id)
+				THEN p.price ELSE EXCLUDED.price END,
+			quantity = CASE WHEN EXISTS (SELECT 1 FROM bazaar.product_variant v WHERE v.product_id = p.id)
+				THEN p.quantity ELSE EXCLUDED.quantity END
+		RETURNING id, xmax = 0`
+
+	querySetProductCategory = `
+		WITH updated AS (
+			UPDATE bazaar.product_subcategory
+			SET subcategory_id = $3
+			WHERE product_id = $2
+			RETURNING id
+		)
+		INSERT INTO bazaar.product_subcategory (id, product_id, subcategory_id)
+		SELECT $1, $2, $3
+		WHERE NOT EXISTS (SELECT 1 FROM updated)`
+
+	// После смены категории у товара остаются только характеристики из её схемы
+	queryDropForeignAttributes = `
+		WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id FROM bazaar.category WHERE id = $2
+			UNION ALL
+			SELECT c.id, c.parent_id
+			FROM bazaar.category c
+			JOIN ancestors a ON c.id = a.parent_id
+		)
+		DELETE FROM bazaar.product_attribute
+		WHERE product_id = $1 AND attribute_id NOT IN (
+			SELECT ca.id
+			FROM bazaar.category_attribute ca
+			JOIN ancestors a ON a.id = ca.category_id
+		)`
+
+	queryExportProducts = `
+		SELECT COALESCE(p.sku, ''), p.name, COALESCE(p.description, ''), COALESCE(c.name, ''), p.price, p.quantity
+		FROM bazaar.product p
+		LEFT JOIN LATERAL (
+			SELECT s.name
+			FROM bazaar.product_subcategory ps
+			JOIN bazaar.category s ON s.id = ps.subcategory_id
+			WHERE ps.product_id = p.id
+			LIMIT 1
+		) c ON true
+		WHERE p.seller_id = $1
+		ORDER BY p.sku NULLS LAST, p.name`
+)
+
+type CatalogRepository struct {
+	db *sql.DB
+}
+
+func NewCatalogRepository(db *sql.DB) *CatalogRepository {
+	return &CatalogRepository{
+		db: db,
+	}
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanImport(row scanner) (*models.CatalogImport, error) {
+	var (
+		job        models.CatalogImport
+		startedAt  sql.NullTime
+		finishedAt sql.NullTime
+	)
+	if err := row.Scan(
+		&job.ID,
+		&job.SellerID,
+		&job.Format,
+		&job.FileName,
+		&job.FileKey,
+		&job.Status,
+		&job.TotalRows,
+		&job.CreatedRows,
+		&job.UpdatedRows,
+		&job.FailedRows,
+		&job.Error,
+		&job.CreatedAt,
+		&startedAt,
+		&finishedAt,
+	); err != nil {
+		return nil, err
+	}
+	if startedAt.Valid {
+		job.StartedAt = &startedAt.Time
+	}
+	if finishedAt.Valid {
+		job.FinishedAt = &finishedAt.Time
+	}
+	return &job, nil
+}
+
+func (r *CatalogRepository) CreateImport(ctx context.Context, job *models.CatalogImport) error {
+	const op = "CatalogRepository.CreateImport"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("seller_id", job.SellerID)
+
+	if err := r.db.QueryRowContext(ctx, queryCreateImport,
+		job.ID,
+		job.SellerID,
+		job.Format,
+		job.FileName,
+		job.FileKey,
+		job.Status,
+	).Scan(&job.CreatedAt); err != nil {
+		logger.WithError(err).Error("insert catalog import")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetImport возвращает задачу импорта продавца; чужая задача считается ненайденной
+func (r *CatalogRepository) GetImport(ctx context.Context, id, sellerID uuid.UUID) (*models.CatalogImport, error) {
+	const op = "CatalogRepository.GetImport"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("import_id", id)
+
+	job, err := scanImport(r.db.QueryRowContext(ctx, queryGetImport, id, sellerID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Warn("catalog import not found")
+			return nil, errs.NewNotFoundError(op)
+		}
+		logger.WithError(err).Error("get catalog import")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return job, nil
+}
+
+// ClaimImport переводит следующую задачу очереди в processing и возвращает её.
+// Если очередь пуста, возвращает errs.ErrNotFound.
+func (r *CatalogRepository) ClaimImport(ctx context.Context, staleAfter time.Duration) (*models.CatalogImport, error) {
+	const op = "CatalogRepository.ClaimImport"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	job, err := scanImport(r.db.QueryRowContext(ctx, queryClaimImport, staleAfter.Seconds()))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.NewNotFoundError(op)
+		}
+		logger.WithError(err).Error("claim catalog import")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return job, nil
+}
+
+// FinishImport сохраняет итог задачи и ошибки строк. Ошибки прошлой попытки
+// обработки той же задачи удаляются.
+func (r *CatalogRepository) FinishImport(ctx context.Context, job *models.CatalogImport, rowErrors []models.ImportRowError) error {
+	const op = "CatalogRepository.FinishImport"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("import_id", job.ID)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.WithError(err).Error("begin transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, queryDeleteImportErrors, job.ID); err != nil {
+		logger.WithError(err).Error("delete previous import errors")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(rowErrors) > 0 {
+		rowNumbers := make([]int64, 0, len(rowErrors))
+		skus := make([]string, 0, len(rowErrors))
+		messages := make([]string, 0, len(rowErrors))
+		for _, rowError := range rowErrors {
+			rowNumbers = append(rowNumbers, int64(rowError.Row))
+			skus = append(skus, rowError.SKU)
+			messages = append(messages, rowError.Message)
+		}
+		if _, err = tx.ExecContext(ctx, queryAddImportErrors,
+			job.ID, pq.Array(rowNumbers), pq.Array(skus), pq.Array(messages),
+		); err != nil {
+			logger.WithError(err).Error("insert import errors")
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if _, err = tx.ExecContext(ctx, queryFinishImport,
+		job.ID,
+		job.Status,
+		job.TotalRows,
+		job.CreatedRows,
+		job.UpdatedRows,
+		job.FailedRows,
+		job.Error,
+	); err != nil {
+		logger.WithError(err).Error("update catalog import")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.WithError(err).Error("commit transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *CatalogRepository) GetImportErrors(ctx context.Context, id uuid.UUID) ([]models.ImportRowError, error) {
+	const op = "CatalogRepository.GetImportErrors"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("import_id", id)
+
+	rows, err := r.db.QueryContext(ctx, queryGetImportErrors, id)
+	if err != nil {
+		logger.WithError(err).Error("query import errors")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	rowErrors := []models.ImportRowError{}
+	for rows.Next() {
+		var rowError models.ImportRowError
+		if err = rows.Scan(&rowError.Row, &rowError.SKU, &rowError.Message); err != nil {
+			logger.WithError(err).Error("scan import error")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		rowErrors = append(rowErrors, rowError)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return rowErrors, nil
+}
+
+// FindCategories ищет подкатегории по названиям без учёта регистра. Ключ
+// результата — название в нижнем регистре; одинаково названных подкатегорий
+// у разных родителей может быть несколько.
+func (r *CatalogRepository) FindCategories(ctx context.Context, names []string) (map[string][]uuid.UUID, error) {
+	const op = "CatalogRepository.FindCategories"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	lowered := make([]string, 0, len(names))
+	for _, name := range names {
+		lowered = append(lowered, strings.ToLower(name))
+	}
+
+	rows, err := r.db.QueryContext(ctx, queryFindCategories, pq.Array(lowered))
+	if err != nil {
+		logger.WithError(err).Error("query categories")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	categories := make(map[string][]uuid.UUID)
+	for rows.Next() {
+		var (
+			id   uuid.UUID
+			name string
+		)
+		if err = rows.Scan(&id, &name); err != nil {
+			logger.WithError(err).Error("scan category")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		categories[name] = append(categories[name], id)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return categories, nil
+}
+
+// GetProductCategories возвращает категории уже заведённых товаров продавца
+// по их артикулам. Товар без категории попадает в результат с uuid.Nil.
+func (r *CatalogRepository) GetProductCategories(ctx context.Context, sellerID uuid.UUID, skus []string) (map[string]uuid.UUID, error) {
+	const op = "CatalogRepository.GetProductCategories"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("seller_id", sellerID)
+
+	rows, err := r.db.QueryContext(ctx, queryGetProductsBySKU, sellerID, pq.Array(skus))
+	if err != nil {
+		logger.WithError(err).Error("query products by sku")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	categories := make(map[string]uuid.UUID)
+	for rows.Next() {
+		var (
+			sku        string
+			categoryID uuid.NullUUID
+		)
+		if err = rows.Scan(&sku, &categoryID); err != nil {
+			logger.WithError(err).Error("scan product")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		categories[sku] = categoryID.UUID
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return categories, nil
+}
+
+// UpsertProduct создаёт товар продавца или обновляет товар с тем же артикулом
+// и сообщает, был ли товар создан
+func (r *CatalogRepository) UpsertProduct(
+	ctx context.Context,
+	sellerID uuid.UUID,
+	row models.CatalogRow,
+	categoryID uuid.UUID,
+) (bool, error) {
+	const op = "CatalogRepository.UpsertProduct"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("sku", row.SKU)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.WithError(err).Error("begin transaction")
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if err = inventory.SetStockContext(ctx, tx, models.StockChange{
+		Kind:    models.StockImport,
+		ActorID: uuid.NullUUID{UUID: sellerID, Valid: true},
+		Reason:  "catalog import",
+	}); err != nil {
+		logger.WithError(err).Error("set stock context")
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var (
+		productID uuid.UUID
+		created   bool
+	)
+	if err = tx.QueryRowContext(ctx, queryUpsertProduct,
+		uuid.New(),
+		sellerID,
+		row.SKU,
+		row.Name,
+		row.Description,
+		row.Price,
+		row.Quantity,
+	).Scan(&productID, &created); err != nil {
+		logger.WithError(err).Error("upsert product")
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, querySetProductCategory, uuid.New(), productID, categoryID); err != nil {
+		logger.WithError(err).Error("set product category")
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !created {
+		if _, err = tx.ExecContext(ctx, queryDropForeignAttributes, productID, categoryID); err != nil {
+			logger.WithError(err).Error("drop foreign attributes")
+			return false, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.WithError(err).Error("commit transaction")
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return created, nil
+}
+
+// ExportProducts возвращает весь каталог продавца в виде строк файла
+func (r *CatalogRepository) ExportProducts(ctx context.Context, sellerID uuid.UUID) ([]models.CatalogRow, error) {
+	const op = "CatalogRepository.ExportProducts"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("seller_id", sellerID)
+
+	rows, err := r.db.QueryContext(ctx, queryExportProducts, sellerID)
+	if err != nil {
+		logger.WithError(err).Error("query seller products")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	products := []models.CatalogRow{}
+	for rows.Next() {
+		var product models.CatalogRow
+		if err = rows.Scan(
+			&product.SKU,
+			&product.Name,
+			&product.Description,
+			&product.Category,
+			&product.Price,
+			&product.Quantity,
+		); err != nil {
+			logger.WithError(err).Error("scan product")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		products = append(products, product)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return products, nil
+}
@@ -10,118 +10,396 @@ import (
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 const (
-	queryGetAllCategories = `
-			SELECT id, name FROM bazaar.category
-	`
+	selectCategory = `
+		SELECT id, name, parent_id, slug, image_url, position FROM bazaar.category`
 
-	queryGetAllSubcategories = `
-		SELECT id, name 
-		FROM bazaar.subcategory
-		WHERE category_id = $1
-	`
+	queryGetAllCategories = selectCategory + `
+		WHERE parent_id IS NULL
+		ORDER BY position, name`
+
+	queryGetAllSubcategories = selectCategory + `
+		WHERE parent_id = $1
+		ORDER BY position, name`
 
 	queryGetNameSybcategory = `
 		SELECT name
-		FROM bazaar.subcategory
+		FROM bazaar.category
 		WHERE id = $1
 	`
+
+	queryGetCategory = selectCategory + `
+		WHERE id = $1`
+
+	queryGetCategoryBySlug = selectCategory + `
+		WHERE slug = $1`
+
+	queryGetCategoryTree = selectCategory + `
+		ORDER BY position, name`
+
+	// Путь от корня дерева до узла
+	queryGetBreadcrumbs = `
+		WITH RECURSIVE path AS (
+			SELECT id, name, parent_id, slug, image_url, position, 0 AS depth
+			FROM bazaar.category
+			WHERE id = $1
+			UNION ALL
+			SELECT c.id, c.name, c.parent_id, c.slug, c.image_url, c.position, p.depth + 1
+			FROM bazaar.category c
+			JOIN path p ON c.id = p.parent_id
+		)
+		SELECT id, name, parent_id, slug, image_url, position
+		FROM path
+		ORDER BY depth DESC`
+
+	queryIsDescendant = `
+		WITH RECURSIVE subtree AS (
+			SELECT id FROM bazaar.category WHERE id = $1
+			UNION ALL
+			SELECT c.id FROM bazaar.category c JOIN subtree s ON c.parent_id = s.id
+		)
+		SELECT EXISTS (SELECT 1 FROM subtree WHERE id = $2)`
+
+	// Новая категория добавляется в конец списка соседних узлов
+	queryCreateCategory = `
+		INSERT INTO bazaar.category (id, name, parent_id, slug, image_url, position)
+		VALUES ($1, $2, $3, $4, $5, (
+			SELECT COALESCE(MAX(position) + 1, 0)
+			FROM bazaar.category
+			WHERE parent_id IS NOT DISTINCT FROM $3
+		))
+		RETURNING position`
+
+	queryUpdateCategory = `
+		UPDATE bazaar.category
+		SET name = $2, slug = $3, image_url = $4
+		WHERE id = $1`
+
+	querySetCategoryImage = `UPDATE bazaar.category SET image_url = $2 WHERE id = $1`
+
+	// Узлы блокируются в порядке id, чтобы встречные переносы не взаимоблокировались
+	queryLockMovedCategories = `
+		SELECT id
+		FROM bazaar.category
+		WHERE id IN ($1, $2)
+		ORDER BY id
+		FOR UPDATE`
+
+	queryLockSiblings = `
+		SELECT id
+		FROM bazaar.category
+		WHERE parent_id IS NOT DISTINCT FROM $1 AND id <> $2
+		ORDER BY position, name
+		FOR UPDATE`
+
+	queryMoveCategory = `UPDATE bazaar.category SET parent_id = $2 WHERE id = $1`
+
+	querySetCategoryPosition = `UPDATE bazaar.category SET position = $2 WHERE id = $1`
+
+	queryDeleteCategory = `DELETE FROM bazaar.category WHERE id = $1`
 )
 
 type CategoryRepository struct {
-	DB  *sql.DB
+	DB *sql.DB
 }
 
 func NewCategoryRepository(db *sql.DB) *CategoryRepository {
 	return &CategoryRepository{
-		DB:  db,
+		DB: db,
 	}
 }
 
-func (p *CategoryRepository) GetAllCategories(ctx context.Context) ([]*models.Category, error) {
-	const op = "CategoryRepository.GetAllCategories"
-    logger := logctx.GetLogger(ctx).WithField("op", op)
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanCategory(row scanner) (*models.Category, error) {
+	category := &models.Category{}
+	err := row.Scan(
+		&category.ID,
+		&category.Name,
+		&category.ParentID,
+		&category.Slug,
+		&category.ImageURL,
+		&category.Position,
+	)
+	return category, err
+}
+
+func (p *CategoryRepository) queryCategories(ctx context.Context, op, query string, args ...interface{}) ([]*models.Category, error) {
+	logger := logctx.GetLogger(ctx).WithField("op", op)
 
 	categoriesList := []*models.Category{}
 
-	rows, err := p.DB.QueryContext(ctx, queryGetAllCategories)
+	rows, err := p.DB.QueryContext(ctx, query, args...)
 	if err != nil {
-		logger.WithError(err).Error("query all categories")
-        return nil, fmt.Errorf("%s: %w", op, err)
+		logger.WithError(err).Error("query categories")
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 	defer rows.Close()
 
 	for rows.Next() {
-		category := &models.Category{}
-		err = rows.Scan(
-			&category.ID,
-			&category.Name,
-		)
+		category, err := scanCategory(rows)
 		if err != nil {
 			logger.WithError(err).Error("scan category row")
-            return nil, fmt.Errorf("%s: %w", op, err)
+			return nil, fmt.Errorf("%s: %w", op, err)
 		}
 		categoriesList = append(categoriesList, category)
 	}
 
 	if err = rows.Err(); err != nil {
 		logger.WithError(err).Error("rows iteration error")
-        return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
 	return categoriesList, nil
 }
 
+// GetAllCategories возвращает корневые категории
+func (p *CategoryRepository) GetAllCategories(ctx context.Context) ([]*models.Category, error) {
+	return p.queryCategories(ctx, "CategoryRepository.GetAllCategories", queryGetAllCategories)
+}
+
+// GetAllSubcategories возвращает прямых потомков категории
 func (p *CategoryRepository) GetAllSubcategories(ctx context.Context, category_id uuid.UUID) ([]*models.Category, error) {
-	const op = "CategoryRepository.GetAllSubcategories"
-    logger := logctx.GetLogger(ctx).WithField("op", op)
+	return p.queryCategories(ctx, "CategoryRepository.GetAllSubcategories", queryGetAllSubcategories, category_id)
+}
 
-	subcategoriesList := []*models.Category{}
+func (p *CategoryRepository) GetNameSubcategory(ctx context.Context, id uuid.UUID) (string, error) {
+	const op = "CategoryRepository.GetNameSubcategory"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
 
-	rows, err := p.DB.QueryContext(ctx, queryGetAllSubcategories, category_id)
+	var name string
+	err := p.DB.QueryRowContext(ctx, queryGetNameSybcategory, id).Scan(&name)
 	if err != nil {
-		logger.WithError(err).Error("query subcategories")
-        return nil, fmt.Errorf("%s: %w", op, err)
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Warn("category not found by ID")
+			return "", fmt.Errorf("%s: %w", op, errs.NewNotFoundError(op))
+		}
+		logger.WithError(err).Error("failed to get category by ID")
+		return "", fmt.Errorf("%s: %w", op, err)
 	}
-	defer rows.Close()
 
-	for rows.Next() {
-		category := &models.Category{}
-		err = rows.Scan(
-			&category.ID,
-			&category.Name,
-		)
-		if err != nil {
-			logger.WithError(err).Error("scan subcategory row")
-            return nil, fmt.Errorf("%s: %w", op, err)
+	return name, nil
+}
+
+func (p *CategoryRepository) GetCategory(ctx context.Context, id uuid.UUID) (*models.Category, error) {
+	return p.getCategory(ctx, "CategoryRepository.GetCategory", queryGetCategory, id)
+}
+
+func (p *CategoryRepository) GetCategoryBySlug(ctx context.Context, slug string) (*models.Category, error) {
+	return p.getCategory(ctx, "CategoryRepository.GetCategoryBySlug", queryGetCategoryBySlug, slug)
+}
+
+func (p *CategoryRepository) getCategory(ctx context.Context, op, query string, arg interface{}) (*models.Category, error) {
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	category, err := scanCategory(p.DB.QueryRowContext(ctx, query, arg))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, errs.NewNotFoundError("category not found"))
+		}
+		logger.WithError(err).Error("get category")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return category, nil
+}
+
+// GetCategoryTree возвращает все узлы дерева; соседние узлы упорядочены по позиции
+func (p *CategoryRepository) GetCategoryTree(ctx context.Context) ([]*models.Category, error) {
+	return p.queryCategories(ctx, "CategoryRepository.GetCategoryTree", queryGetCategoryTree)
+}
+
+// GetBreadcrumbs возвращает цепочку категорий от корня до узла включительно
+func (p *CategoryRepository) GetBreadcrumbs(ctx context.Context, id uuid.UUID) ([]*models.Category, error) {
+	const op = "CategoryRepository.GetBreadcrumbs"
+
+	path, err := p.queryCategories(ctx, op, queryGetBreadcrumbs, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(path) == 0 {
+		return nil, fmt.Errorf("%s: %w", op, errs.NewNotFoundError("category not found"))
+	}
+
+	return path, nil
+}
+
+func (p *CategoryRepository) CreateCategory(ctx context.Context, category *models.Category) error {
+	const op = "CategoryRepository.CreateCategory"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	err := p.DB.QueryRowContext(ctx, queryCreateCategory,
+		category.ID,
+		category.Name,
+		category.ParentID,
+		category.Slug,
+		category.ImageURL,
+	).Scan(&category.Position)
+	if err != nil {
+		logger.WithError(err).Error("create category")
+		return fmt.Errorf("%s: %w", op, mapCategoryError(err))
+	}
+
+	return nil
+}
+
+func (p *CategoryRepository) UpdateCategory(ctx context.Context, category *models.Category) error {
+	const op = "CategoryRepository.UpdateCategory"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("category_id", category.ID)
+
+	res, err := p.DB.ExecContext(ctx, queryUpdateCategory,
+		category.ID,
+		category.Name,
+		category.Slug,
+		category.ImageURL,
+	)
+	if err != nil {
+		logger.WithError(err).Error("update category")
+		return fmt.Errorf("%s: %w", op, mapCategoryError(err))
+	}
+
+	return requireAffected(res, op)
+}
+
+func (p *CategoryRepository) SetCategoryImage(ctx context.Context, id uuid.UUID, imageURL string) error {
+	const op = "CategoryRepository.SetCategoryImage"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("category_id", id)
+
+	res, err := p.DB.ExecContext(ctx, querySetCategoryImage, id, imageURL)
+	if err != nil {
+		logger.WithError(err).Error("set category image")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return requireAffected(res, op)
+}
+
+// MoveCategory переносит узел к новому родителю и ставит его на позицию position
+// среди соседних узлов; позиции соседей пересчитываются подряд с нуля.
+// Перенос узла внутрь собственного поддерева запрещён: проверка выполняется
+// под блокировкой обоих узлов, поэтому встречный перенос не образует цикл.
+func (p *CategoryRepository) MoveCategory(ctx context.Context, id uuid.UUID, parentID uuid.NullUUID, position int) error {
+	const op = "CategoryRepository.MoveCategory"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("category_id", id)
+
+	tx, err := p.DB.BeginTx(ctx, nil)
+	if err != nil {
+		logger.WithError(err).Error("begin transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, queryLockMovedCategories, id, parentID); err != nil {
+		logger.WithError(err).Error("lock moved categories")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if parentID.Valid {
+		var cycle bool
+		if err = tx.QueryRowContext(ctx, queryIsDescendant, id, parentID.UUID).Scan(&cycle); err != nil {
+			logger.WithError(err).Error("check category subtree")
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if cycle {
+			return fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("category cannot be moved into its own subtree"))
 		}
-		subcategoriesList = append(subcategoriesList, category)
 	}
 
+	rows, err := tx.QueryContext(ctx, queryLockSiblings, parentID, id)
+	if err != nil {
+		logger.WithError(err).Error("lock sibling categories")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	siblings := []uuid.UUID{}
+	for rows.Next() {
+		var siblingID uuid.UUID
+		if err = rows.Scan(&siblingID); err != nil {
+			rows.Close()
+			logger.WithError(err).Error("scan sibling category")
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		siblings = append(siblings, siblingID)
+	}
+	rows.Close()
 	if err = rows.Err(); err != nil {
 		logger.WithError(err).Error("rows iteration error")
-        return nil, fmt.Errorf("%s: %w", op, err)
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	return subcategoriesList, nil
+	res, err := tx.ExecContext(ctx, queryMoveCategory, id, parentID)
+	if err != nil {
+		logger.WithError(err).Error("move category")
+		return fmt.Errorf("%s: %w", op, mapCategoryError(err))
+	}
+	if err = requireAffected(res, op); err != nil {
+		return err
+	}
+
+	if position < 0 || position > len(siblings) {
+		position = len(siblings)
+	}
+	ordered := make([]uuid.UUID, 0, len(siblings)+1)
+	ordered = append(ordered, siblings[:position]...)
+	ordered = append(ordered, id)
+	ordered = append(ordered, siblings[position:]...)
+
+	for i, categoryID := range ordered {
+		if _, err = tx.ExecContext(ctx, querySetCategoryPosition, categoryID, i); err != nil {
+			logger.WithError(err).Error("set category position")
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.WithError(err).Error("commit transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
 }
 
-func (p *CategoryRepository) GetNameSubcategory(ctx context.Context, id uuid.UUID) (string, error) {
-	const op = "CategoryRepository.GetNameSubcategory"
-    logger := logctx.GetLogger(ctx).WithField("op", op)
-	
-	var name string
-	err := p.DB.QueryRowContext(ctx, queryGetNameSybcategory, id).Scan(&name)
+func (p *CategoryRepository) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	const op = "CategoryRepository.DeleteCategory"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("category_id", id)
+
+	res, err := p.DB.ExecContext(ctx, queryDeleteCategory, id)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-            logger.Warn("product not found by ID")
-            return "", fmt.Errorf("%s: %w", op, errs.NewNotFoundError(op))
-        }
-        logger.WithError(err).Error("failed to get product by ID")
-        return "", fmt.Errorf("%s: %w", op, err)
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+			return fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("category has subcategories or products"))
+		}
+		logger.WithError(err).Error("delete category")
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	return name, nil
-}
\ No newline at end of file
+	return requireAffected(res, op)
+}
+
+// mapCategoryError переводит нарушения ограничений дерева в доменные ошибки
+func mapCategoryError(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok {
+		switch pqErr.Code {
+		case "23505":
+			return errs.NewAlreadyExistsError("category with this slug or name already exists")
+		case "23503":
+			return errs.NewNotFoundError("parent category not found")
+		}
+	}
+	return err
+}
+
+func requireAffected(res sql.Result, op string) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, errs.NewNotFoundError("category not found"))
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package currency
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+)
+
+const (
+	queryGetRate = `SELECT currency, rate, updated_at FROM bazaar.currency_rate WHERE currency = $1`
+
+	queryGetRates = `SELECT currency, rate, updated_at FROM bazaar.currency_rate ORDER BY currency`
+
+	querySaveRate = `
+		INSERT INTO bazaar.currency_rate (currency, rate, updated_at)
+		VALUES ($1, $2, now())
+		ON CONFLICT (currency) DO UPDATE SET rate = EXCLUDED.rate, updated_at = EXCLUDED.updated_at`
+)
+
+type CurrencyRepository struct {
+	db *sql.DB
+}
+
+func NewCurrencyRepository(db *sql.DB) *CurrencyRepository {
+	return &CurrencyRepository{
+		db: db,
+	}
+}
+
+func (r *CurrencyRepository) GetRate(ctx context.Context, currency models.Currency) (models.ExchangeRate, error) {
+	const op = "CurrencyRepository.GetRate"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("currency", currency)
+
+	var rate models.ExchangeRate
+	err := r.db.QueryRowContext(ctx, queryGetRate, currency).Scan(&rate.Currency, &rate.Rate, &rate.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.ExchangeRate{}, fmt.Errorf("%s: %w", op, errs.NewNotFoundError("exchange rate not found"))
+	}
+	if err != nil {
+		logger.WithError(err).Error("get exchange rate")
+		return models.ExchangeRate{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return rate, nil
+}
+
+func (r *CurrencyRepository) GetRates(ctx context.Context) ([]models.ExchangeRate, error) {
+	const op = "CurrencyRepository.GetRates"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	rows, err := r.db.QueryContext(ctx, queryGetRates)
+	if err != nil {
+		logger.WithError(err).Error("query exchange rates")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var rates []models.ExchangeRate
+	for rows.Next() {
+		var rate models.ExchangeRate
+		if err = rows.Scan(&rate.Currency, &rate.Rate, &rate.UpdatedAt); err != nil {
+			logger.WithError(err).Error("scan exchange rate")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		rates = append(rates, rate)
+	}
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return rates, nil
+}
+
+// SaveRates добавляет или обновляет курсы одной транзакцией: файл с курсами
+// применяется целиком или не применяется вовсе
+func (r *CurrencyRepository) SaveRates(ctx context.Context, rates []models.ExchangeRate) error {
+	const op = "CurrencyRepository.SaveRates"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.WithError(err).Error("begin transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	for _, rate := range rates {
+		if _, err = tx.ExecContext(ctx, querySaveRate, rate.Currency, rate.Rate); err != nil {
+			logger.WithError(err).WithField("currency", rate.Currency).Error("save exchange rate")
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.WithError(err).Error("commit transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
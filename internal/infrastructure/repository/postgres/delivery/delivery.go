@@ -0,0 +1,180 @@
+package delivery
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const (
+	// Зона с совпадающим городом важнее зоны региона, а та — зоны по умолчанию
+	queryGetAddressZone = `
+		SELECT z.id, z.name, z.region, z.city, z.lead_time_days
+		FROM bazaar.address a
+		JOIN bazaar.delivery_zone z
+			ON (z.region IS NULL OR lower(z.region) = lower(a.region))
+			AND (z.city IS NULL OR lower(z.city) = lower(a.city))
+		WHERE a.id = $1
+		ORDER BY (z.city IS NOT NULL) DESC, (z.region IS NOT NULL) DESC
+		LIMIT 1`
+
+	queryGetAvailableSlots = `
+		SELECT id, zone_id, starts_at, ends_at, capacity, reserved
+		FROM bazaar.delivery_slot
+		WHERE zone_id = $1 AND starts_at >= $2 AND starts_at < $3 AND reserved < capacity
+		ORDER BY starts_at`
+
+	queryGetSlot = `
+		SELECT id, zone_id, starts_at, ends_at, capacity, reserved
+		FROM bazaar.delivery_slot
+		WHERE id = $1`
+
+	queryCreateZone = `
+		INSERT INTO bazaar.delivery_zone (id, name, region, city, lead_time_days)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	queryCreateSlot = `
+		INSERT INTO bazaar.delivery_slot (id, zone_id, starts_at, ends_at, capacity)
+		VALUES ($1, $2, $3, $4, $5)`
+)
+
+type DeliveryRepository struct {
+	db *sql.DB
+}
+
+func NewDeliveryRepository(db *sql.DB) *DeliveryRepository {
+	return &DeliveryRepository{
+		db: db,
+	}
+}
+
+// GetAddressZone возвращает зону доставки, в которую попадает адрес
+func (r *DeliveryRepository) GetAddressZone(ctx context.Context, addressID uuid.UUID) (*models.DeliveryZone, error) {
+	const op = "DeliveryRepository.GetAddressZone"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("address_id", addressID)
+
+	var zone models.DeliveryZone
+	if err := r.db.QueryRowContext(ctx, queryGetAddressZone, addressID).Scan(
+		&zone.ID,
+		&zone.Name,
+		&zone.Region,
+		&zone.City,
+		&zone.LeadTimeDays,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, errs.NewNotFoundError("delivery zone not found"))
+		}
+		logger.WithError(err).Error("query address zone")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &zone, nil
+}
+
+// GetAvailableSlots возвращает интервалы зоны со свободными местами,
+// которые начинаются в промежутке [from, to)
+func (r *DeliveryRepository) GetAvailableSlots(ctx context.Context, zoneID uuid.UUID, from, to time.Time) ([]models.DeliverySlot, error) {
+	const op = "DeliveryRepository.GetAvailableSlots"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("zone_id", zoneID)
+
+	rows, err := r.db.QueryContext(ctx, queryGetAvailableSlots, zoneID, from, to)
+	if err != nil {
+		logger.WithError(err).Error("query available slots")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	slots := []models.DeliverySlot{}
+	for rows.Next() {
+		var slot models.DeliverySlot
+		if err = rows.Scan(
+			&slot.ID,
+			&slot.ZoneID,
+			&slot.StartsAt,
+			&slot.EndsAt,
+			&slot.Capacity,
+			&slot.Reserved,
+		); err != nil {
+			logger.WithError(err).Error("scan slot")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		slots = append(slots, slot)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("iterate slots")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return slots, nil
+}
+
+func (r *DeliveryRepository) GetSlot(ctx context.Context, slotID uuid.UUID) (*models.DeliverySlot, error) {
+	const op = "DeliveryRepository.GetSlot"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("slot_id", slotID)
+
+	var slot models.DeliverySlot
+	if err := r.db.QueryRowContext(ctx, queryGetSlot, slotID).Scan(
+		&slot.ID,
+		&slot.ZoneID,
+		&slot.StartsAt,
+		&slot.EndsAt,
+		&slot.Capacity,
+		&slot.Reserved,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, errs.NewNotFoundError("delivery slot not found"))
+		}
+		logger.WithError(err).Error("query slot")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &slot, nil
+}
+
+func (r *DeliveryRepository) CreateZone(ctx context.Context, zone models.DeliveryZone) error {
+	const op = "DeliveryRepository.CreateZone"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	if _, err := r.db.ExecContext(ctx, queryCreateZone,
+		zone.ID, zone.Name, zone.Region, zone.City, zone.LeadTimeDays,
+	); err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return fmt.Errorf("%s: %w", op, errs.NewAlreadyExistsError("delivery zone already exists"))
+		}
+		logger.WithError(err).Error("create zone")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *DeliveryRepository) CreateSlot(ctx context.Context, slot models.DeliverySlot) error {
+	const op = "DeliveryRepository.CreateSlot"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	if _, err := r.db.ExecContext(ctx, queryCreateSlot,
+		slot.ID, slot.ZoneID, slot.StartsAt, slot.EndsAt, slot.Capacity,
+	); err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Code {
+			case "23505":
+				return fmt.Errorf("%s: %w", op, errs.NewAlreadyExistsError("delivery slot already exists"))
+			case "23503":
+				return fmt.Errorf("%s: %w", op, errs.NewNotFoundError("delivery zone not found"))
+			}
+		}
+		logger.WithError(err).Error("create slot")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
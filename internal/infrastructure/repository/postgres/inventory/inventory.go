@@ -0,0 +1,372 @@
+package inventory
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/google/uuid"
+)
+
+const movementsPageSize = 20
+
+const (
+	// Настройки действуют до конца транзакции; их читают триггеры журнала остатков
+	querySetStockContext = `
+		SELECT set_config('bazaar.stock_kind', $1, true),
+			set_config('bazaar.stock_actor', $2, true),
+			set_config('bazaar.stock_order', $3, true),
+			set_config('bazaar.stock_reason', $4, true)`
+
+	// Строка товара не блокируется: остаток товара с вариантами пересчитывает
+	// триггер после изменения SKU, и блокировка здесь нарушила бы порядок,
+	// в котором строки блокирует резервирование
+	queryGetStockOwner = `
+		SELECT p.quantity, EXISTS (SELECT 1 FROM bazaar.product_variant v WHERE v.product_id = p.id)
+		FROM bazaar.product p
+		WHERE p.id = $1 AND p.seller_id = $2`
+
+	queryLockProductStock = `SELECT quantity FROM bazaar.product WHERE id = $1 FOR UPDATE`
+
+	queryLockVariantStock = `SELECT quantity FROM bazaar.product_variant WHERE id = $1 AND product_id = $2 FOR UPDATE`
+
+	queryAdjustProductStock = `UPDATE bazaar.product SET quantity = quantity + $2 WHERE id = $1`
+
+	queryAdjustVariantStock = `UPDATE bazaar.product_variant SET quantity = quantity + $2 WHERE id = $1`
+
+	movementColumns = `id, product_id, variant_id, delta, balance, kind, actor_id, order_id, COALESCE(reason, ''), created_at`
+
+	queryGetLastMovement = `
+		SELECT ` + movementColumns + `
+		FROM bazaar.stock_movement
+		WHERE product_id = $1 AND variant_id IS NOT DISTINCT FROM $2
+		ORDER BY id DESC
+		LIMIT 1`
+
+	queryGetMovements = `
+		SELECT m.id, m.product_id, m.variant_id, m.delta, m.balance, m.kind, m.actor_id, m.order_id,
+			COALESCE(m.reason, ''), m.created_at
+		FROM bazaar.stock_movement m
+		JOIN bazaar.product p ON p.id = m.product_id
+		WHERE m.product_id = $1 AND p.seller_id = $2
+		ORDER BY m.id DESC
+		LIMIT $3 OFFSET $4`
+
+	querySetLowStockThreshold = `
+		UPDATE bazaar.product
+		SET low_stock_threshold = $3
+		WHERE id = $1 AND seller_id = $2`
+
+	// Единица учёта — товар без вариантов или SKU; её остаток должен совпадать
+	// с суммой движений в журнале
+	queryGetDiscrepancies = `
+		WITH units AS (
+			SELECT p.id AS product_id, NULL::uuid AS variant_id, p.name, COALESCE(p.sku, '') AS sku, p.quantity
+			FROM bazaar.product p
+			WHERE p.seller_id = $1
+				AND NOT EXISTS (SELECT 1 FROM bazaar.product_variant v WHERE v.product_id = p.id)
+			UNION ALL
+			SELECT v.product_id, v.id, p.name, v.sku, v.quantity
+			FROM bazaar.product_variant v
+			JOIN bazaar.product p ON p.id = v.product_id
+			WHERE p.seller_id = $1
+		)
+		SELECT u.product_id, u.variant_id, u.name, u.sku, u.quantity, COALESCE(SUM(m.delta), 0)
+		FROM units u
+		LEFT JOIN bazaar.stock_movement m
+			ON m.product_id = u.product_id AND m.variant_id IS NOT DISTINCT FROM u.variant_id
+		GROUP BY u.product_id, u.variant_id, u.name, u.sku, u.quantity
+		HAVING u.quantity <> COALESCE(SUM(m.delta), 0)
+		ORDER BY u.name, u.sku`
+
+	// Забирает пачку неотправленных уведомлений о низком остатке. SKIP LOCKED
+	// позволяет нескольким экземплярам приложения рассылать их параллельно.
+	queryClaimLowStockAlerts = `
+		WITH claimed AS (
+			UPDATE bazaar.low_stock_alert
+			SET notified_at = now()
+			WHERE id IN (
+				SELECT id FROM bazaar.low_stock_alert
+				WHERE notified_at IS NULL
+				ORDER BY created_at
+				LIMIT $1
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id, product_id, variant_id, quantity, threshold
+		)
+		SELECT c.id, p.seller_id, c.product_id, c.variant_id, p.name, COALESCE(v.sku, p.sku, ''),
+			c.quantity, c.threshold
+		FROM claimed c
+		JOIN bazaar.product p ON p.id = c.product_id
+		LEFT JOIN bazaar.product_variant v ON v.id = c.variant_id`
+)
+
+type InventoryRepository struct {
+	db *sql.DB
+}
+
+func NewInventoryRepository(db *sql.DB) *InventoryRepository {
+	return &InventoryRepository{
+		db: db,
+	}
+}
+
+// SetStockContext передаёт триггерам журнала вид, автора, заказ и причину
+// изменений остатка, которые будут сделаны в транзакции дальше. Контекст
+// можно сменить повторным вызовом.
+func SetStockContext(ctx context.Context, tx *sql.Tx, change models.StockChange) error {
+	var actor, order string
+	if change.ActorID.Valid {
+		actor = change.ActorID.UUID.String()
+	}
+	if change.OrderID.Valid {
+		order = change.OrderID.UUID.String()
+	}
+
+	_, err := tx.ExecContext(ctx, querySetStockContext, string(change.Kind), actor, order, change.Reason)
+	return err
+}
+
+// AdjustStock изменяет остаток товара без вариантов или SKU продавца на delta
+// и возвращает запись журнала об этом изменении
+func (r *InventoryRepository) AdjustStock(
+	ctx context.Context,
+	sellerID, productID uuid.UUID,
+	variantID uuid.NullUUID,
+	delta int,
+	change models.StockChange,
+) (*models.StockMovement, error) {
+	const op = "InventoryRepository.AdjustStock"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("product_id", productID)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.WithError(err).Error("begin transaction")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var (
+		quantity    int
+		hasVariants bool
+	)
+	if err = tx.QueryRowContext(ctx, queryGetStockOwner, productID, sellerID).Scan(&quantity, &hasVariants); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, errs.NewNotFoundError("product not found"))
+		}
+		logger.WithError(err).Error("get stock owner")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	switch {
+	case variantID.Valid:
+		err = tx.QueryRowContext(ctx, queryLockVariantStock, variantID.UUID, productID).Scan(&quantity)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, errs.NewNotFoundError("product variant not found"))
+		}
+	case hasVariants:
+		return nil, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("product stock is kept per variant"))
+	default:
+		err = tx.QueryRowContext(ctx, queryLockProductStock, productID).Scan(&quantity)
+	}
+	if err != nil {
+		logger.WithError(err).Error("lock stock")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if quantity+delta < 0 {
+		return nil, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("stock cannot become negative"))
+	}
+
+	if err = SetStockContext(ctx, tx, change); err != nil {
+		logger.WithError(err).Error("set stock context")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if variantID.Valid {
+		_, err = tx.ExecContext(ctx, queryAdjustVariantStock, variantID.UUID, delta)
+	} else {
+		_, err = tx.ExecContext(ctx, queryAdjustProductStock, productID, delta)
+	}
+	if err != nil {
+		logger.WithError(err).Error("adjust stock")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	movement, err := scanMovement(tx.QueryRowContext(ctx, queryGetLastMovement, productID, variantID))
+	if err != nil {
+		logger.WithError(err).Error("get stock movement")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.WithError(err).Error("commit transaction")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return movement, nil
+}
+
+// GetMovements возвращает страницу журнала остатков товара продавца, новые записи первыми
+func (r *InventoryRepository) GetMovements(ctx context.Context, sellerID, productID uuid.UUID, offset int) ([]models.StockMovement, error) {
+	const op = "InventoryRepository.GetMovements"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("product_id", productID)
+
+	rows, err := r.db.QueryContext(ctx, queryGetMovements, productID, sellerID, movementsPageSize, offset)
+	if err != nil {
+		logger.WithError(err).Error("query stock movements")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	movements := make([]models.StockMovement, 0)
+	for rows.Next() {
+		movement, err := scanMovement(rows)
+		if err != nil {
+			logger.WithError(err).Error("scan stock movement")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		movements = append(movements, *movement)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return movements, nil
+}
+
+// SetLowStockThreshold задаёт порог низкого остатка товара продавца;
+// невалидный threshold снимает порог
+func (r *InventoryRepository) SetLowStockThreshold(ctx context.Context, sellerID, productID uuid.UUID, threshold sql.NullInt64) error {
+	const op = "InventoryRepository.SetLowStockThreshold"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("product_id", productID)
+
+	res, err := r.db.ExecContext(ctx, querySetLowStockThreshold, productID, sellerID, threshold)
+	if err != nil {
+		logger.WithError(err).Error("set low stock threshold")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		logger.WithError(err).Error("get rows affected")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, errs.NewNotFoundError("product not found"))
+	}
+
+	return nil
+}
+
+// GetDiscrepancies возвращает товары и SKU продавца, остаток которых не сходится с журналом
+func (r *InventoryRepository) GetDiscrepancies(ctx context.Context, sellerID uuid.UUID) ([]models.StockDiscrepancy, error) {
+	const op = "InventoryRepository.GetDiscrepancies"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("seller_id", sellerID)
+
+	rows, err := r.db.QueryContext(ctx, queryGetDiscrepancies, sellerID)
+	if err != nil {
+		logger.WithError(err).Error("query stock discrepancies")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	discrepancies := make([]models.StockDiscrepancy, 0)
+	for rows.Next() {
+		var d models.StockDiscrepancy
+		if err = rows.Scan(
+			&d.ProductID,
+			&d.VariantID,
+			&d.Name,
+			&d.SKU,
+			&d.Quantity,
+			&d.LedgerBalance,
+		); err != nil {
+			logger.WithError(err).Error("scan stock discrepancy")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		discrepancies = append(discrepancies, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return discrepancies, nil
+}
+
+// ClaimLowStockAlerts помечает отправленными не более limit уведомлений о низком
+// остатке и возвращает их. Уведомление, которое не удалось доставить после
+// этого, повторно не отправляется.
+func (r *InventoryRepository) ClaimLowStockAlerts(ctx context.Context, limit int) ([]models.LowStockAlert, error) {
+	const op = "InventoryRepository.ClaimLowStockAlerts"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	rows, err := r.db.QueryContext(ctx, queryClaimLowStockAlerts, limit)
+	if err != nil {
+		logger.WithError(err).Error("claim low stock alerts")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var alerts []models.LowStockAlert
+	for rows.Next() {
+		var alert models.LowStockAlert
+		if err = rows.Scan(
+			&alert.ID,
+			&alert.SellerID,
+			&alert.ProductID,
+			&alert.VariantID,
+			&alert.ProductName,
+			&alert.SKU,
+			&alert.Quantity,
+			&alert.Threshold,
+		); err != nil {
+			logger.WithError(err).Error("scan low stock alert")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		alerts = append(alerts, alert)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return alerts, nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanMovement(row scanner) (*models.StockMovement, error) {
+	var (
+		movement models.StockMovement
+		kind     string
+	)
+	if err := row.Scan(
+		&movement.ID,
+		&movement.ProductID,
+		&movement.VariantID,
+		&movement.Delta,
+		&movement.Balance,
+		&kind,
+		&movement.ActorID,
+		&movement.OrderID,
+		&movement.Reason,
+		&movement.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	movement.Kind = models.StockMovementKind(kind)
+
+	return &movement, nil
+}
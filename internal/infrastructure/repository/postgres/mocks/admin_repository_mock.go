@@ -51,6 +51,21 @@ func (mr *MockIAdminRepositoryMockRecorder) GetPendingProducts(ctx, offset inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingProducts", reflect.TypeOf((*MockIAdminRepository)(nil).GetPendingProducts), ctx, offset)
 }
 
+// GetPendingReviews mocks base method.
+func (m *MockIAdminRepository) GetPendingReviews(ctx context.Context, offset int) ([]*models.ModerationReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingReviews", ctx, offset)
+	ret0, _ := ret[0].([]*models.ModerationReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingReviews indicates an expected call of GetPendingReviews.
+func (mr *MockIAdminRepositoryMockRecorder) GetPendingReviews(ctx, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingReviews", reflect.TypeOf((*MockIAdminRepository)(nil).GetPendingReviews), ctx, offset)
+}
+
 // GetPendingUsers mocks base method.
 func (m *MockIAdminRepository) GetPendingUsers(ctx context.Context, offset int) ([]*models.User, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +95,21 @@ func (mr *MockIAdminRepositoryMockRecorder) UpdateProductStatus(ctx, productID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductStatus", reflect.TypeOf((*MockIAdminRepository)(nil).UpdateProductStatus), ctx, productID, status)
 }
 
+// UpdateReviewStatus mocks base method.
+func (m *MockIAdminRepository) UpdateReviewStatus(ctx context.Context, reviewID uuid.UUID, status models.ReviewStatus) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReviewStatus", ctx, reviewID, status)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReviewStatus indicates an expected call of UpdateReviewStatus.
+func (mr *MockIAdminRepositoryMockRecorder) UpdateReviewStatus(ctx, reviewID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReviewStatus", reflect.TypeOf((*MockIAdminRepository)(nil).UpdateReviewStatus), ctx, reviewID, status)
+}
+
 // UpdateUserRole mocks base method.
 func (m *MockIAdminRepository) UpdateUserRole(ctx context.Context, userID uuid.UUID, role models.UserRole) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: analytics.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIAnalyticsRepository is a mock of IAnalyticsRepository interface.
+type MockIAnalyticsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAnalyticsRepositoryMockRecorder
+}
+
+// MockIAnalyticsRepositoryMockRecorder is the mock recorder for MockIAnalyticsRepository.
+type MockIAnalyticsRepositoryMockRecorder struct {
+	mock *MockIAnalyticsRepository
+}
+
+// NewMockIAnalyticsRepository creates a new mock instance.
+func NewMockIAnalyticsRepository(ctrl *gomock.Controller) *MockIAnalyticsRepository {
+	mock := &MockIAnalyticsRepository{ctrl: ctrl}
+	mock.recorder = &MockIAnalyticsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAnalyticsRepository) EXPECT() *MockIAnalyticsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetSalesStats mocks base method.
+func (m *MockIAnalyticsRepository) GetSalesStats(ctx context.Context, sellerID uuid.UUID, from, to time.Time, bucket models.AnalyticsBucket) ([]models.SalesStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSalesStats", ctx, sellerID, from, to, bucket)
+	ret0, _ := ret[0].([]models.SalesStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSalesStats indicates an expected call of GetSalesStats.
+func (mr *MockIAnalyticsRepositoryMockRecorder) GetSalesStats(ctx, sellerID, from, to, bucket interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSalesStats", reflect.TypeOf((*MockIAnalyticsRepository)(nil).GetSalesStats), ctx, sellerID, from, to, bucket)
+}
+
+// GetStockOuts mocks base method.
+func (m *MockIAnalyticsRepository) GetStockOuts(ctx context.Context, sellerID uuid.UUID, from, to time.Time, offset int) ([]models.StockOutProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStockOuts", ctx, sellerID, from, to, offset)
+	ret0, _ := ret[0].([]models.StockOutProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStockOuts indicates an expected call of GetStockOuts.
+func (mr *MockIAnalyticsRepositoryMockRecorder) GetStockOuts(ctx, sellerID, from, to, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStockOuts", reflect.TypeOf((*MockIAnalyticsRepository)(nil).GetStockOuts), ctx, sellerID, from, to, offset)
+}
+
+// GetTopProducts mocks base method.
+func (m *MockIAnalyticsRepository) GetTopProducts(ctx context.Context, sellerID uuid.UUID, from, to time.Time, limit int) ([]models.TopProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopProducts", ctx, sellerID, from, to, limit)
+	ret0, _ := ret[0].([]models.TopProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopProducts indicates an expected call of GetTopProducts.
+func (mr *MockIAnalyticsRepositoryMockRecorder) GetTopProducts(ctx, sellerID, from, to, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopProducts", reflect.TypeOf((*MockIAnalyticsRepository)(nil).GetTopProducts), ctx, sellerID, from, to, limit)
+}
+
+// RefreshRollup mocks base method.
+func (m *MockIAnalyticsRepository) RefreshRollup(ctx context.Context, lookbackDays int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshRollup", ctx, lookbackDays)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshRollup indicates an expected call of RefreshRollup.
+func (mr *MockIAnalyticsRepositoryMockRecorder) RefreshRollup(ctx, lookbackDays interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshRollup", reflect.TypeOf((*MockIAnalyticsRepository)(nil).RefreshRollup), ctx, lookbackDays)
+}
@@ -0,0 +1,139 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: attribute.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIAttributeRepository is a mock of IAttributeRepository interface.
+type MockIAttributeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAttributeRepositoryMockRecorder
+}
+
+// MockIAttributeRepositoryMockRecorder is the mock recorder for MockIAttributeRepository.
+type MockIAttributeRepositoryMockRecorder struct {
+	mock *MockIAttributeRepository
+}
+
+// NewMockIAttributeRepository creates a new mock instance.
+func NewMockIAttributeRepository(ctrl *gomock.Controller) *MockIAttributeRepository {
+	mock := &MockIAttributeRepository{ctrl: ctrl}
+	mock.recorder = &MockIAttributeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAttributeRepository) EXPECT() *MockIAttributeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateAttribute mocks base method.
+func (m *MockIAttributeRepository) CreateAttribute(ctx context.Context, attribute *models.CategoryAttribute) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttribute", ctx, attribute)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAttribute indicates an expected call of CreateAttribute.
+func (mr *MockIAttributeRepositoryMockRecorder) CreateAttribute(ctx, attribute interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttribute", reflect.TypeOf((*MockIAttributeRepository)(nil).CreateAttribute), ctx, attribute)
+}
+
+// DeleteAttribute mocks base method.
+func (m *MockIAttributeRepository) DeleteAttribute(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttribute", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttribute indicates an expected call of DeleteAttribute.
+func (mr *MockIAttributeRepositoryMockRecorder) DeleteAttribute(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttribute", reflect.TypeOf((*MockIAttributeRepository)(nil).DeleteAttribute), ctx, id)
+}
+
+// GetAttribute mocks base method.
+func (m *MockIAttributeRepository) GetAttribute(ctx context.Context, id uuid.UUID) (*models.CategoryAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttribute", ctx, id)
+	ret0, _ := ret[0].(*models.CategoryAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttribute indicates an expected call of GetAttribute.
+func (mr *MockIAttributeRepositoryMockRecorder) GetAttribute(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttribute", reflect.TypeOf((*MockIAttributeRepository)(nil).GetAttribute), ctx, id)
+}
+
+// GetCategoryAttributes mocks base method.
+func (m *MockIAttributeRepository) GetCategoryAttributes(ctx context.Context, categoryID uuid.UUID) ([]models.CategoryAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryAttributes", ctx, categoryID)
+	ret0, _ := ret[0].([]models.CategoryAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryAttributes indicates an expected call of GetCategoryAttributes.
+func (mr *MockIAttributeRepositoryMockRecorder) GetCategoryAttributes(ctx, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryAttributes", reflect.TypeOf((*MockIAttributeRepository)(nil).GetCategoryAttributes), ctx, categoryID)
+}
+
+// GetProductAttributes mocks base method.
+func (m *MockIAttributeRepository) GetProductAttributes(ctx context.Context, productID uuid.UUID) ([]models.ProductSpecification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductAttributes", ctx, productID)
+	ret0, _ := ret[0].([]models.ProductSpecification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductAttributes indicates an expected call of GetProductAttributes.
+func (mr *MockIAttributeRepositoryMockRecorder) GetProductAttributes(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductAttributes", reflect.TypeOf((*MockIAttributeRepository)(nil).GetProductAttributes), ctx, productID)
+}
+
+// GetUsedAttributeValues mocks base method.
+func (m *MockIAttributeRepository) GetUsedAttributeValues(ctx context.Context, id uuid.UUID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsedAttributeValues", ctx, id)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsedAttributeValues indicates an expected call of GetUsedAttributeValues.
+func (mr *MockIAttributeRepositoryMockRecorder) GetUsedAttributeValues(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsedAttributeValues", reflect.TypeOf((*MockIAttributeRepository)(nil).GetUsedAttributeValues), ctx, id)
+}
+
+// UpdateAttribute mocks base method.
+func (m *MockIAttributeRepository) UpdateAttribute(ctx context.Context, attribute *models.CategoryAttribute) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttribute", ctx, attribute)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAttribute indicates an expected call of UpdateAttribute.
+func (mr *MockIAttributeRepositoryMockRecorder) UpdateAttribute(ctx, attribute interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttribute", reflect.TypeOf((*MockIAttributeRepository)(nil).UpdateAttribute), ctx, attribute)
+}
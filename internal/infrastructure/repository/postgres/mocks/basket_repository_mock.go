@@ -37,18 +37,18 @@ func (m *MockIBasketRepository) EXPECT() *MockIBasketRepositoryMockRecorder {
 }
 
 // Add mocks base method.
-func (m *MockIBasketRepository) Add(ctx context.Context, userID, productID uuid.UUID) (*models.BasketItem, error) {
+func (m *MockIBasketRepository) Add(ctx context.Context, userID, productID uuid.UUID, variantID uuid.NullUUID) (*models.BasketItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Add", ctx, userID, productID)
+	ret := m.ctrl.Call(m, "Add", ctx, userID, productID, variantID)
 	ret0, _ := ret[0].(*models.BasketItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Add indicates an expected call of Add.
-func (mr *MockIBasketRepositoryMockRecorder) Add(ctx, userID, productID interface{}) *gomock.Call {
+func (mr *MockIBasketRepositoryMockRecorder) Add(ctx, userID, productID, variantID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockIBasketRepository)(nil).Add), ctx, userID, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockIBasketRepository)(nil).Add), ctx, userID, productID, variantID)
 }
 
 // Clear mocks base method.
@@ -66,17 +66,17 @@ func (mr *MockIBasketRepositoryMockRecorder) Clear(ctx, userID interface{}) *gom
 }
 
 // Delete mocks base method.
-func (m *MockIBasketRepository) Delete(ctx context.Context, userID, productID uuid.UUID) error {
+func (m *MockIBasketRepository) Delete(ctx context.Context, userID, productID uuid.UUID, variantID uuid.NullUUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, userID, productID)
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, productID, variantID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockIBasketRepositoryMockRecorder) Delete(ctx, userID, productID interface{}) *gomock.Call {
+func (mr *MockIBasketRepositoryMockRecorder) Delete(ctx, userID, productID, variantID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIBasketRepository)(nil).Delete), ctx, userID, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIBasketRepository)(nil).Delete), ctx, userID, productID, variantID)
 }
 
 // Get mocks base method.
@@ -95,16 +95,206 @@ func (mr *MockIBasketRepositoryMockRecorder) Get(ctx, userID interface{}) *gomoc
 }
 
 // UpdateQuantity mocks base method.
-func (m *MockIBasketRepository) UpdateQuantity(ctx context.Context, userID, productID uuid.UUID, quantity int) (*models.BasketItem, error) {
+func (m *MockIBasketRepository) UpdateQuantity(ctx context.Context, userID, productID uuid.UUID, variantID uuid.NullUUID, quantity int) (*models.BasketItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateQuantity", ctx, userID, productID, quantity)
+	ret := m.ctrl.Call(m, "UpdateQuantity", ctx, userID, productID, variantID, quantity)
 	ret0, _ := ret[0].(*models.BasketItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateQuantity indicates an expected call of UpdateQuantity.
-func (mr *MockIBasketRepositoryMockRecorder) UpdateQuantity(ctx, userID, productID, quantity interface{}) *gomock.Call {
+func (mr *MockIBasketRepositoryMockRecorder) UpdateQuantity(ctx, userID, productID, variantID, quantity interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQuantity", reflect.TypeOf((*MockIBasketRepository)(nil).UpdateQuantity), ctx, userID, productID, quantity)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQuantity", reflect.TypeOf((*MockIBasketRepository)(nil).UpdateQuantity), ctx, userID, productID, variantID, quantity)
+}
+
+// UpdateTotals mocks base method.
+func (m *MockIBasketRepository) UpdateTotals(ctx context.Context, userID uuid.UUID, totalPrice, totalPriceDiscount models.Money) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTotals", ctx, userID, totalPrice, totalPriceDiscount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTotals indicates an expected call of UpdateTotals.
+func (mr *MockIBasketRepositoryMockRecorder) UpdateTotals(ctx, userID, totalPrice, totalPriceDiscount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTotals", reflect.TypeOf((*MockIBasketRepository)(nil).UpdateTotals), ctx, userID, totalPrice, totalPriceDiscount)
+}
+
+// MockIBasketMergeRepository is a mock of IBasketMergeRepository interface.
+type MockIBasketMergeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBasketMergeRepositoryMockRecorder
+}
+
+// MockIBasketMergeRepositoryMockRecorder is the mock recorder for MockIBasketMergeRepository.
+type MockIBasketMergeRepositoryMockRecorder struct {
+	mock *MockIBasketMergeRepository
+}
+
+// NewMockIBasketMergeRepository creates a new mock instance.
+func NewMockIBasketMergeRepository(ctrl *gomock.Controller) *MockIBasketMergeRepository {
+	mock := &MockIBasketMergeRepository{ctrl: ctrl}
+	mock.recorder = &MockIBasketMergeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBasketMergeRepository) EXPECT() *MockIBasketMergeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockIBasketMergeRepository) Add(ctx context.Context, userID, productID uuid.UUID, variantID uuid.NullUUID) (*models.BasketItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, userID, productID, variantID)
+	ret0, _ := ret[0].(*models.BasketItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockIBasketMergeRepositoryMockRecorder) Add(ctx, userID, productID, variantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockIBasketMergeRepository)(nil).Add), ctx, userID, productID, variantID)
+}
+
+// Clear mocks base method.
+func (m *MockIBasketMergeRepository) Clear(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clear", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Clear indicates an expected call of Clear.
+func (mr *MockIBasketMergeRepositoryMockRecorder) Clear(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clear", reflect.TypeOf((*MockIBasketMergeRepository)(nil).Clear), ctx, userID)
+}
+
+// Delete mocks base method.
+func (m *MockIBasketMergeRepository) Delete(ctx context.Context, userID, productID uuid.UUID, variantID uuid.NullUUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, productID, variantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIBasketMergeRepositoryMockRecorder) Delete(ctx, userID, productID, variantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIBasketMergeRepository)(nil).Delete), ctx, userID, productID, variantID)
+}
+
+// Get mocks base method.
+func (m *MockIBasketMergeRepository) Get(ctx context.Context, userID uuid.UUID) ([]*models.BasketItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID)
+	ret0, _ := ret[0].([]*models.BasketItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockIBasketMergeRepositoryMockRecorder) Get(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIBasketMergeRepository)(nil).Get), ctx, userID)
+}
+
+// Merge mocks base method.
+func (m *MockIBasketMergeRepository) Merge(ctx context.Context, userID uuid.UUID, items []models.PricingItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", ctx, userID, items)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockIBasketMergeRepositoryMockRecorder) Merge(ctx, userID, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockIBasketMergeRepository)(nil).Merge), ctx, userID, items)
+}
+
+// UpdateQuantity mocks base method.
+func (m *MockIBasketMergeRepository) UpdateQuantity(ctx context.Context, userID, productID uuid.UUID, variantID uuid.NullUUID, quantity int) (*models.BasketItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateQuantity", ctx, userID, productID, variantID, quantity)
+	ret0, _ := ret[0].(*models.BasketItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateQuantity indicates an expected call of UpdateQuantity.
+func (mr *MockIBasketMergeRepositoryMockRecorder) UpdateQuantity(ctx, userID, productID, variantID, quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQuantity", reflect.TypeOf((*MockIBasketMergeRepository)(nil).UpdateQuantity), ctx, userID, productID, variantID, quantity)
+}
+
+// UpdateTotals mocks base method.
+func (m *MockIBasketMergeRepository) UpdateTotals(ctx context.Context, userID uuid.UUID, totalPrice, totalPriceDiscount models.Money) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTotals", ctx, userID, totalPrice, totalPriceDiscount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTotals indicates an expected call of UpdateTotals.
+func (mr *MockIBasketMergeRepositoryMockRecorder) UpdateTotals(ctx, userID, totalPrice, totalPriceDiscount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTotals", reflect.TypeOf((*MockIBasketMergeRepository)(nil).UpdateTotals), ctx, userID, totalPrice, totalPriceDiscount)
+}
+
+// MockIPricingEngine is a mock of IPricingEngine interface.
+type MockIPricingEngine struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPricingEngineMockRecorder
+}
+
+// MockIPricingEngineMockRecorder is the mock recorder for MockIPricingEngine.
+type MockIPricingEngineMockRecorder struct {
+	mock *MockIPricingEngine
+}
+
+// NewMockIPricingEngine creates a new mock instance.
+func NewMockIPricingEngine(ctrl *gomock.Controller) *MockIPricingEngine {
+	mock := &MockIPricingEngine{ctrl: ctrl}
+	mock.recorder = &MockIPricingEngineMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPricingEngine) EXPECT() *MockIPricingEngineMockRecorder {
+	return m.recorder
+}
+
+// ApplyPromo mocks base method.
+func (m *MockIPricingEngine) ApplyPromo(ctx context.Context, userID uuid.UUID, code string, quote *models.Quote) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyPromo", ctx, userID, code, quote)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyPromo indicates an expected call of ApplyPromo.
+func (mr *MockIPricingEngineMockRecorder) ApplyPromo(ctx, userID, code, quote interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyPromo", reflect.TypeOf((*MockIPricingEngine)(nil).ApplyPromo), ctx, userID, code, quote)
+}
+
+// Price mocks base method.
+func (m *MockIPricingEngine) Price(ctx context.Context, items []models.PricingItem) (*models.Quote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Price", ctx, items)
+	ret0, _ := ret[0].(*models.Quote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Price indicates an expected call of Price.
+func (mr *MockIPricingEngineMockRecorder) Price(ctx, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Price", reflect.TypeOf((*MockIPricingEngine)(nil).Price), ctx, items)
 }
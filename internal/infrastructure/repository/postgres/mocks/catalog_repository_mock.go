@@ -0,0 +1,171 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: catalog.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockICatalogRepository is a mock of ICatalogRepository interface.
+type MockICatalogRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockICatalogRepositoryMockRecorder
+}
+
+// MockICatalogRepositoryMockRecorder is the mock recorder for MockICatalogRepository.
+type MockICatalogRepositoryMockRecorder struct {
+	mock *MockICatalogRepository
+}
+
+// NewMockICatalogRepository creates a new mock instance.
+func NewMockICatalogRepository(ctrl *gomock.Controller) *MockICatalogRepository {
+	mock := &MockICatalogRepository{ctrl: ctrl}
+	mock.recorder = &MockICatalogRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICatalogRepository) EXPECT() *MockICatalogRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimImport mocks base method.
+func (m *MockICatalogRepository) ClaimImport(ctx context.Context, staleAfter time.Duration) (*models.CatalogImport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimImport", ctx, staleAfter)
+	ret0, _ := ret[0].(*models.CatalogImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimImport indicates an expected call of ClaimImport.
+func (mr *MockICatalogRepositoryMockRecorder) ClaimImport(ctx, staleAfter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimImport", reflect.TypeOf((*MockICatalogRepository)(nil).ClaimImport), ctx, staleAfter)
+}
+
+// CreateImport mocks base method.
+func (m *MockICatalogRepository) CreateImport(ctx context.Context, job *models.CatalogImport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImport", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateImport indicates an expected call of CreateImport.
+func (mr *MockICatalogRepositoryMockRecorder) CreateImport(ctx, job interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImport", reflect.TypeOf((*MockICatalogRepository)(nil).CreateImport), ctx, job)
+}
+
+// ExportProducts mocks base method.
+func (m *MockICatalogRepository) ExportProducts(ctx context.Context, sellerID uuid.UUID) ([]models.CatalogRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportProducts", ctx, sellerID)
+	ret0, _ := ret[0].([]models.CatalogRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportProducts indicates an expected call of ExportProducts.
+func (mr *MockICatalogRepositoryMockRecorder) ExportProducts(ctx, sellerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportProducts", reflect.TypeOf((*MockICatalogRepository)(nil).ExportProducts), ctx, sellerID)
+}
+
+// FindCategories mocks base method.
+func (m *MockICatalogRepository) FindCategories(ctx context.Context, names []string) (map[string][]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCategories", ctx, names)
+	ret0, _ := ret[0].(map[string][]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCategories indicates an expected call of FindCategories.
+func (mr *MockICatalogRepositoryMockRecorder) FindCategories(ctx, names interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCategories", reflect.TypeOf((*MockICatalogRepository)(nil).FindCategories), ctx, names)
+}
+
+// FinishImport mocks base method.
+func (m *MockICatalogRepository) FinishImport(ctx context.Context, job *models.CatalogImport, rowErrors []models.ImportRowError) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishImport", ctx, job, rowErrors)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishImport indicates an expected call of FinishImport.
+func (mr *MockICatalogRepositoryMockRecorder) FinishImport(ctx, job, rowErrors interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishImport", reflect.TypeOf((*MockICatalogRepository)(nil).FinishImport), ctx, job, rowErrors)
+}
+
+// GetImport mocks base method.
+func (m *MockICatalogRepository) GetImport(ctx context.Context, id, sellerID uuid.UUID) (*models.CatalogImport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImport", ctx, id, sellerID)
+	ret0, _ := ret[0].(*models.CatalogImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImport indicates an expected call of GetImport.
+func (mr *MockICatalogRepositoryMockRecorder) GetImport(ctx, id, sellerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImport", reflect.TypeOf((*MockICatalogRepository)(nil).GetImport), ctx, id, sellerID)
+}
+
+// GetImportErrors mocks base method.
+func (m *MockICatalogRepository) GetImportErrors(ctx context.Context, id uuid.UUID) ([]models.ImportRowError, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImportErrors", ctx, id)
+	ret0, _ := ret[0].([]models.ImportRowError)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImportErrors indicates an expected call of GetImportErrors.
+func (mr *MockICatalogRepositoryMockRecorder) GetImportErrors(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImportErrors", reflect.TypeOf((*MockICatalogRepository)(nil).GetImportErrors), ctx, id)
+}
+
+// GetProductCategories mocks base method.
+func (m *MockICatalogRepository) GetProductCategories(ctx context.Context, sellerID uuid.UUID, skus []string) (map[string]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductCategories", ctx, sellerID, skus)
+	ret0, _ := ret[0].(map[string]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductCategories indicates an expected call of GetProductCategories.
+func (mr *MockICatalogRepositoryMockRecorder) GetProductCategories(ctx, sellerID, skus interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductCategories", reflect.TypeOf((*MockICatalogRepository)(nil).GetProductCategories), ctx, sellerID, skus)
+}
+
+// UpsertProduct mocks base method.
+func (m *MockICatalogRepository) UpsertProduct(ctx context.Context, sellerID uuid.UUID, row models.CatalogRow, categoryID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertProduct", ctx, sellerID, row, categoryID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertProduct indicates an expected call of UpsertProduct.
+func (mr *MockICatalogRepositoryMockRecorder) UpsertProduct(ctx, sellerID, row, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertProduct", reflect.TypeOf((*MockICatalogRepository)(nil).UpsertProduct), ctx, sellerID, row, categoryID)
+}
@@ -36,6 +36,34 @@ func (m *MockICategoryRepository) EXPECT() *MockICategoryRepositoryMockRecorder
 	return m.recorder
 }
 
+// CreateCategory mocks base method.
+func (m *MockICategoryRepository) CreateCategory(ctx context.Context, category *models.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockICategoryRepositoryMockRecorder) CreateCategory(ctx, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockICategoryRepository)(nil).CreateCategory), ctx, category)
+}
+
+// DeleteCategory mocks base method.
+func (m *MockICategoryRepository) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *MockICategoryRepositoryMockRecorder) DeleteCategory(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockICategoryRepository)(nil).DeleteCategory), ctx, id)
+}
+
 // GetAllCategories mocks base method.
 func (m *MockICategoryRepository) GetAllCategories(ctx context.Context) ([]*models.Category, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +94,66 @@ func (mr *MockICategoryRepositoryMockRecorder) GetAllSubcategories(ctx, category
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSubcategories", reflect.TypeOf((*MockICategoryRepository)(nil).GetAllSubcategories), ctx, category_id)
 }
 
+// GetBreadcrumbs mocks base method.
+func (m *MockICategoryRepository) GetBreadcrumbs(ctx context.Context, id uuid.UUID) ([]*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBreadcrumbs", ctx, id)
+	ret0, _ := ret[0].([]*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBreadcrumbs indicates an expected call of GetBreadcrumbs.
+func (mr *MockICategoryRepositoryMockRecorder) GetBreadcrumbs(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBreadcrumbs", reflect.TypeOf((*MockICategoryRepository)(nil).GetBreadcrumbs), ctx, id)
+}
+
+// GetCategory mocks base method.
+func (m *MockICategoryRepository) GetCategory(ctx context.Context, id uuid.UUID) (*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategory", ctx, id)
+	ret0, _ := ret[0].(*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategory indicates an expected call of GetCategory.
+func (mr *MockICategoryRepositoryMockRecorder) GetCategory(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategory", reflect.TypeOf((*MockICategoryRepository)(nil).GetCategory), ctx, id)
+}
+
+// GetCategoryBySlug mocks base method.
+func (m *MockICategoryRepository) GetCategoryBySlug(ctx context.Context, slug string) (*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryBySlug", ctx, slug)
+	ret0, _ := ret[0].(*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryBySlug indicates an expected call of GetCategoryBySlug.
+func (mr *MockICategoryRepositoryMockRecorder) GetCategoryBySlug(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryBySlug", reflect.TypeOf((*MockICategoryRepository)(nil).GetCategoryBySlug), ctx, slug)
+}
+
+// GetCategoryTree mocks base method.
+func (m *MockICategoryRepository) GetCategoryTree(ctx context.Context) ([]*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryTree", ctx)
+	ret0, _ := ret[0].([]*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryTree indicates an expected call of GetCategoryTree.
+func (mr *MockICategoryRepositoryMockRecorder) GetCategoryTree(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryTree", reflect.TypeOf((*MockICategoryRepository)(nil).GetCategoryTree), ctx)
+}
+
 // GetNameSubcategory mocks base method.
 func (m *MockICategoryRepository) GetNameSubcategory(ctx context.Context, id uuid.UUID) (string, error) {
 	m.ctrl.T.Helper()
@@ -80,3 +168,45 @@ func (mr *MockICategoryRepositoryMockRecorder) GetNameSubcategory(ctx, id interf
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNameSubcategory", reflect.TypeOf((*MockICategoryRepository)(nil).GetNameSubcategory), ctx, id)
 }
+
+// MoveCategory mocks base method.
+func (m *MockICategoryRepository) MoveCategory(ctx context.Context, id uuid.UUID, parentID uuid.NullUUID, position int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveCategory", ctx, id, parentID, position)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveCategory indicates an expected call of MoveCategory.
+func (mr *MockICategoryRepositoryMockRecorder) MoveCategory(ctx, id, parentID, position interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveCategory", reflect.TypeOf((*MockICategoryRepository)(nil).MoveCategory), ctx, id, parentID, position)
+}
+
+// SetCategoryImage mocks base method.
+func (m *MockICategoryRepository) SetCategoryImage(ctx context.Context, id uuid.UUID, imageURL string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCategoryImage", ctx, id, imageURL)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCategoryImage indicates an expected call of SetCategoryImage.
+func (mr *MockICategoryRepositoryMockRecorder) SetCategoryImage(ctx, id, imageURL interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCategoryImage", reflect.TypeOf((*MockICategoryRepository)(nil).SetCategoryImage), ctx, id, imageURL)
+}
+
+// UpdateCategory mocks base method.
+func (m *MockICategoryRepository) UpdateCategory(ctx context.Context, category *models.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockICategoryRepositoryMockRecorder) UpdateCategory(ctx, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockICategoryRepository)(nil).UpdateCategory), ctx, category)
+}
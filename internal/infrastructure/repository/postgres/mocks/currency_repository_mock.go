@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: currency.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockICurrencyRepository is a mock of ICurrencyRepository interface.
+type MockICurrencyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockICurrencyRepositoryMockRecorder
+}
+
+// MockICurrencyRepositoryMockRecorder is the mock recorder for MockICurrencyRepository.
+type MockICurrencyRepositoryMockRecorder struct {
+	mock *MockICurrencyRepository
+}
+
+// NewMockICurrencyRepository creates a new mock instance.
+func NewMockICurrencyRepository(ctrl *gomock.Controller) *MockICurrencyRepository {
+	mock := &MockICurrencyRepository{ctrl: ctrl}
+	mock.recorder = &MockICurrencyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICurrencyRepository) EXPECT() *MockICurrencyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetRate mocks base method.
+func (m *MockICurrencyRepository) GetRate(ctx context.Context, currency models.Currency) (models.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRate", ctx, currency)
+	ret0, _ := ret[0].(models.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRate indicates an expected call of GetRate.
+func (mr *MockICurrencyRepositoryMockRecorder) GetRate(ctx, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRate", reflect.TypeOf((*MockICurrencyRepository)(nil).GetRate), ctx, currency)
+}
+
+// GetRates mocks base method.
+func (m *MockICurrencyRepository) GetRates(ctx context.Context) ([]models.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRates", ctx)
+	ret0, _ := ret[0].([]models.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRates indicates an expected call of GetRates.
+func (mr *MockICurrencyRepositoryMockRecorder) GetRates(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRates", reflect.TypeOf((*MockICurrencyRepository)(nil).GetRates), ctx)
+}
+
+// SaveRates mocks base method.
+func (m *MockICurrencyRepository) SaveRates(ctx context.Context, rates []models.ExchangeRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRates", ctx, rates)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRates indicates an expected call of SaveRates.
+func (mr *MockICurrencyRepositoryMockRecorder) SaveRates(ctx, rates interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRates", reflect.TypeOf((*MockICurrencyRepository)(nil).SaveRates), ctx, rates)
+}
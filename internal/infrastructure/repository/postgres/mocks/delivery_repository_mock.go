@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: delivery.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIDeliveryRepository is a mock of IDeliveryRepository interface.
+type MockIDeliveryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDeliveryRepositoryMockRecorder
+}
+
+// MockIDeliveryRepositoryMockRecorder is the mock recorder for MockIDeliveryRepository.
+type MockIDeliveryRepositoryMockRecorder struct {
+	mock *MockIDeliveryRepository
+}
+
+// NewMockIDeliveryRepository creates a new mock instance.
+func NewMockIDeliveryRepository(ctrl *gomock.Controller) *MockIDeliveryRepository {
+	mock := &MockIDeliveryRepository{ctrl: ctrl}
+	mock.recorder = &MockIDeliveryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDeliveryRepository) EXPECT() *MockIDeliveryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateSlot mocks base method.
+func (m *MockIDeliveryRepository) CreateSlot(ctx context.Context, slot models.DeliverySlot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSlot", ctx, slot)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSlot indicates an expected call of CreateSlot.
+func (mr *MockIDeliveryRepositoryMockRecorder) CreateSlot(ctx, slot interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSlot", reflect.TypeOf((*MockIDeliveryRepository)(nil).CreateSlot), ctx, slot)
+}
+
+// CreateZone mocks base method.
+func (m *MockIDeliveryRepository) CreateZone(ctx context.Context, zone models.DeliveryZone) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateZone", ctx, zone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateZone indicates an expected call of CreateZone.
+func (mr *MockIDeliveryRepositoryMockRecorder) CreateZone(ctx, zone interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateZone", reflect.TypeOf((*MockIDeliveryRepository)(nil).CreateZone), ctx, zone)
+}
+
+// GetAddressZone mocks base method.
+func (m *MockIDeliveryRepository) GetAddressZone(ctx context.Context, addressID uuid.UUID) (*models.DeliveryZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddressZone", ctx, addressID)
+	ret0, _ := ret[0].(*models.DeliveryZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddressZone indicates an expected call of GetAddressZone.
+func (mr *MockIDeliveryRepositoryMockRecorder) GetAddressZone(ctx, addressID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddressZone", reflect.TypeOf((*MockIDeliveryRepository)(nil).GetAddressZone), ctx, addressID)
+}
+
+// GetAvailableSlots mocks base method.
+func (m *MockIDeliveryRepository) GetAvailableSlots(ctx context.Context, zoneID uuid.UUID, from, to time.Time) ([]models.DeliverySlot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailableSlots", ctx, zoneID, from, to)
+	ret0, _ := ret[0].([]models.DeliverySlot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailableSlots indicates an expected call of GetAvailableSlots.
+func (mr *MockIDeliveryRepositoryMockRecorder) GetAvailableSlots(ctx, zoneID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableSlots", reflect.TypeOf((*MockIDeliveryRepository)(nil).GetAvailableSlots), ctx, zoneID, from, to)
+}
+
+// GetSlot mocks base method.
+func (m *MockIDeliveryRepository) GetSlot(ctx context.Context, slotID uuid.UUID) (*models.DeliverySlot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSlot", ctx, slotID)
+	ret0, _ := ret[0].(*models.DeliverySlot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSlot indicates an expected call of GetSlot.
+func (mr *MockIDeliveryRepositoryMockRecorder) GetSlot(ctx, slotID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSlot", reflect.TypeOf((*MockIDeliveryRepository)(nil).GetSlot), ctx, slotID)
+}
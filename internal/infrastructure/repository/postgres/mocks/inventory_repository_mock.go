@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: inventory.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	sql "database/sql"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIInventoryRepository is a mock of IInventoryRepository interface.
+type MockIInventoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIInventoryRepositoryMockRecorder
+}
+
+// MockIInventoryRepositoryMockRecorder is the mock recorder for MockIInventoryRepository.
+type MockIInventoryRepositoryMockRecorder struct {
+	mock *MockIInventoryRepository
+}
+
+// NewMockIInventoryRepository creates a new mock instance.
+func NewMockIInventoryRepository(ctrl *gomock.Controller) *MockIInventoryRepository {
+	mock := &MockIInventoryRepository{ctrl: ctrl}
+	mock.recorder = &MockIInventoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIInventoryRepository) EXPECT() *MockIInventoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AdjustStock mocks base method.
+func (m *MockIInventoryRepository) AdjustStock(ctx context.Context, sellerID, productID uuid.UUID, variantID uuid.NullUUID, delta int, change models.StockChange) (*models.StockMovement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustStock", ctx, sellerID, productID, variantID, delta, change)
+	ret0, _ := ret[0].(*models.StockMovement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdjustStock indicates an expected call of AdjustStock.
+func (mr *MockIInventoryRepositoryMockRecorder) AdjustStock(ctx, sellerID, productID, variantID, delta, change interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustStock", reflect.TypeOf((*MockIInventoryRepository)(nil).AdjustStock), ctx, sellerID, productID, variantID, delta, change)
+}
+
+// ClaimLowStockAlerts mocks base method.
+func (m *MockIInventoryRepository) ClaimLowStockAlerts(ctx context.Context, limit int) ([]models.LowStockAlert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimLowStockAlerts", ctx, limit)
+	ret0, _ := ret[0].([]models.LowStockAlert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimLowStockAlerts indicates an expected call of ClaimLowStockAlerts.
+func (mr *MockIInventoryRepositoryMockRecorder) ClaimLowStockAlerts(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimLowStockAlerts", reflect.TypeOf((*MockIInventoryRepository)(nil).ClaimLowStockAlerts), ctx, limit)
+}
+
+// GetDiscrepancies mocks base method.
+func (m *MockIInventoryRepository) GetDiscrepancies(ctx context.Context, sellerID uuid.UUID) ([]models.StockDiscrepancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDiscrepancies", ctx, sellerID)
+	ret0, _ := ret[0].([]models.StockDiscrepancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDiscrepancies indicates an expected call of GetDiscrepancies.
+func (mr *MockIInventoryRepositoryMockRecorder) GetDiscrepancies(ctx, sellerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiscrepancies", reflect.TypeOf((*MockIInventoryRepository)(nil).GetDiscrepancies), ctx, sellerID)
+}
+
+// GetMovements mocks base method.
+func (m *MockIInventoryRepository) GetMovements(ctx context.Context, sellerID, productID uuid.UUID, offset int) ([]models.StockMovement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMovements", ctx, sellerID, productID, offset)
+	ret0, _ := ret[0].([]models.StockMovement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMovements indicates an expected call of GetMovements.
+func (mr *MockIInventoryRepositoryMockRecorder) GetMovements(ctx, sellerID, productID, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMovements", reflect.TypeOf((*MockIInventoryRepository)(nil).GetMovements), ctx, sellerID, productID, offset)
+}
+
+// SetLowStockThreshold mocks base method.
+func (m *MockIInventoryRepository) SetLowStockThreshold(ctx context.Context, sellerID, productID uuid.UUID, threshold sql.NullInt64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLowStockThreshold", ctx, sellerID, productID, threshold)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLowStockThreshold indicates an expected call of SetLowStockThreshold.
+func (mr *MockIInventoryRepositoryMockRecorder) SetLowStockThreshold(ctx, sellerID, productID, threshold interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLowStockThreshold", reflect.TypeOf((*MockIInventoryRepository)(nil).SetLowStockThreshold), ctx, sellerID, productID, threshold)
+}
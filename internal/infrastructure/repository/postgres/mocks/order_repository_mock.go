@@ -37,6 +37,36 @@ func (m *MockIOrderRepository) EXPECT() *MockIOrderRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CancelShipment mocks base method.
+func (m *MockIOrderRepository) CancelShipment(ctx context.Context, shipmentID, sellerID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelShipment", ctx, shipmentID, sellerID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelShipment indicates an expected call of CancelShipment.
+func (mr *MockIOrderRepositoryMockRecorder) CancelShipment(ctx, shipmentID, sellerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelShipment", reflect.TypeOf((*MockIOrderRepository)(nil).CancelShipment), ctx, shipmentID, sellerID)
+}
+
+// ConfirmShipment mocks base method.
+func (m *MockIOrderRepository) ConfirmShipment(ctx context.Context, req dto.ConfirmShipmentRepoReq) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmShipment", ctx, req)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmShipment indicates an expected call of ConfirmShipment.
+func (mr *MockIOrderRepositoryMockRecorder) ConfirmShipment(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmShipment", reflect.TypeOf((*MockIOrderRepository)(nil).ConfirmShipment), ctx, req)
+}
+
 // CreateOrder mocks base method.
 func (m *MockIOrderRepository) CreateOrder(arg0 context.Context, arg1 dto.CreateOrderRepoReq) error {
 	m.ctrl.T.Helper()
@@ -66,6 +96,21 @@ func (mr *MockIOrderRepositoryMockRecorder) GetOrderAddress(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderAddress", reflect.TypeOf((*MockIOrderRepository)(nil).GetOrderAddress), arg0, arg1)
 }
 
+// GetOrderDetail mocks base method.
+func (m *MockIOrderRepository) GetOrderDetail(ctx context.Context, orderID uuid.UUID) (*models.OrderDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderDetail", ctx, orderID)
+	ret0, _ := ret[0].(*models.OrderDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderDetail indicates an expected call of GetOrderDetail.
+func (mr *MockIOrderRepositoryMockRecorder) GetOrderDetail(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderDetail", reflect.TypeOf((*MockIOrderRepository)(nil).GetOrderDetail), ctx, orderID)
+}
+
 // GetOrderProducts mocks base method.
 func (m *MockIOrderRepository) GetOrderProducts(arg0 context.Context, arg1 uuid.UUID) (*[]dto.GetOrderProductResDTO, error) {
 	m.ctrl.T.Helper()
@@ -81,6 +126,21 @@ func (mr *MockIOrderRepositoryMockRecorder) GetOrderProducts(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderProducts", reflect.TypeOf((*MockIOrderRepository)(nil).GetOrderProducts), arg0, arg1)
 }
 
+// GetOrderShipments mocks base method.
+func (m *MockIOrderRepository) GetOrderShipments(ctx context.Context, orderID uuid.UUID) ([]models.OrderShipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderShipments", ctx, orderID)
+	ret0, _ := ret[0].([]models.OrderShipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderShipments indicates an expected call of GetOrderShipments.
+func (mr *MockIOrderRepositoryMockRecorder) GetOrderShipments(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderShipments", reflect.TypeOf((*MockIOrderRepository)(nil).GetOrderShipments), ctx, orderID)
+}
+
 // GetOrdersByUserID mocks base method.
 func (m *MockIOrderRepository) GetOrdersByUserID(arg0 context.Context, arg1 uuid.UUID) (*[]dto.GetOrderByUserIDResDTO, error) {
 	m.ctrl.T.Helper()
@@ -97,18 +157,33 @@ func (mr *MockIOrderRepositoryMockRecorder) GetOrdersByUserID(arg0, arg1 interfa
 }
 
 // GetOrdersPlaced mocks base method.
-func (m *MockIOrderRepository) GetOrdersPlaced(ctx context.Context) (*[]dto.GetOrderByUserIDResDTO, error) {
+func (m *MockIOrderRepository) GetOrdersPlaced(ctx context.Context, warehouseID uuid.UUID) (*[]dto.GetOrderByUserIDResDTO, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrdersPlaced", ctx)
+	ret := m.ctrl.Call(m, "GetOrdersPlaced", ctx, warehouseID)
 	ret0, _ := ret[0].(*[]dto.GetOrderByUserIDResDTO)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOrdersPlaced indicates an expected call of GetOrdersPlaced.
-func (mr *MockIOrderRepositoryMockRecorder) GetOrdersPlaced(ctx interface{}) *gomock.Call {
+func (mr *MockIOrderRepositoryMockRecorder) GetOrdersPlaced(ctx, warehouseID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrdersPlaced", reflect.TypeOf((*MockIOrderRepository)(nil).GetOrdersPlaced), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrdersPlaced", reflect.TypeOf((*MockIOrderRepository)(nil).GetOrdersPlaced), ctx, warehouseID)
+}
+
+// GetPickupPointAddressID mocks base method.
+func (m *MockIOrderRepository) GetPickupPointAddressID(ctx context.Context, pointID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPickupPointAddressID", ctx, pointID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPickupPointAddressID indicates an expected call of GetPickupPointAddressID.
+func (mr *MockIOrderRepositoryMockRecorder) GetPickupPointAddressID(ctx, pointID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPickupPointAddressID", reflect.TypeOf((*MockIOrderRepository)(nil).GetPickupPointAddressID), ctx, pointID)
 }
 
 // GetProductImage mocks base method.
@@ -126,6 +201,21 @@ func (mr *MockIOrderRepositoryMockRecorder) GetProductImage(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductImage", reflect.TypeOf((*MockIOrderRepository)(nil).GetProductImage), arg0, arg1)
 }
 
+// GetSellerShipments mocks base method.
+func (m *MockIOrderRepository) GetSellerShipments(ctx context.Context, sellerID uuid.UUID, offset int) ([]models.OrderShipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSellerShipments", ctx, sellerID, offset)
+	ret0, _ := ret[0].([]models.OrderShipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSellerShipments indicates an expected call of GetSellerShipments.
+func (mr *MockIOrderRepositoryMockRecorder) GetSellerShipments(ctx, sellerID, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSellerShipments", reflect.TypeOf((*MockIOrderRepository)(nil).GetSellerShipments), ctx, sellerID, offset)
+}
+
 // GetUserIDByOrderID mocks base method.
 func (m *MockIOrderRepository) GetUserIDByOrderID(ctx context.Context, orderID uuid.UUID) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -142,18 +232,18 @@ func (mr *MockIOrderRepositoryMockRecorder) GetUserIDByOrderID(ctx, orderID inte
 }
 
 // ProductDiscounts mocks base method.
-func (m *MockIOrderRepository) ProductDiscounts(arg0 context.Context, arg1 uuid.UUID) ([]models.ProductDiscount, error) {
+func (m *MockIOrderRepository) ProductDiscounts(ctx context.Context, productID uuid.UUID, variantID uuid.NullUUID) ([]models.ProductDiscount, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ProductDiscounts", arg0, arg1)
+	ret := m.ctrl.Call(m, "ProductDiscounts", ctx, productID, variantID)
 	ret0, _ := ret[0].([]models.ProductDiscount)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ProductDiscounts indicates an expected call of ProductDiscounts.
-func (mr *MockIOrderRepositoryMockRecorder) ProductDiscounts(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockIOrderRepositoryMockRecorder) ProductDiscounts(ctx, productID, variantID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductDiscounts", reflect.TypeOf((*MockIOrderRepository)(nil).ProductDiscounts), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductDiscounts", reflect.TypeOf((*MockIOrderRepository)(nil).ProductDiscounts), ctx, productID, variantID)
 }
 
 // ProductPrice mocks base method.
@@ -171,30 +261,31 @@ func (mr *MockIOrderRepositoryMockRecorder) ProductPrice(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductPrice", reflect.TypeOf((*MockIOrderRepository)(nil).ProductPrice), arg0, arg1)
 }
 
-// UpdateProductQuantity mocks base method.
-func (m *MockIOrderRepository) UpdateProductQuantity(arg0 context.Context, arg1 uuid.UUID, arg2 uint) error {
+// UpdateStatus mocks base method.
+func (m *MockIOrderRepository) UpdateStatus(ctx context.Context, orderID, warehouseID uuid.UUID, status models.OrderStatus) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateProductQuantity", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, orderID, warehouseID, status)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateProductQuantity indicates an expected call of UpdateProductQuantity.
-func (mr *MockIOrderRepositoryMockRecorder) UpdateProductQuantity(arg0, arg1, arg2 interface{}) *gomock.Call {
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockIOrderRepositoryMockRecorder) UpdateStatus(ctx, orderID, warehouseID, status interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductQuantity", reflect.TypeOf((*MockIOrderRepository)(nil).UpdateProductQuantity), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockIOrderRepository)(nil).UpdateStatus), ctx, orderID, warehouseID, status)
 }
 
-// UpdateStatus mocks base method.
-func (m *MockIOrderRepository) UpdateStatus(ctx context.Context, orderID uuid.UUID, status models.OrderStatus) error {
+// VariantPrice mocks base method.
+func (m *MockIOrderRepository) VariantPrice(ctx context.Context, productID, variantID uuid.UUID) (*models.ProductVariant, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateStatus", ctx, orderID, status)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "VariantPrice", ctx, productID, variantID)
+	ret0, _ := ret[0].(*models.ProductVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateStatus indicates an expected call of UpdateStatus.
-func (mr *MockIOrderRepositoryMockRecorder) UpdateStatus(ctx, orderID, status interface{}) *gomock.Call {
+// VariantPrice indicates an expected call of VariantPrice.
+func (mr *MockIOrderRepositoryMockRecorder) VariantPrice(ctx, productID, variantID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockIOrderRepository)(nil).UpdateStatus), ctx, orderID, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VariantPrice", reflect.TypeOf((*MockIOrderRepository)(nil).VariantPrice), ctx, productID, variantID)
 }
@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payout.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	sql "database/sql"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIPayoutRepository is a mock of IPayoutRepository interface.
+type MockIPayoutRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPayoutRepositoryMockRecorder
+}
+
+// MockIPayoutRepositoryMockRecorder is the mock recorder for MockIPayoutRepository.
+type MockIPayoutRepositoryMockRecorder struct {
+	mock *MockIPayoutRepository
+}
+
+// NewMockIPayoutRepository creates a new mock instance.
+func NewMockIPayoutRepository(ctrl *gomock.Controller) *MockIPayoutRepository {
+	mock := &MockIPayoutRepository{ctrl: ctrl}
+	mock.recorder = &MockIPayoutRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPayoutRepository) EXPECT() *MockIPayoutRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetBalance mocks base method.
+func (m *MockIPayoutRepository) GetBalance(ctx context.Context, sellerID uuid.UUID) (models.SellerBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", ctx, sellerID)
+	ret0, _ := ret[0].(models.SellerBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockIPayoutRepositoryMockRecorder) GetBalance(ctx, sellerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockIPayoutRepository)(nil).GetBalance), ctx, sellerID)
+}
+
+// GetStatement mocks base method.
+func (m *MockIPayoutRepository) GetStatement(ctx context.Context, statementID uuid.UUID) (models.PayoutStatement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatement", ctx, statementID)
+	ret0, _ := ret[0].(models.PayoutStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatement indicates an expected call of GetStatement.
+func (mr *MockIPayoutRepositoryMockRecorder) GetStatement(ctx, statementID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatement", reflect.TypeOf((*MockIPayoutRepository)(nil).GetStatement), ctx, statementID)
+}
+
+// GetStatementEarnings mocks base method.
+func (m *MockIPayoutRepository) GetStatementEarnings(ctx context.Context, statementID uuid.UUID) ([]models.SellerEarning, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatementEarnings", ctx, statementID)
+	ret0, _ := ret[0].([]models.SellerEarning)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatementEarnings indicates an expected call of GetStatementEarnings.
+func (mr *MockIPayoutRepositoryMockRecorder) GetStatementEarnings(ctx, statementID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatementEarnings", reflect.TypeOf((*MockIPayoutRepository)(nil).GetStatementEarnings), ctx, statementID)
+}
+
+// GetStatements mocks base method.
+func (m *MockIPayoutRepository) GetStatements(ctx context.Context, sellerID uuid.UUID, offset int) ([]models.PayoutStatement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatements", ctx, sellerID, offset)
+	ret0, _ := ret[0].([]models.PayoutStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatements indicates an expected call of GetStatements.
+func (mr *MockIPayoutRepositoryMockRecorder) GetStatements(ctx, sellerID, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatements", reflect.TypeOf((*MockIPayoutRepository)(nil).GetStatements), ctx, sellerID, offset)
+}
+
+// MarkStatementPaid mocks base method.
+func (m *MockIPayoutRepository) MarkStatementPaid(ctx context.Context, statementID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkStatementPaid", ctx, statementID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkStatementPaid indicates an expected call of MarkStatementPaid.
+func (mr *MockIPayoutRepositoryMockRecorder) MarkStatementPaid(ctx, statementID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkStatementPaid", reflect.TypeOf((*MockIPayoutRepository)(nil).MarkStatementPaid), ctx, statementID)
+}
+
+// RecordEarnings mocks base method.
+func (m *MockIPayoutRepository) RecordEarnings(ctx context.Context, orderID uuid.NullUUID, defaultBps int, holdPeriod time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordEarnings", ctx, orderID, defaultBps, holdPeriod)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordEarnings indicates an expected call of RecordEarnings.
+func (mr *MockIPayoutRepositoryMockRecorder) RecordEarnings(ctx, orderID, defaultBps, holdPeriod interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordEarnings", reflect.TypeOf((*MockIPayoutRepository)(nil).RecordEarnings), ctx, orderID, defaultBps, holdPeriod)
+}
+
+// SetCategoryCommission mocks base method.
+func (m *MockIPayoutRepository) SetCategoryCommission(ctx context.Context, categoryID uuid.UUID, bps sql.NullInt32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCategoryCommission", ctx, categoryID, bps)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCategoryCommission indicates an expected call of SetCategoryCommission.
+func (mr *MockIPayoutRepositoryMockRecorder) SetCategoryCommission(ctx, categoryID, bps interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCategoryCommission", reflect.TypeOf((*MockIPayoutRepository)(nil).SetCategoryCommission), ctx, categoryID, bps)
+}
+
+// Settle mocks base method.
+func (m *MockIPayoutRepository) Settle(ctx context.Context, defaultBps int, holdPeriod, statementPeriod time.Duration) (models.SettlementResult, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Settle", ctx, defaultBps, holdPeriod, statementPeriod)
+	ret0, _ := ret[0].(models.SettlementResult)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Settle indicates an expected call of Settle.
+func (mr *MockIPayoutRepositoryMockRecorder) Settle(ctx, defaultBps, holdPeriod, statementPeriod interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Settle", reflect.TypeOf((*MockIPayoutRepository)(nil).Settle), ctx, defaultBps, holdPeriod, statementPeriod)
+}
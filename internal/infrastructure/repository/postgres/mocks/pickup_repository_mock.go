@@ -0,0 +1,153 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pickup.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIPickupRepository is a mock of IPickupRepository interface.
+type MockIPickupRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPickupRepositoryMockRecorder
+}
+
+// MockIPickupRepositoryMockRecorder is the mock recorder for MockIPickupRepository.
+type MockIPickupRepositoryMockRecorder struct {
+	mock *MockIPickupRepository
+}
+
+// NewMockIPickupRepository creates a new mock instance.
+func NewMockIPickupRepository(ctrl *gomock.Controller) *MockIPickupRepository {
+	mock := &MockIPickupRepository{ctrl: ctrl}
+	mock.recorder = &MockIPickupRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPickupRepository) EXPECT() *MockIPickupRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddFavoritePickupPoint mocks base method.
+func (m *MockIPickupRepository) AddFavoritePickupPoint(ctx context.Context, userID, pointID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFavoritePickupPoint", ctx, userID, pointID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddFavoritePickupPoint indicates an expected call of AddFavoritePickupPoint.
+func (mr *MockIPickupRepositoryMockRecorder) AddFavoritePickupPoint(ctx, userID, pointID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFavoritePickupPoint", reflect.TypeOf((*MockIPickupRepository)(nil).AddFavoritePickupPoint), ctx, userID, pointID)
+}
+
+// CreatePickupPoint mocks base method.
+func (m *MockIPickupRepository) CreatePickupPoint(ctx context.Context, point models.PickupPoint) (models.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePickupPoint", ctx, point)
+	ret0, _ := ret[0].(models.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePickupPoint indicates an expected call of CreatePickupPoint.
+func (mr *MockIPickupRepositoryMockRecorder) CreatePickupPoint(ctx, point interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePickupPoint", reflect.TypeOf((*MockIPickupRepository)(nil).CreatePickupPoint), ctx, point)
+}
+
+// DeletePickupPoint mocks base method.
+func (m *MockIPickupRepository) DeletePickupPoint(ctx context.Context, pointID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePickupPoint", ctx, pointID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePickupPoint indicates an expected call of DeletePickupPoint.
+func (mr *MockIPickupRepositoryMockRecorder) DeletePickupPoint(ctx, pointID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePickupPoint", reflect.TypeOf((*MockIPickupRepository)(nil).DeletePickupPoint), ctx, pointID)
+}
+
+// GetFavoritePickupPoints mocks base method.
+func (m *MockIPickupRepository) GetFavoritePickupPoints(ctx context.Context, userID uuid.UUID) ([]models.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFavoritePickupPoints", ctx, userID)
+	ret0, _ := ret[0].([]models.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFavoritePickupPoints indicates an expected call of GetFavoritePickupPoints.
+func (mr *MockIPickupRepositoryMockRecorder) GetFavoritePickupPoints(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoritePickupPoints", reflect.TypeOf((*MockIPickupRepository)(nil).GetFavoritePickupPoints), ctx, userID)
+}
+
+// GetPickupPoint mocks base method.
+func (m *MockIPickupRepository) GetPickupPoint(ctx context.Context, pointID uuid.UUID) (*models.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPickupPoint", ctx, pointID)
+	ret0, _ := ret[0].(*models.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPickupPoint indicates an expected call of GetPickupPoint.
+func (mr *MockIPickupRepositoryMockRecorder) GetPickupPoint(ctx, pointID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPickupPoint", reflect.TypeOf((*MockIPickupRepository)(nil).GetPickupPoint), ctx, pointID)
+}
+
+// GetPickupPoints mocks base method.
+func (m *MockIPickupRepository) GetPickupPoints(ctx context.Context) ([]models.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPickupPoints", ctx)
+	ret0, _ := ret[0].([]models.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPickupPoints indicates an expected call of GetPickupPoints.
+func (mr *MockIPickupRepositoryMockRecorder) GetPickupPoints(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPickupPoints", reflect.TypeOf((*MockIPickupRepository)(nil).GetPickupPoints), ctx)
+}
+
+// RemoveFavoritePickupPoint mocks base method.
+func (m *MockIPickupRepository) RemoveFavoritePickupPoint(ctx context.Context, userID, pointID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFavoritePickupPoint", ctx, userID, pointID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFavoritePickupPoint indicates an expected call of RemoveFavoritePickupPoint.
+func (mr *MockIPickupRepositoryMockRecorder) RemoveFavoritePickupPoint(ctx, userID, pointID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFavoritePickupPoint", reflect.TypeOf((*MockIPickupRepository)(nil).RemoveFavoritePickupPoint), ctx, userID, pointID)
+}
+
+// UpdatePickupPoint mocks base method.
+func (m *MockIPickupRepository) UpdatePickupPoint(ctx context.Context, point models.PickupPoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePickupPoint", ctx, point)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePickupPoint indicates an expected call of UpdatePickupPoint.
+func (mr *MockIPickupRepositoryMockRecorder) UpdatePickupPoint(ctx, point interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePickupPoint", reflect.TypeOf((*MockIPickupRepository)(nil).UpdatePickupPoint), ctx, point)
+}
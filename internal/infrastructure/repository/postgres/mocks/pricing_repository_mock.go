@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pricing.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIPricingRepository is a mock of IPricingRepository interface.
+type MockIPricingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPricingRepositoryMockRecorder
+}
+
+// MockIPricingRepositoryMockRecorder is the mock recorder for MockIPricingRepository.
+type MockIPricingRepositoryMockRecorder struct {
+	mock *MockIPricingRepository
+}
+
+// NewMockIPricingRepository creates a new mock instance.
+func NewMockIPricingRepository(ctrl *gomock.Controller) *MockIPricingRepository {
+	mock := &MockIPricingRepository{ctrl: ctrl}
+	mock.recorder = &MockIPricingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPricingRepository) EXPECT() *MockIPricingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ProductDiscounts mocks base method.
+func (m *MockIPricingRepository) ProductDiscounts(ctx context.Context, productID uuid.UUID, variantID uuid.NullUUID) ([]models.ProductDiscount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProductDiscounts", ctx, productID, variantID)
+	ret0, _ := ret[0].([]models.ProductDiscount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProductDiscounts indicates an expected call of ProductDiscounts.
+func (mr *MockIPricingRepositoryMockRecorder) ProductDiscounts(ctx, productID, variantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductDiscounts", reflect.TypeOf((*MockIPricingRepository)(nil).ProductDiscounts), ctx, productID, variantID)
+}
+
+// ProductPrice mocks base method.
+func (m *MockIPricingRepository) ProductPrice(arg0 context.Context, arg1 uuid.UUID) (*models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProductPrice", arg0, arg1)
+	ret0, _ := ret[0].(*models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProductPrice indicates an expected call of ProductPrice.
+func (mr *MockIPricingRepositoryMockRecorder) ProductPrice(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProductPrice", reflect.TypeOf((*MockIPricingRepository)(nil).ProductPrice), arg0, arg1)
+}
+
+// VariantPrice mocks base method.
+func (m *MockIPricingRepository) VariantPrice(ctx context.Context, productID, variantID uuid.UUID) (*models.ProductVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VariantPrice", ctx, productID, variantID)
+	ret0, _ := ret[0].(*models.ProductVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VariantPrice indicates an expected call of VariantPrice.
+func (mr *MockIPricingRepositoryMockRecorder) VariantPrice(ctx, productID, variantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VariantPrice", reflect.TypeOf((*MockIPricingRepository)(nil).VariantPrice), ctx, productID, variantID)
+}
@@ -81,17 +81,32 @@ func (mr *MockIProductRepositoryMockRecorder) GetProductByID(ctx, id interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductByID", reflect.TypeOf((*MockIProductRepository)(nil).GetProductByID), ctx, id)
 }
 
+// GetProductVariants mocks base method.
+func (m *MockIProductRepository) GetProductVariants(ctx context.Context, productID uuid.UUID) ([]models.ProductVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductVariants", ctx, productID)
+	ret0, _ := ret[0].([]models.ProductVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductVariants indicates an expected call of GetProductVariants.
+func (mr *MockIProductRepositoryMockRecorder) GetProductVariants(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductVariants", reflect.TypeOf((*MockIProductRepository)(nil).GetProductVariants), ctx, productID)
+}
+
 // GetProductsByCategory mocks base method.
-func (m *MockIProductRepository) GetProductsByCategory(ctx context.Context, id uuid.UUID, offset int, minPrice, maxPrice float64, minRating float32, sortOption models.SortOption) ([]*models.Product, error) {
+func (m *MockIProductRepository) GetProductsByCategory(ctx context.Context, id uuid.UUID, includeDescendants bool, offset int, minPrice, maxPrice models.Money, minRating float32, sortOption models.SortOption) ([]*models.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProductsByCategory", ctx, id, offset, minPrice, maxPrice, minRating, sortOption)
+	ret := m.ctrl.Call(m, "GetProductsByCategory", ctx, id, includeDescendants, offset, minPrice, maxPrice, minRating, sortOption)
 	ret0, _ := ret[0].([]*models.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetProductsByCategory indicates an expected call of GetProductsByCategory.
-func (mr *MockIProductRepositoryMockRecorder) GetProductsByCategory(ctx, id, offset, minPrice, maxPrice, minRating, sortOption interface{}) *gomock.Call {
+func (mr *MockIProductRepositoryMockRecorder) GetProductsByCategory(ctx, id, includeDescendants, offset, minPrice, maxPrice, minRating, sortOption interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsByCategory", reflect.TypeOf((*MockIProductRepository)(nil).GetProductsByCategory), ctx, id, offset, minPrice, maxPrice, minRating, sortOption)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsByCategory", reflect.TypeOf((*MockIProductRepository)(nil).GetProductsByCategory), ctx, id, includeDescendants, offset, minPrice, maxPrice, minRating, sortOption)
 }
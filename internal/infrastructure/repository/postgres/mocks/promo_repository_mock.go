@@ -10,6 +10,7 @@ import (
 
 	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
 )
 
 // MockIPromoRepository is a mock of IPromoRepository interface.
@@ -64,6 +65,34 @@ func (mr *MockIPromoRepositoryMockRecorder) Create(ctx, promo interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockIPromoRepository)(nil).Create), ctx, promo)
 }
 
+// CreateCampaign mocks base method.
+func (m *MockIPromoRepository) CreateCampaign(ctx context.Context, campaign models.PromoCampaign, codes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCampaign", ctx, campaign, codes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCampaign indicates an expected call of CreateCampaign.
+func (mr *MockIPromoRepositoryMockRecorder) CreateCampaign(ctx, campaign, codes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCampaign", reflect.TypeOf((*MockIPromoRepository)(nil).CreateCampaign), ctx, campaign, codes)
+}
+
+// DeactivateCampaign mocks base method.
+func (m *MockIPromoRepository) DeactivateCampaign(ctx context.Context, campaignID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateCampaign", ctx, campaignID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeactivateCampaign indicates an expected call of DeactivateCampaign.
+func (mr *MockIPromoRepositoryMockRecorder) DeactivateCampaign(ctx, campaignID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateCampaign", reflect.TypeOf((*MockIPromoRepository)(nil).DeactivateCampaign), ctx, campaignID)
+}
+
 // GetAll mocks base method.
 func (m *MockIPromoRepository) GetAll(ctx context.Context, offset int) ([]*models.PromoCode, error) {
 	m.ctrl.T.Helper()
@@ -78,3 +107,93 @@ func (mr *MockIPromoRepositoryMockRecorder) GetAll(ctx, offset interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockIPromoRepository)(nil).GetAll), ctx, offset)
 }
+
+// GetCampaign mocks base method.
+func (m *MockIPromoRepository) GetCampaign(ctx context.Context, campaignID uuid.UUID) (*models.PromoCampaign, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCampaign", ctx, campaignID)
+	ret0, _ := ret[0].(*models.PromoCampaign)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCampaign indicates an expected call of GetCampaign.
+func (mr *MockIPromoRepositoryMockRecorder) GetCampaign(ctx, campaignID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCampaign", reflect.TypeOf((*MockIPromoRepository)(nil).GetCampaign), ctx, campaignID)
+}
+
+// GetCampaignCodes mocks base method.
+func (m *MockIPromoRepository) GetCampaignCodes(ctx context.Context, campaignID uuid.UUID) ([]models.PromoCampaignCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCampaignCodes", ctx, campaignID)
+	ret0, _ := ret[0].([]models.PromoCampaignCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCampaignCodes indicates an expected call of GetCampaignCodes.
+func (mr *MockIPromoRepositoryMockRecorder) GetCampaignCodes(ctx, campaignID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCampaignCodes", reflect.TypeOf((*MockIPromoRepository)(nil).GetCampaignCodes), ctx, campaignID)
+}
+
+// GetCampaignStats mocks base method.
+func (m *MockIPromoRepository) GetCampaignStats(ctx context.Context, campaignID uuid.UUID) (models.PromoCampaignStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCampaignStats", ctx, campaignID)
+	ret0, _ := ret[0].(models.PromoCampaignStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCampaignStats indicates an expected call of GetCampaignStats.
+func (mr *MockIPromoRepositoryMockRecorder) GetCampaignStats(ctx, campaignID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCampaignStats", reflect.TypeOf((*MockIPromoRepository)(nil).GetCampaignStats), ctx, campaignID)
+}
+
+// GetProductScopes mocks base method.
+func (m *MockIPromoRepository) GetProductScopes(ctx context.Context, productIDs []uuid.UUID) (map[uuid.UUID]models.PromoProductScope, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductScopes", ctx, productIDs)
+	ret0, _ := ret[0].(map[uuid.UUID]models.PromoProductScope)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductScopes indicates an expected call of GetProductScopes.
+func (mr *MockIPromoRepositoryMockRecorder) GetProductScopes(ctx, productIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductScopes", reflect.TypeOf((*MockIPromoRepository)(nil).GetProductScopes), ctx, productIDs)
+}
+
+// GetPromoUsage mocks base method.
+func (m *MockIPromoRepository) GetPromoUsage(ctx context.Context, promoID, userID uuid.UUID) (models.PromoUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPromoUsage", ctx, promoID, userID)
+	ret0, _ := ret[0].(models.PromoUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPromoUsage indicates an expected call of GetPromoUsage.
+func (mr *MockIPromoRepositoryMockRecorder) GetPromoUsage(ctx, promoID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPromoUsage", reflect.TypeOf((*MockIPromoRepository)(nil).GetPromoUsage), ctx, promoID, userID)
+}
+
+// GetUserCart mocks base method.
+func (m *MockIPromoRepository) GetUserCart(ctx context.Context, userID uuid.UUID) ([]models.PromoCartItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserCart", ctx, userID)
+	ret0, _ := ret[0].([]models.PromoCartItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserCart indicates an expected call of GetUserCart.
+func (mr *MockIPromoRepositoryMockRecorder) GetUserCart(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserCart", reflect.TypeOf((*MockIPromoRepository)(nil).GetUserCart), ctx, userID)
+}
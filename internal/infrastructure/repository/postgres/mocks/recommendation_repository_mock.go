@@ -50,6 +50,36 @@ func (mr *MockIRecommendationRepositoryMockRecorder) GetCategoryIDsByProductID(a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryIDsByProductID", reflect.TypeOf((*MockIRecommendationRepository)(nil).GetCategoryIDsByProductID), arg0, arg1)
 }
 
+// GetPersonalProductIDs mocks base method.
+func (m *MockIRecommendationRepository) GetPersonalProductIDs(ctx context.Context, userID uuid.UUID, count int) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonalProductIDs", ctx, userID, count)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonalProductIDs indicates an expected call of GetPersonalProductIDs.
+func (mr *MockIRecommendationRepositoryMockRecorder) GetPersonalProductIDs(ctx, userID, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalProductIDs", reflect.TypeOf((*MockIRecommendationRepository)(nil).GetPersonalProductIDs), ctx, userID, count)
+}
+
+// GetPopularProductIDs mocks base method.
+func (m *MockIRecommendationRepository) GetPopularProductIDs(ctx context.Context, count int) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPopularProductIDs", ctx, count)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPopularProductIDs indicates an expected call of GetPopularProductIDs.
+func (mr *MockIRecommendationRepositoryMockRecorder) GetPopularProductIDs(ctx, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPopularProductIDs", reflect.TypeOf((*MockIRecommendationRepository)(nil).GetPopularProductIDs), ctx, count)
+}
+
 // GetProductIDsBySubcategoryID mocks base method.
 func (m *MockIRecommendationRepository) GetProductIDsBySubcategoryID(ctx context.Context, subcategoryID uuid.UUID, count int) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -64,3 +94,33 @@ func (mr *MockIRecommendationRepositoryMockRecorder) GetProductIDsBySubcategoryI
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductIDsBySubcategoryID", reflect.TypeOf((*MockIRecommendationRepository)(nil).GetProductIDsBySubcategoryID), ctx, subcategoryID, count)
 }
+
+// GetSimilarProductIDs mocks base method.
+func (m *MockIRecommendationRepository) GetSimilarProductIDs(ctx context.Context, productID uuid.UUID, count int) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSimilarProductIDs", ctx, productID, count)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSimilarProductIDs indicates an expected call of GetSimilarProductIDs.
+func (mr *MockIRecommendationRepositoryMockRecorder) GetSimilarProductIDs(ctx, productID, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSimilarProductIDs", reflect.TypeOf((*MockIRecommendationRepository)(nil).GetSimilarProductIDs), ctx, productID, count)
+}
+
+// RefreshSimilarity mocks base method.
+func (m *MockIRecommendationRepository) RefreshSimilarity(ctx context.Context, perProduct int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshSimilarity", ctx, perProduct)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshSimilarity indicates an expected call of RefreshSimilarity.
+func (mr *MockIRecommendationRepositoryMockRecorder) RefreshSimilarity(ctx, perProduct interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshSimilarity", reflect.TypeOf((*MockIRecommendationRepository)(nil).RefreshSimilarity), ctx, perProduct)
+}
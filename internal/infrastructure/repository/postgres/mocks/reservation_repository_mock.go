@@ -0,0 +1,68 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: reservation.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIReservationRepository is a mock of IReservationRepository interface.
+type MockIReservationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReservationRepositoryMockRecorder
+}
+
+// MockIReservationRepositoryMockRecorder is the mock recorder for MockIReservationRepository.
+type MockIReservationRepositoryMockRecorder struct {
+	mock *MockIReservationRepository
+}
+
+// NewMockIReservationRepository creates a new mock instance.
+func NewMockIReservationRepository(ctrl *gomock.Controller) *MockIReservationRepository {
+	mock := &MockIReservationRepository{ctrl: ctrl}
+	mock.recorder = &MockIReservationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReservationRepository) EXPECT() *MockIReservationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Hold mocks base method.
+func (m *MockIReservationRepository) Hold(ctx context.Context, userID uuid.UUID, items []models.PricingItem, expiresAt time.Time) ([]models.StockReservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hold", ctx, userID, items, expiresAt)
+	ret0, _ := ret[0].([]models.StockReservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Hold indicates an expected call of Hold.
+func (mr *MockIReservationRepositoryMockRecorder) Hold(ctx, userID, items, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hold", reflect.TypeOf((*MockIReservationRepository)(nil).Hold), ctx, userID, items, expiresAt)
+}
+
+// ReleaseExpired mocks base method.
+func (m *MockIReservationRepository) ReleaseExpired(ctx context.Context, limit int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseExpired", ctx, limit)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseExpired indicates an expected call of ReleaseExpired.
+func (mr *MockIReservationRepositoryMockRecorder) ReleaseExpired(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseExpired", reflect.TypeOf((*MockIReservationRepository)(nil).ReleaseExpired), ctx, limit)
+}
@@ -36,6 +36,20 @@ func (m *MockIReviewRepository) EXPECT() *MockIReviewRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddReply mocks base method.
+func (m *MockIReviewRepository) AddReply(ctx context.Context, reply models.ReviewReplyDB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReply", ctx, reply)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddReply indicates an expected call of AddReply.
+func (mr *MockIReviewRepositoryMockRecorder) AddReply(ctx, reply interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReply", reflect.TypeOf((*MockIReviewRepository)(nil).AddReply), ctx, reply)
+}
+
 // AddReview mocks base method.
 func (m *MockIReviewRepository) AddReview(ctx context.Context, review models.ReviewDB) error {
 	m.ctrl.T.Helper()
@@ -50,17 +64,214 @@ func (mr *MockIReviewRepositoryMockRecorder) AddReview(ctx, review interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReview", reflect.TypeOf((*MockIReviewRepository)(nil).AddReview), ctx, review)
 }
 
+// AddReviewPhoto mocks base method.
+func (m *MockIReviewRepository) AddReviewPhoto(ctx context.Context, photo models.ReviewPhotoDB, limit int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReviewPhoto", ctx, photo, limit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddReviewPhoto indicates an expected call of AddReviewPhoto.
+func (mr *MockIReviewRepositoryMockRecorder) AddReviewPhoto(ctx, photo, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReviewPhoto", reflect.TypeOf((*MockIReviewRepository)(nil).AddReviewPhoto), ctx, photo, limit)
+}
+
+// DeleteReview mocks base method.
+func (m *MockIReviewRepository) DeleteReview(ctx context.Context, reviewID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReview", ctx, reviewID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReview indicates an expected call of DeleteReview.
+func (mr *MockIReviewRepositoryMockRecorder) DeleteReview(ctx, reviewID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReview", reflect.TypeOf((*MockIReviewRepository)(nil).DeleteReview), ctx, reviewID, userID)
+}
+
 // GetReview mocks base method.
-func (m *MockIReviewRepository) GetReview(ctx context.Context, productID uuid.UUID, offset int) ([]*models.Review, error) {
+func (m *MockIReviewRepository) GetReview(ctx context.Context, productID uuid.UUID, offset int, sort models.ReviewSort) ([]*models.Review, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetReview", ctx, productID, offset)
+	ret := m.ctrl.Call(m, "GetReview", ctx, productID, offset, sort)
 	ret0, _ := ret[0].([]*models.Review)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetReview indicates an expected call of GetReview.
-func (mr *MockIReviewRepositoryMockRecorder) GetReview(ctx, productID, offset interface{}) *gomock.Call {
+func (mr *MockIReviewRepositoryMockRecorder) GetReview(ctx, productID, offset, sort interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReview", reflect.TypeOf((*MockIReviewRepository)(nil).GetReview), ctx, productID, offset, sort)
+}
+
+// GetReviewAuthorID mocks base method.
+func (m *MockIReviewRepository) GetReviewAuthorID(ctx context.Context, reviewID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewAuthorID", ctx, reviewID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewAuthorID indicates an expected call of GetReviewAuthorID.
+func (mr *MockIReviewRepositoryMockRecorder) GetReviewAuthorID(ctx, reviewID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewAuthorID", reflect.TypeOf((*MockIReviewRepository)(nil).GetReviewAuthorID), ctx, reviewID)
+}
+
+// GetReviewProductID mocks base method.
+func (m *MockIReviewRepository) GetReviewProductID(ctx context.Context, reviewID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewProductID", ctx, reviewID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewProductID indicates an expected call of GetReviewProductID.
+func (mr *MockIReviewRepositoryMockRecorder) GetReviewProductID(ctx, reviewID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewProductID", reflect.TypeOf((*MockIReviewRepository)(nil).GetReviewProductID), ctx, reviewID)
+}
+
+// GetReviewSellerID mocks base method.
+func (m *MockIReviewRepository) GetReviewSellerID(ctx context.Context, reviewID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewSellerID", ctx, reviewID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewSellerID indicates an expected call of GetReviewSellerID.
+func (mr *MockIReviewRepositoryMockRecorder) GetReviewSellerID(ctx, reviewID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewSellerID", reflect.TypeOf((*MockIReviewRepository)(nil).GetReviewSellerID), ctx, reviewID)
+}
+
+// GetReviewSummary mocks base method.
+func (m *MockIReviewRepository) GetReviewSummary(ctx context.Context, productID uuid.UUID) (*models.ReviewSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewSummary", ctx, productID)
+	ret0, _ := ret[0].(*models.ReviewSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewSummary indicates an expected call of GetReviewSummary.
+func (mr *MockIReviewRepositoryMockRecorder) GetReviewSummary(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewSummary", reflect.TypeOf((*MockIReviewRepository)(nil).GetReviewSummary), ctx, productID)
+}
+
+// HasDeliveredPurchase mocks base method.
+func (m *MockIReviewRepository) HasDeliveredPurchase(ctx context.Context, userID, productID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasDeliveredPurchase", ctx, userID, productID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasDeliveredPurchase indicates an expected call of HasDeliveredPurchase.
+func (mr *MockIReviewRepositoryMockRecorder) HasDeliveredPurchase(ctx, userID, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDeliveredPurchase", reflect.TypeOf((*MockIReviewRepository)(nil).HasDeliveredPurchase), ctx, userID, productID)
+}
+
+// UpdateReview mocks base method.
+func (m *MockIReviewRepository) UpdateReview(ctx context.Context, review models.ReviewDB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReview", ctx, review)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReview indicates an expected call of UpdateReview.
+func (mr *MockIReviewRepositoryMockRecorder) UpdateReview(ctx, review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockIReviewRepository)(nil).UpdateReview), ctx, review)
+}
+
+// VoteReview mocks base method.
+func (m *MockIReviewRepository) VoteReview(ctx context.Context, vote models.ReviewVoteDB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoteReview", ctx, vote)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VoteReview indicates an expected call of VoteReview.
+func (mr *MockIReviewRepositoryMockRecorder) VoteReview(ctx, vote interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoteReview", reflect.TypeOf((*MockIReviewRepository)(nil).VoteReview), ctx, vote)
+}
+
+// MockIReviewSummaryCache is a mock of IReviewSummaryCache interface.
+type MockIReviewSummaryCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReviewSummaryCacheMockRecorder
+}
+
+// MockIReviewSummaryCacheMockRecorder is the mock recorder for MockIReviewSummaryCache.
+type MockIReviewSummaryCacheMockRecorder struct {
+	mock *MockIReviewSummaryCache
+}
+
+// NewMockIReviewSummaryCache creates a new mock instance.
+func NewMockIReviewSummaryCache(ctrl *gomock.Controller) *MockIReviewSummaryCache {
+	mock := &MockIReviewSummaryCache{ctrl: ctrl}
+	mock.recorder = &MockIReviewSummaryCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReviewSummaryCache) EXPECT() *MockIReviewSummaryCacheMockRecorder {
+	return m.recorder
+}
+
+// GetSummary mocks base method.
+func (m *MockIReviewSummaryCache) GetSummary(ctx context.Context, productID uuid.UUID) (*models.ReviewSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSummary", ctx, productID)
+	ret0, _ := ret[0].(*models.ReviewSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSummary indicates an expected call of GetSummary.
+func (mr *MockIReviewSummaryCacheMockRecorder) GetSummary(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSummary", reflect.TypeOf((*MockIReviewSummaryCache)(nil).GetSummary), ctx, productID)
+}
+
+// InvalidateSummary mocks base method.
+func (m *MockIReviewSummaryCache) InvalidateSummary(ctx context.Context, productID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateSummary", ctx, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateSummary indicates an expected call of InvalidateSummary.
+func (mr *MockIReviewSummaryCacheMockRecorder) InvalidateSummary(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateSummary", reflect.TypeOf((*MockIReviewSummaryCache)(nil).InvalidateSummary), ctx, productID)
+}
+
+// SetSummary mocks base method.
+func (m *MockIReviewSummaryCache) SetSummary(ctx context.Context, summary *models.ReviewSummary) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSummary", ctx, summary)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSummary indicates an expected call of SetSummary.
+func (mr *MockIReviewSummaryCacheMockRecorder) SetSummary(ctx, summary interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReview", reflect.TypeOf((*MockIReviewRepository)(nil).GetReview), ctx, productID, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSummary", reflect.TypeOf((*MockIReviewSummaryCache)(nil).SetSummary), ctx, summary)
 }
@@ -36,6 +36,21 @@ func (m *MockISearchRepository) EXPECT() *MockISearchRepositoryMockRecorder {
 	return m.recorder
 }
 
+// GetAttributeFacets mocks base method.
+func (m *MockISearchRepository) GetAttributeFacets(ctx context.Context, name string, categoryID null.String, minPrice, maxPrice models.Money, minRating float32, attributes []models.AttributeFilter) ([]models.AttributeFacet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttributeFacets", ctx, name, categoryID, minPrice, maxPrice, minRating, attributes)
+	ret0, _ := ret[0].([]models.AttributeFacet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttributeFacets indicates an expected call of GetAttributeFacets.
+func (mr *MockISearchRepositoryMockRecorder) GetAttributeFacets(ctx, name, categoryID, minPrice, maxPrice, minRating, attributes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttributeFacets", reflect.TypeOf((*MockISearchRepository)(nil).GetAttributeFacets), ctx, name, categoryID, minPrice, maxPrice, minRating, attributes)
+}
+
 // GetCategoryByName mocks base method.
 func (m *MockISearchRepository) GetCategoryByName(ctx context.Context, name string) (*models.Category, error) {
 	m.ctrl.T.Helper()
@@ -52,16 +67,16 @@ func (mr *MockISearchRepositoryMockRecorder) GetCategoryByName(ctx, name interfa
 }
 
 // GetProductsByNameWithFilterAndSort mocks base method.
-func (m *MockISearchRepository) GetProductsByNameWithFilterAndSort(ctx context.Context, name string, categoryID null.String, offset int, minPrice, maxPrice float64, minRating float32, sortOption models.SortOption) ([]*models.Product, error) {
+func (m *MockISearchRepository) GetProductsByNameWithFilterAndSort(ctx context.Context, name string, categoryID null.String, offset int, minPrice, maxPrice models.Money, minRating float32, attributes []models.AttributeFilter, sortOption models.SortOption) ([]*models.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProductsByNameWithFilterAndSort", ctx, name, categoryID, offset, minPrice, maxPrice, minRating, sortOption)
+	ret := m.ctrl.Call(m, "GetProductsByNameWithFilterAndSort", ctx, name, categoryID, offset, minPrice, maxPrice, minRating, attributes, sortOption)
 	ret0, _ := ret[0].([]*models.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetProductsByNameWithFilterAndSort indicates an expected call of GetProductsByNameWithFilterAndSort.
-func (mr *MockISearchRepositoryMockRecorder) GetProductsByNameWithFilterAndSort(ctx, name, categoryID, offset, minPrice, maxPrice, minRating, sortOption interface{}) *gomock.Call {
+func (mr *MockISearchRepositoryMockRecorder) GetProductsByNameWithFilterAndSort(ctx, name, categoryID, offset, minPrice, maxPrice, minRating, attributes, sortOption interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsByNameWithFilterAndSort", reflect.TypeOf((*MockISearchRepository)(nil).GetProductsByNameWithFilterAndSort), ctx, name, categoryID, offset, minPrice, maxPrice, minRating, sortOption)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsByNameWithFilterAndSort", reflect.TypeOf((*MockISearchRepository)(nil).GetProductsByNameWithFilterAndSort), ctx, name, categoryID, offset, minPrice, maxPrice, minRating, attributes, sortOption)
 }
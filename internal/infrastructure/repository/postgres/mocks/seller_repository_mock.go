@@ -37,18 +37,32 @@ func (m *MockISellerRepository) EXPECT() *MockISellerRepositoryMockRecorder {
 }
 
 // AddProduct mocks base method.
-func (m *MockISellerRepository) AddProduct(ctx context.Context, product *models.Product, categoryID uuid.UUID) (*models.Product, error) {
+func (m *MockISellerRepository) AddProduct(ctx context.Context, product *models.Product, categoryID uuid.UUID, attributes []models.ProductAttribute) (*models.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddProduct", ctx, product, categoryID)
+	ret := m.ctrl.Call(m, "AddProduct", ctx, product, categoryID, attributes)
 	ret0, _ := ret[0].(*models.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddProduct indicates an expected call of AddProduct.
-func (mr *MockISellerRepositoryMockRecorder) AddProduct(ctx, product, categoryID interface{}) *gomock.Call {
+func (mr *MockISellerRepositoryMockRecorder) AddProduct(ctx, product, categoryID, attributes interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProduct", reflect.TypeOf((*MockISellerRepository)(nil).AddProduct), ctx, product, categoryID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProduct", reflect.TypeOf((*MockISellerRepository)(nil).AddProduct), ctx, product, categoryID, attributes)
+}
+
+// AddVariant mocks base method.
+func (m *MockISellerRepository) AddVariant(ctx context.Context, variant *models.ProductVariant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddVariant", ctx, variant)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddVariant indicates an expected call of AddVariant.
+func (mr *MockISellerRepositoryMockRecorder) AddVariant(ctx, variant interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVariant", reflect.TypeOf((*MockISellerRepository)(nil).AddVariant), ctx, variant)
 }
 
 // CheckProductBelongs mocks base method.
@@ -66,6 +80,20 @@ func (mr *MockISellerRepositoryMockRecorder) CheckProductBelongs(ctx, productID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckProductBelongs", reflect.TypeOf((*MockISellerRepository)(nil).CheckProductBelongs), ctx, productID, sellerID)
 }
 
+// DeleteVariant mocks base method.
+func (m *MockISellerRepository) DeleteVariant(ctx context.Context, sellerID, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVariant", ctx, sellerID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVariant indicates an expected call of DeleteVariant.
+func (mr *MockISellerRepositoryMockRecorder) DeleteVariant(ctx, sellerID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVariant", reflect.TypeOf((*MockISellerRepository)(nil).DeleteVariant), ctx, sellerID, id)
+}
+
 // GetSellerProducts mocks base method.
 func (m *MockISellerRepository) GetSellerProducts(ctx context.Context, sellerID uuid.UUID, offset int) ([]*models.Product, error) {
 	m.ctrl.T.Helper()
@@ -81,6 +109,50 @@ func (mr *MockISellerRepositoryMockRecorder) GetSellerProducts(ctx, sellerID, of
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSellerProducts", reflect.TypeOf((*MockISellerRepository)(nil).GetSellerProducts), ctx, sellerID, offset)
 }
 
+// GetVariant mocks base method.
+func (m *MockISellerRepository) GetVariant(ctx context.Context, id uuid.UUID) (*models.ProductVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVariant", ctx, id)
+	ret0, _ := ret[0].(*models.ProductVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVariant indicates an expected call of GetVariant.
+func (mr *MockISellerRepositoryMockRecorder) GetVariant(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVariant", reflect.TypeOf((*MockISellerRepository)(nil).GetVariant), ctx, id)
+}
+
+// GetVariantOptions mocks base method.
+func (m *MockISellerRepository) GetVariantOptions(ctx context.Context, productID uuid.UUID) ([]models.VariantOptions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVariantOptions", ctx, productID)
+	ret0, _ := ret[0].([]models.VariantOptions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVariantOptions indicates an expected call of GetVariantOptions.
+func (mr *MockISellerRepositoryMockRecorder) GetVariantOptions(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVariantOptions", reflect.TypeOf((*MockISellerRepository)(nil).GetVariantOptions), ctx, productID)
+}
+
+// UpdateVariant mocks base method.
+func (m *MockISellerRepository) UpdateVariant(ctx context.Context, sellerID uuid.UUID, variant *models.ProductVariant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVariant", ctx, sellerID, variant)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateVariant indicates an expected call of UpdateVariant.
+func (mr *MockISellerRepositoryMockRecorder) UpdateVariant(ctx, sellerID, variant interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVariant", reflect.TypeOf((*MockISellerRepository)(nil).UpdateVariant), ctx, sellerID, variant)
+}
+
 // UploadProductImage mocks base method.
 func (m *MockISellerRepository) UploadProductImage(ctx context.Context, productID uuid.UUID, imageURL string) error {
 	m.ctrl.T.Helper()
@@ -94,3 +166,41 @@ func (mr *MockISellerRepositoryMockRecorder) UploadProductImage(ctx, productID,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadProductImage", reflect.TypeOf((*MockISellerRepository)(nil).UploadProductImage), ctx, productID, imageURL)
 }
+
+// MockIAttributeSchemaRepository is a mock of IAttributeSchemaRepository interface.
+type MockIAttributeSchemaRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAttributeSchemaRepositoryMockRecorder
+}
+
+// MockIAttributeSchemaRepositoryMockRecorder is the mock recorder for MockIAttributeSchemaRepository.
+type MockIAttributeSchemaRepositoryMockRecorder struct {
+	mock *MockIAttributeSchemaRepository
+}
+
+// NewMockIAttributeSchemaRepository creates a new mock instance.
+func NewMockIAttributeSchemaRepository(ctrl *gomock.Controller) *MockIAttributeSchemaRepository {
+	mock := &MockIAttributeSchemaRepository{ctrl: ctrl}
+	mock.recorder = &MockIAttributeSchemaRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAttributeSchemaRepository) EXPECT() *MockIAttributeSchemaRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetCategoryAttributes mocks base method.
+func (m *MockIAttributeSchemaRepository) GetCategoryAttributes(ctx context.Context, categoryID uuid.UUID) ([]models.CategoryAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryAttributes", ctx, categoryID)
+	ret0, _ := ret[0].([]models.CategoryAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryAttributes indicates an expected call of GetCategoryAttributes.
+func (mr *MockIAttributeSchemaRepositoryMockRecorder) GetCategoryAttributes(ctx, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryAttributes", reflect.TypeOf((*MockIAttributeSchemaRepository)(nil).GetCategoryAttributes), ctx, categoryID)
+}
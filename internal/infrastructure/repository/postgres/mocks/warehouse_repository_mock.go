@@ -0,0 +1,170 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: warehouse.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIWarehouseRepository is a mock of IWarehouseRepository interface.
+type MockIWarehouseRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWarehouseRepositoryMockRecorder
+}
+
+// MockIWarehouseRepositoryMockRecorder is the mock recorder for MockIWarehouseRepository.
+type MockIWarehouseRepositoryMockRecorder struct {
+	mock *MockIWarehouseRepository
+}
+
+// NewMockIWarehouseRepository creates a new mock instance.
+func NewMockIWarehouseRepository(ctrl *gomock.Controller) *MockIWarehouseRepository {
+	mock := &MockIWarehouseRepository{ctrl: ctrl}
+	mock.recorder = &MockIWarehouseRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWarehouseRepository) EXPECT() *MockIWarehouseRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AssignOrder mocks base method.
+func (m *MockIWarehouseRepository) AssignOrder(ctx context.Context, orderID, warehouseID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignOrder", ctx, orderID, warehouseID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignOrder indicates an expected call of AssignOrder.
+func (mr *MockIWarehouseRepositoryMockRecorder) AssignOrder(ctx, orderID, warehouseID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignOrder", reflect.TypeOf((*MockIWarehouseRepository)(nil).AssignOrder), ctx, orderID, warehouseID)
+}
+
+// AssignStaff mocks base method.
+func (m *MockIWarehouseRepository) AssignStaff(ctx context.Context, userID uuid.UUID, warehouseID uuid.NullUUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignStaff", ctx, userID, warehouseID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignStaff indicates an expected call of AssignStaff.
+func (mr *MockIWarehouseRepositoryMockRecorder) AssignStaff(ctx, userID, warehouseID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignStaff", reflect.TypeOf((*MockIWarehouseRepository)(nil).AssignStaff), ctx, userID, warehouseID)
+}
+
+// CreateWarehouse mocks base method.
+func (m *MockIWarehouseRepository) CreateWarehouse(ctx context.Context, warehouse models.Warehouse) (models.Warehouse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWarehouse", ctx, warehouse)
+	ret0, _ := ret[0].(models.Warehouse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWarehouse indicates an expected call of CreateWarehouse.
+func (mr *MockIWarehouseRepositoryMockRecorder) CreateWarehouse(ctx, warehouse interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWarehouse", reflect.TypeOf((*MockIWarehouseRepository)(nil).CreateWarehouse), ctx, warehouse)
+}
+
+// GetPickList mocks base method.
+func (m *MockIWarehouseRepository) GetPickList(ctx context.Context, warehouseID uuid.UUID) ([]models.PickListItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPickList", ctx, warehouseID)
+	ret0, _ := ret[0].([]models.PickListItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPickList indicates an expected call of GetPickList.
+func (mr *MockIWarehouseRepositoryMockRecorder) GetPickList(ctx, warehouseID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPickList", reflect.TypeOf((*MockIWarehouseRepository)(nil).GetPickList), ctx, warehouseID)
+}
+
+// GetRouteCandidates mocks base method.
+func (m *MockIWarehouseRepository) GetRouteCandidates(ctx context.Context, orderID uuid.UUID) (string, []models.RouteCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRouteCandidates", ctx, orderID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]models.RouteCandidate)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRouteCandidates indicates an expected call of GetRouteCandidates.
+func (mr *MockIWarehouseRepositoryMockRecorder) GetRouteCandidates(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouteCandidates", reflect.TypeOf((*MockIWarehouseRepository)(nil).GetRouteCandidates), ctx, orderID)
+}
+
+// GetStaffWarehouse mocks base method.
+func (m *MockIWarehouseRepository) GetStaffWarehouse(ctx context.Context, userID uuid.UUID) (uuid.NullUUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStaffWarehouse", ctx, userID)
+	ret0, _ := ret[0].(uuid.NullUUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStaffWarehouse indicates an expected call of GetStaffWarehouse.
+func (mr *MockIWarehouseRepositoryMockRecorder) GetStaffWarehouse(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStaffWarehouse", reflect.TypeOf((*MockIWarehouseRepository)(nil).GetStaffWarehouse), ctx, userID)
+}
+
+// GetStock mocks base method.
+func (m *MockIWarehouseRepository) GetStock(ctx context.Context, warehouseID uuid.UUID, offset int) ([]models.WarehouseStock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStock", ctx, warehouseID, offset)
+	ret0, _ := ret[0].([]models.WarehouseStock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStock indicates an expected call of GetStock.
+func (mr *MockIWarehouseRepositoryMockRecorder) GetStock(ctx, warehouseID, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStock", reflect.TypeOf((*MockIWarehouseRepository)(nil).GetStock), ctx, warehouseID, offset)
+}
+
+// GetWarehouses mocks base method.
+func (m *MockIWarehouseRepository) GetWarehouses(ctx context.Context) ([]models.Warehouse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWarehouses", ctx)
+	ret0, _ := ret[0].([]models.Warehouse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWarehouses indicates an expected call of GetWarehouses.
+func (mr *MockIWarehouseRepositoryMockRecorder) GetWarehouses(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWarehouses", reflect.TypeOf((*MockIWarehouseRepository)(nil).GetWarehouses), ctx)
+}
+
+// SetStock mocks base method.
+func (m *MockIWarehouseRepository) SetStock(ctx context.Context, warehouseID, productID uuid.UUID, variantID uuid.NullUUID, quantity int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStock", ctx, warehouseID, productID, variantID, quantity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStock indicates an expected call of SetStock.
+func (mr *MockIWarehouseRepositoryMockRecorder) SetStock(ctx, warehouseID, productID, variantID, quantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStock", reflect.TypeOf((*MockIWarehouseRepository)(nil).SetStock), ctx, warehouseID, productID, variantID, quantity)
+}
@@ -5,9 +5,12 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
 
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/inventory"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/reservation"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
@@ -15,12 +18,18 @@ import (
 )
 
 const (
-	queryCreateOrder           = `INSERT INTO bazaar.order (id, user_id, status, total_price, total_price_discount, address_id) VALUES ($1, $2, $3, $4, $5, $6)`
-	queryAddOrderItem          = `INSERT INTO bazaar.order_item (id, order_id, product_id, price, quantity) VALUES ($1, $2, $3, $4, $5)`
-	queryGetProductPrice       = `SELECT price, status, quantity FROM bazaar.product WHERE id = $1 LIMIT 1`
-	queryGetProductDiscount    = `SELECT discounted_price, start_date, end_date FROM bazaar.discount WHERE product_id = $1`
-	queryUpdateProductQuantity = `UPDATE bazaar.product SET quantity = $1 WHERE id = $2`
-	queryGetOrdersByUserID     = `SELECT id, status, total_price, total_price_discount, address_id, expected_delivery_at, actual_delivery_at, created_at FROM bazaar.order WHERE user_id = $1`
+	queryCreateOrder           = `INSERT INTO bazaar.order (id, user_id, status, total_price, total_price_discount, address_id, delivery_cost, expected_delivery_at, delivery_slot_id, pickup_point_id, display_currency, display_rate) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+	// Место в интервале занимается условным UPDATE: параллельные заказы не превысят вместимость
+	queryReserveDeliverySlot = `UPDATE bazaar.delivery_slot SET reserved = reserved + 1 WHERE id = $1 AND reserved < capacity`
+	queryAddOrderItem          = `INSERT INTO bazaar.order_item (id, order_id, product_id, variant_id, price, quantity, shipment_id, base_price) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	queryGetProductPrice       = `
+		SELECT p.price, p.status, p.quantity, p.seller_id,
+			EXISTS (SELECT 1 FROM bazaar.product_variant v WHERE v.product_id = p.id)
+		FROM bazaar.product p WHERE p.id = $1 LIMIT 1`
+	queryGetVariantPrice = `SELECT id, product_id, sku, options, price, quantity FROM bazaar.product_variant WHERE id = $1 AND product_id = $2`
+	// Скидка без варианта действует на все SKU товара
+	queryGetProductDiscount    = `SELECT discounted_price, start_date, end_date FROM bazaar.discount WHERE product_id = $1 AND (variant_id IS NULL OR variant_id = $2)`
+	queryGetOrdersByUserID     = `SELECT id, status, total_price, total_price_discount, address_id, expected_delivery_at, actual_delivery_at, created_at, display_currency, display_rate FROM bazaar.order WHERE user_id = $1`
 	queryGetOrderProducts = `
         SELECT oi.product_id, oi.quantity, p.name 
         FROM bazaar.order_item oi
@@ -42,34 +51,203 @@ const (
             a.id = $1 
         LIMIT 1`
 
+	// Очередь склада: оформленные заказы, направленные на этот склад
 	queryGetOrders  = `
 		SELECT id, status, total_price, total_price_discount, 
 			address_id, expected_delivery_at, actual_delivery_at, created_at 
-		FROM bazaar.order WHERE status = 'placed'`
+		FROM bazaar.order WHERE status = 'placed' AND warehouse_id = $1
+		ORDER BY created_at`
 
 	queryUpdateOrderStatus = `
-		UPDATE bazaar.order
-		SET 
-			status = $1,
-			updated_at = now()
-		WHERE id = $2`
+		WITH updated AS (
+			UPDATE bazaar.order
+			SET 
+				status = $1,
+				actual_delivery_at = CASE WHEN $1 = 'delivered' THEN now() ELSE actual_delivery_at END,
+				updated_at = now()
+			WHERE id = $2 AND warehouse_id = $3 AND status = $4
+			RETURNING id
+		), shipments AS (
+			UPDATE bazaar.order_shipment s
+			SET status = $1, updated_at = now()
+			FROM updated u
+			WHERE s.order_id = u.id
+				AND s.status NOT IN ('canceled', 'canceled_by_user', 'canceled_by_seller',
+					'canceled_due_to_payment_error', 'payment_failed')
+		)
+		SELECT (SELECT COUNT(*) FROM updated),
+			EXISTS (SELECT 1 FROM bazaar.order WHERE id = $2 AND warehouse_id = $3)`
 
 	queryGetUserIDByOrderID = `SELECT user_id FROM bazaar.order WHERE id = $1`
+
+	queryGetPickupPointAddressID = `SELECT address_id FROM bazaar.pickup_point WHERE id = $1`
+
+	// Блокируем строку промокода, чтобы параллельные заказы не превысили лимиты
+	queryLockPromoCode    = `SELECT id FROM bazaar.promo_code WHERE id = $1 AND is_active FOR UPDATE`
+	// Применения в отменённых заказах лимиты промокода не расходуют
+	queryCountRedemptions = `
+		SELECT
+			COUNT(*),
+			COUNT(*) FILTER (WHERE r.user_id = $2)
+		FROM bazaar.promo_redemption r
+		JOIN bazaar."order" o ON o.id = r.order_id
+		WHERE r.promo_id = $1
+			AND o.status NOT IN ('canceled', 'canceled_by_user', 'canceled_by_seller', 'canceled_due_to_payment_error', 'payment_failed')`
+	// Другие действующие заказы пользователя, кроме оформляемого
+	queryCountUserOrders = `
+		SELECT COUNT(*)
+		FROM bazaar.order
+		WHERE user_id = $1 AND id <> $2
+			AND status NOT IN ('canceled', 'canceled_by_user', 'canceled_by_seller', 'canceled_due_to_payment_error', 'payment_failed')`
+	queryAddRedemption = `
+		INSERT INTO bazaar.promo_redemption (id, promo_id, user_id, order_id, discount)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	// Переводит действующие резервы пользователя на товар в заказ и возвращает их общее количество
+	queryConfirmReservations = `
+		WITH confirmed AS (
+			UPDATE bazaar.stock_reservation
+			SET status = 'confirmed', order_id = $3, updated_at = now()
+			WHERE user_id = $1 AND product_id = $2 AND variant_id IS NOT DISTINCT FROM $4 AND status = 'held'
+			RETURNING quantity
+		)
+		SELECT COALESCE(SUM(quantity), 0) FROM confirmed`
+	queryReturnStock        = `UPDATE bazaar.product SET quantity = quantity + $1 WHERE id = $2`
+	queryReturnVariantStock = `UPDATE bazaar.product_variant SET quantity = quantity + $1 WHERE id = $2`
+
+	queryAddShipment = `
+		INSERT INTO bazaar.order_shipment (id, order_id, seller_id, status, total_price, total_price_discount)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	// Отправления с товарами одной строкой на позицию; порядок строк сохраняет порядок отправлений
+	queryGetOrderShipments = `
+		SELECT s.id, s.order_id, s.seller_id, COALESCE(sl.title, u.name, ''), o.address_id, s.status,
+			s.total_price, s.total_price_discount, s.tracking_number, s.expected_delivery_at, s.created_at,
+			oi.product_id, oi.variant_id, v.options, p.name, p.preview_image_url, COALESCE(oi.base_price, oi.price), oi.price, oi.quantity
+		FROM bazaar.order_shipment s
+		JOIN bazaar."order" o ON o.id = s.order_id
+		JOIN bazaar.order_item oi ON oi.shipment_id = s.id
+		JOIN bazaar.product p ON p.id = oi.product_id
+		LEFT JOIN bazaar.product_variant v ON v.id = oi.variant_id
+		LEFT JOIN bazaar."user" u ON u.id = s.seller_id
+		LEFT JOIN bazaar.seller sl ON sl.user_id = s.seller_id
+		WHERE s.order_id = $1
+		ORDER BY s.created_at, s.id`
+	// Заголовок заказа с промокодом, если он был применён
+	queryGetOrderDetail = `
+		SELECT o.id, o.user_id, o.address_id, o.pickup_point_id, o.status, o.total_price, o.total_price_discount, o.delivery_cost,
+			pc.code, COALESCE(pr.discount, 0), o.expected_delivery_at, o.actual_delivery_at, o.created_at,
+			o.display_currency, o.display_rate
+		FROM bazaar."order" o
+		LEFT JOIN bazaar.promo_redemption pr ON pr.order_id = o.id
+		LEFT JOIN bazaar.promo_code pc ON pc.id = pr.promo_id
+		WHERE o.id = $1`
+	queryGetSellerShipments = `
+		WITH page AS (
+			SELECT id, created_at
+			FROM bazaar.order_shipment
+			WHERE seller_id = $1
+			ORDER BY created_at DESC, id
+			LIMIT 20 OFFSET $2
+		)
+		SELECT s.id, s.order_id, s.seller_id, COALESCE(sl.title, u.name, ''), o.address_id, s.status,
+			s.total_price, s.total_price_discount, s.tracking_number, s.expected_delivery_at, s.created_at,
+			oi.product_id, oi.variant_id, v.options, p.name, p.preview_image_url, COALESCE(oi.base_price, oi.price), oi.price, oi.quantity
+		FROM page
+		JOIN bazaar.order_shipment s ON s.id = page.id
+		JOIN bazaar."order" o ON o.id = s.order_id
+		JOIN bazaar.order_item oi ON oi.shipment_id = s.id
+		JOIN bazaar.product p ON p.id = oi.product_id
+		LEFT JOIN bazaar.product_variant v ON v.id = oi.variant_id
+		LEFT JOIN bazaar."user" u ON u.id = s.seller_id
+		LEFT JOIN bazaar.seller sl ON sl.user_id = s.seller_id
+		ORDER BY page.created_at DESC, page.id`
+
+	// Блокирует отправление продавца и возвращает его статус и покупателя
+	queryLockShipment = `
+		SELECT s.status, o.status, o.id, o.user_id
+		FROM bazaar."order" o
+		JOIN bazaar.order_shipment s ON s.order_id = o.id
+		WHERE s.id = $1 AND s.seller_id = $2
+		FOR UPDATE OF o, s`
+	queryConfirmShipment = `
+		UPDATE bazaar.order_shipment
+		SET status = 'being_prepared',
+			tracking_number = COALESCE($2, tracking_number),
+			expected_delivery_at = COALESCE($3, expected_delivery_at),
+			updated_at = now()
+		WHERE id = $1`
+	queryCancelShipment = `
+		UPDATE bazaar.order_shipment
+		SET status = 'canceled_by_seller', updated_at = now()
+		WHERE id = $1`
+	// Позиции с вариантом возвращаются в остаток SKU, остальные — в остаток товара
+	queryReturnShipmentStock = `
+		WITH items AS (
+			SELECT product_id, variant_id, SUM(quantity) AS quantity
+			FROM bazaar.order_item
+			WHERE shipment_id = $1
+			GROUP BY product_id, variant_id
+		), restored AS (
+			UPDATE bazaar.product p
+			SET quantity = p.quantity + i.quantity
+			FROM items i
+			WHERE i.variant_id IS NULL AND p.id = i.product_id
+		)
+		UPDATE bazaar.product_variant v
+		SET quantity = v.quantity + i.quantity
+		FROM items i
+		WHERE v.id = i.variant_id`
+	// Товар отменённого отправления уже списан со склада заказа и возвращается туда же
+	queryReturnShipmentWarehouseStock = `
+		WITH items AS (
+			SELECT o.warehouse_id, oi.product_id, oi.variant_id, SUM(oi.quantity) AS quantity
+			FROM bazaar.order_item oi
+			JOIN bazaar."order" o ON o.id = oi.order_id
+			WHERE oi.shipment_id = $1 AND o.warehouse_id IS NOT NULL
+			GROUP BY o.warehouse_id, oi.product_id, oi.variant_id
+		)
+		UPDATE bazaar.warehouse_stock ws
+		SET quantity = ws.quantity + i.quantity, updated_at = now()
+		FROM items i
+		WHERE ws.warehouse_id = i.warehouse_id AND ws.product_id = i.product_id
+			AND ws.variant_id IS NOT DISTINCT FROM i.variant_id`
+	// Заказ отменяется целиком, когда продавцы отменили все его отправления;
+	// место в интервале доставки при этом освобождается
+	queryCancelOrderIfAllCanceled = `
+		WITH canceled AS (
+			UPDATE bazaar."order"
+			SET status = 'canceled_by_seller', updated_at = now()
+			WHERE id = $1 AND NOT EXISTS (
+				SELECT 1 FROM bazaar.order_shipment
+				WHERE order_id = $1 AND status <> 'canceled_by_seller'
+			)
+			RETURNING delivery_slot_id
+		)
+		UPDATE bazaar.delivery_slot
+		SET reserved = reserved - 1
+		WHERE id IN (SELECT delivery_slot_id FROM canceled) AND reserved > 0`
 )
 
 //go:generate mockgen -source=order.go -destination=../mocks/order_repository_mock.go -package=mocks IOrderRepository
 type IOrderRepository interface {
 	CreateOrder(context.Context, dto.CreateOrderRepoReq) error
 	ProductPrice(context.Context, uuid.UUID) (*models.Product, error)
-	ProductDiscounts(context.Context, uuid.UUID) ([]models.ProductDiscount, error)
-	UpdateProductQuantity(context.Context, uuid.UUID, uint) error
+	VariantPrice(ctx context.Context, productID, variantID uuid.UUID) (*models.ProductVariant, error)
+	ProductDiscounts(ctx context.Context, productID uuid.UUID, variantID uuid.NullUUID) ([]models.ProductDiscount, error)
 	GetOrdersByUserID(context.Context, uuid.UUID) (*[]dto.GetOrderByUserIDResDTO, error)
 	GetOrderProducts(context.Context, uuid.UUID) (*[]dto.GetOrderProductResDTO, error)
 	GetProductImage(context.Context, uuid.UUID) (string, error)
 	GetOrderAddress(context.Context, uuid.UUID) (*models.AddressDB, error)
-	GetOrdersPlaced(ctx context.Context) (*[]dto.GetOrderByUserIDResDTO, error)
-	UpdateStatus(ctx context.Context, orderID uuid.UUID, status models.OrderStatus) error
+	GetOrdersPlaced(ctx context.Context, warehouseID uuid.UUID) (*[]dto.GetOrderByUserIDResDTO, error)
+	UpdateStatus(ctx context.Context, orderID, warehouseID uuid.UUID, status models.OrderStatus) error
 	GetUserIDByOrderID(ctx context.Context, orderID uuid.UUID) (uuid.UUID, error)
+	GetPickupPointAddressID(ctx context.Context, pointID uuid.UUID) (uuid.UUID, error)
+	GetOrderShipments(ctx context.Context, orderID uuid.UUID) ([]models.OrderShipment, error)
+	GetOrderDetail(ctx context.Context, orderID uuid.UUID) (*models.OrderDetail, error)
+	GetSellerShipments(ctx context.Context, sellerID uuid.UUID, offset int) ([]models.OrderShipment, error)
+	ConfirmShipment(ctx context.Context, req dto.ConfirmShipmentRepoReq) (uuid.UUID, error)
+	CancelShipment(ctx context.Context, shipmentID, sellerID uuid.UUID) (uuid.UUID, error)
 }
 
 type OrderRepository struct {
@@ -83,10 +261,15 @@ func NewOrderRepository(db *sql.DB) *OrderRepository {
 }
 
 func (r *OrderRepository) CreateOrder(ctx context.Context, in dto.CreateOrderRepoReq) error {
+	const op = "OrderRepository.CreateOrder"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("%s", err)
+		logger.WithError(err).Error("begin transaction")
+		return fmt.Errorf("%s: %w", op, err)
 	}
+	defer tx.Rollback()
 
 	if _, err = tx.ExecContext(ctx, queryCreateOrder,
 		in.Order.ID,
@@ -95,29 +278,216 @@ func (r *OrderRepository) CreateOrder(ctx context.Context, in dto.CreateOrderRep
 		in.Order.TotalPrice,
 		in.Order.TotalPriceDiscount,
 		in.Order.AddressID,
+		in.Order.DeliveryCost,
+		in.Order.ExpectedDeliveryAt,
+		in.Order.DeliverySlotID,
+		in.Order.PickupPointID,
+		in.Order.DisplayRate.Code(),
+		in.Order.DisplayRate.Rate,
 	); err != nil {
-		tx.Rollback()
-		return fmt.Errorf("%s", err)
+		logger.WithError(err).Error("create order")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if in.Order.DeliverySlotID.Valid {
+		if err = reserveDeliverySlot(ctx, tx, in.Order.DeliverySlotID.UUID); err != nil {
+			logger.WithError(err).Warn("reserve delivery slot")
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = takeOrderStock(ctx, tx, in.Order); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	for productID, updatedQuantity := range in.UpdatedQuantities {
-		if err = r.UpdateProductQuantity(ctx, productID, updatedQuantity); err != nil {
-			tx.Rollback()
-			return fmt.Errorf("%s", err)
+	for _, shipment := range in.Order.Shipments {
+		if _, err = tx.ExecContext(ctx, queryAddShipment,
+			shipment.ID,
+			in.Order.ID,
+			uuid.NullUUID{UUID: shipment.SellerID, Valid: shipment.SellerID != uuid.Nil},
+			shipment.Status.String(),
+			shipment.TotalPrice,
+			shipment.TotalPriceDiscount,
+		); err != nil {
+			logger.WithError(err).Error("add order shipment")
+			return fmt.Errorf("%s: %w", op, err)
 		}
 	}
 
 	for _, item := range in.Order.Items {
 		if _, err = tx.ExecContext(ctx, queryAddOrderItem,
-			item.ID, in.Order.ID, item.ProductID, item.Price, item.Quantity,
+			item.ID, in.Order.ID, item.ProductID, item.VariantNullID(), item.Price, item.Quantity,
+			uuid.NullUUID{UUID: item.ShipmentID, Valid: item.ShipmentID != uuid.Nil},
+			item.BasePrice,
 		); err != nil {
-			tx.Rollback()
-			return fmt.Errorf("%s", err)
+			logger.WithError(err).Error("add order item")
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if in.PromoRedemption != nil {
+		if err = redeemPromo(ctx, tx, in.Order.ID, in.PromoRedemption); err != nil {
+			return err
 		}
 	}
 
 	if err = tx.Commit(); err != nil {
-		return fmt.Errorf("%s", err)
+		logger.WithError(err).Error("commit transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// reserveDeliverySlot занимает место в интервале доставки. Интервал мог
+// заполниться после проверки в usecase, поэтому вместимость проверяется здесь же
+func reserveDeliverySlot(ctx context.Context, tx *sql.Tx, slotID uuid.UUID) error {
+	res, err := tx.ExecContext(ctx, queryReserveDeliverySlot, slotID)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return errs.ErrSlotUnavailable
+	}
+
+	return nil
+}
+
+// takeOrderStock списывает товары заказа. Сначала засчитываются резервы,
+// сделанные пользователем при начале оформления; недостающее количество
+// атомарно списывается из остатка, а излишек резерва возвращается в остаток.
+func takeOrderStock(ctx context.Context, tx *sql.Tx, order *dto.Order) error {
+	const op = "OrderRepository.takeOrderStock"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	needed := make(map[models.StockKey]uint, len(order.Items))
+	keys := make([]models.StockKey, 0, len(order.Items))
+	for _, item := range order.Items {
+		key := models.StockKey{ProductID: item.ProductID, VariantID: item.VariantNullID()}
+		if _, ok := needed[key]; !ok {
+			keys = append(keys, key)
+		}
+		needed[key] += item.Quantity
+	}
+
+	// Тот же порядок блокировки строк, что и при резервировании
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Less(keys[j])
+	})
+
+	for _, key := range keys {
+		itemLogger := logger.WithField("product_id", key.ProductID)
+		if key.VariantID.Valid {
+			itemLogger = itemLogger.WithField("variant_id", key.VariantID.UUID)
+		}
+
+		var reserved int64
+		if err := tx.QueryRowContext(ctx, queryConfirmReservations,
+			order.UserID, key.ProductID, order.ID, key.VariantID,
+		).Scan(&reserved); err != nil {
+			itemLogger.WithError(err).Error("confirm reservations")
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		quantity := needed[key]
+		switch {
+		case uint(reserved) < quantity:
+			if err := inventory.SetStockContext(ctx, tx, models.StockChange{
+				Kind:    models.StockSale,
+				ActorID: uuid.NullUUID{UUID: order.UserID, Valid: true},
+				OrderID: uuid.NullUUID{UUID: order.ID, Valid: true},
+			}); err != nil {
+				itemLogger.WithError(err).Error("set stock context")
+				return fmt.Errorf("%s: %w", op, err)
+			}
+			if err := reservation.TakeStock(ctx, tx, key, quantity-uint(reserved)); err != nil {
+				itemLogger.WithError(err).Warn("take stock")
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		case uint(reserved) > quantity:
+			if err := inventory.SetStockContext(ctx, tx, models.StockChange{
+				Kind:    models.StockRelease,
+				ActorID: uuid.NullUUID{UUID: order.UserID, Valid: true},
+				OrderID: uuid.NullUUID{UUID: order.ID, Valid: true},
+				Reason:  "reserved more than ordered",
+			}); err != nil {
+				itemLogger.WithError(err).Error("set stock context")
+				return fmt.Errorf("%s: %w", op, err)
+			}
+			if err := returnStock(ctx, tx, key, uint(reserved)-quantity); err != nil {
+				itemLogger.WithError(err).Error("return reserved stock")
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// returnStock возвращает quantity единиц в остаток товара или его SKU
+func returnStock(ctx context.Context, tx *sql.Tx, key models.StockKey, quantity uint) error {
+	if key.VariantID.Valid {
+		_, err := tx.ExecContext(ctx, queryReturnVariantStock, quantity, key.VariantID.UUID)
+		return err
+	}
+	_, err := tx.ExecContext(ctx, queryReturnStock, quantity, key.ProductID)
+	return err
+}
+
+// redeemPromo повторно проверяет лимиты промокода под блокировкой и фиксирует его использование
+func redeemPromo(ctx context.Context, tx *sql.Tx, orderID uuid.UUID, redemption *models.PromoRedemption) error {
+	const op = "OrderRepository.redeemPromo"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	var promoID uuid.UUID
+	if err := tx.QueryRowContext(ctx, queryLockPromoCode, redemption.PromoID).Scan(&promoID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%s: %w", op, errs.NewNotFoundError("promo code not found"))
+		}
+		logger.WithError(err).Error("lock promo code")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var total, byUser int64
+	if err := tx.QueryRowContext(ctx, queryCountRedemptions, redemption.PromoID, redemption.UserID).Scan(&total, &byUser); err != nil {
+		logger.WithError(err).Error("count promo redemptions")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if redemption.UsageLimit.Valid && total >= redemption.UsageLimit.Int64 {
+		return fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("promo code usage limit reached"))
+	}
+	if redemption.PerUserLimit.Valid && byUser >= redemption.PerUserLimit.Int64 {
+		return fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("promo code per-user limit reached"))
+	}
+
+	// Параллельный заказ того же пользователя ждёт блокировку промокода и
+	// после неё видит уже зафиксированный первый заказ
+	if redemption.FirstOrderOnly {
+		var orders int64
+		if err := tx.QueryRowContext(ctx, queryCountUserOrders, redemption.UserID, orderID).Scan(&orders); err != nil {
+			logger.WithError(err).Error("count user orders")
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if orders > 0 {
+			return fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("promo code is valid for the first order only"))
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, queryAddRedemption,
+		redemption.ID,
+		redemption.PromoID,
+		redemption.UserID,
+		orderID,
+		redemption.Discount,
+	); err != nil {
+		logger.WithError(err).Error("add promo redemption")
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
 	return nil
@@ -134,6 +504,8 @@ func (r *OrderRepository) ProductPrice(ctx context.Context, ProductID uuid.UUID)
 		&product.Price,
 		&productStatusString,
 		&product.Quantity,
+		&product.SellerID,
+		&product.HasVariants,
 	); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, errs.NewNotFoundError("product not found")
@@ -152,11 +524,36 @@ func (r *OrderRepository) ProductPrice(ctx context.Context, ProductID uuid.UUID)
 	return &product, nil
 }
 
-func (r *OrderRepository) ProductDiscounts(ctx context.Context, productID uuid.UUID) ([]models.ProductDiscount, error) {
+// VariantPrice возвращает цену и остаток SKU; вариант другого товара не находится
+func (r *OrderRepository) VariantPrice(ctx context.Context, productID, variantID uuid.UUID) (*models.ProductVariant, error) {
+	const op = "OrderRepository.VariantPrice"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("variant_id", variantID)
+
+	var variant models.ProductVariant
+	if err := r.db.QueryRowContext(ctx, queryGetVariantPrice, variantID, productID).Scan(
+		&variant.ID,
+		&variant.ProductID,
+		&variant.SKU,
+		&variant.Options,
+		&variant.Price,
+		&variant.Quantity,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.NewNotFoundError("product variant not found")
+		}
+		logger.WithError(err).Error("get variant price")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &variant, nil
+}
+
+// ProductDiscounts возвращает скидки на товар; для SKU — вместе со скидками на сам вариант
+func (r *OrderRepository) ProductDiscounts(ctx context.Context, productID uuid.UUID, variantID uuid.NullUUID) ([]models.ProductDiscount, error) {
 	const op = "OrderRepository.ProductDiscounts"
 	logger := logctx.GetLogger(ctx).WithField("op", op)
 
-	rows, err := r.db.QueryContext(ctx, queryGetProductDiscount, productID)
+	rows, err := r.db.QueryContext(ctx, queryGetProductDiscount, productID, variantID)
 	if err != nil {
 		logger.WithError(err).WithField("product_id", productID).Error("query product discounts")
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -189,28 +586,6 @@ func (r *OrderRepository) ProductDiscounts(ctx context.Context, productID uuid.U
 	return discounts, nil
 }
 
-func (r *OrderRepository) UpdateProductQuantity(ctx context.Context, productID uuid.UUID, quantity uint) error {
-	const op = "OrderRepository.UpdateProductQuantity"
-	logger := logctx.GetLogger(ctx).WithField("op", op)
-
-	res, err := r.db.ExecContext(ctx, queryUpdateProductQuantity, quantity, productID)
-	if err != nil {
-		logger.WithError(err).WithField("product_id", productID).Error("update product quantity")
-		return fmt.Errorf("%s: %w", op, err)
-	}
-
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		logger.WithError(err).Error("get rows affected")
-		return fmt.Errorf("%s: %w", op, err)
-	}
-	if rowsAffected == 0 {
-		return errs.NewNotFoundError("product not found for quantity update")
-	}
-
-	return nil
-}
-
 func (r *OrderRepository) GetOrdersByUserID(ctx context.Context, userID uuid.UUID) (*[]dto.GetOrderByUserIDResDTO, error) {
 	rows, err := r.db.QueryContext(ctx, queryGetOrdersByUserID, userID)
 	if err != nil {
@@ -231,6 +606,8 @@ func (r *OrderRepository) GetOrdersByUserID(ctx context.Context, userID uuid.UUI
 			&order.ExpectedDeliveryAt,
 			&order.ActualDeliveryAt,
 			&order.CreatedAt,
+			&order.DisplayRate.Currency,
+			&order.DisplayRate.Rate,
 		); err != nil {
 			return nil, err
 		}
@@ -319,26 +696,47 @@ func (r *OrderRepository) GetOrderAddress(ctx context.Context, addressID uuid.UU
 	return &address, nil
 }
 
-func (w *OrderRepository) UpdateStatus(ctx context.Context, orderID uuid.UUID, status models.OrderStatus) error {
-	const op = "AdminRepository.UpdateProductStatus"
-	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("product_id", orderID)
+// UpdateStatus меняет статус заказа, направленного на склад warehouseID,
+// и тем же запросом переводит в него все неотменённые отправления заказа.
+// Заказ меняется, только если он в статусе, предшествующем status: отменённый
+// или уже доставленный заказ склад не трогает. Заказ другого склада не находится.
+func (w *OrderRepository) UpdateStatus(ctx context.Context, orderID, warehouseID uuid.UUID, status models.OrderStatus) error {
+	const op = "OrderRepository.UpdateStatus"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("order_id", orderID)
 
-	_, err := w.db.ExecContext(ctx, queryUpdateOrderStatus, status.String(), orderID)
-	if err != nil {
-		logger.WithError(err).Error("update product status")
+	previous, ok := status.WarehousePrevious()
+	if !ok {
+		return fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("unsupported order status"))
+	}
+
+	var (
+		updated int
+		exists  bool
+	)
+	if err := w.db.QueryRowContext(ctx, queryUpdateOrderStatus,
+		status.String(), orderID, warehouseID, previous.String(),
+	).Scan(&updated, &exists); err != nil {
+		logger.WithError(err).Error("update order status")
 		return fmt.Errorf("%s: %w", op, err)
 	}
+	if !exists {
+		return fmt.Errorf("%s: %w", op, errs.NewNotFoundError("order not found"))
+	}
+	if updated == 0 {
+		return fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError(
+			fmt.Sprintf("order cannot be moved to %s: it is not %s", status, previous)))
+	}
 
 	return nil
 }
 
-func (w *OrderRepository) GetOrdersPlaced(ctx context.Context) (*[]dto.GetOrderByUserIDResDTO, error) {
+func (w *OrderRepository) GetOrdersPlaced(ctx context.Context, warehouseID uuid.UUID) (*[]dto.GetOrderByUserIDResDTO, error) {
 	const op = "WarehouseRepository.Get"
     logger := logctx.GetLogger(ctx).WithField("op", op)
 
 	var orders []dto.GetOrderByUserIDResDTO
 
-	rows, err := w.db.QueryContext(ctx, queryGetOrders)
+	rows, err := w.db.QueryContext(ctx, queryGetOrders, warehouseID)
 	if err != nil {
 		logger.WithError(err).Error("query all products")
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -393,4 +791,297 @@ func (r *OrderRepository) GetUserIDByOrderID(ctx context.Context, orderID uuid.U
     }
 
     return userID, nil
-}
\ No newline at end of file
+}
+
+// GetPickupPointAddressID возвращает адрес ПВЗ, по которому доставляется заказ с самовывозом
+func (r *OrderRepository) GetPickupPointAddressID(ctx context.Context, pointID uuid.UUID) (uuid.UUID, error) {
+	const op = "OrderRepository.GetPickupPointAddressID"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("pickup_point_id", pointID)
+
+	var addressID uuid.UUID
+	if err := r.db.QueryRowContext(ctx, queryGetPickupPointAddressID, pointID).Scan(&addressID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, fmt.Errorf("%s: %w", op, errs.NewNotFoundError("pickup point not found"))
+		}
+		logger.WithError(err).Error("query pickup point address")
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return addressID, nil
+}
+
+// GetOrderShipments возвращает отправления заказа вместе с товарами
+func (r *OrderRepository) GetOrderShipments(ctx context.Context, orderID uuid.UUID) ([]models.OrderShipment, error) {
+	const op = "OrderRepository.GetOrderShipments"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("order_id", orderID)
+
+	rows, err := r.db.QueryContext(ctx, queryGetOrderShipments, orderID)
+	if err != nil {
+		logger.WithError(err).Error("query order shipments")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	shipments, err := scanShipments(rows)
+	if err != nil {
+		logger.WithError(err).Error("scan order shipments")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return shipments, nil
+}
+
+// GetOrderDetail возвращает заказ с промокодом и отправлениями
+func (r *OrderRepository) GetOrderDetail(ctx context.Context, orderID uuid.UUID) (*models.OrderDetail, error) {
+	const op = "OrderRepository.GetOrderDetail"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("order_id", orderID)
+
+	var (
+		order  models.OrderDetail
+		status string
+	)
+	if err := r.db.QueryRowContext(ctx, queryGetOrderDetail, orderID).Scan(
+		&order.ID,
+		&order.UserID,
+		&order.AddressID,
+		&order.PickupPointID,
+		&status,
+		&order.TotalPrice,
+		&order.TotalPriceDiscount,
+		&order.DeliveryCost,
+		&order.PromoCode,
+		&order.PromoDiscount,
+		&order.ExpectedDeliveryAt,
+		&order.ActualDeliveryAt,
+		&order.CreatedAt,
+		&order.DisplayRate.Currency,
+		&order.DisplayRate.Rate,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Warn("order not found")
+			return nil, fmt.Errorf("%s: %w", op, errs.NewNotFoundError("order not found"))
+		}
+		logger.WithError(err).Error("query order")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	parsed, err := models.ParseOrderStatus(status)
+	if err != nil {
+		logger.WithError(err).Error("parse order status")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	order.Status = parsed
+
+	order.Shipments, err = r.GetOrderShipments(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &order, nil
+}
+
+// GetSellerShipments возвращает страницу отправлений продавца, новые первыми
+func (r *OrderRepository) GetSellerShipments(ctx context.Context, sellerID uuid.UUID, offset int) ([]models.OrderShipment, error) {
+	const op = "OrderRepository.GetSellerShipments"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("seller_id", sellerID)
+
+	rows, err := r.db.QueryContext(ctx, queryGetSellerShipments, sellerID, offset)
+	if err != nil {
+		logger.WithError(err).Error("query seller shipments")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	shipments, err := scanShipments(rows)
+	if err != nil {
+		logger.WithError(err).Error("scan seller shipments")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return shipments, nil
+}
+
+// scanShipments собирает отправления из строк «отправление + позиция»,
+// сохраняя порядок, в котором отправления пришли из базы
+func scanShipments(rows *sql.Rows) ([]models.OrderShipment, error) {
+	shipments := []models.OrderShipment{}
+	index := make(map[uuid.UUID]int)
+
+	for rows.Next() {
+		var (
+			shipment models.OrderShipment
+			item     models.ShipmentItem
+			status   string
+		)
+		if err := rows.Scan(
+			&shipment.ID,
+			&shipment.OrderID,
+			&shipment.SellerID,
+			&shipment.SellerName,
+			&shipment.AddressID,
+			&status,
+			&shipment.TotalPrice,
+			&shipment.TotalPriceDiscount,
+			&shipment.TrackingNumber,
+			&shipment.ExpectedDeliveryAt,
+			&shipment.CreatedAt,
+			&item.ProductID,
+			&item.VariantID,
+			&item.VariantOptions,
+			&item.ProductName,
+			&item.ProductImageURL,
+			&item.BasePrice,
+			&item.Price,
+			&item.Quantity,
+		); err != nil {
+			return nil, err
+		}
+
+		i, ok := index[shipment.ID]
+		if !ok {
+			parsed, err := models.ParseOrderStatus(status)
+			if err != nil {
+				return nil, err
+			}
+			shipment.Status = parsed
+
+			i = len(shipments)
+			index[shipment.ID] = i
+			shipments = append(shipments, shipment)
+		}
+		shipments[i].Items = append(shipments[i].Items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return shipments, nil
+}
+
+// ConfirmShipment принимает отправление продавца в работу и возвращает покупателя
+func (r *OrderRepository) ConfirmShipment(ctx context.Context, req dto.ConfirmShipmentRepoReq) (uuid.UUID, error) {
+	const op = "OrderRepository.ConfirmShipment"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("shipment_id", req.ShipmentID)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.WithError(err).Error("begin transaction")
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	status, orderStatus, _, userID, err := lockShipment(ctx, tx, req.ShipmentID, req.SellerID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if orderStatus != models.Placed {
+		return uuid.Nil, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("order cannot be changed in status "+orderStatus.String()))
+	}
+	if !status.SellerCanConfirm() {
+		return uuid.Nil, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("shipment cannot be confirmed in status "+status.String()))
+	}
+
+	if _, err = tx.ExecContext(ctx, queryConfirmShipment,
+		req.ShipmentID, req.TrackingNumber, req.ExpectedDeliveryAt,
+	); err != nil {
+		logger.WithError(err).Error("confirm shipment")
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.WithError(err).Error("commit transaction")
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return userID, nil
+}
+
+// CancelShipment отменяет отправление продавца, возвращает его товары в остаток
+// и возвращает покупателя. Если отменены все отправления, отменяется и заказ.
+func (r *OrderRepository) CancelShipment(ctx context.Context, shipmentID, sellerID uuid.UUID) (uuid.UUID, error) {
+	const op = "OrderRepository.CancelShipment"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("shipment_id", shipmentID)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.WithError(err).Error("begin transaction")
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	status, orderStatus, orderID, userID, err := lockShipment(ctx, tx, shipmentID, sellerID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if orderStatus != models.Placed {
+		return uuid.Nil, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("order cannot be changed in status "+orderStatus.String()))
+	}
+	if !status.SellerCanCancel() {
+		return uuid.Nil, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("shipment cannot be canceled in status "+status.String()))
+	}
+
+	if _, err = tx.ExecContext(ctx, queryCancelShipment, shipmentID); err != nil {
+		logger.WithError(err).Error("cancel shipment")
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = inventory.SetStockContext(ctx, tx, models.StockChange{
+		Kind:    models.StockCancelRestore,
+		ActorID: uuid.NullUUID{UUID: sellerID, Valid: true},
+		OrderID: uuid.NullUUID{UUID: orderID, Valid: true},
+		Reason:  "shipment canceled by seller",
+	}); err != nil {
+		logger.WithError(err).Error("set stock context")
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, queryReturnShipmentStock, shipmentID); err != nil {
+		logger.WithError(err).Error("return shipment stock")
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, queryReturnShipmentWarehouseStock, shipmentID); err != nil {
+		logger.WithError(err).Error("return shipment warehouse stock")
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, queryCancelOrderIfAllCanceled, orderID); err != nil {
+		logger.WithError(err).Error("cancel order")
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.WithError(err).Error("commit transaction")
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return userID, nil
+}
+
+// lockShipment блокирует отправление вместе с его заказом и возвращает статусы
+// обоих: после передачи заказа в доставку продавец его уже не меняет
+func lockShipment(ctx context.Context, tx *sql.Tx, shipmentID, sellerID uuid.UUID) (models.OrderStatus, models.OrderStatus, uuid.UUID, uuid.UUID, error) {
+	var (
+		statusStr, orderStatusStr string
+		orderID, userID           uuid.UUID
+	)
+	if err := tx.QueryRowContext(ctx, queryLockShipment, shipmentID, sellerID).
+		Scan(&statusStr, &orderStatusStr, &orderID, &userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, 0, uuid.Nil, uuid.Nil, errs.NewNotFoundError("shipment not found")
+		}
+		return 0, 0, uuid.Nil, uuid.Nil, err
+	}
+
+	status, err := models.ParseOrderStatus(statusStr)
+	if err != nil {
+		return 0, 0, uuid.Nil, uuid.Nil, err
+	}
+	orderStatus, err := models.ParseOrderStatus(orderStatusStr)
+	if err != nil {
+		return 0, 0, uuid.Nil, uuid.Nil, err
+	}
+
+	return status, orderStatus, orderID, userID, nil
+}
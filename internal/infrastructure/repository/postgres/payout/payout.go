@@ -0,0 +1,423 @@
+package payout
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/google/uuid"
+)
+
+const statementsPageSize = 20
+
+const (
+	// settlementLockID — ключ advisory-блокировки, чтобы расчёты выполняла только одна реплика
+	settlementLockID = 7303
+
+	queryTrySettlementLock = `SELECT pg_try_advisory_xact_lock($1)`
+
+	querySetCategoryCommission = `UPDATE bazaar.category SET commission_bps = $2 WHERE id = $1`
+
+	// Начисления за позиции доставленных заказов: $1 — заказ или NULL для всех,
+	// $2 — комиссия по умолчанию, $3 — окно возврата в секундах. Комиссия товара —
+	// наибольшая из комиссий его категорий; категория без своей комиссии берёт
+	// комиссию ближайшего предка. Суммы считаются в NUMERIC без потери точности.
+	queryRecordEarnings = `
+		WITH RECURSIVE chain AS (
+			SELECT c.id AS category_id, c.parent_id, c.commission_bps, 0 AS depth
+			FROM bazaar.category c
+			UNION ALL
+			SELECT ch.category_id, p.parent_id, p.commission_bps, ch.depth + 1
+			FROM chain ch
+			JOIN bazaar.category p ON p.id = ch.parent_id
+			WHERE ch.commission_bps IS NULL
+		),
+		category_rate AS (
+			SELECT DISTINCT ON (category_id) category_id, commission_bps
+			FROM chain
+			WHERE commission_bps IS NOT NULL
+			ORDER BY category_id, depth
+		)
+		INSERT INTO bazaar.seller_earning (id, order_item_id, order_id, product_id, seller_id, quantity,
+			gross, commission_bps, commission, net, available_at)
+		SELECT gen_random_uuid(), i.id, i.order_id, i.product_id, i.seller_id, i.quantity,
+			i.gross, i.bps, ROUND(i.gross * i.bps / 10000, 2), i.gross - ROUND(i.gross * i.bps / 10000, 2),
+			i.delivered_at + $3::int * interval '1 second'
+		FROM (
+			SELECT oi.id, oi.order_id, oi.product_id, COALESCE(s.seller_id, p.seller_id) AS seller_id,
+				oi.quantity, oi.price * oi.quantity AS gross,
+				COALESCE((
+					SELECT MAX(cr.commission_bps)
+					FROM bazaar.product_subcategory ps
+					JOIN category_rate cr ON cr.category_id = ps.subcategory_id
+					WHERE ps.product_id = oi.product_id
+				), $2) AS bps,
+				COALESCE(o.actual_delivery_at, o.updated_at, now()) AS delivered_at
+			FROM bazaar.order_item oi
+			JOIN bazaar."order" o ON o.id = oi.order_id
+			JOIN bazaar.product p ON p.id = oi.product_id
+			LEFT JOIN bazaar.order_shipment s ON s.id = oi.shipment_id
+			WHERE o.status = 'delivered'
+				AND ($1::uuid IS NULL OR o.id = $1::uuid)
+				AND COALESCE(s.seller_id, p.seller_id) IS NOT NULL
+				AND COALESCE(s.status, o.status) NOT IN
+					('canceled', 'canceled_by_user', 'canceled_by_seller', 'canceled_due_to_payment_error', 'payment_failed')
+				AND NOT EXISTS (SELECT 1 FROM bazaar.seller_earning e WHERE e.order_item_id = oi.id)
+		) i
+		ON CONFLICT (order_item_id) DO NOTHING`
+
+	// Возврат по заказу или отправлению отменяет ещё не выплаченные начисления.
+	// Статус отправления не заслоняет возврат всего заказа: проверяются оба.
+	queryReverseEarnings = `
+		UPDATE bazaar.seller_earning e
+		SET status = 'reversed'
+		FROM bazaar.order_item oi
+		JOIN bazaar."order" o ON o.id = oi.order_id
+		LEFT JOIN bazaar.order_shipment s ON s.id = oi.shipment_id
+		WHERE oi.id = e.order_item_id
+			AND e.status IN ('held', 'available')
+			AND (s.status IN ('return_requested', 'return_processed', 'return_initiated', 'return_completed')
+				OR o.status IN ('return_requested', 'return_processed', 'return_initiated', 'return_completed'))`
+
+	queryReleaseEarnings = `
+		UPDATE bazaar.seller_earning
+		SET status = 'available'
+		WHERE status = 'held' AND available_at <= now()`
+
+	// Выписка формируется, если с конца предыдущей выписки продавца (или с первого
+	// доступного начисления) прошло $1 секунд, и забирает все доступные начисления
+	queryCreateStatements = `
+		WITH due AS (
+			SELECT e.seller_id,
+				COALESCE(
+					(SELECT MAX(ps.period_end) FROM bazaar.payout_statement ps WHERE ps.seller_id = e.seller_id),
+					MIN(e.available_at)
+				) AS period_start
+			FROM bazaar.seller_earning e
+			WHERE e.status = 'available'
+			GROUP BY e.seller_id
+		),
+		statement AS (
+			INSERT INTO bazaar.payout_statement (id, seller_id, period_start, period_end, gross, commission, net)
+			SELECT gen_random_uuid(), d.seller_id, d.period_start, now(),
+				SUM(e.gross), SUM(e.commission), SUM(e.net)
+			FROM due d
+			JOIN bazaar.seller_earning e ON e.seller_id = d.seller_id AND e.status = 'available'
+			WHERE d.period_start <= now() - $1::int * interval '1 second'
+			GROUP BY d.seller_id, d.period_start
+			RETURNING id, seller_id
+		),
+		settled AS (
+			UPDATE bazaar.seller_earning e
+			SET status = 'settled', statement_id = st.id
+			FROM statement st
+			WHERE e.seller_id = st.seller_id AND e.status = 'available'
+		)
+		SELECT COUNT(*) FROM statement`
+
+	queryGetBalance = `
+		SELECT
+			(SELECT COALESCE(SUM(net), 0) FROM bazaar.seller_earning WHERE seller_id = $1 AND status = 'held'),
+			(SELECT COALESCE(SUM(net), 0) FROM bazaar.seller_earning WHERE seller_id = $1 AND status = 'available'),
+			(SELECT COALESCE(SUM(net), 0) FROM bazaar.payout_statement WHERE seller_id = $1 AND status = 'pending'),
+			(SELECT COALESCE(SUM(net), 0) FROM bazaar.payout_statement WHERE seller_id = $1 AND status = 'paid')`
+
+	queryGetStatements = `
+		SELECT id, seller_id, period_start, period_end, gross, commission, net, status, paid_at, created_at
+		FROM bazaar.payout_statement
+		WHERE seller_id = $1
+		ORDER BY period_end DESC
+		LIMIT $2 OFFSET $3`
+
+	queryGetStatement = `
+		SELECT id, seller_id, period_start, period_end, gross, commission, net, status, paid_at, created_at
+		FROM bazaar.payout_statement
+		WHERE id = $1`
+
+	queryGetStatementEarnings = `
+		SELECT e.id, e.order_id, e.product_id, p.name, e.quantity, e.gross, e.commission_bps,
+			e.commission, e.net, e.status, e.available_at, e.created_at
+		FROM bazaar.seller_earning e
+		JOIN bazaar.product p ON p.id = e.product_id
+		WHERE e.statement_id = $1
+		ORDER BY e.created_at, e.id`
+
+	queryMarkStatementPaid = `
+		UPDATE bazaar.payout_statement
+		SET status = 'paid', paid_at = now()
+		WHERE id = $1 AND status = 'pending'`
+)
+
+type PayoutRepository struct {
+	db *sql.DB
+}
+
+func NewPayoutRepository(db *sql.DB) *PayoutRepository {
+	return &PayoutRepository{
+		db: db,
+	}
+}
+
+// SetCategoryCommission задаёт комиссию категории в базисных пунктах;
+// NULL возвращает категорию к комиссии предка
+func (r *PayoutRepository) SetCategoryCommission(ctx context.Context, categoryID uuid.UUID, bps sql.NullInt32) error {
+	const op = "PayoutRepository.SetCategoryCommission"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	res, err := r.db.ExecContext(ctx, querySetCategoryCommission, categoryID, bps)
+	if err != nil {
+		logger.WithError(err).Error("set category commission")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, errs.NewNotFoundError("category not found"))
+	}
+
+	return nil
+}
+
+// RecordEarnings начисляет продавцам за позиции доставленного заказа orderID
+// или, если он не задан, всех доставленных заказов без начислений. Повторный
+// вызов ничего не меняет. Возвращает число новых начислений.
+func (r *PayoutRepository) RecordEarnings(
+	ctx context.Context,
+	orderID uuid.NullUUID,
+	defaultBps int,
+	holdPeriod time.Duration,
+) (int64, error) {
+	const op = "PayoutRepository.RecordEarnings"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	res, err := r.db.ExecContext(ctx, queryRecordEarnings, orderID, defaultBps, int(holdPeriod.Seconds()))
+	if err != nil {
+		logger.WithError(err).Error("record earnings")
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	recorded, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return recorded, nil
+}
+
+// Settle выполняет проход расчётов: дописывает пропущенные начисления, отменяет
+// начисления по возвратам, снимает холд по прошедшему окну возврата и формирует
+// выписки раз в statementPeriod. Возвращает false, если расчёты уже выполняет
+// другая реплика.
+func (r *PayoutRepository) Settle(
+	ctx context.Context,
+	defaultBps int,
+	holdPeriod, statementPeriod time.Duration,
+) (models.SettlementResult, bool, error) {
+	const op = "PayoutRepository.Settle"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	var result models.SettlementResult
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.WithError(err).Error("begin transaction")
+		return result, false, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var locked bool
+	if err = tx.QueryRowContext(ctx, queryTrySettlementLock, settlementLockID).Scan(&locked); err != nil {
+		logger.WithError(err).Error("acquire settlement lock")
+		return result, false, fmt.Errorf("%s: %w", op, err)
+	}
+	if !locked {
+		return result, false, nil
+	}
+
+	for _, step := range []struct {
+		name     string
+		query    string
+		args     []any
+		affected *int64
+	}{
+		{"record earnings", queryRecordEarnings, []any{uuid.NullUUID{}, defaultBps, int(holdPeriod.Seconds())}, &result.Recorded},
+		{"reverse earnings", queryReverseEarnings, nil, &result.Reversed},
+		{"release earnings", queryReleaseEarnings, nil, &result.Released},
+	} {
+		res, err := tx.ExecContext(ctx, step.query, step.args...)
+		if err != nil {
+			logger.WithError(err).Error(step.name)
+			return result, false, fmt.Errorf("%s: %w", op, err)
+		}
+		if *step.affected, err = res.RowsAffected(); err != nil {
+			return result, false, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.QueryRowContext(ctx, queryCreateStatements, int(statementPeriod.Seconds())).Scan(&result.Statements); err != nil {
+		logger.WithError(err).Error("create statements")
+		return result, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.WithError(err).Error("commit transaction")
+		return result, false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, true, nil
+}
+
+func (r *PayoutRepository) GetBalance(ctx context.Context, sellerID uuid.UUID) (models.SellerBalance, error) {
+	const op = "PayoutRepository.GetBalance"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	var balance models.SellerBalance
+	if err := r.db.QueryRowContext(ctx, queryGetBalance, sellerID).Scan(
+		&balance.Held,
+		&balance.Available,
+		&balance.Pending,
+		&balance.PaidOut,
+	); err != nil {
+		logger.WithError(err).Error("get seller balance")
+		return models.SellerBalance{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return balance, nil
+}
+
+// GetStatements возвращает выписки продавца от новых к старым, по 20 записей
+func (r *PayoutRepository) GetStatements(ctx context.Context, sellerID uuid.UUID, offset int) ([]models.PayoutStatement, error) {
+	const op = "PayoutRepository.GetStatements"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	rows, err := r.db.QueryContext(ctx, queryGetStatements, sellerID, statementsPageSize, offset)
+	if err != nil {
+		logger.WithError(err).Error("query statements")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	statements := make([]models.PayoutStatement, 0, statementsPageSize)
+	for rows.Next() {
+		statement, err := scanStatement(rows)
+		if err != nil {
+			logger.WithError(err).Error("scan statement")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		statements = append(statements, statement)
+	}
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return statements, nil
+}
+
+func (r *PayoutRepository) GetStatement(ctx context.Context, statementID uuid.UUID) (models.PayoutStatement, error) {
+	const op = "PayoutRepository.GetStatement"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	statement, err := scanStatement(r.db.QueryRowContext(ctx, queryGetStatement, statementID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.PayoutStatement{}, fmt.Errorf("%s: %w", op, errs.NewNotFoundError("payout statement not found"))
+	}
+	if err != nil {
+		logger.WithError(err).Error("get statement")
+		return models.PayoutStatement{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return statement, nil
+}
+
+func (r *PayoutRepository) GetStatementEarnings(ctx context.Context, statementID uuid.UUID) ([]models.SellerEarning, error) {
+	const op = "PayoutRepository.GetStatementEarnings"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	rows, err := r.db.QueryContext(ctx, queryGetStatementEarnings, statementID)
+	if err != nil {
+		logger.WithError(err).Error("query statement earnings")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	earnings := make([]models.SellerEarning, 0)
+	for rows.Next() {
+		var e models.SellerEarning
+		if err = rows.Scan(
+			&e.ID,
+			&e.OrderID,
+			&e.ProductID,
+			&e.ProductName,
+			&e.Quantity,
+			&e.Gross,
+			&e.CommissionBps,
+			&e.Commission,
+			&e.Net,
+			&e.Status,
+			&e.AvailableAt,
+			&e.CreatedAt,
+		); err != nil {
+			logger.WithError(err).Error("scan earning")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		earnings = append(earnings, e)
+	}
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return earnings, nil
+}
+
+// MarkStatementPaid отмечает ожидающую выписку выплаченной
+func (r *PayoutRepository) MarkStatementPaid(ctx context.Context, statementID uuid.UUID) error {
+	const op = "PayoutRepository.MarkStatementPaid"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	res, err := r.db.ExecContext(ctx, queryMarkStatementPaid, statementID)
+	if err != nil {
+		logger.WithError(err).Error("mark statement paid")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, errs.NewNotFoundError("pending payout statement not found"))
+	}
+
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanStatement(row rowScanner) (models.PayoutStatement, error) {
+	var s models.PayoutStatement
+	err := row.Scan(
+		&s.ID,
+		&s.SellerID,
+		&s.PeriodStart,
+		&s.PeriodEnd,
+		&s.Gross,
+		&s.Commission,
+		&s.Net,
+		&s.Status,
+		&s.PaidAt,
+		&s.CreatedAt,
+	)
+	return s, err
+}
@@ -0,0 +1,255 @@
+package pickup
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const (
+	selectPickupPoint = `
+		SELECT pp.id, pp.name, to_char(pp.opens_at, 'HH24:MI'), to_char(pp.closes_at, 'HH24:MI'),
+			a.id, a.region, a.city, a.address_string, a.coordinate
+		FROM bazaar.pickup_point pp
+		JOIN bazaar.address a ON a.id = pp.address_id`
+
+	queryGetPickupPoints = selectPickupPoint + `
+		ORDER BY pp.created_at`
+
+	queryGetPickupPoint = selectPickupPoint + `
+		WHERE pp.id = $1`
+
+	queryGetFavoritePickupPoints = selectPickupPoint + `
+		JOIN bazaar.user_pickup_point upp ON upp.pickup_point_id = pp.id
+		WHERE upp.user_id = $1
+		ORDER BY upp.created_at DESC`
+
+	// Адрес ПВЗ может совпадать с уже сохранённым адресом пользователя,
+	// тогда используется существующая запись
+	queryUpsertPickupAddress = `
+		INSERT INTO bazaar.address (id, region, city, address_string, coordinate)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (address_string, coordinate) DO UPDATE SET address_string = EXCLUDED.address_string
+		RETURNING id`
+
+	queryCreatePickupPoint = `
+		INSERT INTO bazaar.pickup_point (id, address_id, name, opens_at, closes_at)
+		VALUES ($1, $2, $3, $4::time, $5::time)`
+
+	queryUpdatePickupPoint = `
+		UPDATE bazaar.pickup_point
+		SET name = $2, opens_at = $3::time, closes_at = $4::time, updated_at = now()
+		WHERE id = $1`
+
+	queryDeletePickupPoint = `DELETE FROM bazaar.pickup_point WHERE id = $1`
+
+	queryAddFavoritePickupPoint = `
+		INSERT INTO bazaar.user_pickup_point (id, user_id, pickup_point_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, pickup_point_id) DO NOTHING`
+
+	queryRemoveFavoritePickupPoint = `
+		DELETE FROM bazaar.user_pickup_point WHERE user_id = $1 AND pickup_point_id = $2`
+)
+
+type PickupRepository struct {
+	db *sql.DB
+}
+
+func NewPickupRepository(db *sql.DB) *PickupRepository {
+	return &PickupRepository{
+		db: db,
+	}
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanPickupPoint(row scanner) (models.PickupPoint, error) {
+	var point models.PickupPoint
+	err := row.Scan(
+		&point.ID,
+		&point.Name,
+		&point.OpensAt,
+		&point.ClosesAt,
+		&point.Address.ID,
+		&point.Address.Region,
+		&point.Address.City,
+		&point.Address.AddressString,
+		&point.Address.Coordinate,
+	)
+	return point, err
+}
+
+func (r *PickupRepository) queryPickupPoints(ctx context.Context, query string, args ...interface{}) ([]models.PickupPoint, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	points := []models.PickupPoint{}
+	for rows.Next() {
+		point, err := scanPickupPoint(rows)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, point)
+	}
+
+	return points, rows.Err()
+}
+
+func (r *PickupRepository) GetPickupPoints(ctx context.Context) ([]models.PickupPoint, error) {
+	const op = "PickupRepository.GetPickupPoints"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	points, err := r.queryPickupPoints(ctx, queryGetPickupPoints)
+	if err != nil {
+		logger.WithError(err).Error("query pickup points")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return points, nil
+}
+
+func (r *PickupRepository) GetPickupPoint(ctx context.Context, pointID uuid.UUID) (*models.PickupPoint, error) {
+	const op = "PickupRepository.GetPickupPoint"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("pickup_point_id", pointID)
+
+	point, err := scanPickupPoint(r.db.QueryRowContext(ctx, queryGetPickupPoint, pointID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, errs.NewNotFoundError("pickup point not found"))
+		}
+		logger.WithError(err).Error("query pickup point")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &point, nil
+}
+
+func (r *PickupRepository) GetFavoritePickupPoints(ctx context.Context, userID uuid.UUID) ([]models.PickupPoint, error) {
+	const op = "PickupRepository.GetFavoritePickupPoints"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("user_id", userID)
+
+	points, err := r.queryPickupPoints(ctx, queryGetFavoritePickupPoints, userID)
+	if err != nil {
+		logger.WithError(err).Error("query favorite pickup points")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return points, nil
+}
+
+// CreatePickupPoint сохраняет ПВЗ вместе с адресом и возвращает его с итоговым ID адреса
+func (r *PickupRepository) CreatePickupPoint(ctx context.Context, point models.PickupPoint) (models.PickupPoint, error) {
+	const op = "PickupRepository.CreatePickupPoint"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.WithError(err).Error("begin transaction")
+		return models.PickupPoint{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if err = tx.QueryRowContext(ctx, queryUpsertPickupAddress,
+		point.Address.ID,
+		point.Address.Region,
+		point.Address.City,
+		point.Address.AddressString,
+		point.Address.Coordinate,
+	).Scan(&point.Address.ID); err != nil {
+		logger.WithError(err).Error("upsert pickup point address")
+		return models.PickupPoint{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err = tx.ExecContext(ctx, queryCreatePickupPoint,
+		point.ID, point.Address.ID, point.Name, point.OpensAt, point.ClosesAt,
+	); err != nil {
+		logger.WithError(err).Error("create pickup point")
+		return models.PickupPoint{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.WithError(err).Error("commit transaction")
+		return models.PickupPoint{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return point, nil
+}
+
+func (r *PickupRepository) UpdatePickupPoint(ctx context.Context, point models.PickupPoint) error {
+	const op = "PickupRepository.UpdatePickupPoint"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("pickup_point_id", point.ID)
+
+	res, err := r.db.ExecContext(ctx, queryUpdatePickupPoint, point.ID, point.Name, point.OpensAt, point.ClosesAt)
+	if err != nil {
+		logger.WithError(err).Error("update pickup point")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return requireAffected(res, op, "pickup point not found")
+}
+
+func (r *PickupRepository) DeletePickupPoint(ctx context.Context, pointID uuid.UUID) error {
+	const op = "PickupRepository.DeletePickupPoint"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("pickup_point_id", pointID)
+
+	res, err := r.db.ExecContext(ctx, queryDeletePickupPoint, pointID)
+	if err != nil {
+		logger.WithError(err).Error("delete pickup point")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return requireAffected(res, op, "pickup point not found")
+}
+
+func (r *PickupRepository) AddFavoritePickupPoint(ctx context.Context, userID, pointID uuid.UUID) error {
+	const op = "PickupRepository.AddFavoritePickupPoint"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("pickup_point_id", pointID)
+
+	if _, err := r.db.ExecContext(ctx, queryAddFavoritePickupPoint, uuid.New(), userID, pointID); err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+			return fmt.Errorf("%s: %w", op, errs.NewNotFoundError("pickup point not found"))
+		}
+		logger.WithError(err).Error("add favorite pickup point")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *PickupRepository) RemoveFavoritePickupPoint(ctx context.Context, userID, pointID uuid.UUID) error {
+	const op = "PickupRepository.RemoveFavoritePickupPoint"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("pickup_point_id", pointID)
+
+	res, err := r.db.ExecContext(ctx, queryRemoveFavoritePickupPoint, userID, pointID)
+	if err != nil {
+		logger.WithError(err).Error("remove favorite pickup point")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return requireAffected(res, op, "favorite pickup point not found")
+}
+
+func requireAffected(res sql.Result, op, notFound string) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, errs.NewNotFoundError(notFound))
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
@@ -29,12 +30,39 @@ const (
 				p.status, p.price, p.quantity, p.updated_at, p.rating, p.reviews_count,
 				d.discounted_price, s.id, s.title, s.description
 		FROM bazaar.product p
-		LEFT JOIN bazaar.discount d ON p.id = d.product_id
+		LEFT JOIN bazaar.discount d ON p.id = d.product_id AND d.variant_id IS NULL
         LEFT JOIN bazaar.seller s ON s.user_id = p.seller_id
 		WHERE p.id = $1
 	`
 
+	// Варианты товара с действующей скидкой: своей или общей для всех SKU
+	queryGetProductVariants = `
+		SELECT v.id, v.product_id, v.sku, v.options, v.price, d.discounted_price,
+			v.quantity, v.images, v.position, v.updated_at
+		FROM bazaar.product_variant v
+		LEFT JOIN LATERAL (
+			SELECT discounted_price
+			FROM bazaar.discount
+			WHERE product_id = v.product_id
+				AND (variant_id IS NULL OR variant_id = v.id)
+				AND now() BETWEEN start_date AND end_date
+			ORDER BY start_date DESC
+			LIMIT 1
+		) d ON true
+		WHERE v.product_id = $1
+		ORDER BY v.position, v.created_at
+	`
+
+	// При $6 = true в выборку попадают товары всех потомков категории
 	queryGetProductsByCategoryWithFilterAndSort = `
+        WITH RECURSIVE categories AS (
+            SELECT id FROM bazaar.category WHERE id = $1
+            UNION ALL
+            SELECT c.id
+            FROM bazaar.category c
+            JOIN categories ON c.parent_id = categories.id
+            WHERE $6
+        )
         SELECT 
             p.id, 
             p.seller_id, 
@@ -49,13 +77,15 @@ const (
             p.reviews_count
         FROM 
             bazaar.product p
-        JOIN 
-            bazaar.product_subcategory pc ON p.id = pc.product_id
         WHERE 
-            pc.subcategory_id = $1 
+            EXISTS (
+                SELECT 1
+                FROM bazaar.product_subcategory pc
+                WHERE pc.product_id = p.id AND pc.subcategory_id IN (SELECT id FROM categories)
+            )
             AND p.status = 'approved'
-            AND ($3 = 0 OR p.price > $3)
-            AND ($4 = 0 OR p.price < $4)
+            AND ($3::numeric = 0 OR p.price > $3)
+            AND ($4::numeric = 0 OR p.price < $4)
             AND ($5 = 0::FLOAT OR p.rating > $5::FLOAT)
         ORDER BY %s
         LIMIT 20 OFFSET $2
@@ -106,7 +136,7 @@ func (p *ProductRepository) GetAllProducts(ctx context.Context, offset int) ([]*
 	defer rows.Close()
 
 	for rows.Next() {
-		var priceDiscount sql.NullFloat64
+		var priceDiscount models.NullMoney
 		product := &models.Product{}
 		err = rows.Scan(
 			&product.ID,
@@ -126,7 +156,7 @@ func (p *ProductRepository) GetAllProducts(ctx context.Context, offset int) ([]*
 			logger.WithError(err).Error("scan product row")
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
-		product.PriceDiscount = priceDiscount.Float64
+		product.PriceDiscount = priceDiscount.Money
 		productsList = append(productsList, product)
 	}
 
@@ -146,7 +176,7 @@ func (p *ProductRepository) GetProductByID(ctx context.Context, id uuid.UUID) (*
 	product := &models.Product{}
 	var seller models.Seller
 	var sellerID uuid.NullUUID
-	var priceDiscount sql.NullFloat64
+	var priceDiscount models.NullMoney
 	err := p.DB.QueryRowContext(ctx, queryGetProductByID, id).
 		Scan(
 			&product.ID,
@@ -178,16 +208,64 @@ func (p *ProductRepository) GetProductByID(ctx context.Context, id uuid.UUID) (*
 		seller.ID = sellerID.UUID
 		product.Seller = &seller
 	}
-	product.PriceDiscount = priceDiscount.Float64
+	product.PriceDiscount = priceDiscount.Money
 
 	return product, nil
 }
 
+// GetProductVariants возвращает SKU товара в порядке, заданном продавцом
+func (p *ProductRepository) GetProductVariants(ctx context.Context, productID uuid.UUID) ([]models.ProductVariant, error) {
+	const op = "ProductRepository.GetProductVariants"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("product_id", productID)
+
+	rows, err := p.DB.QueryContext(ctx, queryGetProductVariants, productID)
+	if err != nil {
+		logger.WithError(err).Error("query product variants")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	variants := []models.ProductVariant{}
+	for rows.Next() {
+		var (
+			variant       models.ProductVariant
+			priceDiscount models.NullMoney
+		)
+		if err = rows.Scan(
+			&variant.ID,
+			&variant.ProductID,
+			&variant.SKU,
+			&variant.Options,
+			&variant.Price,
+			&priceDiscount,
+			&variant.Quantity,
+			pq.Array(&variant.Images),
+			&variant.Position,
+			&variant.UpdatedAt,
+		); err != nil {
+			logger.WithError(err).Error("scan product variant")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if priceDiscount.Valid && priceDiscount.Money.LessThan(variant.Price) {
+			variant.PriceDiscount = priceDiscount.Money
+		}
+		variants = append(variants, variant)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return variants, nil
+}
+
 func (p *ProductRepository) GetProductsByCategory(
 	ctx context.Context,
 	id uuid.UUID,
+	includeDescendants bool,
 	offset int,
-	minPrice, maxPrice float64,
+	minPrice, maxPrice models.Money,
 	minRating float32,
 	sortOption models.SortOption,
 ) ([]*models.Product, error) {
@@ -220,6 +298,7 @@ func (p *ProductRepository) GetProductsByCategory(
 		minPrice,
 		maxPrice,
 		minRating,
+		includeDescendants,
 	)
 
 	if err != nil {
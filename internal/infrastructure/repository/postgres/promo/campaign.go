@@ -0,0 +1,288 @@
+package promo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const (
+	queryCreateCampaign = `
+		INSERT INTO bazaar.promo_campaign (id, name, prefix, alphabet, code_length, codes_count, is_active, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	// Коды, уже существующие в таблице, пропускаются; вызывающий сравнивает
+	// число вставленных строк с запрошенным
+	queryAddCampaignCodes = `
+		INSERT INTO bazaar.promo_code (
+			id, code, campaign_id, percent, start_date, end_date, discount_type, amount, min_order_amount,
+			max_discount, usage_limit, per_user_limit, first_order_only, stack_with_discounts
+		)
+		SELECT c.id, c.code, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+		FROM unnest($1::uuid[], $2::text[]) AS c(id, code)
+		ON CONFLICT (code) DO NOTHING
+	`
+
+	queryAddCampaignScope = `
+		INSERT INTO bazaar.promo_code_scope (promo_id, scope_type, target_id)
+		SELECT id, $2, $3
+		FROM bazaar.promo_code
+		WHERE campaign_id = $1
+	`
+
+	queryGetCampaign = `
+		SELECT id, name, prefix, alphabet, code_length, codes_count, is_active, created_at
+		FROM bazaar.promo_campaign
+		WHERE id = $1
+	`
+
+	queryDeactivateCampaign = `
+		UPDATE bazaar.promo_campaign
+		SET is_active = FALSE
+		WHERE id = $1
+	`
+
+	queryDeactivateCampaignCodes = `
+		UPDATE bazaar.promo_code
+		SET is_active = FALSE, updated_at = now()
+		WHERE campaign_id = $1
+	`
+
+	queryGetCampaignCodes = `
+		SELECT
+			pc.code,
+			pc.is_active,
+			EXISTS (SELECT 1 FROM bazaar.promo_redemption r WHERE r.promo_id = pc.id)
+		FROM bazaar.promo_code pc
+		WHERE pc.campaign_id = $1
+		ORDER BY pc.code
+	`
+
+	// Учитываются только использования в неотменённых заказах
+	queryGetCampaignStats = `
+		SELECT
+			c.id,
+			c.name,
+			c.is_active,
+			(SELECT COUNT(*) FROM bazaar.promo_code WHERE campaign_id = c.id),
+			COUNT(r.id),
+			COALESCE(SUM(o.total_price_discount), 0),
+			COALESCE(SUM(r.discount), 0),
+			COALESCE(ROUND(AVG(r.discount), 2), 0)
+		FROM bazaar.promo_campaign c
+		LEFT JOIN bazaar.promo_code pc ON pc.campaign_id = c.id
+		LEFT JOIN (
+			bazaar.promo_redemption r
+			JOIN bazaar."order" o ON o.id = r.order_id
+				AND o.status NOT IN ('canceled', 'canceled_by_user', 'canceled_by_seller', 'canceled_due_to_payment_error', 'payment_failed')
+		) ON r.promo_id = pc.id
+		WHERE c.id = $1
+		GROUP BY c.id
+	`
+)
+
+// CreateCampaign сохраняет кампанию и её коды в одной транзакции.
+// Если часть кодов уже занята, транзакция откатывается с errs.ErrAlreadyExists.
+func (r *PromoRepository) CreateCampaign(ctx context.Context, campaign models.PromoCampaign, codes []string) error {
+	const op = "PromoRepository.CreateCampaign"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.WithError(err).Error("begin transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, queryCreateCampaign,
+		campaign.ID,
+		campaign.Name,
+		campaign.Prefix,
+		campaign.Alphabet,
+		campaign.CodeLength,
+		campaign.CodesCount,
+		campaign.IsActive,
+		campaign.CreatedAt,
+	); err != nil {
+		logger.WithError(err).Error("create campaign")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	ids := make([]uuid.UUID, len(codes))
+	for i := range ids {
+		ids[i] = uuid.New()
+	}
+
+	template := campaign.Template
+	res, err := tx.ExecContext(ctx, queryAddCampaignCodes,
+		pq.Array(ids),
+		pq.Array(codes),
+		campaign.ID,
+		template.Percent,
+		template.StartDate,
+		template.EndDate,
+		template.DiscountType,
+		template.Amount,
+		template.MinOrderAmount,
+		template.MaxDiscount,
+		template.UsageLimit,
+		template.PerUserLimit,
+		template.FirstOrderOnly,
+		template.StackWithDiscounts,
+	)
+	if err != nil {
+		logger.WithError(err).Error("add campaign codes")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	inserted, err := res.RowsAffected()
+	if err != nil {
+		logger.WithError(err).Error("get rows affected")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if inserted != int64(len(codes)) {
+		logger.WithField("inserted", inserted).Warn("campaign codes collided with existing ones")
+		return fmt.Errorf("%s: %w", op, errs.NewAlreadyExistsError("promo code already exists"))
+	}
+
+	for _, scope := range template.Scopes {
+		if _, err = tx.ExecContext(ctx, queryAddCampaignScope, campaign.ID, scope.Type, scope.TargetID); err != nil {
+			logger.WithError(err).Error("add campaign scope")
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.WithError(err).Error("commit transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *PromoRepository) GetCampaign(ctx context.Context, campaignID uuid.UUID) (*models.PromoCampaign, error) {
+	const op = "PromoRepository.GetCampaign"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	var campaign models.PromoCampaign
+	if err := r.db.QueryRowContext(ctx, queryGetCampaign, campaignID).Scan(
+		&campaign.ID,
+		&campaign.Name,
+		&campaign.Prefix,
+		&campaign.Alphabet,
+		&campaign.CodeLength,
+		&campaign.CodesCount,
+		&campaign.IsActive,
+		&campaign.CreatedAt,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, errs.NewNotFoundError("promo campaign not found"))
+		}
+		logger.WithError(err).Error("get campaign")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &campaign, nil
+}
+
+// DeactivateCampaign выключает кампанию и все её коды
+func (r *PromoRepository) DeactivateCampaign(ctx context.Context, campaignID uuid.UUID) error {
+	const op = "PromoRepository.DeactivateCampaign"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.WithError(err).Error("begin transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, queryDeactivateCampaign, campaignID)
+	if err != nil {
+		logger.WithError(err).Error("deactivate campaign")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		logger.WithError(err).Error("get rows affected")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, errs.NewNotFoundError("promo campaign not found"))
+	}
+
+	if _, err = tx.ExecContext(ctx, queryDeactivateCampaignCodes, campaignID); err != nil {
+		logger.WithError(err).Error("deactivate campaign codes")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.WithError(err).Error("commit transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *PromoRepository) GetCampaignCodes(ctx context.Context, campaignID uuid.UUID) ([]models.PromoCampaignCode, error) {
+	const op = "PromoRepository.GetCampaignCodes"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	rows, err := r.db.QueryContext(ctx, queryGetCampaignCodes, campaignID)
+	if err != nil {
+		logger.WithError(err).Error("query campaign codes")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var codes []models.PromoCampaignCode
+	for rows.Next() {
+		var code models.PromoCampaignCode
+		if err = rows.Scan(&code.Code, &code.IsActive, &code.Redeemed); err != nil {
+			logger.WithError(err).Error("scan campaign code")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		codes = append(codes, code)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return codes, nil
+}
+
+func (r *PromoRepository) GetCampaignStats(ctx context.Context, campaignID uuid.UUID) (models.PromoCampaignStats, error) {
+	const op = "PromoRepository.GetCampaignStats"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	var stats models.PromoCampaignStats
+	if err := r.db.QueryRowContext(ctx, queryGetCampaignStats, campaignID).Scan(
+		&stats.CampaignID,
+		&stats.Name,
+		&stats.IsActive,
+		&stats.CodesTotal,
+		&stats.Redemptions,
+		&stats.Revenue,
+		&stats.TotalDiscount,
+		&stats.AverageDiscount,
+	); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.PromoCampaignStats{}, fmt.Errorf("%s: %w", op, errs.NewNotFoundError("promo campaign not found"))
+		}
+		logger.WithError(err).Error("get campaign stats")
+		return models.PromoCampaignStats{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return stats, nil
+}
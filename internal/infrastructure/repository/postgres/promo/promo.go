@@ -9,26 +9,97 @@ import (
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 const (
 	queryCreatePromoCode = `
-		INSERT INTO bazaar.promo_code (id, code, percent, start_date, end_date)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO bazaar.promo_code (
+			id, code, percent, start_date, end_date, discount_type, amount, min_order_amount,
+			max_discount, usage_limit, per_user_limit, first_order_only, stack_with_discounts
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`
+
+	queryAddPromoScope = `
+		INSERT INTO bazaar.promo_code_scope (promo_id, scope_type, target_id)
+		VALUES ($1, $2, $3)
 	`
 
 	queryGetAllPromoCode = `
-		SELECT id, code, percent, start_date, end_date
+		SELECT id, code, percent, start_date, end_date, discount_type, amount, min_order_amount,
+			max_discount, usage_limit, per_user_limit, first_order_only, stack_with_discounts
 		FROM bazaar.promo_code
+		WHERE campaign_id IS NULL
 		ORDER BY start_date DESC
 		LIMIT 50 OFFSET $1
 	`
 
 	queryCheckPromo = `
-        SELECT id, code, percent, start_date, end_date 
-        FROM bazaar.promo_code 
-        WHERE code = $1
-    `
+		SELECT id, code, percent, start_date, end_date, discount_type, amount, min_order_amount,
+			max_discount, usage_limit, per_user_limit, first_order_only, stack_with_discounts
+		FROM bazaar.promo_code
+		WHERE code = $1 AND is_active
+	`
+
+	queryGetPromoScopes = `
+		SELECT promo_id, scope_type, target_id
+		FROM bazaar.promo_code_scope
+		WHERE promo_id = ANY($1)
+	`
+
+	// Применения в отменённых заказах лимиты промокода не расходуют
+	queryGetPromoUsage = `
+		SELECT
+			COUNT(*),
+			COUNT(*) FILTER (WHERE r.user_id = $2),
+			(SELECT COUNT(*) FROM bazaar."order" WHERE user_id = $2
+				AND status NOT IN ('canceled', 'canceled_by_user', 'canceled_by_seller', 'canceled_due_to_payment_error', 'payment_failed'))
+		FROM bazaar.promo_redemption r
+		JOIN bazaar."order" o ON o.id = r.order_id
+		WHERE r.promo_id = $1
+			AND o.status NOT IN ('canceled', 'canceled_by_user', 'canceled_by_seller', 'canceled_due_to_payment_error', 'payment_failed')
+	`
+
+	// Категории товара и все их предки: промокод на раздел действует на всё его поддерево
+	productCategoriesExpr = `
+		ARRAY(
+			WITH RECURSIVE ancestors AS (
+				SELECT ps.subcategory_id AS id FROM bazaar.product_subcategory ps WHERE ps.product_id = p.id
+				UNION
+				SELECT c.parent_id
+				FROM bazaar.category c
+				JOIN ancestors a ON a.id = c.id
+				WHERE c.parent_id IS NOT NULL
+			)
+			SELECT id FROM ancestors
+		)`
+
+	queryGetUserCart = `
+		SELECT
+			p.id,
+			p.seller_id,
+			p.price,
+			COALESCE((
+				SELECT d.discounted_price
+				FROM bazaar.discount d
+				WHERE d.product_id = p.id AND now() BETWEEN d.start_date AND d.end_date
+				ORDER BY d.start_date DESC
+				LIMIT 1
+			), p.price),
+			bi.quantity,` + productCategoriesExpr + `
+		FROM bazaar.basket_item bi
+		JOIN bazaar.basket b ON b.id = bi.basket_id
+		JOIN bazaar.product p ON p.id = bi.product_id
+		WHERE b.user_id = $1
+	`
+
+	queryGetProductScopes = `
+		SELECT p.id, p.seller_id,` + productCategoriesExpr + `
+		FROM bazaar.product p
+		WHERE p.id = ANY($1)
+	`
 )
 
 type PromoRepository struct {
@@ -36,25 +107,52 @@ type PromoRepository struct {
 }
 
 func NewPromoRepository(db *sql.DB) *PromoRepository {
-	return &PromoRepository{db:db}
+	return &PromoRepository{db: db}
 }
 
 func (r *PromoRepository) Create(ctx context.Context, promo models.PromoCode) error {
 	const op = "PromoRepository.CreatePromoCode"
 	logger := logctx.GetLogger(ctx).WithField("op", op)
 
-	_, err := r.db.ExecContext(ctx, queryCreatePromoCode,
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.WithError(err).Error("begin transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, queryCreatePromoCode,
 		promo.ID,
 		promo.Code,
 		promo.Percent,
 		promo.StartDate,
 		promo.EndDate,
+		promo.DiscountType,
+		promo.Amount,
+		promo.MinOrderAmount,
+		promo.MaxDiscount,
+		promo.UsageLimit,
+		promo.PerUserLimit,
+		promo.FirstOrderOnly,
+		promo.StackWithDiscounts,
 	)
 	if err != nil {
 		logger.WithError(err).Error("create promo code")
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	for _, scope := range promo.Scopes {
+		if _, err = tx.ExecContext(ctx, queryAddPromoScope, promo.ID, scope.Type, scope.TargetID); err != nil {
+			logger.WithError(err).Error("add promo scope")
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.WithError(err).Error("commit transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	return nil
 }
 
@@ -76,14 +174,7 @@ func (r *PromoRepository) GetAll(ctx context.Context, offset int) ([]*models.Pro
 	defer rows.Close()
 
 	for rows.Next() {
-		promo := &models.PromoCode{}
-		err = rows.Scan(
-			&promo.ID,
-			&promo.Code,
-			&promo.Percent,
-			&promo.StartDate,
-			&promo.EndDate,
-		)
+		promo, err := scanPromo(rows)
 		if err != nil {
 			logger.WithError(err).Error("scan promo code")
 			return nil, fmt.Errorf("%s: %w", op, err)
@@ -96,26 +187,215 @@ func (r *PromoRepository) GetAll(ctx context.Context, offset int) ([]*models.Pro
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err = r.attachScopes(ctx, promoList); err != nil {
+		logger.WithError(err).Error("get promo scopes")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return promoList, nil
 }
 
 func (r *PromoRepository) CheckPromoCode(ctx context.Context, code string) (*models.PromoCode, error) {
-    const op = "PromoRepository.GetAllPromoCodes"
+	const op = "PromoRepository.CheckPromoCode"
 	logger := logctx.GetLogger(ctx).WithField("op", op)
-	
-	var promo models.PromoCode
-    err := r.db.QueryRowContext(ctx, queryCheckPromo, code).Scan(
-        &promo.ID,
-        &promo.Code,
-        &promo.Percent,
-        &promo.StartDate,
-        &promo.EndDate,
-    )
-    
-    if err != nil {
+
+	promo, err := scanPromo(r.db.QueryRowContext(ctx, queryCheckPromo, code))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.NewNotFoundError("promo code not found")
+		}
 		logger.WithError(err).Error("scan promo code")
-        return nil, err
-    }
-    
-    return &promo, nil
-}
\ No newline at end of file
+		return nil, err
+	}
+
+	if err = r.attachScopes(ctx, []*models.PromoCode{promo}); err != nil {
+		logger.WithError(err).Error("get promo scopes")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return promo, nil
+}
+
+// GetPromoUsage возвращает число использований промокода всего и пользователем,
+// а также число заказов пользователя
+func (r *PromoRepository) GetPromoUsage(ctx context.Context, promoID, userID uuid.UUID) (models.PromoUsage, error) {
+	const op = "PromoRepository.GetPromoUsage"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	var usage models.PromoUsage
+	if err := r.db.QueryRowContext(ctx, queryGetPromoUsage, promoID, userID).Scan(
+		&usage.Total,
+		&usage.ByUser,
+		&usage.UserOrders,
+	); err != nil {
+		logger.WithError(err).Error("get promo usage")
+		return models.PromoUsage{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return usage, nil
+}
+
+// GetUserCart возвращает позиции корзины пользователя с актуальными ценами
+func (r *PromoRepository) GetUserCart(ctx context.Context, userID uuid.UUID) ([]models.PromoCartItem, error) {
+	const op = "PromoRepository.GetUserCart"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	rows, err := r.db.QueryContext(ctx, queryGetUserCart, userID)
+	if err != nil {
+		logger.WithError(err).Error("query user cart")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var items []models.PromoCartItem
+	for rows.Next() {
+		var (
+			item        models.PromoCartItem
+			categoryIDs pq.StringArray
+		)
+		if err = rows.Scan(
+			&item.ProductID,
+			&item.SellerID,
+			&item.Price,
+			&item.FinalPrice,
+			&item.Quantity,
+			&categoryIDs,
+		); err != nil {
+			logger.WithError(err).Error("scan cart item")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if item.CategoryIDs, err = parseUUIDs(categoryIDs); err != nil {
+			logger.WithError(err).Error("parse category ids")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		items = append(items, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return items, nil
+}
+
+// GetProductScopes возвращает продавца и категории товаров для проверки области действия промокода
+func (r *PromoRepository) GetProductScopes(ctx context.Context, productIDs []uuid.UUID) (map[uuid.UUID]models.PromoProductScope, error) {
+	const op = "PromoRepository.GetProductScopes"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	rows, err := r.db.QueryContext(ctx, queryGetProductScopes, pq.Array(productIDs))
+	if err != nil {
+		logger.WithError(err).Error("query product scopes")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	scopes := make(map[uuid.UUID]models.PromoProductScope, len(productIDs))
+	for rows.Next() {
+		var (
+			productID   uuid.UUID
+			scope       models.PromoProductScope
+			categoryIDs pq.StringArray
+		)
+		if err = rows.Scan(&productID, &scope.SellerID, &categoryIDs); err != nil {
+			logger.WithError(err).Error("scan product scope")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if scope.CategoryIDs, err = parseUUIDs(categoryIDs); err != nil {
+			logger.WithError(err).Error("parse category ids")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		scopes[productID] = scope
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return scopes, nil
+}
+
+func (r *PromoRepository) attachScopes(ctx context.Context, promos []*models.PromoCode) error {
+	if len(promos) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*models.PromoCode, len(promos))
+	ids := make([]uuid.UUID, 0, len(promos))
+	for _, promo := range promos {
+		byID[promo.ID] = promo
+		ids = append(ids, promo.ID)
+	}
+
+	rows, err := r.db.QueryContext(ctx, queryGetPromoScopes, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			promoID   uuid.UUID
+			scopeType string
+			scope     models.PromoScope
+		)
+		if err = rows.Scan(&promoID, &scopeType, &scope.TargetID); err != nil {
+			return err
+		}
+		scope.Type = models.PromoScopeType(scopeType)
+
+		if promo, ok := byID[promoID]; ok {
+			promo.Scopes = append(promo.Scopes, scope)
+		}
+	}
+
+	return rows.Err()
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanPromo(row rowScanner) (*models.PromoCode, error) {
+	var (
+		promo        models.PromoCode
+		discountType string
+	)
+
+	if err := row.Scan(
+		&promo.ID,
+		&promo.Code,
+		&promo.Percent,
+		&promo.StartDate,
+		&promo.EndDate,
+		&discountType,
+		&promo.Amount,
+		&promo.MinOrderAmount,
+		&promo.MaxDiscount,
+		&promo.UsageLimit,
+		&promo.PerUserLimit,
+		&promo.FirstOrderOnly,
+		&promo.StackWithDiscounts,
+	); err != nil {
+		return nil, err
+	}
+	promo.DiscountType = models.PromoDiscountType(discountType)
+
+	return &promo, nil
+}
+
+func parseUUIDs(values []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(values))
+	for _, value := range values {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -10,18 +10,128 @@ import (
 
 const (
 	queryGetSubcategoryByProduct    = `SELECT subcategory_id FROM bazaar.product_subcategory WHERE product_id = $1`
-	queryGetProductIDsBySubcategory = `SELECT product_id 
-		FROM bazaar.product_subcategory 
-		WHERE subcategory_id = $1
-		ORDER BY RANDOM()
-		LIMIT 10`
-)
+	queryGetProductIDsBySubcategory = `SELECT ps.product_id
+		FROM bazaar.product_subcategory ps
+		JOIN bazaar.product p ON p.id = ps.product_id AND p.status = 'approved'
+		LEFT JOIN bazaar.product_popularity pp ON pp.product_id = ps.product_id
+		WHERE ps.subcategory_id = $1
+		ORDER BY COALESCE(pp.score, 0) DESC
+		LIMIT $2`
+
+	queryGetSimilarProductIDs = `SELECT ps.similar_product_id
+		FROM bazaar.product_similarity ps
+		JOIN bazaar.product p ON p.id = ps.similar_product_id AND p.status = 'approved'
+		WHERE ps.product_id = $1
+		ORDER BY ps.score DESC
+		LIMIT $2`
+
+	queryGetPersonalProductIDs = `WITH seen AS (
+			SELECT oi.product_id
+			FROM bazaar.order_item oi
+			JOIN bazaar."order" o ON o.id = oi.order_id
+			WHERE o.user_id = $1
+			UNION
+			SELECT bi.product_id
+			FROM bazaar.basket_item bi
+			JOIN bazaar.basket b ON b.id = bi.basket_id
+			WHERE b.user_id = $1
+		)
+		SELECT ps.similar_product_id
+		FROM bazaar.product_similarity ps
+		JOIN bazaar.product p ON p.id = ps.similar_product_id AND p.status = 'approved'
+		WHERE ps.product_id IN (SELECT product_id FROM seen)
+		  AND ps.similar_product_id NOT IN (SELECT product_id FROM seen)
+		GROUP BY ps.similar_product_id
+		ORDER BY SUM(ps.score) DESC
+		LIMIT $2`
+
+	queryGetPopularProductIDs = `SELECT pp.product_id
+		FROM bazaar.product_popularity pp
+		JOIN bazaar.product p ON p.id = pp.product_id AND p.status = 'approved'
+		ORDER BY pp.score DESC, p.rating DESC
+		LIMIT $1`
+
+	// refreshLockID — ключ advisory-блокировки, чтобы пересчёт выполняла только одна реплика
+	refreshLockID = 7301
+
+	queryTryRefreshLock = `SELECT pg_try_advisory_xact_lock($1)`
+
+	queryClearSimilarity = `DELETE FROM bazaar.product_similarity`
+
+	// Косинусная похожесть товаров по пользователям: заказ весит 2, товар в корзине — 1.
+	// Для каждого товара сохраняются только $1 самых похожих.
+	queryFillSimilarity = `WITH interactions AS (
+			SELECT o.user_id, oi.product_id, 2.0 AS weight
+			FROM bazaar.order_item oi
+			JOIN bazaar."order" o ON o.id = oi.order_id
+			UNION ALL
+			SELECT b.user_id, bi.product_id, 1.0 AS weight
+			FROM bazaar.basket_item bi
+			JOIN bazaar.basket b ON b.id = bi.basket_id
+		),
+		user_product AS (
+			SELECT user_id, product_id, MAX(weight) AS weight
+			FROM interactions
+			GROUP BY user_id, product_id
+		),
+		product_norm AS (
+			SELECT product_id, SQRT(SUM(weight * weight)) AS norm
+			FROM user_product
+			GROUP BY product_id
+		),
+		pairs AS (
+			SELECT a.product_id, b.product_id AS similar_product_id, SUM(a.weight * b.weight) AS dot
+			FROM user_product a
+			JOIN user_product b ON a.user_id = b.user_id AND a.product_id <> b.product_id
+			GROUP BY a.product_id, b.product_id
+		),
+		ranked AS (
+			SELECT
+				pairs.product_id,
+				pairs.similar_product_id,
+				pairs.dot / (na.norm * nb.norm) AS score,
+				ROW_NUMBER() OVER (
+					PARTITION BY pairs.product_id
+					ORDER BY pairs.dot / (na.norm * nb.norm) DESC
+				) AS rn
+			FROM pairs
+			JOIN product_norm na ON na.product_id = pairs.product_id
+			JOIN product_norm nb ON nb.product_id = pairs.similar_product_id
+		)
+		INSERT INTO bazaar.product_similarity (product_id, similar_product_id, score)
+		SELECT product_id, similar_product_id, score
+		FROM ranked
+		WHERE rn <= $1`
 
+	queryClearPopularity = `DELETE FROM bazaar.product_popularity`
+
+	// Популярность за последние 30 дней: проданные штуки весят 2, добавления в корзину — 1
+	queryFillPopularity = `INSERT INTO bazaar.product_popularity (product_id, score)
+		SELECT p.id, COALESCE(sold.cnt, 0) * 2 + COALESCE(carted.cnt, 0)
+		FROM bazaar.product p
+		LEFT JOIN (
+			SELECT oi.product_id, SUM(oi.quantity) AS cnt
+			FROM bazaar.order_item oi
+			JOIN bazaar."order" o ON o.id = oi.order_id
+			WHERE o.created_at >= now() - INTERVAL '30 days'
+			GROUP BY oi.product_id
+		) sold ON sold.product_id = p.id
+		LEFT JOIN (
+			SELECT product_id, COUNT(*) AS cnt
+			FROM bazaar.basket_item
+			GROUP BY product_id
+		) carted ON carted.product_id = p.id
+		WHERE p.status = 'approved'`
+)
 
 //go:generate mockgen -source=recommendation.go -destination=../mocks/recommendation_repository_mock.go -package=mocks IRecommendationRepository
 type IRecommendationRepository interface {
 	GetCategoryIDsByProductID(context.Context, uuid.UUID) ([]uuid.UUID, error)
 	GetProductIDsBySubcategoryID(ctx context.Context, subcategoryID uuid.UUID, count int) ([]uuid.UUID, error)
+	GetSimilarProductIDs(ctx context.Context, productID uuid.UUID, count int) ([]uuid.UUID, error)
+	GetPersonalProductIDs(ctx context.Context, userID uuid.UUID, count int) ([]uuid.UUID, error)
+	GetPopularProductIDs(ctx context.Context, count int) ([]uuid.UUID, error)
+	RefreshSimilarity(ctx context.Context, perProduct int) (bool, error)
 }
 
 type RecommendationRepository struct {
@@ -74,7 +184,7 @@ func (r *RecommendationRepository) GetProductIDsBySubcategoryID(ctx context.Cont
 	const op = "RecommendationRepository.GetProductIDsBySubcategoryID"
 	logger := logctx.GetLogger(ctx).WithField("op", op)
 
-	rows, err := r.db.QueryContext(ctx, queryGetProductIDsBySubcategory, subcategoryID)
+	rows, err := r.db.QueryContext(ctx, queryGetProductIDsBySubcategory, subcategoryID, count)
 	if err != nil {
 		logger.WithError(err).Error("query all product ids by subcategory id")
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -104,3 +214,116 @@ func (r *RecommendationRepository) GetProductIDsBySubcategoryID(ctx context.Cont
 
 	return productIDsList, nil
 }
+
+// GetSimilarProductIDs возвращает товары, которые чаще всего покупают вместе с данным
+func (r *RecommendationRepository) GetSimilarProductIDs(ctx context.Context, productID uuid.UUID, count int) ([]uuid.UUID, error) {
+	const op = "RecommendationRepository.GetSimilarProductIDs"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	ids, err := r.queryProductIDs(ctx, queryGetSimilarProductIDs, productID, count)
+	if err != nil {
+		logger.WithError(err).Error("query similar product ids")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return ids, nil
+}
+
+// GetPersonalProductIDs подбирает товары, похожие на заказанные пользователем или лежащие в его корзине
+func (r *RecommendationRepository) GetPersonalProductIDs(ctx context.Context, userID uuid.UUID, count int) ([]uuid.UUID, error) {
+	const op = "RecommendationRepository.GetPersonalProductIDs"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	ids, err := r.queryProductIDs(ctx, queryGetPersonalProductIDs, userID, count)
+	if err != nil {
+		logger.WithError(err).Error("query personal product ids")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return ids, nil
+}
+
+// GetPopularProductIDs возвращает самые популярные одобренные товары
+func (r *RecommendationRepository) GetPopularProductIDs(ctx context.Context, count int) ([]uuid.UUID, error) {
+	const op = "RecommendationRepository.GetPopularProductIDs"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	ids, err := r.queryProductIDs(ctx, queryGetPopularProductIDs, count)
+	if err != nil {
+		logger.WithError(err).Error("query popular product ids")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return ids, nil
+}
+
+func (r *RecommendationRepository) queryProductIDs(ctx context.Context, query string, args ...interface{}) ([]uuid.UUID, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// RefreshSimilarity пересобирает таблицы похожести и популярности товаров.
+// Возвращает false, если пересчёт уже выполняет другая реплика.
+func (r *RecommendationRepository) RefreshSimilarity(ctx context.Context, perProduct int) (bool, error) {
+	const op = "RecommendationRepository.RefreshSimilarity"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.WithError(err).Error("begin transaction")
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var locked bool
+	if err := tx.QueryRowContext(ctx, queryTryRefreshLock, refreshLockID).Scan(&locked); err != nil {
+		logger.WithError(err).Error("acquire refresh lock")
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	if !locked {
+		return false, nil
+	}
+
+	steps := []struct {
+		name  string
+		query string
+		args  []interface{}
+	}{
+		{"clear similarity", queryClearSimilarity, nil},
+		{"fill similarity", queryFillSimilarity, []interface{}{perProduct}},
+		{"clear popularity", queryClearPopularity, nil},
+		{"fill popularity", queryFillPopularity, nil},
+	}
+
+	for _, step := range steps {
+		if _, err := tx.ExecContext(ctx, step.query, step.args...); err != nil {
+			logger.WithError(err).Error(step.name)
+			return false, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.WithError(err).Error("commit transaction")
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return true, nil
+}
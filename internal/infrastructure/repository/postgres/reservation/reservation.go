@@ -0,0 +1,257 @@
+package reservation
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/inventory"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/google/uuid"
+)
+
+const (
+	// Списывает товар из остатка, только если его хватает: проверка и списание
+	// выполняются одним запросом, поэтому параллельные резервы не уводят остаток в минус
+	// Товар с вариантами так не списывается: его остаток — сумма остатков SKU
+	queryTakeStock = `
+		UPDATE bazaar.product
+		SET quantity = quantity - $1
+		WHERE id = $2 AND status = 'approved' AND quantity >= $1
+			AND NOT EXISTS (SELECT 1 FROM bazaar.product_variant WHERE product_id = $2)`
+
+	// Списывает остаток SKU; остаток родительского товара пересчитывает триггер
+	queryTakeVariantStock = `
+		UPDATE bazaar.product_variant v
+		SET quantity = v.quantity - $1
+		FROM bazaar.product p
+		WHERE v.id = $3 AND v.product_id = $2 AND p.id = v.product_id
+			AND p.status = 'approved' AND v.quantity >= $1`
+
+	queryAddReservation = `
+		INSERT INTO bazaar.stock_reservation (id, user_id, product_id, variant_id, quantity, status, expires_at)
+		VALUES ($1, $2, $3, $4, $5, 'held', $6)`
+
+	// Отменяет действующие резервы пользователя и возвращает товар в остаток
+	queryReleaseUserReservations = `
+		WITH released AS (
+			UPDATE bazaar.stock_reservation
+			SET status = 'released', updated_at = now()
+			WHERE user_id = $1 AND status = 'held'
+			RETURNING product_id, variant_id, quantity
+		), restored AS (
+			UPDATE bazaar.product p
+			SET quantity = p.quantity + r.quantity
+			FROM (
+				SELECT product_id, SUM(quantity) AS quantity FROM released
+				WHERE variant_id IS NULL GROUP BY product_id
+			) r
+			WHERE p.id = r.product_id
+		), restored_variants AS (
+			UPDATE bazaar.product_variant v
+			SET quantity = v.quantity + r.quantity
+			FROM (
+				SELECT variant_id, SUM(quantity) AS quantity FROM released
+				WHERE variant_id IS NOT NULL GROUP BY variant_id
+			) r
+			WHERE v.id = r.variant_id
+		)
+		SELECT COUNT(*) FROM released`
+
+	// Освобождает пачку просроченных резервов. SKIP LOCKED позволяет нескольким
+	// экземплярам приложения чистить резервы параллельно, не мешая оформлению заказов.
+	queryReleaseExpired = `
+		WITH released AS (
+			UPDATE bazaar.stock_reservation
+			SET status = 'released', updated_at = now()
+			WHERE id IN (
+				SELECT id FROM bazaar.stock_reservation
+				WHERE status = 'held' AND expires_at <= now()
+				ORDER BY expires_at
+				LIMIT $1
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING product_id, variant_id, quantity
+		), restored AS (
+			UPDATE bazaar.product p
+			SET quantity = p.quantity + r.quantity
+			FROM (
+				SELECT product_id, SUM(quantity) AS quantity FROM released
+				WHERE variant_id IS NULL GROUP BY product_id
+			) r
+			WHERE p.id = r.product_id
+		), restored_variants AS (
+			UPDATE bazaar.product_variant v
+			SET quantity = v.quantity + r.quantity
+			FROM (
+				SELECT variant_id, SUM(quantity) AS quantity FROM released
+				WHERE variant_id IS NOT NULL GROUP BY variant_id
+			) r
+			WHERE v.id = r.variant_id
+		)
+		SELECT COUNT(*) FROM released`
+)
+
+type ReservationRepository struct {
+	db *sql.DB
+}
+
+func NewReservationRepository(db *sql.DB) *ReservationRepository {
+	return &ReservationRepository{
+		db: db,
+	}
+}
+
+// Hold резервирует товары для пользователя до expiresAt. Прежние резервы
+// пользователя отменяются. Если хотя бы одного товара не хватает, не
+// резервируется ничего и возвращается errs.ErrNotEnoughStock.
+func (r *ReservationRepository) Hold(
+	ctx context.Context,
+	userID uuid.UUID,
+	items []models.PricingItem,
+	expiresAt time.Time,
+) ([]models.StockReservation, error) {
+	const op = "ReservationRepository.Hold"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("user_id", userID)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.WithError(err).Error("begin transaction")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	actor := uuid.NullUUID{UUID: userID, Valid: true}
+	if err = inventory.SetStockContext(ctx, tx, models.StockChange{Kind: models.StockRelease, ActorID: actor}); err != nil {
+		logger.WithError(err).Error("set stock context")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var released int64
+	if err = tx.QueryRowContext(ctx, queryReleaseUserReservations, userID).Scan(&released); err != nil {
+		logger.WithError(err).Error("release previous reservations")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if released > 0 {
+		logger.WithField("released", released).Debug("previous reservations released")
+	}
+
+	// Строки товаров блокируются в одном порядке, чтобы параллельные резервы не взаимоблокировались
+	sorted := make([]models.PricingItem, len(items))
+	copy(sorted, items)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Key().Less(sorted[j].Key())
+	})
+
+	if err = inventory.SetStockContext(ctx, tx, models.StockChange{Kind: models.StockReserve, ActorID: actor}); err != nil {
+		logger.WithError(err).Error("set stock context")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	reservations := make([]models.StockReservation, 0, len(sorted))
+	for _, item := range sorted {
+		if err = TakeStock(ctx, tx, item.Key(), item.Quantity); err != nil {
+			logger.WithError(err).WithField("product_id", item.ProductID).Warn("take stock")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		reservation := models.StockReservation{
+			ID:        uuid.New(),
+			UserID:    userID,
+			ProductID: item.ProductID,
+			VariantID: item.VariantID,
+			Quantity:  item.Quantity,
+			Status:    models.ReservationHeld,
+			ExpiresAt: expiresAt,
+		}
+		if _, err = tx.ExecContext(ctx, queryAddReservation,
+			reservation.ID,
+			reservation.UserID,
+			reservation.ProductID,
+			reservation.VariantID,
+			reservation.Quantity,
+			reservation.ExpiresAt,
+		); err != nil {
+			logger.WithError(err).Error("add reservation")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		reservations = append(reservations, reservation)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.WithError(err).Error("commit transaction")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return reservations, nil
+}
+
+// ReleaseExpired возвращает в остаток не более limit просроченных резервов
+// и сообщает, сколько резервов освобождено
+func (r *ReservationRepository) ReleaseExpired(ctx context.Context, limit int) (int64, error) {
+	const op = "ReservationRepository.ReleaseExpired"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.WithError(err).Error("begin transaction")
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if err = inventory.SetStockContext(ctx, tx, models.StockChange{
+		Kind:   models.StockRelease,
+		Reason: "reservation expired",
+	}); err != nil {
+		logger.WithError(err).Error("set stock context")
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var released int64
+	if err = tx.QueryRowContext(ctx, queryReleaseExpired, limit).Scan(&released); err != nil {
+		logger.WithError(err).Error("release expired reservations")
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.WithError(err).Error("commit transaction")
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return released, nil
+}
+
+// TakeStock атомарно списывает quantity единиц товара или его SKU внутри транзакции.
+// Если товара не хватает или он не одобрен, возвращает errs.ErrNotEnoughStock.
+func TakeStock(ctx context.Context, tx *sql.Tx, key models.StockKey, quantity uint) error {
+	var (
+		res sql.Result
+		err error
+	)
+	if key.VariantID.Valid {
+		res, err = tx.ExecContext(ctx, queryTakeVariantStock, quantity, key.ProductID, key.VariantID.UUID)
+	} else {
+		res, err = tx.ExecContext(ctx, queryTakeStock, quantity, key.ProductID)
+	}
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		if key.VariantID.Valid {
+			return fmt.Errorf("variant %s: %w", key.VariantID.UUID, errs.ErrNotEnoughStock)
+		}
+		return fmt.Errorf("product %s: %w", key.ProductID, errs.ErrNotEnoughStock)
+	}
+
+	return nil
+}
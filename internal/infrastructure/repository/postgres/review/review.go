@@ -5,37 +5,151 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
 	"github.com/google/uuid"
+	"github.com/guregu/null"
 	"github.com/lib/pq"
 )
 
 const (
 	queryAddReview = `
-		INSERT INTO bazaar.review (id, user_id, product_id, rating, comment)
-			VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO bazaar.review (id, user_id, product_id, rating, comment, verified_purchase, status, moderation_reason)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''))
 	`
 
 	queryUpdateCount = `
 		UPDATE bazaar.product
-		SET reviews_count = reviews_count + 1 
+		SET reviews_count = reviews_count + 1
 		WHERE id = $1
 	`
 
 	queryGetReview = `
-		SELECT 
-			r.id, u.name, u.surname, u.image_url, r.rating, r.comment
+		SELECT
+			r.id, u.name, u.surname, u.image_url, r.rating, r.comment,
+			r.helpful_count, r.unhelpful_count, r.created_at, r.verified_purchase,
+			rr.text, rr.created_at
 		FROM bazaar.review r
 		JOIN bazaar.user u ON r.user_id = u.id
-		WHERE r.product_id = $1
-		ORDER BY r.created_at DESC
+		LEFT JOIN bazaar.review_reply rr ON rr.review_id = r.id
+		WHERE r.product_id = $1 AND r.status = 'published'
+		ORDER BY %s
         LIMIT 7 OFFSET $2
 	`
+
+	queryGetReviewPhotos = `
+		SELECT review_id, image_url
+		FROM bazaar.review_photo
+		WHERE review_id = ANY($1)
+		ORDER BY created_at
+	`
+
+	// Правка сама публикует только уже опубликованный отзыв: отклонённый
+	// или ожидающий проверки отзыв после правки снова уходит модератору
+	queryUpdateReview = `
+		UPDATE bazaar.review
+		SET rating = $1, comment = $2,
+			status = CASE WHEN status = 'published' THEN $3::bazaar.review_status ELSE 'pending'::bazaar.review_status END,
+			moderation_reason = CASE WHEN status = 'published' THEN NULLIF($4, '')
+				ELSE COALESCE(NULLIF($4, ''), moderation_reason) END
+		WHERE id = $5 AND user_id = $6
+	`
+
+	queryDeleteReview = `
+		DELETE FROM bazaar.review
+		WHERE id = $1 AND user_id = $2
+	`
+
+	queryGetReviewAuthor = `
+		SELECT user_id FROM bazaar.review WHERE id = $1
+	`
+
+	queryGetReviewProduct = `
+		SELECT product_id FROM bazaar.review WHERE id = $1
+	`
+
+	queryGetReviewSeller = `
+		SELECT p.seller_id
+		FROM bazaar.review r
+		JOIN bazaar.product p ON p.id = r.product_id
+		WHERE r.id = $1
+	`
+
+	queryLockReview = `
+		SELECT id FROM bazaar.review WHERE id = $1 FOR UPDATE
+	`
+
+	queryAddReviewPhoto = `
+		INSERT INTO bazaar.review_photo (id, review_id, image_url)
+		SELECT $1, $2, $3
+		WHERE (SELECT COUNT(*) FROM bazaar.review_photo WHERE review_id = $2) < $4
+	`
+
+	queryUpsertVote = `
+		INSERT INTO bazaar.review_vote (id, review_id, user_id, is_helpful)
+			VALUES ($1, $2, $3, $4)
+		ON CONFLICT (review_id, user_id) DO UPDATE SET is_helpful = EXCLUDED.is_helpful
+	`
+
+	queryHasDeliveredPurchase = `
+		SELECT EXISTS (
+			SELECT 1
+			FROM bazaar.order_item oi
+			JOIN bazaar."order" o ON o.id = oi.order_id
+			WHERE o.user_id = $1 AND oi.product_id = $2 AND o.status = 'delivered'
+		)
+	`
+
+	queryCountUserReviewsSince = `
+		SELECT COUNT(*)
+		FROM bazaar.review
+		WHERE user_id = $1 AND id <> $2 AND created_at >= $3
+	`
+
+	queryHasDuplicateReview = `
+		SELECT EXISTS (
+			SELECT 1
+			FROM bazaar.review
+			WHERE id <> $1
+			  AND (user_id = $2
+			       OR product_id = COALESCE((SELECT product_id FROM bazaar.review WHERE id = $1), $3))
+			  AND lower(btrim(comment)) = lower(btrim($4))
+		)
+	`
+
+	queryGetReviewSummary = `
+		SELECT
+			COUNT(*),
+			COUNT(*) FILTER (WHERE r.rating = 1),
+			COUNT(*) FILTER (WHERE r.rating = 2),
+			COUNT(*) FILTER (WHERE r.rating = 3),
+			COUNT(*) FILTER (WHERE r.rating = 4),
+			COUNT(*) FILTER (WHERE r.rating = 5),
+			COUNT(*) FILTER (WHERE r.verified_purchase),
+			COUNT(*) FILTER (WHERE EXISTS (
+				SELECT 1 FROM bazaar.review_photo rp WHERE rp.review_id = r.id
+			))
+		FROM bazaar.review r
+		WHERE r.product_id = $1 AND r.status = 'published'
+	`
+
+	queryAddReply = `
+		INSERT INTO bazaar.review_reply (id, review_id, seller_id, text)
+			VALUES ($1, $2, $3, $4)
+	`
 )
 
+// reviewOrderClauses сопоставляет вариант сортировки с выражением ORDER BY
+var reviewOrderClauses = map[models.ReviewSort]string{
+	models.ReviewSortNewest:  "r.created_at DESC",
+	models.ReviewSortHighest: "r.rating DESC, r.created_at DESC",
+	models.ReviewSortLowest:  "r.rating ASC, r.created_at DESC",
+	models.ReviewSortHelpful: "r.helpful_count DESC, r.created_at DESC",
+}
+
 type ReviewRepository struct {
 	DB *sql.DB
 }
@@ -69,6 +183,9 @@ func (r *ReviewRepository) AddReview(ctx context.Context, review models.ReviewDB
 		review.ProductID,
 		review.Rating,
 		review.Comment,
+		review.VerifiedPurchase,
+		review.Status,
+		review.ModerationReason,
 	)
 	if err != nil {
 		// Проверяем, является ли ошибка нарушением уникальности
@@ -93,13 +210,18 @@ func (r *ReviewRepository) AddReview(ctx context.Context, review models.ReviewDB
 	return nil
 }
 
-func (r *ReviewRepository) GetReview(ctx context.Context, productID uuid.UUID, offset int) ([]*models.Review, error) {
+func (r *ReviewRepository) GetReview(ctx context.Context, productID uuid.UUID, offset int, sort models.ReviewSort) ([]*models.Review, error) {
 	const op = "ReviewRepository.GetReview"
 	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("productID", productID)
 
+	orderClause, ok := reviewOrderClauses[sort]
+	if !ok {
+		orderClause = reviewOrderClauses[models.ReviewSortNewest]
+	}
+
 	reviewList := []*models.Review{}
 
-	rows, err := r.DB.QueryContext(ctx, queryGetReview, productID, offset)
+	rows, err := r.DB.QueryContext(ctx, fmt.Sprintf(queryGetReview, orderClause), productID, offset)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
             logger.Warn("no reviews on this product")
@@ -110,8 +232,11 @@ func (r *ReviewRepository) GetReview(ctx context.Context, productID uuid.UUID, o
 	}
 	defer rows.Close()
 
+	reviewByID := make(map[uuid.UUID]*models.Review)
 	for rows.Next() {
 		review := &models.Review{}
+		var replyText null.String
+		var replyCreatedAt null.Time
 		err = rows.Scan(
 			&review.ID,
 			&review.Name,
@@ -119,12 +244,28 @@ func (r *ReviewRepository) GetReview(ctx context.Context, productID uuid.UUID, o
 			&review.ImageURL,
 			&review.Rating,
 			&review.Comment,
+			&review.HelpfulCount,
+			&review.UnhelpfulCount,
+			&review.CreatedAt,
+			&review.VerifiedPurchase,
+			&replyText,
+			&replyCreatedAt,
 		)
 		if err != nil {
 			logger.WithError(err).Error("scan review")
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
+
+		if replyText.Valid {
+			review.Reply = &models.ReviewReply{
+				Text:      replyText.String,
+				CreatedAt: replyCreatedAt.Time,
+			}
+		}
+
+		review.Photos = []string{}
 		reviewList = append(reviewList, review)
+		reviewByID[review.ID] = review
 	}
 
 	if err = rows.Err(); err != nil {
@@ -132,5 +273,304 @@ func (r *ReviewRepository) GetReview(ctx context.Context, productID uuid.UUID, o
         return nil, fmt.Errorf("%s: %w", op, err)
     }
 
+	if len(reviewList) == 0 {
+		return reviewList, nil
+	}
+
+	if err := r.attachPhotos(ctx, reviewByID); err != nil {
+		logger.WithError(err).Error("attach photos")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	return reviewList, nil
-}
\ No newline at end of file
+}
+
+// attachPhotos подгружает фотографии одним запросом для всей страницы отзывов
+func (r *ReviewRepository) attachPhotos(ctx context.Context, reviewByID map[uuid.UUID]*models.Review) error {
+	ids := make([]string, 0, len(reviewByID))
+	for id := range reviewByID {
+		ids = append(ids, id.String())
+	}
+
+	rows, err := r.DB.QueryContext(ctx, queryGetReviewPhotos, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var reviewID uuid.UUID
+		var imageURL string
+		if err := rows.Scan(&reviewID, &imageURL); err != nil {
+			return err
+		}
+		if review, ok := reviewByID[reviewID]; ok {
+			review.Photos = append(review.Photos, imageURL)
+		}
+	}
+
+	return rows.Err()
+}
+
+// HasDeliveredPurchase проверяет, что пользователь получил товар хотя бы в одном заказе
+func (r *ReviewRepository) HasDeliveredPurchase(ctx context.Context, userID, productID uuid.UUID) (bool, error) {
+	const op = "ReviewRepository.HasDeliveredPurchase"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("productID", productID)
+
+	var purchased bool
+	if err := r.DB.QueryRowContext(ctx, queryHasDeliveredPurchase, userID, productID).Scan(&purchased); err != nil {
+		logger.WithError(err).Error("check delivered purchase")
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return purchased, nil
+}
+
+// GetReviewSummary считает распределение оценок и доли отзывов с фото и подтверждённой покупкой
+func (r *ReviewRepository) GetReviewSummary(ctx context.Context, productID uuid.UUID) (*models.ReviewSummary, error) {
+	const op = "ReviewRepository.GetReviewSummary"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("productID", productID)
+
+	summary := &models.ReviewSummary{ProductID: productID}
+	var verified, withPhotos int
+	err := r.DB.QueryRowContext(ctx, queryGetReviewSummary, productID).Scan(
+		&summary.Total,
+		&summary.StarCounts[0],
+		&summary.StarCounts[1],
+		&summary.StarCounts[2],
+		&summary.StarCounts[3],
+		&summary.StarCounts[4],
+		&verified,
+		&withPhotos,
+	)
+	if err != nil {
+		logger.WithError(err).Error("query review summary")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if summary.Total > 0 {
+		summary.VerifiedShare = float64(verified) / float64(summary.Total)
+		summary.WithPhotosShare = float64(withPhotos) / float64(summary.Total)
+	}
+
+	return summary, nil
+}
+
+// CountUserReviewsSince считает отзывы пользователя, оставленные после since, не учитывая excludeID
+func (r *ReviewRepository) CountUserReviewsSince(ctx context.Context, userID, excludeID uuid.UUID, since time.Time) (int, error) {
+	const op = "ReviewRepository.CountUserReviewsSince"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("userID", userID)
+
+	var count int
+	if err := r.DB.QueryRowContext(ctx, queryCountUserReviewsSince, userID, excludeID, since).Scan(&count); err != nil {
+		logger.WithError(err).Error("count user reviews")
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return count, nil
+}
+
+// HasDuplicateReview проверяет, встречался ли такой же текст у других отзывов автора или товара
+func (r *ReviewRepository) HasDuplicateReview(ctx context.Context, review models.ReviewDB) (bool, error) {
+	const op = "ReviewRepository.HasDuplicateReview"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("reviewID", review.ID)
+
+	var duplicate bool
+	err := r.DB.QueryRowContext(ctx, queryHasDuplicateReview,
+		review.ID,
+		review.UserID,
+		review.ProductID,
+		review.Comment,
+	).Scan(&duplicate)
+	if err != nil {
+		logger.WithError(err).Error("check duplicate review")
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return duplicate, nil
+}
+
+// UpdateReview сохраняет правку отзыва автором. Статус review.Status применяется
+// только к опубликованному отзыву, остальные возвращаются на модерацию.
+func (r *ReviewRepository) UpdateReview(ctx context.Context, review models.ReviewDB) error {
+	const op = "ReviewRepository.UpdateReview"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("reviewID", review.ID)
+
+	res, err := r.DB.ExecContext(ctx, queryUpdateReview,
+		review.Rating,
+		review.Comment,
+		review.Status,
+		review.ModerationReason,
+		review.ID,
+		review.UserID,
+	)
+	if err != nil {
+		logger.WithError(err).Error("update review")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		logger.WithError(err).Error("get rows affected")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		logger.Warn("review not found")
+		return fmt.Errorf("%s: %w", op, errs.NewNotFoundError("review not found"))
+	}
+
+	return nil
+}
+
+func (r *ReviewRepository) DeleteReview(ctx context.Context, reviewID, userID uuid.UUID) error {
+	const op = "ReviewRepository.DeleteReview"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("reviewID", reviewID)
+
+	res, err := r.DB.ExecContext(ctx, queryDeleteReview, reviewID, userID)
+	if err != nil {
+		logger.WithError(err).Error("delete review")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		logger.WithError(err).Error("get rows affected")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		logger.Warn("review not found")
+		return fmt.Errorf("%s: %w", op, errs.NewNotFoundError("review not found"))
+	}
+
+	return nil
+}
+
+func (r *ReviewRepository) GetReviewAuthorID(ctx context.Context, reviewID uuid.UUID) (uuid.UUID, error) {
+	const op = "ReviewRepository.GetReviewAuthorID"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("reviewID", reviewID)
+
+	var userID uuid.UUID
+	err := r.DB.QueryRowContext(ctx, queryGetReviewAuthor, reviewID).Scan(&userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Warn("review not found")
+			return uuid.Nil, fmt.Errorf("%s: %w", op, errs.NewNotFoundError("review not found"))
+		}
+		logger.WithError(err).Error("get review author")
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return userID, nil
+}
+
+func (r *ReviewRepository) GetReviewProductID(ctx context.Context, reviewID uuid.UUID) (uuid.UUID, error) {
+	const op = "ReviewRepository.GetReviewProductID"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("reviewID", reviewID)
+
+	var productID uuid.UUID
+	err := r.DB.QueryRowContext(ctx, queryGetReviewProduct, reviewID).Scan(&productID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Warn("review not found")
+			return uuid.Nil, fmt.Errorf("%s: %w", op, errs.NewNotFoundError("review not found"))
+		}
+		logger.WithError(err).Error("get review product")
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return productID, nil
+}
+
+func (r *ReviewRepository) GetReviewSellerID(ctx context.Context, reviewID uuid.UUID) (uuid.UUID, error) {
+	const op = "ReviewRepository.GetReviewSellerID"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("reviewID", reviewID)
+
+	var sellerID uuid.UUID
+	err := r.DB.QueryRowContext(ctx, queryGetReviewSeller, reviewID).Scan(&sellerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Warn("review not found")
+			return uuid.Nil, fmt.Errorf("%s: %w", op, errs.NewNotFoundError("review not found"))
+		}
+		logger.WithError(err).Error("get review seller")
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return sellerID, nil
+}
+
+// AddReviewPhoto добавляет фото, если у отзыва их меньше limit. Строка отзыва
+// блокируется до конца транзакции, чтобы параллельные загрузки не превысили лимит
+func (r *ReviewRepository) AddReviewPhoto(ctx context.Context, photo models.ReviewPhotoDB, limit int) error {
+	const op = "ReviewRepository.AddReviewPhoto"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("reviewID", photo.ReviewID)
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		logger.WithError(err).Error("begin transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var reviewID uuid.UUID
+	if err := tx.QueryRowContext(ctx, queryLockReview, photo.ReviewID).Scan(&reviewID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Warn("review not found")
+			return fmt.Errorf("%s: %w", op, errs.NewNotFoundError("review not found"))
+		}
+		logger.WithError(err).Error("lock review")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	res, err := tx.ExecContext(ctx, queryAddReviewPhoto, photo.ID, photo.ReviewID, photo.ImageURL, limit)
+	if err != nil {
+		logger.WithError(err).Error("add review photo")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		logger.WithError(err).Error("get rows affected")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		logger.Warn("review photo limit reached")
+		return fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("too many photos"))
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.WithError(err).Error("commit transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *ReviewRepository) VoteReview(ctx context.Context, vote models.ReviewVoteDB) error {
+	const op = "ReviewRepository.VoteReview"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("reviewID", vote.ReviewID)
+
+	if _, err := r.DB.ExecContext(ctx, queryUpsertVote, vote.ID, vote.ReviewID, vote.UserID, vote.IsHelpful); err != nil {
+		logger.WithError(err).Error("vote review")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *ReviewRepository) AddReply(ctx context.Context, reply models.ReviewReplyDB) error {
+	const op = "ReviewRepository.AddReply"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("reviewID", reply.ReviewID)
+
+	_, err := r.DB.ExecContext(ctx, queryAddReply, reply.ID, reply.ReviewID, reply.SellerID, reply.Text)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			logger.WithError(err).Warn("review already has a reply")
+			return fmt.Errorf("%s: %w", op, errs.NewAlreadyExistsError("review already has a reply"))
+		}
+		logger.WithError(err).Error("add reply")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
@@ -4,30 +4,55 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
+
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
 	"github.com/guregu/null"
+	"github.com/lib/pq"
 )
 
 const (
 	queryGetCategoryByName = `
 	SELECT id, name FROM bazaar.subcategory
 	WHERE LOWER(name) = LOWER($1)`
+
+	// Общие условия поиска; %s — условия по характеристикам
+	searchProductsCondition = `
+WHERE p.status = 'approved'
+  AND LOWER(p.name) LIKE LOWER($1)
+  AND ($2 = '' OR ps.subcategory_id = $2::uuid)
+  AND ($3::numeric = 0 OR p.price >= $3)
+  AND ($4::numeric = 0 OR p.price <= $4)
+  AND ($5 = 0::FLOAT OR p.rating >= $5::FLOAT)%s`
+
 	querySearchProductsByNameWithFilterAndSort = `
 SELECT p.id, p.seller_id, p.name, p.preview_image_url, p.description, 
        p.status, p.price, p.quantity, p.updated_at, p.rating, p.reviews_count,
        d.discounted_price
 FROM bazaar.product p
 JOIN bazaar.product_subcategory ps ON p.id = ps.product_id
-LEFT JOIN bazaar.discount d ON p.id = d.product_id
-WHERE p.status = 'approved'
-  AND LOWER(p.name) LIKE LOWER($1)
-  AND ($2 = '' OR ps.subcategory_id = $2::uuid)
-  AND ($3 = 0 OR p.price >= $3)
-  AND ($4 = 0 OR p.price <= $4)
-  AND ($5 = 0::FLOAT OR p.rating >= $5::FLOAT)
+LEFT JOIN bazaar.discount d ON p.id = d.product_id` + searchProductsCondition + `
 ORDER BY %s
 LIMIT 20 OFFSET $6`
+
+	// Распределение значений фильтруемых характеристик среди найденных товаров.
+	// У числовых характеристик value пустой, и строка несёт диапазон значений.
+	queryGetAttributeFacets = `
+WITH matched AS (
+    SELECT DISTINCT p.id
+    FROM bazaar.product p
+    JOIN bazaar.product_subcategory ps ON p.id = ps.product_id` + searchProductsCondition + `
+)
+SELECT ca.id, ca.name, ca.type, ca.unit,
+       COALESCE(pa.value_text, pa.value_bool::TEXT) AS value,
+       COUNT(*), MIN(pa.value_number), MAX(pa.value_number)
+FROM matched m
+JOIN bazaar.product_attribute pa ON pa.product_id = m.id
+JOIN bazaar.category_attribute ca ON ca.id = pa.attribute_id
+WHERE ca.filterable
+GROUP BY ca.id, ca.name, ca.type, ca.unit, ca.position, value
+ORDER BY ca.position, ca.name, ca.id, COUNT(*) DESC, value`
 )
 
 type SearchRepository struct {
@@ -62,8 +87,9 @@ func (s *SearchRepository) GetProductsByNameWithFilterAndSort(
 	name string,
 	categoryID null.String,
 	offset int,
-	minPrice, maxPrice float64,
+	minPrice, maxPrice models.Money,
 	minRating float32,
+	attributes []models.AttributeFilter,
 	sortOption models.SortOption,
 ) ([]*models.Product, error) {
 	const op = "SearchRepository.GetProductsByNameWithFilterAndSort"
@@ -83,21 +109,13 @@ func (s *SearchRepository) GetProductsByNameWithFilterAndSort(
 		orderBy = "p.updated_at DESC"
 	}
 
-	// Формируем запрос
-	query := fmt.Sprintf(querySearchProductsByNameWithFilterAndSort, orderBy)
-
 	// Готовим параметры запроса
-	args := []interface{}{
-		fmt.Sprintf("%%%s%%", name), // $1
-	}
+	args := searchArgs(name, categoryID, minPrice, maxPrice, minRating)
+	args = append(args, offset) // $6
 
-	// Добавляем параметры фильтрации по категории, цене и рейтингу
-	if categoryID.Valid {
-		args = append(args, categoryID.String) // $2
-	} else {
-		args = append(args, "") // Пустая строка, если категория не задана
-	}
-	args = append(args, minPrice, maxPrice, minRating, offset)
+	// Формируем запрос
+	conditions, args := attributeConditions(attributes, args)
+	query := fmt.Sprintf(querySearchProductsByNameWithFilterAndSort, conditions, orderBy)
 
 	// Выполняем запрос
 	rows, err := s.db.QueryContext(ctx, query, args...)
@@ -110,7 +128,7 @@ func (s *SearchRepository) GetProductsByNameWithFilterAndSort(
 	// Чтение данных
 	productsList := []*models.Product{}
 	for rows.Next() {
-		var priceDiscount sql.NullFloat64
+		var priceDiscount models.NullMoney
 		product := &models.Product{}
 		if err := rows.Scan(
 			&product.ID,
@@ -129,7 +147,7 @@ func (s *SearchRepository) GetProductsByNameWithFilterAndSort(
 			logger.WithError(err).Error("scan product row")
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
-		product.PriceDiscount = priceDiscount.Float64
+		product.PriceDiscount = priceDiscount.Money
 		productsList = append(productsList, product)
 	}
 
@@ -141,3 +159,104 @@ func (s *SearchRepository) GetProductsByNameWithFilterAndSort(
 
 	return productsList, nil
 }
+
+// GetAttributeFacets считает значения фильтруемых характеристик у товаров,
+// подходящих под запрос с учётом уже выбранных фильтров
+func (s *SearchRepository) GetAttributeFacets(
+	ctx context.Context,
+	name string,
+	categoryID null.String,
+	minPrice, maxPrice models.Money,
+	minRating float32,
+	attributes []models.AttributeFilter,
+) ([]models.AttributeFacet, error) {
+	const op = "SearchRepository.GetAttributeFacets"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	conditions, args := attributeConditions(attributes, searchArgs(name, categoryID, minPrice, maxPrice, minRating))
+
+	rows, err := s.db.QueryContext(ctx, fmt.Sprintf(queryGetAttributeFacets, conditions), args...)
+	if err != nil {
+		logger.WithError(err).Error("query attribute facets")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	facets := []models.AttributeFacet{}
+	for rows.Next() {
+		var (
+			facet    models.AttributeFacet
+			value    sql.NullString
+			count    int
+			min, max sql.NullFloat64
+		)
+		if err = rows.Scan(&facet.AttributeID, &facet.Name, &facet.Type, &facet.Unit, &value, &count, &min, &max); err != nil {
+			logger.WithError(err).Error("scan attribute facet")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Строки одной характеристики идут подряд
+		if len(facets) == 0 || facets[len(facets)-1].AttributeID != facet.AttributeID {
+			facets = append(facets, facet)
+		}
+		last := &facets[len(facets)-1]
+		if facet.Type == models.AttributeNumber {
+			last.Min = null.NewFloat(min.Float64, min.Valid)
+			last.Max = null.NewFloat(max.Float64, max.Valid)
+			continue
+		}
+		last.Values = append(last.Values, models.FacetValue{Value: value.String, Count: count})
+	}
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return facets, nil
+}
+
+// searchArgs готовит параметры $1-$5 общих условий поиска
+func searchArgs(name string, categoryID null.String, minPrice, maxPrice models.Money, minRating float32) []interface{} {
+	args := []interface{}{
+		fmt.Sprintf("%%%s%%", name), // $1
+	}
+
+	// Добавляем параметры фильтрации по категории, цене и рейтингу
+	if categoryID.Valid {
+		args = append(args, categoryID.String) // $2
+	} else {
+		args = append(args, "") // Пустая строка, если категория не задана
+	}
+
+	return append(args, minPrice, maxPrice, minRating)
+}
+
+// attributeConditions добавляет к запросу условие на каждую характеристику;
+// параметры нумеруются после уже переданных args
+func attributeConditions(filters []models.AttributeFilter, args []interface{}) (string, []interface{}) {
+	var b strings.Builder
+	param := func(value interface{}) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	for _, f := range filters {
+		b.WriteString("\n  AND EXISTS (SELECT 1 FROM bazaar.product_attribute pa WHERE pa.product_id = p.id AND pa.attribute_id = ")
+		b.WriteString(param(f.AttributeID))
+		if len(f.Values) > 0 {
+			b.WriteString(" AND pa.value_text = ANY(" + param(pq.Array(f.Values)) + ")")
+		}
+		if f.Min.Valid {
+			b.WriteString(" AND pa.value_number >= " + param(f.Min.Float64))
+		}
+		if f.Max.Valid {
+			b.WriteString(" AND pa.value_number <= " + param(f.Max.Float64))
+		}
+		if f.Bool.Valid {
+			b.WriteString(" AND pa.value_bool = " + param(f.Bool.Bool))
+		}
+		b.WriteString(")")
+	}
+
+	return b.String(), args
+}
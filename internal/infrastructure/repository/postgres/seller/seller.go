@@ -7,8 +7,11 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/inventory"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
 )
 
@@ -26,6 +29,11 @@ const (
 		VALUES ($1, $2, $3)
 	`
 
+	queryAddProductAttribute = `
+		INSERT INTO bazaar.product_attribute (product_id, attribute_id, value_text, value_number, value_bool)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
 	queryGetSellerProducts = `
 		SELECT id, seller_id, name, preview_image_url, 
 			description, status, price, quantity, rating, reviews_count
@@ -41,6 +49,31 @@ const (
 		)
 	`
 
+	queryAddVariant = `
+		INSERT INTO bazaar.product_variant (id, product_id, sku, options, price, quantity, images, position)
+		SELECT $1, $2, $3, $4, $5, $6, $7, COALESCE(MAX(position) + 1, 0)
+		FROM bazaar.product_variant
+		WHERE product_id = $2
+		RETURNING position, updated_at
+	`
+
+	queryGetVariant = `
+		SELECT id, product_id, sku, options, price, quantity, images, position, updated_at
+		FROM bazaar.product_variant
+		WHERE id = $1
+	`
+
+	queryGetVariantOptions = `SELECT options FROM bazaar.product_variant WHERE product_id = $1`
+
+	queryUpdateVariant = `
+		UPDATE bazaar.product_variant
+		SET price = $2, quantity = $3, images = $4
+		WHERE id = $1
+		RETURNING updated_at
+	`
+
+	queryDeleteVariant = `DELETE FROM bazaar.product_variant WHERE id = $1`
+
 	queryUpdateProductImage = `
 		UPDATE bazaar.product
 		SET preview_image_url = $1
@@ -57,7 +90,12 @@ func NewSellerRepository(db *sql.DB) *SellerRepository {
 	return &SellerRepository{db: db}
 }
 
-func (r *SellerRepository) AddProduct(ctx context.Context, product *models.Product, categoryID uuid.UUID) (*models.Product, error) {
+func (r *SellerRepository) AddProduct(
+	ctx context.Context,
+	product *models.Product,
+	categoryID uuid.UUID,
+	attributes []models.ProductAttribute,
+) (*models.Product, error) {
 	const op = "SellerRepository.AddProduct"
 	logger := logctx.GetLogger(ctx).WithField("op", op)
 
@@ -99,6 +137,21 @@ func (r *SellerRepository) AddProduct(ctx context.Context, product *models.Produ
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	// Сохраняем значения характеристик
+	for _, attribute := range attributes {
+		_, err = tx.ExecContext(ctx, queryAddProductAttribute,
+			product.ID,
+			attribute.AttributeID,
+			attribute.Text,
+			attribute.Number,
+			attribute.Bool,
+		)
+		if err != nil {
+			logger.WithError(err).WithField("attribute_id", attribute.AttributeID).Error("insert product attribute")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
 	if err = tx.Commit(); err != nil {
 		logger.WithError(err).Error("commit transaction")
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -177,4 +230,174 @@ func (r *SellerRepository) CheckProductBelongs(ctx context.Context, productID, s
 	}
 
 	return belongs, nil
-}
\ No newline at end of file
+}
+
+// AddVariant добавляет SKU в конец списка вариантов товара
+func (r *SellerRepository) AddVariant(ctx context.Context, variant *models.ProductVariant) error {
+	const op = "SellerRepository.AddVariant"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("product_id", variant.ProductID)
+
+	err := r.db.QueryRowContext(ctx, queryAddVariant,
+		variant.ID,
+		variant.ProductID,
+		variant.SKU,
+		variant.Options,
+		variant.Price,
+		variant.Quantity,
+		pq.Array(variant.Images),
+	).Scan(&variant.Position, &variant.UpdatedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return fmt.Errorf("%s: %w", op, errs.NewAlreadyExistsError("variant with this SKU or options already exists"))
+		}
+		logger.WithError(err).Error("insert product variant")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (r *SellerRepository) GetVariant(ctx context.Context, id uuid.UUID) (*models.ProductVariant, error) {
+	const op = "SellerRepository.GetVariant"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("variant_id", id)
+
+	var variant models.ProductVariant
+	err := r.db.QueryRowContext(ctx, queryGetVariant, id).Scan(
+		&variant.ID,
+		&variant.ProductID,
+		&variant.SKU,
+		&variant.Options,
+		&variant.Price,
+		&variant.Quantity,
+		pq.Array(&variant.Images),
+		&variant.Position,
+		&variant.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%s: %w", op, errs.NewNotFoundError("product variant not found"))
+		}
+		logger.WithError(err).Error("get product variant")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return &variant, nil
+}
+
+// GetVariantOptions возвращает опции всех SKU товара
+func (r *SellerRepository) GetVariantOptions(ctx context.Context, productID uuid.UUID) ([]models.VariantOptions, error) {
+	const op = "SellerRepository.GetVariantOptions"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("product_id", productID)
+
+	rows, err := r.db.QueryContext(ctx, queryGetVariantOptions, productID)
+	if err != nil {
+		logger.WithError(err).Error("query variant options")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var result []models.VariantOptions
+	for rows.Next() {
+		var options models.VariantOptions
+		if err = rows.Scan(&options); err != nil {
+			logger.WithError(err).Error("scan variant options")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		result = append(result, options)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return result, nil
+}
+
+// UpdateVariant сохраняет цену, остаток и изображения SKU. Изменение
+// остатка попадает в журнал как корректировка продавца.
+func (r *SellerRepository) UpdateVariant(ctx context.Context, sellerID uuid.UUID, variant *models.ProductVariant) error {
+	const op = "SellerRepository.UpdateVariant"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("variant_id", variant.ID)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.WithError(err).Error("begin transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if err = inventory.SetStockContext(ctx, tx, models.StockChange{
+		Kind:    models.StockAdjustment,
+		ActorID: uuid.NullUUID{UUID: sellerID, Valid: true},
+		Reason:  "variant updated",
+	}); err != nil {
+		logger.WithError(err).Error("set stock context")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = tx.QueryRowContext(ctx, queryUpdateVariant,
+		variant.ID,
+		variant.Price,
+		variant.Quantity,
+		pq.Array(variant.Images),
+	).Scan(&variant.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%s: %w", op, errs.NewNotFoundError("product variant not found"))
+		}
+		logger.WithError(err).Error("update product variant")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.WithError(err).Error("commit transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// DeleteVariant удаляет SKU; списание его остатка попадает в журнал
+func (r *SellerRepository) DeleteVariant(ctx context.Context, sellerID, id uuid.UUID) error {
+	const op = "SellerRepository.DeleteVariant"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("variant_id", id)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.WithError(err).Error("begin transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if err = inventory.SetStockContext(ctx, tx, models.StockChange{
+		Kind:    models.StockAdjustment,
+		ActorID: uuid.NullUUID{UUID: sellerID, Valid: true},
+		Reason:  "variant deleted",
+	}); err != nil {
+		logger.WithError(err).Error("set stock context")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	res, err := tx.ExecContext(ctx, queryDeleteVariant, id)
+	if err != nil {
+		logger.WithError(err).Error("delete product variant")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		logger.WithError(err).Error("get rows affected")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, errs.NewNotFoundError("product variant not found"))
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.WithError(err).Error("commit transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
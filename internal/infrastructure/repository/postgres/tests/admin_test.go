@@ -8,6 +8,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	admin "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/admin"
@@ -34,7 +35,7 @@ func TestAdminRepository_GetPendingProducts(t *testing.T) {
 		}).
 			AddRow(
 				productID, sellerID, "Test Product", "image.jpg", "Description",
-				"pending", 100.0, 10, now, 4.5, 20, 90.0,
+				"pending", "100.00", 10, now, 4.5, 20, "90.00",
 				sellerID, "Seller Name", "Seller Description",
 			)
 
@@ -47,7 +48,7 @@ func TestAdminRepository_GetPendingProducts(t *testing.T) {
 		assert.Len(t, products, 1)
 		assert.Equal(t, productID, products[0].ID)
 		assert.Equal(t, models.ProductPending, products[0].Status)
-		assert.Equal(t, 90.0, products[0].PriceDiscount)
+		assert.Equal(t, models.Rubles(90), products[0].PriceDiscount)
 		assert.NotNil(t, products[0].Seller)
 		assert.Equal(t, sellerID, products[0].Seller.ID)
 
@@ -254,4 +255,82 @@ func TestAdminRepository_UpdateUserRole(t *testing.T) {
 
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
-}
\ No newline at end of file
+}
+func TestAdminRepository_GetPendingReviews(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	repo := admin.NewAdminRepository(db)
+
+	t.Run("Success", func(t *testing.T) {
+		reviewID, productID, userID := uuid.New(), uuid.New(), uuid.New()
+		now := time.Now()
+
+		rows := sqlmock.NewRows([]string{
+			"id", "product_id", "name", "user_id", "name", "rating", "comment", "moderation_reason", "created_at",
+		}).AddRow(reviewID, productID, "Phone", userID, "John", 1, "see shop.ru", "link", now)
+
+		mock.ExpectQuery("SELECT .+ FROM bazaar.review r .+ WHERE r.status = 'pending'").
+			WithArgs(0).
+			WillReturnRows(rows)
+
+		reviews, err := repo.GetPendingReviews(context.Background(), 0)
+		assert.NoError(t, err)
+		assert.Len(t, reviews, 1)
+		assert.Equal(t, reviewID, reviews[0].ID)
+		assert.Equal(t, "Phone", reviews[0].ProductName)
+		assert.Equal(t, "link", reviews[0].Reason)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Query error", func(t *testing.T) {
+		mock.ExpectQuery("SELECT").
+			WithArgs(20).
+			WillReturnError(sql.ErrConnDone)
+
+		reviews, err := repo.GetPendingReviews(context.Background(), 20)
+		assert.Error(t, err)
+		assert.Nil(t, reviews)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAdminRepository_UpdateReviewStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	repo := admin.NewAdminRepository(db)
+	reviewID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		productID := uuid.New()
+		mock.ExpectQuery("UPDATE bazaar.review").
+			WithArgs("published", reviewID).
+			WillReturnRows(sqlmock.NewRows([]string{"product_id"}).AddRow(productID))
+
+		gotProductID, err := repo.UpdateReviewStatus(context.Background(), reviewID, models.ReviewPublished)
+		assert.NoError(t, err)
+		assert.Equal(t, productID, gotProductID)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Not pending", func(t *testing.T) {
+		mock.ExpectQuery("UPDATE bazaar.review").
+			WithArgs("rejected", reviewID).
+			WillReturnRows(sqlmock.NewRows([]string{"product_id"}))
+
+		_, err := repo.UpdateReviewStatus(context.Background(), reviewID, models.ReviewRejected)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/analytics"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyticsRepository_RefreshRollup(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		start := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT pg_try_advisory_xact_lock\\(\\$1\\)").
+			WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_xact_lock"}).AddRow(true))
+		mock.ExpectQuery("SELECT COALESCE\\(").
+			WithArgs(30).
+			WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(start))
+		mock.ExpectExec("DELETE FROM bazaar.seller_product_daily WHERE day >= \\$1::date").
+			WithArgs(start).
+			WillReturnResult(sqlmock.NewResult(0, 40))
+		mock.ExpectExec("INSERT INTO bazaar.seller_product_daily .+ " +
+			"FILTER \\(WHERE s.status IN \\('return_requested', .+\\) OR o.status IN \\('return_requested', .+\\)\\)").
+			WithArgs(start).
+			WillReturnResult(sqlmock.NewResult(0, 42))
+		mock.ExpectCommit()
+
+		repo := analytics.NewAnalyticsRepository(db)
+		refreshed, err := repo.RefreshRollup(context.Background(), 30)
+
+		require.NoError(t, err)
+		assert.True(t, refreshed)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("locked by another instance", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT pg_try_advisory_xact_lock\\(\\$1\\)").
+			WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_xact_lock"}).AddRow(false))
+		mock.ExpectRollback()
+
+		repo := analytics.NewAnalyticsRepository(db)
+		refreshed, err := repo.RefreshRollup(context.Background(), 30)
+
+		require.NoError(t, err)
+		assert.False(t, refreshed)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAnalyticsRepository_GetSalesStats(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	sellerID := uuid.New()
+	from := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 5, 31, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("FROM bazaar.seller_product_daily d WHERE d.seller_id = \\$1").
+		WithArgs(sellerID, from, to, "week").
+		WillReturnRows(sqlmock.NewRows([]string{"period", "revenue", "units", "orders", "returned_units",
+			"basket_adds", "rating_sum", "rating_count"}).
+			AddRow(time.Date(2025, 4, 28, 0, 0, 0, 0, time.UTC), "1500.50", 3, 2, 1, 10, 9, 2))
+
+	repo := analytics.NewAnalyticsRepository(db)
+	stats, err := repo.GetSalesStats(context.Background(), sellerID, from, to, models.AnalyticsWeek)
+
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	assert.Equal(t, models.Kopecks(150050), stats[0].Revenue)
+	assert.Equal(t, 3, stats[0].Units)
+	assert.Equal(t, 10, stats[0].BasketAdds)
+	assert.Equal(t, 2, stats[0].RatingCount)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAnalyticsRepository_GetStockOuts(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	sellerID := uuid.New()
+	productID := uuid.New()
+	from := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 5, 31, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery("AND d.stock_out").
+		WithArgs(sellerID, from, to, 20, 40).
+		WillReturnRows(sqlmock.NewRows([]string{"product_id", "name", "count", "quantity"}).
+			AddRow(productID, "Кружка", 4, 0))
+
+	repo := analytics.NewAnalyticsRepository(db)
+	products, err := repo.GetStockOuts(context.Background(), sellerID, from, to, 40)
+
+	require.NoError(t, err)
+	require.Len(t, products, 1)
+	assert.Equal(t, models.StockOutProduct{ProductID: productID, Name: "Кружка", Days: 4}, products[0])
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
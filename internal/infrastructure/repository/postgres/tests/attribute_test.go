@@ -0,0 +1,163 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/attribute"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var categoryAttributeColumns = []string{
+	"id", "category_id", "name", "type", "unit", "options", "required", "filterable", "position",
+}
+
+func TestAttributeRepository_GetCategoryAttributes(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		rootID, categoryID := uuid.New(), uuid.New()
+		brandID, weightID := uuid.New(), uuid.New()
+		mock.ExpectQuery("WITH RECURSIVE ancestors").
+			WithArgs(categoryID).
+			WillReturnRows(sqlmock.NewRows(categoryAttributeColumns).
+				AddRow(brandID, rootID, "Бренд", "enum", nil, "{Apple,Samsung}", true, true, 0).
+				AddRow(weightID, categoryID, "Вес", "number", "г", "{}", false, true, 0))
+
+		attributes, err := attribute.NewAttributeRepository(db).GetCategoryAttributes(context.Background(), categoryID)
+		require.NoError(t, err)
+		require.Len(t, attributes, 2)
+		assert.Equal(t, models.AttributeEnum, attributes[0].Type)
+		assert.Equal(t, []string{"Apple", "Samsung"}, attributes[0].Options)
+		assert.Equal(t, rootID, attributes[0].CategoryID)
+		assert.Equal(t, null.StringFrom("г"), attributes[1].Unit)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("WITH RECURSIVE ancestors").WillReturnError(errors.New("db error"))
+
+		_, err = attribute.NewAttributeRepository(db).GetCategoryAttributes(context.Background(), uuid.New())
+		assert.Error(t, err)
+	})
+}
+
+func TestAttributeRepository_GetAttribute(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	id := uuid.New()
+	mock.ExpectQuery("WHERE ca.id = \\$1").
+		WithArgs(id).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = attribute.NewAttributeRepository(db).GetAttribute(context.Background(), id)
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAttributeRepository_CreateAttribute(t *testing.T) {
+	newAttribute := func() *models.CategoryAttribute {
+		return &models.CategoryAttribute{
+			ID:         uuid.New(),
+			CategoryID: uuid.New(),
+			Name:       "Бренд",
+			Type:       models.AttributeEnum,
+			Options:    []string{"Apple", "Samsung"},
+			Filterable: true,
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		attr := newAttribute()
+		mock.ExpectQuery("INSERT INTO bazaar.category_attribute").
+			WithArgs(attr.ID, attr.CategoryID, attr.Name, attr.Type, attr.Unit,
+				pq.Array(attr.Options), attr.Required, attr.Filterable).
+			WillReturnRows(sqlmock.NewRows([]string{"position"}).AddRow(3))
+
+		err = attribute.NewAttributeRepository(db).CreateAttribute(context.Background(), attr)
+		require.NoError(t, err)
+		assert.Equal(t, 3, attr.Position)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("duplicate name", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("INSERT INTO bazaar.category_attribute").
+			WillReturnError(&pq.Error{Code: "23505"})
+
+		err = attribute.NewAttributeRepository(db).CreateAttribute(context.Background(), newAttribute())
+		assert.ErrorIs(t, err, errs.ErrAlreadyExists)
+	})
+
+	t.Run("category not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("INSERT INTO bazaar.category_attribute").
+			WillReturnError(&pq.Error{Code: "23503"})
+
+		err = attribute.NewAttributeRepository(db).CreateAttribute(context.Background(), newAttribute())
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+}
+
+func TestAttributeRepository_DeleteAttribute(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	id := uuid.New()
+	mock.ExpectExec("DELETE FROM bazaar.category_attribute").
+		WithArgs(id).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = attribute.NewAttributeRepository(db).DeleteAttribute(context.Background(), id)
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAttributeRepository_GetProductAttributes(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	productID, attributeID := uuid.New(), uuid.New()
+	columns := append(append([]string{}, categoryAttributeColumns...), "value_text", "value_number", "value_bool")
+	mock.ExpectQuery("FROM bazaar.product_attribute pa").
+		WithArgs(productID).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(attributeID, uuid.New(), "Вес", "number", "г", "{}", false, true, 0, nil, 180.5, nil))
+
+	specs, err := attribute.NewAttributeRepository(db).GetProductAttributes(context.Background(), productID)
+	require.NoError(t, err)
+	require.Len(t, specs, 1)
+	assert.Equal(t, attributeID, specs[0].Value.AttributeID)
+	assert.Equal(t, null.FloatFrom(180.5), specs[0].Value.Number)
+	assert.False(t, specs[0].Value.Text.Valid)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
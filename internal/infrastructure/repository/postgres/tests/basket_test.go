@@ -9,6 +9,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	basketRepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/basket"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -109,11 +110,11 @@ func TestBasketRepository_Add(t *testing.T) {
 		// Second expectation - add product to basket
 		// Use sqlmock.AnyArg() for the generated UUID
 		mock.ExpectQuery(`INSERT INTO bazaar.basket_item`).
-			WithArgs(sqlmock.AnyArg(), basketID, productID).
-			WillReturnRows(sqlmock.NewRows([]string{"id", "basket_id", "product_id", "quantity", "updated_at"}).
-				AddRow(uuid.New(), basketID, productID, 1, now))
+			WithArgs(sqlmock.AnyArg(), basketID, productID, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "basket_id", "product_id", "variant_id", "quantity", "updated_at"}).
+				AddRow(uuid.New(), basketID, productID, nil, 1, now))
 
-		_, err := repo.Add(context.Background(), userID, productID)
+		_, err := repo.Add(context.Background(), userID, productID, uuid.NullUUID{})
 		require.NoError(t, err)
 	})
 
@@ -125,7 +126,7 @@ func TestBasketRepository_Add(t *testing.T) {
 			WithArgs(userID).
 			WillReturnError(sql.ErrNoRows)
 
-		_, err := repo.Add(context.Background(), userID, productID)
+		_, err := repo.Add(context.Background(), userID, productID, uuid.NullUUID{})
 		require.Error(t, err)
 		assert.ErrorIs(t, err, errs.ErrNotFound)
 	})
@@ -140,10 +141,10 @@ func TestBasketRepository_Add(t *testing.T) {
 			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(basketID))
 
 		mock.ExpectQuery(`INSERT INTO bazaar.basket_item`).
-			WithArgs(sqlmock.AnyArg(), basketID, productID).
+			WithArgs(sqlmock.AnyArg(), basketID, productID, nil).
 			WillReturnError(sql.ErrNoRows)
 
-		_, err := repo.Add(context.Background(), userID, productID)
+		_, err := repo.Add(context.Background(), userID, productID, uuid.NullUUID{})
 		require.Error(t, err)
 		assert.ErrorIs(t, err, errs.ErrNotFound)
 	})
@@ -169,10 +170,10 @@ func TestBasketRepository_Delete(t *testing.T) {
 			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(basketID))
 
 		mock.ExpectQuery(`DELETE FROM bazaar.basket_item`).
-			WithArgs(basketID, productID).
+			WithArgs(basketID, productID, nil).
 			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(deletedID))
 
-		err := repo.Delete(context.Background(), userID, productID)
+		err := repo.Delete(context.Background(), userID, productID, uuid.NullUUID{})
 		require.NoError(t, err)
 	})
 
@@ -184,7 +185,7 @@ func TestBasketRepository_Delete(t *testing.T) {
 			WithArgs(userID).
 			WillReturnError(sql.ErrNoRows)
 
-		err := repo.Delete(context.Background(), userID, productID)
+		err := repo.Delete(context.Background(), userID, productID, uuid.NullUUID{})
 		require.Error(t, err)
 		assert.ErrorIs(t, err, errs.ErrNotFound)
 	})
@@ -199,10 +200,10 @@ func TestBasketRepository_Delete(t *testing.T) {
 			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(basketID))
 
 		mock.ExpectQuery(`DELETE FROM bazaar.basket_item`).
-			WithArgs(basketID, productID).
+			WithArgs(basketID, productID, nil).
 			WillReturnError(sql.ErrNoRows)
 
-		err := repo.Delete(context.Background(), userID, productID)
+		err := repo.Delete(context.Background(), userID, productID, uuid.NullUUID{})
 		require.Error(t, err)
 		assert.ErrorIs(t, err, errs.ErrNotFound)
 	})
@@ -235,11 +236,11 @@ func TestBasketRepository_UpdateQuantity(t *testing.T) {
 			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(basketID))
 
 		mock.ExpectQuery(`UPDATE bazaar.basket_item`).
-			WithArgs(quantity, basketID, productID).
-			WillReturnRows(sqlmock.NewRows([]string{"id", "basket_id", "product_id", "quantity", "updated_at"}).
-				AddRow(itemID, basketID, productID, quantity, now))
+			WithArgs(quantity, basketID, productID, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "basket_id", "product_id", "variant_id", "quantity", "updated_at"}).
+				AddRow(itemID, basketID, productID, nil, quantity, now))
 
-		item, err := repo.UpdateQuantity(context.Background(), userID, productID, quantity)
+		item, err := repo.UpdateQuantity(context.Background(), userID, productID, uuid.NullUUID{}, quantity)
 		require.NoError(t, err)
 		assert.Equal(t, itemID, item.ID)
 		assert.Equal(t, quantity, item.Quantity)
@@ -255,7 +256,7 @@ func TestBasketRepository_UpdateQuantity(t *testing.T) {
 			WithArgs(productID).
 			WillReturnRows(sqlmock.NewRows([]string{"quantity"}).AddRow(availableQuantity))
 
-		_, err := repo.UpdateQuantity(context.Background(), userID, productID, quantity)
+		_, err := repo.UpdateQuantity(context.Background(), userID, productID, uuid.NullUUID{}, quantity)
 		require.Error(t, err)
 		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
 	})
@@ -269,7 +270,7 @@ func TestBasketRepository_UpdateQuantity(t *testing.T) {
 			WithArgs(productID).
 			WillReturnError(sql.ErrNoRows)
 
-		_, err := repo.UpdateQuantity(context.Background(), userID, productID, quantity)
+		_, err := repo.UpdateQuantity(context.Background(), userID, productID, uuid.NullUUID{}, quantity)
 		require.Error(t, err)
 		assert.ErrorIs(t, err, errs.ErrNotFound)
 	})
@@ -288,7 +289,7 @@ func TestBasketRepository_UpdateQuantity(t *testing.T) {
 			WithArgs(userID).
 			WillReturnError(sql.ErrNoRows)
 
-		_, err := repo.UpdateQuantity(context.Background(), userID, productID, quantity)
+		_, err := repo.UpdateQuantity(context.Background(), userID, productID, uuid.NullUUID{}, quantity)
 		require.Error(t, err)
 		assert.ErrorIs(t, err, errs.ErrNotFound)
 	})
@@ -302,7 +303,7 @@ func TestBasketRepository_UpdateQuantity(t *testing.T) {
 			WithArgs(productID).
 			WillReturnError(errors.New("database error"))
 
-		_, err := repo.UpdateQuantity(context.Background(), userID, productID, quantity)
+		_, err := repo.UpdateQuantity(context.Background(), userID, productID, uuid.NullUUID{}, quantity)
 		require.Error(t, err)
 	})
 }
@@ -381,11 +382,11 @@ func TestBasketRepository_GetProductsInBasket(t *testing.T) {
 			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(basketID))
 
 		rows := sqlmock.NewRows([]string{
-			"id", "basket_id", "product_id", "quantity", "updated_at",
+			"id", "basket_id", "product_id", "variant_id", "options", "quantity", "updated_at",
 			"name", "price", "preview_image_url", "discounted_price", "available_quantity",
 		}).AddRow(
-			uuid.New(), basketID, productID, 2, now,
-			"Test Product", 1000.0, "image.jpg", 800.0, 5,
+			uuid.New(), basketID, productID, nil, nil, 2, now,
+			"Test Product", "1000.00", "image.jpg", "800.00", 5,
 		)
 
 		mock.ExpectQuery(`SELECT`).
@@ -398,9 +399,9 @@ func TestBasketRepository_GetProductsInBasket(t *testing.T) {
 		assert.Equal(t, productID, items[0].ProductID)
 		assert.Equal(t, 2, items[0].Quantity)
 		assert.Equal(t, "Test Product", items[0].ProductName)
-		assert.Equal(t, 1000.0, items[0].Price)
+		assert.Equal(t, models.Rubles(1000), items[0].Price)
 		assert.Equal(t, "image.jpg", items[0].ProductImage)
-		assert.Equal(t, 800.0, items[0].PriceDiscount)
+		assert.Equal(t, models.Rubles(800), items[0].PriceDiscount)
 	})
 
 	t.Run("basket not found", func(t *testing.T) {
@@ -431,3 +432,155 @@ func TestBasketRepository_GetProductsInBasket(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestBasketRepository_UpdateTotals(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := basketRepo.NewBasketRepository(db)
+
+	t.Run("success", func(t *testing.T) {
+		userID := uuid.New()
+
+		mock.ExpectExec(`UPDATE bazaar.basket SET total_price = \$1, total_price_discount = \$2 WHERE user_id = \$3`).
+			WithArgs(models.Rubles(2000), models.Rubles(1600), userID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.UpdateTotals(context.Background(), userID, models.Rubles(2000), models.Rubles(1600))
+		require.NoError(t, err)
+	})
+
+	t.Run("basket not found", func(t *testing.T) {
+		userID := uuid.New()
+
+		mock.ExpectExec(`UPDATE bazaar.basket SET total_price = \$1, total_price_discount = \$2 WHERE user_id = \$3`).
+			WithArgs(models.Money{}, models.Money{}, userID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.UpdateTotals(context.Background(), userID, models.Money{}, models.Money{})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		userID := uuid.New()
+
+		mock.ExpectExec(`UPDATE bazaar.basket SET total_price = \$1, total_price_discount = \$2 WHERE user_id = \$3`).
+			WithArgs(models.Money{}, models.Money{}, userID).
+			WillReturnError(errors.New("database error"))
+
+		err := repo.UpdateTotals(context.Background(), userID, models.Money{}, models.Money{})
+		require.Error(t, err)
+	})
+}
+
+func TestBasketRepository_GetBasketItems(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := basketRepo.NewBasketRepository(db)
+	productID := uuid.New()
+	variantID := uuid.New()
+	items := []models.PricingItem{
+		{ProductID: productID, Quantity: 2},
+		{ProductID: productID, VariantID: uuid.NullUUID{UUID: variantID, Valid: true}, Quantity: 3},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{
+			"product_id", "variant_id", "options", "name", "price", "preview_image_url", "discounted_price", "quantity",
+		}).
+			AddRow(productID, nil, nil, "Чайник", "1000.00", "kettle.png", "800.00", 7).
+			AddRow(productID, variantID, []byte(`{"Цвет": "Белый"}`), "Чайник", "1200.00", "white.png", nil, 5)
+
+		mock.ExpectQuery(`SELECT .* FROM unnest\(\$1::uuid\[\], \$2::text\[\]\)`).
+			WillReturnRows(rows)
+
+		result, err := repo.GetBasketItems(context.Background(), items)
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		assert.Equal(t, models.Rubles(800), result[0].PriceDiscount)
+		assert.Equal(t, 2, result[0].Quantity)
+		assert.Equal(t, 5, result[0].QuantityRemain)
+		assert.Equal(t, variantID, result[1].VariantID.UUID)
+		assert.Equal(t, "Белый", result[1].VariantOptions["Цвет"])
+		assert.Equal(t, 3, result[1].Quantity)
+		assert.Equal(t, 2, result[1].QuantityRemain)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT`).
+			WillReturnError(errors.New("database error"))
+
+		_, err := repo.GetBasketItems(context.Background(), items)
+		require.Error(t, err)
+	})
+}
+
+func TestBasketRepository_Merge(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := basketRepo.NewBasketRepository(db)
+	items := []models.PricingItem{
+		{ProductID: uuid.New(), Quantity: 2},
+		{ProductID: uuid.New(), Quantity: 1},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		userID := uuid.New()
+		basketID := uuid.New()
+
+		mock.ExpectQuery(`SELECT id FROM bazaar.basket WHERE user_id = \$1`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(basketID))
+		mock.ExpectBegin()
+		for _, item := range items {
+			mock.ExpectExec(`INSERT INTO bazaar.basket_item .* LEAST`).
+				WithArgs(sqlmock.AnyArg(), basketID, item.ProductID, int64(item.Quantity), nil).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+		mock.ExpectCommit()
+
+		err := repo.Merge(context.Background(), userID, items)
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("basket not found", func(t *testing.T) {
+		userID := uuid.New()
+
+		mock.ExpectQuery(`SELECT id FROM bazaar.basket WHERE user_id = \$1`).
+			WithArgs(userID).
+			WillReturnError(sql.ErrNoRows)
+
+		err := repo.Merge(context.Background(), userID, items)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+
+	t.Run("insert error rolls back", func(t *testing.T) {
+		userID := uuid.New()
+		basketID := uuid.New()
+
+		mock.ExpectQuery(`SELECT id FROM bazaar.basket WHERE user_id = \$1`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(basketID))
+		mock.ExpectBegin()
+		mock.ExpectExec(`INSERT INTO bazaar.basket_item`).
+			WillReturnError(errors.New("database error"))
+		mock.ExpectRollback()
+
+		err := repo.Merge(context.Background(), userID, items)
+		require.Error(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
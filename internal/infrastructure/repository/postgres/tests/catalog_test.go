@@ -0,0 +1,200 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/catalog"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var catalogImportColumns = []string{
+	"id", "seller_id", "format", "file_name", "file_key", "status", "total_rows", "created_rows",
+	"updated_rows", "failed_rows", "error", "created_at", "started_at", "finished_at",
+}
+
+func TestCatalogRepository_ClaimImport(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := catalog.NewCatalogRepository(db)
+		importID := uuid.New()
+		sellerID := uuid.New()
+		now := time.Now()
+
+		mock.ExpectQuery("UPDATE bazaar.catalog_import SET status = 'processing'.*FOR UPDATE SKIP LOCKED").
+			WithArgs(float64(900)).
+			WillReturnRows(sqlmock.NewRows(catalogImportColumns).AddRow(
+				importID, sellerID, "xlsx", "catalog.xlsx", "key", "processing", 0, 0, 0, 0, "", now, now, nil,
+			))
+
+		job, err := repo.ClaimImport(context.Background(), 15*time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, importID, job.ID)
+		assert.Equal(t, models.CatalogXLSX, job.Format)
+		assert.Equal(t, models.ImportProcessing, job.Status)
+		require.NotNil(t, job.StartedAt)
+		assert.Nil(t, job.FinishedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("empty queue", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := catalog.NewCatalogRepository(db)
+
+		mock.ExpectQuery("UPDATE bazaar.catalog_import").
+			WithArgs(float64(60)).
+			WillReturnError(sql.ErrNoRows)
+
+		_, err = repo.ClaimImport(context.Background(), time.Minute)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCatalogRepository_FinishImport(t *testing.T) {
+	job := &models.CatalogImport{
+		ID:          uuid.New(),
+		Status:      models.ImportDone,
+		TotalRows:   3,
+		CreatedRows: 1,
+		UpdatedRows: 1,
+		FailedRows:  1,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := catalog.NewCatalogRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("DELETE FROM bazaar.catalog_import_error").
+			WithArgs(job.ID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("INSERT INTO bazaar.catalog_import_error").
+			WithArgs(job.ID, "{4}", `{"A-1"}`, `{"invalid product price"}`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE bazaar.catalog_import SET status = \\$2").
+			WithArgs(job.ID, models.ImportDone, 3, 1, 1, 1, "").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err = repo.FinishImport(context.Background(), job, []models.ImportRowError{
+			{Row: 4, SKU: "A-1", Message: "invalid product price"},
+		})
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("update error", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := catalog.NewCatalogRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec("DELETE FROM bazaar.catalog_import_error").
+			WithArgs(job.ID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("UPDATE bazaar.catalog_import").
+			WillReturnError(errors.New("db error"))
+		mock.ExpectRollback()
+
+		err = repo.FinishImport(context.Background(), job, nil)
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCatalogRepository_UpsertProduct(t *testing.T) {
+	sellerID := uuid.New()
+	productID := uuid.New()
+	categoryID := uuid.New()
+	row := models.CatalogRow{SKU: "A-1", Name: "Phone", Description: "New", Price: models.Rubles(100), Quantity: 3}
+
+	t.Run("created", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := catalog.NewCatalogRepository(db)
+
+		mock.ExpectBegin()
+		expectStockContext(mock, models.StockImport, sellerID.String(), "", "catalog import")
+		mock.ExpectQuery("INSERT INTO bazaar.product .* ON CONFLICT \\(seller_id, sku\\)").
+			WithArgs(sqlmock.AnyArg(), sellerID, "A-1", "Phone", "New", models.Rubles(100), uint(3)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created"}).AddRow(productID, true))
+		mock.ExpectExec("UPDATE bazaar.product_subcategory").
+			WithArgs(sqlmock.AnyArg(), productID, categoryID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		created, err := repo.UpsertProduct(context.Background(), sellerID, row, categoryID)
+		require.NoError(t, err)
+		assert.True(t, created)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("updated drops foreign attributes", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := catalog.NewCatalogRepository(db)
+
+		mock.ExpectBegin()
+		expectStockContext(mock, models.StockImport, sellerID.String(), "", "catalog import")
+		mock.ExpectQuery("INSERT INTO bazaar.product").
+			WithArgs(sqlmock.AnyArg(), sellerID, "A-1", "Phone", "New", models.Rubles(100), uint(3)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created"}).AddRow(productID, false))
+		mock.ExpectExec("UPDATE bazaar.product_subcategory").
+			WithArgs(sqlmock.AnyArg(), productID, categoryID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("DELETE FROM bazaar.product_attribute").
+			WithArgs(productID, categoryID).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		created, err := repo.UpsertProduct(context.Background(), sellerID, row, categoryID)
+		require.NoError(t, err)
+		assert.False(t, created)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCatalogRepository_FindCategories(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := catalog.NewCatalogRepository(db)
+	firstID := uuid.New()
+	secondID := uuid.New()
+
+	mock.ExpectQuery("SELECT id, lower\\(name\\) FROM bazaar.subcategory").
+		WithArgs(`{"phones"}`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).
+			AddRow(firstID, "phones").
+			AddRow(secondID, "phones"))
+
+	categories, err := repo.FindCategories(context.Background(), []string{"phones"})
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{firstID, secondID}, categories["phones"])
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
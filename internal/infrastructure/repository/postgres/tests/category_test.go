@@ -11,10 +11,14 @@ import (
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+var categoryColumns = []string{"id", "name", "parent_id", "slug", "image_url", "position"}
+
 func TestCategoryRepository_GetAllCategories(t *testing.T) {
 	t.Parallel()
 
@@ -23,6 +27,7 @@ func TestCategoryRepository_GetAllCategories(t *testing.T) {
 	defer db.Close()
 
 	repo := categoryRepo.NewCategoryRepository(db)
+	query := `SELECT id, name, parent_id, slug, image_url, position FROM bazaar.category WHERE parent_id IS NULL`
 
 	t.Run("success", func(t *testing.T) {
 		category1ID := uuid.New()
@@ -30,20 +35,24 @@ func TestCategoryRepository_GetAllCategories(t *testing.T) {
 
 		expectedCategories := []*models.Category{
 			{
-				ID:   category1ID,
-				Name: "Category 1",
+				ID:       category1ID,
+				Name:     "Category 1",
+				Slug:     "category-1",
+				ImageURL: null.StringFrom("http://img/1.png"),
 			},
 			{
-				ID:   category2ID,
-				Name: "Category 2",
+				ID:       category2ID,
+				Name:     "Category 2",
+				Slug:     "category-2",
+				Position: 1,
 			},
 		}
 
-		rows := sqlmock.NewRows([]string{"id", "name"}).
-			AddRow(category1ID, "Category 1").
-			AddRow(category2ID, "Category 2")
+		rows := sqlmock.NewRows(categoryColumns).
+			AddRow(category1ID, "Category 1", nil, "category-1", "http://img/1.png", 0).
+			AddRow(category2ID, "Category 2", nil, "category-2", nil, 1)
 
-		mock.ExpectQuery(`SELECT id, name FROM bazaar.category`).WillReturnRows(rows)
+		mock.ExpectQuery(query).WillReturnRows(rows)
 
 		categories, err := repo.GetAllCategories(context.Background())
 		require.NoError(t, err)
@@ -52,9 +61,7 @@ func TestCategoryRepository_GetAllCategories(t *testing.T) {
 	})
 
 	t.Run("empty result", func(t *testing.T) {
-		rows := sqlmock.NewRows([]string{"id", "name"})
-
-		mock.ExpectQuery(`SELECT id, name FROM bazaar.category`).WillReturnRows(rows)
+		mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows(categoryColumns))
 
 		categories, err := repo.GetAllCategories(context.Background())
 		require.NoError(t, err)
@@ -62,8 +69,7 @@ func TestCategoryRepository_GetAllCategories(t *testing.T) {
 	})
 
 	t.Run("database error", func(t *testing.T) {
-		mock.ExpectQuery(`SELECT id, name FROM bazaar.category`).
-			WillReturnError(errors.New("database error"))
+		mock.ExpectQuery(query).WillReturnError(errors.New("database error"))
 
 		categories, err := repo.GetAllCategories(context.Background())
 		require.Error(t, err)
@@ -71,13 +77,10 @@ func TestCategoryRepository_GetAllCategories(t *testing.T) {
 	})
 
 	t.Run("scan error", func(t *testing.T) {
-		categoryID := uuid.New()
-
-		// Return invalid data (missing name column)
-		rows := sqlmock.NewRows([]string{"id"}).
-			AddRow(categoryID)
+		// Return invalid data (missing columns)
+		rows := sqlmock.NewRows([]string{"id"}).AddRow(uuid.New())
 
-		mock.ExpectQuery(`SELECT id, name FROM bazaar.category`).WillReturnRows(rows)
+		mock.ExpectQuery(query).WillReturnRows(rows)
 
 		categories, err := repo.GetAllCategories(context.Background())
 		require.Error(t, err)
@@ -86,135 +89,267 @@ func TestCategoryRepository_GetAllCategories(t *testing.T) {
 }
 
 func TestCategoryRepository_GetAllSubcategories(t *testing.T) {
-    t.Parallel()
-
-    db, mock, err := sqlmock.New()
-    require.NoError(t, err)
-    defer db.Close()
-
-    repo := categoryRepo.NewCategoryRepository(db)
-
-    t.Run("success", func(t *testing.T) {
-        categoryID := uuid.New()
-        subcategory1ID := uuid.New()
-        subcategory2ID := uuid.New()
-
-        expectedSubcategories := []*models.Category{
-            {
-                ID:   subcategory1ID,
-                Name: "Subcategory 1",
-            },
-            {
-                ID:   subcategory2ID,
-                Name: "Subcategory 2",
-            },
-        }
-
-        rows := sqlmock.NewRows([]string{"id", "name"}).
-            AddRow(subcategory1ID, "Subcategory 1").
-            AddRow(subcategory2ID, "Subcategory 2")
-
-        mock.ExpectQuery(`SELECT id, name FROM bazaar.subcategory WHERE category_id = \$1`).
-            WithArgs(categoryID).
-            WillReturnRows(rows)
-
-        subcategories, err := repo.GetAllSubcategories(context.Background(), categoryID)
-        require.NoError(t, err)
-        require.Len(t, subcategories, 2)
-        assert.Equal(t, expectedSubcategories, subcategories)
-    })
-
-    t.Run("empty result", func(t *testing.T) {
-        categoryID := uuid.New()
-
-        rows := sqlmock.NewRows([]string{"id", "name"})
-
-        mock.ExpectQuery(`SELECT id, name FROM bazaar.subcategory WHERE category_id = \$1`).
-            WithArgs(categoryID).
-            WillReturnRows(rows)
-
-        subcategories, err := repo.GetAllSubcategories(context.Background(), categoryID)
-        require.NoError(t, err)
-        assert.Empty(t, subcategories)
-    })
-
-    t.Run("database error", func(t *testing.T) {
-        categoryID := uuid.New()
-
-        mock.ExpectQuery(`SELECT id, name FROM bazaar.subcategory WHERE category_id = \$1`).
-            WithArgs(categoryID).
-            WillReturnError(errors.New("database error"))
-
-        subcategories, err := repo.GetAllSubcategories(context.Background(), categoryID)
-        require.Error(t, err)
-        assert.Nil(t, subcategories)
-    })
-
-    t.Run("scan error", func(t *testing.T) {
-        categoryID := uuid.New()
-        subcategoryID := uuid.New()
-
-        // Return invalid data (missing name column)
-        rows := sqlmock.NewRows([]string{"id"}).
-            AddRow(subcategoryID)
-
-        mock.ExpectQuery(`SELECT id, name FROM bazaar.subcategory WHERE category_id = \$1`).
-            WithArgs(categoryID).
-            WillReturnRows(rows)
-
-        subcategories, err := repo.GetAllSubcategories(context.Background(), categoryID)
-        require.Error(t, err)
-        assert.Nil(t, subcategories)
-    })
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := categoryRepo.NewCategoryRepository(db)
+	query := `SELECT id, name, parent_id, slug, image_url, position FROM bazaar.category WHERE parent_id = \$1`
+
+	t.Run("success", func(t *testing.T) {
+		categoryID := uuid.New()
+		subcategoryID := uuid.New()
+
+		rows := sqlmock.NewRows(categoryColumns).
+			AddRow(subcategoryID, "Subcategory 1", categoryID, "subcategory-1", nil, 0)
+
+		mock.ExpectQuery(query).WithArgs(categoryID).WillReturnRows(rows)
+
+		subcategories, err := repo.GetAllSubcategories(context.Background(), categoryID)
+		require.NoError(t, err)
+		require.Len(t, subcategories, 1)
+		assert.Equal(t, &models.Category{
+			ID:       subcategoryID,
+			Name:     "Subcategory 1",
+			ParentID: uuid.NullUUID{UUID: categoryID, Valid: true},
+			Slug:     "subcategory-1",
+		}, subcategories[0])
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		categoryID := uuid.New()
+
+		mock.ExpectQuery(query).WithArgs(categoryID).WillReturnError(errors.New("database error"))
+
+		subcategories, err := repo.GetAllSubcategories(context.Background(), categoryID)
+		require.Error(t, err)
+		assert.Nil(t, subcategories)
+	})
 }
 
 func TestCategoryRepository_GetNameSubcategory(t *testing.T) {
-    t.Parallel()
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := categoryRepo.NewCategoryRepository(db)
+	query := `SELECT name FROM bazaar.category WHERE id = \$1`
+
+	t.Run("success", func(t *testing.T) {
+		subcategoryID := uuid.New()
+
+		mock.ExpectQuery(query).
+			WithArgs(subcategoryID).
+			WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("Test Subcategory"))
+
+		name, err := repo.GetNameSubcategory(context.Background(), subcategoryID)
+		require.NoError(t, err)
+		assert.Equal(t, "Test Subcategory", name)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		subcategoryID := uuid.New()
+
+		mock.ExpectQuery(query).WithArgs(subcategoryID).WillReturnError(sql.ErrNoRows)
+
+		name, err := repo.GetNameSubcategory(context.Background(), subcategoryID)
+		require.Error(t, err)
+		assert.Equal(t, "", name)
+		assert.True(t, errors.Is(err, errs.ErrNotFound))
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		subcategoryID := uuid.New()
 
-    db, mock, err := sqlmock.New()
-    require.NoError(t, err)
-    defer db.Close()
+		mock.ExpectQuery(query).WithArgs(subcategoryID).WillReturnError(errors.New("database error"))
 
-    repo := categoryRepo.NewCategoryRepository(db)
+		name, err := repo.GetNameSubcategory(context.Background(), subcategoryID)
+		require.Error(t, err)
+		assert.Equal(t, "", name)
+	})
+}
 
-    t.Run("success", func(t *testing.T) {
-        subcategoryID := uuid.New()
-        expectedName := "Test Subcategory"
+func TestCategoryRepository_GetBreadcrumbs(t *testing.T) {
+	t.Parallel()
 
-        row := sqlmock.NewRows([]string{"name"}).
-            AddRow(expectedName)
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
 
-        mock.ExpectQuery(`SELECT name FROM bazaar.subcategory WHERE id = \$1`).
-            WithArgs(subcategoryID).
-            WillReturnRows(row)
+	repo := categoryRepo.NewCategoryRepository(db)
 
-        name, err := repo.GetNameSubcategory(context.Background(), subcategoryID)
-        require.NoError(t, err)
-        assert.Equal(t, expectedName, name)
-    })
+	t.Run("path from root", func(t *testing.T) {
+		rootID, childID := uuid.New(), uuid.New()
 
-    t.Run("not found", func(t *testing.T) {
-        subcategoryID := uuid.New()
+		rows := sqlmock.NewRows(categoryColumns).
+			AddRow(rootID, "Электроника", nil, "elektronika", nil, 0).
+			AddRow(childID, "Смартфоны", rootID, "smartfony", nil, 2)
 
-        mock.ExpectQuery(`SELECT name FROM bazaar.subcategory WHERE id = \$1`).
-            WithArgs(subcategoryID).
-            WillReturnError(sql.ErrNoRows)
+		mock.ExpectQuery(`WITH RECURSIVE path AS .+ ORDER BY depth DESC`).
+			WithArgs(childID).
+			WillReturnRows(rows)
 
-        name, err := repo.GetNameSubcategory(context.Background(), subcategoryID)
-        require.Error(t, err)
-        assert.Equal(t, "", name)
-        assert.True(t, errors.Is(err, errs.ErrNotFound))
-    })
+		path, err := repo.GetBreadcrumbs(context.Background(), childID)
+		require.NoError(t, err)
+		require.Len(t, path, 2)
+		assert.Equal(t, rootID, path[0].ID)
+		assert.Equal(t, childID, path[1].ID)
+	})
 
-    t.Run("database error", func(t *testing.T) {
-        subcategoryID := uuid.New()
+	t.Run("not found", func(t *testing.T) {
+		id := uuid.New()
 
-        mock.ExpectQuery(`SELECT name FROM bazaar.subcategory WHERE id = \$1`).
-            WithArgs(subcategoryID).
-            WillReturnError(errors.New("database error"))
+		mock.ExpectQuery(`WITH RECURSIVE path AS`).
+			WithArgs(id).
+			WillReturnRows(sqlmock.NewRows(categoryColumns))
 
-        name, err := repo.GetNameSubcategory(context.Background(), subcategoryID)
-        require.Error(t, err)
-        assert.Equal(t, "", name)
-    })
-}
\ No newline at end of file
+		_, err := repo.GetBreadcrumbs(context.Background(), id)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+}
+
+func TestCategoryRepository_CreateCategory(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := categoryRepo.NewCategoryRepository(db)
+
+	t.Run("appended after siblings", func(t *testing.T) {
+		category := &models.Category{
+			ID:       uuid.New(),
+			Name:     "Смартфоны",
+			ParentID: uuid.NullUUID{UUID: uuid.New(), Valid: true},
+			Slug:     "smartfony",
+		}
+
+		mock.ExpectQuery(`INSERT INTO bazaar.category .+ RETURNING position`).
+			WithArgs(category.ID, category.Name, category.ParentID, category.Slug, category.ImageURL).
+			WillReturnRows(sqlmock.NewRows([]string{"position"}).AddRow(3))
+
+		require.NoError(t, repo.CreateCategory(context.Background(), category))
+		assert.Equal(t, 3, category.Position)
+	})
+
+	t.Run("duplicate slug", func(t *testing.T) {
+		category := &models.Category{ID: uuid.New(), Name: "Смартфоны", Slug: "smartfony"}
+
+		mock.ExpectQuery(`INSERT INTO bazaar.category`).
+			WillReturnError(&pq.Error{Code: "23505"})
+
+		err := repo.CreateCategory(context.Background(), category)
+		assert.ErrorIs(t, err, errs.ErrAlreadyExists)
+	})
+}
+
+func TestCategoryRepository_MoveCategory(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := categoryRepo.NewCategoryRepository(db)
+
+	t.Run("renumbers siblings", func(t *testing.T) {
+		id, parentID := uuid.New(), uuid.New()
+		sibling1, sibling2 := uuid.New(), uuid.New()
+		parent := uuid.NullUUID{UUID: parentID, Valid: true}
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`SELECT id FROM bazaar.category WHERE id IN \(\$1, \$2\) ORDER BY id FOR UPDATE`).
+			WithArgs(id, parent).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectQuery(`WITH RECURSIVE subtree`).
+			WithArgs(id, parentID).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+		mock.ExpectQuery(`SELECT id FROM bazaar.category WHERE parent_id IS NOT DISTINCT FROM \$1 AND id <> \$2 .+ FOR UPDATE`).
+			WithArgs(parent, id).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(sibling1).AddRow(sibling2))
+		mock.ExpectExec(`UPDATE bazaar.category SET parent_id = \$2 WHERE id = \$1`).
+			WithArgs(id, parent).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		for i, categoryID := range []uuid.UUID{sibling1, id, sibling2} {
+			mock.ExpectExec(`UPDATE bazaar.category SET position = \$2 WHERE id = \$1`).
+				WithArgs(categoryID, i).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+		}
+		mock.ExpectCommit()
+
+		require.NoError(t, repo.MoveCategory(context.Background(), id, parent, 1))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("into own subtree", func(t *testing.T) {
+		id, childID := uuid.New(), uuid.New()
+		parent := uuid.NullUUID{UUID: childID, Valid: true}
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`WHERE id IN \(\$1, \$2\)`).
+			WithArgs(id, parent).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectQuery(`WITH RECURSIVE subtree`).
+			WithArgs(id, childID).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		mock.ExpectRollback()
+
+		err := repo.MoveCategory(context.Background(), id, parent, 0)
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("category not found", func(t *testing.T) {
+		id := uuid.New()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`WHERE id IN \(\$1, \$2\)`).
+			WithArgs(id, uuid.NullUUID{}).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`SELECT id FROM bazaar.category WHERE parent_id`).
+			WithArgs(uuid.NullUUID{}, id).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectExec(`UPDATE bazaar.category SET parent_id`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		err := repo.MoveCategory(context.Background(), id, uuid.NullUUID{}, 0)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+}
+
+func TestCategoryRepository_DeleteCategory(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := categoryRepo.NewCategoryRepository(db)
+
+	t.Run("success", func(t *testing.T) {
+		id := uuid.New()
+
+		mock.ExpectExec(`DELETE FROM bazaar.category WHERE id = \$1`).
+			WithArgs(id).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.DeleteCategory(context.Background(), id))
+	})
+
+	t.Run("category is not empty", func(t *testing.T) {
+		id := uuid.New()
+
+		mock.ExpectExec(`DELETE FROM bazaar.category`).
+			WithArgs(id).
+			WillReturnError(&pq.Error{Code: "23503"})
+
+		err := repo.DeleteCategory(context.Background(), id)
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+	})
+}
@@ -0,0 +1,121 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/currency"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrencyRepository_GetRate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		now := time.Now()
+		mock.ExpectQuery("FROM bazaar.currency_rate WHERE currency = \\$1").
+			WithArgs(models.Currency("USD")).
+			WillReturnRows(sqlmock.NewRows([]string{"currency", "rate", "updated_at"}).
+				AddRow("USD", "92.500000", now))
+
+		repo := currency.NewCurrencyRepository(db)
+		rate, err := repo.GetRate(context.Background(), "USD")
+
+		require.NoError(t, err)
+		assert.Equal(t, models.Currency("USD"), rate.Currency)
+		assert.Equal(t, "92.5", rate.Rate.String())
+		require.NotNil(t, rate.UpdatedAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("FROM bazaar.currency_rate").
+			WithArgs(models.Currency("XYZ")).
+			WillReturnRows(sqlmock.NewRows([]string{"currency", "rate", "updated_at"}))
+
+		repo := currency.NewCurrencyRepository(db)
+		_, err = repo.GetRate(context.Background(), "XYZ")
+
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCurrencyRepository_GetRates(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	now := time.Now()
+	mock.ExpectQuery("FROM bazaar.currency_rate ORDER BY currency").
+		WillReturnRows(sqlmock.NewRows([]string{"currency", "rate", "updated_at"}).
+			AddRow("EUR", "100.250000", now).
+			AddRow("USD", "92.500000", now))
+
+	repo := currency.NewCurrencyRepository(db)
+	rates, err := repo.GetRates(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, rates, 2)
+	assert.Equal(t, models.Currency("EUR"), rates[0].Currency)
+	assert.Equal(t, "100.25", rates[0].Rate.String())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCurrencyRepository_SaveRates(t *testing.T) {
+	usd, err := models.ParseRate("92.5")
+	require.NoError(t, err)
+	eur, err := models.ParseRate("100.25")
+	require.NoError(t, err)
+	rates := []models.ExchangeRate{{Currency: "USD", Rate: usd}, {Currency: "EUR", Rate: eur}}
+
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO bazaar.currency_rate").
+			WithArgs(models.Currency("USD"), "92.5").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO bazaar.currency_rate").
+			WithArgs(models.Currency("EUR"), "100.25").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		repo := currency.NewCurrencyRepository(db)
+		require.NoError(t, repo.SaveRates(context.Background(), rates))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rolls back on error", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO bazaar.currency_rate").
+			WithArgs(models.Currency("USD"), "92.5").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO bazaar.currency_rate").
+			WithArgs(models.Currency("EUR"), "100.25").
+			WillReturnError(errors.New("db error"))
+		mock.ExpectRollback()
+
+		repo := currency.NewCurrencyRepository(db)
+		assert.Error(t, repo.SaveRates(context.Background(), rates))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,174 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/delivery"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var deliverySlotColumns = []string{"id", "zone_id", "starts_at", "ends_at", "capacity", "reserved"}
+
+func TestDeliveryRepository_GetAddressZone(t *testing.T) {
+	addressID := uuid.New()
+	zoneID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("FROM bazaar.address a\\s+JOIN bazaar.delivery_zone z").
+			WithArgs(addressID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "region", "city", "lead_time_days"}).
+				AddRow(zoneID, "Москва", nil, "Москва", 1))
+
+		zone, err := delivery.NewDeliveryRepository(db).GetAddressZone(context.Background(), addressID)
+		require.NoError(t, err)
+		assert.Equal(t, zoneID, zone.ID)
+		assert.False(t, zone.Region.Valid)
+		assert.Equal(t, null.StringFrom("Москва"), zone.City)
+		assert.Equal(t, 24*time.Hour, zone.LeadTime())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("JOIN bazaar.delivery_zone").
+			WithArgs(addressID).
+			WillReturnError(sql.ErrNoRows)
+
+		_, err = delivery.NewDeliveryRepository(db).GetAddressZone(context.Background(), addressID)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestDeliveryRepository_GetAvailableSlots(t *testing.T) {
+	zoneID := uuid.New()
+	from := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(7 * 24 * time.Hour)
+
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		slotID := uuid.New()
+		mock.ExpectQuery("FROM bazaar.delivery_slot\\s+WHERE zone_id = \\$1 AND starts_at >= \\$2 AND starts_at < \\$3 AND reserved < capacity").
+			WithArgs(zoneID, from, to).
+			WillReturnRows(sqlmock.NewRows(deliverySlotColumns).
+				AddRow(slotID, zoneID, from.Add(10*time.Hour), from.Add(14*time.Hour), 10, 3))
+
+		slots, err := delivery.NewDeliveryRepository(db).GetAvailableSlots(context.Background(), zoneID, from, to)
+		require.NoError(t, err)
+		require.Len(t, slots, 1)
+		assert.Equal(t, slotID, slots[0].ID)
+		assert.Equal(t, 3, slots[0].Reserved)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("FROM bazaar.delivery_slot").
+			WithArgs(zoneID, from, to).
+			WillReturnError(errors.New("db error"))
+
+		_, err = delivery.NewDeliveryRepository(db).GetAvailableSlots(context.Background(), zoneID, from, to)
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestDeliveryRepository_GetSlot(t *testing.T) {
+	slotID := uuid.New()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("FROM bazaar.delivery_slot\\s+WHERE id = \\$1").
+		WithArgs(slotID).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = delivery.NewDeliveryRepository(db).GetSlot(context.Background(), slotID)
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeliveryRepository_CreateSlot(t *testing.T) {
+	slot := models.DeliverySlot{
+		ID:       uuid.New(),
+		ZoneID:   uuid.New(),
+		StartsAt: time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC),
+		EndsAt:   time.Date(2025, 6, 1, 14, 0, 0, 0, time.UTC),
+		Capacity: 20,
+	}
+
+	tests := []struct {
+		name    string
+		execErr error
+		wantErr error
+	}{
+		{name: "success"},
+		{name: "duplicate slot", execErr: &pq.Error{Code: "23505"}, wantErr: errs.ErrAlreadyExists},
+		{name: "unknown zone", execErr: &pq.Error{Code: "23503"}, wantErr: errs.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			expect := mock.ExpectExec("INSERT INTO bazaar.delivery_slot").
+				WithArgs(slot.ID, slot.ZoneID, slot.StartsAt, slot.EndsAt, slot.Capacity)
+			if tt.execErr != nil {
+				expect.WillReturnError(tt.execErr)
+			} else {
+				expect.WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+
+			err = delivery.NewDeliveryRepository(db).CreateSlot(context.Background(), slot)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDeliveryRepository_CreateZone(t *testing.T) {
+	zone := models.DeliveryZone{ID: uuid.New(), Name: "Казань", City: null.StringFrom("Казань"), LeadTimeDays: 3}
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec("INSERT INTO bazaar.delivery_zone").
+		WithArgs(zone.ID, zone.Name, nil, "Казань", 3).
+		WillReturnError(&pq.Error{Code: "23505"})
+
+	err = delivery.NewDeliveryRepository(db).CreateZone(context.Background(), zone)
+	assert.ErrorIs(t, err, errs.ErrAlreadyExists)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,273 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/inventory"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var stockMovementColumns = []string{
+	"id", "product_id", "variant_id", "delta", "balance", "kind", "actor_id", "order_id", "reason", "created_at",
+}
+
+// expectStockContext ожидает передачу контекста изменения остатка триггерам журнала
+func expectStockContext(mock sqlmock.Sqlmock, kind models.StockMovementKind, actor, order, reason string) {
+	mock.ExpectExec("SELECT set_config\\('bazaar.stock_kind'").
+		WithArgs(string(kind), actor, order, reason).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func TestInventoryRepository_AdjustStock(t *testing.T) {
+	sellerID := uuid.New()
+	productID := uuid.New()
+	change := models.StockChange{
+		Kind:    models.StockAdjustment,
+		ActorID: uuid.NullUUID{UUID: sellerID, Valid: true},
+		Reason:  "inventory count",
+	}
+
+	t.Run("product stock", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := inventory.NewInventoryRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT p.quantity, EXISTS").
+			WithArgs(productID, sellerID).
+			WillReturnRows(sqlmock.NewRows([]string{"quantity", "has_variants"}).AddRow(5, false))
+		mock.ExpectQuery("SELECT quantity FROM bazaar.product WHERE id = \\$1 FOR UPDATE").
+			WithArgs(productID).
+			WillReturnRows(sqlmock.NewRows([]string{"quantity"}).AddRow(5))
+		expectStockContext(mock, models.StockAdjustment, sellerID.String(), "", "inventory count")
+		mock.ExpectExec("UPDATE bazaar.product SET quantity = quantity \\+ \\$2").
+			WithArgs(productID, -2).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("FROM bazaar.stock_movement").
+			WithArgs(productID, uuid.NullUUID{}).
+			WillReturnRows(sqlmock.NewRows(stockMovementColumns).AddRow(
+				int64(7), productID, nil, -2, 3, "adjustment", sellerID, nil, "inventory count", time.Now(),
+			))
+		mock.ExpectCommit()
+
+		movement, err := repo.AdjustStock(context.Background(), sellerID, productID, uuid.NullUUID{}, -2, change)
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), movement.ID)
+		assert.Equal(t, 3, movement.Balance)
+		assert.Equal(t, models.StockAdjustment, movement.Kind)
+		assert.False(t, movement.VariantID.Valid)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("variant stock", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := inventory.NewInventoryRepository(db)
+		variantID := uuid.NullUUID{UUID: uuid.New(), Valid: true}
+		returned := models.StockChange{Kind: models.StockReturn, ActorID: change.ActorID, Reason: "customer return"}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT p.quantity, EXISTS").
+			WithArgs(productID, sellerID).
+			WillReturnRows(sqlmock.NewRows([]string{"quantity", "has_variants"}).AddRow(4, true))
+		mock.ExpectQuery("SELECT quantity FROM bazaar.product_variant").
+			WithArgs(variantID.UUID, productID).
+			WillReturnRows(sqlmock.NewRows([]string{"quantity"}).AddRow(1))
+		expectStockContext(mock, models.StockReturn, sellerID.String(), "", "customer return")
+		mock.ExpectExec("UPDATE bazaar.product_variant SET quantity = quantity \\+ \\$2").
+			WithArgs(variantID.UUID, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery("FROM bazaar.stock_movement").
+			WithArgs(productID, variantID).
+			WillReturnRows(sqlmock.NewRows(stockMovementColumns).AddRow(
+				int64(8), productID, variantID.UUID, 1, 2, "return", sellerID, nil, "customer return", time.Now(),
+			))
+		mock.ExpectCommit()
+
+		movement, err := repo.AdjustStock(context.Background(), sellerID, productID, variantID, 1, returned)
+		require.NoError(t, err)
+		assert.Equal(t, variantID, movement.VariantID)
+		assert.Equal(t, models.StockReturn, movement.Kind)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("negative stock", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := inventory.NewInventoryRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT p.quantity, EXISTS").
+			WithArgs(productID, sellerID).
+			WillReturnRows(sqlmock.NewRows([]string{"quantity", "has_variants"}).AddRow(1, false))
+		mock.ExpectQuery("SELECT quantity FROM bazaar.product WHERE id = \\$1 FOR UPDATE").
+			WithArgs(productID).
+			WillReturnRows(sqlmock.NewRows([]string{"quantity"}).AddRow(1))
+		mock.ExpectRollback()
+
+		_, err = repo.AdjustStock(context.Background(), sellerID, productID, uuid.NullUUID{}, -2, change)
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("product with variants", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := inventory.NewInventoryRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT p.quantity, EXISTS").
+			WithArgs(productID, sellerID).
+			WillReturnRows(sqlmock.NewRows([]string{"quantity", "has_variants"}).AddRow(6, true))
+		mock.ExpectRollback()
+
+		_, err = repo.AdjustStock(context.Background(), sellerID, productID, uuid.NullUUID{}, 1, change)
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("foreign product", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := inventory.NewInventoryRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT p.quantity, EXISTS").
+			WithArgs(productID, sellerID).
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		_, err = repo.AdjustStock(context.Background(), sellerID, productID, uuid.NullUUID{}, 1, change)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestInventoryRepository_GetMovements(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := inventory.NewInventoryRepository(db)
+	sellerID := uuid.New()
+	productID := uuid.New()
+	orderID := uuid.New()
+
+	mock.ExpectQuery("FROM bazaar.stock_movement m JOIN bazaar.product p").
+		WithArgs(productID, sellerID, 20, 40).
+		WillReturnRows(sqlmock.NewRows(stockMovementColumns).
+			AddRow(int64(2), productID, nil, -1, 4, "sale", nil, orderID, "", time.Now()).
+			AddRow(int64(1), productID, nil, 5, 5, "initial", sellerID, nil, "", time.Now()))
+
+	movements, err := repo.GetMovements(context.Background(), sellerID, productID, 40)
+	require.NoError(t, err)
+	require.Len(t, movements, 2)
+	assert.Equal(t, models.StockSale, movements[0].Kind)
+	assert.Equal(t, orderID, movements[0].OrderID.UUID)
+	assert.False(t, movements[0].ActorID.Valid)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInventoryRepository_SetLowStockThreshold(t *testing.T) {
+	sellerID := uuid.New()
+	productID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := inventory.NewInventoryRepository(db)
+
+		mock.ExpectExec("UPDATE bazaar.product SET low_stock_threshold").
+			WithArgs(productID, sellerID, int64(3)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err = repo.SetLowStockThreshold(context.Background(), sellerID, productID, sql.NullInt64{Int64: 3, Valid: true})
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := inventory.NewInventoryRepository(db)
+
+		mock.ExpectExec("UPDATE bazaar.product SET low_stock_threshold").
+			WithArgs(productID, sellerID, nil).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err = repo.SetLowStockThreshold(context.Background(), sellerID, productID, sql.NullInt64{})
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestInventoryRepository_GetDiscrepancies(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := inventory.NewInventoryRepository(db)
+	sellerID := uuid.New()
+	productID := uuid.New()
+	variantID := uuid.New()
+
+	mock.ExpectQuery("HAVING u.quantity <> COALESCE\\(SUM\\(m.delta\\), 0\\)").
+		WithArgs(sellerID).
+		WillReturnRows(sqlmock.NewRows([]string{"product_id", "variant_id", "name", "sku", "quantity", "ledger"}).
+			AddRow(productID, variantID, "Phone", "PH-BLK", 4, 3))
+
+	discrepancies, err := repo.GetDiscrepancies(context.Background(), sellerID)
+	require.NoError(t, err)
+	require.Len(t, discrepancies, 1)
+	assert.Equal(t, variantID, discrepancies[0].VariantID.UUID)
+	assert.Equal(t, 4, discrepancies[0].Quantity)
+	assert.Equal(t, 3, discrepancies[0].LedgerBalance)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestInventoryRepository_ClaimLowStockAlerts(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := inventory.NewInventoryRepository(db)
+	alertID := uuid.New()
+	sellerID := uuid.New()
+	productID := uuid.New()
+
+	mock.ExpectQuery("UPDATE bazaar.low_stock_alert SET notified_at = now\\(\\).*FOR UPDATE SKIP LOCKED").
+		WithArgs(50).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "seller_id", "product_id", "variant_id", "name", "sku", "quantity", "threshold",
+		}).AddRow(alertID, sellerID, productID, nil, "Phone", "", 2, 3))
+
+	alerts, err := repo.ClaimLowStockAlerts(context.Background(), 50)
+	require.NoError(t, err)
+	require.Len(t, alerts, 1)
+	assert.Equal(t, sellerID, alerts[0].SellerID)
+	assert.Equal(t, 2, alerts[0].Quantity)
+	assert.Equal(t, 3, alerts[0].Threshold)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -3,6 +3,7 @@ package tests
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"errors"
 	"github.com/DATA-DOG/go-sqlmock"
 	order2 "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/order"
@@ -19,16 +20,30 @@ import (
 )
 
 const queryUpdateOrderStatus = `
-	UPDATE bazaar.order
-	SET 
-		status = $1,
-		updated_at = now()
-	WHERE id = $2`
+	WITH updated AS (
+		UPDATE bazaar.order
+		SET 
+			status = $1,
+			actual_delivery_at = CASE WHEN $1 = 'delivered' THEN now() ELSE actual_delivery_at END,
+			updated_at = now()
+		WHERE id = $2 AND warehouse_id = $3 AND status = $4
+		RETURNING id
+	), shipments AS (
+		UPDATE bazaar.order_shipment s
+		SET status = $1, updated_at = now()
+		FROM updated u
+		WHERE s.order_id = u.id
+			AND s.status NOT IN ('canceled', 'canceled_by_user', 'canceled_by_seller',
+				'canceled_due_to_payment_error', 'payment_failed')
+	)
+	SELECT (SELECT COUNT(*) FROM updated),
+		EXISTS (SELECT 1 FROM bazaar.order WHERE id = $2 AND warehouse_id = $3)`
 
 const queryGetOrders = `
 		SELECT id, status, total_price, total_price_discount, 
 			address_id, expected_delivery_at, actual_delivery_at, created_at 
-		FROM bazaar.order WHERE status = 'placed'`
+		FROM bazaar.order WHERE status = 'placed' AND warehouse_id = $1
+		ORDER BY created_at`
 
 func TestCreateOrder_Success(t *testing.T) {
 	db, mock, err := sqlmock.New()
@@ -42,26 +57,36 @@ func TestCreateOrder_Success(t *testing.T) {
 	addressID := uuid.New()
 	productID := uuid.New()
 	itemID := uuid.New()
+	shipmentID := uuid.New()
+	sellerID := uuid.New()
 
 	req := dto.CreateOrderRepoReq{
 		Order: &dto.Order{
 			ID:                 orderID,
 			UserID:             userID,
 			Status:             models.Placed,
-			TotalPrice:         100.0,
-			TotalPriceDiscount: 90.0,
+			TotalPrice:         models.Rubles(100),
+			TotalPriceDiscount: models.Rubles(90),
 			AddressID:          addressID,
 			Items: []dto.CreateOrderItemDTO{
 				{
-					ID:        itemID,
-					ProductID: productID,
-					Price:     50.0,
-					Quantity:  2,
+					ID:         itemID,
+					ShipmentID: shipmentID,
+					ProductID:  productID,
+					Price:      models.Rubles(50),
+					BasePrice:  models.Rubles(55),
+					Quantity:   2,
+				},
+			},
+			Shipments: []dto.Shipment{
+				{
+					ID:                 shipmentID,
+					SellerID:           sellerID,
+					Status:             models.AwaitingConfirmation,
+					TotalPrice:         models.Rubles(100),
+					TotalPriceDiscount: models.Rubles(90),
 				},
 			},
-		},
-		UpdatedQuantities: map[uuid.UUID]uint{
-			productID: 5,
 		},
 	}
 
@@ -71,21 +96,44 @@ func TestCreateOrder_Success(t *testing.T) {
 			orderID,
 			userID,
 			"placed",
-			float64(100),
-			float64(90),
+			models.Rubles(100),
+			models.Rubles(90),
 			addressID,
+			models.Money{},
+			nil,
+			nil,
+			nil,
+			models.BaseCurrency,
+			nil,
 		).
 		WillReturnResult(sqlmock.NewResult(1, 1))
-	mock.ExpectExec("UPDATE bazaar.product SET quantity").
-		WithArgs(uint(5), productID).
+	mock.ExpectQuery("UPDATE bazaar.stock_reservation SET status = 'confirmed'").
+		WithArgs(userID, productID, orderID, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(0))
+	expectStockContext(mock, models.StockSale, userID.String(), orderID.String(), "")
+	mock.ExpectExec(`UPDATE bazaar.product SET quantity = quantity - \$1 WHERE id = \$2 AND status = 'approved' AND quantity >= \$1`).
+		WithArgs(uint(2), productID).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO bazaar.order_shipment").
+		WithArgs(
+			shipmentID,
+			orderID,
+			sellerID,
+			"awaiting_confirmation",
+			models.Rubles(100),
+			models.Rubles(90),
+		).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec("INSERT INTO bazaar.order_item").
 		WithArgs(
 			itemID,
 			orderID,
 			productID,
-			float64(50),
+			nil,
+			models.Rubles(50),
 			uint(2),
+			shipmentID,
+			models.Rubles(55),
 		).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
@@ -97,6 +145,70 @@ func TestCreateOrder_Success(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestCreateOrder_FirstOrderOnlyPromo(t *testing.T) {
+	orderID := uuid.New()
+	userID := uuid.New()
+	promoID := uuid.New()
+	redemptionID := uuid.New()
+
+	req := dto.CreateOrderRepoReq{
+		Order: &dto.Order{ID: orderID, UserID: userID, Status: models.Placed},
+		PromoRedemption: &models.PromoRedemption{
+			ID:             redemptionID,
+			PromoID:        promoID,
+			UserID:         userID,
+			Discount:       models.Rubles(10),
+			FirstOrderOnly: true,
+		},
+	}
+
+	expectRedemptionChecks := func(mock sqlmock.Sqlmock, priorOrders int) {
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO bazaar.order").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery("SELECT id FROM bazaar.promo_code WHERE id = \\$1 AND is_active FOR UPDATE").
+			WithArgs(promoID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(promoID))
+		mock.ExpectQuery("FROM bazaar.promo_redemption r JOIN bazaar.\"order\" o ON o.id = r.order_id "+
+			"WHERE r.promo_id = \\$1 AND o.status NOT IN \\('canceled', 'canceled_by_user', 'canceled_by_seller', "+
+			"'canceled_due_to_payment_error', 'payment_failed'\\)").
+			WithArgs(promoID, userID).
+			WillReturnRows(sqlmock.NewRows([]string{"total", "by_user"}).AddRow(0, 0))
+		mock.ExpectQuery("FROM bazaar.order\\s+WHERE user_id = \\$1 AND id <> \\$2").
+			WithArgs(userID, orderID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(priorOrders))
+	}
+
+	t.Run("first order", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		expectRedemptionChecks(mock, 0)
+		mock.ExpectExec("INSERT INTO bazaar.promo_redemption").
+			WithArgs(redemptionID, promoID, userID, orderID, models.Rubles(10)).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		repo := order2.NewOrderRepository(db)
+		assert.NoError(t, repo.CreateOrder(context.Background(), req))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("concurrent order committed first", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		expectRedemptionChecks(mock, 1)
+		mock.ExpectRollback()
+
+		repo := order2.NewOrderRepository(db)
+		err = repo.CreateOrder(context.Background(), req)
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 func TestCreateOrder_ErrorOnBeginTx(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -130,8 +242,8 @@ func TestCreateOrder_ErrorOnInsertOrder(t *testing.T) {
 			ID:                 orderID,
 			UserID:             userID,
 			Status:             models.Placed,
-			TotalPrice:         100.0,
-			TotalPriceDiscount: 90.0,
+			TotalPrice:         models.Rubles(100),
+			TotalPriceDiscount: models.Rubles(90),
 			AddressID:          addressID,
 		},
 	}
@@ -142,9 +254,15 @@ func TestCreateOrder_ErrorOnInsertOrder(t *testing.T) {
 			orderID,
 			userID,
 			"placed",
-			float64(100),
-			float64(90),
+			models.Rubles(100),
+			models.Rubles(90),
 			addressID,
+			models.Money{},
+			nil,
+			nil,
+			nil,
+			models.BaseCurrency,
+			nil,
 		).
 		WillReturnError(errors.New("insert error"))
 	mock.ExpectRollback()
@@ -157,7 +275,7 @@ func TestCreateOrder_ErrorOnInsertOrder(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
-func TestCreateOrder_ErrorOnUpdateQuantity(t *testing.T) {
+func TestCreateOrder_NotEnoughStock(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
 		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
@@ -174,12 +292,12 @@ func TestCreateOrder_ErrorOnUpdateQuantity(t *testing.T) {
 			ID:                 orderID,
 			UserID:             userID,
 			Status:             models.Placed,
-			TotalPrice:         100.0,
-			TotalPriceDiscount: 90.0,
+			TotalPrice:         models.Rubles(100),
+			TotalPriceDiscount: models.Rubles(90),
 			AddressID:          addressID,
-		},
-		UpdatedQuantities: map[uuid.UUID]uint{
-			productID: 5,
+			Items: []dto.CreateOrderItemDTO{
+				{ID: uuid.New(), ProductID: productID, Price: models.Rubles(50), Quantity: 5},
+			},
 		},
 	}
 
@@ -189,21 +307,215 @@ func TestCreateOrder_ErrorOnUpdateQuantity(t *testing.T) {
 			orderID,
 			userID,
 			"placed",
-			float64(100),
-			float64(90),
+			models.Rubles(100),
+			models.Rubles(90),
 			addressID,
+			models.Money{},
+			nil,
+			nil,
+			nil,
+			models.BaseCurrency,
+			nil,
 		).
 		WillReturnResult(sqlmock.NewResult(1, 1))
-	mock.ExpectExec("UPDATE bazaar.product SET quantity").
-		WithArgs(uint(5), productID).
-		WillReturnError(errors.New("update error"))
+	mock.ExpectQuery("UPDATE bazaar.stock_reservation SET status = 'confirmed'").
+		WithArgs(userID, productID, orderID, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(2))
+	// Резерв покрывает 2 единицы, остальные 3 списываются из остатка, которого не хватает
+	expectStockContext(mock, models.StockSale, userID.String(), orderID.String(), "")
+	mock.ExpectExec("UPDATE bazaar.product SET quantity = quantity -").
+		WithArgs(uint(3), productID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
 	mock.ExpectRollback()
 
 	repo := order2.NewOrderRepository(db)
 	err = repo.CreateOrder(context.Background(), req)
 
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "update error")
+	assert.ErrorIs(t, err, errs.ErrNotEnoughStock)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+// Параллельный заказ, закоммиченный первым, забрал последние единицы: условие
+// остатка в запросе списания не выполняется, и заказ откатывается целиком
+func TestCreateOrder_StockTakenConcurrently(t *testing.T) {
+	orderID := uuid.New()
+	userID := uuid.New()
+	addressID := uuid.New()
+	productID := uuid.New()
+	variantID := uuid.New()
+
+	for name, tc := range map[string]struct {
+		variantID uuid.NullUUID
+		takeStock string
+		args      []driver.Value
+	}{
+		"product": {
+			takeStock: `UPDATE bazaar.product SET quantity = quantity - \$1 WHERE id = \$2 AND status = 'approved' AND quantity >= \$1`,
+			args:      []driver.Value{uint(1), productID},
+		},
+		"variant": {
+			variantID: uuid.NullUUID{UUID: variantID, Valid: true},
+			takeStock: `UPDATE bazaar.product_variant v SET quantity = v.quantity - \$1 .+ AND v.quantity >= \$1`,
+			args:      []driver.Value{uint(1), productID, variantID},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			item := dto.CreateOrderItemDTO{ID: uuid.New(), ProductID: productID, Price: models.Rubles(50), Quantity: 1}
+			if tc.variantID.Valid {
+				item.VariantID = &tc.variantID.UUID
+			}
+			req := dto.CreateOrderRepoReq{
+				Order: &dto.Order{
+					ID:                 orderID,
+					UserID:             userID,
+					Status:             models.Placed,
+					TotalPrice:         models.Rubles(50),
+					TotalPriceDiscount: models.Rubles(50),
+					AddressID:          addressID,
+					Items:              []dto.CreateOrderItemDTO{item},
+				},
+			}
+
+			mock.ExpectBegin()
+			mock.ExpectExec("INSERT INTO bazaar.order").WillReturnResult(sqlmock.NewResult(1, 1))
+			mock.ExpectQuery("UPDATE bazaar.stock_reservation SET status = 'confirmed'").
+				WithArgs(userID, productID, orderID, tc.variantID).
+				WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(0))
+			expectStockContext(mock, models.StockSale, userID.String(), orderID.String(), "")
+			mock.ExpectExec(tc.takeStock).
+				WithArgs(tc.args...).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+			// Ни отправлений, ни позиций: заказ откатывается
+			mock.ExpectRollback()
+
+			repo := order2.NewOrderRepository(db)
+			err = repo.CreateOrder(context.Background(), req)
+
+			assert.ErrorIs(t, err, errs.ErrNotEnoughStock)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCreateOrder_DeliverySlot(t *testing.T) {
+	orderID := uuid.New()
+	userID := uuid.New()
+	addressID := uuid.New()
+	slotID := uuid.New()
+	expected := time.Date(2025, 6, 2, 18, 0, 0, 0, time.UTC)
+
+	req := dto.CreateOrderRepoReq{
+		Order: &dto.Order{
+			ID:                 orderID,
+			UserID:             userID,
+			Status:             models.Placed,
+			TotalPrice:         models.Rubles(100),
+			TotalPriceDiscount: models.Rubles(90),
+			AddressID:          addressID,
+			ExpectedDeliveryAt: &expected,
+			DeliverySlotID:     uuid.NullUUID{UUID: slotID, Valid: true},
+		},
+	}
+	expectInsertOrder := func(mock sqlmock.Sqlmock) {
+		mock.ExpectExec("INSERT INTO bazaar.order").
+			WithArgs(orderID, userID, "placed", models.Rubles(100), models.Rubles(90), addressID, models.Money{}, expected, slotID, nil, models.BaseCurrency, nil).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+	}
+
+	t.Run("slot reserved", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		expectInsertOrder(mock)
+		mock.ExpectExec(`UPDATE bazaar.delivery_slot SET reserved = reserved \+ 1 WHERE id = \$1 AND reserved < capacity`).
+			WithArgs(slotID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		repo := order2.NewOrderRepository(db)
+		err = repo.CreateOrder(context.Background(), req)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("slot is full", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		expectInsertOrder(mock)
+		mock.ExpectExec("UPDATE bazaar.delivery_slot SET reserved = reserved").
+			WithArgs(slotID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		repo := order2.NewOrderRepository(db)
+		err = repo.CreateOrder(context.Background(), req)
+
+		assert.ErrorIs(t, err, errs.ErrSlotUnavailable)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCreateOrder_ReservationCoversOrder(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	orderID := uuid.New()
+	userID := uuid.New()
+	addressID := uuid.New()
+	firstID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	secondID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+
+	req := dto.CreateOrderRepoReq{
+		Order: &dto.Order{
+			ID:        orderID,
+			UserID:    userID,
+			Status:    models.Placed,
+			AddressID: addressID,
+			Items: []dto.CreateOrderItemDTO{
+				{ID: uuid.New(), ProductID: secondID, Price: models.Rubles(10), Quantity: 1},
+				{ID: uuid.New(), ProductID: firstID, Price: models.Rubles(20), Quantity: 2},
+			},
+		},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO bazaar.order").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	// Товары обрабатываются в порядке идентификаторов
+	mock.ExpectQuery("UPDATE bazaar.stock_reservation SET status = 'confirmed'").
+		WithArgs(userID, firstID, orderID, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(2))
+	mock.ExpectQuery("UPDATE bazaar.stock_reservation SET status = 'confirmed'").
+		WithArgs(userID, secondID, orderID, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(3))
+	// Излишек резерва возвращается в остаток
+	expectStockContext(mock, models.StockRelease, userID.String(), orderID.String(), "reserved more than ordered")
+	mock.ExpectExec(`UPDATE bazaar.product SET quantity = quantity \+ \$1 WHERE id = \$2`).
+		WithArgs(uint(2), secondID).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO bazaar.order_item").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO bazaar.order_item").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	repo := order2.NewOrderRepository(db)
+	err = repo.CreateOrder(context.Background(), req)
+
+	assert.NoError(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -225,14 +537,14 @@ func TestCreateOrder_ErrorOnInsertOrderItem(t *testing.T) {
 			ID:                 orderID,
 			UserID:             userID,
 			Status:             models.Placed,
-			TotalPrice:         100.0,
-			TotalPriceDiscount: 90.0,
+			TotalPrice:         models.Rubles(100),
+			TotalPriceDiscount: models.Rubles(90),
 			AddressID:          addressID,
 			Items: []dto.CreateOrderItemDTO{
 				{
 					ID:        itemID,
 					ProductID: productID,
-					Price:     50.0,
+					Price:     models.Rubles(50),
 					Quantity:  2,
 				},
 			},
@@ -245,18 +557,30 @@ func TestCreateOrder_ErrorOnInsertOrderItem(t *testing.T) {
 			orderID,
 			userID,
 			"placed",
-			float64(100),
-			float64(90),
+			models.Rubles(100),
+			models.Rubles(90),
 			addressID,
+			models.Money{},
+			nil,
+			nil,
+			nil,
+			models.BaseCurrency,
+			nil,
 		).
 		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("UPDATE bazaar.stock_reservation SET status = 'confirmed'").
+		WithArgs(userID, productID, orderID, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(2))
 	mock.ExpectExec("INSERT INTO bazaar.order_item").
 		WithArgs(
 			itemID,
 			orderID,
 			productID,
-			float64(50),
+			nil,
+			models.Rubles(50),
 			uint(2),
+			nil,
+			models.Money{},
 		).
 		WillReturnError(errors.New("insert item error"))
 	mock.ExpectRollback()
@@ -287,14 +611,14 @@ func TestCreateOrder_ErrorOnCommit(t *testing.T) {
 			ID:                 orderID,
 			UserID:             userID,
 			Status:             models.Placed,
-			TotalPrice:         100.0,
-			TotalPriceDiscount: 90.0,
+			TotalPrice:         models.Rubles(100),
+			TotalPriceDiscount: models.Rubles(90),
 			AddressID:          addressID,
 			Items: []dto.CreateOrderItemDTO{
 				{
 					ID:        itemID,
 					ProductID: productID,
-					Price:     50.0,
+					Price:     models.Rubles(50),
 					Quantity:  2,
 				},
 			},
@@ -307,18 +631,30 @@ func TestCreateOrder_ErrorOnCommit(t *testing.T) {
 			orderID,
 			userID,
 			"placed",
-			float64(100),
-			float64(90),
+			models.Rubles(100),
+			models.Rubles(90),
 			addressID,
+			models.Money{},
+			nil,
+			nil,
+			nil,
+			models.BaseCurrency,
+			nil,
 		).
 		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery("UPDATE bazaar.stock_reservation SET status = 'confirmed'").
+		WithArgs(userID, productID, orderID, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(2))
 	mock.ExpectExec("INSERT INTO bazaar.order_item").
 		WithArgs(
 			itemID,
 			orderID,
 			productID,
-			float64(50),
+			nil,
+			models.Rubles(50),
 			uint(2),
+			nil,
+			models.Money{},
 		).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit().WillReturnError(errors.New("commit error"))
@@ -340,15 +676,16 @@ func TestProductPrice_Success(t *testing.T) {
 
 	productID := uuid.New()
 	expectedProduct := &models.Product{
-		Price:    100.0,
+		Price:    models.Rubles(100),
 		Status:   models.ProductApproved,
 		Quantity: 10,
+		SellerID: uuid.New(),
 	}
 
-	rows := sqlmock.NewRows([]string{"price", "status", "quantity"}).
-		AddRow(expectedProduct.Price, "approved", expectedProduct.Quantity)
+	rows := sqlmock.NewRows([]string{"price", "status", "quantity", "seller_id", "has_variants"}).
+		AddRow(expectedProduct.Price, "approved", expectedProduct.Quantity, expectedProduct.SellerID.String(), false)
 
-	mock.ExpectQuery("SELECT price, status, quantity FROM bazaar.product").
+	mock.ExpectQuery("SELECT p.price, p.status, p.quantity, p.seller_id, EXISTS").
 		WithArgs(productID).
 		WillReturnRows(rows)
 
@@ -369,7 +706,7 @@ func TestProductPrice_NotFound(t *testing.T) {
 
 	productID := uuid.New()
 
-	mock.ExpectQuery("SELECT price, status, quantity FROM bazaar.product").
+	mock.ExpectQuery("SELECT p.price, p.status, p.quantity, p.seller_id, EXISTS").
 		WithArgs(productID).
 		WillReturnError(sql.ErrNoRows)
 
@@ -391,10 +728,10 @@ func TestProductPrice_InvalidStatus(t *testing.T) {
 
 	productID := uuid.New()
 
-	rows := sqlmock.NewRows([]string{"price", "status", "quantity"}).
-		AddRow(100.0, "invalid_status", 10)
+	rows := sqlmock.NewRows([]string{"price", "status", "quantity", "seller_id", "has_variants"}).
+		AddRow("100.00", "invalid_status", 10, uuid.New().String(), false)
 
-	mock.ExpectQuery("SELECT price, status, quantity FROM bazaar.product").
+	mock.ExpectQuery("SELECT p.price, p.status, p.quantity, p.seller_id, EXISTS").
 		WithArgs(productID).
 		WillReturnRows(rows)
 
@@ -416,7 +753,7 @@ func TestProductPrice_QueryError(t *testing.T) {
 
 	productID := uuid.New()
 
-	mock.ExpectQuery("SELECT price, status, quantity FROM bazaar.product").
+	mock.ExpectQuery("SELECT p.price, p.status, p.quantity, p.seller_id, EXISTS").
 		WithArgs(productID).
 		WillReturnError(errors.New("database error"))
 
@@ -440,7 +777,7 @@ func TestProductDiscounts_Success(t *testing.T) {
 	now := time.Now()
 	expectedDiscounts := []models.ProductDiscount{
 		{
-			DiscountedPrice:   90.0,
+			DiscountedPrice:   models.Rubles(90),
 			DiscountStartDate: now.Add(-24 * time.Hour),
 			DiscountEndDate:   now.Add(24 * time.Hour),
 		},
@@ -450,60 +787,17 @@ func TestProductDiscounts_Success(t *testing.T) {
 		AddRow(expectedDiscounts[0].DiscountedPrice, expectedDiscounts[0].DiscountStartDate, expectedDiscounts[0].DiscountEndDate)
 
 	mock.ExpectQuery("SELECT discounted_price, start_date, end_date FROM bazaar.discount").
-		WithArgs(productID).
+		WithArgs(productID, nil).
 		WillReturnRows(rows)
 
 	repo := order2.NewOrderRepository(db)
-	discounts, err := repo.ProductDiscounts(context.Background(), productID)
+	discounts, err := repo.ProductDiscounts(context.Background(), productID, uuid.NullUUID{})
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedDiscounts, discounts)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
-func TestUpdateProductQuantity_Success(t *testing.T) {
-	db, mock, err := sqlmock.New()
-	if err != nil {
-		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
-	}
-	defer db.Close()
-
-	productID := uuid.New()
-	quantity := uint(5)
-
-	mock.ExpectExec("UPDATE bazaar.product SET quantity").
-		WithArgs(quantity, productID).
-		WillReturnResult(sqlmock.NewResult(1, 1))
-
-	repo := order2.NewOrderRepository(db)
-	err = repo.UpdateProductQuantity(context.Background(), productID, quantity)
-
-	assert.NoError(t, err)
-	assert.NoError(t, mock.ExpectationsWereMet())
-}
-
-func TestUpdateProductQuantity_NotFound(t *testing.T) {
-	db, mock, err := sqlmock.New()
-	if err != nil {
-		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
-	}
-	defer db.Close()
-
-	productID := uuid.New()
-	quantity := uint(5)
-
-	mock.ExpectExec("UPDATE bazaar.product SET quantity").
-		WithArgs(quantity, productID).
-		WillReturnResult(sqlmock.NewResult(0, 0))
-
-	repo := order2.NewOrderRepository(db)
-	err = repo.UpdateProductQuantity(context.Background(), productID, quantity)
-
-	assert.Error(t, err)
-	assert.True(t, errors.Is(err, errs.ErrNotFound))
-	assert.NoError(t, mock.ExpectationsWereMet())
-}
-
 func TestGetOrdersByUserID_QueryError(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -513,7 +807,7 @@ func TestGetOrdersByUserID_QueryError(t *testing.T) {
 
 	userID := uuid.New()
 
-	mock.ExpectQuery("SELECT id, status, total_price, total_price_discount, address_id, expected_delivery_at, actual_delivery_at, created_at FROM bazaar.order").
+	mock.ExpectQuery("SELECT id, status, total_price, total_price_discount, address_id, expected_delivery_at, actual_delivery_at, created_at, display_currency, display_rate FROM bazaar.order").
 		WithArgs(userID).
 		WillReturnError(errors.New("database error"))
 
@@ -540,12 +834,12 @@ func TestGetOrdersByUserID_ScanError(t *testing.T) {
 
 	rows := sqlmock.NewRows([]string{
 		"id", "status", "total_price", "total_price_discount", "address_id",
-		"expected_delivery_at", "actual_delivery_at", "created_at",
+		"expected_delivery_at", "actual_delivery_at", "created_at", "display_currency", "display_rate",
 	}).AddRow(
-		orderID, "invalid_status", 100.0, 90.0, addressID, now.Add(24*time.Hour), nil, now,
+		orderID, "invalid_status", "100.00", "90.00", addressID, now.Add(24*time.Hour), nil, now, "RUB", nil,
 	)
 
-	mock.ExpectQuery("SELECT id, status, total_price, total_price_discount, address_id, expected_delivery_at, actual_delivery_at, created_at FROM bazaar.order").
+	mock.ExpectQuery("SELECT id, status, total_price, total_price_discount, address_id, expected_delivery_at, actual_delivery_at, created_at, display_currency, display_rate FROM bazaar.order").
 		WithArgs(userID).
 		WillReturnRows(rows)
 
@@ -725,10 +1019,12 @@ func TestGetOrdersPlaced_QueryError(t *testing.T) {
 
 	repo := order2.NewOrderRepository(db)
 
-	mock.ExpectQuery("SELECT (.+) FROM bazaar.order WHERE status = 'placed'").
+	warehouseID := uuid.New()
+	mock.ExpectQuery("SELECT (.+) FROM bazaar.order WHERE status = 'placed' AND warehouse_id = \\$1").
+		WithArgs(warehouseID).
 		WillReturnError(errors.New("query error"))
 
-	orders, err := repo.GetOrdersPlaced(context.Background())
+	orders, err := repo.GetOrdersPlaced(context.Background(), warehouseID)
 	assert.Nil(t, orders)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "query error")
@@ -823,16 +1119,73 @@ func TestUpdateStatus_Success(t *testing.T) {
 	defer db.Close()
 
 	orderID := uuid.New()
-	status := models.Placed
+	warehouseID := uuid.New()
 
-	mock.ExpectExec(regexp.QuoteMeta(queryUpdateOrderStatus)).
-		WithArgs(status.String(), orderID).
-		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(queryUpdateOrderStatus)).
+		WithArgs(models.InTransit.String(), orderID, warehouseID, models.Placed.String()).
+		WillReturnRows(sqlmock.NewRows([]string{"count", "exists"}).AddRow(1, true))
+	mock.ExpectQuery(regexp.QuoteMeta(queryUpdateOrderStatus)).
+		WithArgs(models.Delivered.String(), orderID, warehouseID, models.InTransit.String()).
+		WillReturnRows(sqlmock.NewRows([]string{"count", "exists"}).AddRow(1, true))
 
 	repo := order2.NewOrderRepository(db)
-	err = repo.UpdateStatus(context.Background(), orderID, status)
+	require.NoError(t, repo.UpdateStatus(context.Background(), orderID, warehouseID, models.InTransit))
+	require.NoError(t, repo.UpdateStatus(context.Background(), orderID, warehouseID, models.Delivered))
 
-	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateStatus_OtherWarehouse(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	orderID := uuid.New()
+	warehouseID := uuid.New()
+
+	mock.ExpectQuery(regexp.QuoteMeta(queryUpdateOrderStatus)).
+		WithArgs(models.InTransit.String(), orderID, warehouseID, models.Placed.String()).
+		WillReturnRows(sqlmock.NewRows([]string{"count", "exists"}).AddRow(0, false))
+
+	repo := order2.NewOrderRepository(db)
+	err = repo.UpdateStatus(context.Background(), orderID, warehouseID, models.InTransit)
+
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateStatus_RejectedTransition(t *testing.T) {
+	orderID := uuid.New()
+	warehouseID := uuid.New()
+
+	// Отменённый заказ нельзя отправить, а доставленный или отменённый — отметить доставленным
+	for _, status := range []models.OrderStatus{models.InTransit, models.Delivered} {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+
+		previous, _ := status.WarehousePrevious()
+		mock.ExpectQuery(regexp.QuoteMeta(queryUpdateOrderStatus)).
+			WithArgs(status.String(), orderID, warehouseID, previous.String()).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "exists"}).AddRow(0, true))
+
+		repo := order2.NewOrderRepository(db)
+		err = repo.UpdateStatus(context.Background(), orderID, warehouseID, status)
+
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic, status.String())
+		assert.NoError(t, mock.ExpectationsWereMet())
+		db.Close()
+	}
+}
+
+func TestUpdateStatus_UnsupportedStatus(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := order2.NewOrderRepository(db)
+	err = repo.UpdateStatus(context.Background(), uuid.New(), uuid.New(), models.Placed)
+
+	assert.ErrorIs(t, err, errs.ErrBusinessLogic)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -844,14 +1197,15 @@ func TestUpdateStatus_Error(t *testing.T) {
 	defer db.Close()
 
 	orderID := uuid.New()
-	status := models.Placed
+	warehouseID := uuid.New()
+	status := models.InTransit
 
-	mock.ExpectExec(regexp.QuoteMeta(queryUpdateOrderStatus)).
-		WithArgs(status.String(), orderID).
+	mock.ExpectQuery(regexp.QuoteMeta(queryUpdateOrderStatus)).
+		WithArgs(status.String(), orderID, warehouseID, models.Placed.String()).
 		WillReturnError(errors.New("database error"))
 
 	repo := order2.NewOrderRepository(db)
-	err = repo.UpdateStatus(context.Background(), orderID, status)
+	err = repo.UpdateStatus(context.Background(), orderID, warehouseID, status)
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "database error")
@@ -873,11 +1227,13 @@ func TestGetOrdersPlaced_Success(t *testing.T) {
 		uuid.New(), time.Now(), time.Now(), time.Now(),
 	)
 
+	warehouseID := uuid.New()
 	mock.ExpectQuery(regexp.QuoteMeta(queryGetOrders)).
+		WithArgs(warehouseID).
 		WillReturnRows(rows)
 
 	ctx := context.Background()
-	orders, err := repo.GetOrdersPlaced(ctx)
+	orders, err := repo.GetOrdersPlaced(ctx, warehouseID)
 
 	require.NoError(t, err)
 	require.Len(t, *orders, 1)
@@ -900,11 +1256,13 @@ func TestGetOrdersPlaced_ScanError(t *testing.T) {
 		uuid.New(), time.Now(), time.Now(), time.Now(),
 	)
 
+	warehouseID := uuid.New()
 	mock.ExpectQuery(regexp.QuoteMeta(queryGetOrders)).
+		WithArgs(warehouseID).
 		WillReturnRows(rows)
 
 	ctx := context.Background()
-	orders, err := repo.GetOrdersPlaced(ctx)
+	orders, err := repo.GetOrdersPlaced(ctx, warehouseID)
 
 	require.Error(t, err)
 	assert.Nil(t, orders)
@@ -926,13 +1284,339 @@ func TestGetOrdersPlaced_InvalidStatus(t *testing.T) {
 		uuid.New(), time.Now(), time.Now(), time.Now(),
 	)
 
+	warehouseID := uuid.New()
 	mock.ExpectQuery(regexp.QuoteMeta(queryGetOrders)).
+		WithArgs(warehouseID).
 		WillReturnRows(rows)
 
 	ctx := context.Background()
-	orders, err := repo.GetOrdersPlaced(ctx)
+	orders, err := repo.GetOrdersPlaced(ctx, warehouseID)
 
 	require.Error(t, err)
 	assert.Nil(t, orders)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+var shipmentColumns = []string{
+	"id", "order_id", "seller_id", "seller_name", "address_id", "status", "total_price", "total_price_discount",
+	"tracking_number", "expected_delivery_at", "created_at",
+	"product_id", "variant_id", "options", "name", "preview_image_url", "base_price", "price", "quantity",
+}
+
+func TestGetOrderShipments_GroupsItems(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	orderID := uuid.New()
+	addressID := uuid.New()
+	firstID, secondID := uuid.New(), uuid.New()
+	firstSeller, secondSeller := uuid.New(), uuid.New()
+	now := time.Now()
+
+	rows := sqlmock.NewRows(shipmentColumns).
+		AddRow(firstID, orderID, firstSeller, "Shop 1", addressID, "awaiting_confirmation", "300.00", "250.00",
+			nil, nil, now, uuid.New(), nil, nil, "Product 1", "img1.jpg", "100.00", "100.00", 1).
+		AddRow(firstID, orderID, firstSeller, "Shop 1", addressID, "awaiting_confirmation", "300.00", "250.00",
+			nil, nil, now, uuid.New(), nil, nil, "Product 2", nil, "100.00", "75.00", 2).
+		AddRow(secondID, orderID, secondSeller, "Shop 2", addressID, "being_prepared", "50.00", "50.00",
+			"TRACK-1", now, now, uuid.New(), nil, nil, "Product 3", "img3.jpg", "50.00", "50.00", 1)
+
+	mock.ExpectQuery("FROM bazaar.order_shipment s").
+		WithArgs(orderID).
+		WillReturnRows(rows)
+
+	repo := order2.NewOrderRepository(db)
+	shipments, err := repo.GetOrderShipments(context.Background(), orderID)
+
+	require.NoError(t, err)
+	require.Len(t, shipments, 2)
+	assert.Equal(t, firstID, shipments[0].ID)
+	assert.Equal(t, models.AwaitingConfirmation, shipments[0].Status)
+	assert.Equal(t, "Shop 1", shipments[0].SellerName)
+	require.Len(t, shipments[0].Items, 2)
+	assert.Equal(t, models.Rubles(100), shipments[0].Items[1].BasePrice)
+	assert.Equal(t, models.Rubles(75), shipments[0].Items[1].Price)
+	assert.False(t, shipments[0].TrackingNumber.Valid)
+	assert.Equal(t, secondID, shipments[1].ID)
+	assert.Equal(t, models.BeingPrepared, shipments[1].Status)
+	assert.Equal(t, "TRACK-1", shipments[1].TrackingNumber.String)
+	require.Len(t, shipments[1].Items, 1)
+	assert.Equal(t, "Product 3", shipments[1].Items[0].ProductName)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetSellerShipments(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	sellerID := uuid.New()
+	shipmentID := uuid.New()
+	now := time.Now()
+
+	mock.ExpectQuery("WITH page AS").
+		WithArgs(sellerID, 20).
+		WillReturnRows(sqlmock.NewRows(shipmentColumns).
+			AddRow(shipmentID, uuid.New(), sellerID, "Shop", uuid.New(), "awaiting_confirmation", "100.00", "100.00",
+				nil, nil, now, uuid.New(), nil, nil, "Product", nil, "100.00", "100.00", 1))
+
+	repo := order2.NewOrderRepository(db)
+	shipments, err := repo.GetSellerShipments(context.Background(), sellerID, 20)
+
+	require.NoError(t, err)
+	require.Len(t, shipments, 1)
+	assert.Equal(t, sellerID, shipments[0].SellerID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetSellerShipments_QueryError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery("WITH page AS").WillReturnError(errors.New("db error"))
+
+	repo := order2.NewOrderRepository(db)
+	_, err = repo.GetSellerShipments(context.Background(), uuid.New(), 0)
+
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetOrderDetail(t *testing.T) {
+	orderID := uuid.New()
+	userID := uuid.New()
+	addressID := uuid.New()
+	detailColumns := []string{
+		"id", "user_id", "address_id", "pickup_point_id", "status", "total_price", "total_price_discount", "delivery_cost",
+		"code", "discount", "expected_delivery_at", "actual_delivery_at", "created_at",
+		"display_currency", "display_rate",
+	}
+
+	t.Run("success with promo", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		now := time.Now()
+		mock.ExpectQuery("LEFT JOIN bazaar.promo_redemption pr").
+			WithArgs(orderID).
+			WillReturnRows(sqlmock.NewRows(detailColumns).
+				AddRow(orderID, userID, addressID, nil, "placed", "300.00", "240.00", "99.00",
+					"SALE10", "25.00", nil, nil, now, "USD", "92.5"))
+		mock.ExpectQuery("FROM bazaar.order_shipment s").
+			WithArgs(orderID).
+			WillReturnRows(sqlmock.NewRows(shipmentColumns).
+				AddRow(uuid.New(), orderID, uuid.New(), "Shop", addressID, "awaiting_confirmation", "300.00", "265.00",
+					nil, nil, now, uuid.New(), nil, nil, "Product", nil, "150.00", "132.50", 2))
+
+		repo := order2.NewOrderRepository(db)
+		detail, err := repo.GetOrderDetail(context.Background(), orderID)
+
+		require.NoError(t, err)
+		assert.Equal(t, userID, detail.UserID)
+		assert.Equal(t, models.Placed, detail.Status)
+		assert.Equal(t, "SALE10", detail.PromoCode.String)
+		assert.Equal(t, models.Rubles(25), detail.PromoDiscount)
+		assert.Equal(t, models.Currency("USD"), detail.DisplayRate.Currency)
+		assert.Equal(t, "92.5", detail.DisplayRate.Rate.String())
+		assert.Equal(t, models.Rubles(99), detail.DeliveryCost)
+		require.Len(t, detail.Shipments, 1)
+		assert.Equal(t, models.Rubles(150), detail.Shipments[0].Items[0].BasePrice)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("LEFT JOIN bazaar.promo_redemption pr").
+			WithArgs(orderID).
+			WillReturnError(sql.ErrNoRows)
+
+		repo := order2.NewOrderRepository(db)
+		_, err = repo.GetOrderDetail(context.Background(), orderID)
+
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestConfirmShipment(t *testing.T) {
+	shipmentID := uuid.New()
+	sellerID := uuid.New()
+	userID := uuid.New()
+	tracking := "TRACK-1"
+
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("FOR UPDATE OF o, s").
+			WithArgs(shipmentID, sellerID).
+			WillReturnRows(sqlmock.NewRows([]string{"status", "order_status", "id", "user_id"}).
+				AddRow("awaiting_confirmation", "placed", uuid.New(), userID))
+		mock.ExpectExec("SET status = 'being_prepared'").
+			WithArgs(shipmentID, &tracking, nil).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		repo := order2.NewOrderRepository(db)
+		buyerID, err := repo.ConfirmShipment(context.Background(), dto.ConfirmShipmentRepoReq{
+			ShipmentID:     shipmentID,
+			SellerID:       sellerID,
+			TrackingNumber: &tracking,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, userID, buyerID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("already confirmed", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("FOR UPDATE OF o, s").
+			WithArgs(shipmentID, sellerID).
+			WillReturnRows(sqlmock.NewRows([]string{"status", "order_status", "id", "user_id"}).
+				AddRow("being_prepared", "placed", uuid.New(), userID))
+		mock.ExpectRollback()
+
+		repo := order2.NewOrderRepository(db)
+		_, err = repo.ConfirmShipment(context.Background(), dto.ConfirmShipmentRepoReq{
+			ShipmentID: shipmentID,
+			SellerID:   sellerID,
+		})
+
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("order already dispatched", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("FOR UPDATE OF o, s").
+			WithArgs(shipmentID, sellerID).
+			WillReturnRows(sqlmock.NewRows([]string{"status", "order_status", "id", "user_id"}).
+				AddRow("awaiting_confirmation", "in_transit", uuid.New(), userID))
+		mock.ExpectRollback()
+
+		repo := order2.NewOrderRepository(db)
+		_, err = repo.ConfirmShipment(context.Background(), dto.ConfirmShipmentRepoReq{
+			ShipmentID: shipmentID,
+			SellerID:   sellerID,
+		})
+
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found for seller", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("FOR UPDATE OF o, s").
+			WithArgs(shipmentID, sellerID).
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		repo := order2.NewOrderRepository(db)
+		_, err = repo.ConfirmShipment(context.Background(), dto.ConfirmShipmentRepoReq{
+			ShipmentID: shipmentID,
+			SellerID:   sellerID,
+		})
+
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCancelShipment(t *testing.T) {
+	shipmentID := uuid.New()
+	sellerID := uuid.New()
+	orderID := uuid.New()
+	userID := uuid.New()
+
+	t.Run("success returns stock", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("FOR UPDATE OF o, s").
+			WithArgs(shipmentID, sellerID).
+			WillReturnRows(sqlmock.NewRows([]string{"status", "order_status", "id", "user_id"}).
+				AddRow("being_prepared", "placed", orderID, userID))
+		mock.ExpectExec("SET status = 'canceled_by_seller'").
+			WithArgs(shipmentID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		expectStockContext(mock, models.StockCancelRestore, sellerID.String(), orderID.String(), "shipment canceled by seller")
+		mock.ExpectExec("SET quantity = p.quantity \\+ i.quantity").
+			WithArgs(shipmentID).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec("UPDATE bazaar.warehouse_stock ws SET quantity = ws.quantity \\+ i.quantity").
+			WithArgs(shipmentID).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec("NOT EXISTS").
+			WithArgs(orderID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		repo := order2.NewOrderRepository(db)
+		buyerID, err := repo.CancelShipment(context.Background(), shipmentID, sellerID)
+
+		require.NoError(t, err)
+		assert.Equal(t, userID, buyerID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("already shipped", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("FOR UPDATE OF o, s").
+			WithArgs(shipmentID, sellerID).
+			WillReturnRows(sqlmock.NewRows([]string{"status", "order_status", "id", "user_id"}).
+				AddRow("shipped", "placed", orderID, userID))
+		mock.ExpectRollback()
+
+		repo := order2.NewOrderRepository(db)
+		_, err = repo.CancelShipment(context.Background(), shipmentID, sellerID)
+
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("order already dispatched", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("FOR UPDATE OF o, s").
+			WithArgs(shipmentID, sellerID).
+			WillReturnRows(sqlmock.NewRows([]string{"status", "order_status", "id", "user_id"}).
+				AddRow("being_prepared", "shipped", orderID, userID))
+		mock.ExpectRollback()
+
+		repo := order2.NewOrderRepository(db)
+		_, err = repo.CancelShipment(context.Background(), shipmentID, sellerID)
+
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
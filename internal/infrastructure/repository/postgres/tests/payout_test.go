@@ -0,0 +1,234 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/payout"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayoutRepository_SetCategoryCommission(t *testing.T) {
+	categoryID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec("UPDATE bazaar.category SET commission_bps = \\$2 WHERE id = \\$1").
+			WithArgs(categoryID, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		repo := payout.NewPayoutRepository(db)
+		err = repo.SetCategoryCommission(context.Background(), categoryID, sql.NullInt32{Int32: 1500, Valid: true})
+
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec("UPDATE bazaar.category SET commission_bps").
+			WithArgs(categoryID, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		repo := payout.NewPayoutRepository(db)
+		err = repo.SetCategoryCommission(context.Background(), categoryID, sql.NullInt32{})
+
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPayoutRepository_RecordEarnings(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	orderID := uuid.NullUUID{UUID: uuid.New(), Valid: true}
+
+	mock.ExpectExec("INSERT INTO bazaar.seller_earning").
+		WithArgs(orderID, 1000, 14*24*3600).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	repo := payout.NewPayoutRepository(db)
+	recorded, err := repo.RecordEarnings(context.Background(), orderID, 1000, 14*24*time.Hour)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), recorded)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPayoutRepository_Settle(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT pg_try_advisory_xact_lock\\(\\$1\\)").
+			WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_xact_lock"}).AddRow(true))
+		mock.ExpectExec("INSERT INTO bazaar.seller_earning").
+			WithArgs(uuid.NullUUID{}, 1000, 14*24*3600).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec("SET status = 'reversed'").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("SET status = 'available'").
+			WillReturnResult(sqlmock.NewResult(0, 4))
+		mock.ExpectQuery("INSERT INTO bazaar.payout_statement").
+			WithArgs(7 * 24 * 3600).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectCommit()
+
+		repo := payout.NewPayoutRepository(db)
+		result, settled, err := repo.Settle(context.Background(), 1000, 14*24*time.Hour, 7*24*time.Hour)
+
+		require.NoError(t, err)
+		assert.True(t, settled)
+		assert.Equal(t, models.SettlementResult{Recorded: 2, Reversed: 1, Released: 4, Statements: 1}, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("order return reverses items with a shipment", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		// Статус отправления не должен заслонять возврат, оформленный на весь заказ
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT pg_try_advisory_xact_lock\\(\\$1\\)").
+			WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_xact_lock"}).AddRow(true))
+		mock.ExpectExec("INSERT INTO bazaar.seller_earning").
+			WithArgs(uuid.NullUUID{}, 1000, 14*24*3600).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("LEFT JOIN bazaar.order_shipment s ON s.id = oi.shipment_id .+ " +
+			"AND \\(s.status IN \\('return_requested', .+\\) OR o.status IN \\('return_requested', .+\\)\\)").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("SET status = 'available'").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("INSERT INTO bazaar.payout_statement").
+			WithArgs(7 * 24 * 3600).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectCommit()
+
+		repo := payout.NewPayoutRepository(db)
+		result, settled, err := repo.Settle(context.Background(), 1000, 14*24*time.Hour, 7*24*time.Hour)
+
+		require.NoError(t, err)
+		assert.True(t, settled)
+		assert.Equal(t, int64(1), result.Reversed)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("locked by another instance", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT pg_try_advisory_xact_lock\\(\\$1\\)").
+			WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_xact_lock"}).AddRow(false))
+		mock.ExpectRollback()
+
+		repo := payout.NewPayoutRepository(db)
+		_, settled, err := repo.Settle(context.Background(), 1000, 14*24*time.Hour, 7*24*time.Hour)
+
+		require.NoError(t, err)
+		assert.False(t, settled)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPayoutRepository_GetBalance(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	sellerID := uuid.New()
+
+	mock.ExpectQuery("FROM bazaar.seller_earning WHERE seller_id = \\$1 AND status = 'held'").
+		WithArgs(sellerID).
+		WillReturnRows(sqlmock.NewRows([]string{"held", "available", "pending", "paid"}).
+			AddRow("1350.00", "0", "900.10", "12345.67"))
+
+	repo := payout.NewPayoutRepository(db)
+	balance, err := repo.GetBalance(context.Background(), sellerID)
+
+	require.NoError(t, err)
+	assert.Equal(t, models.SellerBalance{Held: models.Rubles(1350), Pending: models.Kopecks(90010), PaidOut: models.Kopecks(1234567)}, balance)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPayoutRepository_GetStatement(t *testing.T) {
+	statementID := uuid.New()
+	columns := []string{"id", "seller_id", "period_start", "period_end", "gross", "commission", "net",
+		"status", "paid_at", "created_at"}
+
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		sellerID := uuid.New()
+		now := time.Now()
+		mock.ExpectQuery("FROM bazaar.payout_statement WHERE id = \\$1").
+			WithArgs(statementID).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(statementID, sellerID, now.AddDate(0, 0, -7), now, "1000.00", "100.00", "900.00", "pending", nil, now))
+
+		repo := payout.NewPayoutRepository(db)
+		statement, err := repo.GetStatement(context.Background(), statementID)
+
+		require.NoError(t, err)
+		assert.Equal(t, sellerID, statement.SellerID)
+		assert.Equal(t, models.Rubles(900), statement.Net)
+		assert.Equal(t, models.PayoutPending, statement.Status)
+		assert.False(t, statement.PaidAt.Valid)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("FROM bazaar.payout_statement WHERE id = \\$1").
+			WithArgs(statementID).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		repo := payout.NewPayoutRepository(db)
+		_, err = repo.GetStatement(context.Background(), statementID)
+
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPayoutRepository_MarkStatementPaid(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	statementID := uuid.New()
+
+	mock.ExpectExec("SET status = 'paid', paid_at = now\\(\\)").
+		WithArgs(statementID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	repo := payout.NewPayoutRepository(db)
+	err = repo.MarkStatementPaid(context.Background(), statementID)
+
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
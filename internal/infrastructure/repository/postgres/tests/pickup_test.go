@@ -0,0 +1,206 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/pickup"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var pickupPointColumns = []string{
+	"id", "name", "opens_at", "closes_at", "address_id", "region", "city", "address_string", "coordinate",
+}
+
+func TestPickupRepository_GetPickupPoints(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		pointID, addressID := uuid.New(), uuid.New()
+		mock.ExpectQuery("FROM bazaar.pickup_point pp\\s+JOIN bazaar.address a").
+			WillReturnRows(sqlmock.NewRows(pickupPointColumns).
+				AddRow(pointID, "ПВЗ на Ленина", "09:00", "21:00", addressID, nil, "Москва", "ул. Ленина, д. 1", "55.7558,37.6176"))
+
+		points, err := pickup.NewPickupRepository(db).GetPickupPoints(context.Background())
+		require.NoError(t, err)
+		require.Len(t, points, 1)
+		assert.Equal(t, pointID, points[0].ID)
+		assert.Equal(t, "09:00", points[0].OpensAt)
+		assert.Equal(t, addressID, points[0].Address.ID)
+		assert.Equal(t, null.StringFrom("55.7558,37.6176"), points[0].Address.Coordinate)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("FROM bazaar.pickup_point").WillReturnError(errors.New("db error"))
+
+		_, err = pickup.NewPickupRepository(db).GetPickupPoints(context.Background())
+		assert.Error(t, err)
+	})
+}
+
+func TestPickupRepository_GetPickupPoint(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	pointID := uuid.New()
+	mock.ExpectQuery("WHERE pp.id = \\$1").
+		WithArgs(pointID).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = pickup.NewPickupRepository(db).GetPickupPoint(context.Background(), pointID)
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPickupRepository_CreatePickupPoint(t *testing.T) {
+	point := models.PickupPoint{
+		ID:       uuid.New(),
+		Name:     "ПВЗ на Ленина",
+		OpensAt:  "09:00",
+		ClosesAt: "21:00",
+		Address: models.AddressDB{
+			ID:            uuid.New(),
+			City:          null.StringFrom("Москва"),
+			AddressString: null.StringFrom("ул. Ленина, д. 1"),
+			Coordinate:    null.StringFrom("55.7558,37.6176"),
+		},
+	}
+
+	t.Run("reuses existing address", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		existingAddressID := uuid.New()
+		mock.ExpectBegin()
+		mock.ExpectQuery("ON CONFLICT \\(address_string, coordinate\\)").
+			WithArgs(point.Address.ID, point.Address.Region, point.Address.City, point.Address.AddressString, point.Address.Coordinate).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(existingAddressID))
+		mock.ExpectExec("INSERT INTO bazaar.pickup_point").
+			WithArgs(point.ID, existingAddressID, point.Name, "09:00", "21:00").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		created, err := pickup.NewPickupRepository(db).CreatePickupPoint(context.Background(), point)
+		require.NoError(t, err)
+		assert.Equal(t, existingAddressID, created.Address.ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("insert error rolls back", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("INSERT INTO bazaar.address").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(point.Address.ID))
+		mock.ExpectExec("INSERT INTO bazaar.pickup_point").
+			WillReturnError(errors.New("db error"))
+		mock.ExpectRollback()
+
+		_, err = pickup.NewPickupRepository(db).CreatePickupPoint(context.Background(), point)
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPickupRepository_UpdateAndDelete(t *testing.T) {
+	pointID := uuid.New()
+
+	t.Run("update missing point", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec("UPDATE bazaar.pickup_point").
+			WithArgs(pointID, "ПВЗ", "10:00", "20:00").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err = pickup.NewPickupRepository(db).UpdatePickupPoint(context.Background(), models.PickupPoint{
+			ID: pointID, Name: "ПВЗ", OpensAt: "10:00", ClosesAt: "20:00",
+		})
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec("DELETE FROM bazaar.pickup_point WHERE id = \\$1").
+			WithArgs(pointID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err = pickup.NewPickupRepository(db).DeletePickupPoint(context.Background(), pointID)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestPickupRepository_Favorites(t *testing.T) {
+	userID, pointID := uuid.New(), uuid.New()
+
+	t.Run("add unknown point", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec("INSERT INTO bazaar.user_pickup_point").
+			WithArgs(sqlmock.AnyArg(), userID, pointID).
+			WillReturnError(&pq.Error{Code: "23503"})
+
+		err = pickup.NewPickupRepository(db).AddFavoritePickupPoint(context.Background(), userID, pointID)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("get favorites", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery("JOIN bazaar.user_pickup_point upp").
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows(pickupPointColumns).
+				AddRow(pointID, "ПВЗ", "09:00", "21:00", uuid.New(), nil, nil, "ул. Ленина, д. 1", "55.7558,37.6176"))
+
+		points, err := pickup.NewPickupRepository(db).GetFavoritePickupPoints(context.Background(), userID)
+		require.NoError(t, err)
+		require.Len(t, points, 1)
+		assert.Equal(t, pointID, points[0].ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("remove missing favorite", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectExec("DELETE FROM bazaar.user_pickup_point").
+			WithArgs(userID, pointID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err = pickup.NewPickupRepository(db).RemoveFavoritePickupPoint(context.Background(), userID, pointID)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
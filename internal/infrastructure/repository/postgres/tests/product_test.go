@@ -39,12 +39,12 @@ func TestProductRepository_GetAllProducts(t *testing.T) {
 				PreviewImageURL: "image1.jpg",
 				Description:     "Description 1",
 				Status:          models.ProductApproved,
-				Price:           1000,
+				Price:           models.Rubles(1000),
 				Quantity:        10,
 				UpdatedAt:       now,
 				Rating:          4,
 				ReviewsCount:    20,
-				PriceDiscount:   800,
+				PriceDiscount:   models.Rubles(800),
 			},
 			{
 				ID:              product2ID,
@@ -53,12 +53,12 @@ func TestProductRepository_GetAllProducts(t *testing.T) {
 				PreviewImageURL: "image2.jpg",
 				Description:     "Description 2",
 				Status:          models.ProductApproved,
-				Price:           2000,
+				Price:           models.Rubles(2000),
 				Quantity:        5,
 				UpdatedAt:       now,
 				Rating:          4,
 				ReviewsCount:    15,
-				PriceDiscount:   0,
+				PriceDiscount:   models.Money{},
 			},
 		}
 
@@ -153,12 +153,12 @@ func TestProductRepository_GetProductByID(t *testing.T) {
 			PreviewImageURL: "test.jpg",
 			Description:     "Test Description",
 			Status:          models.ProductApproved,
-			Price:           1500,
+			Price:           models.Rubles(1500),
 			Quantity:        8,
 			UpdatedAt:       now,
 			Rating:          4,
 			ReviewsCount:    10,
-			PriceDiscount:   1200,
+			PriceDiscount:   models.Rubles(1200),
 			Seller: &models.Seller{
 				ID:          sellerID,
 				Title:       "Test Seller",
@@ -194,7 +194,7 @@ func TestProductRepository_GetProductByID(t *testing.T) {
 				p.status, p.price, p.quantity, p.updated_at, p.rating, p.reviews_count,
 				d.discounted_price, s.id, s.title, s.description
 			FROM bazaar.product p
-			LEFT JOIN bazaar.discount d ON p.id = d.product_id
+			LEFT JOIN bazaar.discount d ON p.id = d.product_id AND d.variant_id IS NULL
 			LEFT JOIN bazaar.seller s ON s.user_id = p.seller_id
 			WHERE p.id = \$1
 		`).
@@ -214,7 +214,7 @@ func TestProductRepository_GetProductByID(t *testing.T) {
 				p.status, p.price, p.quantity, p.updated_at, p.rating, p.reviews_count,
 				d.discounted_price, s.id, s.title, s.description
 			FROM bazaar.product p
-			LEFT JOIN bazaar.discount d ON p.id = d.product_id
+			LEFT JOIN bazaar.discount d ON p.id = d.product_id AND d.variant_id IS NULL
 			LEFT JOIN bazaar.seller s ON s.user_id = p.seller_id
 			WHERE p.id = \$1
 		`).
@@ -235,7 +235,7 @@ func TestProductRepository_GetProductByID(t *testing.T) {
 				p.status, p.price, p.quantity, p.updated_at, p.rating, p.reviews_count,
 				d.discounted_price, s.id, s.title, s.description
 			FROM bazaar.product p
-			LEFT JOIN bazaar.discount d ON p.id = d.product_id
+			LEFT JOIN bazaar.discount d ON p.id = d.product_id AND d.variant_id IS NULL
 			LEFT JOIN bazaar.seller s ON s.user_id = p.seller_id
 			WHERE p.id = \$1
 		`).
@@ -264,8 +264,8 @@ func TestProductRepository_GetProductsByCategory(t *testing.T) {
 		sellerID := uuid.New()
 		now := time.Now()
 		offset := 0
-		minPrice := 1000.0
-		maxPrice := 2000.0
+		minPrice := models.Rubles(1000)
+		maxPrice := models.Rubles(2000)
 		minRating := float32(4.0)
 
 		expectedProducts := []*models.Product{
@@ -276,7 +276,7 @@ func TestProductRepository_GetProductsByCategory(t *testing.T) {
 				PreviewImageURL: "image1.jpg",
 				Description:     "Description 1",
 				Status:          models.ProductApproved,
-				Price:           1000,
+				Price:           models.Rubles(1000),
 				Quantity:        10,
 				UpdatedAt:       now,
 				Rating:          4,
@@ -289,7 +289,7 @@ func TestProductRepository_GetProductsByCategory(t *testing.T) {
 				PreviewImageURL: "image2.jpg",
 				Description:     "Description 2",
 				Status:          models.ProductApproved,
-				Price:           2000,
+				Price:           models.Rubles(2000),
 				Quantity:        5,
 				UpdatedAt:       now,
 				Rating:          4,
@@ -329,6 +329,14 @@ func TestProductRepository_GetProductsByCategory(t *testing.T) {
 			)
 
 		mock.ExpectQuery(`
+			WITH RECURSIVE categories AS \(
+				SELECT id FROM bazaar.category WHERE id = \$1
+				UNION ALL
+				SELECT c.id
+				FROM bazaar.category c
+				JOIN categories ON c.parent_id = categories.id
+				WHERE \$6
+			\)
 			SELECT 
 				p.id, 
 				p.seller_id, 
@@ -343,23 +351,26 @@ func TestProductRepository_GetProductsByCategory(t *testing.T) {
 				p.reviews_count
 			FROM 
 				bazaar.product p
-			JOIN 
-				bazaar.product_subcategory pc ON p.id = pc.product_id
 			WHERE 
-				pc.subcategory_id = \$1 
+				EXISTS \(
+					SELECT 1
+					FROM bazaar.product_subcategory pc
+					WHERE pc.product_id = p.id AND pc.subcategory_id IN \(SELECT id FROM categories\)
+				\)
 				AND p.status = 'approved'
-				AND \(\$3 = 0 OR p.price > \$3\)
-				AND \(\$4 = 0 OR p.price < \$4\)
+				AND \(\$3::numeric = 0 OR p.price > \$3\)
+				AND \(\$4::numeric = 0 OR p.price < \$4\)
 				AND \(\$5 = 0::FLOAT OR p.rating > \$5::FLOAT\)
 			ORDER BY p.price ASC
 			LIMIT 20 OFFSET \$2
 		`).
-			WithArgs(categoryID, offset, minPrice, maxPrice, minRating).
+			WithArgs(categoryID, offset, minPrice, maxPrice, minRating, false).
 			WillReturnRows(rows)
 
 		products, err := repo.GetProductsByCategory(
 			context.Background(),
 			categoryID,
+			false,
 			offset,
 			minPrice,
 			maxPrice,
@@ -374,8 +385,8 @@ func TestProductRepository_GetProductsByCategory(t *testing.T) {
 	t.Run("empty result", func(t *testing.T) {
 		categoryID := uuid.New()
 		offset := 0
-		minPrice := 0.0
-		maxPrice := 0.0
+		minPrice := models.Money{}
+		maxPrice := models.Money{}
 		minRating := float32(0.0)
 
 		rows := sqlmock.NewRows([]string{
@@ -384,6 +395,14 @@ func TestProductRepository_GetProductsByCategory(t *testing.T) {
 		})
 
 		mock.ExpectQuery(`
+			WITH RECURSIVE categories AS \(
+				SELECT id FROM bazaar.category WHERE id = \$1
+				UNION ALL
+				SELECT c.id
+				FROM bazaar.category c
+				JOIN categories ON c.parent_id = categories.id
+				WHERE \$6
+			\)
 			SELECT 
 				p.id, 
 				p.seller_id, 
@@ -398,23 +417,26 @@ func TestProductRepository_GetProductsByCategory(t *testing.T) {
 				p.reviews_count
 			FROM 
 				bazaar.product p
-			JOIN 
-				bazaar.product_subcategory pc ON p.id = pc.product_id
 			WHERE 
-				pc.subcategory_id = \$1 
+				EXISTS \(
+					SELECT 1
+					FROM bazaar.product_subcategory pc
+					WHERE pc.product_id = p.id AND pc.subcategory_id IN \(SELECT id FROM categories\)
+				\)
 				AND p.status = 'approved'
-				AND \(\$3 = 0 OR p.price > \$3\)
-				AND \(\$4 = 0 OR p.price < \$4\)
+				AND \(\$3::numeric = 0 OR p.price > \$3\)
+				AND \(\$4::numeric = 0 OR p.price < \$4\)
 				AND \(\$5 = 0::FLOAT OR p.rating > \$5::FLOAT\)
 			ORDER BY p.updated_at DESC
 			LIMIT 20 OFFSET \$2
 		`).
-			WithArgs(categoryID, offset, minPrice, maxPrice, minRating).
+			WithArgs(categoryID, offset, minPrice, maxPrice, minRating, false).
 			WillReturnRows(rows)
 
 		products, err := repo.GetProductsByCategory(
 			context.Background(),
 			categoryID,
+			false,
 			offset,
 			minPrice,
 			maxPrice,
@@ -428,11 +450,19 @@ func TestProductRepository_GetProductsByCategory(t *testing.T) {
 	t.Run("database error", func(t *testing.T) {
 		categoryID := uuid.New()
 		offset := 0
-		minPrice := 0.0
-		maxPrice := 0.0
+		minPrice := models.Money{}
+		maxPrice := models.Money{}
 		minRating := float32(0.0)
 
 		mock.ExpectQuery(`
+			WITH RECURSIVE categories AS \(
+				SELECT id FROM bazaar.category WHERE id = \$1
+				UNION ALL
+				SELECT c.id
+				FROM bazaar.category c
+				JOIN categories ON c.parent_id = categories.id
+				WHERE \$6
+			\)
 			SELECT 
 				p.id, 
 				p.seller_id, 
@@ -447,23 +477,26 @@ func TestProductRepository_GetProductsByCategory(t *testing.T) {
 				p.reviews_count
 			FROM 
 				bazaar.product p
-			JOIN 
-				bazaar.product_subcategory pc ON p.id = pc.product_id
 			WHERE 
-				pc.subcategory_id = \$1 
+				EXISTS \(
+					SELECT 1
+					FROM bazaar.product_subcategory pc
+					WHERE pc.product_id = p.id AND pc.subcategory_id IN \(SELECT id FROM categories\)
+				\)
 				AND p.status = 'approved'
-				AND \(\$3 = 0 OR p.price > \$3\)
-				AND \(\$4 = 0 OR p.price < \$4\)
+				AND \(\$3::numeric = 0 OR p.price > \$3\)
+				AND \(\$4::numeric = 0 OR p.price < \$4\)
 				AND \(\$5 = 0::FLOAT OR p.rating > \$5::FLOAT\)
 			ORDER BY p.updated_at DESC
 			LIMIT 20 OFFSET \$2
 		`).
-			WithArgs(categoryID, offset, minPrice, maxPrice, minRating).
+			WithArgs(categoryID, offset, minPrice, maxPrice, minRating, false).
 			WillReturnError(errors.New("database error"))
 
 		products, err := repo.GetProductsByCategory(
 			context.Background(),
 			categoryID,
+			false,
 			offset,
 			minPrice,
 			maxPrice,
@@ -490,8 +523,8 @@ func TestProductRepository_AddProduct(t *testing.T) {
 			Name:            "New Product",
 			PreviewImageURL: "new.jpg",
 			Description:     "New Description",
-			Price:           2000,
-			PriceDiscount:   1800,
+			Price:           models.Rubles(2000),
+			PriceDiscount:   models.Rubles(1800),
 			Quantity:        10,
 			Rating:          0,
 			ReviewsCount:    0,
@@ -549,8 +582,8 @@ func TestProductRepository_AddProduct(t *testing.T) {
 			Name:            "New Product",
 			PreviewImageURL: "new.jpg",
 			Description:     "New Description",
-			Price:           2000,
-			PriceDiscount:   1800,
+			Price:           models.Rubles(2000),
+			PriceDiscount:   models.Rubles(1800),
 			Quantity:        10,
 			Rating:          0,
 			ReviewsCount:    0,
@@ -570,8 +603,8 @@ func TestProductRepository_AddProduct(t *testing.T) {
 			Name:            "New Product",
 			PreviewImageURL: "new.jpg",
 			Description:     "New Description",
-			Price:           2000,
-			PriceDiscount:   1800,
+			Price:           models.Rubles(2000),
+			PriceDiscount:   models.Rubles(1800),
 			Quantity:        10,
 			Rating:          0,
 			ReviewsCount:    0,
@@ -605,3 +638,48 @@ func TestProductRepository_AddProduct(t *testing.T) {
 		assert.Nil(t, result)
 	})
 }
+
+func TestProductRepository_GetProductVariants(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := productRepo.NewProductRepository(db)
+	productID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		now := time.Now()
+		rows := sqlmock.NewRows([]string{
+			"id", "product_id", "sku", "options", "price", "discounted_price",
+			"quantity", "images", "position", "updated_at",
+		}).
+			AddRow(uuid.New(), productID, "TS-M-WHITE", []byte(`{"Размер": "M", "Цвет": "Белый"}`), "1000.00", "800.00",
+				3, "{white.png,white-back.png}", 0, now).
+			AddRow(uuid.New(), productID, "TS-L-WHITE", []byte(`{"Размер": "L", "Цвет": "Белый"}`), "700.00", "800.00",
+				0, "{}", 1, now)
+
+		mock.ExpectQuery(`FROM bazaar.product_variant v .* WHERE v.product_id = \$1`).
+			WithArgs(productID).
+			WillReturnRows(rows)
+
+		variants, err := repo.GetProductVariants(context.Background(), productID)
+		require.NoError(t, err)
+		require.Len(t, variants, 2)
+		assert.Equal(t, "M", variants[0].Options["Размер"])
+		assert.Equal(t, []string{"white.png", "white-back.png"}, variants[0].Images)
+		assert.Equal(t, models.Rubles(800), variants[0].PriceDiscount)
+		// Скидка товара не поднимает цену варианта, который и так дешевле
+		assert.Equal(t, models.Money{}, variants[1].PriceDiscount)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		mock.ExpectQuery(`FROM bazaar.product_variant v`).
+			WithArgs(productID).
+			WillReturnError(errors.New("database error"))
+
+		_, err := repo.GetProductVariants(context.Background(), productID)
+		require.Error(t, err)
+	})
+}
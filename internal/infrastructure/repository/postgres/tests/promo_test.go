@@ -2,6 +2,8 @@ package tests
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
 	"errors"
 	"testing"
 	"time"
@@ -9,10 +11,28 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/promo"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/google/uuid"
+	"github.com/guregu/null"
 	"github.com/stretchr/testify/assert"
 )
 
+var promoColumns = []string{
+	"id", "code", "percent", "start_date", "end_date", "discount_type", "amount", "min_order_amount",
+	"max_discount", "usage_limit", "per_user_limit", "first_order_only", "stack_with_discounts",
+}
+
+func promoRow(p *models.PromoCode) []driver.Value {
+	maxDiscount, _ := p.MaxDiscount.Value()
+	usageLimit, _ := p.UsageLimit.Value()
+	perUserLimit, _ := p.PerUserLimit.Value()
+
+	return []driver.Value{
+		p.ID, p.Code, p.Percent, p.StartDate, p.EndDate, string(p.DiscountType), p.Amount, p.MinOrderAmount,
+		maxDiscount, usageLimit, perUserLimit, p.FirstOrderOnly, p.StackWithDiscounts,
+	}
+}
+
 func TestCreatePromoCode_Success(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -21,16 +41,30 @@ func TestCreatePromoCode_Success(t *testing.T) {
 	defer db.Close()
 
 	p := models.PromoCode{
-		ID:        uuid.New(),
-		Code:      "SUMMER20",
-		Percent:   20,
-		StartDate: time.Now(),
-		EndDate:   time.Now().Add(24 * time.Hour),
+		ID:                 uuid.New(),
+		Code:               "SUMMER20",
+		Percent:            20,
+		StartDate:          time.Now(),
+		EndDate:            time.Now().Add(24 * time.Hour),
+		DiscountType:       models.PromoDiscountPercent,
+		MinOrderAmount:     models.Rubles(1000),
+		MaxDiscount:        models.NullMoneyFrom(models.Rubles(500)),
+		UsageLimit:         null.IntFrom(100),
+		StackWithDiscounts: true,
+		Scopes: []models.PromoScope{
+			{Type: models.PromoScopeSeller, TargetID: uuid.New()},
+		},
 	}
 
+	mock.ExpectBegin()
 	mock.ExpectExec("INSERT INTO bazaar.promo_code").
-		WithArgs(p.ID, p.Code, p.Percent, p.StartDate, p.EndDate).
+		WithArgs(p.ID, p.Code, p.Percent, p.StartDate, p.EndDate, p.DiscountType, p.Amount, p.MinOrderAmount,
+			p.MaxDiscount, p.UsageLimit, p.PerUserLimit, p.FirstOrderOnly, p.StackWithDiscounts).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO bazaar.promo_code_scope").
+		WithArgs(p.ID, p.Scopes[0].Type, p.Scopes[0].TargetID).
 		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
 
 	repo := promo.NewPromoRepository(db)
 	err = repo.Create(context.Background(), p)
@@ -47,16 +81,18 @@ func TestCreatePromoCode_DBError(t *testing.T) {
 	defer db.Close()
 
 	p := models.PromoCode{
-		ID:        uuid.New(),
-		Code:      "SUMMER20",
-		Percent:   20,
-		StartDate: time.Now(),
-		EndDate:   time.Now().Add(24 * time.Hour),
+		ID:           uuid.New(),
+		Code:         "SUMMER20",
+		Percent:      20,
+		StartDate:    time.Now(),
+		EndDate:      time.Now().Add(24 * time.Hour),
+		DiscountType: models.PromoDiscountPercent,
 	}
 
+	mock.ExpectBegin()
 	mock.ExpectExec("INSERT INTO bazaar.promo_code").
-		WithArgs(p.ID, p.Code, p.Percent, p.StartDate, p.EndDate).
 		WillReturnError(errors.New("database error"))
+	mock.ExpectRollback()
 
 	repo := promo.NewPromoRepository(db)
 	err = repo.Create(context.Background(), p)
@@ -74,29 +110,42 @@ func TestGetAllPromoCodes_Success(t *testing.T) {
 	defer db.Close()
 
 	now := time.Now()
+	categoryID := uuid.New()
 	expected := []*models.PromoCode{
 		{
-			ID:        uuid.New(),
-			Code:      "SUMMER20",
-			Percent:   20,
-			StartDate: now,
-			EndDate:   now.Add(24 * time.Hour),
+			ID:                 uuid.New(),
+			Code:               "SUMMER20",
+			Percent:            20,
+			StartDate:          now,
+			EndDate:            now.Add(24 * time.Hour),
+			DiscountType:       models.PromoDiscountPercent,
+			StackWithDiscounts: true,
+			Scopes: []models.PromoScope{
+				{Type: models.PromoScopeCategory, TargetID: categoryID},
+			},
 		},
 		{
-			ID:        uuid.New(),
-			Code:      "WINTER30",
-			Percent:   30,
-			StartDate: now.Add(-48 * time.Hour),
-			EndDate:   now.Add(-24 * time.Hour),
+			ID:             uuid.New(),
+			Code:           "WINTER300",
+			StartDate:      now.Add(-48 * time.Hour),
+			EndDate:        now.Add(-24 * time.Hour),
+			DiscountType:   models.PromoDiscountFixed,
+			Amount:         models.Rubles(300),
+			PerUserLimit:   null.IntFrom(1),
+			FirstOrderOnly: true,
 		},
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "code", "percent", "start_date", "end_date"}).
-		AddRow(expected[0].ID, expected[0].Code, expected[0].Percent, expected[0].StartDate, expected[0].EndDate).
-		AddRow(expected[1].ID, expected[1].Code, expected[1].Percent, expected[1].StartDate, expected[1].EndDate)
+	rows := sqlmock.NewRows(promoColumns).
+		AddRow(promoRow(expected[0])...).
+		AddRow(promoRow(expected[1])...)
 
-	mock.ExpectQuery("SELECT id, code, percent, start_date, end_date FROM bazaar.promo_code").
+	mock.ExpectQuery("SELECT id, code, percent, start_date, end_date, discount_type.* FROM bazaar.promo_code WHERE campaign_id IS NULL ORDER BY").
+		WithArgs(0).
 		WillReturnRows(rows)
+	mock.ExpectQuery("SELECT promo_id, scope_type, target_id FROM bazaar.promo_code_scope").
+		WillReturnRows(sqlmock.NewRows([]string{"promo_id", "scope_type", "target_id"}).
+			AddRow(expected[0].ID, "category", categoryID))
 
 	repo := promo.NewPromoRepository(db)
 	result, err := repo.GetAll(context.Background(), 0)
@@ -113,9 +162,9 @@ func TestGetAllPromoCodes_Empty(t *testing.T) {
 	}
 	defer db.Close()
 
-	rows := sqlmock.NewRows([]string{"id", "code", "percent", "start_date", "end_date"})
+	rows := sqlmock.NewRows(promoColumns)
 
-	mock.ExpectQuery("SELECT id, code, percent, start_date, end_date FROM bazaar.promo_code").
+	mock.ExpectQuery("SELECT id, code, percent, start_date, end_date, discount_type.* FROM bazaar.promo_code WHERE campaign_id IS NULL ORDER BY").
 		WillReturnRows(rows)
 
 	repo := promo.NewPromoRepository(db)
@@ -133,7 +182,7 @@ func TestGetAllPromoCodes_DBError(t *testing.T) {
 	}
 	defer db.Close()
 
-	mock.ExpectQuery("SELECT id, code, percent, start_date, end_date FROM bazaar.promo_code").
+	mock.ExpectQuery("SELECT id, code, percent, start_date, end_date, discount_type.* FROM bazaar.promo_code WHERE campaign_id IS NULL ORDER BY").
 		WillReturnError(errors.New("database error"))
 
 	repo := promo.NewPromoRepository(db)
@@ -153,19 +202,22 @@ func TestCheckPromoCode_Success(t *testing.T) {
 
 	now := time.Now()
 	expected := &models.PromoCode{
-		ID:        uuid.New(),
-		Code:      "SUMMER20",
-		Percent:   20,
-		StartDate: now,
-		EndDate:   now.Add(24 * time.Hour),
+		ID:                 uuid.New(),
+		Code:               "SUMMER20",
+		Percent:            20,
+		StartDate:          now,
+		EndDate:            now.Add(24 * time.Hour),
+		DiscountType:       models.PromoDiscountPercent,
+		StackWithDiscounts: true,
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "code", "percent", "start_date", "end_date"}).
-		AddRow(expected.ID, expected.Code, expected.Percent, expected.StartDate, expected.EndDate)
+	rows := sqlmock.NewRows(promoColumns).AddRow(promoRow(expected)...)
 
-	mock.ExpectQuery("SELECT id, code, percent, start_date, end_date FROM bazaar.promo_code WHERE code = ?").
+	mock.ExpectQuery("SELECT id, code, percent, start_date, end_date, discount_type.* FROM bazaar.promo_code WHERE code = \\$1 AND is_active").
 		WithArgs("SUMMER20").
 		WillReturnRows(rows)
+	mock.ExpectQuery("SELECT promo_id, scope_type, target_id FROM bazaar.promo_code_scope").
+		WillReturnRows(sqlmock.NewRows([]string{"promo_id", "scope_type", "target_id"}))
 
 	repo := promo.NewPromoRepository(db)
 	result, err := repo.CheckPromoCode(context.Background(), "SUMMER20")
@@ -175,6 +227,24 @@ func TestCheckPromoCode_Success(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestCheckPromoCode_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery("SELECT id, code, percent, start_date, end_date, discount_type.* FROM bazaar.promo_code WHERE code = \\$1 AND is_active").
+		WithArgs("MISSING").
+		WillReturnRows(sqlmock.NewRows(promoColumns))
+
+	repo := promo.NewPromoRepository(db)
+	_, err = repo.CheckPromoCode(context.Background(), "MISSING")
+
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestCheckPromoCode_DBError(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	if err != nil {
@@ -182,7 +252,7 @@ func TestCheckPromoCode_DBError(t *testing.T) {
 	}
 	defer db.Close()
 
-	mock.ExpectQuery("SELECT id, code, percent, start_date, end_date FROM bazaar.promo_code WHERE code = ?").
+	mock.ExpectQuery("SELECT id, code, percent, start_date, end_date, discount_type.* FROM bazaar.promo_code WHERE code = \\$1 AND is_active").
 		WithArgs("ERROR").
 		WillReturnError(errors.New("database error"))
 
@@ -193,3 +263,274 @@ func TestCheckPromoCode_DBError(t *testing.T) {
 	assert.Contains(t, err.Error(), "database error")
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestGetPromoUsage_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	promoID := uuid.New()
+	userID := uuid.New()
+
+	// Применения в отменённых заказах не учитываются
+	mock.ExpectQuery("FROM bazaar.promo_redemption r JOIN bazaar.\"order\" o ON o.id = r.order_id "+
+		"WHERE r.promo_id = \\$1 AND o.status NOT IN \\('canceled', 'canceled_by_user', 'canceled_by_seller', "+
+		"'canceled_due_to_payment_error', 'payment_failed'\\)").
+		WithArgs(promoID, userID).
+		WillReturnRows(sqlmock.NewRows([]string{"total", "by_user", "orders"}).AddRow(10, 1, 3))
+
+	repo := promo.NewPromoRepository(db)
+	usage, err := repo.GetPromoUsage(context.Background(), promoID, userID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.PromoUsage{Total: 10, ByUser: 1, UserOrders: 3}, usage)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetUserCart_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	userID := uuid.New()
+	productID := uuid.New()
+	sellerID := uuid.New()
+	subcategoryID := uuid.New()
+	categoryID := uuid.New()
+
+	mock.ExpectQuery("SELECT p.id, p.seller_id, p.price, .* FROM bazaar.basket_item bi").
+		WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "seller_id", "price", "final_price", "quantity", "categories"}).
+			AddRow(productID, sellerID, "1000.00", "800.00", 2, "{"+subcategoryID.String()+","+categoryID.String()+"}"))
+
+	repo := promo.NewPromoRepository(db)
+	items, err := repo.GetUserCart(context.Background(), userID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []models.PromoCartItem{{
+		ProductID:   productID,
+		SellerID:    sellerID,
+		CategoryIDs: []uuid.UUID{subcategoryID, categoryID},
+		Price:       models.Rubles(1000),
+		FinalPrice:  models.Rubles(800),
+		Quantity:    2,
+	}}, items)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetProductScopes_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	productID := uuid.New()
+	sellerID := uuid.New()
+
+	mock.ExpectQuery("SELECT p.id, p.seller_id, .* FROM bazaar.product p WHERE p.id = ANY\\(\\$1\\)").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "seller_id", "categories"}).
+			AddRow(productID, sellerID, "{}"))
+
+	repo := promo.NewPromoRepository(db)
+	scopes, err := repo.GetProductScopes(context.Background(), []uuid.UUID{productID})
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]models.PromoProductScope{
+		productID: {SellerID: sellerID, CategoryIDs: []uuid.UUID{}},
+	}, scopes)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func testCampaign() models.PromoCampaign {
+	return models.PromoCampaign{
+		ID:         uuid.New(),
+		Name:       "Black Friday",
+		Prefix:     "BF-",
+		Alphabet:   "ABCDEF234",
+		CodeLength: 6,
+		CodesCount: 2,
+		IsActive:   true,
+		CreatedAt:  time.Now(),
+		Template: models.PromoCode{
+			Percent:            15,
+			StartDate:          time.Now(),
+			EndDate:            time.Now().Add(24 * time.Hour),
+			DiscountType:       models.PromoDiscountPercent,
+			UsageLimit:         null.IntFrom(1),
+			PerUserLimit:       null.IntFrom(1),
+			StackWithDiscounts: true,
+			Scopes:             []models.PromoScope{{Type: models.PromoScopeCategory, TargetID: uuid.New()}},
+		},
+	}
+}
+
+func TestCreateCampaign_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	c := testCampaign()
+	codes := []string{"BF-AAAAAA", "BF-BBBBBB"}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO bazaar.promo_campaign").
+		WithArgs(c.ID, c.Name, c.Prefix, c.Alphabet, c.CodeLength, c.CodesCount, c.IsActive, c.CreatedAt).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO bazaar.promo_code .* FROM unnest\\(\\$1::uuid\\[\\], \\$2::text\\[\\]\\) .* ON CONFLICT \\(code\\) DO NOTHING").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("INSERT INTO bazaar.promo_code_scope .* WHERE campaign_id = \\$1").
+		WithArgs(c.ID, c.Template.Scopes[0].Type, c.Template.Scopes[0].TargetID).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	repo := promo.NewPromoRepository(db)
+	err = repo.CreateCampaign(context.Background(), c, codes)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateCampaign_CodeCollision(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	c := testCampaign()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO bazaar.promo_campaign").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO bazaar.promo_code .* ON CONFLICT \\(code\\) DO NOTHING").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectRollback()
+
+	repo := promo.NewPromoRepository(db)
+	err = repo.CreateCampaign(context.Background(), c, []string{"BF-AAAAAA", "BF-BBBBBB"})
+
+	assert.ErrorIs(t, err, errs.ErrAlreadyExists)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeactivateCampaign_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	campaignID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE bazaar.promo_campaign SET is_active = FALSE WHERE id = \\$1").
+		WithArgs(campaignID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE bazaar.promo_code SET is_active = FALSE, updated_at = now\\(\\) WHERE campaign_id = \\$1").
+		WithArgs(campaignID).
+		WillReturnResult(sqlmock.NewResult(0, 500))
+	mock.ExpectCommit()
+
+	repo := promo.NewPromoRepository(db)
+	err = repo.DeactivateCampaign(context.Background(), campaignID)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeactivateCampaign_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	campaignID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE bazaar.promo_campaign").
+		WithArgs(campaignID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	repo := promo.NewPromoRepository(db)
+	err = repo.DeactivateCampaign(context.Background(), campaignID)
+
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetCampaignCodes_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	campaignID := uuid.New()
+
+	mock.ExpectQuery("SELECT pc.code, pc.is_active, EXISTS .* WHERE pc.campaign_id = \\$1 ORDER BY pc.code").
+		WithArgs(campaignID).
+		WillReturnRows(sqlmock.NewRows([]string{"code", "is_active", "redeemed"}).
+			AddRow("BF-AAAAAA", true, true).
+			AddRow("BF-BBBBBB", true, false))
+
+	repo := promo.NewPromoRepository(db)
+	codes, err := repo.GetCampaignCodes(context.Background(), campaignID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []models.PromoCampaignCode{
+		{Code: "BF-AAAAAA", IsActive: true, Redeemed: true},
+		{Code: "BF-BBBBBB", IsActive: true, Redeemed: false},
+	}, codes)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetCampaignStats(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	campaignID := uuid.New()
+	repo := promo.NewPromoRepository(db)
+
+	// Отменённые заказы в любом из статусов отмены не считаются использованием кода
+	mock.ExpectQuery("SELECT c.id, c.name, c.is_active, .* FROM bazaar.promo_campaign c .*" +
+		"o.status NOT IN \\('canceled', 'canceled_by_user', 'canceled_by_seller', 'canceled_due_to_payment_error', 'payment_failed'\\)" +
+		".* WHERE c.id = \\$1 GROUP BY c.id").
+		WithArgs(campaignID).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "name", "is_active", "codes_total", "redemptions", "revenue", "total_discount", "avg_discount",
+		}).AddRow(campaignID, "Black Friday", true, 100, 4, "12000.50", "800.00", "200.00"))
+
+	stats, err := repo.GetCampaignStats(context.Background(), campaignID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.PromoCampaignStats{
+		CampaignID:      campaignID,
+		Name:            "Black Friday",
+		IsActive:        true,
+		CodesTotal:      100,
+		Redemptions:     4,
+		Revenue:         models.Kopecks(1200050),
+		TotalDiscount:   models.Rubles(800),
+		AverageDiscount: models.Rubles(200),
+	}, stats)
+
+	mock.ExpectQuery("SELECT c.id, c.name, c.is_active, .* FROM bazaar.promo_campaign c").
+		WithArgs(campaignID).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.GetCampaignStats(context.Background(), campaignID)
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
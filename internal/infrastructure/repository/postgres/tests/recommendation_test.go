@@ -126,8 +126,8 @@ func TestGetProductIDsBySubcategoryID_Success(t *testing.T) {
 		AddRow(expectedProducts[0]).
 		AddRow(expectedProducts[1])
 
-	mock.ExpectQuery("SELECT product_id FROM bazaar.product_subcategory WHERE subcategory_id = \\$1 ORDER BY RANDOM\\(\\) LIMIT 10").
-		WithArgs(subcategoryID).
+	mock.ExpectQuery("SELECT ps.product_id FROM bazaar.product_subcategory ps JOIN bazaar.product p ON p.id = ps.product_id AND p.status = 'approved' LEFT JOIN bazaar.product_popularity pp .* ORDER BY COALESCE\\(pp.score, 0\\) DESC LIMIT \\$2").
+		WithArgs(subcategoryID, 10).
 		WillReturnRows(rows)
 
 	repo := recommendation.NewRecommendationRepository(db)
@@ -149,8 +149,8 @@ func TestGetProductIDsBySubcategoryID_Empty(t *testing.T) {
 
 	rows := sqlmock.NewRows([]string{"product_id"})
 
-	mock.ExpectQuery("SELECT product_id FROM bazaar.product_subcategory WHERE subcategory_id = \\$1 ORDER BY RANDOM\\(\\) LIMIT 10").
-		WithArgs(subcategoryID).
+	mock.ExpectQuery("SELECT ps.product_id FROM bazaar.product_subcategory ps JOIN bazaar.product p ON p.id = ps.product_id AND p.status = 'approved' LEFT JOIN bazaar.product_popularity pp .* ORDER BY COALESCE\\(pp.score, 0\\) DESC LIMIT \\$2").
+		WithArgs(subcategoryID, 10).
 		WillReturnRows(rows)
 
 	repo := recommendation.NewRecommendationRepository(db)
@@ -170,8 +170,8 @@ func TestGetProductIDsBySubcategoryID_DBError(t *testing.T) {
 
 	subcategoryID := uuid.New()
 
-	mock.ExpectQuery("SELECT product_id FROM bazaar.product_subcategory WHERE subcategory_id = \\$1 ORDER BY RANDOM\\(\\) LIMIT 10").
-		WithArgs(subcategoryID).
+	mock.ExpectQuery("SELECT ps.product_id FROM bazaar.product_subcategory ps JOIN bazaar.product p ON p.id = ps.product_id AND p.status = 'approved' LEFT JOIN bazaar.product_popularity pp .* ORDER BY COALESCE\\(pp.score, 0\\) DESC LIMIT \\$2").
+		WithArgs(subcategoryID, 10).
 		WillReturnError(errors.New("database error"))
 
 	repo := recommendation.NewRecommendationRepository(db)
@@ -194,8 +194,8 @@ func TestGetProductIDsBySubcategoryID_ScanError(t *testing.T) {
 	rows := sqlmock.NewRows([]string{"product_id"}).
 		AddRow("invalid-uuid")
 
-	mock.ExpectQuery("SELECT product_id FROM bazaar.product_subcategory WHERE subcategory_id = \\$1 ORDER BY RANDOM\\(\\) LIMIT 10").
-		WithArgs(subcategoryID).
+	mock.ExpectQuery("SELECT ps.product_id FROM bazaar.product_subcategory ps JOIN bazaar.product p ON p.id = ps.product_id AND p.status = 'approved' LEFT JOIN bazaar.product_popularity pp .* ORDER BY COALESCE\\(pp.score, 0\\) DESC LIMIT \\$2").
+		WithArgs(subcategoryID, 10).
 		WillReturnRows(rows)
 
 	repo := recommendation.NewRecommendationRepository(db)
@@ -205,3 +205,174 @@ func TestGetProductIDsBySubcategoryID_ScanError(t *testing.T) {
 	assert.Contains(t, err.Error(), "invalid UUID length")
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestGetSimilarProductIDs_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	productID := uuid.New()
+	expectedProducts := []uuid.UUID{uuid.New(), uuid.New()}
+
+	rows := sqlmock.NewRows([]string{"similar_product_id"}).
+		AddRow(expectedProducts[0]).
+		AddRow(expectedProducts[1])
+
+	mock.ExpectQuery("SELECT ps.similar_product_id FROM bazaar.product_similarity ps .* WHERE ps.product_id = \\$1 ORDER BY ps.score DESC LIMIT \\$2").
+		WithArgs(productID, 10).
+		WillReturnRows(rows)
+
+	repo := recommendation.NewRecommendationRepository(db)
+	result, err := repo.GetSimilarProductIDs(context.Background(), productID, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedProducts, result)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetSimilarProductIDs_DBError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	productID := uuid.New()
+
+	mock.ExpectQuery("SELECT ps.similar_product_id FROM bazaar.product_similarity ps").
+		WithArgs(productID, 10).
+		WillReturnError(errors.New("database error"))
+
+	repo := recommendation.NewRecommendationRepository(db)
+	_, err = repo.GetSimilarProductIDs(context.Background(), productID, 10)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "database error")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPersonalProductIDs_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	userID := uuid.New()
+	expectedProducts := []uuid.UUID{uuid.New()}
+
+	rows := sqlmock.NewRows([]string{"similar_product_id"}).
+		AddRow(expectedProducts[0])
+
+	mock.ExpectQuery("WITH seen AS .* NOT IN \\(SELECT product_id FROM seen\\) .* LIMIT \\$2").
+		WithArgs(userID, 10).
+		WillReturnRows(rows)
+
+	repo := recommendation.NewRecommendationRepository(db)
+	result, err := repo.GetPersonalProductIDs(context.Background(), userID, 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedProducts, result)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPopularProductIDs_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	expectedProducts := []uuid.UUID{uuid.New(), uuid.New()}
+
+	rows := sqlmock.NewRows([]string{"product_id"}).
+		AddRow(expectedProducts[0]).
+		AddRow(expectedProducts[1])
+
+	mock.ExpectQuery("SELECT pp.product_id FROM bazaar.product_popularity pp .* LIMIT \\$1").
+		WithArgs(20).
+		WillReturnRows(rows)
+
+	repo := recommendation.NewRecommendationRepository(db)
+	result, err := repo.GetPopularProductIDs(context.Background(), 20)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedProducts, result)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRefreshSimilarity_Success(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT pg_try_advisory_xact_lock\\(\\$1\\)").
+		WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_xact_lock"}).AddRow(true))
+	mock.ExpectExec("DELETE FROM bazaar.product_similarity").
+		WillReturnResult(sqlmock.NewResult(0, 5))
+	mock.ExpectExec("INSERT INTO bazaar.product_similarity").
+		WithArgs(20).
+		WillReturnResult(sqlmock.NewResult(0, 5))
+	mock.ExpectExec("DELETE FROM bazaar.product_popularity").
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec("INSERT INTO bazaar.product_popularity").
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+
+	repo := recommendation.NewRecommendationRepository(db)
+	refreshed, err := repo.RefreshSimilarity(context.Background(), 20)
+
+	assert.NoError(t, err)
+	assert.True(t, refreshed)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRefreshSimilarity_LockedByAnotherInstance(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT pg_try_advisory_xact_lock\\(\\$1\\)").
+		WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_xact_lock"}).AddRow(false))
+	mock.ExpectRollback()
+
+	repo := recommendation.NewRecommendationRepository(db)
+	refreshed, err := repo.RefreshSimilarity(context.Background(), 20)
+
+	assert.NoError(t, err)
+	assert.False(t, refreshed)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRefreshSimilarity_FillError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT pg_try_advisory_xact_lock\\(\\$1\\)").
+		WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_xact_lock"}).AddRow(true))
+	mock.ExpectExec("DELETE FROM bazaar.product_similarity").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO bazaar.product_similarity").
+		WithArgs(20).
+		WillReturnError(errors.New("database error"))
+	mock.ExpectRollback()
+
+	repo := recommendation.NewRecommendationRepository(db)
+	refreshed, err := repo.RefreshSimilarity(context.Background(), 20)
+
+	assert.Error(t, err)
+	assert.False(t, refreshed)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
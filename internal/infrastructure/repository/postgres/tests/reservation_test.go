@@ -0,0 +1,176 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/reservation"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReservationRepository_Hold(t *testing.T) {
+	userID := uuid.New()
+	firstID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	secondID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	expiresAt := time.Now().Add(15 * time.Minute)
+
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := reservation.NewReservationRepository(db)
+
+		mock.ExpectBegin()
+		expectStockContext(mock, models.StockRelease, userID.String(), "", "")
+		mock.ExpectQuery("UPDATE bazaar.stock_reservation SET status = 'released'").
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		expectStockContext(mock, models.StockReserve, userID.String(), "", "")
+		// Товары списываются в порядке идентификаторов, а не в порядке запроса
+		mock.ExpectExec("UPDATE bazaar.product SET quantity = quantity - \\$1").
+			WithArgs(uint(1), firstID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO bazaar.stock_reservation").
+			WithArgs(sqlmock.AnyArg(), userID, firstID, nil, uint(1), expiresAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("UPDATE bazaar.product SET quantity = quantity - \\$1").
+			WithArgs(uint(2), secondID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO bazaar.stock_reservation").
+			WithArgs(sqlmock.AnyArg(), userID, secondID, nil, uint(2), expiresAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		reservations, err := repo.Hold(context.Background(), userID, []models.PricingItem{
+			{ProductID: secondID, Quantity: 2},
+			{ProductID: firstID, Quantity: 1},
+		}, expiresAt)
+		require.NoError(t, err)
+		require.Len(t, reservations, 2)
+		assert.Equal(t, firstID, reservations[0].ProductID)
+		assert.Equal(t, models.ReservationHeld, reservations[0].Status)
+		assert.Equal(t, expiresAt, reservations[1].ExpiresAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not enough stock", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := reservation.NewReservationRepository(db)
+
+		mock.ExpectBegin()
+		expectStockContext(mock, models.StockRelease, userID.String(), "", "")
+		mock.ExpectQuery("UPDATE bazaar.stock_reservation SET status = 'released'").
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		expectStockContext(mock, models.StockReserve, userID.String(), "", "")
+		mock.ExpectExec("UPDATE bazaar.product SET quantity = quantity - \\$1").
+			WithArgs(uint(5), firstID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		_, err = repo.Hold(context.Background(), userID, []models.PricingItem{
+			{ProductID: firstID, Quantity: 5},
+		}, expiresAt)
+		assert.ErrorIs(t, err, errs.ErrNotEnoughStock)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("variant takes sku stock", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := reservation.NewReservationRepository(db)
+		variantID := uuid.New()
+
+		mock.ExpectBegin()
+		expectStockContext(mock, models.StockRelease, userID.String(), "", "")
+		mock.ExpectQuery("UPDATE bazaar.stock_reservation SET status = 'released'").
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		expectStockContext(mock, models.StockReserve, userID.String(), "", "")
+		mock.ExpectExec("UPDATE bazaar.product_variant v SET quantity = v.quantity - \\$1").
+			WithArgs(uint(2), firstID, variantID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO bazaar.stock_reservation").
+			WithArgs(sqlmock.AnyArg(), userID, firstID, variantID, uint(2), expiresAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		reservations, err := repo.Hold(context.Background(), userID, []models.PricingItem{
+			{ProductID: firstID, VariantID: uuid.NullUUID{UUID: variantID, Valid: true}, Quantity: 2},
+		}, expiresAt)
+		require.NoError(t, err)
+		require.Len(t, reservations, 1)
+		assert.Equal(t, variantID, reservations[0].VariantID.UUID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("begin error", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := reservation.NewReservationRepository(db)
+
+		mock.ExpectBegin().WillReturnError(errors.New("begin error"))
+
+		_, err = repo.Hold(context.Background(), userID, []models.PricingItem{
+			{ProductID: firstID, Quantity: 1},
+		}, expiresAt)
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestReservationRepository_ReleaseExpired(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := reservation.NewReservationRepository(db)
+
+		mock.ExpectBegin()
+		expectStockContext(mock, models.StockRelease, "", "", "reservation expired")
+		mock.ExpectQuery("FOR UPDATE SKIP LOCKED").
+			WithArgs(100).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+		mock.ExpectCommit()
+
+		released, err := repo.ReleaseExpired(context.Background(), 100)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), released)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := reservation.NewReservationRepository(db)
+
+		mock.ExpectBegin()
+		expectStockContext(mock, models.StockRelease, "", "", "reservation expired")
+		mock.ExpectQuery("FOR UPDATE SKIP LOCKED").
+			WithArgs(100).
+			WillReturnError(errors.New("db error"))
+		mock.ExpectRollback()
+
+		_, err = repo.ReleaseExpired(context.Background(), 100)
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	review "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/review"
 )
@@ -34,8 +37,8 @@ func TestReviewRepository_AddReview(t *testing.T) {
 		mock.ExpectExec("UPDATE bazaar.product SET reviews_count = reviews_count + 1 WHERE id = $1").
 			WithArgs(reviewDB.ProductID).
 			WillReturnResult(sqlmock.NewResult(1, 1))
-		mock.ExpectExec("INSERT INTO bazaar.review (id, user_id, product_id, rating, comment) VALUES ($1, $2, $3, $4, $5)").
-			WithArgs(reviewDB.ID, reviewDB.UserID, reviewDB.ProductID, reviewDB.Rating, reviewDB.Comment).
+		mock.ExpectExec("INSERT INTO bazaar.review (id, user_id, product_id, rating, comment, verified_purchase, status, moderation_reason) VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''))").
+			WithArgs(reviewDB.ID, reviewDB.UserID, reviewDB.ProductID, reviewDB.Rating, reviewDB.Comment, reviewDB.VerifiedPurchase, reviewDB.Status, reviewDB.ModerationReason).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectCommit()
 
@@ -77,8 +80,8 @@ func TestReviewRepository_AddReview(t *testing.T) {
 		mock.ExpectExec("UPDATE bazaar.product SET reviews_count = reviews_count + 1 WHERE id = $1").
 			WithArgs(reviewDB.ProductID).
 			WillReturnResult(sqlmock.NewResult(1, 1))
-		mock.ExpectExec("INSERT INTO bazaar.review (id, user_id, product_id, rating, comment) VALUES ($1, $2, $3, $4, $5)").
-			WithArgs(reviewDB.ID, reviewDB.UserID, reviewDB.ProductID, reviewDB.Rating, reviewDB.Comment).
+		mock.ExpectExec("INSERT INTO bazaar.review (id, user_id, product_id, rating, comment, verified_purchase, status, moderation_reason) VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''))").
+			WithArgs(reviewDB.ID, reviewDB.UserID, reviewDB.ProductID, reviewDB.Rating, reviewDB.Comment, reviewDB.VerifiedPurchase, reviewDB.Status, reviewDB.ModerationReason).
 			WillReturnError(sql.ErrConnDone)
 		mock.ExpectRollback()
 
@@ -100,8 +103,8 @@ func TestReviewRepository_AddReview(t *testing.T) {
 		mock.ExpectExec("UPDATE bazaar.product SET reviews_count = reviews_count + 1 WHERE id = $1").
 			WithArgs(reviewDB.ProductID).
 			WillReturnResult(sqlmock.NewResult(1, 1))
-		mock.ExpectExec("INSERT INTO bazaar.review (id, user_id, product_id, rating, comment) VALUES ($1, $2, $3, $4, $5)").
-			WithArgs(reviewDB.ID, reviewDB.UserID, reviewDB.ProductID, reviewDB.Rating, reviewDB.Comment).
+		mock.ExpectExec("INSERT INTO bazaar.review (id, user_id, product_id, rating, comment, verified_purchase, status, moderation_reason) VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''))").
+			WithArgs(reviewDB.ID, reviewDB.UserID, reviewDB.ProductID, reviewDB.Rating, reviewDB.Comment, reviewDB.VerifiedPurchase, reviewDB.Status, reviewDB.ModerationReason).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectCommit().WillReturnError(sql.ErrConnDone)
 
@@ -112,7 +115,7 @@ func TestReviewRepository_AddReview(t *testing.T) {
 }
 
 func TestReviewRepository_GetReview(t *testing.T) {
-	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	db, mock, err := sqlmock.New()
 	if err != nil {
 		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
 	}
@@ -120,57 +123,67 @@ func TestReviewRepository_GetReview(t *testing.T) {
 
 	repo := review.NewReviewRepository(db)
 
+	columns := []string{
+		"id", "name", "surname", "image_url", "rating", "comment",
+		"helpful_count", "unhelpful_count", "created_at", "verified_purchase", "text", "created_at",
+	}
+
 	t.Run("Success", func(t *testing.T) {
 		productID := uuid.New()
 		offset := 0
+		firstID := uuid.New()
+		secondID := uuid.New()
+		now := time.Now()
 
-		rows := sqlmock.NewRows([]string{
-			"id", "name", "surname", "image_url", "rating", "comment",
-		}).
-			AddRow(uuid.New(), "John", "Doe", "image1.jpg", 5, "Excellent").
-			AddRow(uuid.New(), "Jane", "Smith", "image2.jpg", 4, "Good")
-
-		expectedQuery := `SELECT 
-			r.id, u.name, u.surname, u.image_url, r.rating, r.comment
-		FROM bazaar.review r
-		JOIN bazaar.user u ON r.user_id = u.id
-		WHERE r.product_id = $1
-		ORDER BY r.created_at DESC
-        LIMIT 7 OFFSET $2`
-
-		mock.ExpectQuery(expectedQuery).
+		rows := sqlmock.NewRows(columns).
+			AddRow(firstID, "John", "Doe", "image1.jpg", 5, "Excellent", 3, 1, now, true, "Спасибо!", now).
+			AddRow(secondID, "Jane", "Smith", "image2.jpg", 4, "Good", 0, 0, now, false, nil, nil)
+
+		mock.ExpectQuery(`SELECT .+ FROM bazaar.review r .+ ORDER BY r.created_at DESC`).
 			WithArgs(productID, offset).
 			WillReturnRows(rows)
+		mock.ExpectQuery(`SELECT review_id, image_url FROM bazaar.review_photo`).
+			WillReturnRows(sqlmock.NewRows([]string{"review_id", "image_url"}).
+				AddRow(secondID, "photo.jpg"))
 
-		reviews, err := repo.GetReview(context.Background(), productID, offset)
+		reviews, err := repo.GetReview(context.Background(), productID, offset, models.ReviewSortNewest)
 		assert.NoError(t, err)
 		assert.Len(t, reviews, 2)
 		assert.Equal(t, "John", reviews[0].Name)
+		assert.Equal(t, 3, reviews[0].HelpfulCount)
+		assert.True(t, reviews[0].VerifiedPurchase)
+		assert.False(t, reviews[1].VerifiedPurchase)
+		assert.NotNil(t, reviews[0].Reply)
+		assert.Equal(t, "Спасибо!", reviews[0].Reply.Text)
+		assert.Empty(t, reviews[0].Photos)
 		assert.Equal(t, "Jane", reviews[1].Name)
+		assert.Nil(t, reviews[1].Reply)
+		assert.Equal(t, []string{"photo.jpg"}, reviews[1].Photos)
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
-	t.Run("NoReviews", func(t *testing.T) {
+	t.Run("SortByHelpful", func(t *testing.T) {
 		productID := uuid.New()
-		offset := 0
 
-		rows := sqlmock.NewRows([]string{
-			"id", "name", "surname", "image_url", "rating", "comment",
-		})
+		mock.ExpectQuery(`ORDER BY r.helpful_count DESC, r.created_at DESC`).
+			WithArgs(productID, 7).
+			WillReturnRows(sqlmock.NewRows(columns))
 
-		expectedQuery := `SELECT 
-			r.id, u.name, u.surname, u.image_url, r.rating, r.comment
-		FROM bazaar.review r
-		JOIN bazaar.user u ON r.user_id = u.id
-		WHERE r.product_id = $1
-		ORDER BY r.created_at DESC
-        LIMIT 7 OFFSET $2`
+		reviews, err := repo.GetReview(context.Background(), productID, 7, models.ReviewSortHelpful)
+		assert.NoError(t, err)
+		assert.Empty(t, reviews)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NoReviews", func(t *testing.T) {
+		productID := uuid.New()
+		offset := 0
 
-		mock.ExpectQuery(expectedQuery).
+		mock.ExpectQuery(`SELECT .+ FROM bazaar.review r`).
 			WithArgs(productID, offset).
-			WillReturnRows(rows)
+			WillReturnRows(sqlmock.NewRows(columns))
 
-		reviews, err := repo.GetReview(context.Background(), productID, offset)
+		reviews, err := repo.GetReview(context.Background(), productID, offset, models.ReviewSortNewest)
 		assert.NoError(t, err)
 		assert.Empty(t, reviews)
 		assert.NoError(t, mock.ExpectationsWereMet())
@@ -180,19 +193,11 @@ func TestReviewRepository_GetReview(t *testing.T) {
 		productID := uuid.New()
 		offset := 0
 
-		expectedQuery := `SELECT 
-			r.id, u.name, u.surname, u.image_url, r.rating, r.comment
-		FROM bazaar.review r
-		JOIN bazaar.user u ON r.user_id = u.id
-		WHERE r.product_id = $1
-		ORDER BY r.created_at DESC
-        LIMIT 7 OFFSET $2`
-
-		mock.ExpectQuery(expectedQuery).
+		mock.ExpectQuery(`SELECT .+ FROM bazaar.review r`).
 			WithArgs(productID, offset).
 			WillReturnError(sql.ErrConnDone)
 
-		_, err := repo.GetReview(context.Background(), productID, offset)
+		_, err := repo.GetReview(context.Background(), productID, offset, models.ReviewSortNewest)
 		assert.Error(t, err)
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
@@ -201,24 +206,335 @@ func TestReviewRepository_GetReview(t *testing.T) {
 		productID := uuid.New()
 		offset := 0
 
-		rows := sqlmock.NewRows([]string{
-			"id", "name", "surname", "image_url", "rating", "comment",
-		}).AddRow("invalid-uuid", nil, nil, nil, nil, nil)
-
-		expectedQuery := `SELECT 
-			r.id, u.name, u.surname, u.image_url, r.rating, r.comment
-		FROM bazaar.review r
-		JOIN bazaar.user u ON r.user_id = u.id
-		WHERE r.product_id = $1
-		ORDER BY r.created_at DESC
-        LIMIT 7 OFFSET $2`
+		rows := sqlmock.NewRows(columns).
+			AddRow("invalid-uuid", nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
-		mock.ExpectQuery(expectedQuery).
+		mock.ExpectQuery(`SELECT .+ FROM bazaar.review r`).
 			WithArgs(productID, offset).
 			WillReturnRows(rows)
 
-		_, err := repo.GetReview(context.Background(), productID, offset)
+		_, err := repo.GetReview(context.Background(), productID, offset, models.ReviewSortNewest)
 		assert.Error(t, err)
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
-}
\ No newline at end of file
+}
+
+func TestReviewRepository_UpdateReview(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	repo := review.NewReviewRepository(db)
+
+	reviewDB := models.ReviewDB{
+		ID:               uuid.New(),
+		UserID:           uuid.New(),
+		Rating:           4,
+		Comment:          "Updated",
+		Status:           models.ReviewPending,
+		ModerationReason: "link",
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(`UPDATE bazaar.review SET rating = \$1, comment = \$2, status = CASE .+ WHERE id = \$5 AND user_id = \$6`).
+			WithArgs(reviewDB.Rating, reviewDB.Comment, reviewDB.Status, reviewDB.ModerationReason, reviewDB.ID, reviewDB.UserID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.UpdateReview(context.Background(), reviewDB)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("RejectedIsNotPublishedByEdit", func(t *testing.T) {
+		published := reviewDB
+		published.Status, published.ModerationReason = models.ReviewPublished, ""
+
+		// Прошедшая проверку правка публикует только уже опубликованный отзыв,
+		// отклонённый или ожидающий проверки отзыв возвращается модератору
+		mock.ExpectExec(`status = CASE WHEN status = 'published' THEN \$3::bazaar.review_status ` +
+			`ELSE 'pending'::bazaar.review_status END`).
+			WithArgs(published.Rating, published.Comment, models.ReviewPublished, "", published.ID, published.UserID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.UpdateReview(context.Background(), published)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		mock.ExpectExec(`UPDATE bazaar.review`).
+			WithArgs(reviewDB.Rating, reviewDB.Comment, reviewDB.Status, reviewDB.ModerationReason, reviewDB.ID, reviewDB.UserID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.UpdateReview(context.Background(), reviewDB)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestReviewRepository_DeleteReview(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	repo := review.NewReviewRepository(db)
+	reviewID, userID := uuid.New(), uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM bazaar.review WHERE id = \$1 AND user_id = \$2`).
+			WithArgs(reviewID, userID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.DeleteReview(context.Background(), reviewID, userID))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM bazaar.review`).
+			WithArgs(reviewID, userID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		assert.ErrorIs(t, repo.DeleteReview(context.Background(), reviewID, userID), errs.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestReviewRepository_AddReply(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	repo := review.NewReviewRepository(db)
+	reply := models.ReviewReplyDB{
+		ID:       uuid.New(),
+		ReviewID: uuid.New(),
+		SellerID: uuid.New(),
+		Text:     "Спасибо за отзыв",
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(`INSERT INTO bazaar.review_reply`).
+			WithArgs(reply.ID, reply.ReviewID, reply.SellerID, reply.Text).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		assert.NoError(t, repo.AddReply(context.Background(), reply))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("AlreadyReplied", func(t *testing.T) {
+		mock.ExpectExec(`INSERT INTO bazaar.review_reply`).
+			WithArgs(reply.ID, reply.ReviewID, reply.SellerID, reply.Text).
+			WillReturnError(&pq.Error{Code: "23505"})
+
+		assert.ErrorIs(t, repo.AddReply(context.Background(), reply), errs.ErrAlreadyExists)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestReviewRepository_AddReviewPhoto(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	repo := review.NewReviewRepository(db)
+	photo := models.ReviewPhotoDB{
+		ID:       uuid.New(),
+		ReviewID: uuid.New(),
+		ImageURL: "photo.jpg",
+	}
+	const (
+		lockQuery   = `SELECT id FROM bazaar.review WHERE id = \$1 FOR UPDATE`
+		insertQuery = `INSERT INTO bazaar.review_photo \(id, review_id, image_url\) SELECT \$1, \$2, \$3 ` +
+			`WHERE \(SELECT COUNT\(\*\) FROM bazaar.review_photo WHERE review_id = \$2\) < \$4`
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockQuery).
+			WithArgs(photo.ReviewID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(photo.ReviewID))
+		mock.ExpectExec(insertQuery).
+			WithArgs(photo.ID, photo.ReviewID, photo.ImageURL, models.MaxReviewPhotos).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.AddReviewPhoto(context.Background(), photo, models.MaxReviewPhotos))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("LimitReached", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockQuery).
+			WithArgs(photo.ReviewID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(photo.ReviewID))
+		mock.ExpectExec(insertQuery).
+			WithArgs(photo.ID, photo.ReviewID, photo.ImageURL, models.MaxReviewPhotos).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		err := repo.AddReviewPhoto(context.Background(), photo, models.MaxReviewPhotos)
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ReviewNotFound", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(lockQuery).
+			WithArgs(photo.ReviewID).
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		err := repo.AddReviewPhoto(context.Background(), photo, models.MaxReviewPhotos)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestReviewRepository_HasDeliveredPurchase(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	repo := review.NewReviewRepository(db)
+	userID, productID := uuid.New(), uuid.New()
+
+	t.Run("Delivered", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT EXISTS .+ o.status = 'delivered'`).
+			WithArgs(userID, productID).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+		purchased, err := repo.HasDeliveredPurchase(context.Background(), userID, productID)
+		assert.NoError(t, err)
+		assert.True(t, purchased)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NotPurchased", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT EXISTS`).
+			WithArgs(userID, productID).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+		purchased, err := repo.HasDeliveredPurchase(context.Background(), userID, productID)
+		assert.NoError(t, err)
+		assert.False(t, purchased)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("QueryError", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT EXISTS`).
+			WithArgs(userID, productID).
+			WillReturnError(sql.ErrConnDone)
+
+		_, err := repo.HasDeliveredPurchase(context.Background(), userID, productID)
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestReviewRepository_Screening(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	repo := review.NewReviewRepository(db)
+	reviewDB := models.ReviewDB{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		ProductID: uuid.New(),
+		Comment:   "Пришёл быстро, упакован хорошо",
+	}
+
+	t.Run("CountUserReviewsSince", func(t *testing.T) {
+		since := time.Now().Add(-time.Hour)
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM bazaar.review WHERE user_id = \$1 AND id <> \$2 AND created_at >= \$3`).
+			WithArgs(reviewDB.UserID, reviewDB.ID, since).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+		count, err := repo.CountUserReviewsSince(context.Background(), reviewDB.UserID, reviewDB.ID, since)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, count)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("HasDuplicateReview", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT EXISTS .+ lower\(btrim\(comment\)\) = lower\(btrim\(\$4\)\)`).
+			WithArgs(reviewDB.ID, reviewDB.UserID, reviewDB.ProductID, reviewDB.Comment).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+		duplicate, err := repo.HasDuplicateReview(context.Background(), reviewDB)
+		assert.NoError(t, err)
+		assert.True(t, duplicate)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("HasDuplicateReviewError", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT EXISTS`).
+			WithArgs(reviewDB.ID, reviewDB.UserID, reviewDB.ProductID, reviewDB.Comment).
+			WillReturnError(sql.ErrConnDone)
+
+		_, err := repo.HasDuplicateReview(context.Background(), reviewDB)
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestReviewRepository_GetReviewSummary(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	repo := review.NewReviewRepository(db)
+	productID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT .+ FROM bazaar.review r WHERE r.product_id = \$1 AND r.status = 'published'`).
+			WithArgs(productID).
+			WillReturnRows(sqlmock.NewRows([]string{"total", "s1", "s2", "s3", "s4", "s5", "verified", "photos"}).
+				AddRow(4, 1, 0, 0, 1, 2, 3, 1))
+
+		summary, err := repo.GetReviewSummary(context.Background(), productID)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, summary.Total)
+		assert.Equal(t, [5]int{1, 0, 0, 1, 2}, summary.StarCounts)
+		assert.InDelta(t, 0.75, summary.VerifiedShare, 1e-9)
+		assert.InDelta(t, 0.25, summary.WithPhotosShare, 1e-9)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NoReviews", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT`).
+			WithArgs(productID).
+			WillReturnRows(sqlmock.NewRows([]string{"total", "s1", "s2", "s3", "s4", "s5", "verified", "photos"}).
+				AddRow(0, 0, 0, 0, 0, 0, 0, 0))
+
+		summary, err := repo.GetReviewSummary(context.Background(), productID)
+		assert.NoError(t, err)
+		assert.Zero(t, summary.VerifiedShare)
+		assert.Zero(t, summary.WithPhotosShare)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("QueryError", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT`).
+			WithArgs(productID).
+			WillReturnError(sql.ErrConnDone)
+
+		_, err := repo.GetReviewSummary(context.Background(), productID)
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/google/uuid"
 	"github.com/guregu/null"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -88,8 +89,8 @@ func TestSearchRepository_GetProductsByNameWithFilterAndSort(t *testing.T) {
 		now := time.Now()
 		searchTerm := "test"
 		offset := 0
-		minPrice := 1000.0
-		maxPrice := 2000.0
+		minPrice := models.Rubles(1000)
+		maxPrice := models.Rubles(2000)
 		minRating := float32(4.0)
 
 		expectedProducts := []*models.Product{
@@ -100,12 +101,12 @@ func TestSearchRepository_GetProductsByNameWithFilterAndSort(t *testing.T) {
 				PreviewImageURL: "image1.jpg",
 				Description:     "Description 1",
 				Status:          models.ProductApproved,
-				Price:           1000,
+				Price:           models.Rubles(1000),
 				Quantity:        10,
 				UpdatedAt:       now,
 				Rating:          4.5,
 				ReviewsCount:    20,
-				PriceDiscount:   800,
+				PriceDiscount:   models.Rubles(800),
 			},
 			{
 				ID:              product2ID,
@@ -114,12 +115,12 @@ func TestSearchRepository_GetProductsByNameWithFilterAndSort(t *testing.T) {
 				PreviewImageURL: "image2.jpg",
 				Description:     "Description 2",
 				Status:          models.ProductApproved,
-				Price:           1500,
+				Price:           models.Rubles(1500),
 				Quantity:        5,
 				UpdatedAt:       now,
 				Rating:          4.0,
 				ReviewsCount:    15,
-				PriceDiscount:   0,
+				PriceDiscount:   models.Money{},
 			},
 		}
 
@@ -167,8 +168,8 @@ func TestSearchRepository_GetProductsByNameWithFilterAndSort(t *testing.T) {
 		WHERE p.status = 'approved'
 		AND LOWER\(p.name\) LIKE LOWER\(\$1\)
 		AND \(\$2 = '' OR ps.subcategory_id = \$2::uuid\)
-		AND \(\$3 = 0 OR p.price >= \$3\)
-		AND \(\$4 = 0 OR p.price <= \$4\)
+		AND \(\$3::numeric = 0 OR p.price >= \$3\)
+		AND \(\$4::numeric = 0 OR p.price <= \$4\)
 		AND \(\$5 = 0::FLOAT OR p.rating >= \$5::FLOAT\)
 		ORDER BY p.price ASC
 		LIMIT 20 OFFSET \$6`
@@ -192,6 +193,7 @@ func TestSearchRepository_GetProductsByNameWithFilterAndSort(t *testing.T) {
 			minPrice,
 			maxPrice,
 			minRating,
+			nil,
 			models.SortByPriceAsc,
 		)
 		require.NoError(t, err)
@@ -213,12 +215,12 @@ func TestSearchRepository_GetProductsByNameWithFilterAndSort(t *testing.T) {
 			PreviewImageURL: "image.jpg",
 			Description:     "Description",
 			Status:          models.ProductApproved,
-			Price:           1000,
+			Price:           models.Rubles(1000),
 			Quantity:        10,
 			UpdatedAt:       now,
 			Rating:          4.5,
 			ReviewsCount:    20,
-			PriceDiscount:   800,
+			PriceDiscount:   models.Rubles(800),
 		}
 
 		rows := sqlmock.NewRows([]string{
@@ -250,8 +252,8 @@ func TestSearchRepository_GetProductsByNameWithFilterAndSort(t *testing.T) {
 		WHERE p.status = 'approved'
 		AND LOWER\(p.name\) LIKE LOWER\(\$1\)
 		AND \(\$2 = '' OR ps.subcategory_id = \$2::uuid\)
-		AND \(\$3 = 0 OR p.price >= \$3\)
-		AND \(\$4 = 0 OR p.price <= \$4\)
+		AND \(\$3::numeric = 0 OR p.price >= \$3\)
+		AND \(\$4::numeric = 0 OR p.price <= \$4\)
 		AND \(\$5 = 0::FLOAT OR p.rating >= \$5::FLOAT\)
 		ORDER BY p.updated_at DESC
 		LIMIT 20 OFFSET \$6`
@@ -260,8 +262,8 @@ func TestSearchRepository_GetProductsByNameWithFilterAndSort(t *testing.T) {
 			WithArgs(
 				"%"+searchTerm+"%",
 				"",
-				0.0,
-				0.0,
+				models.Money{},
+				models.Money{},
 				float32(0.0),
 				offset,
 			).
@@ -272,9 +274,10 @@ func TestSearchRepository_GetProductsByNameWithFilterAndSort(t *testing.T) {
 			searchTerm,
 			null.String{},
 			offset,
+			models.Money{},
+			models.Money{},
 			0.0,
-			0.0,
-			0.0,
+			nil,
 			models.SortByDefault,
 		)
 		require.NoError(t, err)
@@ -301,8 +304,8 @@ func TestSearchRepository_GetProductsByNameWithFilterAndSort(t *testing.T) {
 		WHERE p.status = 'approved'
 		AND LOWER\(p.name\) LIKE LOWER\(\$1\)
 		AND \(\$2 = '' OR ps.subcategory_id = \$2::uuid\)
-		AND \(\$3 = 0 OR p.price >= \$3\)
-		AND \(\$4 = 0 OR p.price <= \$4\)
+		AND \(\$3::numeric = 0 OR p.price >= \$3\)
+		AND \(\$4::numeric = 0 OR p.price <= \$4\)
 		AND \(\$5 = 0::FLOAT OR p.rating >= \$5::FLOAT\)
 		ORDER BY p.updated_at DESC
 		LIMIT 20 OFFSET \$6`
@@ -311,8 +314,8 @@ func TestSearchRepository_GetProductsByNameWithFilterAndSort(t *testing.T) {
 			WithArgs(
 				"%"+searchTerm+"%",
 				"",
-				0.0,
-				0.0,
+				models.Money{},
+				models.Money{},
 				float32(0.0),
 				offset,
 			).
@@ -323,9 +326,10 @@ func TestSearchRepository_GetProductsByNameWithFilterAndSort(t *testing.T) {
 			searchTerm,
 			null.String{},
 			offset,
+			models.Money{},
+			models.Money{},
 			0.0,
-			0.0,
-			0.0,
+			nil,
 			models.SortByDefault,
 		)
 		require.NoError(t, err)
@@ -345,8 +349,8 @@ func TestSearchRepository_GetProductsByNameWithFilterAndSort(t *testing.T) {
 		WHERE p.status = 'approved'
 		AND LOWER\(p.name\) LIKE LOWER\(\$1\)
 		AND \(\$2 = '' OR ps.subcategory_id = \$2::uuid\)
-		AND \(\$3 = 0 OR p.price >= \$3\)
-		AND \(\$4 = 0 OR p.price <= \$4\)
+		AND \(\$3::numeric = 0 OR p.price >= \$3\)
+		AND \(\$4::numeric = 0 OR p.price <= \$4\)
 		AND \(\$5 = 0::FLOAT OR p.rating >= \$5::FLOAT\)
 		ORDER BY p.updated_at DESC
 		LIMIT 20 OFFSET \$6`
@@ -355,8 +359,8 @@ func TestSearchRepository_GetProductsByNameWithFilterAndSort(t *testing.T) {
 			WithArgs(
 				"%"+searchTerm+"%",
 				"",
-				0.0,
-				0.0,
+				models.Money{},
+				models.Money{},
 				float32(0.0),
 				offset,
 			).
@@ -367,12 +371,59 @@ func TestSearchRepository_GetProductsByNameWithFilterAndSort(t *testing.T) {
 			searchTerm,
 			null.String{},
 			offset,
+			models.Money{},
+			models.Money{},
 			0.0,
-			0.0,
-			0.0,
+			nil,
 			models.SortByDefault,
 		)
 		require.Error(t, err)
 		assert.Nil(t, products)
 	})
-}
\ No newline at end of file
+}
+func TestSearchRepository_AttributeFilters(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := search.NewSearchRepository(db)
+
+	brandID, weightID := uuid.New(), uuid.New()
+	filters := []models.AttributeFilter{
+		{AttributeID: brandID, Values: []string{"Apple", "Samsung"}},
+		{AttributeID: weightID, Min: null.FloatFrom(100), Max: null.FloatFrom(200)},
+	}
+
+	t.Run("products filtered by attributes", func(t *testing.T) {
+		mock.ExpectQuery(`AND EXISTS \(SELECT 1 FROM bazaar.product_attribute pa WHERE pa.product_id = p.id AND pa.attribute_id = \$7 AND pa.value_text = ANY\(\$8\)\)
+		AND EXISTS \(SELECT 1 FROM bazaar.product_attribute pa WHERE pa.product_id = p.id AND pa.attribute_id = \$9 AND pa.value_number >= \$10 AND pa.value_number <= \$11\)
+		ORDER BY p.updated_at DESC`).
+			WithArgs("%phone%", "", models.Money{}, models.Money{}, float32(0), 0,
+				brandID, pq.Array(filters[0].Values), weightID, 100.0, 200.0).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, err := repo.GetProductsByNameWithFilterAndSort(
+			context.Background(), "phone", null.String{}, 0, models.Money{}, models.Money{}, 0, filters, models.SortByDefault,
+		)
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("facets", func(t *testing.T) {
+		mock.ExpectQuery(`AND EXISTS \(SELECT 1 FROM bazaar.product_attribute pa WHERE pa.product_id = p.id AND pa.attribute_id = \$6 AND pa.value_text = ANY\(\$7\)\)`).
+			WithArgs("%phone%", "", models.Money{}, models.Money{}, float32(0), brandID, pq.Array(filters[0].Values)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "type", "unit", "value", "count", "min", "max"}).
+				AddRow(brandID, "Бренд", "enum", nil, "Apple", 5, nil, nil).
+				AddRow(brandID, "Бренд", "enum", nil, "Samsung", 2, nil, nil).
+				AddRow(weightID, "Вес", "number", "г", nil, 7, 120.0, 190.0))
+
+		facets, err := repo.GetAttributeFacets(context.Background(), "phone", null.String{}, models.Money{}, models.Money{}, 0, filters[:1])
+		require.NoError(t, err)
+		require.Len(t, facets, 2)
+		assert.Equal(t, []models.FacetValue{{Value: "Apple", Count: 5}, {Value: "Samsung", Count: 2}}, facets[0].Values)
+		assert.Empty(t, facets[1].Values)
+		assert.Equal(t, null.FloatFrom(120), facets[1].Min)
+		assert.Equal(t, null.FloatFrom(190), facets[1].Max)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
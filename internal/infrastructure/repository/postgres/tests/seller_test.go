@@ -4,10 +4,14 @@ import (
 	"context"
 	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	seller "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/seller"
 )
@@ -26,7 +30,7 @@ func TestSellerRepository_AddProduct(t *testing.T) {
 			SellerID:  uuid.New(),
 			Name:      "Test Product",
 			Description: "Test Description",
-			Price:     100.0,
+			Price:     models.Rubles(100),
 			Quantity:  10,
 		}
 		categoryID := uuid.New()
@@ -48,7 +52,7 @@ func TestSellerRepository_AddProduct(t *testing.T) {
 			WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectCommit()
 
-		result, err := repo.AddProduct(context.Background(), product, categoryID)
+		result, err := repo.AddProduct(context.Background(), product, categoryID, nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 		assert.NotEqual(t, uuid.Nil, result.ID)
@@ -56,12 +60,43 @@ func TestSellerRepository_AddProduct(t *testing.T) {
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
+	t.Run("SuccessWithAttributes", func(t *testing.T) {
+		product := &models.Product{
+			SellerID: uuid.New(),
+			Name:     "Test Product",
+			Price:    models.Rubles(100),
+			Quantity: 10,
+		}
+		categoryID := uuid.New()
+		attributes := []models.ProductAttribute{
+			{AttributeID: uuid.New(), Text: null.StringFrom("Apple")},
+			{AttributeID: uuid.New(), Number: null.FloatFrom(180)},
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectExec("INSERT INTO bazaar.product").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("INSERT INTO bazaar.product_subcategory").
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), categoryID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		for _, attribute := range attributes {
+			mock.ExpectExec("INSERT INTO bazaar.product_attribute").
+				WithArgs(sqlmock.AnyArg(), attribute.AttributeID, attribute.Text, attribute.Number, attribute.Bool).
+				WillReturnResult(sqlmock.NewResult(1, 1))
+		}
+		mock.ExpectCommit()
+
+		_, err := repo.AddProduct(context.Background(), product, categoryID, attributes)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
 	t.Run("InsertProductError", func(t *testing.T) {
 		product := &models.Product{
 			SellerID:  uuid.New(),
 			Name:      "Test Product",
 			Description: "Test Description",
-			Price:     100.0,
+			Price:     models.Rubles(100),
 			Quantity:  10,
 		}
 		categoryID := uuid.New()
@@ -80,7 +115,7 @@ func TestSellerRepository_AddProduct(t *testing.T) {
 			WillReturnError(sql.ErrConnDone)
 		mock.ExpectRollback()
 
-		_, err := repo.AddProduct(context.Background(), product, categoryID)
+		_, err := repo.AddProduct(context.Background(), product, categoryID, nil)
 		assert.Error(t, err)
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
@@ -90,7 +125,7 @@ func TestSellerRepository_AddProduct(t *testing.T) {
 			SellerID:  uuid.New(),
 			Name:      "Test Product",
 			Description: "Test Description",
-			Price:     100.0,
+			Price:     models.Rubles(100),
 			Quantity:  10,
 		}
 		categoryID := uuid.New()
@@ -112,7 +147,7 @@ func TestSellerRepository_AddProduct(t *testing.T) {
 			WillReturnError(sql.ErrConnDone)
 		mock.ExpectRollback()
 
-		_, err := repo.AddProduct(context.Background(), product, categoryID)
+		_, err := repo.AddProduct(context.Background(), product, categoryID, nil)
 		assert.Error(t, err)
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
@@ -173,11 +208,11 @@ func TestSellerRepository_GetSellerProducts(t *testing.T) {
 		}).
 			AddRow(
 				uuid.New(), sellerID, "Product 1", "image1.jpg", 
-				"Description 1", models.ProductApproved, 100.0, 10, 4.5, 20,
+				"Description 1", models.ProductApproved, "100.00", 10, 4.5, 20,
 			).
 			AddRow(
 				uuid.New(), sellerID, "Product 2", "image2.jpg", 
-				"Description 2", models.ProductApproved, 200.0, 20, 4.0, 15,
+				"Description 2", models.ProductApproved, "200.00", 20, 4.0, 15,
 			)
 
 		mock.ExpectQuery("SELECT id, seller_id, name, preview_image_url,").
@@ -278,4 +313,50 @@ func TestSellerRepository_CheckProductBelongs(t *testing.T) {
 		assert.Error(t, err)
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
-}
\ No newline at end of file
+}
+
+func TestSellerRepository_AddVariant(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
+	}
+	defer db.Close()
+
+	repo := seller.NewSellerRepository(db)
+
+	newVariant := func() *models.ProductVariant {
+		return &models.ProductVariant{
+			ID:        uuid.New(),
+			ProductID: uuid.New(),
+			SKU:       "TS-M-WHITE",
+			Options:   models.VariantOptions{"Размер": "M"},
+			Price:     models.Rubles(1000),
+			Quantity:  3,
+			Images:    []string{"white.png"},
+		}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		variant := newVariant()
+		now := time.Now()
+
+		mock.ExpectQuery("INSERT INTO bazaar.product_variant").
+			WithArgs(variant.ID, variant.ProductID, variant.SKU, sqlmock.AnyArg(), variant.Price, variant.Quantity, sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows([]string{"position", "updated_at"}).AddRow(2, now))
+
+		err := repo.AddVariant(context.Background(), variant)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, variant.Position)
+		assert.Equal(t, now, variant.UpdatedAt)
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		mock.ExpectQuery("INSERT INTO bazaar.product_variant").
+			WillReturnError(&pq.Error{Code: "23505"})
+
+		err := repo.AddVariant(context.Background(), newVariant())
+		assert.ErrorIs(t, err, errs.ErrAlreadyExists)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,228 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/warehouse"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarehouseRepository_AssignOrder(t *testing.T) {
+	orderID := uuid.New()
+	warehouseID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := warehouse.NewWarehouseRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("SELECT status, warehouse_id FROM bazaar.\"order\" WHERE id = \\$1 FOR UPDATE").
+			WithArgs(orderID).
+			WillReturnRows(sqlmock.NewRows([]string{"status", "warehouse_id"}).AddRow("placed", nil))
+		mock.ExpectQuery("UPDATE bazaar.warehouse_stock ws SET quantity = ws.quantity - n.quantity").
+			WithArgs(orderID, warehouseID).
+			WillReturnRows(sqlmock.NewRows([]string{"taken", "needed"}).AddRow(2, 2))
+		mock.ExpectExec("UPDATE bazaar.\"order\" SET warehouse_id = \\$2").
+			WithArgs(orderID, warehouseID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, repo.AssignOrder(context.Background(), orderID, warehouseID))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("stock taken concurrently", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := warehouse.NewWarehouseRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("FOR UPDATE").
+			WithArgs(orderID).
+			WillReturnRows(sqlmock.NewRows([]string{"status", "warehouse_id"}).AddRow("placed", nil))
+		mock.ExpectQuery("UPDATE bazaar.warehouse_stock").
+			WithArgs(orderID, warehouseID).
+			WillReturnRows(sqlmock.NewRows([]string{"taken", "needed"}).AddRow(1, 2))
+		mock.ExpectRollback()
+
+		err = repo.AssignOrder(context.Background(), orderID, warehouseID)
+		assert.ErrorIs(t, err, errs.ErrNotEnoughStock)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("already assigned", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := warehouse.NewWarehouseRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("FOR UPDATE").
+			WithArgs(orderID).
+			WillReturnRows(sqlmock.NewRows([]string{"status", "warehouse_id"}).AddRow("placed", uuid.New()))
+		mock.ExpectRollback()
+
+		err = repo.AssignOrder(context.Background(), orderID, warehouseID)
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not placed", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := warehouse.NewWarehouseRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery("FOR UPDATE").
+			WithArgs(orderID).
+			WillReturnRows(sqlmock.NewRows([]string{"status", "warehouse_id"}).AddRow("canceled_by_seller", nil))
+		mock.ExpectRollback()
+
+		err = repo.AssignOrder(context.Background(), orderID, warehouseID)
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestWarehouseRepository_GetRouteCandidates(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := warehouse.NewWarehouseRepository(db)
+	orderID := uuid.New()
+	near := uuid.New()
+	far := uuid.New()
+
+	mock.ExpectQuery("SELECT a.coordinate FROM bazaar.\"order\" o").
+		WithArgs(orderID).
+		WillReturnRows(sqlmock.NewRows([]string{"coordinate"}).AddRow("55.75,37.61"))
+	mock.ExpectQuery("ws.quantity >= n.quantity").
+		WithArgs(orderID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "coordinate"}).
+			AddRow(far, "59.93,30.36").
+			AddRow(near, "55.79,37.70"))
+
+	destination, candidates, err := repo.GetRouteCandidates(context.Background(), orderID)
+	require.NoError(t, err)
+	assert.Equal(t, "55.75,37.61", destination)
+	require.Len(t, candidates, 2)
+	assert.Equal(t, near, candidates[1].WarehouseID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWarehouseRepository_SetStock(t *testing.T) {
+	warehouseID := uuid.New()
+	productID := uuid.New()
+
+	t.Run("sku stock", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := warehouse.NewWarehouseRepository(db)
+		variantID := uuid.NullUUID{UUID: uuid.New(), Valid: true}
+
+		mock.ExpectQuery("FROM bazaar.product p WHERE p.id = \\$1").
+			WithArgs(productID, variantID).
+			WillReturnRows(sqlmock.NewRows([]string{"has_variants", "variant_found"}).AddRow(true, true))
+		mock.ExpectExec("INSERT INTO bazaar.warehouse_stock").
+			WithArgs(warehouseID, productID, variantID, 7).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		require.NoError(t, repo.SetStock(context.Background(), warehouseID, productID, variantID, 7))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("product with variants", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := warehouse.NewWarehouseRepository(db)
+
+		mock.ExpectQuery("FROM bazaar.product p WHERE p.id = \\$1").
+			WithArgs(productID, uuid.NullUUID{}).
+			WillReturnRows(sqlmock.NewRows([]string{"has_variants", "variant_found"}).AddRow(true, false))
+
+		err = repo.SetStock(context.Background(), warehouseID, productID, uuid.NullUUID{}, 7)
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("foreign variant", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		repo := warehouse.NewWarehouseRepository(db)
+		variantID := uuid.NullUUID{UUID: uuid.New(), Valid: true}
+
+		mock.ExpectQuery("FROM bazaar.product p WHERE p.id = \\$1").
+			WithArgs(productID, variantID).
+			WillReturnRows(sqlmock.NewRows([]string{"has_variants", "variant_found"}).AddRow(false, false))
+
+		err = repo.SetStock(context.Background(), warehouseID, productID, variantID, 7)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestWarehouseRepository_AssignStaff(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := warehouse.NewWarehouseRepository(db)
+	userID := uuid.New()
+	warehouseID := uuid.NullUUID{UUID: uuid.New(), Valid: true}
+
+	mock.ExpectExec("UPDATE bazaar.\"user\" SET warehouse_id = \\$2 WHERE id = \\$1 AND role = 'warehouseman'").
+		WithArgs(userID, warehouseID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.AssignStaff(context.Background(), userID, warehouseID)
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWarehouseRepository_GetPickList(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := warehouse.NewWarehouseRepository(db)
+	warehouseID := uuid.New()
+	productID := uuid.New()
+	variantID := uuid.New()
+
+	mock.ExpectQuery("WHERE o.warehouse_id = \\$1 AND o.status = 'placed'").
+		WithArgs(warehouseID).
+		WillReturnRows(sqlmock.NewRows([]string{"product_id", "variant_id", "name", "sku", "options", "quantity", "orders"}).
+			AddRow(productID, variantID, "Футболка", "TS-M", []byte(`{"Размер": "M"}`), 5, 3).
+			AddRow(uuid.New(), nil, "Кружка", "", nil, 1, 1))
+
+	items, err := repo.GetPickList(context.Background(), warehouseID)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, variantID, items[0].VariantID.UUID)
+	assert.Equal(t, models.VariantOptions{"Размер": "M"}, items[0].Options)
+	assert.Equal(t, 5, items[0].Quantity)
+	assert.Equal(t, 3, items[0].Orders)
+	assert.False(t, items[1].VariantID.Valid)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
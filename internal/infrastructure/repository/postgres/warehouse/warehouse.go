@@ -0,0 +1,451 @@
+package warehouse
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/google/uuid"
+)
+
+const stockPageSize = 20
+
+const (
+	queryGetWarehouses = `
+		SELECT w.id, w.name, w.created_at, a.id, a.region, a.city, a.address_string, a.coordinate
+		FROM bazaar.warehouse w
+		JOIN bazaar.address a ON a.id = w.address_id
+		ORDER BY w.created_at`
+
+	// Адрес склада может совпадать с уже сохранённым адресом, тогда используется существующая запись
+	queryUpsertWarehouseAddress = `
+		INSERT INTO bazaar.address (id, region, city, address_string, coordinate)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (address_string, coordinate) DO UPDATE SET address_string = EXCLUDED.address_string
+		RETURNING id`
+
+	queryCreateWarehouse = `
+		INSERT INTO bazaar.warehouse (id, name, address_id)
+		VALUES ($1, $2, $3)
+		RETURNING created_at`
+
+	// Открепить работника можно всегда, прикрепить — только к существующему складу
+	queryAssignStaff = `
+		UPDATE bazaar."user"
+		SET warehouse_id = $2
+		WHERE id = $1 AND role = 'warehouseman'
+			AND ($2::uuid IS NULL OR EXISTS (SELECT 1 FROM bazaar.warehouse WHERE id = $2))`
+
+	queryGetStaffWarehouse = `SELECT warehouse_id FROM bazaar."user" WHERE id = $1`
+
+	queryGetStockUnit = `
+		SELECT
+			EXISTS (SELECT 1 FROM bazaar.product_variant v WHERE v.product_id = p.id),
+			EXISTS (SELECT 1 FROM bazaar.product_variant v WHERE v.product_id = p.id AND v.id = $2)
+		FROM bazaar.product p
+		WHERE p.id = $1`
+
+	queryUpsertStock = `
+		INSERT INTO bazaar.warehouse_stock (warehouse_id, product_id, variant_id, quantity)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (warehouse_id, product_id, variant_id)
+		DO UPDATE SET quantity = EXCLUDED.quantity, updated_at = now()`
+
+	queryGetStock = `
+		SELECT ws.product_id, ws.variant_id, p.name, COALESCE(v.sku, p.sku, ''), ws.quantity, ws.updated_at
+		FROM bazaar.warehouse_stock ws
+		JOIN bazaar.product p ON p.id = ws.product_id
+		LEFT JOIN bazaar.product_variant v ON v.id = ws.variant_id
+		WHERE ws.warehouse_id = $1
+		ORDER BY p.name, ws.product_id, v.sku
+		LIMIT $2 OFFSET $3`
+
+	// Позиции отменённых продавцами отправлений собирать не нужно
+	queryGetPickList = `
+		SELECT oi.product_id, oi.variant_id, p.name, COALESCE(v.sku, p.sku, ''), v.options,
+			SUM(oi.quantity), COUNT(DISTINCT o.id)
+		FROM bazaar."order" o
+		JOIN bazaar.order_item oi ON oi.order_id = o.id
+		LEFT JOIN bazaar.order_shipment s ON s.id = oi.shipment_id
+		JOIN bazaar.product p ON p.id = oi.product_id
+		LEFT JOIN bazaar.product_variant v ON v.id = oi.variant_id
+		WHERE o.warehouse_id = $1 AND o.status = 'placed'
+			AND (s.status IS NULL OR s.status <> 'canceled_by_seller')
+		GROUP BY oi.product_id, oi.variant_id, p.name, p.sku, v.sku, v.options
+		ORDER BY p.name, oi.product_id, v.sku`
+
+	// orderNeed — сколько единиц каждого товара и SKU нужно собрать по заказу $1
+	orderNeed = `
+		WITH need AS (
+			SELECT oi.product_id, oi.variant_id, SUM(oi.quantity) AS quantity
+			FROM bazaar.order_item oi
+			LEFT JOIN bazaar.order_shipment s ON s.id = oi.shipment_id
+			WHERE oi.order_id = $1 AND (s.status IS NULL OR s.status <> 'canceled_by_seller')
+			GROUP BY oi.product_id, oi.variant_id
+		)`
+
+	queryGetOrderDestination = `
+		SELECT a.coordinate
+		FROM bazaar."order" o
+		LEFT JOIN bazaar.address a ON a.id = o.address_id
+		WHERE o.id = $1`
+
+	// Склады, на которых хватает каждой позиции заказа целиком
+	queryGetRouteCandidates = orderNeed + `
+		SELECT w.id, a.coordinate
+		FROM bazaar.warehouse w
+		JOIN bazaar.address a ON a.id = w.address_id
+		WHERE NOT EXISTS (
+			SELECT 1 FROM need n
+			WHERE NOT EXISTS (
+				SELECT 1 FROM bazaar.warehouse_stock ws
+				WHERE ws.warehouse_id = w.id AND ws.product_id = n.product_id
+					AND ws.variant_id IS NOT DISTINCT FROM n.variant_id AND ws.quantity >= n.quantity
+			)
+		)`
+
+	queryLockOrder = `SELECT status, warehouse_id FROM bazaar."order" WHERE id = $1 FOR UPDATE`
+
+	// Остаток списывается условным UPDATE: если параллельный заказ успел забрать
+	// товар, списанных строк окажется меньше, чем позиций заказа
+	queryTakeWarehouseStock = orderNeed + `, taken AS (
+			UPDATE bazaar.warehouse_stock ws
+			SET quantity = ws.quantity - n.quantity, updated_at = now()
+			FROM need n
+			WHERE ws.warehouse_id = $2 AND ws.product_id = n.product_id
+				AND ws.variant_id IS NOT DISTINCT FROM n.variant_id AND ws.quantity >= n.quantity
+			RETURNING ws.product_id
+		)
+		SELECT (SELECT COUNT(*) FROM taken), (SELECT COUNT(*) FROM need)`
+
+	queryAssignOrder = `UPDATE bazaar."order" SET warehouse_id = $2, updated_at = now() WHERE id = $1`
+)
+
+type WarehouseRepository struct {
+	db *sql.DB
+}
+
+func NewWarehouseRepository(db *sql.DB) *WarehouseRepository {
+	return &WarehouseRepository{
+		db: db,
+	}
+}
+
+func (r *WarehouseRepository) GetWarehouses(ctx context.Context) ([]models.Warehouse, error) {
+	const op = "WarehouseRepository.GetWarehouses"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	rows, err := r.db.QueryContext(ctx, queryGetWarehouses)
+	if err != nil {
+		logger.WithError(err).Error("query warehouses")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	warehouses := []models.Warehouse{}
+	for rows.Next() {
+		var warehouse models.Warehouse
+		if err = rows.Scan(
+			&warehouse.ID,
+			&warehouse.Name,
+			&warehouse.CreatedAt,
+			&warehouse.Address.ID,
+			&warehouse.Address.Region,
+			&warehouse.Address.City,
+			&warehouse.Address.AddressString,
+			&warehouse.Address.Coordinate,
+		); err != nil {
+			logger.WithError(err).Error("scan warehouse")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		warehouses = append(warehouses, warehouse)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return warehouses, nil
+}
+
+// CreateWarehouse сохраняет склад вместе с адресом и возвращает его с итоговым ID адреса
+func (r *WarehouseRepository) CreateWarehouse(ctx context.Context, warehouse models.Warehouse) (models.Warehouse, error) {
+	const op = "WarehouseRepository.CreateWarehouse"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.WithError(err).Error("begin transaction")
+		return models.Warehouse{}, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	if err = tx.QueryRowContext(ctx, queryUpsertWarehouseAddress,
+		warehouse.Address.ID,
+		warehouse.Address.Region,
+		warehouse.Address.City,
+		warehouse.Address.AddressString,
+		warehouse.Address.Coordinate,
+	).Scan(&warehouse.Address.ID); err != nil {
+		logger.WithError(err).Error("upsert warehouse address")
+		return models.Warehouse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.QueryRowContext(ctx, queryCreateWarehouse,
+		warehouse.ID, warehouse.Name, warehouse.Address.ID,
+	).Scan(&warehouse.CreatedAt); err != nil {
+		logger.WithError(err).Error("create warehouse")
+		return models.Warehouse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.WithError(err).Error("commit transaction")
+		return models.Warehouse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return warehouse, nil
+}
+
+// AssignStaff прикрепляет работника склада к складу; пустой warehouseID открепляет его
+func (r *WarehouseRepository) AssignStaff(ctx context.Context, userID uuid.UUID, warehouseID uuid.NullUUID) error {
+	const op = "WarehouseRepository.AssignStaff"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("user_id", userID)
+
+	res, err := r.db.ExecContext(ctx, queryAssignStaff, userID, warehouseID)
+	if err != nil {
+		logger.WithError(err).Error("assign staff")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("%s: %w", op, errs.NewNotFoundError("warehouseman or warehouse not found"))
+	}
+
+	return nil
+}
+
+// GetStaffWarehouse возвращает склад, к которому прикреплён пользователь
+func (r *WarehouseRepository) GetStaffWarehouse(ctx context.Context, userID uuid.UUID) (uuid.NullUUID, error) {
+	const op = "WarehouseRepository.GetStaffWarehouse"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("user_id", userID)
+
+	var warehouseID uuid.NullUUID
+	if err := r.db.QueryRowContext(ctx, queryGetStaffWarehouse, userID).Scan(&warehouseID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.NullUUID{}, fmt.Errorf("%s: %w", op, errs.NewNotFoundError("user not found"))
+		}
+		logger.WithError(err).Error("query staff warehouse")
+		return uuid.NullUUID{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return warehouseID, nil
+}
+
+// SetStock задаёт остаток товара или SKU на складе. У товара с вариантами
+// остаток ведётся только по SKU.
+func (r *WarehouseRepository) SetStock(ctx context.Context, warehouseID, productID uuid.UUID, variantID uuid.NullUUID, quantity int) error {
+	const op = "WarehouseRepository.SetStock"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("product_id", productID)
+
+	var hasVariants, variantFound bool
+	if err := r.db.QueryRowContext(ctx, queryGetStockUnit, productID, variantID).Scan(&hasVariants, &variantFound); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%s: %w", op, errs.NewNotFoundError("product not found"))
+		}
+		logger.WithError(err).Error("query stock unit")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if hasVariants && !variantID.Valid {
+		return fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("product has variants, variant_id is required"))
+	}
+	if variantID.Valid && !variantFound {
+		return fmt.Errorf("%s: %w", op, errs.NewNotFoundError("product variant not found"))
+	}
+
+	if _, err := r.db.ExecContext(ctx, queryUpsertStock, warehouseID, productID, variantID, quantity); err != nil {
+		logger.WithError(err).Error("upsert warehouse stock")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetStock возвращает страницу остатков склада
+func (r *WarehouseRepository) GetStock(ctx context.Context, warehouseID uuid.UUID, offset int) ([]models.WarehouseStock, error) {
+	const op = "WarehouseRepository.GetStock"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("warehouse_id", warehouseID)
+
+	rows, err := r.db.QueryContext(ctx, queryGetStock, warehouseID, stockPageSize, offset)
+	if err != nil {
+		logger.WithError(err).Error("query warehouse stock")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	stock := []models.WarehouseStock{}
+	for rows.Next() {
+		var unit models.WarehouseStock
+		if err = rows.Scan(
+			&unit.ProductID,
+			&unit.VariantID,
+			&unit.Name,
+			&unit.SKU,
+			&unit.Quantity,
+			&unit.UpdatedAt,
+		); err != nil {
+			logger.WithError(err).Error("scan warehouse stock")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		stock = append(stock, unit)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return stock, nil
+}
+
+// GetPickList суммирует позиции оформленных заказов склада по товарам и SKU
+func (r *WarehouseRepository) GetPickList(ctx context.Context, warehouseID uuid.UUID) ([]models.PickListItem, error) {
+	const op = "WarehouseRepository.GetPickList"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("warehouse_id", warehouseID)
+
+	rows, err := r.db.QueryContext(ctx, queryGetPickList, warehouseID)
+	if err != nil {
+		logger.WithError(err).Error("query pick list")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	items := []models.PickListItem{}
+	for rows.Next() {
+		var item models.PickListItem
+		if err = rows.Scan(
+			&item.ProductID,
+			&item.VariantID,
+			&item.Name,
+			&item.SKU,
+			&item.Options,
+			&item.Quantity,
+			&item.Orders,
+		); err != nil {
+			logger.WithError(err).Error("scan pick list item")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		items = append(items, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return items, nil
+}
+
+// GetRouteCandidates возвращает координату адреса доставки заказа и склады,
+// на которых хватает товара для всего заказа
+func (r *WarehouseRepository) GetRouteCandidates(ctx context.Context, orderID uuid.UUID) (string, []models.RouteCandidate, error) {
+	const op = "WarehouseRepository.GetRouteCandidates"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("order_id", orderID)
+
+	var destination sql.NullString
+	if err := r.db.QueryRowContext(ctx, queryGetOrderDestination, orderID).Scan(&destination); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil, fmt.Errorf("%s: %w", op, errs.NewNotFoundError("order not found"))
+		}
+		logger.WithError(err).Error("query order destination")
+		return "", nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, queryGetRouteCandidates, orderID)
+	if err != nil {
+		logger.WithError(err).Error("query route candidates")
+		return "", nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	var candidates []models.RouteCandidate
+	for rows.Next() {
+		var candidate models.RouteCandidate
+		if err = rows.Scan(&candidate.WarehouseID, &candidate.Coordinate); err != nil {
+			logger.WithError(err).Error("scan route candidate")
+			return "", nil, fmt.Errorf("%s: %w", op, err)
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	if err = rows.Err(); err != nil {
+		logger.WithError(err).Error("rows iteration error")
+		return "", nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return destination.String, candidates, nil
+}
+
+// AssignOrder направляет оформленный заказ на склад и списывает его позиции
+// из остатка склада. Если товара на складе уже не хватает, возвращает
+// errs.ErrNotEnoughStock и ничего не меняет.
+func (r *WarehouseRepository) AssignOrder(ctx context.Context, orderID, warehouseID uuid.UUID) error {
+	const op = "WarehouseRepository.AssignOrder"
+	logger := logctx.GetLogger(ctx).WithField("op", op).
+		WithField("order_id", orderID).
+		WithField("warehouse_id", warehouseID)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.WithError(err).Error("begin transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback()
+
+	var (
+		status   string
+		assigned uuid.NullUUID
+	)
+	if err = tx.QueryRowContext(ctx, queryLockOrder, orderID).Scan(&status, &assigned); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%s: %w", op, errs.NewNotFoundError("order not found"))
+		}
+		logger.WithError(err).Error("lock order")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if assigned.Valid {
+		return fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("order is already assigned to a warehouse"))
+	}
+	if status != models.Placed.String() {
+		return fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("order cannot be routed in status "+status))
+	}
+
+	var taken, needed int
+	if err = tx.QueryRowContext(ctx, queryTakeWarehouseStock, orderID, warehouseID).Scan(&taken, &needed); err != nil {
+		logger.WithError(err).Error("take warehouse stock")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if taken < needed {
+		return fmt.Errorf("%s: %w", op, errs.ErrNotEnoughStock)
+	}
+
+	if _, err = tx.ExecContext(ctx, queryAssignOrder, orderID, warehouseID); err != nil {
+		logger.WithError(err).Error("assign order")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logger.WithError(err).Error("commit transaction")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
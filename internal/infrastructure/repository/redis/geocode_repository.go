@@ -0,0 +1,64 @@
+package redis
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/redis/go-redis/v9"
+)
+
+type GeocodeRepository struct {
+	client *Client
+	ttl    time.Duration
+}
+
+func NewGeocodeRepository(client *Client, ttl time.Duration) *GeocodeRepository {
+	return &GeocodeRepository{
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+// Get возвращает закэшированный адрес; при промахе возвращает nil без ошибки
+func (r *GeocodeRepository) Get(ctx context.Context, key string) (*models.GeocodedAddress, error) {
+	data, err := r.client.Get(ctx, geocodeKey(key)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get geocoded address: %w", err)
+	}
+
+	var address models.GeocodedAddress
+	if err := json.Unmarshal(data, &address); err != nil {
+		return nil, fmt.Errorf("failed to decode geocoded address: %w", err)
+	}
+
+	return &address, nil
+}
+
+// Set сохраняет адрес на время жизни ttl
+func (r *GeocodeRepository) Set(ctx context.Context, key string, address *models.GeocodedAddress) error {
+	data, err := json.Marshal(address)
+	if err != nil {
+		return fmt.Errorf("failed to encode geocoded address: %w", err)
+	}
+
+	if err := r.client.Set(ctx, geocodeKey(key), data, r.ttl).Err(); err != nil {
+		return fmt.Errorf("failed to set geocoded address: %w", err)
+	}
+
+	return nil
+}
+
+// geocodeKey хэширует запрос, чтобы длинные адреса не раздували ключи
+func geocodeKey(key string) string {
+	sum := sha1.Sum([]byte(key))
+	return fmt.Sprintf("geocode:%s", hex.EncodeToString(sum[:]))
+}
@@ -0,0 +1,236 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/google/uuid"
+)
+
+func guestBasketKey(guestID uuid.UUID) string {
+	return fmt.Sprintf("basket:guest:%s", guestID)
+}
+
+// guestBasketField — поле хеша для позиции: product_id или product_id:variant_id
+func guestBasketField(productID uuid.UUID, variantID uuid.NullUUID) string {
+	if variantID.Valid {
+		return productID.String() + ":" + variantID.UUID.String()
+	}
+	return productID.String()
+}
+
+// parseGuestBasketField разбирает поле хеша гостевой корзины
+func parseGuestBasketField(field string) (models.StockKey, error) {
+	productPart, variantPart, hasVariant := strings.Cut(field, ":")
+
+	productID, err := uuid.Parse(productPart)
+	if err != nil {
+		return models.StockKey{}, err
+	}
+
+	key := models.StockKey{ProductID: productID}
+	if hasVariant {
+		variantID, err := uuid.Parse(variantPart)
+		if err != nil {
+			return models.StockKey{}, err
+		}
+		key.VariantID = uuid.NullUUID{UUID: variantID, Valid: true}
+	}
+
+	return key, nil
+}
+
+// BasketItemSource отдаёт данные товаров и SKU для позиций гостевой корзины
+type BasketItemSource interface {
+	GetBasketItems(ctx context.Context, items []models.PricingItem) ([]*models.BasketItem, error)
+}
+
+// GuestBasketRepository хранит корзину анонимного посетителя в хеше
+// product_id[:variant_id] -> количество. Вместо идентификатора пользователя
+// методы принимают идентификатор гостевой корзины.
+type GuestBasketRepository struct {
+	client   *Client
+	products BasketItemSource
+	ttl      time.Duration
+}
+
+func NewGuestBasketRepository(client *Client, products BasketItemSource, ttl time.Duration) *GuestBasketRepository {
+	return &GuestBasketRepository{
+		client:   client,
+		products: products,
+		ttl:      ttl,
+	}
+}
+
+func (r *GuestBasketRepository) Get(ctx context.Context, guestID uuid.UUID) ([]*models.BasketItem, error) {
+	quantities, err := r.client.HGetAll(ctx, guestBasketKey(guestID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guest basket: %w", err)
+	}
+
+	items := []*models.BasketItem{}
+	if len(quantities) == 0 {
+		return items, nil
+	}
+
+	keys := make([]models.PricingItem, 0, len(quantities))
+	for field, value := range quantities {
+		key, err := parseGuestBasketField(field)
+		if err != nil {
+			continue
+		}
+		quantity, err := strconv.Atoi(value)
+		if err != nil || quantity <= 0 {
+			continue
+		}
+		keys = append(keys, models.PricingItem{
+			ProductID: key.ProductID,
+			VariantID: key.VariantID,
+			Quantity:  uint(quantity),
+		})
+	}
+
+	// Товары, снятые с продажи, не попадают в корзину, как и у пользователя
+	items, err = r.products.GetBasketItems(ctx, keys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get guest basket products: %w", err)
+	}
+
+	for _, item := range items {
+		item.ID = item.ProductID
+		if item.VariantID.Valid {
+			item.ID = item.VariantID.UUID
+		}
+		item.BasketID = guestID
+	}
+
+	return items, nil
+}
+
+// Add увеличивает количество на единицу, не выходя за остаток товара или SKU.
+// Инкремент сверх остатка откатывается, так что параллельные запросы тоже его не превысят
+func (r *GuestBasketRepository) Add(ctx context.Context, guestID uuid.UUID, productID uuid.UUID, variantID uuid.NullUUID) (*models.BasketItem, error) {
+	item, err := r.getItem(ctx, productID, variantID)
+	if err != nil {
+		return nil, err
+	}
+
+	key := guestBasketKey(guestID)
+	field := guestBasketField(productID, variantID)
+	quantity, err := r.client.HIncrBy(ctx, key, field, 1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to add product to guest basket: %w", err)
+	}
+
+	if quantity > int64(item.QuantityRemain) {
+		// Товара, которого нет в наличии, в корзине остаться не должно
+		if quantity == 1 {
+			err = r.client.HDel(ctx, key, field).Err()
+		} else {
+			err = r.client.HIncrBy(ctx, key, field, -1).Err()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to roll back guest basket: %w", err)
+		}
+		return nil, errs.NewBusinessLogicError("requested quantity exceeds available stock")
+	}
+
+	if err = r.client.Expire(ctx, key, r.ttl).Err(); err != nil {
+		return nil, fmt.Errorf("failed to extend guest basket: %w", err)
+	}
+
+	return &models.BasketItem{
+		ID:             productID,
+		BasketID:       guestID,
+		ProductID:      productID,
+		VariantID:      variantID,
+		Quantity:       int(quantity),
+		UpdatedAt:      time.Now(),
+		QuantityRemain: item.QuantityRemain - int(quantity),
+	}, nil
+}
+
+func (r *GuestBasketRepository) Delete(ctx context.Context, guestID uuid.UUID, productID uuid.UUID, variantID uuid.NullUUID) error {
+	deleted, err := r.client.HDel(ctx, guestBasketKey(guestID), guestBasketField(productID, variantID)).Result()
+	if err != nil {
+		return fmt.Errorf("failed to delete product from guest basket: %w", err)
+	}
+
+	if deleted == 0 {
+		return errs.NewNotFoundError("product not found in basket")
+	}
+
+	return nil
+}
+
+func (r *GuestBasketRepository) UpdateQuantity(ctx context.Context, guestID uuid.UUID, productID uuid.UUID, variantID uuid.NullUUID, quantity int) (*models.BasketItem, error) {
+	item, err := r.getItem(ctx, productID, variantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if quantity > item.QuantityRemain {
+		return nil, errs.NewBusinessLogicError("requested quantity exceeds available stock")
+	}
+
+	key := guestBasketKey(guestID)
+	field := guestBasketField(productID, variantID)
+	exists, err := r.client.HExists(ctx, key, field).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to check guest basket: %w", err)
+	}
+	if !exists {
+		return nil, errs.NewNotFoundError("product not found in basket")
+	}
+
+	if err = r.client.HSet(ctx, key, field, quantity).Err(); err != nil {
+		return nil, fmt.Errorf("failed to update guest basket: %w", err)
+	}
+
+	if err = r.client.Expire(ctx, key, r.ttl).Err(); err != nil {
+		return nil, fmt.Errorf("failed to extend guest basket: %w", err)
+	}
+
+	return &models.BasketItem{
+		ID:             productID,
+		BasketID:       guestID,
+		ProductID:      productID,
+		VariantID:      variantID,
+		Quantity:       quantity,
+		UpdatedAt:      time.Now(),
+		QuantityRemain: item.QuantityRemain - quantity,
+	}, nil
+}
+
+func (r *GuestBasketRepository) Clear(ctx context.Context, guestID uuid.UUID) error {
+	if err := r.client.Del(ctx, guestBasketKey(guestID)).Err(); err != nil {
+		return fmt.Errorf("failed to clear guest basket: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateTotals ничего не делает: суммы гостевой корзины не хранятся и
+// считаются при каждом запросе
+func (r *GuestBasketRepository) UpdateTotals(ctx context.Context, guestID uuid.UUID, totalPrice, totalPriceDiscount models.Money) error {
+	return nil
+}
+
+// getItem возвращает данные товара или SKU; QuantityRemain равен всему остатку
+func (r *GuestBasketRepository) getItem(ctx context.Context, productID uuid.UUID, variantID uuid.NullUUID) (*models.BasketItem, error) {
+	items, err := r.products.GetBasketItems(ctx, []models.PricingItem{{ProductID: productID, VariantID: variantID}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product: %w", err)
+	}
+
+	if len(items) == 0 {
+		return nil, errs.NewNotFoundError("product not found")
+	}
+
+	return items[0], nil
+}
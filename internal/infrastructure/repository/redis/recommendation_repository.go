@@ -0,0 +1,60 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+type RecommendationRepository struct {
+	client *Client
+	ttl    time.Duration
+}
+
+func NewRecommendationRepository(client *Client, ttl time.Duration) *RecommendationRepository {
+	return &RecommendationRepository{
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+// GetProductIDs возвращает закэшированную подборку; при промахе возвращает nil без ошибки
+func (r *RecommendationRepository) GetProductIDs(ctx context.Context, key string) ([]uuid.UUID, error) {
+	data, err := r.client.Get(ctx, recommendationKey(key)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get recommendations: %w", err)
+	}
+
+	var ids []uuid.UUID
+	if err := json.Unmarshal(data, &ids); err != nil {
+		return nil, fmt.Errorf("failed to decode recommendations: %w", err)
+	}
+
+	return ids, nil
+}
+
+// SetProductIDs сохраняет подборку на время жизни ttl
+func (r *RecommendationRepository) SetProductIDs(ctx context.Context, key string, ids []uuid.UUID) error {
+	data, err := json.Marshal(ids)
+	if err != nil {
+		return fmt.Errorf("failed to encode recommendations: %w", err)
+	}
+
+	if err := r.client.Set(ctx, recommendationKey(key), data, r.ttl).Err(); err != nil {
+		return fmt.Errorf("failed to set recommendations: %w", err)
+	}
+
+	return nil
+}
+
+func recommendationKey(key string) string {
+	return fmt.Sprintf("recommendation:%s", key)
+}
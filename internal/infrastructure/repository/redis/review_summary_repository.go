@@ -0,0 +1,70 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+func reviewSummaryKey(productID uuid.UUID) string {
+	return fmt.Sprintf("review:summary:%s", productID)
+}
+
+type ReviewSummaryRepository struct {
+	client *Client
+	ttl    time.Duration
+}
+
+func NewReviewSummaryRepository(client *Client, ttl time.Duration) *ReviewSummaryRepository {
+	return &ReviewSummaryRepository{
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+// GetSummary возвращает сводку из кэша; при промахе возвращает nil без ошибки
+func (r *ReviewSummaryRepository) GetSummary(ctx context.Context, productID uuid.UUID) (*models.ReviewSummary, error) {
+	data, err := r.client.Get(ctx, reviewSummaryKey(productID)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get review summary: %w", err)
+	}
+
+	var summary models.ReviewSummary
+	if err := json.Unmarshal(data, &summary); err != nil {
+		return nil, fmt.Errorf("failed to decode review summary: %w", err)
+	}
+
+	return &summary, nil
+}
+
+// SetSummary сохраняет сводку в кэш на время жизни ttl
+func (r *ReviewSummaryRepository) SetSummary(ctx context.Context, summary *models.ReviewSummary) error {
+	data, err := json.Marshal(summary)
+	if err != nil {
+		return fmt.Errorf("failed to encode review summary: %w", err)
+	}
+
+	if err := r.client.Set(ctx, reviewSummaryKey(summary.ProductID), data, r.ttl).Err(); err != nil {
+		return fmt.Errorf("failed to set review summary: %w", err)
+	}
+
+	return nil
+}
+
+// InvalidateSummary удаляет сводку товара из кэша
+func (r *ReviewSummaryRepository) InvalidateSummary(ctx context.Context, productID uuid.UUID) error {
+	if err := r.client.Del(ctx, reviewSummaryKey(productID)).Err(); err != nil {
+		return fmt.Errorf("failed to invalidate review summary: %w", err)
+	}
+
+	return nil
+}
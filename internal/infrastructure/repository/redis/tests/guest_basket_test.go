@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/redis"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/google/uuid"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// hashHook подменяет сервер Redis: хеш-команды выполняются над map в памяти
+type hashHook struct {
+	hashes map[string]map[string]int64
+}
+
+func (h *hashHook) DialHook(next goredis.DialHook) goredis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return next(ctx, network, addr)
+	}
+}
+
+func (h *hashHook) ProcessHook(next goredis.ProcessHook) goredis.ProcessHook {
+	return func(ctx context.Context, cmd goredis.Cmder) error {
+		args := cmd.Args()
+		key := args[1].(string)
+		if h.hashes[key] == nil {
+			h.hashes[key] = map[string]int64{}
+		}
+		hash := h.hashes[key]
+
+		switch cmd.Name() {
+		case "hincrby":
+			field := args[2].(string)
+			hash[field] += args[3].(int64)
+			cmd.(*goredis.IntCmd).SetVal(hash[field])
+		case "hdel":
+			field := args[2].(string)
+			_, ok := hash[field]
+			delete(hash, field)
+			if ok {
+				cmd.(*goredis.IntCmd).SetVal(1)
+			}
+		case "expire":
+			cmd.(*goredis.BoolCmd).SetVal(true)
+		default:
+			return next(ctx, cmd)
+		}
+		return nil
+	}
+}
+
+func (h *hashHook) ProcessPipelineHook(next goredis.ProcessPipelineHook) goredis.ProcessPipelineHook {
+	return next
+}
+
+type stockSource struct {
+	stock int
+}
+
+func (s stockSource) GetBasketItems(_ context.Context, items []models.PricingItem) ([]*models.BasketItem, error) {
+	return []*models.BasketItem{{ProductID: items[0].ProductID, VariantID: items[0].VariantID, QuantityRemain: s.stock}}, nil
+}
+
+func newGuestBasketRepository(stock int) (*redis.GuestBasketRepository, *hashHook) {
+	hook := &hashHook{hashes: map[string]map[string]int64{}}
+	client := goredis.NewClient(&goredis.Options{Addr: "localhost:0"})
+	client.AddHook(hook)
+
+	return redis.NewGuestBasketRepository(&redis.Client{Client: client}, stockSource{stock: stock}, 0), hook
+}
+
+func TestGuestBasketRepository_Add(t *testing.T) {
+	ctx := context.Background()
+	guestID, productID := uuid.New(), uuid.New()
+	key := "basket:guest:" + guestID.String()
+
+	t.Run("within stock", func(t *testing.T) {
+		repo, hook := newGuestBasketRepository(2)
+
+		for want := 1; want <= 2; want++ {
+			item, err := repo.Add(ctx, guestID, productID, uuid.NullUUID{})
+			require.NoError(t, err)
+			assert.Equal(t, want, item.Quantity)
+			assert.Equal(t, 2-want, item.QuantityRemain)
+		}
+		assert.Equal(t, int64(2), hook.hashes[key][productID.String()])
+	})
+
+	t.Run("exceeding stock is rolled back", func(t *testing.T) {
+		repo, hook := newGuestBasketRepository(1)
+
+		_, err := repo.Add(ctx, guestID, productID, uuid.NullUUID{})
+		require.NoError(t, err)
+
+		_, err = repo.Add(ctx, guestID, productID, uuid.NullUUID{})
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+		assert.Equal(t, int64(1), hook.hashes[key][productID.String()])
+	})
+
+	t.Run("out of stock is not added", func(t *testing.T) {
+		repo, hook := newGuestBasketRepository(0)
+		variantID := uuid.NullUUID{UUID: uuid.New(), Valid: true}
+
+		_, err := repo.Add(ctx, guestID, productID, variantID)
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+		_, exists := hook.hashes[key][productID.String()+":"+variantID.UUID.String()]
+		assert.False(t, exists)
+	})
+}
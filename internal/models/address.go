@@ -1,6 +1,8 @@
 package models
 
 import (
+	"errors"
+
 	"github.com/google/uuid"
 	"github.com/guregu/null"
 )
@@ -20,3 +22,15 @@ type UserAddress struct {
 	UserID    uuid.UUID
 	AddressID uuid.UUID
 }
+
+// ErrAddressNotGeocoded — геокодер не нашёл адрес на карте
+var ErrAddressNotGeocoded = errors.New("address not geocoded")
+
+// GeocodedAddress — адрес, нормализованный геокодером. Пустые Region и City
+// означают, что геокодер их не определил.
+type GeocodedAddress struct {
+	Region        string     `json:"region"`
+	City          string     `json:"city"`
+	AddressString string     `json:"addressString"`
+	Coordinate    Coordinate `json:"coordinate"`
+}
@@ -0,0 +1,85 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AnalyticsBucket — шаг временного ряда аналитики продавца
+type AnalyticsBucket string
+
+const (
+	AnalyticsDay   AnalyticsBucket = "day"
+	AnalyticsWeek  AnalyticsBucket = "week"
+	AnalyticsMonth AnalyticsBucket = "month"
+)
+
+func (b AnalyticsBucket) Valid() bool {
+	switch b {
+	case AnalyticsDay, AnalyticsWeek, AnalyticsMonth:
+		return true
+	}
+	return false
+}
+
+// Start возвращает первый день периода, в который попадает day. Неделя
+// начинается с понедельника, как у date_trunc в PostgreSQL.
+func (b AnalyticsBucket) Start(day time.Time) time.Time {
+	day = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	switch b {
+	case AnalyticsWeek:
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case AnalyticsMonth:
+		return day.AddDate(0, 0, 1-day.Day())
+	}
+	return day
+}
+
+// Next возвращает начало периода, следующего за периодом, начатым в start
+func (b AnalyticsBucket) Next(start time.Time) time.Time {
+	switch b {
+	case AnalyticsWeek:
+		return start.AddDate(0, 0, 7)
+	case AnalyticsMonth:
+		return start.AddDate(0, 1, 0)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+// AnalyticsPeriod — отчётный период аналитики: дни с From по To включительно
+// и шаг временного ряда
+type AnalyticsPeriod struct {
+	From   time.Time
+	To     time.Time
+	Bucket AnalyticsBucket
+}
+
+// SalesStats — показатели товаров продавца, просуммированные за период
+type SalesStats struct {
+	Period        time.Time
+	Revenue       Money
+	Units         int
+	Orders        int
+	ReturnedUnits int
+	BasketAdds    int
+	RatingSum     int
+	RatingCount   int
+}
+
+// TopProduct — товар продавца с выручкой и продажами за период
+type TopProduct struct {
+	ProductID uuid.UUID `json:"product_id"`
+	Name      string    `json:"name"`
+	Revenue   Money     `json:"revenue"`
+	Units     int       `json:"units"`
+}
+
+// StockOutProduct — товар, которого не было в наличии хотя бы день за период.
+// Quantity — текущий остаток.
+type StockOutProduct struct {
+	ProductID uuid.UUID `json:"product_id"`
+	Name      string    `json:"name"`
+	Days      int       `json:"days"`
+	Quantity  int       `json:"quantity"`
+}
@@ -0,0 +1,125 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+type AttributeType string
+
+const (
+	AttributeEnum    AttributeType = "enum"
+	AttributeNumber  AttributeType = "number"
+	AttributeBoolean AttributeType = "boolean"
+	AttributeText    AttributeType = "text"
+)
+
+var ErrInvalidAttributeValue = errors.New("invalid attribute value")
+
+func (t AttributeType) Valid() bool {
+	switch t {
+	case AttributeEnum, AttributeNumber, AttributeBoolean, AttributeText:
+		return true
+	}
+	return false
+}
+
+// CategoryAttribute — характеристика из схемы категории. Схема узла действует
+// и на товары всех его подкатегорий.
+type CategoryAttribute struct {
+	ID         uuid.UUID     `json:"id"`
+	CategoryID uuid.UUID     `json:"categoryId"`
+	Name       string        `json:"name"`
+	Type       AttributeType `json:"type"`
+	// Unit — единица измерения числовой характеристики: "ГБ", "мм"
+	Unit       null.String `json:"unit" swaggertype:"primitive,string"`
+	Options    []string    `json:"options"`
+	Required   bool        `json:"required"`
+	Filterable bool        `json:"filterable"`
+	Position   int         `json:"position"`
+}
+
+// ProductAttribute — значение характеристики товара. Заполняется ровно одно поле
+// по типу характеристики; значение enum передаётся в Text.
+type ProductAttribute struct {
+	AttributeID uuid.UUID   `json:"attributeId"`
+	Text        null.String `json:"text" swaggertype:"primitive,string"`
+	Number      null.Float  `json:"number" swaggertype:"primitive,number"`
+	Bool        null.Bool   `json:"bool" swaggertype:"primitive,boolean"`
+}
+
+// Validate проверяет, что значение соответствует типу характеристики
+func (a CategoryAttribute) Validate(value ProductAttribute) error {
+	filled := 0
+	for _, valid := range []bool{value.Text.Valid, value.Number.Valid, value.Bool.Valid} {
+		if valid {
+			filled++
+		}
+	}
+	if filled != 1 {
+		return fmt.Errorf("%w: %q must have exactly one value", ErrInvalidAttributeValue, a.Name)
+	}
+
+	switch a.Type {
+	case AttributeEnum:
+		if !value.Text.Valid || !slices.Contains(a.Options, value.Text.String) {
+			return fmt.Errorf("%w: %q must be one of %s", ErrInvalidAttributeValue, a.Name, strings.Join(a.Options, ", "))
+		}
+	case AttributeNumber:
+		if !value.Number.Valid || math.IsNaN(value.Number.Float64) || math.IsInf(value.Number.Float64, 0) {
+			return fmt.Errorf("%w: %q must be a number", ErrInvalidAttributeValue, a.Name)
+		}
+	case AttributeBoolean:
+		if !value.Bool.Valid {
+			return fmt.Errorf("%w: %q must be a boolean", ErrInvalidAttributeValue, a.Name)
+		}
+	case AttributeText:
+		if !value.Text.Valid || strings.TrimSpace(value.Text.String) == "" {
+			return fmt.Errorf("%w: %q must be a non-empty string", ErrInvalidAttributeValue, a.Name)
+		}
+	default:
+		return fmt.Errorf("%w: %q has unknown type %s", ErrInvalidAttributeValue, a.Name, a.Type)
+	}
+
+	return nil
+}
+
+// AttributeFilter — условие поиска по характеристике. Для enum и text задаётся
+// список допустимых значений, для number — границы Min/Max, для boolean — Bool.
+type AttributeFilter struct {
+	AttributeID uuid.UUID
+	Values      []string
+	Min         null.Float
+	Max         null.Float
+	Bool        null.Bool
+}
+
+// AttributeFacet — распределение значений характеристики в результатах поиска
+type AttributeFacet struct {
+	AttributeID uuid.UUID
+	Name        string
+	Type        AttributeType
+	Unit        null.String
+	// Values — количество товаров по значениям enum, text и boolean
+	Values []FacetValue
+	// Min и Max — диапазон значений числовой характеристики
+	Min null.Float
+	Max null.Float
+}
+
+type FacetValue struct {
+	Value string
+	Count int
+}
+
+// ProductSpecification — значение характеристики товара вместе с её описанием
+type ProductSpecification struct {
+	Attribute CategoryAttribute
+	Value     ProductAttribute
+}
@@ -0,0 +1,96 @@
+package models
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CatalogFormat — формат файла импорта и выгрузки каталога продавца
+type CatalogFormat string
+
+const (
+	CatalogCSV  CatalogFormat = "csv"
+	CatalogXLSX CatalogFormat = "xlsx"
+)
+
+// ParseCatalogFormat разбирает название формата: "csv" или "xlsx"
+func ParseCatalogFormat(value string) (CatalogFormat, error) {
+	switch format := CatalogFormat(strings.ToLower(strings.TrimSpace(value))); format {
+	case CatalogCSV, CatalogXLSX:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unsupported catalog format %q", value)
+	}
+}
+
+// CatalogFormatFromFileName определяет формат файла по расширению
+func CatalogFormatFromFileName(name string) (CatalogFormat, error) {
+	return ParseCatalogFormat(strings.TrimPrefix(path.Ext(name), "."))
+}
+
+// ContentType возвращает MIME-тип файла в этом формате
+func (f CatalogFormat) ContentType() string {
+	if f == CatalogXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+type CatalogImportStatus string
+
+const (
+	// ImportPending — файл загружен, задача ждёт обработчика
+	ImportPending CatalogImportStatus = "pending"
+	// ImportProcessing — строки файла загружаются в каталог
+	ImportProcessing CatalogImportStatus = "processing"
+	// ImportDone — файл обработан; ошибки отдельных строк есть в отчёте
+	ImportDone CatalogImportStatus = "done"
+	// ImportFailed — файл не удалось прочитать, каталог не изменился
+	ImportFailed CatalogImportStatus = "failed"
+)
+
+// CatalogImport — задача импорта каталога из файла продавца
+type CatalogImport struct {
+	ID          uuid.UUID           `json:"id"`
+	SellerID    uuid.UUID           `json:"-"`
+	Format      CatalogFormat       `json:"format"`
+	FileName    string              `json:"file_name"`
+	FileKey     string              `json:"-"`
+	Status      CatalogImportStatus `json:"status"`
+	TotalRows   int                 `json:"total_rows"`
+	CreatedRows int                 `json:"created_rows"`
+	UpdatedRows int                 `json:"updated_rows"`
+	FailedRows  int                 `json:"failed_rows"`
+	Error       string              `json:"error,omitempty"`
+	CreatedAt   time.Time           `json:"created_at"`
+	StartedAt   *time.Time          `json:"started_at,omitempty"`
+	FinishedAt  *time.Time          `json:"finished_at,omitempty"`
+}
+
+// Finished сообщает, завершена ли обработка задачи
+func (i *CatalogImport) Finished() bool {
+	return i.Status == ImportDone || i.Status == ImportFailed
+}
+
+// CatalogRow — товар в файле каталога. Row — номер строки файла,
+// заголовок считается первой строкой.
+type CatalogRow struct {
+	Row         int
+	SKU         string
+	Name        string
+	Description string
+	Category    string
+	Price       Money
+	Quantity    uint
+}
+
+// ImportRowError — причина, по которой строка файла не попала в каталог
+type ImportRowError struct {
+	Row     int
+	SKU     string
+	Message string
+}
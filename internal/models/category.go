@@ -1,8 +1,17 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
 
+// Category — узел дерева категорий. У корневых категорий ParentID не задан,
+// соседние узлы упорядочены по Position.
 type Category struct {
-	ID              uuid.UUID `json:"id" db:"id"`
-	Name            string    `json:"name" db:"name"`
-}
\ No newline at end of file
+	ID       uuid.UUID     `json:"id" db:"id"`
+	Name     string        `json:"name" db:"name"`
+	ParentID uuid.NullUUID `json:"parentId" db:"parent_id"`
+	Slug     string        `json:"slug" db:"slug"`
+	ImageURL null.String   `json:"imageUrl" db:"image_url" swaggertype:"primitive,string"`
+	Position int           `json:"position" db:"position"`
+}
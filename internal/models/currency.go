@@ -0,0 +1,161 @@
+package models
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// rateDigits — точность курса: знаков после точки, как у NUMERIC(18, 6) в базе
+const (
+	rateDigits = 6
+	rateScale  = 1_000_000
+)
+
+var (
+	ErrInvalidCurrency = errors.New("invalid currency code")
+	ErrInvalidRate     = errors.New("invalid exchange rate")
+)
+
+// ParseCurrency проверяет код валюты: три латинские буквы, регистр не важен
+func ParseCurrency(s string) (Currency, error) {
+	code := strings.ToUpper(strings.TrimSpace(s))
+	if len(code) != 3 {
+		return "", fmt.Errorf("%w: %q", ErrInvalidCurrency, s)
+	}
+	for _, c := range code {
+		if c < 'A' || c > 'Z' {
+			return "", fmt.Errorf("%w: %q", ErrInvalidCurrency, s)
+		}
+	}
+	return Currency(code), nil
+}
+
+// Rate — курс валюты: сколько рублей стоит её единица, с точностью до 10^-6
+type Rate struct {
+	micro int64
+}
+
+// ParseRate разбирает курс в десятичной записи: "92.5", "0.012345"
+func ParseRate(s string) (Rate, error) {
+	micro, ok := parseFixed(s, rateDigits)
+	if !ok || micro <= 0 {
+		return Rate{}, fmt.Errorf("%w: %q", ErrInvalidRate, s)
+	}
+	return Rate{micro: micro}, nil
+}
+
+// String возвращает курс без незначащих нулей: "92.5"
+func (r Rate) String() string {
+	return trimNumeric(fmt.Sprintf("%d.%06d", r.micro/rateScale, r.micro%rateScale))
+}
+
+func (r Rate) IsZero() bool {
+	return r.micro == 0
+}
+
+// Scan читает курс из NUMERIC; NULL — курс не задан
+func (r *Rate) Scan(src any) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+		*r = Rate{}
+		return nil
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	case int64:
+		s = fmt.Sprint(v)
+	default:
+		return fmt.Errorf("%w: cannot scan %T", ErrInvalidRate, src)
+	}
+
+	parsed, err := ParseRate(trimNumeric(s))
+	if err != nil {
+		return err
+	}
+	*r = parsed
+	return nil
+}
+
+func (r Rate) Value() (driver.Value, error) {
+	if r.IsZero() {
+		return nil, nil
+	}
+	return r.String(), nil
+}
+
+func (r Rate) MarshalJSON() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+func (r *Rate) UnmarshalJSON(data []byte) error {
+	parsed, err := ParseRate(strings.Trim(string(data), `"`))
+	if err != nil {
+		return err
+	}
+	*r = parsed
+	return nil
+}
+
+func (r Rate) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawString(r.String())
+}
+
+func (r *Rate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	parsed, err := ParseRate(strings.Trim(string(l.Raw()), `"`))
+	if err != nil {
+		l.AddError(err)
+		return
+	}
+	*r = parsed
+}
+
+// ExchangeRate — курс, по которому суммы в базовой валюте показываются в валюте
+// покупателя. Нулевое значение — базовая валюта: суммы не пересчитываются.
+type ExchangeRate struct {
+	Currency Currency `json:"currency"`
+	Rate     Rate     `json:"rate"`
+	// UpdatedAt — когда курс был задан; у базовой валюты не указывается
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// Code возвращает валюту, в которой показываются суммы
+func (r ExchangeRate) Code() Currency {
+	if r.Currency == "" {
+		return BaseCurrency
+	}
+	return r.Currency
+}
+
+// IsBase сообщает, что суммы показываются в базовой валюте без пересчёта
+func (r ExchangeRate) IsBase() bool {
+	return r.Code() == BaseCurrency || r.Rate.IsZero()
+}
+
+// Convert пересчитывает сумму в базовой валюте в валюту курса. Результат
+// округляется до минимальной единицы валюты половиной от нуля и служит только
+// для показа: списание всегда идёт в базовой валюте.
+func (r ExchangeRate) Convert(m Money) Money {
+	Money{}.mustMatch(m)
+	if r.IsBase() {
+		return m
+	}
+	return NewMoney(roundDiv(m.minor*rateScale, r.Rate.micro), r.Currency)
+}
+
+// ToBase пересчитывает в базовую валюту сумму, которую покупатель ввёл в валюте
+// курса, например границу фильтра по цене. ParseMoney не знает валюты, поэтому
+// валюта m не проверяется: её минимальные единицы считаются единицами валюты курса.
+func (r ExchangeRate) ToBase(m Money) Money {
+	if r.IsBase() {
+		return Money{minor: m.minor}
+	}
+	return Money{minor: roundDiv(m.minor*r.Rate.micro, rateScale)}
+}
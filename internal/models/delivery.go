@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+// DeliveryZone — зона доставки со своим сроком. Зона без региона и города
+// используется для адресов, которым не подошла ни одна другая.
+type DeliveryZone struct {
+	ID           uuid.UUID
+	Name         string
+	Region       null.String
+	City         null.String
+	LeadTimeDays int
+}
+
+// LeadTime — минимальный срок доставки в зону
+func (z DeliveryZone) LeadTime() time.Duration {
+	return time.Duration(z.LeadTimeDays) * 24 * time.Hour
+}
+
+// DeliverySlot — интервал доставки, на который можно оформить ограниченное число заказов
+type DeliverySlot struct {
+	ID       uuid.UUID
+	ZoneID   uuid.UUID
+	StartsAt time.Time
+	EndsAt   time.Time
+	Capacity int
+	Reserved int
+}
+
+// Available сообщает, остались ли в интервале свободные места
+func (s DeliverySlot) Available() bool {
+	return s.Reserved < s.Capacity
+}
+
+// DeliveryPlan — доставка, рассчитанная для заказа при оформлении
+type DeliveryPlan struct {
+	SlotID             uuid.NullUUID
+	ExpectedDeliveryAt time.Time
+}
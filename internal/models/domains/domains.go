@@ -6,8 +6,13 @@ type (
 	UserIDKey struct{}
 	LoggerKey struct{}
 	RoleKey   struct{}
+	// ExchangeRateKey — курс валюты, в которой покупатель смотрит цены
+	ExchangeRateKey struct{}
 )
 
 const (
-	TokenCookieName = "token"
+	TokenCookieName       = "token"
+	GuestBasketCookieName = "guest_basket"
+	CurrencyCookieName    = "currency"
+	CurrencyHeader        = "X-Currency"
 )
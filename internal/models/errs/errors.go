@@ -18,6 +18,8 @@ var (
 	ErrBusinessLogic      = errors.New("business logic error")
 	ErrProductNotApproved = errors.New("product not approved")
 	ErrNotEnoughStock     = errors.New("not enough stock")
+	ErrSlotUnavailable    = errors.New("delivery slot unavailable")
+	ErrForbidden          = errors.New("forbidden")
 
 	ErrMissingToken      = errors.New("missing jwt token")
 	ErrTokenRevoked      = errors.New("token revoked")
@@ -52,6 +54,10 @@ func MapErrorToGRPC(err error) error {
 		return status.Error(codes.NotFound, err.Error())
 	case errors.Is(err, ErrInvalidToken), errors.Is(err, ErrTokenRevoked):
 		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, ErrInvalidID), errors.Is(err, ErrBusinessLogic):
+		return status.Error(codes.InvalidArgument, err.Error())
 	default:
 		return status.Error(codes.Internal, "internal server error")
 	}
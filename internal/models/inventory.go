@@ -0,0 +1,80 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StockMovementKind — причина изменения остатка в журнале
+type StockMovementKind string
+
+const (
+	// StockInitial — остаток заведён вместе с товаром или SKU
+	StockInitial StockMovementKind = "initial"
+	// StockReserve — товар зарезервирован при начале оформления заказа
+	StockReserve StockMovementKind = "reserve"
+	// StockRelease — резерв отменён или истёк, товар вернулся в остаток
+	StockRelease StockMovementKind = "release"
+	// StockSale — товар списан заказом сверх резерва
+	StockSale StockMovementKind = "sale"
+	// StockCancelRestore — продавец отменил отправление, товар вернулся в остаток
+	StockCancelRestore StockMovementKind = "cancel_restore"
+	// StockReturn — покупатель вернул товар
+	StockReturn StockMovementKind = "return"
+	// StockAdjustment — ручная корректировка продавцом
+	StockAdjustment StockMovementKind = "adjustment"
+	// StockImport — остаток задан импортом каталога
+	StockImport StockMovementKind = "import"
+)
+
+// ManualStockKind сообщает, может ли продавец записать такое движение вручную
+func (k StockMovementKind) ManualStockKind() bool {
+	return k == StockAdjustment || k == StockReturn
+}
+
+// StockChange — контекст изменения остатка, который попадает в журнал
+// вместе с каждым движением
+type StockChange struct {
+	Kind    StockMovementKind
+	ActorID uuid.NullUUID
+	OrderID uuid.NullUUID
+	Reason  string
+}
+
+// StockMovement — запись журнала остатков. Balance — остаток единицы учёта
+// (товара без вариантов или SKU) после движения.
+type StockMovement struct {
+	ID        int64             `json:"id"`
+	ProductID uuid.UUID         `json:"product_id"`
+	VariantID uuid.NullUUID     `json:"variant_id"`
+	Delta     int               `json:"delta"`
+	Balance   int               `json:"balance"`
+	Kind      StockMovementKind `json:"kind"`
+	ActorID   uuid.NullUUID     `json:"actor_id"`
+	OrderID   uuid.NullUUID     `json:"order_id"`
+	Reason    string            `json:"reason,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+// StockDiscrepancy — единица учёта, остаток которой расходится с журналом
+type StockDiscrepancy struct {
+	ProductID     uuid.UUID     `json:"product_id"`
+	VariantID     uuid.NullUUID `json:"variant_id"`
+	Name          string        `json:"name"`
+	SKU           string        `json:"sku,omitempty"`
+	Quantity      int           `json:"quantity"`
+	LedgerBalance int           `json:"ledger_balance"`
+}
+
+// LowStockAlert — остаток товара или SKU опустился до порога продавца
+type LowStockAlert struct {
+	ID          uuid.UUID
+	SellerID    uuid.UUID
+	ProductID   uuid.UUID
+	VariantID   uuid.NullUUID
+	ProductName string
+	SKU         string
+	Quantity    int
+	Threshold   int
+}
@@ -0,0 +1,364 @@
+package models
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// Currency — код валюты ISO 4217
+type Currency string
+
+const (
+	RUB Currency = "RUB"
+
+	// BaseCurrency — валюта, в которой хранятся цены и списываются деньги
+	BaseCurrency = RUB
+)
+
+// moneyScale — число минимальных единиц (копеек, центов) в единице валюты
+const moneyScale = 100
+
+var (
+	ErrInvalidMoney     = errors.New("invalid money amount")
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+)
+
+// Money — денежная сумма в минимальных единицах валюты (копейках для рубля).
+// Суммы складываются и умножаются на количество целочисленно и без потерь.
+// Округление происходит только при взятии доли суммы (Share, Percent) —
+// до минимальной единицы, половина — от нуля, как ROUND для NUMERIC в Postgres.
+//
+// Нулевое значение — ноль в базовой валюте. В базе сумма хранится как NUMERIC
+// с двумя знаками, в JSON записывается числом с двумя знаками после точки.
+// Суммы в разных валютах складывать и сравнивать нельзя: это ошибка
+// программы, и операции с ними паникуют.
+type Money struct {
+	minor int64
+	// currency пуст для базовой валюты, чтобы нулевое значение и суммы
+	// из базы совпадали при сравнении ==
+	currency Currency
+}
+
+// NewMoney возвращает сумму minor минимальных единиц валюты currency
+func NewMoney(minor int64, currency Currency) Money {
+	if currency == BaseCurrency {
+		currency = ""
+	}
+	return Money{minor: minor, currency: currency}
+}
+
+// Kopecks возвращает сумму в копейках базовой валюты
+func Kopecks(minor int64) Money {
+	return Money{minor: minor}
+}
+
+// Rubles возвращает сумму в целых рублях базовой валюты
+func Rubles(rubles int64) Money {
+	return Money{minor: rubles * moneyScale}
+}
+
+// ParseMoney разбирает десятичную запись суммы в базовой валюте: "1500", "1500.5",
+// "-0.99". Больше двух знаков после точки не допускается, чтобы сумма не
+// округлялась молча.
+func ParseMoney(s string) (Money, error) {
+	minor, ok := parseFixed(s, 2)
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidMoney, s)
+	}
+	return Money{minor: minor}, nil
+}
+
+// parseFixed разбирает десятичную запись не более чем с digits знаками после
+// точки и возвращает её, умноженной на 10^digits
+func parseFixed(s string, digits int) (int64, bool) {
+	s = strings.TrimSpace(s)
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" || len(frac) > digits || strings.ContainsAny(whole+frac, "+-") {
+		return 0, false
+	}
+	frac += strings.Repeat("0", digits-len(frac))
+
+	scale := int64(math.Pow10(digits))
+	units, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || units > math.MaxInt64/scale-1 {
+		return 0, false
+	}
+	fraction := int64(0)
+	if frac != "" {
+		if fraction, err = strconv.ParseInt(frac, 10, 64); err != nil {
+			return 0, false
+		}
+	}
+
+	value := units*scale + fraction
+	if negative {
+		value = -value
+	}
+	return value, true
+}
+
+// trimNumeric убирает незначащие нули после точки, которые Postgres отдаёт
+// для NUMERIC, например "1500.5000" после деления
+func trimNumeric(s string) string {
+	if whole, frac, ok := strings.Cut(s, "."); ok {
+		s = strings.TrimSuffix(whole+"."+strings.TrimRight(frac, "0"), ".")
+	}
+	return s
+}
+
+// Minor возвращает сумму в минимальных единицах валюты
+func (m Money) Minor() int64 {
+	return m.minor
+}
+
+func (m Money) Currency() Currency {
+	if m.currency == "" {
+		return BaseCurrency
+	}
+	return m.currency
+}
+
+// String возвращает сумму с двумя знаками после точки: "1500.50"
+func (m Money) String() string {
+	sign := ""
+	value := m.minor
+	if value < 0 {
+		sign = "-"
+		value = -value
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, value/moneyScale, value%moneyScale)
+}
+
+// Float64 возвращает приближённое значение суммы для метрик и расчёта долей.
+// Для арифметики с деньгами не используется.
+func (m Money) Float64() float64 {
+	return float64(m.minor) / moneyScale
+}
+
+func (m Money) mustMatch(other Money) {
+	if m.currency != other.currency {
+		panic(fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency(), other.Currency()))
+	}
+}
+
+func (m Money) Add(other Money) Money {
+	m.mustMatch(other)
+	return Money{minor: m.minor + other.minor, currency: m.currency}
+}
+
+func (m Money) Sub(other Money) Money {
+	m.mustMatch(other)
+	return Money{minor: m.minor - other.minor, currency: m.currency}
+}
+
+// Mul возвращает стоимость quantity единиц по цене m
+func (m Money) Mul(quantity int64) Money {
+	return Money{minor: m.minor * quantity, currency: m.currency}
+}
+
+// Share возвращает долю суммы numerator/denominator, округлённую до
+// минимальной единицы половиной от нуля
+func (m Money) Share(numerator, denominator int64) Money {
+	return Money{minor: roundDiv(m.minor*numerator, denominator), currency: m.currency}
+}
+
+// Percent возвращает percent процентов от суммы с тем же округлением, что и Share
+func (m Money) Percent(percent int) Money {
+	return m.Share(int64(percent), 100)
+}
+
+// Cmp возвращает -1, 0 или 1, если m меньше, равна или больше other
+func (m Money) Cmp(other Money) int {
+	m.mustMatch(other)
+	switch {
+	case m.minor < other.minor:
+		return -1
+	case m.minor > other.minor:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (m Money) LessThan(other Money) bool {
+	return m.Cmp(other) < 0
+}
+
+func (m Money) GreaterThan(other Money) bool {
+	return m.Cmp(other) > 0
+}
+
+func (m Money) IsZero() bool {
+	return m.minor == 0
+}
+
+func (m Money) IsPositive() bool {
+	return m.minor > 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.minor < 0
+}
+
+// MinMoney возвращает меньшую из сумм
+func MinMoney(a, b Money) Money {
+	if b.LessThan(a) {
+		return b
+	}
+	return a
+}
+
+// roundDiv делит с округлением половины от нуля
+func roundDiv(value, divisor int64) int64 {
+	if divisor < 0 {
+		value, divisor = -value, -divisor
+	}
+	quotient, remainder := value/divisor, value%divisor
+	switch {
+	case remainder*2 >= divisor:
+		quotient++
+	case remainder*2 <= -divisor:
+		quotient--
+	}
+	return quotient
+}
+
+// Scan читает NUMERIC как сумму в базовой валюте
+func (m *Money) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*m = Money{}
+		return nil
+	case int64:
+		*m = Money{minor: v * moneyScale}
+		return nil
+	case []byte:
+		return m.scanString(string(v))
+	case string:
+		return m.scanString(v)
+	default:
+		return fmt.Errorf("%w: cannot scan %T", ErrInvalidMoney, src)
+	}
+}
+
+func (m *Money) scanString(s string) error {
+	parsed, err := ParseMoney(trimNumeric(s))
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// Value записывает сумму как десятичную строку. Колонки с деньгами хранят
+// базовую валюту, поэтому сумма в другой валюте не записывается.
+func (m Money) Value() (driver.Value, error) {
+	if m.currency != "" {
+		return nil, fmt.Errorf("%w: cannot store %s amount", ErrCurrencyMismatch, m.currency)
+	}
+	return m.String(), nil
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalJSON принимает сумму числом или строкой: 1500.5 или "1500.50"
+func (m *Money) UnmarshalJSON(data []byte) error {
+	parsed, err := ParseMoney(strings.Trim(string(data), `"`))
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// IsDefined сообщает easyjson, что поле с omitempty нужно записать: нулевая
+// сумма пропускается так же, как пропускался нулевой float64
+func (m Money) IsDefined() bool {
+	return m.minor != 0
+}
+
+func (m Money) MarshalEasyJSON(w *jwriter.Writer) {
+	w.RawString(m.String())
+}
+
+func (m *Money) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	parsed, err := ParseMoney(strings.Trim(string(l.Raw()), `"`))
+	if err != nil {
+		l.AddError(err)
+		return
+	}
+	*m = parsed
+}
+
+// NullMoney — сумма, которая может отсутствовать, например необязательный
+// потолок скидки
+type NullMoney struct {
+	Money Money
+	Valid bool
+}
+
+func NullMoneyFrom(m Money) NullMoney {
+	return NullMoney{Money: m, Valid: true}
+}
+
+func (n *NullMoney) Scan(src any) error {
+	if src == nil {
+		*n = NullMoney{}
+		return nil
+	}
+	n.Valid = true
+	return n.Money.Scan(src)
+}
+
+func (n NullMoney) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Money.Value()
+}
+
+func (n NullMoney) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Money.MarshalJSON()
+}
+
+func (n *NullMoney) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*n = NullMoney{}
+		return nil
+	}
+	n.Valid = true
+	return n.Money.UnmarshalJSON(data)
+}
+
+func (n NullMoney) MarshalEasyJSON(w *jwriter.Writer) {
+	if !n.Valid {
+		w.RawString("null")
+		return
+	}
+	n.Money.MarshalEasyJSON(w)
+}
+
+func (n *NullMoney) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	if l.IsNull() {
+		l.Skip()
+		*n = NullMoney{}
+		return
+	}
+	n.Valid = true
+	n.Money.UnmarshalEasyJSON(l)
+}
@@ -3,6 +3,7 @@ package models
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/guregu/null"
@@ -11,24 +12,31 @@ import (
 type OrderStatus int
 
 const (
-	Placed                    OrderStatus = iota // Оформлен
-	InTransit                                    // В пути
+	Placed               OrderStatus = iota // Оформлен
+	InTransit                               // В пути
+	AwaitingConfirmation                    // Ожидает подтверждения
+	BeingPrepared                           // Готовится
+	Shipped                                 // Отправлен
+	Delivered                               // Доставлен
+	CanceledBySeller                        // Отменен продавцом
 )
 
+var orderStatuses = [...]string{
+	"placed",
+	"in_transit",
+	"awaiting_confirmation",
+	"being_prepared",
+	"shipped",
+	"delivered",
+	"canceled_by_seller",
+}
+
 func (s OrderStatus) String() string {
-	return [...]string{
-		"placed",
-		"in_transit",
-	}[s]
+	return orderStatuses[s]
 }
 
 func ParseOrderStatus(s string) (OrderStatus, error) {
-	statuses := [...]string{
-		"placed",
-		"in_transit",
-	}
-
-	for i, val := range statuses {
+	for i, val := range orderStatuses {
 		if s == val {
 			return OrderStatus(i), nil
 		}
@@ -47,3 +55,75 @@ type OrderPreviewProductDTO struct {
 	ProductImageURL null.String `json:"ProductImageURL" swaggertype:"primitive,string"`
 	ProductQuantity uint
 }
+
+// OrderShipment — часть заказа с товарами одного продавца. У каждого
+// отправления свой статус, трек-номер и ожидаемая дата доставки.
+type OrderShipment struct {
+	ID                 uuid.UUID
+	OrderID            uuid.UUID
+	SellerID           uuid.UUID
+	SellerName         string
+	AddressID          uuid.UUID
+	Status             OrderStatus
+	TotalPrice         Money
+	TotalPriceDiscount Money
+	TrackingNumber     null.String
+	ExpectedDeliveryAt *time.Time
+	CreatedAt          *time.Time
+	Items              []ShipmentItem
+}
+
+type ShipmentItem struct {
+	ProductID       uuid.UUID
+	VariantID       uuid.NullUUID
+	VariantOptions  VariantOptions
+	ProductName     string
+	ProductImageURL null.String
+	// BasePrice — цена без скидки на момент заказа, Price — цена покупки
+	BasePrice Money
+	Price     Money
+	Quantity  uint
+}
+
+// OrderDetail — заказ целиком: суммы, промокод и отправления с товарами
+type OrderDetail struct {
+	ID                 uuid.UUID
+	UserID             uuid.UUID
+	AddressID          uuid.UUID
+	PickupPointID      uuid.NullUUID
+	Status             OrderStatus
+	TotalPrice         Money
+	TotalPriceDiscount Money
+	DeliveryCost       Money
+	PromoCode          null.String
+	PromoDiscount      Money
+	ExpectedDeliveryAt *time.Time
+	ActualDeliveryAt   *time.Time
+	CreatedAt          *time.Time
+	Shipments          []OrderShipment
+	// DisplayRate — курс валюты, в которой покупатель видел заказ при оформлении
+	DisplayRate ExchangeRate
+}
+
+// SellerCanConfirm сообщает, может ли продавец принять отправление в работу
+func (s OrderStatus) SellerCanConfirm() bool {
+	return s == AwaitingConfirmation
+}
+
+// WarehousePrevious возвращает статус, из которого склад может перевести заказ
+// в s: в доставку — только оформленный заказ, доставленным — только заказ в пути
+func (s OrderStatus) WarehousePrevious() (OrderStatus, bool) {
+	switch s {
+	case InTransit:
+		return Placed, true
+	case Delivered:
+		return InTransit, true
+	}
+	return 0, false
+}
+
+// SellerCanCancel сообщает, может ли продавец отменить отправление:
+// только пока оно не передано в доставку
+func (s OrderStatus) SellerCanCancel() bool {
+	return s == AwaitingConfirmation || s == BeingPrepared
+}
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+// EarningStatus — состояние начисления продавцу за позицию заказа
+type EarningStatus string
+
+const (
+	// EarningHeld — заказ доставлен, но покупатель ещё может оформить возврат
+	EarningHeld EarningStatus = "held"
+	// EarningAvailable — окно возврата прошло, начисление попадёт в ближайшую выписку
+	EarningAvailable EarningStatus = "available"
+	// EarningSettled — начисление включено в выписку к выплате
+	EarningSettled EarningStatus = "settled"
+	// EarningReversed — по позиции оформлен возврат, начисление отменено
+	EarningReversed EarningStatus = "reversed"
+)
+
+// PayoutStatus — состояние выписки к выплате
+type PayoutStatus string
+
+const (
+	PayoutPending PayoutStatus = "pending"
+	PayoutPaid    PayoutStatus = "paid"
+)
+
+// SellerEarning — начисление продавцу за позицию доставленного заказа.
+// Gross — сумма позиции по цене продажи, Commission — комиссия площадки,
+// Net — сумма к выплате продавцу.
+type SellerEarning struct {
+	ID            uuid.UUID     `json:"id"`
+	OrderID       uuid.UUID     `json:"order_id"`
+	ProductID     uuid.UUID     `json:"product_id"`
+	ProductName   string        `json:"product_name"`
+	Quantity      int           `json:"quantity"`
+	Gross         Money         `json:"gross"`
+	CommissionBps int           `json:"commission_bps"`
+	Commission    Money         `json:"commission"`
+	Net           Money         `json:"net"`
+	Status        EarningStatus `json:"status"`
+	AvailableAt   time.Time     `json:"available_at"`
+	CreatedAt     time.Time     `json:"created_at"`
+}
+
+// PayoutStatement — выписка к выплате: доступные начисления продавца,
+// собранные за период [PeriodStart, PeriodEnd)
+type PayoutStatement struct {
+	ID          uuid.UUID    `json:"id"`
+	SellerID    uuid.UUID    `json:"seller_id"`
+	PeriodStart time.Time    `json:"period_start"`
+	PeriodEnd   time.Time    `json:"period_end"`
+	Gross       Money        `json:"gross"`
+	Commission  Money        `json:"commission"`
+	Net         Money        `json:"net"`
+	Status      PayoutStatus `json:"status"`
+	PaidAt      null.Time    `json:"paid_at" swaggertype:"primitive,string"`
+	CreatedAt   time.Time    `json:"created_at"`
+}
+
+// SellerBalance — суммы к выплате продавцу по состояниям
+type SellerBalance struct {
+	// Held — начисления в окне возврата
+	Held Money
+	// Available — начисления, ещё не попавшие в выписку
+	Available Money
+	// Pending — выписки, ожидающие выплаты
+	Pending Money
+	// PaidOut — выплачено за всё время
+	PaidOut Money
+}
+
+// SettlementResult — итоги одного прохода расчётов с продавцами
+type SettlementResult struct {
+	Recorded   int64
+	Reversed   int64
+	Released   int64
+	Statements int64
+}
@@ -0,0 +1,72 @@
+package models
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const earthRadiusKm = 6371.0
+
+var ErrInvalidCoordinate = errors.New("invalid coordinate")
+
+// Coordinate — точка на карте. В bazaar.address хранится строкой "широта,долгота".
+type Coordinate struct {
+	Lat float64
+	Lon float64
+}
+
+func ParseCoordinate(s string) (Coordinate, error) {
+	latStr, lonStr, ok := strings.Cut(s, ",")
+	if !ok {
+		return Coordinate{}, ErrInvalidCoordinate
+	}
+
+	lat, err := strconv.ParseFloat(strings.TrimSpace(latStr), 64)
+	if err != nil {
+		return Coordinate{}, ErrInvalidCoordinate
+	}
+	lon, err := strconv.ParseFloat(strings.TrimSpace(lonStr), 64)
+	if err != nil {
+		return Coordinate{}, ErrInvalidCoordinate
+	}
+
+	c := Coordinate{Lat: lat, Lon: lon}
+	if !c.Valid() {
+		return Coordinate{}, ErrInvalidCoordinate
+	}
+
+	return c, nil
+}
+
+func (c Coordinate) Valid() bool {
+	return c.Lat >= -90 && c.Lat <= 90 && c.Lon >= -180 && c.Lon <= 180
+}
+
+func (c Coordinate) String() string {
+	return strconv.FormatFloat(c.Lat, 'f', -1, 64) + "," + strconv.FormatFloat(c.Lon, 'f', -1, 64)
+}
+
+// DistanceKm — расстояние по поверхности Земли по формуле гаверсинусов
+func (c Coordinate) DistanceKm(other Coordinate) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRad(other.Lat - c.Lat)
+	dLon := toRad(other.Lon - c.Lon)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(c.Lat))*math.Cos(toRad(other.Lat))*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(a))
+}
+
+// PickupPoint — пункт выдачи заказов. Часы работы хранятся в формате "15:04".
+type PickupPoint struct {
+	ID       uuid.UUID
+	Name     string
+	OpensAt  string
+	ClosesAt string
+	Address  AddressDB
+}
@@ -0,0 +1,70 @@
+package models
+
+import "github.com/google/uuid"
+
+// PricingItem — товар и количество, для которых нужно посчитать цену.
+// VariantID указывается для товаров с вариантами
+type PricingItem struct {
+	ProductID uuid.UUID
+	VariantID uuid.NullUUID
+	Quantity  uint
+}
+
+// StockKey — единица учёта остатка: товар без вариантов или конкретный SKU
+type StockKey struct {
+	ProductID uuid.UUID
+	VariantID uuid.NullUUID
+}
+
+// Key возвращает единицу учёта остатка позиции
+func (i PricingItem) Key() StockKey {
+	return StockKey{ProductID: i.ProductID, VariantID: i.VariantID}
+}
+
+// Less задаёт порядок блокировки строк остатка, одинаковый во всех транзакциях
+func (k StockKey) Less(other StockKey) bool {
+	if k.ProductID != other.ProductID {
+		return k.ProductID.String() < other.ProductID.String()
+	}
+	return k.VariantID.UUID.String() < other.VariantID.UUID.String()
+}
+
+// QuoteLine — расчёт одной позиции. UnitPrice — цена без скидки,
+// FinalUnitPrice — с учётом действующей скидки на товар.
+type QuoteLine struct {
+	ProductID      uuid.UUID
+	VariantID      uuid.NullUUID
+	SellerID       uuid.UUID
+	Quantity       uint
+	Status         ProductStatus
+	Stock          uint
+	UnitPrice      Money
+	FinalUnitPrice Money
+	// Discount — применённая скидка на товар, nil если её нет
+	Discount     *ProductDiscount
+	LineTotal    Money
+	LineDiscount Money
+}
+
+// Available сообщает, можно ли заказать позицию в нужном количестве
+func (l QuoteLine) Available() bool {
+	return l.Status == ProductApproved && l.Stock >= l.Quantity
+}
+
+// Quote — расчёт стоимости набора товаров.
+// Total = Subtotal - ProductDiscount - PromoDiscount + DeliveryCost.
+type Quote struct {
+	Lines           []QuoteLine
+	Subtotal        Money
+	ProductDiscount Money
+	PromoDiscount   Money
+	// Promo заполняется, если промокод применён
+	Promo        *PromoRedemption
+	DeliveryCost Money
+	Total        Money
+}
+
+// GoodsTotal — сумма за товары после всех скидок, без доставки
+func (q *Quote) GoodsTotal() Money {
+	return q.Subtotal.Sub(q.ProductDiscount).Sub(q.PromoDiscount)
+}
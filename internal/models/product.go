@@ -42,17 +42,22 @@ type Product struct {
 	PreviewImageURL string        `json:"preview_image_url,omitempty" db:"preview_image_url"`
 	Description     string        `json:"description" db:"description"`
 	Status          ProductStatus `json:"status" db:"status"`
-	Price           float64       `json:"price" db:"price"`
-	PriceDiscount   float64  	  `json:"price_discount"`
+	Price           Money         `json:"price" db:"price"`
+	PriceDiscount   Money    	  `json:"price_discount"`
 	Quantity        uint          `json:"quantity" db:"quantity"`
 	UpdatedAt       time.Time     `json:"updated_at" db:"updated_at"`
 	Rating          float32       `json:"rating" db:"rating"`
 	ReviewsCount    uint          `json:"reviews_count" db:"reviews_count"`
 	Seller          *Seller       `json:"seller,omitempty"`
+	// HasVariants — товар продаётся только вариантами; цена и остаток родителя
+	// тогда считаются по вариантам
+	HasVariants bool             `json:"has_variants"`
+	Options     []VariantOption  `json:"options,omitempty"`
+	Variants    []ProductVariant `json:"variants,omitempty"`
 }
 
 type ProductDiscount struct {
-	DiscountedPrice   float64   `db:"discounted_price"`
+	DiscountedPrice   Money     `db:"discounted_price"`
 	DiscountEndDate   time.Time `db:"end_date"`
 	DiscountStartDate time.Time `db:"start_date"`
 }
@@ -1,14 +1,115 @@
 package models
 
 import (
-	"github.com/google/uuid"
 	"time"
+
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+type PromoDiscountType string
+
+const (
+	PromoDiscountPercent PromoDiscountType = "percent"
+	PromoDiscountFixed   PromoDiscountType = "fixed"
+)
+
+type PromoScopeType string
+
+const (
+	PromoScopeCategory PromoScopeType = "category"
+	PromoScopeSeller   PromoScopeType = "seller"
+	PromoScopeProduct  PromoScopeType = "product"
 )
 
+// PromoScope ограничивает действие промокода категорией, продавцом или товаром
+type PromoScope struct {
+	Type     PromoScopeType `json:"type"`
+	TargetID uuid.UUID      `json:"target_id"`
+}
+
 type PromoCode struct {
-	ID         uuid.UUID `json:"id"`
-	Code       string    `json:"code"`
-	Percent    int       `json:"percent"`
-	StartDate  time.Time `json:"start_date"`
-	EndDate    time.Time `json:"end_date"`
-}
\ No newline at end of file
+	ID                 uuid.UUID         `json:"id"`
+	Code               string            `json:"code"`
+	Percent            int               `json:"percent"`
+	StartDate          time.Time         `json:"start_date"`
+	EndDate            time.Time         `json:"end_date"`
+	DiscountType       PromoDiscountType `json:"discount_type"`
+	Amount             Money             `json:"amount"`
+	MinOrderAmount     Money             `json:"min_order_amount"`
+	MaxDiscount        NullMoney         `json:"max_discount"`
+	UsageLimit         null.Int          `json:"usage_limit"`
+	PerUserLimit       null.Int          `json:"per_user_limit"`
+	FirstOrderOnly     bool              `json:"first_order_only"`
+	StackWithDiscounts bool              `json:"stack_with_discounts"`
+	Scopes             []PromoScope      `json:"scopes"`
+}
+
+// PromoCartItem — позиция корзины или заказа, к которой может применяться промокод.
+// FinalPrice — цена с учётом скидки на товар, Price — цена без неё.
+type PromoCartItem struct {
+	ProductID   uuid.UUID
+	SellerID    uuid.UUID
+	CategoryIDs []uuid.UUID
+	Price       Money
+	FinalPrice  Money
+	Quantity    uint
+}
+
+// PromoProductScope — продавец и категории товара для проверки области действия промокода
+type PromoProductScope struct {
+	SellerID    uuid.UUID
+	CategoryIDs []uuid.UUID
+}
+
+// PromoUsage — сколько раз промокод уже использован и сколько заказов у пользователя
+type PromoUsage struct {
+	Total      int
+	ByUser     int
+	UserOrders int
+}
+
+// PromoRedemption — факт использования промокода в заказе
+type PromoRedemption struct {
+	ID           uuid.UUID
+	PromoID      uuid.UUID
+	UserID       uuid.UUID
+	Discount     Money
+	UsageLimit   null.Int
+	PerUserLimit null.Int
+	// FirstOrderOnly — код действует только на первый заказ пользователя
+	FirstOrderOnly bool
+}
+
+// PromoCampaign — партия одноразовых промокодов с общими правилами.
+// Template хранит правила, которые копируются в каждый сгенерированный код.
+type PromoCampaign struct {
+	ID         uuid.UUID
+	Name       string
+	Prefix     string
+	Alphabet   string
+	CodeLength int
+	CodesCount int
+	IsActive   bool
+	CreatedAt  time.Time
+	Template   PromoCode
+}
+
+// PromoCampaignCode — код кампании и его состояние для выгрузки
+type PromoCampaignCode struct {
+	Code     string
+	IsActive bool
+	Redeemed bool
+}
+
+// PromoCampaignStats — аналитика кампании по оформленным заказам
+type PromoCampaignStats struct {
+	CampaignID      uuid.UUID
+	Name            string
+	IsActive        bool
+	CodesTotal      int
+	Redemptions     int
+	Revenue         Money
+	TotalDiscount   Money
+	AverageDiscount Money
+}
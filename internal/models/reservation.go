@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReservationStatus string
+
+const (
+	// ReservationHeld — товар списан из остатка и ждёт оформления заказа
+	ReservationHeld ReservationStatus = "held"
+	// ReservationConfirmed — резерв перешёл в заказ
+	ReservationConfirmed ReservationStatus = "confirmed"
+	// ReservationReleased — резерв отменён или истёк, товар возвращён в остаток
+	ReservationReleased ReservationStatus = "released"
+)
+
+type StockReservation struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	ProductID uuid.UUID
+	VariantID uuid.NullUUID
+	Quantity  uint
+	Status    ReservationStatus
+	ExpiresAt time.Time
+}
@@ -1,23 +1,113 @@
 package models
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/guregu/null"
 )
 
 type ReviewDB struct {
+	ID               uuid.UUID    `json:"id" db:"id"`
+	UserID           uuid.UUID    `json:"user_id" db:"user_id"`
+	ProductID        uuid.UUID    `json:"product_id" db:"product_id"`
+	Rating           int          `json:"rating" db:"rating"`
+	Comment          string       `json:"comment" db:"comment"`
+	VerifiedPurchase bool         `json:"verified_purchase" db:"verified_purchase"`
+	Status           ReviewStatus `json:"status" db:"status"`
+	ModerationReason string       `json:"moderation_reason" db:"moderation_reason"`
+}
+
+type Review struct {
+	ID               uuid.UUID    `json:"id"`
+	Name             string       `json:"name"`
+	Surname          null.String  `json:"surname"`
+	ImageURL         null.String  `json:"image_url"`
+	Rating           int          `json:"rating"`
+	Comment          string       `json:"comment"`
+	Photos           []string     `json:"photos"`
+	HelpfulCount     int          `json:"helpful_count"`
+	UnhelpfulCount   int          `json:"unhelpful_count"`
+	Reply            *ReviewReply `json:"reply"`
+	CreatedAt        time.Time    `json:"created_at"`
+	VerifiedPurchase bool         `json:"verified_purchase"`
+}
+
+type ReviewReply struct {
+	Text      string    `json:"text"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ReviewSummary — сводка по опубликованным отзывам товара
+type ReviewSummary struct {
+	ProductID uuid.UUID `json:"product_id"`
+	Total     int       `json:"total"`
+	// StarCounts[i] — количество отзывов с оценкой i+1
+	StarCounts      [5]int    `json:"star_counts"`
+	WithPhotosShare float64   `json:"with_photos_share"`
+	VerifiedShare   float64   `json:"verified_share"`
+	Recent          []*Review `json:"recent"`
+}
+
+// ModerationReview описывает отзыв в очереди модерации
+type ModerationReview struct {
+	ID          uuid.UUID `json:"id"`
+	ProductID   uuid.UUID `json:"product_id"`
+	ProductName string    `json:"product_name"`
+	UserID      uuid.UUID `json:"user_id"`
+	UserName    string    `json:"user_name"`
+	Rating      int       `json:"rating"`
+	Comment     string    `json:"comment"`
+	Reason      string    `json:"reason"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type ReviewPhotoDB struct {
+	ID       uuid.UUID `json:"id" db:"id"`
+	ReviewID uuid.UUID `json:"review_id" db:"review_id"`
+	ImageURL string    `json:"image_url" db:"image_url"`
+}
+
+type ReviewVoteDB struct {
 	ID        uuid.UUID `json:"id" db:"id"`
+	ReviewID  uuid.UUID `json:"review_id" db:"review_id"`
 	UserID    uuid.UUID `json:"user_id" db:"user_id"`
-	ProductID uuid.UUID `json:"product_id" db:"product_id"`
-	Rating    int       `json:"rating" db:"rating"`
-	Comment   string    `json:"comment" db:"comment"`
+	IsHelpful bool      `json:"is_helpful" db:"is_helpful"`
 }
 
-type Review struct {
-	ID       uuid.UUID `json:"id"`
-	Name     string    `json:"name"`
-	Surname  null.String    `json:"surname"`
-	ImageURL null.String    `json:"image_url"`
-	Rating   int       `json:"rating"`
-	Comment  string    `json:"comment"`
-}
\ No newline at end of file
+type ReviewReplyDB struct {
+	ID       uuid.UUID `json:"id" db:"id"`
+	ReviewID uuid.UUID `json:"review_id" db:"review_id"`
+	SellerID uuid.UUID `json:"seller_id" db:"seller_id"`
+	Text     string    `json:"text" db:"text"`
+}
+
+// ReviewSort задаёт порядок выдачи отзывов
+type ReviewSort string
+
+const (
+	ReviewSortNewest  ReviewSort = "newest"
+	ReviewSortHighest ReviewSort = "highest"
+	ReviewSortLowest  ReviewSort = "lowest"
+	ReviewSortHelpful ReviewSort = "helpful"
+)
+
+// MaxReviewPhotos ограничивает количество фотографий в одном отзыве
+const MaxReviewPhotos = 5
+
+func (s ReviewSort) IsValid() bool {
+	switch s {
+	case ReviewSortNewest, ReviewSortHighest, ReviewSortLowest, ReviewSortHelpful:
+		return true
+	}
+	return false
+}
+
+// ReviewStatus определяет состояние отзыва в процессе модерации
+type ReviewStatus string
+
+const (
+	ReviewPending   ReviewStatus = "pending"
+	ReviewPublished ReviewStatus = "published"
+	ReviewRejected  ReviewStatus = "rejected"
+)
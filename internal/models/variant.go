@@ -0,0 +1,123 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VariantOptions — значения опций варианта, например {"Размер": "M", "Цвет": "Белый"}
+type VariantOptions map[string]string
+
+// Names возвращает названия опций в алфавитном порядке
+func (o VariantOptions) Names() []string {
+	names := make([]string, 0, len(o))
+	for name := range o {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SameNames сообщает, задан ли у вариантов одинаковый набор опций
+func (o VariantOptions) SameNames(other VariantOptions) bool {
+	if len(o) != len(other) {
+		return false
+	}
+	for name := range o {
+		if _, ok := other[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// String возвращает опции в виде "Размер: M, Цвет: Белый"
+func (o VariantOptions) String() string {
+	parts := make([]string, 0, len(o))
+	for _, name := range o.Names() {
+		parts = append(parts, name+": "+o[name])
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Scan реализует интерфейс sql.Scanner для чтения JSONB из БД
+func (o *VariantOptions) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*o = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, o)
+	case string:
+		return json.Unmarshal([]byte(v), o)
+	default:
+		return fmt.Errorf("failed to scan VariantOptions: unsupported type %T", value)
+	}
+}
+
+// Value реализует интерфейс driver.Valuer для записи в БД
+func (o VariantOptions) Value() (driver.Value, error) {
+	if o == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(o)
+}
+
+// ProductVariant — SKU товара со своими опциями, ценой, остатком и изображениями
+type ProductVariant struct {
+	ID        uuid.UUID      `json:"id"`
+	ProductID uuid.UUID      `json:"product_id"`
+	SKU       string         `json:"sku"`
+	Options   VariantOptions `json:"options"`
+	Price     Money          `json:"price"`
+	// PriceDiscount — цена со скидкой, действующей на вариант; 0 если скидки нет
+	PriceDiscount Money     `json:"price_discount"`
+	Quantity      uint      `json:"quantity"`
+	Images        []string  `json:"images"`
+	Position      int       `json:"position"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// VariantOption — опция товара и все её значения среди вариантов
+type VariantOption struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+// BuildOptionMatrix собирает опции товара из его вариантов. Опции упорядочены
+// по названию, значения — в порядке первого появления среди вариантов
+func BuildOptionMatrix(variants []ProductVariant) []VariantOption {
+	values := make(map[string][]string)
+	seen := make(map[string]map[string]struct{})
+	for _, variant := range variants {
+		for _, name := range variant.Options.Names() {
+			if seen[name] == nil {
+				seen[name] = make(map[string]struct{})
+			}
+			value := variant.Options[name]
+			if _, ok := seen[name][value]; ok {
+				continue
+			}
+			seen[name][value] = struct{}{}
+			values[name] = append(values[name], value)
+		}
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	matrix := make([]VariantOption, 0, len(names))
+	for _, name := range names {
+		matrix = append(matrix, VariantOption{Name: name, Values: values[name]})
+	}
+	return matrix
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Warehouse — склад, на котором собираются заказы
+type Warehouse struct {
+	ID        uuid.UUID
+	Name      string
+	Address   AddressDB
+	CreatedAt time.Time
+}
+
+// WarehouseStock — физический остаток товара или его SKU на складе
+type WarehouseStock struct {
+	ProductID uuid.UUID     `json:"productID"`
+	VariantID uuid.NullUUID `json:"variantID" swaggertype:"primitive,string"`
+	Name      string        `json:"name"`
+	SKU       string        `json:"sku"`
+	Quantity  int           `json:"quantity"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+}
+
+// PickListItem — строка листа сборки: сколько единиц товара или SKU нужно
+// собрать по всем оформленным заказам склада
+type PickListItem struct {
+	ProductID uuid.UUID      `json:"productID"`
+	VariantID uuid.NullUUID  `json:"variantID" swaggertype:"primitive,string"`
+	Name      string         `json:"name"`
+	SKU       string         `json:"sku"`
+	Options   VariantOptions `json:"options,omitempty"`
+	Quantity  int            `json:"quantity"`
+	Orders    int            `json:"orders"`
+}
+
+// RouteCandidate — склад, на котором хватает товара для всего заказа
+type RouteCandidate struct {
+	WarehouseID uuid.UUID
+	Coordinate  string
+}
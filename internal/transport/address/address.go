@@ -1,13 +1,9 @@
 package address
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/utils/validator"
 	"github.com/mailru/easyjson"
 	"net/http"
-	"net/url"
 	"strings"
 
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/domains"
@@ -20,18 +16,13 @@ import (
 
 type AddressHandler struct {
 	addressService address.IAddressUsecase
-	geoapifyAPIKey string
-	httpClient     *http.Client
 }
 
 func NewAddressHandler(
 	u address.IAddressUsecase,
-	geoapifyAPIKey string,
 ) *AddressHandler {
 	return &AddressHandler{
 		addressService: u,
-		geoapifyAPIKey: geoapifyAPIKey,
-		httpClient:     &http.Client{},
 	}
 }
 
@@ -47,6 +38,7 @@ func NewAddressHandler(
 //	@Success		201				"Адрес успешно создан"
 //	@Failure		400				{object}	object	"Неверный формат данных или ID пользователя"
 //	@Failure		401				{object}	object	"Пользователь не авторизован"
+//	@Failure		422				{object}	object	"Адрес не найден геокодером"
 //	@Failure		500				{object}	object	"Ошибка сервера при создании адреса"
 //	@Security		TokenAuth
 //	@Router			/addresses [post]
@@ -91,30 +83,6 @@ func (h *AddressHandler) CreateAddress(w http.ResponseWriter, r *http.Request) {
 
 	logger = logger.WithField("user_id", userID)
 
-	//var geoData *dto.GeoapifyResponse
-	//geoData, err = h.geocodeAddress(r.Context(), createAddressReq)
-	//if err != nil {
-	//	logger.WithError(err).Error("geoapify API error")
-	//	response.SendJSONError(r.Context(), w, http.StatusInternalServerError, "failed to validate address")
-	//	return
-	//}
-	//
-	//var bestMatch *dto.GeoapifyFeature
-	//for _, feature := range geoData.Features {
-	//	if feature.Properties.ResultType == "building" && feature.Properties.Rank.Importance > 0.2 {
-	//		if bestMatch == nil || feature.Properties.Rank.Importance > bestMatch.Properties.Rank.Importance {
-	//			bestMatch = &feature
-	//			break
-	//		}
-	//	}
-	//}
-	//
-	//if bestMatch == nil {
-	//	logger.Warn("no valid building address found")
-	//	response.SendJSONError(r.Context(), w, http.StatusBadRequest, "no valid building address found")
-	//	return
-	//}
-
 	if err := h.addressService.CreateAddress(r.Context(), userID, createAddressReq); err != nil {
 		logger.WithError(err).Error("create address")
 		response.HandleDomainError(r.Context(), w, err, op)
@@ -124,48 +92,6 @@ func (h *AddressHandler) CreateAddress(w http.ResponseWriter, r *http.Request) {
 	response.SendJSONResponse(r.Context(), w, http.StatusCreated, nil)
 }
 
-func (h *AddressHandler) geocodeAddress(ctx context.Context, address dto.AddressDTO) (*dto.GeoapifyResponse, error) {
-	const op = "AddressHandler.geocodeAddress"
-	logger := logctx.GetLogger(ctx).WithField("op", op)
-
-	if !address.AddressString.Valid || address.AddressString.String == "" {
-		logger.Error("address string is empty")
-		return nil, fmt.Errorf("%s: address string is empty", op)
-	}
-
-	encodedAddress := url.QueryEscape(address.AddressString.String)
-
-	apiURL := fmt.Sprintf("https://api.geoapify.com/v1/geocode/search?text=%s&apiKey=%s",
-		encodedAddress,
-		h.geoapifyAPIKey)
-
-	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
-	if err != nil {
-		logger.WithError(err).Error("create request")
-		return nil, fmt.Errorf("%s: failed to create request: %w", op, err)
-	}
-	req.Header.Set("Accept", "application/json")
-
-	resp, err := h.httpClient.Do(req)
-	if err != nil {
-		logger.WithError(err).Error("call Geoapify API")
-		return nil, fmt.Errorf("%s: failed to call Geoapify API: %w", op, err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("Geoapify API returned status: %d", resp.StatusCode)
-	}
-
-	var geoResponse dto.GeoapifyResponse
-	if err = json.NewDecoder(resp.Body).Decode(&geoResponse); err != nil {
-		logger.WithError(err).Error("decode Geoapify response")
-		return nil, fmt.Errorf("%s: failed to decode Geoapify response: %w", op, err)
-	}
-
-	return &geoResponse, nil
-}
-
 // GetAddress godoc
 //
 //	@Summary		Получение списка адресов пользователя
@@ -19,6 +19,8 @@ type IAdminUsecase interface {
 	UpdateProductStatus(ctx context.Context, req dto.UpdateProductStatusRequest) error
 	GetPendingUsers(ctx context.Context, offset int) (dto.UsersResponse, error)
 	UpdateUserRole(ctx context.Context, req dto.UpdateUserRoleRequest) error
+	GetPendingReviews(ctx context.Context, offset int) (dto.ModerationReviewsResponse, error)
+	UpdateReviewStatus(ctx context.Context, req dto.UpdateReviewStatusRequest) error
 }
 
 type AdminService struct {
@@ -114,3 +116,46 @@ func (h *AdminService) UpdateUserRole(w http.ResponseWriter, r *http.Request) {
 
 	response.SendJSONResponse(r.Context(), w, http.StatusOK, nil)
 }
+
+func (h *AdminService) GetPendingReviews(w http.ResponseWriter, r *http.Request) {
+	const op = "AdminService.GetPendingReviews"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	vars := mux.Vars(r)
+	offsetStr := vars["offset"]
+	offset, err := strconv.Atoi(offsetStr)
+	if err != nil {
+		logger.WithError(err).WithField("offset", offsetStr).Error("parse offset")
+		response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+		return
+	}
+
+	reviews, err := h.uc.GetPendingReviews(r.Context(), offset)
+	if err != nil {
+		logger.WithError(err).Error("get pending reviews")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, reviews)
+}
+
+func (h *AdminService) UpdateReviewStatus(w http.ResponseWriter, r *http.Request) {
+	const op = "AdminService.UpdateReviewStatus"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	var req dto.UpdateReviewStatusRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Error("parse request data")
+		response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+		return
+	}
+
+	if err := h.uc.UpdateReviewStatus(r.Context(), req); err != nil {
+		logger.WithError(err).Error("update review status")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, nil)
+}
@@ -0,0 +1,197 @@
+package analytics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/utils/response"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/helpers"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=analytics.go -destination=../../usecase/mocks/analytics_usecase_mock.go -package=mocks IAnalyticsUsecase
+type IAnalyticsUsecase interface {
+	GetSummary(ctx context.Context, sellerID uuid.UUID, period models.AnalyticsPeriod) (dto.SellerAnalyticsDTO, error)
+	GetTopProducts(ctx context.Context, sellerID uuid.UUID, period models.AnalyticsPeriod, limit int) ([]models.TopProduct, error)
+	GetStockOuts(ctx context.Context, sellerID uuid.UUID, period models.AnalyticsPeriod, offset int) ([]models.StockOutProduct, error)
+}
+
+type AnalyticsService struct {
+	u IAnalyticsUsecase
+}
+
+func NewAnalyticsService(u IAnalyticsUsecase) *AnalyticsService {
+	return &AnalyticsService{
+		u: u,
+	}
+}
+
+// GetSummary godoc
+//
+//	@Summary		Аналитика продаж продавца
+//	@Description	Выручка, проданные штуки и заказы, добавления в корзину и конверсия в заказы,
+//	@Description	доля возвратов и средняя оценка отзывов — итогом и по периодам.
+//	@Description	По умолчанию — последние 30 дней с шагом в день. Данные обновляются фоновой задачей.
+//	@Tags			seller
+//	@Produce		json
+//	@Param			from	query		string	false	"Начало периода, YYYY-MM-DD"
+//	@Param			to		query		string	false	"Конец периода включительно, YYYY-MM-DD"
+//	@Param			bucket	query		string	false	"Шаг ряда: day, week или month"
+//	@Success		200		{object}	dto.SellerAnalyticsDTO
+//	@Failure		400		{object}	object
+//	@Failure		422		{object}	object	"Некорректный период или шаг"
+//	@Failure		500		{object}	object
+//	@Router			/seller/analytics/summary [get]
+func (h *AnalyticsService) GetSummary(w http.ResponseWriter, r *http.Request) {
+	const op = "AnalyticsService.GetSummary"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	sellerID, period, ok := h.periodRequest(w, r, op)
+	if !ok {
+		return
+	}
+
+	summary, err := h.u.GetSummary(r.Context(), sellerID, period)
+	if err != nil {
+		logger.WithError(err).Error("get seller analytics")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, summary)
+}
+
+// GetTopProducts godoc
+//
+//	@Summary	Самые продаваемые товары продавца
+//	@Tags		seller
+//	@Produce	json
+//	@Param		from	query		string	false	"Начало периода, YYYY-MM-DD"
+//	@Param		to		query		string	false	"Конец периода включительно, YYYY-MM-DD"
+//	@Param		limit	query		int		false	"Количество товаров, по умолчанию 10, не больше 50"
+//	@Success	200		{array}		models.TopProduct
+//	@Failure	400		{object}	object
+//	@Failure	422		{object}	object	"Некорректный период"
+//	@Failure	500		{object}	object
+//	@Router		/seller/analytics/top-products [get]
+func (h *AnalyticsService) GetTopProducts(w http.ResponseWriter, r *http.Request) {
+	const op = "AnalyticsService.GetTopProducts"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	sellerID, period, ok := h.periodRequest(w, r, op)
+	if !ok {
+		return
+	}
+
+	limit, ok := intQuery(w, r, "limit")
+	if !ok {
+		return
+	}
+
+	products, err := h.u.GetTopProducts(r.Context(), sellerID, period, limit)
+	if err != nil {
+		logger.WithError(err).Error("get top products")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, products)
+}
+
+// GetStockOuts godoc
+//
+//	@Summary		Дни без наличия
+//	@Description	Товары продавца, которых не было в наличии хотя бы день за период, по 20 записей
+//	@Tags			seller
+//	@Produce		json
+//	@Param			from	query		string	false	"Начало периода, YYYY-MM-DD"
+//	@Param			to		query		string	false	"Конец периода включительно, YYYY-MM-DD"
+//	@Param			offset	query		int		false	"Смещение для пагинации"
+//	@Success		200		{array}		models.StockOutProduct
+//	@Failure		400		{object}	object
+//	@Failure		422		{object}	object	"Некорректный период"
+//	@Failure		500		{object}	object
+//	@Router			/seller/analytics/stock-outs [get]
+func (h *AnalyticsService) GetStockOuts(w http.ResponseWriter, r *http.Request) {
+	const op = "AnalyticsService.GetStockOuts"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	sellerID, period, ok := h.periodRequest(w, r, op)
+	if !ok {
+		return
+	}
+
+	offset, ok := intQuery(w, r, "offset")
+	if !ok {
+		return
+	}
+
+	products, err := h.u.GetStockOuts(r.Context(), sellerID, period, offset)
+	if err != nil {
+		logger.WithError(err).Error("get stock outs")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, products)
+}
+
+// periodRequest достаёт продавца из контекста и период из параметров запроса;
+// при ошибке сам отвечает клиенту
+func (h *AnalyticsService) periodRequest(w http.ResponseWriter, r *http.Request, op string) (uuid.UUID, models.AnalyticsPeriod, bool) {
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	sellerID, err := helpers.GetUserIDFromContext(r.Context())
+	if err != nil {
+		logger.WithError(err).Error("get user ID from context")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return uuid.Nil, models.AnalyticsPeriod{}, false
+	}
+
+	query := r.URL.Query()
+	period := models.AnalyticsPeriod{Bucket: models.AnalyticsBucket(query.Get("bucket"))}
+	for _, bound := range []struct {
+		name  string
+		value *time.Time
+	}{
+		{"from", &period.From},
+		{"to", &period.To},
+	} {
+		raw := query.Get(bound.name)
+		if raw == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.DateOnly, raw)
+		if err != nil {
+			logger.WithField(bound.name, raw).Warn("invalid date")
+			response.SendJSONError(r.Context(), w, http.StatusBadRequest, "invalid "+bound.name+" date")
+			return uuid.Nil, models.AnalyticsPeriod{}, false
+		}
+		*bound.value = parsed
+	}
+
+	return sellerID, period, true
+}
+
+// intQuery читает необязательный неотрицательный числовой параметр запроса;
+// при ошибке сам отвечает клиенту
+func intQuery(w http.ResponseWriter, r *http.Request, name string) (int, bool) {
+	raw := r.URL.Query().Get(name)
+	if raw == "" {
+		return 0, true
+	}
+
+	value, err := strconv.Atoi(raw)
+	if err != nil || value < 0 {
+		logctx.GetLogger(r.Context()).WithField(name, raw).Warn("invalid " + name)
+		response.SendJSONError(r.Context(), w, http.StatusBadRequest, "invalid "+name)
+		return 0, false
+	}
+
+	return value, true
+}
@@ -0,0 +1,220 @@
+package attribute
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/utils/response"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+)
+
+//go:generate mockgen -source=attribute.go -destination=../../usecase/mocks/attribute_usecase_mock.go -package=mocks IAttributeUsecase
+type IAttributeUsecase interface {
+	GetCategoryAttributes(ctx context.Context, categoryID uuid.UUID) (dto.CategoryAttributesResponse, error)
+	GetProductAttributes(ctx context.Context, productID uuid.UUID) (dto.ProductAttributesResponse, error)
+	CreateAttribute(ctx context.Context, categoryID uuid.UUID, req dto.CreateAttributeRequest) (*models.CategoryAttribute, error)
+	UpdateAttribute(ctx context.Context, id uuid.UUID, req dto.UpdateAttributeRequest) (*models.CategoryAttribute, error)
+	DeleteAttribute(ctx context.Context, id uuid.UUID) error
+}
+
+type AttributeService struct {
+	u IAttributeUsecase
+}
+
+func NewAttributeService(u IAttributeUsecase) *AttributeService {
+	return &AttributeService{
+		u: u,
+	}
+}
+
+// GetCategoryAttributes godoc
+//
+//	@Summary		Характеристики категории
+//	@Description	Возвращает схему характеристик категории вместе с унаследованными от родительских категорий
+//	@Tags			attributes
+//	@Produce		json
+//	@Param			id	path		string							true	"ID категории"
+//	@Success		200	{object}	dto.CategoryAttributesResponse	"Схема характеристик"
+//	@Failure		400	{object}	object							"Некорректный ID"
+//	@Failure		500	{object}	object							"Внутренняя ошибка сервера"
+//	@Router			/categories/{id}/attributes [get]
+func (h *AttributeService) GetCategoryAttributes(w http.ResponseWriter, r *http.Request) {
+	const op = "AttributeService.GetCategoryAttributes"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	categoryID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse category ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	attributes, err := h.u.GetCategoryAttributes(r.Context(), categoryID)
+	if err != nil {
+		logger.WithError(err).Error("get category attributes")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, attributes)
+}
+
+// GetProductAttributes godoc
+//
+//	@Summary		Характеристики товара
+//	@Description	Возвращает значения характеристик, заданные продавцом
+//	@Tags			attributes
+//	@Produce		json
+//	@Param			id	path		string							true	"ID товара"
+//	@Success		200	{object}	dto.ProductAttributesResponse	"Характеристики товара"
+//	@Failure		400	{object}	object							"Некорректный ID"
+//	@Failure		500	{object}	object							"Внутренняя ошибка сервера"
+//	@Router			/product/{id}/attributes [get]
+func (h *AttributeService) GetProductAttributes(w http.ResponseWriter, r *http.Request) {
+	const op = "AttributeService.GetProductAttributes"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	productID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse product ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	attributes, err := h.u.GetProductAttributes(r.Context(), productID)
+	if err != nil {
+		logger.WithError(err).Error("get product attributes")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, attributes)
+}
+
+// CreateAttribute godoc
+//
+//	@Summary		Добавить характеристику
+//	@Description	Добавляет характеристику в конец схемы категории
+//	@Tags			attributes
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		string						true	"ID категории"
+//	@Param			request			body		dto.CreateAttributeRequest	true	"Данные характеристики"
+//	@Param			X-Csrf-Token	header		string						true	"CSRF-токен для защиты от подделки запросов"
+//	@Success		201				{object}	models.CategoryAttribute	"Характеристика создана"
+//	@Failure		400				{object}	object						"Некорректные данные запроса"
+//	@Failure		404				{object}	object						"Категория не найдена"
+//	@Failure		409				{object}	object						"Характеристика с таким названием уже есть"
+//	@Failure		422				{object}	object						"Некорректная схема характеристики"
+//	@Security		TokenAuth
+//	@Router			/admin/categories/{id}/attributes [post]
+func (h *AttributeService) CreateAttribute(w http.ResponseWriter, r *http.Request) {
+	const op = "AttributeService.CreateAttribute"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	categoryID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse category ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	var req dto.CreateAttributeRequest
+	if err = easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Error("parse request data")
+		response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+		return
+	}
+
+	attribute, err := h.u.CreateAttribute(r.Context(), categoryID, req)
+	if err != nil {
+		logger.WithError(err).Error("create attribute")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusCreated, attribute)
+}
+
+// UpdateAttribute godoc
+//
+//	@Summary		Изменить характеристику
+//	@Description	Меняет название, единицу, варианты и порядок характеристики; тип не меняется
+//	@Tags			attributes
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		string						true	"ID характеристики"
+//	@Param			request			body		dto.UpdateAttributeRequest	true	"Новые данные характеристики"
+//	@Param			X-Csrf-Token	header		string						true	"CSRF-токен для защиты от подделки запросов"
+//	@Success		200				{object}	models.CategoryAttribute	"Характеристика изменена"
+//	@Failure		400				{object}	object						"Некорректные данные запроса"
+//	@Failure		404				{object}	object						"Характеристика не найдена"
+//	@Failure		409				{object}	object						"Характеристика с таким названием уже есть"
+//	@Failure		422				{object}	object						"Удаляемый вариант указан у товаров"
+//	@Security		TokenAuth
+//	@Router			/admin/attributes/{id} [put]
+func (h *AttributeService) UpdateAttribute(w http.ResponseWriter, r *http.Request) {
+	const op = "AttributeService.UpdateAttribute"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse attribute ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	var req dto.UpdateAttributeRequest
+	if err = easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Error("parse request data")
+		response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+		return
+	}
+
+	attribute, err := h.u.UpdateAttribute(r.Context(), id, req)
+	if err != nil {
+		logger.WithError(err).Error("update attribute")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, attribute)
+}
+
+// DeleteAttribute godoc
+//
+//	@Summary		Удалить характеристику
+//	@Description	Удаляет характеристику из схемы категории вместе со значениями у товаров
+//	@Tags			attributes
+//	@Param			id				path	string	true	"ID характеристики"
+//	@Param			X-Csrf-Token	header	string	true	"CSRF-токен для защиты от подделки запросов"
+//	@Success		204				"Характеристика удалена"
+//	@Failure		400				{object}	object	"Некорректный ID"
+//	@Failure		404				{object}	object	"Характеристика не найдена"
+//	@Security		TokenAuth
+//	@Router			/admin/attributes/{id} [delete]
+func (h *AttributeService) DeleteAttribute(w http.ResponseWriter, r *http.Request) {
+	const op = "AttributeService.DeleteAttribute"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse attribute ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	if err = h.u.DeleteAttribute(r.Context(), id); err != nil {
+		logger.WithError(err).Error("delete attribute")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusNoContent, nil)
+}
@@ -5,8 +5,11 @@ import (
 	"net/http"
 
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/auth"
 	gen "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/generated/auth"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/jwt"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/utils/metadata"
+	"github.com/google/uuid"
 
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/domains"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
@@ -18,17 +21,23 @@ import (
 )
 
 type AuthHandler struct {
-	authClient gen.AuthServiceClient
-	config     *config.Config
+	authClient   gen.AuthServiceClient
+	config       *config.Config
+	tokenator    *jwt.Tokenator
+	basketMerger auth.IGuestBasketMerger
 }
 
 func NewAuthHandler(
 	authClient gen.AuthServiceClient,
 	cfg *config.Config,
+	tokenator *jwt.Tokenator,
+	basketMerger auth.IGuestBasketMerger,
 ) *AuthHandler {
 	return &AuthHandler{
-		authClient: authClient,
-		config:     cfg,
+		authClient:   authClient,
+		config:       cfg,
+		tokenator:    tokenator,
+		basketMerger: basketMerger,
 	}
 }
 
@@ -81,6 +90,8 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	cookieProvider.Set(w, res.Token, domains.TokenCookieName)
 	w.Header().Set("X-CSRF-Token", csrfToken)
 
+	h.mergeGuestBasket(w, r, res.Token)
+
 	response.SendJSONResponse(r.Context(), w, http.StatusOK, nil)
 }
 
@@ -130,6 +141,8 @@ func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 	cookieProvider.Set(w, res.Token, domains.TokenCookieName)
 	w.Header().Set("X-CSRF-Token", csrfToken)
 
+	h.mergeGuestBasket(w, r, res.Token)
+
 	response.SendJSONResponse(r.Context(), w, http.StatusOK, nil)
 }
 
@@ -167,3 +180,39 @@ func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
 
 	response.SendJSONResponse(r.Context(), w, http.StatusOK, nil)
 }
+
+// mergeGuestBasket переносит гостевую корзину в корзину вошедшего пользователя.
+// Ошибка не мешает входу: гостевая корзина сохранится до следующего входа.
+func (h *AuthHandler) mergeGuestBasket(w http.ResponseWriter, r *http.Request, token string) {
+	const op = "AuthHandler.mergeGuestBasket"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	if _, err := r.Cookie(domains.GuestBasketCookieName); err != nil {
+		return
+	}
+
+	guestID, err := middleware.GuestIDFromRequest(r, h.config.GuestBasketConfig.SecretKey)
+	if err != nil {
+		logger.WithError(err).Warn("parse guest basket cookie")
+		return
+	}
+
+	claims, err := h.tokenator.ParseJWT(token)
+	if err != nil {
+		logger.WithError(err).Error("parse token")
+		return
+	}
+
+	userID, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		logger.WithError(err).Error("parse user ID")
+		return
+	}
+
+	if err = h.basketMerger.MergeGuest(r.Context(), guestID, userID); err != nil {
+		logger.WithError(err).Error("merge guest basket")
+		return
+	}
+
+	cookie.NewCookieProvider(h.config).Unset(w, domains.GuestBasketCookieName)
+}
@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/google/uuid"
 )
 
 //go:generate mockgen -source=interface.go -destination=../../usecase/mocks/auth_usecase_mock.go -package=mocks IAuthUsecase
@@ -11,3 +12,8 @@ type IAuthUsecase interface {
 	Login(context.Context, dto.UserLoginRequestDTO) (string, error)
 	Logout(context.Context, string) error
 }
+
+// IGuestBasketMerger переносит гостевую корзину в корзину пользователя после входа
+type IGuestBasketMerger interface {
+	MergeGuest(ctx context.Context, guestID, userID uuid.UUID) error
+}
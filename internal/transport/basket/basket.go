@@ -6,9 +6,11 @@ import (
 	"net/http"
 
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/utils/response"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/helpers"
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	"github.com/sirupsen/logrus"
@@ -17,10 +19,11 @@ import (
 //go:generate mockgen -source=basket.go -destination=../../usecase/mocks/basket_usecase_mock.go -package=mocks IBasketUsecase
 type IBasketUsecase interface {
 	Get(ctx context.Context) ([]*models.BasketItem, error)
-	Add(ctx context.Context, productID uuid.UUID) (*models.BasketItem, error)
-	Delete(ctx context.Context, productID uuid.UUID) error
-	UpdateQuantity(ctx context.Context, productID uuid.UUID, quantity int) (*models.BasketItem, error)
+	Add(ctx context.Context, productID uuid.UUID, variantID uuid.NullUUID) (*models.BasketItem, error)
+	Delete(ctx context.Context, productID uuid.UUID, variantID uuid.NullUUID) error
+	UpdateQuantity(ctx context.Context, productID uuid.UUID, variantID uuid.NullUUID, quantity int) (*models.BasketItem, error)
 	Clear(ctx context.Context) error
+	Quote(ctx context.Context, promoCode string) (dto.BasketQuoteResponse, error)
 }
 
 type BasketService struct {
@@ -56,7 +59,8 @@ func (h *BasketService) Get(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	responseBasket := dto.ConvertToBasketResponse(items)
+	rate := helpers.GetExchangeRateFromContext(r.Context())
+	responseBasket := dto.ConvertToBasketResponse(items).InCurrency(rate)
 
 	response.SendJSONResponse(r.Context(), w, http.StatusOK, responseBasket)
 }
@@ -69,6 +73,7 @@ func (h *BasketService) Get(w http.ResponseWriter, r *http.Request) {
 //	@Accept			json
 //	@Produce		json
 //	@Param			id				path		string	true	"ID товара в формате UUID"
+//	@Param			variant			query		string	false	"ID варианта (SKU), обязателен для товаров с вариантами"
 //	@Param			X-Csrf-Token	header		string	true	"CSRF-токен для защиты от подделки запросов"
 //	@Success		201				{object}	models.BasketItem
 //	@Failure		400				{object}	object
@@ -90,12 +95,23 @@ func (h *BasketService) Add(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	item, err := h.u.Add(r.Context(), productID)
+	variantID, err := parseVariantID(r)
+	if err != nil {
+		logger.WithError(err).Error("parse variant ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	item, err := h.u.Add(r.Context(), productID, variantID)
 	if err != nil {
 		logger.WithField("product_id", productID).WithError(err).Error("add product to basket")
 		response.HandleDomainError(r.Context(), w, err, op)
 		return
 	}
+	if item != nil {
+		converted := dto.BasketItemInCurrency(*item, helpers.GetExchangeRateFromContext(r.Context()))
+		item = &converted
+	}
 
 	response.SendJSONResponse(r.Context(), w, http.StatusCreated, item)
 }
@@ -106,6 +122,7 @@ func (h *BasketService) Add(w http.ResponseWriter, r *http.Request) {
 //	@Description	Удаляет товар из корзины пользователя
 //	@Tags			basket
 //	@Param			id				path	string	true	"ID товара в формате UUID"
+//	@Param			variant			query	string	false	"ID варианта (SKU)"
 //	@Param			X-Csrf-Token	header	string	true	"CSRF-токен для защиты от подделки запросов"
 //	@Success		204
 //	@Failure		400	{object}	object
@@ -127,7 +144,14 @@ func (h *BasketService) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.u.Delete(r.Context(), productID); err != nil {
+	variantID, err := parseVariantID(r)
+	if err != nil {
+		logger.WithError(err).Error("parse variant ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	if err := h.u.Delete(r.Context(), productID, variantID); err != nil {
 		logger.WithField("product_id", productID).WithError(err).Error("delete product from basket")
 		response.HandleDomainError(r.Context(), w, err, op)
 		return
@@ -144,6 +168,7 @@ func (h *BasketService) Delete(w http.ResponseWriter, r *http.Request) {
 //	@Accept			json
 //	@Produce		json
 //	@Param			id				path		string						true	"ID товара в формате UUID"
+//	@Param			variant			query		string						false	"ID варианта (SKU)"
 //	@Param			request			body		dto.UpdateQuantityRequest	true	"Новое количество"
 //	@Param			X-Csrf-Token	header		string						true	"CSRF-токен для защиты от подделки запросов"
 //	@Success		200				{object}	dto.UpdateQuantityResponse
@@ -173,19 +198,27 @@ func (h *BasketService) UpdateQuantity(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	variantID, err := parseVariantID(r)
+	if err != nil {
+		logger.WithError(err).Error("parse variant ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
 	logger = logger.WithFields(logrus.Fields{
 		"product_id": productID,
 		"quantity":   req.Quantity,
 	})
 
-	item, err := h.u.UpdateQuantity(r.Context(), productID, req.Quantity)
+	item, err := h.u.UpdateQuantity(r.Context(), productID, variantID, req.Quantity)
 	if err != nil {
 		logger.WithError(err).Error("update product quantity")
 		response.HandleDomainError(r.Context(), w, err, op)
 		return
 	}
 
-	resp := dto.ConvertToQuantityResponse(item)
+	rate := helpers.GetExchangeRateFromContext(r.Context())
+	resp := dto.ConvertToQuantityResponse(item).InCurrency(rate)
 
 	response.SendJSONResponse(r.Context(), w, http.StatusOK, resp)
 }
@@ -213,3 +246,59 @@ func (h *BasketService) Clear(w http.ResponseWriter, r *http.Request) {
 
 	response.SendJSONResponse(r.Context(), w, http.StatusNoContent, nil)
 }
+
+// QuoteBasket godoc
+//
+//	@Summary		Рассчитать стоимость корзины
+//	@Description	Возвращает суммы по позициям, скидки, эффект промокода, стоимость доставки и итог так же, как их посчитает оформление заказа.
+//	@Description	Суммы показываются в выбранной валюте, к оплате — charge_total в рублях.
+//	@Tags			basket
+//	@Accept			json
+//	@Produce		json
+//	@Param			X-Csrf-Token	header		string					true	"CSRF-токен для защиты от подделки запросов"
+//	@Param			input			body		dto.BasketQuoteRequest	false	"Промокод"
+//	@Success		200				{object}	dto.BasketQuoteResponse
+//	@Failure		400				{object}	object
+//	@Failure		401				{object}	object
+//	@Failure		404				{object}	object
+//	@Failure		500				{object}	object
+//	@Security		TokenAuth
+//	@Router			/basket/quote [post]
+func (h *BasketService) Quote(w http.ResponseWriter, r *http.Request) {
+	const op = "BasketService.Quote"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	var req dto.BasketQuoteRequest
+	if r.ContentLength != 0 {
+		if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+			logger.WithError(err).Error("parse request data")
+			response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+			return
+		}
+	}
+
+	quote, err := h.u.Quote(r.Context(), req.PromoCode)
+	if err != nil {
+		logger.WithError(err).Error("quote basket")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	rate := helpers.GetExchangeRateFromContext(r.Context())
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, quote.InCurrency(rate))
+}
+
+// parseVariantID читает необязательный параметр variant — SKU товара с вариантами
+func parseVariantID(r *http.Request) (uuid.NullUUID, error) {
+	raw := r.URL.Query().Get("variant")
+	if raw == "" {
+		return uuid.NullUUID{}, nil
+	}
+
+	variantID, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.NullUUID{}, err
+	}
+
+	return uuid.NullUUID{UUID: variantID, Valid: true}, nil
+}
@@ -0,0 +1,248 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/utils/response"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/helpers"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+//go:generate mockgen -source=catalog.go -destination=../../usecase/mocks/catalog_usecase_mock.go -package=mocks ICatalogUsecase
+type ICatalogUsecase interface {
+	StartImport(ctx context.Context, sellerID uuid.UUID, fileName string, data []byte) (*models.CatalogImport, error)
+	GetImport(ctx context.Context, sellerID, id uuid.UUID) (*models.CatalogImport, error)
+	GetImportReport(ctx context.Context, sellerID, id uuid.UUID) ([]byte, models.CatalogFormat, error)
+	Export(ctx context.Context, sellerID uuid.UUID, format models.CatalogFormat) ([]byte, error)
+}
+
+const (
+	// maxUploadMemory — сколько файла держится в памяти при разборе формы, остальное уходит во временный файл
+	maxUploadMemory = 10 << 20
+	// maxFormOverhead — запас на заголовки и границы multipart сверх размера самого файла
+	maxFormOverhead = 1 << 20
+)
+
+type CatalogService struct {
+	u    ICatalogUsecase
+	conf *config.CatalogImportConfig
+}
+
+func NewCatalogService(u ICatalogUsecase, conf *config.CatalogImportConfig) *CatalogService {
+	return &CatalogService{
+		u:    u,
+		conf: conf,
+	}
+}
+
+// StartImport godoc
+//
+//	@Summary		Импортировать каталог из файла
+//	@Description	Загружает CSV или XLSX с колонками sku, name, description, category, price, quantity.
+//	@Description	Товары с известным артикулом обновляются, остальные создаются; файл обрабатывается в фоне.
+//	@Tags			seller
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			file	formData	file	true	"Файл каталога (.csv или .xlsx)"
+//	@Success		202		{object}	models.CatalogImport
+//	@Failure		400		{object}	object
+//	@Failure		422		{object}	object	"Неподдерживаемый формат или размер файла"
+//	@Failure		500		{object}	object
+//	@Router			/seller/catalog/imports [post]
+func (h *CatalogService) StartImport(w http.ResponseWriter, r *http.Request) {
+	const op = "CatalogService.StartImport"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	sellerID, err := helpers.GetUserIDFromContext(r.Context())
+	if err != nil {
+		logger.WithError(err).Error("get user ID from context")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	// Тело ограничивается до разбора формы, чтобы слишком большой файл
+	// не читался целиком ни в память, ни во временный файл
+	r.Body = http.MaxBytesReader(w, r.Body, h.conf.MaxFileSize+maxFormOverhead)
+	if err = r.ParseMultipartForm(maxUploadMemory); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			logger.WithError(err).Warn("catalog file is too large")
+			response.HandleDomainError(r.Context(), w,
+				errs.NewBusinessLogicError(fmt.Sprintf("file is larger than %d bytes", h.conf.MaxFileSize)), op)
+			return
+		}
+		logger.WithError(err).Error("parse multipart form")
+		response.SendJSONError(r.Context(), w, http.StatusBadRequest, "failed to parse form")
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		logger.WithError(err).Error("get file from form")
+		response.SendJSONError(r.Context(), w, http.StatusBadRequest, "no file uploaded")
+		return
+	}
+	defer file.Close()
+
+	// Лишний байт сверх предела оставляет usecase возможность отклонить файл по размеру
+	data, err := io.ReadAll(io.LimitReader(file, h.conf.MaxFileSize+1))
+	if err != nil {
+		logger.WithError(err).Error("read file content")
+		response.SendJSONError(r.Context(), w, http.StatusBadRequest, "failed to read file")
+		return
+	}
+
+	job, err := h.u.StartImport(r.Context(), sellerID, header.Filename, data)
+	if err != nil {
+		logger.WithError(err).Error("start catalog import")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusAccepted, job)
+}
+
+// GetImport godoc
+//
+//	@Summary		Статус импорта каталога
+//	@Description	Возвращает состояние задачи импорта и число созданных, обновлённых и отклонённых строк
+//	@Tags			seller
+//	@Produce		json
+//	@Param			id	path		string	true	"ID задачи импорта"
+//	@Success		200	{object}	models.CatalogImport
+//	@Failure		400	{object}	object
+//	@Failure		404	{object}	object
+//	@Failure		500	{object}	object
+//	@Router			/seller/catalog/imports/{id} [get]
+func (h *CatalogService) GetImport(w http.ResponseWriter, r *http.Request) {
+	const op = "CatalogService.GetImport"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	sellerID, importID, ok := h.importRequest(w, r, op)
+	if !ok {
+		return
+	}
+
+	job, err := h.u.GetImport(r.Context(), sellerID, importID)
+	if err != nil {
+		logger.WithError(err).Error("get catalog import")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, job)
+}
+
+// GetImportReport godoc
+//
+//	@Summary		Отчёт об ошибках импорта
+//	@Description	Скачивает отклонённые строки (row, sku, message) в формате исходного файла
+//	@Tags			seller
+//	@Produce		octet-stream
+//	@Param			id	path		string	true	"ID задачи импорта"
+//	@Success		200	{file}		file
+//	@Failure		400	{object}	object
+//	@Failure		404	{object}	object
+//	@Failure		422	{object}	object	"Импорт ещё не завершён"
+//	@Failure		500	{object}	object
+//	@Router			/seller/catalog/imports/{id}/report [get]
+func (h *CatalogService) GetImportReport(w http.ResponseWriter, r *http.Request) {
+	const op = "CatalogService.GetImportReport"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	sellerID, importID, ok := h.importRequest(w, r, op)
+	if !ok {
+		return
+	}
+
+	report, format, err := h.u.GetImportReport(r.Context(), sellerID, importID)
+	if err != nil {
+		logger.WithError(err).Error("get import report")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	sendFile(w, r, fmt.Sprintf("import-%s-errors.%s", importID, format), format, report)
+}
+
+// Export godoc
+//
+//	@Summary		Выгрузить каталог
+//	@Description	Скачивает все товары продавца в формате, пригодном для повторного импорта
+//	@Tags			seller
+//	@Produce		octet-stream
+//	@Param			format	query		string	false	"csv (по умолчанию) или xlsx"
+//	@Success		200		{file}		file
+//	@Failure		400		{object}	object
+//	@Failure		500		{object}	object
+//	@Router			/seller/catalog/export [get]
+func (h *CatalogService) Export(w http.ResponseWriter, r *http.Request) {
+	const op = "CatalogService.Export"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	sellerID, err := helpers.GetUserIDFromContext(r.Context())
+	if err != nil {
+		logger.WithError(err).Error("get user ID from context")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	format := models.CatalogCSV
+	if value := r.URL.Query().Get("format"); value != "" {
+		if format, err = models.ParseCatalogFormat(value); err != nil {
+			response.SendJSONError(r.Context(), w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	data, err := h.u.Export(r.Context(), sellerID, format)
+	if err != nil {
+		logger.WithError(err).Error("export catalog")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	sendFile(w, r, "catalog."+string(format), format, data)
+}
+
+// importRequest достаёт продавца из контекста и ID задачи из пути
+func (h *CatalogService) importRequest(w http.ResponseWriter, r *http.Request, op string) (uuid.UUID, uuid.UUID, bool) {
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	sellerID, err := helpers.GetUserIDFromContext(r.Context())
+	if err != nil {
+		logger.WithError(err).Error("get user ID from context")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	importID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse import ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return sellerID, importID, true
+}
+
+func sendFile(w http.ResponseWriter, r *http.Request, name string, format models.CatalogFormat, data []byte) {
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, name))
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := w.Write(data); err != nil {
+		logctx.GetLogger(r.Context()).WithError(err).Error("write file")
+	}
+}
@@ -2,8 +2,10 @@ package category
 
 import (
 	"context"
+	"io"
 	"net/http"
 
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/minio"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
@@ -11,6 +13,7 @@ import (
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/utils/response"
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
 )
 
 //go:generate mockgen -source=category.go -destination=../../usecase/mocks/category_usecase_mock.go -package=mocks ICategoryUsecase
@@ -18,15 +21,25 @@ type ICategoryUsecase interface {
 	GetAllCategories(ctx context.Context) ([]*models.Category, error)
 	GetAllSubategories(ctx context.Context, category_id uuid.UUID) ([]*models.Category, error)
 	GetNameSubcategory(ctx context.Context, id uuid.UUID) (string, error)
+	GetCategoryTree(ctx context.Context) (dto.CategoryTreeResponse, error)
+	GetCategoryBySlug(ctx context.Context, slug string) (*models.Category, error)
+	GetBreadcrumbs(ctx context.Context, id uuid.UUID) (dto.BreadcrumbsResponse, error)
+	CreateCategory(ctx context.Context, req dto.CreateCategoryRequest) (*models.Category, error)
+	UpdateCategory(ctx context.Context, id uuid.UUID, req dto.UpdateCategoryRequest) (*models.Category, error)
+	SetCategoryImage(ctx context.Context, id uuid.UUID, imageURL string) error
+	MoveCategory(ctx context.Context, id uuid.UUID, req dto.MoveCategoryRequest) error
+	DeleteCategory(ctx context.Context, id uuid.UUID) error
 }
 
 type CategoryService struct {
-	u ICategoryUsecase
+	u            ICategoryUsecase
+	minioService minio.Provider
 }
 
-func NewCategoryService(u ICategoryUsecase) *CategoryService {
+func NewCategoryService(u ICategoryUsecase, ms minio.Provider) *CategoryService {
 	return &CategoryService{
-		u: u,
+		u:            u,
+		minioService: ms,
 	}
 }
 
@@ -104,4 +117,299 @@ func (h *CategoryService) GetNameSubcategory(w http.ResponseWriter, r *http.Requ
 	resp.Name = name
 
 	response.SendJSONResponse(r.Context(), w, http.StatusOK, resp)
-}
\ No newline at end of file
+}
+
+// GetCategoryTree godoc
+//
+//	@Summary		Дерево категорий
+//	@Description	Возвращает все категории с вложенными подкатегориями
+//	@Tags			categories
+//	@Produce		json
+//	@Success		200	{object}	dto.CategoryTreeResponse	"Дерево категорий"
+//	@Failure		500	{object}	object						"Внутренняя ошибка сервера"
+//	@Router			/categories/tree [get]
+func (h *CategoryService) GetCategoryTree(w http.ResponseWriter, r *http.Request) {
+	const op = "CategoryService.GetCategoryTree"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	tree, err := h.u.GetCategoryTree(r.Context())
+	if err != nil {
+		logger.WithError(err).Error("get category tree")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, tree)
+}
+
+// GetCategoryBySlug godoc
+//
+//	@Summary		Категория по slug
+//	@Tags			categories
+//	@Produce		json
+//	@Param			slug	path		string			true	"Slug категории"
+//	@Success		200		{object}	models.Category	"Категория"
+//	@Failure		404		{object}	object			"Категория не найдена"
+//	@Router			/categories/slug/{slug} [get]
+func (h *CategoryService) GetCategoryBySlug(w http.ResponseWriter, r *http.Request) {
+	const op = "CategoryService.GetCategoryBySlug"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	category, err := h.u.GetCategoryBySlug(r.Context(), mux.Vars(r)["slug"])
+	if err != nil {
+		logger.WithError(err).Error("get category by slug")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, category)
+}
+
+// GetBreadcrumbs godoc
+//
+//	@Summary		Хлебные крошки категории
+//	@Description	Возвращает путь от корневой категории до запрошенной
+//	@Tags			categories
+//	@Produce		json
+//	@Param			id	path		string					true	"ID категории"
+//	@Success		200	{object}	dto.BreadcrumbsResponse	"Путь к категории"
+//	@Failure		400	{object}	object					"Некорректный ID"
+//	@Failure		404	{object}	object					"Категория не найдена"
+//	@Router			/categories/{id}/breadcrumbs [get]
+func (h *CategoryService) GetBreadcrumbs(w http.ResponseWriter, r *http.Request) {
+	const op = "CategoryService.GetBreadcrumbs"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse category ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	breadcrumbs, err := h.u.GetBreadcrumbs(r.Context(), id)
+	if err != nil {
+		logger.WithError(err).Error("get breadcrumbs")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, breadcrumbs)
+}
+
+// CreateCategory godoc
+//
+//	@Summary		Создать категорию
+//	@Tags			categories
+//	@Accept			json
+//	@Produce		json
+//	@Param			request			body		dto.CreateCategoryRequest	true	"Категория"
+//	@Param			X-Csrf-Token	header		string						true	"CSRF-токен для защиты от подделки запросов"
+//	@Success		201				{object}	models.Category				"Категория создана"
+//	@Failure		404				{object}	object						"Родительская категория не найдена"
+//	@Failure		409				{object}	object						"Slug или название уже заняты"
+//	@Failure		422				{object}	object						"Некорректные данные категории"
+//	@Security		TokenAuth
+//	@Router			/admin/categories [post]
+func (h *CategoryService) CreateCategory(w http.ResponseWriter, r *http.Request) {
+	const op = "CategoryService.CreateCategory"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	var req dto.CreateCategoryRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Error("parse request data")
+		response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+		return
+	}
+
+	category, err := h.u.CreateCategory(r.Context(), req)
+	if err != nil {
+		logger.WithError(err).Error("create category")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusCreated, category)
+}
+
+// UpdateCategory godoc
+//
+//	@Summary		Изменить категорию
+//	@Description	Меняет название, slug и изображение категории; пустые поля не меняются
+//	@Tags			categories
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		string						true	"ID категории"
+//	@Param			request			body		dto.UpdateCategoryRequest	true	"Новые данные категории"
+//	@Param			X-Csrf-Token	header		string						true	"CSRF-токен для защиты от подделки запросов"
+//	@Success		200				{object}	models.Category				"Категория изменена"
+//	@Failure		404				{object}	object						"Категория не найдена"
+//	@Failure		409				{object}	object						"Slug или название уже заняты"
+//	@Failure		422				{object}	object						"Некорректные данные категории"
+//	@Security		TokenAuth
+//	@Router			/admin/categories/{id} [put]
+func (h *CategoryService) UpdateCategory(w http.ResponseWriter, r *http.Request) {
+	const op = "CategoryService.UpdateCategory"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse category ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	var req dto.UpdateCategoryRequest
+	if err = easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Error("parse request data")
+		response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+		return
+	}
+
+	category, err := h.u.UpdateCategory(r.Context(), id, req)
+	if err != nil {
+		logger.WithError(err).Error("update category")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, category)
+}
+
+// MoveCategory godoc
+//
+//	@Summary		Переместить категорию
+//	@Description	Переносит категорию с поддеревом к другому родителю и/или меняет её позицию среди соседей
+//	@Tags			categories
+//	@Accept			json
+//	@Param			id				path	string					true	"ID категории"
+//	@Param			request			body	dto.MoveCategoryRequest	true	"Новый родитель и позиция"
+//	@Param			X-Csrf-Token	header	string					true	"CSRF-токен для защиты от подделки запросов"
+//	@Success		204				"Категория перемещена"
+//	@Failure		404				{object}	object	"Категория не найдена"
+//	@Failure		422				{object}	object	"Перенос в собственное поддерево"
+//	@Security		TokenAuth
+//	@Router			/admin/categories/{id}/move [post]
+func (h *CategoryService) MoveCategory(w http.ResponseWriter, r *http.Request) {
+	const op = "CategoryService.MoveCategory"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse category ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	var req dto.MoveCategoryRequest
+	if err = easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Error("parse request data")
+		response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+		return
+	}
+
+	if err = h.u.MoveCategory(r.Context(), id, req); err != nil {
+		logger.WithError(err).Error("move category")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusNoContent, nil)
+}
+
+// DeleteCategory godoc
+//
+//	@Summary		Удалить категорию
+//	@Description	Удаляет категорию без подкатегорий и товаров
+//	@Tags			categories
+//	@Param			id				path	string	true	"ID категории"
+//	@Param			X-Csrf-Token	header	string	true	"CSRF-токен для защиты от подделки запросов"
+//	@Success		204				"Категория удалена"
+//	@Failure		404				{object}	object	"Категория не найдена"
+//	@Failure		422				{object}	object	"В категории есть подкатегории или товары"
+//	@Security		TokenAuth
+//	@Router			/admin/categories/{id} [delete]
+func (h *CategoryService) DeleteCategory(w http.ResponseWriter, r *http.Request) {
+	const op = "CategoryService.DeleteCategory"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse category ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	if err = h.u.DeleteCategory(r.Context(), id); err != nil {
+		logger.WithError(err).Error("delete category")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusNoContent, nil)
+}
+
+// UploadCategoryImage godoc
+//
+//	@Summary		Загрузить изображение категории
+//	@Tags			categories
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			id				path		string	true	"ID категории"
+//	@Param			file			formData	file	true	"Изображение категории"
+//	@Param			X-Csrf-Token	header		string	true	"CSRF-токен для защиты от подделки запросов"
+//	@Success		200				{object}	dto.UploadResponse	"Изображение загружено"
+//	@Failure		400				{object}	object				"Файл не передан"
+//	@Failure		404				{object}	object				"Категория не найдена"
+//	@Security		TokenAuth
+//	@Router			/admin/categories/{id}/image [post]
+func (h *CategoryService) UploadCategoryImage(w http.ResponseWriter, r *http.Request) {
+	const op = "CategoryService.UploadCategoryImage"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse category ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	if err = r.ParseMultipartForm(10 << 20); err != nil { // 10 MB max
+		logger.WithError(err).Error("parse multipart form")
+		response.SendJSONError(r.Context(), w, http.StatusBadRequest, "failed to parse form")
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		logger.WithError(err).Error("get file from form")
+		response.SendJSONError(r.Context(), w, http.StatusBadRequest, "no file uploaded")
+		return
+	}
+	defer file.Close()
+
+	fileBytes, err := io.ReadAll(file)
+	if err != nil {
+		logger.WithError(err).Error("read file content")
+		response.SendJSONError(r.Context(), w, http.StatusBadRequest, "failed to read file")
+		return
+	}
+
+	uploaded, err := h.minioService.CreateOne(r.Context(), minio.FileData{
+		Name: header.Filename,
+		Data: fileBytes,
+	})
+	if err != nil {
+		logger.WithError(err).Error("upload file to minio")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	if err = h.u.SetCategoryImage(r.Context(), id, uploaded.URL); err != nil {
+		logger.WithError(err).Error("set category image")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, uploaded)
+}
@@ -0,0 +1,147 @@
+package currency
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/utils/response"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+)
+
+// maxRatesFileSize — наибольший размер файла с курсами
+const maxRatesFileSize = 1 << 20
+
+//go:generate mockgen -source=currency.go -destination=../../usecase/mocks/currency_usecase_mock.go -package=mocks ICurrencyUsecase
+type ICurrencyUsecase interface {
+	GetRates(ctx context.Context) ([]models.ExchangeRate, error)
+	SetRate(ctx context.Context, code string, req dto.SetExchangeRateRequest) error
+	ImportRates(ctx context.Context, data []byte) (dto.ImportRatesResponse, error)
+}
+
+type CurrencyService struct {
+	u ICurrencyUsecase
+}
+
+func NewCurrencyService(u ICurrencyUsecase) *CurrencyService {
+	return &CurrencyService{
+		u: u,
+	}
+}
+
+// GetRates godoc
+//
+//	@Summary		Валюты для показа цен
+//	@Description	Возвращает валюты и курсы к рублю. Валюта выбирается заголовком X-Currency или кукой currency;
+//	@Description	оплата всегда проходит в рублях.
+//	@Tags			currency
+//	@Produce		json
+//	@Success		200	{array}		models.ExchangeRate
+//	@Failure		500	{object}	object
+//	@Router			/currencies [get]
+func (h *CurrencyService) GetRates(w http.ResponseWriter, r *http.Request) {
+	const op = "CurrencyService.GetRates"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	rates, err := h.u.GetRates(r.Context())
+	if err != nil {
+		logger.WithError(err).Error("get exchange rates")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, rates)
+}
+
+// SetRate godoc
+//
+//	@Summary		Курс валюты
+//	@Description	Добавляет валюту или обновляет её курс: сколько рублей стоит единица валюты.
+//	@Description	Оформленные заказы показываются по курсу на момент покупки.
+//	@Tags			admin
+//	@Accept			json
+//	@Param			code			path	string						true	"Код валюты ISO 4217"
+//	@Param			X-Csrf-Token	header	string						true	"CSRF-токен для защиты от подделки запросов"
+//	@Param			request			body	dto.SetExchangeRateRequest	true	"Курс"
+//	@Success		204				"Курс сохранён"
+//	@Failure		422				{object}	object	"Неверный код валюты или курс"
+//	@Failure		500				{object}	object
+//	@Router			/admin/currencies/{code} [put]
+func (h *CurrencyService) SetRate(w http.ResponseWriter, r *http.Request) {
+	const op = "CurrencyService.SetRate"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	var req dto.SetExchangeRateRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Error("parse request data")
+		response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+		return
+	}
+
+	if err := h.u.SetRate(r.Context(), mux.Vars(r)["code"], req); err != nil {
+		logger.WithError(err).Error("set exchange rate")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ImportRates godoc
+//
+//	@Summary		Загрузить курсы из файла
+//	@Description	Загружает CSV с колонками currency и rate. Файл применяется целиком:
+//	@Description	при ошибке в любой строке курсы не меняются.
+//	@Tags			admin
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			file			formData	file	true	"Файл курсов (.csv)"
+//	@Param			X-Csrf-Token	header		string	true	"CSRF-токен для защиты от подделки запросов"
+//	@Success		200				{object}	dto.ImportRatesResponse
+//	@Failure		400				{object}	object
+//	@Failure		422				{object}	object	"Ошибка в строке файла"
+//	@Failure		500				{object}	object
+//	@Router			/admin/currencies/import [post]
+func (h *CurrencyService) ImportRates(w http.ResponseWriter, r *http.Request) {
+	const op = "CurrencyService.ImportRates"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	if err := r.ParseMultipartForm(maxRatesFileSize); err != nil {
+		logger.WithError(err).Error("parse multipart form")
+		response.SendJSONError(r.Context(), w, http.StatusBadRequest, "failed to parse form")
+		return
+	}
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		logger.WithError(err).Error("get file from form")
+		response.SendJSONError(r.Context(), w, http.StatusBadRequest, "no file uploaded")
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxRatesFileSize+1))
+	if err != nil {
+		logger.WithError(err).Error("read file content")
+		response.SendJSONError(r.Context(), w, http.StatusBadRequest, "failed to read file")
+		return
+	}
+	if len(data) > maxRatesFileSize {
+		response.SendJSONError(r.Context(), w, http.StatusBadRequest, "file is too large")
+		return
+	}
+
+	result, err := h.u.ImportRates(r.Context(), data)
+	if err != nil {
+		logger.WithError(err).Error("import exchange rates")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, result)
+}
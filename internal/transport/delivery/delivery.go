@@ -0,0 +1,136 @@
+package delivery
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/utils/response"
+	"github.com/google/uuid"
+	"github.com/mailru/easyjson"
+)
+
+//go:generate mockgen -source=delivery.go -destination=../../usecase/mocks/delivery_usecase_mock.go -package=mocks IDeliveryUsecase
+type IDeliveryUsecase interface {
+	GetOptions(ctx context.Context, addressID uuid.UUID) (dto.DeliveryOptionsDTO, error)
+	CreateZone(ctx context.Context, req dto.CreateDeliveryZoneRequest) (dto.DeliveryZoneDTO, error)
+	CreateSlot(ctx context.Context, req dto.CreateDeliverySlotRequest) (dto.DeliverySlotDTO, error)
+}
+
+type DeliveryService struct {
+	u IDeliveryUsecase
+}
+
+func NewDeliveryService(u IDeliveryUsecase) *DeliveryService {
+	return &DeliveryService{
+		u: u,
+	}
+}
+
+// GetOptions godoc
+//
+//	@Summary		Варианты доставки
+//	@Description	Возвращает ожидаемую дату доставки по адресу и свободные интервалы доставки
+//	@Tags			delivery
+//	@Produce		json
+//	@Param			addressID	query		string					true	"ID адреса доставки"
+//	@Success		200			{object}	dto.DeliveryOptionsDTO	"Варианты доставки"
+//	@Failure		400			{object}	object					"Некорректный ID адреса"
+//	@Failure		401			{object}	object					"Пользователь не авторизован"
+//	@Failure		500			{object}	object					"Внутренняя ошибка сервера"
+//	@Security		TokenAuth
+//	@Router			/delivery/options [get]
+func (h *DeliveryService) GetOptions(w http.ResponseWriter, r *http.Request) {
+	const op = "DeliveryService.GetOptions"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	addressID, err := uuid.Parse(r.URL.Query().Get("addressID"))
+	if err != nil {
+		logger.WithError(err).Error("parse address ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	options, err := h.u.GetOptions(r.Context(), addressID)
+	if err != nil {
+		logger.WithError(err).Error("get delivery options")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, options)
+}
+
+// CreateZone godoc
+//
+//	@Summary		Создать зону доставки
+//	@Description	Зона задаётся регионом и/или городом адреса; зона без региона и города действует по умолчанию
+//	@Tags			delivery
+//	@Accept			json
+//	@Produce		json
+//	@Param			request			body		dto.CreateDeliveryZoneRequest	true	"Зона доставки"
+//	@Param			X-Csrf-Token	header		string							true	"CSRF-токен для защиты от подделки запросов"
+//	@Success		201				{object}	dto.DeliveryZoneDTO				"Зона создана"
+//	@Failure		409				{object}	object							"Зона уже существует"
+//	@Failure		422				{object}	object							"Некорректные данные зоны"
+//	@Failure		500				{object}	object							"Внутренняя ошибка сервера"
+//	@Security		TokenAuth
+//	@Router			/admin/delivery/zones [post]
+func (h *DeliveryService) CreateZone(w http.ResponseWriter, r *http.Request) {
+	const op = "DeliveryService.CreateZone"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	var req dto.CreateDeliveryZoneRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Error("parse request data")
+		response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+		return
+	}
+
+	zone, err := h.u.CreateZone(r.Context(), req)
+	if err != nil {
+		logger.WithError(err).Error("create delivery zone")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusCreated, zone)
+}
+
+// CreateSlot godoc
+//
+//	@Summary		Создать интервал доставки
+//	@Description	Создаёт интервал доставки зоны с ограниченным числом заказов
+//	@Tags			delivery
+//	@Accept			json
+//	@Produce		json
+//	@Param			request			body		dto.CreateDeliverySlotRequest	true	"Интервал доставки"
+//	@Param			X-Csrf-Token	header		string							true	"CSRF-токен для защиты от подделки запросов"
+//	@Success		201				{object}	dto.DeliverySlotDTO				"Интервал создан"
+//	@Failure		409				{object}	object							"Интервал уже существует"
+//	@Failure		422				{object}	object							"Некорректные данные интервала"
+//	@Failure		500				{object}	object							"Внутренняя ошибка сервера"
+//	@Security		TokenAuth
+//	@Router			/admin/delivery/slots [post]
+func (h *DeliveryService) CreateSlot(w http.ResponseWriter, r *http.Request) {
+	const op = "DeliveryService.CreateSlot"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	var req dto.CreateDeliverySlotRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Error("parse request data")
+		response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+		return
+	}
+
+	slot, err := h.u.CreateSlot(r.Context(), req)
+	if err != nil {
+		logger.WithError(err).Error("create delivery slot")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusCreated, slot)
+}
@@ -41,6 +41,9 @@ type GeoapifyResponse struct {
 type GeoapifyFeature struct {
 	Properties struct {
 		ResultType string  `json:"result_type"`
+		Formatted  string  `json:"formatted"`
+		State      string  `json:"state"`
+		City       string  `json:"city"`
 		Lon        float64 `json:"lon"`
 		Lat        float64 `json:"lat"`
 		Rank       struct {
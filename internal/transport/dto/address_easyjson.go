@@ -225,7 +225,7 @@ func easyjsonF4fdf71eDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 				in.Delim('[')
 				if out.Features == nil {
 					if !in.IsDelim(']') {
-						out.Features = make([]GeoapifyFeature, 0, 1)
+						out.Features = make([]GeoapifyFeature, 0, 0)
 					} else {
 						out.Features = []GeoapifyFeature{}
 					}
@@ -364,6 +364,9 @@ func (v *GeoapifyFeature) UnmarshalEasyJSON(l *jlexer.Lexer) {
 }
 func easyjsonF4fdf71eDecode(in *jlexer.Lexer, out *struct {
 	ResultType string  `json:"result_type"`
+	Formatted  string  `json:"formatted"`
+	State      string  `json:"state"`
+	City       string  `json:"city"`
 	Lon        float64 `json:"lon"`
 	Lat        float64 `json:"lat"`
 	Rank       struct {
@@ -390,6 +393,12 @@ func easyjsonF4fdf71eDecode(in *jlexer.Lexer, out *struct {
 		switch key {
 		case "result_type":
 			out.ResultType = string(in.String())
+		case "formatted":
+			out.Formatted = string(in.String())
+		case "state":
+			out.State = string(in.String())
+		case "city":
+			out.City = string(in.String())
 		case "lon":
 			out.Lon = float64(in.Float64())
 		case "lat":
@@ -408,6 +417,9 @@ func easyjsonF4fdf71eDecode(in *jlexer.Lexer, out *struct {
 }
 func easyjsonF4fdf71eEncode(out *jwriter.Writer, in struct {
 	ResultType string  `json:"result_type"`
+	Formatted  string  `json:"formatted"`
+	State      string  `json:"state"`
+	City       string  `json:"city"`
 	Lon        float64 `json:"lon"`
 	Lat        float64 `json:"lat"`
 	Rank       struct {
@@ -422,6 +434,21 @@ func easyjsonF4fdf71eEncode(out *jwriter.Writer, in struct {
 		out.RawString(prefix[1:])
 		out.String(string(in.ResultType))
 	}
+	{
+		const prefix string = ",\"formatted\":"
+		out.RawString(prefix)
+		out.String(string(in.Formatted))
+	}
+	{
+		const prefix string = ",\"state\":"
+		out.RawString(prefix)
+		out.String(string(in.State))
+	}
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		out.String(string(in.City))
+	}
 	{
 		const prefix string = ",\"lon\":"
 		out.RawString(prefix)
@@ -1,6 +1,8 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/google/uuid"
 )
@@ -15,6 +17,11 @@ type UpdateProductStatusRequest struct{
 	Update int 			  `json:"update"`
 }
 
+type UpdateReviewStatusRequest struct {
+	ReviewID uuid.UUID `json:"reviewID"`
+	Update   int       `json:"update"`
+}
+
 type BriefUser struct {
     ID         uuid.UUID `json:"id"`
     Email      string    `json:"email"`
@@ -67,4 +74,43 @@ func ConvertToUsersResponse(users []*models.User) UsersResponse {
         Total: len(briefUsers),
         Users: briefUsers,
     }
-}
\ No newline at end of file
+}
+
+type ModerationReviewDTO struct {
+	ID          uuid.UUID `json:"id"`
+	ProductID   uuid.UUID `json:"productID"`
+	ProductName string    `json:"productName"`
+	UserID      uuid.UUID `json:"userID"`
+	UserName    string    `json:"userName"`
+	Rating      int       `json:"rating"`
+	Comment     string    `json:"comment"`
+	Reason      string    `json:"reason"`
+	CreatedAt   string    `json:"createdAt"`
+}
+
+type ModerationReviewsResponse struct {
+	Total   int                   `json:"total"`
+	Reviews []ModerationReviewDTO `json:"reviews"`
+}
+
+func ConvertToModerationReviewsResponse(reviews []*models.ModerationReview) ModerationReviewsResponse {
+	reviewDTOs := make([]ModerationReviewDTO, 0, len(reviews))
+	for _, review := range reviews {
+		reviewDTOs = append(reviewDTOs, ModerationReviewDTO{
+			ID:          review.ID,
+			ProductID:   review.ProductID,
+			ProductName: review.ProductName,
+			UserID:      review.UserID,
+			UserName:    review.UserName,
+			Rating:      review.Rating,
+			Comment:     review.Comment,
+			Reason:      review.Reason,
+			CreatedAt:   review.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return ModerationReviewsResponse{
+		Total:   len(reviewDTOs),
+		Reviews: reviewDTOs,
+	}
+}
@@ -197,7 +197,82 @@ func (v *UpdateUserRoleRequest) UnmarshalJSON(data []byte) error {
 func (v *UpdateUserRoleRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(l, v)
 }
-func easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(in *jlexer.Lexer, out *UpdateProductStatusRequest) {
+func easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(in *jlexer.Lexer, out *UpdateReviewStatusRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "reviewID":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ReviewID).UnmarshalText(data))
+			}
+		case "update":
+			out.Update = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(out *jwriter.Writer, in UpdateReviewStatusRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"reviewID\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ReviewID).MarshalText())
+	}
+	{
+		const prefix string = ",\"update\":"
+		out.RawString(prefix)
+		out.Int(int(in.Update))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UpdateReviewStatusRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UpdateReviewStatusRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UpdateReviewStatusRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UpdateReviewStatusRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(l, v)
+}
+func easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(in *jlexer.Lexer, out *UpdateProductStatusRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -232,7 +307,7 @@ func easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		in.Consumed()
 	}
 }
-func easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(out *jwriter.Writer, in UpdateProductStatusRequest) {
+func easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(out *jwriter.Writer, in UpdateProductStatusRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -252,27 +327,27 @@ func easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 // MarshalJSON supports json.Marshaler interface
 func (v UpdateProductStatusRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&w, v)
+	easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UpdateProductStatusRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(w, v)
+	easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UpdateProductStatusRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&r, v)
+	easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UpdateProductStatusRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(l, v)
+	easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(l, v)
 }
-func easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(in *jlexer.Lexer, out *SellerInfo) {
+func easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(in *jlexer.Lexer, out *SellerInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -305,7 +380,7 @@ func easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		in.Consumed()
 	}
 }
-func easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(out *jwriter.Writer, in SellerInfo) {
+func easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(out *jwriter.Writer, in SellerInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -325,27 +400,260 @@ func easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 // MarshalJSON supports json.Marshaler interface
 func (v SellerInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&w, v)
+	easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SellerInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(w, v)
+	easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SellerInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&r, v)
+	easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SellerInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(l, v)
+	easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(l, v)
+}
+func easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(in *jlexer.Lexer, out *ModerationReviewsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "total":
+			out.Total = int(in.Int())
+		case "reviews":
+			if in.IsNull() {
+				in.Skip()
+				out.Reviews = nil
+			} else {
+				in.Delim('[')
+				if out.Reviews == nil {
+					if !in.IsDelim(']') {
+						out.Reviews = make([]ModerationReviewDTO, 0, 0)
+					} else {
+						out.Reviews = []ModerationReviewDTO{}
+					}
+				} else {
+					out.Reviews = (out.Reviews)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 ModerationReviewDTO
+					(v4).UnmarshalEasyJSON(in)
+					out.Reviews = append(out.Reviews, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(out *jwriter.Writer, in ModerationReviewsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Total))
+	}
+	{
+		const prefix string = ",\"reviews\":"
+		out.RawString(prefix)
+		if in.Reviews == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Reviews {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ModerationReviewsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ModerationReviewsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ModerationReviewsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ModerationReviewsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(l, v)
+}
+func easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(in *jlexer.Lexer, out *ModerationReviewDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "productID":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ProductID).UnmarshalText(data))
+			}
+		case "productName":
+			out.ProductName = string(in.String())
+		case "userID":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.UserID).UnmarshalText(data))
+			}
+		case "userName":
+			out.UserName = string(in.String())
+		case "rating":
+			out.Rating = int(in.Int())
+		case "comment":
+			out.Comment = string(in.String())
+		case "reason":
+			out.Reason = string(in.String())
+		case "createdAt":
+			out.CreatedAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
 }
-func easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(in *jlexer.Lexer, out *BriefUser) {
+func easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(out *jwriter.Writer, in ModerationReviewDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"productID\":"
+		out.RawString(prefix)
+		out.RawText((in.ProductID).MarshalText())
+	}
+	{
+		const prefix string = ",\"productName\":"
+		out.RawString(prefix)
+		out.String(string(in.ProductName))
+	}
+	{
+		const prefix string = ",\"userID\":"
+		out.RawString(prefix)
+		out.RawText((in.UserID).MarshalText())
+	}
+	{
+		const prefix string = ",\"userName\":"
+		out.RawString(prefix)
+		out.String(string(in.UserName))
+	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Int(int(in.Rating))
+	}
+	{
+		const prefix string = ",\"comment\":"
+		out.RawString(prefix)
+		out.String(string(in.Comment))
+	}
+	{
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ModerationReviewDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ModerationReviewDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ModerationReviewDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ModerationReviewDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(l, v)
+}
+func easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(in *jlexer.Lexer, out *BriefUser) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -400,7 +708,7 @@ func easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		in.Consumed()
 	}
 }
-func easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(out *jwriter.Writer, in BriefUser) {
+func easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(out *jwriter.Writer, in BriefUser) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -450,23 +758,23 @@ func easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 // MarshalJSON supports json.Marshaler interface
 func (v BriefUser) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&w, v)
+	easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v BriefUser) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(w, v)
+	easyjson9280440fEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BriefUser) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&r, v)
+	easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *BriefUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(l, v)
+	easyjson9280440fDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(l, v)
 }
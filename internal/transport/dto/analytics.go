@@ -0,0 +1,60 @@
+package dto
+
+import (
+	"math"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+)
+
+// AnalyticsPointDTO — показатели продавца за период, который начинается с Period.
+// Доли и средняя оценка равны null, если считать их не из чего.
+type AnalyticsPointDTO struct {
+	Period         string       `json:"period"`
+	Revenue        models.Money `json:"revenue"`
+	Units          int          `json:"units"`
+	Orders         int          `json:"orders"`
+	BasketAdds     int          `json:"basket_adds"`
+	ConversionRate *float64     `json:"conversion_rate"`
+	ReturnedUnits  int          `json:"returned_units"`
+	ReturnRate     *float64     `json:"return_rate"`
+	AverageRating  *float64     `json:"average_rating"`
+	Reviews        int          `json:"reviews"`
+}
+
+// SellerAnalyticsDTO — сводка продавца за период: итоги и временной ряд
+// с шагом bucket. Периоды без продаж присутствуют в ряду с нулями.
+type SellerAnalyticsDTO struct {
+	From   string              `json:"from"`
+	To     string              `json:"to"`
+	Bucket string              `json:"bucket"`
+	Totals AnalyticsPointDTO   `json:"totals"`
+	Series []AnalyticsPointDTO `json:"series"`
+}
+
+// ConvertToAnalyticsPointDTO считает доли по сумме показателей: конверсия —
+// заказы на одно добавление в корзину, доля возвратов — от проданных штук
+func ConvertToAnalyticsPointDTO(stats models.SalesStats) AnalyticsPointDTO {
+	return AnalyticsPointDTO{
+		Period:         stats.Period.Format(time.DateOnly),
+		Revenue:        stats.Revenue,
+		Units:          stats.Units,
+		Orders:         stats.Orders,
+		BasketAdds:     stats.BasketAdds,
+		ConversionRate: ratio(stats.Orders, stats.BasketAdds, 4),
+		ReturnedUnits:  stats.ReturnedUnits,
+		ReturnRate:     ratio(stats.ReturnedUnits, stats.Units, 4),
+		AverageRating:  ratio(stats.RatingSum, stats.RatingCount, 2),
+		Reviews:        stats.RatingCount,
+	}
+}
+
+// ratio возвращает part/total, округлённое до digits знаков, или nil при нулевом total
+func ratio(part, total, digits int) *float64 {
+	if total == 0 {
+		return nil
+	}
+	scale := math.Pow10(digits)
+	value := math.Round(float64(part)/float64(total)*scale) / scale
+	return &value
+}
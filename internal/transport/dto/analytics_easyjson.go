@@ -0,0 +1,310 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package dto
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonDfaeaa7eDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(in *jlexer.Lexer, out *SellerAnalyticsDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "from":
+			out.From = string(in.String())
+		case "to":
+			out.To = string(in.String())
+		case "bucket":
+			out.Bucket = string(in.String())
+		case "totals":
+			(out.Totals).UnmarshalEasyJSON(in)
+		case "series":
+			if in.IsNull() {
+				in.Skip()
+				out.Series = nil
+			} else {
+				in.Delim('[')
+				if out.Series == nil {
+					if !in.IsDelim(']') {
+						out.Series = make([]AnalyticsPointDTO, 0, 0)
+					} else {
+						out.Series = []AnalyticsPointDTO{}
+					}
+				} else {
+					out.Series = (out.Series)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 AnalyticsPointDTO
+					(v1).UnmarshalEasyJSON(in)
+					out.Series = append(out.Series, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDfaeaa7eEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(out *jwriter.Writer, in SellerAnalyticsDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"from\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.From))
+	}
+	{
+		const prefix string = ",\"to\":"
+		out.RawString(prefix)
+		out.String(string(in.To))
+	}
+	{
+		const prefix string = ",\"bucket\":"
+		out.RawString(prefix)
+		out.String(string(in.Bucket))
+	}
+	{
+		const prefix string = ",\"totals\":"
+		out.RawString(prefix)
+		(in.Totals).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"series\":"
+		out.RawString(prefix)
+		if in.Series == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Series {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SellerAnalyticsDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDfaeaa7eEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SellerAnalyticsDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDfaeaa7eEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SellerAnalyticsDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDfaeaa7eDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SellerAnalyticsDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDfaeaa7eDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(l, v)
+}
+func easyjsonDfaeaa7eDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(in *jlexer.Lexer, out *AnalyticsPointDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "period":
+			out.Period = string(in.String())
+		case "revenue":
+			(out.Revenue).UnmarshalEasyJSON(in)
+		case "units":
+			out.Units = int(in.Int())
+		case "orders":
+			out.Orders = int(in.Int())
+		case "basket_adds":
+			out.BasketAdds = int(in.Int())
+		case "conversion_rate":
+			if in.IsNull() {
+				in.Skip()
+				out.ConversionRate = nil
+			} else {
+				if out.ConversionRate == nil {
+					out.ConversionRate = new(float64)
+				}
+				*out.ConversionRate = float64(in.Float64())
+			}
+		case "returned_units":
+			out.ReturnedUnits = int(in.Int())
+		case "return_rate":
+			if in.IsNull() {
+				in.Skip()
+				out.ReturnRate = nil
+			} else {
+				if out.ReturnRate == nil {
+					out.ReturnRate = new(float64)
+				}
+				*out.ReturnRate = float64(in.Float64())
+			}
+		case "average_rating":
+			if in.IsNull() {
+				in.Skip()
+				out.AverageRating = nil
+			} else {
+				if out.AverageRating == nil {
+					out.AverageRating = new(float64)
+				}
+				*out.AverageRating = float64(in.Float64())
+			}
+		case "reviews":
+			out.Reviews = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDfaeaa7eEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(out *jwriter.Writer, in AnalyticsPointDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"period\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Period))
+	}
+	{
+		const prefix string = ",\"revenue\":"
+		out.RawString(prefix)
+		(in.Revenue).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"units\":"
+		out.RawString(prefix)
+		out.Int(int(in.Units))
+	}
+	{
+		const prefix string = ",\"orders\":"
+		out.RawString(prefix)
+		out.Int(int(in.Orders))
+	}
+	{
+		const prefix string = ",\"basket_adds\":"
+		out.RawString(prefix)
+		out.Int(int(in.BasketAdds))
+	}
+	{
+		const prefix string = ",\"conversion_rate\":"
+		out.RawString(prefix)
+		if in.ConversionRate == nil {
+			out.RawString("null")
+		} else {
+			out.Float64(float64(*in.ConversionRate))
+		}
+	}
+	{
+		const prefix string = ",\"returned_units\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReturnedUnits))
+	}
+	{
+		const prefix string = ",\"return_rate\":"
+		out.RawString(prefix)
+		if in.ReturnRate == nil {
+			out.RawString("null")
+		} else {
+			out.Float64(float64(*in.ReturnRate))
+		}
+	}
+	{
+		const prefix string = ",\"average_rating\":"
+		out.RawString(prefix)
+		if in.AverageRating == nil {
+			out.RawString("null")
+		} else {
+			out.Float64(float64(*in.AverageRating))
+		}
+	}
+	{
+		const prefix string = ",\"reviews\":"
+		out.RawString(prefix)
+		out.Int(int(in.Reviews))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AnalyticsPointDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDfaeaa7eEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AnalyticsPointDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDfaeaa7eEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AnalyticsPointDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDfaeaa7eDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AnalyticsPointDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDfaeaa7eDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(l, v)
+}
@@ -0,0 +1,109 @@
+package dto
+
+import (
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+type CategoryAttributesResponse struct {
+	Attributes []models.CategoryAttribute `json:"attributes"`
+}
+
+// CreateAttributeRequest — новая характеристика в схеме категории. Options задаются
+// только для enum, Unit — только для number. Если filterable не указан, по тексту
+// фильтровать нельзя, по остальным типам — можно.
+type CreateAttributeRequest struct {
+	Name       string               `json:"name"`
+	Type       models.AttributeType `json:"type"`
+	Unit       null.String          `json:"unit" swaggertype:"primitive,string"`
+	Options    []string             `json:"options,omitempty"`
+	Required   bool                 `json:"required"`
+	Filterable *bool                `json:"filterable,omitempty"`
+}
+
+// UpdateAttributeRequest заменяет изменяемые поля характеристики. Тип не меняется:
+// у товаров уже могут быть значения старого типа.
+type UpdateAttributeRequest struct {
+	Name       string      `json:"name"`
+	Unit       null.String `json:"unit" swaggertype:"primitive,string"`
+	Options    []string    `json:"options,omitempty"`
+	Required   bool        `json:"required"`
+	Filterable bool        `json:"filterable"`
+	Position   int         `json:"position"`
+}
+
+type ProductSpecificationDTO struct {
+	AttributeID uuid.UUID            `json:"attributeId"`
+	Name        string               `json:"name"`
+	Type        models.AttributeType `json:"type"`
+	Unit        null.String          `json:"unit" swaggertype:"primitive,string"`
+	Text        null.String          `json:"text" swaggertype:"primitive,string"`
+	Number      null.Float           `json:"number" swaggertype:"primitive,number"`
+	Bool        null.Bool            `json:"bool" swaggertype:"primitive,boolean"`
+}
+
+type ProductAttributesResponse struct {
+	Attributes []ProductSpecificationDTO `json:"attributes"`
+}
+
+// AttributeFilterDTO — фильтр поиска по характеристике: values для enum и text,
+// min/max для number, bool для boolean
+type AttributeFilterDTO struct {
+	AttributeID uuid.UUID  `json:"attributeId"`
+	Values      []string   `json:"values,omitempty"`
+	Min         null.Float `json:"min" swaggertype:"primitive,number"`
+	Max         null.Float `json:"max" swaggertype:"primitive,number"`
+	Bool        null.Bool  `json:"bool" swaggertype:"primitive,boolean"`
+}
+
+type FacetValueDTO struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+type AttributeFacetDTO struct {
+	AttributeID uuid.UUID            `json:"attributeId"`
+	Name        string               `json:"name"`
+	Type        models.AttributeType `json:"type"`
+	Unit        null.String          `json:"unit" swaggertype:"primitive,string"`
+	Values      []FacetValueDTO      `json:"values,omitempty"`
+	Min         null.Float           `json:"min" swaggertype:"primitive,number"`
+	Max         null.Float           `json:"max" swaggertype:"primitive,number"`
+}
+
+func ConvertToProductAttributesResponse(specifications []models.ProductSpecification) ProductAttributesResponse {
+	res := ProductAttributesResponse{Attributes: make([]ProductSpecificationDTO, 0, len(specifications))}
+	for _, spec := range specifications {
+		res.Attributes = append(res.Attributes, ProductSpecificationDTO{
+			AttributeID: spec.Attribute.ID,
+			Name:        spec.Attribute.Name,
+			Type:        spec.Attribute.Type,
+			Unit:        spec.Attribute.Unit,
+			Text:        spec.Value.Text,
+			Number:      spec.Value.Number,
+			Bool:        spec.Value.Bool,
+		})
+	}
+	return res
+}
+
+func ConvertToAttributeFacetsDTO(facets []models.AttributeFacet) []AttributeFacetDTO {
+	res := make([]AttributeFacetDTO, 0, len(facets))
+	for _, facet := range facets {
+		values := make([]FacetValueDTO, 0, len(facet.Values))
+		for _, v := range facet.Values {
+			values = append(values, FacetValueDTO{Value: v.Value, Count: v.Count})
+		}
+		res = append(res, AttributeFacetDTO{
+			AttributeID: facet.AttributeID,
+			Name:        facet.Name,
+			Type:        facet.Type,
+			Unit:        facet.Unit,
+			Values:      values,
+			Min:         facet.Min,
+			Max:         facet.Max,
+		})
+	}
+	return res
+}
@@ -0,0 +1,1095 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package dto
+
+import (
+	json "encoding/json"
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(in *jlexer.Lexer, out *UpdateAttributeRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "unit":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Unit).UnmarshalJSON(data))
+			}
+		case "options":
+			if in.IsNull() {
+				in.Skip()
+				out.Options = nil
+			} else {
+				in.Delim('[')
+				if out.Options == nil {
+					if !in.IsDelim(']') {
+						out.Options = make([]string, 0, 4)
+					} else {
+						out.Options = []string{}
+					}
+				} else {
+					out.Options = (out.Options)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 string
+					v1 = string(in.String())
+					out.Options = append(out.Options, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "required":
+			out.Required = bool(in.Bool())
+		case "filterable":
+			out.Filterable = bool(in.Bool())
+		case "position":
+			out.Position = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(out *jwriter.Writer, in UpdateAttributeRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"unit\":"
+		out.RawString(prefix)
+		out.Raw((in.Unit).MarshalJSON())
+	}
+	if len(in.Options) != 0 {
+		const prefix string = ",\"options\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v2, v3 := range in.Options {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v3))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"required\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Required))
+	}
+	{
+		const prefix string = ",\"filterable\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Filterable))
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		out.Int(int(in.Position))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UpdateAttributeRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UpdateAttributeRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UpdateAttributeRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UpdateAttributeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(l, v)
+}
+func easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(in *jlexer.Lexer, out *ProductSpecificationDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attributeId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AttributeID).UnmarshalText(data))
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "type":
+			out.Type = models.AttributeType(in.String())
+		case "unit":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Unit).UnmarshalJSON(data))
+			}
+		case "text":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Text).UnmarshalJSON(data))
+			}
+		case "number":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Number).UnmarshalJSON(data))
+			}
+		case "bool":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Bool).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(out *jwriter.Writer, in ProductSpecificationDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attributeId\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.AttributeID).MarshalText())
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"unit\":"
+		out.RawString(prefix)
+		out.Raw((in.Unit).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.Raw((in.Text).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"number\":"
+		out.RawString(prefix)
+		out.Raw((in.Number).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"bool\":"
+		out.RawString(prefix)
+		out.Raw((in.Bool).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProductSpecificationDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProductSpecificationDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProductSpecificationDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProductSpecificationDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(l, v)
+}
+func easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(in *jlexer.Lexer, out *ProductAttributesResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attributes":
+			if in.IsNull() {
+				in.Skip()
+				out.Attributes = nil
+			} else {
+				in.Delim('[')
+				if out.Attributes == nil {
+					if !in.IsDelim(']') {
+						out.Attributes = make([]ProductSpecificationDTO, 0, 0)
+					} else {
+						out.Attributes = []ProductSpecificationDTO{}
+					}
+				} else {
+					out.Attributes = (out.Attributes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 ProductSpecificationDTO
+					(v4).UnmarshalEasyJSON(in)
+					out.Attributes = append(out.Attributes, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(out *jwriter.Writer, in ProductAttributesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attributes\":"
+		out.RawString(prefix[1:])
+		if in.Attributes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Attributes {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProductAttributesResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProductAttributesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProductAttributesResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProductAttributesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(l, v)
+}
+func easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(in *jlexer.Lexer, out *FacetValueDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "value":
+			out.Value = string(in.String())
+		case "count":
+			out.Count = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(out *jwriter.Writer, in FacetValueDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"value\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Value))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int(int(in.Count))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FacetValueDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FacetValueDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FacetValueDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FacetValueDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(l, v)
+}
+func easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(in *jlexer.Lexer, out *CreateAttributeRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "type":
+			out.Type = models.AttributeType(in.String())
+		case "unit":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Unit).UnmarshalJSON(data))
+			}
+		case "options":
+			if in.IsNull() {
+				in.Skip()
+				out.Options = nil
+			} else {
+				in.Delim('[')
+				if out.Options == nil {
+					if !in.IsDelim(']') {
+						out.Options = make([]string, 0, 4)
+					} else {
+						out.Options = []string{}
+					}
+				} else {
+					out.Options = (out.Options)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 string
+					v7 = string(in.String())
+					out.Options = append(out.Options, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "required":
+			out.Required = bool(in.Bool())
+		case "filterable":
+			if in.IsNull() {
+				in.Skip()
+				out.Filterable = nil
+			} else {
+				if out.Filterable == nil {
+					out.Filterable = new(bool)
+				}
+				*out.Filterable = bool(in.Bool())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(out *jwriter.Writer, in CreateAttributeRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"unit\":"
+		out.RawString(prefix)
+		out.Raw((in.Unit).MarshalJSON())
+	}
+	if len(in.Options) != 0 {
+		const prefix string = ",\"options\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v8, v9 := range in.Options {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v9))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"required\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Required))
+	}
+	if in.Filterable != nil {
+		const prefix string = ",\"filterable\":"
+		out.RawString(prefix)
+		out.Bool(bool(*in.Filterable))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreateAttributeRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreateAttributeRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreateAttributeRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreateAttributeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(l, v)
+}
+func easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(in *jlexer.Lexer, out *CategoryAttributesResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attributes":
+			if in.IsNull() {
+				in.Skip()
+				out.Attributes = nil
+			} else {
+				in.Delim('[')
+				if out.Attributes == nil {
+					if !in.IsDelim(']') {
+						out.Attributes = make([]models.CategoryAttribute, 0, 0)
+					} else {
+						out.Attributes = []models.CategoryAttribute{}
+					}
+				} else {
+					out.Attributes = (out.Attributes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 models.CategoryAttribute
+					easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalModels(in, &v10)
+					out.Attributes = append(out.Attributes, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(out *jwriter.Writer, in CategoryAttributesResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attributes\":"
+		out.RawString(prefix[1:])
+		if in.Attributes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.Attributes {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalModels(out, v12)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CategoryAttributesResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CategoryAttributesResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CategoryAttributesResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CategoryAttributesResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(l, v)
+}
+func easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalModels(in *jlexer.Lexer, out *models.CategoryAttribute) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "categoryId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.CategoryID).UnmarshalText(data))
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "type":
+			out.Type = models.AttributeType(in.String())
+		case "unit":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Unit).UnmarshalJSON(data))
+			}
+		case "options":
+			if in.IsNull() {
+				in.Skip()
+				out.Options = nil
+			} else {
+				in.Delim('[')
+				if out.Options == nil {
+					if !in.IsDelim(']') {
+						out.Options = make([]string, 0, 4)
+					} else {
+						out.Options = []string{}
+					}
+				} else {
+					out.Options = (out.Options)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 string
+					v13 = string(in.String())
+					out.Options = append(out.Options, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "required":
+			out.Required = bool(in.Bool())
+		case "filterable":
+			out.Filterable = bool(in.Bool())
+		case "position":
+			out.Position = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalModels(out *jwriter.Writer, in models.CategoryAttribute) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"categoryId\":"
+		out.RawString(prefix)
+		out.RawText((in.CategoryID).MarshalText())
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"unit\":"
+		out.RawString(prefix)
+		out.Raw((in.Unit).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"options\":"
+		out.RawString(prefix)
+		if in.Options == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.Options {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v15))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"required\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Required))
+	}
+	{
+		const prefix string = ",\"filterable\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Filterable))
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		out.Int(int(in.Position))
+	}
+	out.RawByte('}')
+}
+func easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(in *jlexer.Lexer, out *AttributeFilterDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attributeId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AttributeID).UnmarshalText(data))
+			}
+		case "values":
+			if in.IsNull() {
+				in.Skip()
+				out.Values = nil
+			} else {
+				in.Delim('[')
+				if out.Values == nil {
+					if !in.IsDelim(']') {
+						out.Values = make([]string, 0, 4)
+					} else {
+						out.Values = []string{}
+					}
+				} else {
+					out.Values = (out.Values)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v16 string
+					v16 = string(in.String())
+					out.Values = append(out.Values, v16)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "min":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Min).UnmarshalJSON(data))
+			}
+		case "max":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Max).UnmarshalJSON(data))
+			}
+		case "bool":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Bool).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(out *jwriter.Writer, in AttributeFilterDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attributeId\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.AttributeID).MarshalText())
+	}
+	if len(in.Values) != 0 {
+		const prefix string = ",\"values\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v17, v18 := range in.Values {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v18))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"min\":"
+		out.RawString(prefix)
+		out.Raw((in.Min).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"max\":"
+		out.RawString(prefix)
+		out.Raw((in.Max).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"bool\":"
+		out.RawString(prefix)
+		out.Raw((in.Bool).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AttributeFilterDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AttributeFilterDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AttributeFilterDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AttributeFilterDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(l, v)
+}
+func easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(in *jlexer.Lexer, out *AttributeFacetDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attributeId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AttributeID).UnmarshalText(data))
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "type":
+			out.Type = models.AttributeType(in.String())
+		case "unit":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Unit).UnmarshalJSON(data))
+			}
+		case "values":
+			if in.IsNull() {
+				in.Skip()
+				out.Values = nil
+			} else {
+				in.Delim('[')
+				if out.Values == nil {
+					if !in.IsDelim(']') {
+						out.Values = make([]FacetValueDTO, 0, 2)
+					} else {
+						out.Values = []FacetValueDTO{}
+					}
+				} else {
+					out.Values = (out.Values)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 FacetValueDTO
+					(v19).UnmarshalEasyJSON(in)
+					out.Values = append(out.Values, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "min":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Min).UnmarshalJSON(data))
+			}
+		case "max":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Max).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(out *jwriter.Writer, in AttributeFacetDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attributeId\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.AttributeID).MarshalText())
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"unit\":"
+		out.RawString(prefix)
+		out.Raw((in.Unit).MarshalJSON())
+	}
+	if len(in.Values) != 0 {
+		const prefix string = ",\"values\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v20, v21 := range in.Values {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				(v21).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"min\":"
+		out.RawString(prefix)
+		out.Raw((in.Min).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"max\":"
+		out.RawString(prefix)
+		out.Raw((in.Max).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AttributeFacetDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AttributeFacetDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson7cab1c76EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AttributeFacetDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AttributeFacetDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson7cab1c76DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(l, v)
+}
@@ -1,6 +1,11 @@
 package dto
 
-import "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+import (
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/google/uuid"
+)
 
 type UpdateQuantityRequest struct {
 	Quantity  int       `json:"quantity"`
@@ -16,30 +21,178 @@ func ConvertToQuantityResponse(item *models.BasketItem) UpdateQuantityResponse {
 	}
 }
 
+// InCurrency возвращает ответ с ценами позиции в валюте курса
+func (r UpdateQuantityResponse) InCurrency(rate models.ExchangeRate) UpdateQuantityResponse {
+	if r.Item != nil {
+		item := BasketItemInCurrency(*r.Item, rate)
+		r.Item = &item
+	}
+	return r
+}
+
+// BasketItemInCurrency возвращает позицию корзины с ценами в валюте курса
+func BasketItemInCurrency(item models.BasketItem, rate models.ExchangeRate) models.BasketItem {
+	item.Price = rate.Convert(item.Price)
+	item.PriceDiscount = rate.Convert(item.PriceDiscount)
+	return item
+}
+
 type BasketResponse struct {
-    Total              int          		`json:"total"`
-    TotalPrice         float64      		`json:"total_price"`
-    TotalPriceDiscount float64      		`json:"total_price_discount"`
-	Products           []models.BasketItem  `json:"products"`
+	Total              int                 `json:"total"`
+	Currency           models.Currency     `json:"currency"`
+	TotalPrice         models.Money        `json:"total_price"`
+	TotalPriceDiscount models.Money        `json:"total_price_discount"`
+	Products           []models.BasketItem `json:"products"`
+}
+
+// InCurrency возвращает корзину с ценами в валюте курса. Суммы пересчитываются
+// из рублёвых, а не складываются из пересчитанных цен, поэтому совпадают
+// с суммой к оплате по курсу.
+func (r BasketResponse) InCurrency(rate models.ExchangeRate) BasketResponse {
+	products := make([]models.BasketItem, 0, len(r.Products))
+	for _, product := range r.Products {
+		products = append(products, BasketItemInCurrency(product, rate))
+	}
+	r.Products = products
+	r.TotalPrice = rate.Convert(r.TotalPrice)
+	r.TotalPriceDiscount = rate.Convert(r.TotalPriceDiscount)
+	r.Currency = rate.Code()
+	return r
 }
 
 func ConvertToBasketResponse(items []*models.BasketItem) BasketResponse{
-	var totalPrice, totalPriceDiscount float64 
+	var totalPrice, totalPriceDiscount models.Money
 	productsList := make([]models.BasketItem, 0, len(items))
 	for _, product := range items{
 		productsList = append(productsList, *product)
-		totalPrice = totalPrice + product.Price * float64(product.Quantity)
+		totalPrice = totalPrice.Add(product.Price.Mul(int64(product.Quantity)))
         price := product.Price
-        if product.PriceDiscount > 0 {
+        if product.PriceDiscount.IsPositive() {
             price = product.PriceDiscount
         }
-        totalPriceDiscount += price * float64(product.Quantity)
+        totalPriceDiscount = totalPriceDiscount.Add(price.Mul(int64(product.Quantity)))
 	}
 
 	return BasketResponse{
 		Total: len(productsList),
+		Currency: models.BaseCurrency,
 		TotalPrice: totalPrice,
 		TotalPriceDiscount : totalPriceDiscount,
 		Products: productsList,
 	}
-}
\ No newline at end of file
+}
+
+type BasketQuoteRequest struct {
+	PromoCode string `json:"promo_code,omitempty"`
+}
+
+type QuoteLineResponse struct {
+	ProductID      uuid.UUID             `json:"product_id"`
+	VariantID      uuid.NullUUID         `json:"variant_id"`
+	VariantOptions models.VariantOptions `json:"variant_options,omitempty"`
+	ProductName    string                `json:"product_name"`
+	ProductImage   string                `json:"product_image"`
+	Quantity       uint                  `json:"quantity"`
+	UnitPrice      models.Money          `json:"unit_price"`
+	FinalUnitPrice models.Money          `json:"final_unit_price"`
+	LineTotal      models.Money          `json:"line_total"`
+	LineDiscount   models.Money          `json:"line_discount"`
+	DiscountEndsAt *time.Time            `json:"discount_ends_at,omitempty"`
+	Available      bool                  `json:"available"`
+}
+
+// QuotePromoResponse — результат применения промокода к корзине.
+// При отказе Applied=false, а Reason и Message объясняют причину.
+type QuotePromoResponse struct {
+	Code     string       `json:"code"`
+	Applied  bool         `json:"applied"`
+	Discount models.Money `json:"discount"`
+	Reason   string       `json:"reason,omitempty"`
+	Message  string       `json:"message,omitempty"`
+}
+
+// BasketQuoteResponse — расчёт корзины по тем же правилам, что и при оформлении заказа.
+// Суммы показываются в валюте Currency, а списывается ChargeTotal в рублях.
+type BasketQuoteResponse struct {
+	Items           []QuoteLineResponse `json:"items"`
+	Currency        models.Currency     `json:"currency"`
+	Subtotal        models.Money        `json:"subtotal"`
+	ProductDiscount models.Money        `json:"product_discount"`
+	PromoDiscount   models.Money        `json:"promo_discount"`
+	Promo           *QuotePromoResponse `json:"promo,omitempty"`
+	DeliveryCost    models.Money        `json:"delivery_cost"`
+	Total           models.Money        `json:"total"`
+	ChargeTotal     models.Money        `json:"charge_total"`
+}
+
+// InCurrency возвращает расчёт с суммами в валюте курса; ChargeTotal
+// остаётся в рублях
+func (r BasketQuoteResponse) InCurrency(rate models.ExchangeRate) BasketQuoteResponse {
+	items := make([]QuoteLineResponse, 0, len(r.Items))
+	for _, item := range r.Items {
+		item.UnitPrice = rate.Convert(item.UnitPrice)
+		item.FinalUnitPrice = rate.Convert(item.FinalUnitPrice)
+		item.LineTotal = rate.Convert(item.LineTotal)
+		item.LineDiscount = rate.Convert(item.LineDiscount)
+		items = append(items, item)
+	}
+	r.Items = items
+
+	if r.Promo != nil {
+		promo := *r.Promo
+		promo.Discount = rate.Convert(promo.Discount)
+		r.Promo = &promo
+	}
+
+	r.Subtotal = rate.Convert(r.Subtotal)
+	r.ProductDiscount = rate.Convert(r.ProductDiscount)
+	r.PromoDiscount = rate.Convert(r.PromoDiscount)
+	r.DeliveryCost = rate.Convert(r.DeliveryCost)
+	r.Total = rate.Convert(r.Total)
+	r.Currency = rate.Code()
+	return r
+}
+
+// ConvertToBasketQuoteResponse собирает ответ из расчёта; названия и изображения
+// товаров берутся из позиций корзины
+func ConvertToBasketQuoteResponse(quote *models.Quote, items []*models.BasketItem) BasketQuoteResponse {
+	byKey := make(map[models.StockKey]*models.BasketItem, len(items))
+	for _, item := range items {
+		byKey[models.StockKey{ProductID: item.ProductID, VariantID: item.VariantID}] = item
+	}
+
+	lines := make([]QuoteLineResponse, 0, len(quote.Lines))
+	for _, line := range quote.Lines {
+		resp := QuoteLineResponse{
+			ProductID:      line.ProductID,
+			VariantID:      line.VariantID,
+			Quantity:       line.Quantity,
+			UnitPrice:      line.UnitPrice,
+			FinalUnitPrice: line.FinalUnitPrice,
+			LineTotal:      line.LineTotal,
+			LineDiscount:   line.LineDiscount,
+			Available:      line.Available(),
+		}
+		if item, ok := byKey[models.StockKey{ProductID: line.ProductID, VariantID: line.VariantID}]; ok {
+			resp.ProductName = item.ProductName
+			resp.ProductImage = item.ProductImage
+			resp.VariantOptions = item.VariantOptions
+		}
+		if line.Discount != nil {
+			endsAt := line.Discount.DiscountEndDate
+			resp.DiscountEndsAt = &endsAt
+		}
+		lines = append(lines, resp)
+	}
+
+	return BasketQuoteResponse{
+		Items:           lines,
+		Currency:        models.BaseCurrency,
+		Subtotal:        quote.Subtotal,
+		ProductDiscount: quote.ProductDiscount,
+		PromoDiscount:   quote.PromoDiscount,
+		DeliveryCost:    quote.DeliveryCost,
+		Total:           quote.Total,
+		ChargeTotal:     quote.Total,
+	}
+}
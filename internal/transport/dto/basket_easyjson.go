@@ -8,6 +8,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -127,6 +128,30 @@ func easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalModels(in
 			if data := in.UnsafeBytes(); in.Ok() {
 				in.AddError((out.ProductID).UnmarshalText(data))
 			}
+		case "variant_id":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.VariantID).UnmarshalJSON(data))
+			}
+		case "variant_options":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.VariantOptions = make(models.VariantOptions)
+				} else {
+					out.VariantOptions = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v1 string
+					v1 = string(in.String())
+					(out.VariantOptions)[key] = v1
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
 		case "quantity":
 			out.Quantity = int(in.Int())
 		case "updated_at":
@@ -136,11 +161,11 @@ func easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalModels(in
 		case "product_name":
 			out.ProductName = string(in.String())
 		case "product_price":
-			out.Price = float64(in.Float64())
+			(out.Price).UnmarshalEasyJSON(in)
 		case "product_image":
 			out.ProductImage = string(in.String())
 		case "price_discount":
-			out.PriceDiscount = float64(in.Float64())
+			(out.PriceDiscount).UnmarshalEasyJSON(in)
 		case "remain_quantity":
 			out.QuantityRemain = int(in.Int())
 		default:
@@ -172,6 +197,30 @@ func easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalModels(out
 		out.RawString(prefix)
 		out.RawText((in.ProductID).MarshalText())
 	}
+	{
+		const prefix string = ",\"variant_id\":"
+		out.RawString(prefix)
+		out.Raw((in.VariantID).MarshalJSON())
+	}
+	if len(in.VariantOptions) != 0 {
+		const prefix string = ",\"variant_options\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v2First := true
+			for v2Name, v2Value := range in.VariantOptions {
+				if v2First {
+					v2First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v2Name))
+				out.RawByte(':')
+				out.String(string(v2Value))
+			}
+			out.RawByte('}')
+		}
+	}
 	{
 		const prefix string = ",\"quantity\":"
 		out.RawString(prefix)
@@ -190,7 +239,7 @@ func easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalModels(out
 	{
 		const prefix string = ",\"product_price\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.Price))
+		(in.Price).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"product_image\":"
@@ -200,7 +249,7 @@ func easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalModels(out
 	{
 		const prefix string = ",\"price_discount\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.PriceDiscount))
+		(in.PriceDiscount).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"remain_quantity\":"
@@ -275,7 +324,290 @@ func (v *UpdateQuantityRequest) UnmarshalJSON(data []byte) error {
 func (v *UpdateQuantityRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(l, v)
 }
-func easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(in *jlexer.Lexer, out *BasketResponse) {
+func easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(in *jlexer.Lexer, out *QuotePromoResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "code":
+			out.Code = string(in.String())
+		case "applied":
+			out.Applied = bool(in.Bool())
+		case "discount":
+			(out.Discount).UnmarshalEasyJSON(in)
+		case "reason":
+			out.Reason = string(in.String())
+		case "message":
+			out.Message = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(out *jwriter.Writer, in QuotePromoResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"code\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Code))
+	}
+	{
+		const prefix string = ",\"applied\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Applied))
+	}
+	{
+		const prefix string = ",\"discount\":"
+		out.RawString(prefix)
+		(in.Discount).MarshalEasyJSON(out)
+	}
+	if in.Reason != "" {
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	if in.Message != "" {
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuotePromoResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuotePromoResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuotePromoResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuotePromoResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(l, v)
+}
+func easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(in *jlexer.Lexer, out *QuoteLineResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "product_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ProductID).UnmarshalText(data))
+			}
+		case "variant_id":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.VariantID).UnmarshalJSON(data))
+			}
+		case "variant_options":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.VariantOptions = make(models.VariantOptions)
+				} else {
+					out.VariantOptions = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v3 string
+					v3 = string(in.String())
+					(out.VariantOptions)[key] = v3
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "product_name":
+			out.ProductName = string(in.String())
+		case "product_image":
+			out.ProductImage = string(in.String())
+		case "quantity":
+			out.Quantity = uint(in.Uint())
+		case "unit_price":
+			(out.UnitPrice).UnmarshalEasyJSON(in)
+		case "final_unit_price":
+			(out.FinalUnitPrice).UnmarshalEasyJSON(in)
+		case "line_total":
+			(out.LineTotal).UnmarshalEasyJSON(in)
+		case "line_discount":
+			(out.LineDiscount).UnmarshalEasyJSON(in)
+		case "discount_ends_at":
+			if in.IsNull() {
+				in.Skip()
+				out.DiscountEndsAt = nil
+			} else {
+				if out.DiscountEndsAt == nil {
+					out.DiscountEndsAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.DiscountEndsAt).UnmarshalJSON(data))
+				}
+			}
+		case "available":
+			out.Available = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(out *jwriter.Writer, in QuoteLineResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"product_id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ProductID).MarshalText())
+	}
+	{
+		const prefix string = ",\"variant_id\":"
+		out.RawString(prefix)
+		out.Raw((in.VariantID).MarshalJSON())
+	}
+	if len(in.VariantOptions) != 0 {
+		const prefix string = ",\"variant_options\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v4First := true
+			for v4Name, v4Value := range in.VariantOptions {
+				if v4First {
+					v4First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v4Name))
+				out.RawByte(':')
+				out.String(string(v4Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"product_name\":"
+		out.RawString(prefix)
+		out.String(string(in.ProductName))
+	}
+	{
+		const prefix string = ",\"product_image\":"
+		out.RawString(prefix)
+		out.String(string(in.ProductImage))
+	}
+	{
+		const prefix string = ",\"quantity\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Quantity))
+	}
+	{
+		const prefix string = ",\"unit_price\":"
+		out.RawString(prefix)
+		(in.UnitPrice).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"final_unit_price\":"
+		out.RawString(prefix)
+		(in.FinalUnitPrice).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"line_total\":"
+		out.RawString(prefix)
+		(in.LineTotal).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"line_discount\":"
+		out.RawString(prefix)
+		(in.LineDiscount).MarshalEasyJSON(out)
+	}
+	if in.DiscountEndsAt != nil {
+		const prefix string = ",\"discount_ends_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.DiscountEndsAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"available\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Available))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v QuoteLineResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v QuoteLineResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *QuoteLineResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *QuoteLineResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(l, v)
+}
+func easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(in *jlexer.Lexer, out *BasketResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -296,10 +628,12 @@ func easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		switch key {
 		case "total":
 			out.Total = int(in.Int())
+		case "currency":
+			out.Currency = models.Currency(in.String())
 		case "total_price":
-			out.TotalPrice = float64(in.Float64())
+			(out.TotalPrice).UnmarshalEasyJSON(in)
 		case "total_price_discount":
-			out.TotalPriceDiscount = float64(in.Float64())
+			(out.TotalPriceDiscount).UnmarshalEasyJSON(in)
 		case "products":
 			if in.IsNull() {
 				in.Skip()
@@ -316,9 +650,9 @@ func easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 					out.Products = (out.Products)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v1 models.BasketItem
-					easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalModels(in, &v1)
-					out.Products = append(out.Products, v1)
+					var v5 models.BasketItem
+					easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalModels(in, &v5)
+					out.Products = append(out.Products, v5)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -333,7 +667,7 @@ func easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		in.Consumed()
 	}
 }
-func easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(out *jwriter.Writer, in BasketResponse) {
+func easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(out *jwriter.Writer, in BasketResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -342,15 +676,20 @@ func easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		out.RawString(prefix[1:])
 		out.Int(int(in.Total))
 	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
 	{
 		const prefix string = ",\"total_price\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.TotalPrice))
+		(in.TotalPrice).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"total_price_discount\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.TotalPriceDiscount))
+		(in.TotalPriceDiscount).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"products\":"
@@ -359,11 +698,11 @@ func easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v2, v3 := range in.Products {
-				if v2 > 0 {
+			for v6, v7 := range in.Products {
+				if v6 > 0 {
 					out.RawByte(',')
 				}
-				easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalModels(out, v3)
+				easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalModels(out, v7)
 			}
 			out.RawByte(']')
 		}
@@ -374,23 +713,252 @@ func easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 // MarshalJSON supports json.Marshaler interface
 func (v BasketResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&w, v)
+	easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v BasketResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(w, v)
+	easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BasketResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&r, v)
+	easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *BasketResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(l, v)
+	easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(l, v)
+}
+func easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(in *jlexer.Lexer, out *BasketQuoteResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else {
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]QuoteLineResponse, 0, 0)
+					} else {
+						out.Items = []QuoteLineResponse{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v8 QuoteLineResponse
+					(v8).UnmarshalEasyJSON(in)
+					out.Items = append(out.Items, v8)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "currency":
+			out.Currency = models.Currency(in.String())
+		case "subtotal":
+			(out.Subtotal).UnmarshalEasyJSON(in)
+		case "product_discount":
+			(out.ProductDiscount).UnmarshalEasyJSON(in)
+		case "promo_discount":
+			(out.PromoDiscount).UnmarshalEasyJSON(in)
+		case "promo":
+			if in.IsNull() {
+				in.Skip()
+				out.Promo = nil
+			} else {
+				if out.Promo == nil {
+					out.Promo = new(QuotePromoResponse)
+				}
+				(*out.Promo).UnmarshalEasyJSON(in)
+			}
+		case "delivery_cost":
+			(out.DeliveryCost).UnmarshalEasyJSON(in)
+		case "total":
+			(out.Total).UnmarshalEasyJSON(in)
+		case "charge_total":
+			(out.ChargeTotal).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(out *jwriter.Writer, in BasketQuoteResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"items\":"
+		out.RawString(prefix[1:])
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v9, v10 := range in.Items {
+				if v9 > 0 {
+					out.RawByte(',')
+				}
+				(v10).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"subtotal\":"
+		out.RawString(prefix)
+		(in.Subtotal).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"product_discount\":"
+		out.RawString(prefix)
+		(in.ProductDiscount).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"promo_discount\":"
+		out.RawString(prefix)
+		(in.PromoDiscount).MarshalEasyJSON(out)
+	}
+	if in.Promo != nil {
+		const prefix string = ",\"promo\":"
+		out.RawString(prefix)
+		(*in.Promo).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"delivery_cost\":"
+		out.RawString(prefix)
+		(in.DeliveryCost).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		(in.Total).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"charge_total\":"
+		out.RawString(prefix)
+		(in.ChargeTotal).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BasketQuoteResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BasketQuoteResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BasketQuoteResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BasketQuoteResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(l, v)
+}
+func easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(in *jlexer.Lexer, out *BasketQuoteRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "promo_code":
+			out.PromoCode = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(out *jwriter.Writer, in BasketQuoteRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.PromoCode != "" {
+		const prefix string = ",\"promo_code\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.PromoCode))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BasketQuoteRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BasketQuoteRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCee004caEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BasketQuoteRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BasketQuoteRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCee004caDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(l, v)
 }
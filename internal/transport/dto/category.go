@@ -1,6 +1,10 @@
 package dto
 
-import "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+import (
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
 
 type CategoryResponse struct {
 	Total     int               `json:"total"`
@@ -24,4 +28,99 @@ func ConvertToCategoriesResponse(categories []*models.Category) CategoryResponse
 
 type NameSubcategory struct {
 	Name string `json:"name"`
-}
\ No newline at end of file
+}
+
+// CategoryTreeNode — узел дерева категорий с вложенными потомками
+type CategoryTreeNode struct {
+	ID       uuid.UUID          `json:"id"`
+	Name     string             `json:"name"`
+	Slug     string             `json:"slug"`
+	ImageURL null.String        `json:"imageUrl" swaggertype:"primitive,string"`
+	Position int                `json:"position"`
+	Children []CategoryTreeNode `json:"children"`
+}
+
+type CategoryTreeResponse struct {
+	Categories []CategoryTreeNode `json:"categories"`
+}
+
+type BreadcrumbDTO struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+	Slug string    `json:"slug"`
+}
+
+// BreadcrumbsResponse — путь от корневой категории до запрошенной включительно
+type BreadcrumbsResponse struct {
+	Breadcrumbs []BreadcrumbDTO `json:"breadcrumbs"`
+}
+
+// CreateCategoryRequest — новая категория; без parentId создаётся корневая.
+// Если slug не указан, он генерируется из названия.
+type CreateCategoryRequest struct {
+	Name     string      `json:"name"`
+	ParentID *uuid.UUID  `json:"parentId,omitempty"`
+	Slug     string      `json:"slug,omitempty"`
+	ImageURL null.String `json:"imageUrl" swaggertype:"primitive,string"`
+}
+
+// UpdateCategoryRequest меняет только заполненные поля
+type UpdateCategoryRequest struct {
+	Name     string      `json:"name,omitempty"`
+	Slug     string      `json:"slug,omitempty"`
+	ImageURL null.String `json:"imageUrl" swaggertype:"primitive,string"`
+}
+
+// MoveCategoryRequest переносит категорию к родителю parentId (без него — в корень)
+// и ставит её на позицию position среди соседей
+type MoveCategoryRequest struct {
+	ParentID *uuid.UUID `json:"parentId,omitempty"`
+	Position int        `json:"position"`
+}
+
+// ConvertToCategoryTree собирает дерево из плоского списка узлов. Порядок соседей
+// сохраняется из входного списка; узлы с отсутствующим родителем отбрасываются.
+func ConvertToCategoryTree(categories []*models.Category) CategoryTreeResponse {
+	children := make(map[uuid.UUID][]*models.Category, len(categories))
+	roots := make([]*models.Category, 0)
+	for _, cat := range categories {
+		if cat == nil {
+			continue
+		}
+		if cat.ParentID.Valid {
+			children[cat.ParentID.UUID] = append(children[cat.ParentID.UUID], cat)
+		} else {
+			roots = append(roots, cat)
+		}
+	}
+
+	var build func(nodes []*models.Category) []CategoryTreeNode
+	build = func(nodes []*models.Category) []CategoryTreeNode {
+		res := make([]CategoryTreeNode, 0, len(nodes))
+		for _, cat := range nodes {
+			res = append(res, CategoryTreeNode{
+				ID:       cat.ID,
+				Name:     cat.Name,
+				Slug:     cat.Slug,
+				ImageURL: cat.ImageURL,
+				Position: cat.Position,
+				Children: build(children[cat.ID]),
+			})
+		}
+		return res
+	}
+
+	return CategoryTreeResponse{Categories: build(roots)}
+}
+
+func ConvertToBreadcrumbsResponse(path []*models.Category) BreadcrumbsResponse {
+	res := BreadcrumbsResponse{Breadcrumbs: make([]BreadcrumbDTO, 0, len(path))}
+	for _, cat := range path {
+		res.Breadcrumbs = append(res.Breadcrumbs, BreadcrumbDTO{
+			ID:   cat.ID,
+			Name: cat.Name,
+			Slug: cat.Slug,
+		})
+	}
+	return res
+}
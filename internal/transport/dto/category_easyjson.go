@@ -5,6 +5,7 @@ package dto
 import (
 	json "encoding/json"
 	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	uuid "github.com/google/uuid"
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
@@ -18,7 +19,7 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(in *jlexer.Lexer, out *NameSubcategory) {
+func easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(in *jlexer.Lexer, out *UpdateCategoryRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -39,6 +40,486 @@ func easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		switch key {
 		case "name":
 			out.Name = string(in.String())
+		case "slug":
+			out.Slug = string(in.String())
+		case "imageUrl":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ImageURL).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(out *jwriter.Writer, in UpdateCategoryRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Name != "" {
+		const prefix string = ",\"name\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	if in.Slug != "" {
+		const prefix string = ",\"slug\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.String(string(in.Slug))
+	}
+	{
+		const prefix string = ",\"imageUrl\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((in.ImageURL).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UpdateCategoryRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UpdateCategoryRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UpdateCategoryRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UpdateCategoryRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(l, v)
+}
+func easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(in *jlexer.Lexer, out *NameSubcategory) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(out *jwriter.Writer, in NameSubcategory) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NameSubcategory) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NameSubcategory) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NameSubcategory) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NameSubcategory) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(l, v)
+}
+func easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(in *jlexer.Lexer, out *MoveCategoryRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "parentId":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentID = nil
+			} else {
+				if out.ParentID == nil {
+					out.ParentID = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.ParentID).UnmarshalText(data))
+				}
+			}
+		case "position":
+			out.Position = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(out *jwriter.Writer, in MoveCategoryRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.ParentID != nil {
+		const prefix string = ",\"parentId\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.RawText((*in.ParentID).MarshalText())
+	}
+	{
+		const prefix string = ",\"position\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Position))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MoveCategoryRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MoveCategoryRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MoveCategoryRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MoveCategoryRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(l, v)
+}
+func easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(in *jlexer.Lexer, out *CreateCategoryRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "parentId":
+			if in.IsNull() {
+				in.Skip()
+				out.ParentID = nil
+			} else {
+				if out.ParentID == nil {
+					out.ParentID = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.ParentID).UnmarshalText(data))
+				}
+			}
+		case "slug":
+			out.Slug = string(in.String())
+		case "imageUrl":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ImageURL).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(out *jwriter.Writer, in CreateCategoryRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	if in.ParentID != nil {
+		const prefix string = ",\"parentId\":"
+		out.RawString(prefix)
+		out.RawText((*in.ParentID).MarshalText())
+	}
+	if in.Slug != "" {
+		const prefix string = ",\"slug\":"
+		out.RawString(prefix)
+		out.String(string(in.Slug))
+	}
+	{
+		const prefix string = ",\"imageUrl\":"
+		out.RawString(prefix)
+		out.Raw((in.ImageURL).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreateCategoryRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreateCategoryRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreateCategoryRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreateCategoryRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(l, v)
+}
+func easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(in *jlexer.Lexer, out *CategoryTreeResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "categories":
+			if in.IsNull() {
+				in.Skip()
+				out.Categories = nil
+			} else {
+				in.Delim('[')
+				if out.Categories == nil {
+					if !in.IsDelim(']') {
+						out.Categories = make([]CategoryTreeNode, 0, 0)
+					} else {
+						out.Categories = []CategoryTreeNode{}
+					}
+				} else {
+					out.Categories = (out.Categories)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 CategoryTreeNode
+					(v1).UnmarshalEasyJSON(in)
+					out.Categories = append(out.Categories, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(out *jwriter.Writer, in CategoryTreeResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"categories\":"
+		out.RawString(prefix[1:])
+		if in.Categories == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Categories {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CategoryTreeResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CategoryTreeResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CategoryTreeResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CategoryTreeResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(l, v)
+}
+func easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(in *jlexer.Lexer, out *CategoryTreeNode) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "slug":
+			out.Slug = string(in.String())
+		case "imageUrl":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ImageURL).UnmarshalJSON(data))
+			}
+		case "position":
+			out.Position = int(in.Int())
+		case "children":
+			if in.IsNull() {
+				in.Skip()
+				out.Children = nil
+			} else {
+				in.Delim('[')
+				if out.Children == nil {
+					if !in.IsDelim(']') {
+						out.Children = make([]CategoryTreeNode, 0, 0)
+					} else {
+						out.Children = []CategoryTreeNode{}
+					}
+				} else {
+					out.Children = (out.Children)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 CategoryTreeNode
+					(v4).UnmarshalEasyJSON(in)
+					out.Children = append(out.Children, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -49,42 +530,78 @@ func easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		in.Consumed()
 	}
 }
-func easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(out *jwriter.Writer, in NameSubcategory) {
+func easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(out *jwriter.Writer, in CategoryTreeNode) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"name\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
 		out.String(string(in.Name))
 	}
+	{
+		const prefix string = ",\"slug\":"
+		out.RawString(prefix)
+		out.String(string(in.Slug))
+	}
+	{
+		const prefix string = ",\"imageUrl\":"
+		out.RawString(prefix)
+		out.Raw((in.ImageURL).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		out.Int(int(in.Position))
+	}
+	{
+		const prefix string = ",\"children\":"
+		out.RawString(prefix)
+		if in.Children == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Children {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v NameSubcategory) MarshalJSON() ([]byte, error) {
+func (v CategoryTreeNode) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(&w, v)
+	easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v NameSubcategory) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(w, v)
+func (v CategoryTreeNode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *NameSubcategory) UnmarshalJSON(data []byte) error {
+func (v *CategoryTreeNode) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(&r, v)
+	easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *NameSubcategory) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(l, v)
+func (v *CategoryTreeNode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(l, v)
 }
-func easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(in *jlexer.Lexer, out *CategoryResponse) {
+func easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(in *jlexer.Lexer, out *CategoryResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -113,7 +630,7 @@ func easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 				in.Delim('[')
 				if out.Categorys == nil {
 					if !in.IsDelim(']') {
-						out.Categorys = make([]models.Category, 0, 2)
+						out.Categorys = make([]models.Category, 0, 0)
 					} else {
 						out.Categorys = []models.Category{}
 					}
@@ -121,9 +638,9 @@ func easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 					out.Categorys = (out.Categorys)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v1 models.Category
-					easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalModels(in, &v1)
-					out.Categorys = append(out.Categorys, v1)
+					var v7 models.Category
+					easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalModels(in, &v7)
+					out.Categorys = append(out.Categorys, v7)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -138,7 +655,7 @@ func easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		in.Consumed()
 	}
 }
-func easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(out *jwriter.Writer, in CategoryResponse) {
+func easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(out *jwriter.Writer, in CategoryResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -154,11 +671,11 @@ func easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v2, v3 := range in.Categorys {
-				if v2 > 0 {
+			for v8, v9 := range in.Categorys {
+				if v8 > 0 {
 					out.RawByte(',')
 				}
-				easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalModels(out, v3)
+				easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalModels(out, v9)
 			}
 			out.RawByte(']')
 		}
@@ -169,25 +686,25 @@ func easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 // MarshalJSON supports json.Marshaler interface
 func (v CategoryResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&w, v)
+	easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CategoryResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(w, v)
+	easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CategoryResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&r, v)
+	easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CategoryResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(l, v)
+	easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(l, v)
 }
 func easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalModels(in *jlexer.Lexer, out *models.Category) {
 	isTopLevel := in.IsStart()
@@ -214,6 +731,18 @@ func easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalModels(in
 			}
 		case "name":
 			out.Name = string(in.String())
+		case "parentId":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ParentID).UnmarshalJSON(data))
+			}
+		case "slug":
+			out.Slug = string(in.String())
+		case "imageUrl":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ImageURL).UnmarshalJSON(data))
+			}
+		case "position":
+			out.Position = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -238,5 +767,205 @@ func easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalModels(out
 		out.RawString(prefix)
 		out.String(string(in.Name))
 	}
+	{
+		const prefix string = ",\"parentId\":"
+		out.RawString(prefix)
+		out.Raw((in.ParentID).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"slug\":"
+		out.RawString(prefix)
+		out.String(string(in.Slug))
+	}
+	{
+		const prefix string = ",\"imageUrl\":"
+		out.RawString(prefix)
+		out.Raw((in.ImageURL).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		out.Int(int(in.Position))
+	}
+	out.RawByte('}')
+}
+func easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(in *jlexer.Lexer, out *BreadcrumbsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "breadcrumbs":
+			if in.IsNull() {
+				in.Skip()
+				out.Breadcrumbs = nil
+			} else {
+				in.Delim('[')
+				if out.Breadcrumbs == nil {
+					if !in.IsDelim(']') {
+						out.Breadcrumbs = make([]BreadcrumbDTO, 0, 1)
+					} else {
+						out.Breadcrumbs = []BreadcrumbDTO{}
+					}
+				} else {
+					out.Breadcrumbs = (out.Breadcrumbs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 BreadcrumbDTO
+					(v10).UnmarshalEasyJSON(in)
+					out.Breadcrumbs = append(out.Breadcrumbs, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(out *jwriter.Writer, in BreadcrumbsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"breadcrumbs\":"
+		out.RawString(prefix[1:])
+		if in.Breadcrumbs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.Breadcrumbs {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				(v12).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BreadcrumbsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BreadcrumbsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BreadcrumbsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BreadcrumbsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(l, v)
+}
+func easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto8(in *jlexer.Lexer, out *BreadcrumbDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "slug":
+			out.Slug = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto8(out *jwriter.Writer, in BreadcrumbDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"slug\":"
+		out.RawString(prefix)
+		out.String(string(in.Slug))
+	}
 	out.RawByte('}')
 }
+
+// MarshalJSON supports json.Marshaler interface
+func (v BreadcrumbDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BreadcrumbDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6a91a67cEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BreadcrumbDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BreadcrumbDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6a91a67cDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto8(l, v)
+}
@@ -0,0 +1,15 @@
+package dto
+
+import (
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+)
+
+// SetExchangeRateRequest — курс валюты: сколько рублей стоит её единица
+type SetExchangeRateRequest struct {
+	Rate models.Rate `json:"rate"`
+}
+
+// ImportRatesResponse — число курсов, обновлённых из файла
+type ImportRatesResponse struct {
+	Imported int `json:"imported"`
+}
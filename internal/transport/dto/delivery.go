@@ -0,0 +1,68 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+type DeliverySlotDTO struct {
+	ID       uuid.UUID `json:"id"`
+	ZoneID   uuid.UUID `json:"zoneID"`
+	StartsAt time.Time `json:"startsAt"`
+	EndsAt   time.Time `json:"endsAt"`
+	// Free — сколько заказов ещё можно оформить на интервал
+	Free int `json:"free"`
+}
+
+// DeliveryOptionsDTO — варианты доставки по адресу: ближайшая дата без выбора
+// интервала и свободные интервалы
+type DeliveryOptionsDTO struct {
+	Zone               string            `json:"zone"`
+	ExpectedDeliveryAt time.Time         `json:"expectedDeliveryAt"`
+	Slots              []DeliverySlotDTO `json:"slots"`
+}
+
+type CreateDeliveryZoneRequest struct {
+	Name         string  `json:"name"`
+	Region       *string `json:"region,omitempty"`
+	City         *string `json:"city,omitempty"`
+	LeadTimeDays int     `json:"leadTimeDays"`
+}
+
+type CreateDeliverySlotRequest struct {
+	ZoneID   uuid.UUID `json:"zoneID"`
+	StartsAt time.Time `json:"startsAt"`
+	EndsAt   time.Time `json:"endsAt"`
+	Capacity int       `json:"capacity"`
+}
+
+type DeliveryZoneDTO struct {
+	ID           uuid.UUID   `json:"id"`
+	Name         string      `json:"name"`
+	Region       null.String `json:"region" swaggertype:"primitive,string"`
+	City         null.String `json:"city" swaggertype:"primitive,string"`
+	LeadTimeDays int         `json:"leadTimeDays"`
+}
+
+func ConvertToDeliverySlotDTO(slot models.DeliverySlot) DeliverySlotDTO {
+	return DeliverySlotDTO{
+		ID:       slot.ID,
+		ZoneID:   slot.ZoneID,
+		StartsAt: slot.StartsAt,
+		EndsAt:   slot.EndsAt,
+		Free:     slot.Capacity - slot.Reserved,
+	}
+}
+
+func ConvertToDeliveryZoneDTO(zone models.DeliveryZone) DeliveryZoneDTO {
+	return DeliveryZoneDTO{
+		ID:           zone.ID,
+		Name:         zone.Name,
+		Region:       zone.Region,
+		City:         zone.City,
+		LeadTimeDays: zone.LeadTimeDays,
+	}
+}
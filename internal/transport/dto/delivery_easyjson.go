@@ -0,0 +1,531 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package dto
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(in *jlexer.Lexer, out *DeliveryZoneDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "region":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Region).UnmarshalJSON(data))
+			}
+		case "city":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.City).UnmarshalJSON(data))
+			}
+		case "leadTimeDays":
+			out.LeadTimeDays = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(out *jwriter.Writer, in DeliveryZoneDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"region\":"
+		out.RawString(prefix)
+		out.Raw((in.Region).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		out.Raw((in.City).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"leadTimeDays\":"
+		out.RawString(prefix)
+		out.Int(int(in.LeadTimeDays))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DeliveryZoneDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DeliveryZoneDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DeliveryZoneDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DeliveryZoneDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(in *jlexer.Lexer, out *DeliverySlotDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "zoneID":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ZoneID).UnmarshalText(data))
+			}
+		case "startsAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.StartsAt).UnmarshalJSON(data))
+			}
+		case "endsAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.EndsAt).UnmarshalJSON(data))
+			}
+		case "free":
+			out.Free = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(out *jwriter.Writer, in DeliverySlotDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"zoneID\":"
+		out.RawString(prefix)
+		out.RawText((in.ZoneID).MarshalText())
+	}
+	{
+		const prefix string = ",\"startsAt\":"
+		out.RawString(prefix)
+		out.Raw((in.StartsAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"endsAt\":"
+		out.RawString(prefix)
+		out.Raw((in.EndsAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"free\":"
+		out.RawString(prefix)
+		out.Int(int(in.Free))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DeliverySlotDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DeliverySlotDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DeliverySlotDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DeliverySlotDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(in *jlexer.Lexer, out *DeliveryOptionsDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "zone":
+			out.Zone = string(in.String())
+		case "expectedDeliveryAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ExpectedDeliveryAt).UnmarshalJSON(data))
+			}
+		case "slots":
+			if in.IsNull() {
+				in.Skip()
+				out.Slots = nil
+			} else {
+				in.Delim('[')
+				if out.Slots == nil {
+					if !in.IsDelim(']') {
+						out.Slots = make([]DeliverySlotDTO, 0, 0)
+					} else {
+						out.Slots = []DeliverySlotDTO{}
+					}
+				} else {
+					out.Slots = (out.Slots)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 DeliverySlotDTO
+					(v1).UnmarshalEasyJSON(in)
+					out.Slots = append(out.Slots, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(out *jwriter.Writer, in DeliveryOptionsDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"zone\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Zone))
+	}
+	{
+		const prefix string = ",\"expectedDeliveryAt\":"
+		out.RawString(prefix)
+		out.Raw((in.ExpectedDeliveryAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"slots\":"
+		out.RawString(prefix)
+		if in.Slots == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Slots {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DeliveryOptionsDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DeliveryOptionsDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DeliveryOptionsDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DeliveryOptionsDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(in *jlexer.Lexer, out *CreateDeliveryZoneRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "region":
+			if in.IsNull() {
+				in.Skip()
+				out.Region = nil
+			} else {
+				if out.Region == nil {
+					out.Region = new(string)
+				}
+				*out.Region = string(in.String())
+			}
+		case "city":
+			if in.IsNull() {
+				in.Skip()
+				out.City = nil
+			} else {
+				if out.City == nil {
+					out.City = new(string)
+				}
+				*out.City = string(in.String())
+			}
+		case "leadTimeDays":
+			out.LeadTimeDays = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(out *jwriter.Writer, in CreateDeliveryZoneRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	if in.Region != nil {
+		const prefix string = ",\"region\":"
+		out.RawString(prefix)
+		out.String(string(*in.Region))
+	}
+	if in.City != nil {
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		out.String(string(*in.City))
+	}
+	{
+		const prefix string = ",\"leadTimeDays\":"
+		out.RawString(prefix)
+		out.Int(int(in.LeadTimeDays))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreateDeliveryZoneRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreateDeliveryZoneRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreateDeliveryZoneRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreateDeliveryZoneRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(in *jlexer.Lexer, out *CreateDeliverySlotRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "zoneID":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ZoneID).UnmarshalText(data))
+			}
+		case "startsAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.StartsAt).UnmarshalJSON(data))
+			}
+		case "endsAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.EndsAt).UnmarshalJSON(data))
+			}
+		case "capacity":
+			out.Capacity = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(out *jwriter.Writer, in CreateDeliverySlotRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"zoneID\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ZoneID).MarshalText())
+	}
+	{
+		const prefix string = ",\"startsAt\":"
+		out.RawString(prefix)
+		out.Raw((in.StartsAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"endsAt\":"
+		out.RawString(prefix)
+		out.Raw((in.EndsAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"capacity\":"
+		out.RawString(prefix)
+		out.Int(int(in.Capacity))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreateDeliverySlotRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreateDeliverySlotRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreateDeliverySlotRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreateDeliverySlotRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(l, v)
+}
@@ -0,0 +1,17 @@
+package dto
+
+import "github.com/google/uuid"
+
+// StockAdjustmentRequest — ручное изменение остатка товара или SKU продавцом.
+// Kind — adjustment (по умолчанию) или return.
+type StockAdjustmentRequest struct {
+	VariantID *uuid.UUID `json:"variant_id,omitempty"`
+	Delta     int        `json:"delta"`
+	Kind      string     `json:"kind,omitempty"`
+	Reason    string     `json:"reason"`
+}
+
+// LowStockThresholdRequest — порог низкого остатка товара; null снимает порог
+type LowStockThresholdRequest struct {
+	Threshold *int `json:"threshold"`
+}
@@ -3,6 +3,7 @@ package dto
 import (
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/google/uuid"
+	"github.com/guregu/null"
 	"time"
 )
 
@@ -10,52 +11,114 @@ type Order struct {
 	ID                 uuid.UUID
 	UserID             uuid.UUID
 	Status             models.OrderStatus
-	TotalPrice         float64
-	TotalPriceDiscount float64
+	TotalPrice         models.Money
+	TotalPriceDiscount models.Money
+	DeliveryCost       models.Money
 	AddressID          uuid.UUID
-	Items              []CreateOrderItemDTO
+	ExpectedDeliveryAt *time.Time
+	// DeliverySlotID — интервал доставки, выбранный покупателем
+	DeliverySlotID uuid.NullUUID
+	// PickupPointID — ПВЗ при самовывозе; AddressID тогда указывает на адрес ПВЗ
+	PickupPointID uuid.NullUUID
+	Items         []CreateOrderItemDTO
+	// Shipments — товары заказа, разложенные по продавцам; позиции ссылаются на них через ShipmentID
+	Shipments []Shipment
+	// DisplayRate — курс валюты, в которой покупатель видел заказ при оформлении
+	DisplayRate models.ExchangeRate
+}
+
+type Shipment struct {
+	ID                 uuid.UUID
+	SellerID           uuid.UUID
+	Status             models.OrderStatus
+	TotalPrice         models.Money
+	TotalPriceDiscount models.Money
 }
 
 type CreateOrderDTO struct {
 	UserID    uuid.UUID
 	Items     []CreateOrderItemDTO `json:"items"`
 	AddressID uuid.UUID            `json:"addressID"`
-	PromoCode *string              `json:"promoCode,omitempty"`
+	// PickupPointID — ПВЗ для самовывоза, указывается вместо addressID
+	PickupPointID *uuid.UUID `json:"pickupPointID,omitempty"`
+	PromoCode     *string    `json:"promoCode,omitempty"`
+	// DeliverySlotID — интервал доставки; без него заказ доставляется в ближайшую возможную дату
+	DeliverySlotID *uuid.UUID `json:"deliverySlotID,omitempty"`
 }
 
 type CreateOrderItemDTO struct {
-	ID        uuid.UUID
-	ProductID uuid.UUID `json:"productID"`
-	Price     float64   `json:"productPrice"`
-	Quantity  uint      `json:"quantity"`
+	ID         uuid.UUID
+	ShipmentID uuid.UUID `json:"-"`
+	ProductID  uuid.UUID `json:"productID"`
+	// VariantID — выбранный SKU, обязателен для товаров с вариантами
+	VariantID *uuid.UUID   `json:"variantID,omitempty"`
+	Price     models.Money `json:"productPrice"`
+	BasePrice models.Money `json:"-"`
+	Quantity  uint         `json:"quantity"`
+}
+
+// VariantNullID возвращает выбранный SKU в виде, пригодном для запросов к базе
+func (i CreateOrderItemDTO) VariantNullID() uuid.NullUUID {
+	if i.VariantID == nil {
+		return uuid.NullUUID{}
+	}
+	return uuid.NullUUID{UUID: *i.VariantID, Valid: true}
 }
 
 type CreateOrderRepoReq struct {
-	Order             *Order
-	UpdatedQuantities map[uuid.UUID]uint
+	Order *Order
+	// PromoRedemption заполняется, если к заказу применён промокод
+	PromoRedemption *models.PromoRedemption
 }
 
 type GetOrderByUserIDResDTO struct {
 	ID                 uuid.UUID          `json:"id"`
 	Status             models.OrderStatus `json:"status"`
-	TotalPrice         float64            `json:"totalPrice"`
-	TotalPriceDiscount float64            `json:"totalPriceDiscount"`
+	TotalPrice         models.Money       `json:"totalPrice"`
+	TotalPriceDiscount models.Money       `json:"totalPriceDiscount"`
 	AddressID          uuid.UUID          `json:"addressID"`
 	ExpectedDeliveryAt *time.Time         `json:"expectedDeliveryAt"`
 	ActualDeliveryAt   *time.Time         `json:"actualDeliveryAt"`
 	CreatedAt          *time.Time         `json:"createdAt,omitempty"`
+	// DisplayRate — курс, сохранённый при оформлении заказа
+	DisplayRate models.ExchangeRate `json:"-"`
 }
 
+// OrderPreviewDTO — заказ в истории покупателя. Суммы показываются в валюте
+// Currency по курсу ExchangeRate, сохранённому при оформлении.
 type OrderPreviewDTO struct {
 	ID                 uuid.UUID                       `json:"id"`
 	Status             models.OrderStatus              `json:"status"`
-	TotalPrice         float64                         `json:"totalPrice"`
-	TotalDiscountPrice float64                         `json:"totalDiscountPrice"`
+	TotalPrice         models.Money                    `json:"totalPrice"`
+	TotalDiscountPrice models.Money                    `json:"totalDiscountPrice"`
 	Products           []models.OrderPreviewProductDTO `json:"products"`
+	Shipments          []ShipmentPreviewDTO            `json:"shipments"`
 	Address            models.AddressDB                `json:"address"`
 	ExpectedDeliveryAt *time.Time                      `json:"expectedDeliveryAt"`
 	ActualDeliveryAt   *time.Time                      `json:"actualDeliveryAt"`
 	CreatedAt          *time.Time                      `json:"createdAt,omitempty"`
+	Currency           models.Currency                 `json:"currency"`
+	ExchangeRate       *models.Rate                    `json:"exchangeRate,omitempty"`
+	DisplayRate        models.ExchangeRate             `json:"-"`
+}
+
+// InDisplayCurrency возвращает заказ с суммами в валюте, в которой покупатель
+// видел его при оформлении, по сохранённому тогда курсу
+func (o OrderPreviewDTO) InDisplayCurrency() OrderPreviewDTO {
+	rate := o.DisplayRate
+	o.TotalPrice = rate.Convert(o.TotalPrice)
+	o.TotalDiscountPrice = rate.Convert(o.TotalDiscountPrice)
+	o.Shipments = shipmentsInCurrency(o.Shipments, rate)
+	o.Currency, o.ExchangeRate = displayCurrency(rate)
+	return o
+}
+
+// displayCurrency возвращает валюту и курс для ответа; для рублей курс не указывается
+func displayCurrency(rate models.ExchangeRate) (models.Currency, *models.Rate) {
+	if rate.IsBase() {
+		return models.BaseCurrency, nil
+	}
+	return rate.Code(), &rate.Rate
 }
 
 func (orderItem *GetOrderByUserIDResDTO) ConvertToGetOrderByUserIDResDTO(
@@ -69,6 +132,8 @@ func (orderItem *GetOrderByUserIDResDTO) ConvertToGetOrderByUserIDResDTO(
 		TotalDiscountPrice: orderItem.TotalPriceDiscount,
 		Products:           products,
 		Address:            *address,
+		Currency:           models.BaseCurrency,
+		DisplayRate:        orderItem.DisplayRate,
 	}
 }
 
@@ -80,4 +145,178 @@ type GetOrderProductResDTO struct {
 
 type UpdateOrderStatusRequest struct{
 	OrderID uuid.UUID   `json:"orderID"`
-}
\ No newline at end of file
+	// Status — новый статус заказа: in_transit (по умолчанию) или delivered
+	Status string `json:"status,omitempty"`
+}
+
+// ShipmentPreviewDTO — отправление одного продавца в составе заказа
+type ShipmentPreviewDTO struct {
+	ID                 uuid.UUID          `json:"id"`
+	SellerID           uuid.UUID          `json:"sellerID"`
+	SellerName         string             `json:"sellerName"`
+	Status             models.OrderStatus `json:"status"`
+	TotalPrice         models.Money       `json:"totalPrice"`
+	TotalDiscountPrice models.Money       `json:"totalDiscountPrice"`
+	TrackingNumber     null.String        `json:"trackingNumber" swaggertype:"primitive,string"`
+	ExpectedDeliveryAt *time.Time         `json:"expectedDeliveryAt"`
+	Products           []ShipmentItemDTO  `json:"products"`
+}
+
+type ShipmentItemDTO struct {
+	ProductID       uuid.UUID             `json:"productID"`
+	VariantID       *uuid.UUID            `json:"variantID,omitempty"`
+	VariantOptions  models.VariantOptions `json:"variantOptions,omitempty"`
+	ProductName     string                `json:"productName"`
+	ProductImageURL null.String           `json:"productImageURL" swaggertype:"primitive,string"`
+	BasePrice       models.Money          `json:"basePrice"`
+	Price           models.Money          `json:"price"`
+	Quantity        uint                  `json:"quantity"`
+}
+
+// Discount — скидка на позицию целиком
+func (i ShipmentItemDTO) Discount() models.Money {
+	return i.BasePrice.Sub(i.Price).Mul(int64(i.Quantity))
+}
+
+// Total — сумма позиции со скидкой
+func (i ShipmentItemDTO) Total() models.Money {
+	return i.Price.Mul(int64(i.Quantity))
+}
+
+// InCurrency возвращает отправление с суммами в валюте курса
+func (s ShipmentPreviewDTO) InCurrency(rate models.ExchangeRate) ShipmentPreviewDTO {
+	s.TotalPrice = rate.Convert(s.TotalPrice)
+	s.TotalDiscountPrice = rate.Convert(s.TotalDiscountPrice)
+
+	products := make([]ShipmentItemDTO, 0, len(s.Products))
+	for _, product := range s.Products {
+		product.BasePrice = rate.Convert(product.BasePrice)
+		product.Price = rate.Convert(product.Price)
+		products = append(products, product)
+	}
+	s.Products = products
+	return s
+}
+
+func shipmentsInCurrency(shipments []ShipmentPreviewDTO, rate models.ExchangeRate) []ShipmentPreviewDTO {
+	if shipments == nil {
+		return nil
+	}
+	result := make([]ShipmentPreviewDTO, 0, len(shipments))
+	for _, shipment := range shipments {
+		result = append(result, shipment.InCurrency(rate))
+	}
+	return result
+}
+
+// SellerOrderDTO — отправление в списке заказов продавца
+type SellerOrderDTO struct {
+	ShipmentPreviewDTO
+	OrderID   uuid.UUID        `json:"orderID"`
+	Address   models.AddressDB `json:"address"`
+	CreatedAt *time.Time       `json:"createdAt,omitempty"`
+}
+
+// ConfirmShipmentRequest — данные, которые продавец может указать при подтверждении отправления
+type ConfirmShipmentRequest struct {
+	TrackingNumber     *string    `json:"trackingNumber,omitempty"`
+	ExpectedDeliveryAt *time.Time `json:"expectedDeliveryAt,omitempty"`
+}
+
+type ConfirmShipmentRepoReq struct {
+	ShipmentID         uuid.UUID
+	SellerID           uuid.UUID
+	TrackingNumber     *string
+	ExpectedDeliveryAt *time.Time
+}
+
+func ConvertToShipmentPreviewDTO(shipment models.OrderShipment) ShipmentPreviewDTO {
+	products := make([]ShipmentItemDTO, 0, len(shipment.Items))
+	for _, item := range shipment.Items {
+		var variantID *uuid.UUID
+		if item.VariantID.Valid {
+			variantID = &item.VariantID.UUID
+		}
+		products = append(products, ShipmentItemDTO{
+			ProductID:       item.ProductID,
+			VariantID:       variantID,
+			VariantOptions:  item.VariantOptions,
+			ProductName:     item.ProductName,
+			ProductImageURL: item.ProductImageURL,
+			BasePrice:       item.BasePrice,
+			Price:           item.Price,
+			Quantity:        item.Quantity,
+		})
+	}
+
+	return ShipmentPreviewDTO{
+		ID:                 shipment.ID,
+		SellerID:           shipment.SellerID,
+		SellerName:         shipment.SellerName,
+		Status:             shipment.Status,
+		TotalPrice:         shipment.TotalPrice,
+		TotalDiscountPrice: shipment.TotalPriceDiscount,
+		TrackingNumber:     shipment.TrackingNumber,
+		ExpectedDeliveryAt: shipment.ExpectedDeliveryAt,
+		Products:           products,
+	}
+}
+
+func ConvertToShipmentPreviews(shipments []models.OrderShipment) []ShipmentPreviewDTO {
+	result := make([]ShipmentPreviewDTO, 0, len(shipments))
+	for _, shipment := range shipments {
+		result = append(result, ConvertToShipmentPreviewDTO(shipment))
+	}
+	return result
+}
+
+func ConvertToSellerOrderDTO(shipment models.OrderShipment, address *models.AddressDB) SellerOrderDTO {
+	order := SellerOrderDTO{
+		ShipmentPreviewDTO: ConvertToShipmentPreviewDTO(shipment),
+		OrderID:            shipment.OrderID,
+		CreatedAt:          shipment.CreatedAt,
+	}
+	if address != nil {
+		order.Address = *address
+	}
+	return order
+}
+
+// OrderDetailDTO — заказ с полной разбивкой стоимости.
+// Total = Subtotal - ProductDiscount - PromoDiscount + DeliveryCost.
+// Суммы показываются в валюте Currency, ChargeTotal — списанная сумма в рублях.
+type OrderDetailDTO struct {
+	ID                 uuid.UUID            `json:"id"`
+	Status             models.OrderStatus   `json:"status"`
+	Address            models.AddressDB     `json:"address"`
+	PickupPointID      uuid.NullUUID        `json:"pickupPointID" swaggertype:"primitive,string"`
+	Shipments          []ShipmentPreviewDTO `json:"shipments"`
+	Subtotal           models.Money         `json:"subtotal"`
+	ProductDiscount    models.Money         `json:"productDiscount"`
+	PromoCode          null.String          `json:"promoCode" swaggertype:"primitive,string"`
+	PromoDiscount      models.Money         `json:"promoDiscount"`
+	DeliveryCost       models.Money         `json:"deliveryCost"`
+	Total              models.Money         `json:"total"`
+	ChargeTotal        models.Money         `json:"chargeTotal"`
+	Currency           models.Currency      `json:"currency"`
+	ExchangeRate       *models.Rate         `json:"exchangeRate,omitempty"`
+	DisplayRate        models.ExchangeRate  `json:"-"`
+	ExpectedDeliveryAt *time.Time           `json:"expectedDeliveryAt"`
+	ActualDeliveryAt   *time.Time           `json:"actualDeliveryAt"`
+	CreatedAt          *time.Time           `json:"createdAt,omitempty"`
+}
+
+// InDisplayCurrency возвращает заказ с суммами в валюте, в которой покупатель
+// видел его при оформлении, по сохранённому тогда курсу. ChargeTotal остаётся
+// в рублях.
+func (o OrderDetailDTO) InDisplayCurrency() OrderDetailDTO {
+	rate := o.DisplayRate
+	o.Subtotal = rate.Convert(o.Subtotal)
+	o.ProductDiscount = rate.Convert(o.ProductDiscount)
+	o.PromoDiscount = rate.Convert(o.PromoDiscount)
+	o.DeliveryCost = rate.Convert(o.DeliveryCost)
+	o.Total = rate.Convert(o.Total)
+	o.Shipments = shipmentsInCurrency(o.Shipments, rate)
+	o.Currency, o.ExchangeRate = displayCurrency(rate)
+	return o
+}
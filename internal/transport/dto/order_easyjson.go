@@ -43,6 +43,8 @@ func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 			if data := in.UnsafeBytes(); in.Ok() {
 				in.AddError((out.OrderID).UnmarshalText(data))
 			}
+		case "status":
+			out.Status = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -62,6 +64,11 @@ func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		out.RawString(prefix[1:])
 		out.RawText((in.OrderID).MarshalText())
 	}
+	if in.Status != "" {
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
 	out.RawByte('}')
 }
 
@@ -88,7 +95,7 @@ func (v *UpdateOrderStatusRequest) UnmarshalJSON(data []byte) error {
 func (v *UpdateOrderStatusRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(l, v)
 }
-func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(in *jlexer.Lexer, out *OrderPreviewDTO) {
+func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(in *jlexer.Lexer, out *ShipmentPreviewDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -111,37 +118,22 @@ func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 			if data := in.UnsafeBytes(); in.Ok() {
 				in.AddError((out.ID).UnmarshalText(data))
 			}
+		case "sellerID":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.SellerID).UnmarshalText(data))
+			}
+		case "sellerName":
+			out.SellerName = string(in.String())
 		case "status":
 			out.Status = models.OrderStatus(in.Int())
 		case "totalPrice":
-			out.TotalPrice = float64(in.Float64())
+			(out.TotalPrice).UnmarshalEasyJSON(in)
 		case "totalDiscountPrice":
-			out.TotalDiscountPrice = float64(in.Float64())
-		case "products":
-			if in.IsNull() {
-				in.Skip()
-				out.Products = nil
-			} else {
-				in.Delim('[')
-				if out.Products == nil {
-					if !in.IsDelim(']') {
-						out.Products = make([]models.OrderPreviewProductDTO, 0, 1)
-					} else {
-						out.Products = []models.OrderPreviewProductDTO{}
-					}
-				} else {
-					out.Products = (out.Products)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v1 models.OrderPreviewProductDTO
-					easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalModels(in, &v1)
-					out.Products = append(out.Products, v1)
-					in.WantComma()
-				}
-				in.Delim(']')
+			(out.TotalDiscountPrice).UnmarshalEasyJSON(in)
+		case "trackingNumber":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.TrackingNumber).UnmarshalJSON(data))
 			}
-		case "address":
-			easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalModels1(in, &out.Address)
 		case "expectedDeliveryAt":
 			if in.IsNull() {
 				in.Skip()
@@ -154,29 +146,28 @@ func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 					in.AddError((*out.ExpectedDeliveryAt).UnmarshalJSON(data))
 				}
 			}
-		case "actualDeliveryAt":
-			if in.IsNull() {
-				in.Skip()
-				out.ActualDeliveryAt = nil
-			} else {
-				if out.ActualDeliveryAt == nil {
-					out.ActualDeliveryAt = new(time.Time)
-				}
-				if data := in.Raw(); in.Ok() {
-					in.AddError((*out.ActualDeliveryAt).UnmarshalJSON(data))
-				}
-			}
-		case "createdAt":
+		case "products":
 			if in.IsNull() {
 				in.Skip()
-				out.CreatedAt = nil
+				out.Products = nil
 			} else {
-				if out.CreatedAt == nil {
-					out.CreatedAt = new(time.Time)
+				in.Delim('[')
+				if out.Products == nil {
+					if !in.IsDelim(']') {
+						out.Products = make([]ShipmentItemDTO, 0, 0)
+					} else {
+						out.Products = []ShipmentItemDTO{}
+					}
+				} else {
+					out.Products = (out.Products)[:0]
 				}
-				if data := in.Raw(); in.Ok() {
-					in.AddError((*out.CreatedAt).UnmarshalJSON(data))
+				for !in.IsDelim(']') {
+					var v1 ShipmentItemDTO
+					(v1).UnmarshalEasyJSON(in)
+					out.Products = append(out.Products, v1)
+					in.WantComma()
 				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -188,7 +179,7 @@ func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		in.Consumed()
 	}
 }
-func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(out *jwriter.Writer, in OrderPreviewDTO) {
+func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(out *jwriter.Writer, in ShipmentPreviewDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -197,6 +188,16 @@ func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		out.RawString(prefix[1:])
 		out.RawText((in.ID).MarshalText())
 	}
+	{
+		const prefix string = ",\"sellerID\":"
+		out.RawString(prefix)
+		out.RawText((in.SellerID).MarshalText())
+	}
+	{
+		const prefix string = ",\"sellerName\":"
+		out.RawString(prefix)
+		out.String(string(in.SellerName))
+	}
 	{
 		const prefix string = ",\"status\":"
 		out.RawString(prefix)
@@ -205,33 +206,17 @@ func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 	{
 		const prefix string = ",\"totalPrice\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.TotalPrice))
+		(in.TotalPrice).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"totalDiscountPrice\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.TotalDiscountPrice))
-	}
-	{
-		const prefix string = ",\"products\":"
-		out.RawString(prefix)
-		if in.Products == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v2, v3 := range in.Products {
-				if v2 > 0 {
-					out.RawByte(',')
-				}
-				easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalModels(out, v3)
-			}
-			out.RawByte(']')
-		}
+		(in.TotalDiscountPrice).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"address\":"
+		const prefix string = ",\"trackingNumber\":"
 		out.RawString(prefix)
-		easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalModels1(out, in.Address)
+		out.Raw((in.TrackingNumber).MarshalJSON())
 	}
 	{
 		const prefix string = ",\"expectedDeliveryAt\":"
@@ -243,46 +228,48 @@ func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		}
 	}
 	{
-		const prefix string = ",\"actualDeliveryAt\":"
+		const prefix string = ",\"products\":"
 		out.RawString(prefix)
-		if in.ActualDeliveryAt == nil {
+		if in.Products == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
-			out.Raw((*in.ActualDeliveryAt).MarshalJSON())
+			out.RawByte('[')
+			for v2, v3 := range in.Products {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
 		}
 	}
-	if in.CreatedAt != nil {
-		const prefix string = ",\"createdAt\":"
-		out.RawString(prefix)
-		out.Raw((*in.CreatedAt).MarshalJSON())
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v OrderPreviewDTO) MarshalJSON() ([]byte, error) {
+func (v ShipmentPreviewDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v OrderPreviewDTO) MarshalEasyJSON(w *jwriter.Writer) {
+func (v ShipmentPreviewDTO) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *OrderPreviewDTO) UnmarshalJSON(data []byte) error {
+func (v *ShipmentPreviewDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *OrderPreviewDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *ShipmentPreviewDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(l, v)
 }
-func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalModels1(in *jlexer.Lexer, out *models.AddressDB) {
+func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(in *jlexer.Lexer, out *ShipmentItemDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -301,30 +288,54 @@ func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalModels1(in
 			continue
 		}
 		switch key {
-		case "id":
+		case "productID":
 			if data := in.UnsafeBytes(); in.Ok() {
-				in.AddError((out.ID).UnmarshalText(data))
-			}
-		case "label":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Label).UnmarshalJSON(data))
-			}
-		case "region":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Region).UnmarshalJSON(data))
+				in.AddError((out.ProductID).UnmarshalText(data))
 			}
-		case "city":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.City).UnmarshalJSON(data))
+		case "variantID":
+			if in.IsNull() {
+				in.Skip()
+				out.VariantID = nil
+			} else {
+				if out.VariantID == nil {
+					out.VariantID = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.VariantID).UnmarshalText(data))
+				}
 			}
-		case "AddressString":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.AddressString).UnmarshalJSON(data))
+		case "variantOptions":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.VariantOptions = make(models.VariantOptions)
+				} else {
+					out.VariantOptions = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v4 string
+					v4 = string(in.String())
+					(out.VariantOptions)[key] = v4
+					in.WantComma()
+				}
+				in.Delim('}')
 			}
-		case "coordinate":
+		case "productName":
+			out.ProductName = string(in.String())
+		case "productImageURL":
 			if data := in.Raw(); in.Ok() {
-				in.AddError((out.Coordinate).UnmarshalJSON(data))
+				in.AddError((out.ProductImageURL).UnmarshalJSON(data))
 			}
+		case "basePrice":
+			(out.BasePrice).UnmarshalEasyJSON(in)
+		case "price":
+			(out.Price).UnmarshalEasyJSON(in)
+		case "quantity":
+			out.Quantity = uint(in.Uint())
 		default:
 			in.SkipRecursive()
 		}
@@ -335,43 +346,91 @@ func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalModels1(in
 		in.Consumed()
 	}
 }
-func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalModels1(out *jwriter.Writer, in models.AddressDB) {
+func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(out *jwriter.Writer, in ShipmentItemDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"productID\":"
 		out.RawString(prefix[1:])
-		out.RawText((in.ID).MarshalText())
+		out.RawText((in.ProductID).MarshalText())
+	}
+	if in.VariantID != nil {
+		const prefix string = ",\"variantID\":"
+		out.RawString(prefix)
+		out.RawText((*in.VariantID).MarshalText())
+	}
+	if len(in.VariantOptions) != 0 {
+		const prefix string = ",\"variantOptions\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v5First := true
+			for v5Name, v5Value := range in.VariantOptions {
+				if v5First {
+					v5First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v5Name))
+				out.RawByte(':')
+				out.String(string(v5Value))
+			}
+			out.RawByte('}')
+		}
 	}
 	{
-		const prefix string = ",\"label\":"
+		const prefix string = ",\"productName\":"
 		out.RawString(prefix)
-		out.Raw((in.Label).MarshalJSON())
+		out.String(string(in.ProductName))
 	}
 	{
-		const prefix string = ",\"region\":"
+		const prefix string = ",\"productImageURL\":"
 		out.RawString(prefix)
-		out.Raw((in.Region).MarshalJSON())
+		out.Raw((in.ProductImageURL).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"city\":"
+		const prefix string = ",\"basePrice\":"
 		out.RawString(prefix)
-		out.Raw((in.City).MarshalJSON())
+		(in.BasePrice).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"AddressString\":"
+		const prefix string = ",\"price\":"
 		out.RawString(prefix)
-		out.Raw((in.AddressString).MarshalJSON())
+		(in.Price).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"coordinate\":"
+		const prefix string = ",\"quantity\":"
 		out.RawString(prefix)
-		out.Raw((in.Coordinate).MarshalJSON())
+		out.Uint(uint(in.Quantity))
 	}
 	out.RawByte('}')
 }
-func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalModels(in *jlexer.Lexer, out *models.OrderPreviewProductDTO) {
+
+// MarshalJSON supports json.Marshaler interface
+func (v ShipmentItemDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ShipmentItemDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ShipmentItemDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ShipmentItemDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(l, v)
+}
+func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(in *jlexer.Lexer, out *Shipment) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -390,18 +449,20 @@ func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalModels(in
 			continue
 		}
 		switch key {
-		case "product_id":
+		case "ID":
 			if data := in.UnsafeBytes(); in.Ok() {
-				in.AddError((out.ProductID).UnmarshalText(data))
+				in.AddError((out.ID).UnmarshalText(data))
 			}
-		case "product_name":
-			out.ProductName = string(in.String())
-		case "ProductImageURL":
-			if data := in.Raw(); in.Ok() {
-				in.AddError((out.ProductImageURL).UnmarshalJSON(data))
+		case "SellerID":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.SellerID).UnmarshalText(data))
 			}
-		case "ProductQuantity":
-			out.ProductQuantity = uint(in.Uint())
+		case "Status":
+			out.Status = models.OrderStatus(in.Int())
+		case "TotalPrice":
+			(out.TotalPrice).UnmarshalEasyJSON(in)
+		case "TotalPriceDiscount":
+			(out.TotalPriceDiscount).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -412,33 +473,62 @@ func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalModels(in
 		in.Consumed()
 	}
 }
-func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalModels(out *jwriter.Writer, in models.OrderPreviewProductDTO) {
+func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(out *jwriter.Writer, in Shipment) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"product_id\":"
+		const prefix string = ",\"ID\":"
 		out.RawString(prefix[1:])
-		out.RawText((in.ProductID).MarshalText())
+		out.RawText((in.ID).MarshalText())
 	}
 	{
-		const prefix string = ",\"product_name\":"
+		const prefix string = ",\"SellerID\":"
 		out.RawString(prefix)
-		out.String(string(in.ProductName))
+		out.RawText((in.SellerID).MarshalText())
 	}
 	{
-		const prefix string = ",\"ProductImageURL\":"
+		const prefix string = ",\"Status\":"
 		out.RawString(prefix)
-		out.Raw((in.ProductImageURL).MarshalJSON())
+		out.Raw((in.Status).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"ProductQuantity\":"
+		const prefix string = ",\"TotalPrice\":"
 		out.RawString(prefix)
-		out.Uint(uint(in.ProductQuantity))
+		(in.TotalPrice).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"TotalPriceDiscount\":"
+		out.RawString(prefix)
+		(in.TotalPriceDiscount).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
-func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(in *jlexer.Lexer, out *Order) {
+
+// MarshalJSON supports json.Marshaler interface
+func (v Shipment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Shipment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Shipment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Shipment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(l, v)
+}
+func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(in *jlexer.Lexer, out *SellerOrderDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -457,43 +547,75 @@ func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 			continue
 		}
 		switch key {
-		case "ID":
-			if data := in.UnsafeBytes(); in.Ok() {
-				in.AddError((out.ID).UnmarshalText(data))
-			}
-		case "UserID":
+		case "orderID":
 			if data := in.UnsafeBytes(); in.Ok() {
-				in.AddError((out.UserID).UnmarshalText(data))
+				in.AddError((out.OrderID).UnmarshalText(data))
 			}
-		case "Status":
-			out.Status = models.OrderStatus(in.Int())
-		case "TotalPrice":
-			out.TotalPrice = float64(in.Float64())
-		case "TotalPriceDiscount":
-			out.TotalPriceDiscount = float64(in.Float64())
-		case "AddressID":
+		case "address":
+			easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalModels(in, &out.Address)
+		case "createdAt":
+			if in.IsNull() {
+				in.Skip()
+				out.CreatedAt = nil
+			} else {
+				if out.CreatedAt == nil {
+					out.CreatedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CreatedAt).UnmarshalJSON(data))
+				}
+			}
+		case "id":
 			if data := in.UnsafeBytes(); in.Ok() {
-				in.AddError((out.AddressID).UnmarshalText(data))
+				in.AddError((out.ID).UnmarshalText(data))
 			}
-		case "Items":
+		case "sellerID":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.SellerID).UnmarshalText(data))
+			}
+		case "sellerName":
+			out.SellerName = string(in.String())
+		case "status":
+			out.Status = models.OrderStatus(in.Int())
+		case "totalPrice":
+			(out.TotalPrice).UnmarshalEasyJSON(in)
+		case "totalDiscountPrice":
+			(out.TotalDiscountPrice).UnmarshalEasyJSON(in)
+		case "trackingNumber":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.TrackingNumber).UnmarshalJSON(data))
+			}
+		case "expectedDeliveryAt":
 			if in.IsNull() {
 				in.Skip()
-				out.Items = nil
+				out.ExpectedDeliveryAt = nil
+			} else {
+				if out.ExpectedDeliveryAt == nil {
+					out.ExpectedDeliveryAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ExpectedDeliveryAt).UnmarshalJSON(data))
+				}
+			}
+		case "products":
+			if in.IsNull() {
+				in.Skip()
+				out.Products = nil
 			} else {
 				in.Delim('[')
-				if out.Items == nil {
+				if out.Products == nil {
 					if !in.IsDelim(']') {
-						out.Items = make([]CreateOrderItemDTO, 0, 1)
+						out.Products = make([]ShipmentItemDTO, 0, 0)
 					} else {
-						out.Items = []CreateOrderItemDTO{}
+						out.Products = []ShipmentItemDTO{}
 					}
 				} else {
-					out.Items = (out.Items)[:0]
+					out.Products = (out.Products)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v4 CreateOrderItemDTO
-					(v4).UnmarshalEasyJSON(in)
-					out.Items = append(out.Items, v4)
+					var v6 ShipmentItemDTO
+					(v6).UnmarshalEasyJSON(in)
+					out.Products = append(out.Products, v6)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -508,52 +630,81 @@ func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		in.Consumed()
 	}
 }
-func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(out *jwriter.Writer, in Order) {
+func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(out *jwriter.Writer, in SellerOrderDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"ID\":"
+		const prefix string = ",\"orderID\":"
 		out.RawString(prefix[1:])
+		out.RawText((in.OrderID).MarshalText())
+	}
+	{
+		const prefix string = ",\"address\":"
+		out.RawString(prefix)
+		easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalModels(out, in.Address)
+	}
+	if in.CreatedAt != nil {
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((*in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
 		out.RawText((in.ID).MarshalText())
 	}
 	{
-		const prefix string = ",\"UserID\":"
+		const prefix string = ",\"sellerID\":"
 		out.RawString(prefix)
-		out.RawText((in.UserID).MarshalText())
+		out.RawText((in.SellerID).MarshalText())
 	}
 	{
-		const prefix string = ",\"Status\":"
+		const prefix string = ",\"sellerName\":"
+		out.RawString(prefix)
+		out.String(string(in.SellerName))
+	}
+	{
+		const prefix string = ",\"status\":"
 		out.RawString(prefix)
 		out.Raw((in.Status).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"TotalPrice\":"
+		const prefix string = ",\"totalPrice\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.TotalPrice))
+		(in.TotalPrice).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"TotalPriceDiscount\":"
+		const prefix string = ",\"totalDiscountPrice\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.TotalPriceDiscount))
+		(in.TotalDiscountPrice).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"AddressID\":"
+		const prefix string = ",\"trackingNumber\":"
 		out.RawString(prefix)
-		out.RawText((in.AddressID).MarshalText())
+		out.Raw((in.TrackingNumber).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"Items\":"
+		const prefix string = ",\"expectedDeliveryAt\":"
 		out.RawString(prefix)
-		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.ExpectedDeliveryAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ExpectedDeliveryAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"products\":"
+		out.RawString(prefix)
+		if in.Products == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v5, v6 := range in.Items {
-				if v5 > 0 {
+			for v7, v8 := range in.Products {
+				if v7 > 0 {
 					out.RawByte(',')
 				}
-				(v6).MarshalEasyJSON(out)
+				(v8).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -562,29 +713,29 @@ func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Order) MarshalJSON() ([]byte, error) {
+func (v SellerOrderDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&w, v)
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Order) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(w, v)
+func (v SellerOrderDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Order) UnmarshalJSON(data []byte) error {
+func (v *SellerOrderDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&r, v)
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Order) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(l, v)
+func (v *SellerOrderDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(l, v)
 }
-func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(in *jlexer.Lexer, out *GetOrderProductResDTO) {
+func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalModels(in *jlexer.Lexer, out *models.AddressDB) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -603,14 +754,30 @@ func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 			continue
 		}
 		switch key {
-		case "productID":
+		case "id":
 			if data := in.UnsafeBytes(); in.Ok() {
-				in.AddError((out.ProductID).UnmarshalText(data))
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "label":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Label).UnmarshalJSON(data))
+			}
+		case "region":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Region).UnmarshalJSON(data))
+			}
+		case "city":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.City).UnmarshalJSON(data))
+			}
+		case "AddressString":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.AddressString).UnmarshalJSON(data))
+			}
+		case "coordinate":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Coordinate).UnmarshalJSON(data))
 			}
-		case "quantity":
-			out.Quantity = uint(in.Uint())
-		case "product_name":
-			out.ProductName = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -621,52 +788,43 @@ func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		in.Consumed()
 	}
 }
-func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(out *jwriter.Writer, in GetOrderProductResDTO) {
+func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalModels(out *jwriter.Writer, in models.AddressDB) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"productID\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.RawText((in.ProductID).MarshalText())
+		out.RawText((in.ID).MarshalText())
 	}
 	{
-		const prefix string = ",\"quantity\":"
+		const prefix string = ",\"label\":"
 		out.RawString(prefix)
-		out.Uint(uint(in.Quantity))
+		out.Raw((in.Label).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"product_name\":"
+		const prefix string = ",\"region\":"
 		out.RawString(prefix)
-		out.String(string(in.ProductName))
+		out.Raw((in.Region).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		out.Raw((in.City).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"AddressString\":"
+		out.RawString(prefix)
+		out.Raw((in.AddressString).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"coordinate\":"
+		out.RawString(prefix)
+		out.Raw((in.Coordinate).MarshalJSON())
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v GetOrderProductResDTO) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v GetOrderProductResDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *GetOrderProductResDTO) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *GetOrderProductResDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(l, v)
-}
-func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(in *jlexer.Lexer, out *GetOrderByUserIDResDTO) {
+func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(in *jlexer.Lexer, out *OrderPreviewDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -692,13 +850,57 @@ func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		case "status":
 			out.Status = models.OrderStatus(in.Int())
 		case "totalPrice":
-			out.TotalPrice = float64(in.Float64())
-		case "totalPriceDiscount":
-			out.TotalPriceDiscount = float64(in.Float64())
-		case "addressID":
-			if data := in.UnsafeBytes(); in.Ok() {
-				in.AddError((out.AddressID).UnmarshalText(data))
+			(out.TotalPrice).UnmarshalEasyJSON(in)
+		case "totalDiscountPrice":
+			(out.TotalDiscountPrice).UnmarshalEasyJSON(in)
+		case "products":
+			if in.IsNull() {
+				in.Skip()
+				out.Products = nil
+			} else {
+				in.Delim('[')
+				if out.Products == nil {
+					if !in.IsDelim(']') {
+						out.Products = make([]models.OrderPreviewProductDTO, 0, 1)
+					} else {
+						out.Products = []models.OrderPreviewProductDTO{}
+					}
+				} else {
+					out.Products = (out.Products)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v9 models.OrderPreviewProductDTO
+					easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalModels1(in, &v9)
+					out.Products = append(out.Products, v9)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "shipments":
+			if in.IsNull() {
+				in.Skip()
+				out.Shipments = nil
+			} else {
+				in.Delim('[')
+				if out.Shipments == nil {
+					if !in.IsDelim(']') {
+						out.Shipments = make([]ShipmentPreviewDTO, 0, 0)
+					} else {
+						out.Shipments = []ShipmentPreviewDTO{}
+					}
+				} else {
+					out.Shipments = (out.Shipments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 ShipmentPreviewDTO
+					(v10).UnmarshalEasyJSON(in)
+					out.Shipments = append(out.Shipments, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
+		case "address":
+			easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalModels(in, &out.Address)
 		case "expectedDeliveryAt":
 			if in.IsNull() {
 				in.Skip()
@@ -735,6 +937,18 @@ func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 					in.AddError((*out.CreatedAt).UnmarshalJSON(data))
 				}
 			}
+		case "currency":
+			out.Currency = models.Currency(in.String())
+		case "exchangeRate":
+			if in.IsNull() {
+				in.Skip()
+				out.ExchangeRate = nil
+			} else {
+				if out.ExchangeRate == nil {
+					out.ExchangeRate = new(models.Rate)
+				}
+				(*out.ExchangeRate).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -745,7 +959,7 @@ func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		in.Consumed()
 	}
 }
-func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(out *jwriter.Writer, in GetOrderByUserIDResDTO) {
+func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(out *jwriter.Writer, in OrderPreviewDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -762,17 +976,49 @@ func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 	{
 		const prefix string = ",\"totalPrice\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.TotalPrice))
+		(in.TotalPrice).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"totalPriceDiscount\":"
+		const prefix string = ",\"totalDiscountPrice\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.TotalPriceDiscount))
+		(in.TotalDiscountPrice).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"addressID\":"
+		const prefix string = ",\"products\":"
 		out.RawString(prefix)
-		out.RawText((in.AddressID).MarshalText())
+		if in.Products == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.Products {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalModels1(out, v12)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"shipments\":"
+		out.RawString(prefix)
+		if in.Shipments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v13, v14 := range in.Shipments {
+				if v13 > 0 {
+					out.RawByte(',')
+				}
+				(v14).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"address\":"
+		out.RawString(prefix)
+		easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalModels(out, in.Address)
 	}
 	{
 		const prefix string = ",\"expectedDeliveryAt\":"
@@ -797,33 +1043,915 @@ func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		out.RawString(prefix)
 		out.Raw((*in.CreatedAt).MarshalJSON())
 	}
-	out.RawByte('}')
-}
-
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	if in.ExchangeRate != nil {
+		const prefix string = ",\"exchangeRate\":"
+		out.RawString(prefix)
+		(*in.ExchangeRate).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OrderPreviewDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OrderPreviewDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OrderPreviewDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OrderPreviewDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(l, v)
+}
+func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalModels1(in *jlexer.Lexer, out *models.OrderPreviewProductDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "product_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ProductID).UnmarshalText(data))
+			}
+		case "product_name":
+			out.ProductName = string(in.String())
+		case "ProductImageURL":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ProductImageURL).UnmarshalJSON(data))
+			}
+		case "ProductQuantity":
+			out.ProductQuantity = uint(in.Uint())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalModels1(out *jwriter.Writer, in models.OrderPreviewProductDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"product_id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ProductID).MarshalText())
+	}
+	{
+		const prefix string = ",\"product_name\":"
+		out.RawString(prefix)
+		out.String(string(in.ProductName))
+	}
+	{
+		const prefix string = ",\"ProductImageURL\":"
+		out.RawString(prefix)
+		out.Raw((in.ProductImageURL).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"ProductQuantity\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.ProductQuantity))
+	}
+	out.RawByte('}')
+}
+func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(in *jlexer.Lexer, out *OrderDetailDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "status":
+			out.Status = models.OrderStatus(in.Int())
+		case "address":
+			easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalModels(in, &out.Address)
+		case "pickupPointID":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PickupPointID).UnmarshalJSON(data))
+			}
+		case "shipments":
+			if in.IsNull() {
+				in.Skip()
+				out.Shipments = nil
+			} else {
+				in.Delim('[')
+				if out.Shipments == nil {
+					if !in.IsDelim(']') {
+						out.Shipments = make([]ShipmentPreviewDTO, 0, 0)
+					} else {
+						out.Shipments = []ShipmentPreviewDTO{}
+					}
+				} else {
+					out.Shipments = (out.Shipments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v15 ShipmentPreviewDTO
+					(v15).UnmarshalEasyJSON(in)
+					out.Shipments = append(out.Shipments, v15)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "subtotal":
+			(out.Subtotal).UnmarshalEasyJSON(in)
+		case "productDiscount":
+			(out.ProductDiscount).UnmarshalEasyJSON(in)
+		case "promoCode":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PromoCode).UnmarshalJSON(data))
+			}
+		case "promoDiscount":
+			(out.PromoDiscount).UnmarshalEasyJSON(in)
+		case "deliveryCost":
+			(out.DeliveryCost).UnmarshalEasyJSON(in)
+		case "total":
+			(out.Total).UnmarshalEasyJSON(in)
+		case "chargeTotal":
+			(out.ChargeTotal).UnmarshalEasyJSON(in)
+		case "currency":
+			out.Currency = models.Currency(in.String())
+		case "exchangeRate":
+			if in.IsNull() {
+				in.Skip()
+				out.ExchangeRate = nil
+			} else {
+				if out.ExchangeRate == nil {
+					out.ExchangeRate = new(models.Rate)
+				}
+				(*out.ExchangeRate).UnmarshalEasyJSON(in)
+			}
+		case "expectedDeliveryAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ExpectedDeliveryAt = nil
+			} else {
+				if out.ExpectedDeliveryAt == nil {
+					out.ExpectedDeliveryAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ExpectedDeliveryAt).UnmarshalJSON(data))
+				}
+			}
+		case "actualDeliveryAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ActualDeliveryAt = nil
+			} else {
+				if out.ActualDeliveryAt == nil {
+					out.ActualDeliveryAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ActualDeliveryAt).UnmarshalJSON(data))
+				}
+			}
+		case "createdAt":
+			if in.IsNull() {
+				in.Skip()
+				out.CreatedAt = nil
+			} else {
+				if out.CreatedAt == nil {
+					out.CreatedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CreatedAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(out *jwriter.Writer, in OrderDetailDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.Raw((in.Status).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"address\":"
+		out.RawString(prefix)
+		easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalModels(out, in.Address)
+	}
+	{
+		const prefix string = ",\"pickupPointID\":"
+		out.RawString(prefix)
+		out.Raw((in.PickupPointID).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"shipments\":"
+		out.RawString(prefix)
+		if in.Shipments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v16, v17 := range in.Shipments {
+				if v16 > 0 {
+					out.RawByte(',')
+				}
+				(v17).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"subtotal\":"
+		out.RawString(prefix)
+		(in.Subtotal).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"productDiscount\":"
+		out.RawString(prefix)
+		(in.ProductDiscount).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"promoCode\":"
+		out.RawString(prefix)
+		out.Raw((in.PromoCode).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"promoDiscount\":"
+		out.RawString(prefix)
+		(in.PromoDiscount).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"deliveryCost\":"
+		out.RawString(prefix)
+		(in.DeliveryCost).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		(in.Total).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"chargeTotal\":"
+		out.RawString(prefix)
+		(in.ChargeTotal).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	if in.ExchangeRate != nil {
+		const prefix string = ",\"exchangeRate\":"
+		out.RawString(prefix)
+		(*in.ExchangeRate).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"expectedDeliveryAt\":"
+		out.RawString(prefix)
+		if in.ExpectedDeliveryAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ExpectedDeliveryAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"actualDeliveryAt\":"
+		out.RawString(prefix)
+		if in.ActualDeliveryAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ActualDeliveryAt).MarshalJSON())
+		}
+	}
+	if in.CreatedAt != nil {
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((*in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OrderDetailDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OrderDetailDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OrderDetailDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OrderDetailDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(l, v)
+}
+func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(in *jlexer.Lexer, out *Order) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ID":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "UserID":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.UserID).UnmarshalText(data))
+			}
+		case "Status":
+			out.Status = models.OrderStatus(in.Int())
+		case "TotalPrice":
+			(out.TotalPrice).UnmarshalEasyJSON(in)
+		case "TotalPriceDiscount":
+			(out.TotalPriceDiscount).UnmarshalEasyJSON(in)
+		case "DeliveryCost":
+			(out.DeliveryCost).UnmarshalEasyJSON(in)
+		case "AddressID":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AddressID).UnmarshalText(data))
+			}
+		case "ExpectedDeliveryAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ExpectedDeliveryAt = nil
+			} else {
+				if out.ExpectedDeliveryAt == nil {
+					out.ExpectedDeliveryAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ExpectedDeliveryAt).UnmarshalJSON(data))
+				}
+			}
+		case "DeliverySlotID":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.DeliverySlotID).UnmarshalJSON(data))
+			}
+		case "PickupPointID":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PickupPointID).UnmarshalJSON(data))
+			}
+		case "Items":
+			if in.IsNull() {
+				in.Skip()
+				out.Items = nil
+			} else {
+				in.Delim('[')
+				if out.Items == nil {
+					if !in.IsDelim(']') {
+						out.Items = make([]CreateOrderItemDTO, 0, 0)
+					} else {
+						out.Items = []CreateOrderItemDTO{}
+					}
+				} else {
+					out.Items = (out.Items)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v18 CreateOrderItemDTO
+					(v18).UnmarshalEasyJSON(in)
+					out.Items = append(out.Items, v18)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "Shipments":
+			if in.IsNull() {
+				in.Skip()
+				out.Shipments = nil
+			} else {
+				in.Delim('[')
+				if out.Shipments == nil {
+					if !in.IsDelim(']') {
+						out.Shipments = make([]Shipment, 0, 0)
+					} else {
+						out.Shipments = []Shipment{}
+					}
+				} else {
+					out.Shipments = (out.Shipments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 Shipment
+					(v19).UnmarshalEasyJSON(in)
+					out.Shipments = append(out.Shipments, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "DisplayRate":
+			easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalModels2(in, &out.DisplayRate)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(out *jwriter.Writer, in Order) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ID\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"UserID\":"
+		out.RawString(prefix)
+		out.RawText((in.UserID).MarshalText())
+	}
+	{
+		const prefix string = ",\"Status\":"
+		out.RawString(prefix)
+		out.Raw((in.Status).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"TotalPrice\":"
+		out.RawString(prefix)
+		(in.TotalPrice).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"TotalPriceDiscount\":"
+		out.RawString(prefix)
+		(in.TotalPriceDiscount).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"DeliveryCost\":"
+		out.RawString(prefix)
+		(in.DeliveryCost).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"AddressID\":"
+		out.RawString(prefix)
+		out.RawText((in.AddressID).MarshalText())
+	}
+	{
+		const prefix string = ",\"ExpectedDeliveryAt\":"
+		out.RawString(prefix)
+		if in.ExpectedDeliveryAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ExpectedDeliveryAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"DeliverySlotID\":"
+		out.RawString(prefix)
+		out.Raw((in.DeliverySlotID).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"PickupPointID\":"
+		out.RawString(prefix)
+		out.Raw((in.PickupPointID).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"Items\":"
+		out.RawString(prefix)
+		if in.Items == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v20, v21 := range in.Items {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				(v21).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"Shipments\":"
+		out.RawString(prefix)
+		if in.Shipments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v22, v23 := range in.Shipments {
+				if v22 > 0 {
+					out.RawByte(',')
+				}
+				(v23).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"DisplayRate\":"
+		out.RawString(prefix)
+		easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalModels2(out, in.DisplayRate)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Order) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Order) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Order) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Order) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(l, v)
+}
+func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalModels2(in *jlexer.Lexer, out *models.ExchangeRate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "currency":
+			out.Currency = models.Currency(in.String())
+		case "rate":
+			(out.Rate).UnmarshalEasyJSON(in)
+		case "updated_at":
+			if in.IsNull() {
+				in.Skip()
+				out.UpdatedAt = nil
+			} else {
+				if out.UpdatedAt == nil {
+					out.UpdatedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.UpdatedAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalModels2(out *jwriter.Writer, in models.ExchangeRate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"rate\":"
+		out.RawString(prefix)
+		(in.Rate).MarshalEasyJSON(out)
+	}
+	if in.UpdatedAt != nil {
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto8(in *jlexer.Lexer, out *GetOrderProductResDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "productID":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ProductID).UnmarshalText(data))
+			}
+		case "quantity":
+			out.Quantity = uint(in.Uint())
+		case "product_name":
+			out.ProductName = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto8(out *jwriter.Writer, in GetOrderProductResDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"productID\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ProductID).MarshalText())
+	}
+	{
+		const prefix string = ",\"quantity\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Quantity))
+	}
+	{
+		const prefix string = ",\"product_name\":"
+		out.RawString(prefix)
+		out.String(string(in.ProductName))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GetOrderProductResDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GetOrderProductResDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GetOrderProductResDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GetOrderProductResDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto8(l, v)
+}
+func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto9(in *jlexer.Lexer, out *GetOrderByUserIDResDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "status":
+			out.Status = models.OrderStatus(in.Int())
+		case "totalPrice":
+			(out.TotalPrice).UnmarshalEasyJSON(in)
+		case "totalPriceDiscount":
+			(out.TotalPriceDiscount).UnmarshalEasyJSON(in)
+		case "addressID":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AddressID).UnmarshalText(data))
+			}
+		case "expectedDeliveryAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ExpectedDeliveryAt = nil
+			} else {
+				if out.ExpectedDeliveryAt == nil {
+					out.ExpectedDeliveryAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ExpectedDeliveryAt).UnmarshalJSON(data))
+				}
+			}
+		case "actualDeliveryAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ActualDeliveryAt = nil
+			} else {
+				if out.ActualDeliveryAt == nil {
+					out.ActualDeliveryAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ActualDeliveryAt).UnmarshalJSON(data))
+				}
+			}
+		case "createdAt":
+			if in.IsNull() {
+				in.Skip()
+				out.CreatedAt = nil
+			} else {
+				if out.CreatedAt == nil {
+					out.CreatedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.CreatedAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto9(out *jwriter.Writer, in GetOrderByUserIDResDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.Raw((in.Status).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"totalPrice\":"
+		out.RawString(prefix)
+		(in.TotalPrice).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"totalPriceDiscount\":"
+		out.RawString(prefix)
+		(in.TotalPriceDiscount).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"addressID\":"
+		out.RawString(prefix)
+		out.RawText((in.AddressID).MarshalText())
+	}
+	{
+		const prefix string = ",\"expectedDeliveryAt\":"
+		out.RawString(prefix)
+		if in.ExpectedDeliveryAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ExpectedDeliveryAt).MarshalJSON())
+		}
+	}
+	{
+		const prefix string = ",\"actualDeliveryAt\":"
+		out.RawString(prefix)
+		if in.ActualDeliveryAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ActualDeliveryAt).MarshalJSON())
+		}
+	}
+	if in.CreatedAt != nil {
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((*in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
 // MarshalJSON supports json.Marshaler interface
 func (v GetOrderByUserIDResDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&w, v)
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v GetOrderByUserIDResDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(w, v)
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *GetOrderByUserIDResDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&r, v)
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *GetOrderByUserIDResDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(l, v)
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto9(l, v)
 }
-func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(in *jlexer.Lexer, out *CreateOrderRepoReq) {
+func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto10(in *jlexer.Lexer, out *CreateOrderRepoReq) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -852,24 +1980,114 @@ func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 				}
 				(*out.Order).UnmarshalEasyJSON(in)
 			}
-		case "UpdatedQuantities":
+		case "PromoRedemption":
 			if in.IsNull() {
 				in.Skip()
+				out.PromoRedemption = nil
 			} else {
-				in.Delim('{')
-				out.UpdatedQuantities = make(map[uuid.UUID]uint)
-				for !in.IsDelim('}') {
-					var key uuid.UUID
-					if data := in.UnsafeBytes(); in.Ok() {
-						in.AddError(key.UnmarshalText(data))
-					}
-					in.WantColon()
-					var v7 uint
-					v7 = uint(in.Uint())
-					(out.UpdatedQuantities)[key] = v7
-					in.WantComma()
+				if out.PromoRedemption == nil {
+					out.PromoRedemption = new(models.PromoRedemption)
 				}
-				in.Delim('}')
+				easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalModels3(in, out.PromoRedemption)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto10(out *jwriter.Writer, in CreateOrderRepoReq) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"Order\":"
+		out.RawString(prefix[1:])
+		if in.Order == nil {
+			out.RawString("null")
+		} else {
+			(*in.Order).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"PromoRedemption\":"
+		out.RawString(prefix)
+		if in.PromoRedemption == nil {
+			out.RawString("null")
+		} else {
+			easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalModels3(out, *in.PromoRedemption)
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreateOrderRepoReq) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto10(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreateOrderRepoReq) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto10(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreateOrderRepoReq) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto10(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreateOrderRepoReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto10(l, v)
+}
+func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalModels3(in *jlexer.Lexer, out *models.PromoRedemption) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ID":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "PromoID":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.PromoID).UnmarshalText(data))
+			}
+		case "UserID":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.UserID).UnmarshalText(data))
+			}
+		case "Discount":
+			(out.Discount).UnmarshalEasyJSON(in)
+		case "UsageLimit":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UsageLimit).UnmarshalJSON(data))
+			}
+		case "PerUserLimit":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PerUserLimit).UnmarshalJSON(data))
 			}
 		default:
 			in.SkipRecursive()
@@ -881,67 +2099,43 @@ func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		in.Consumed()
 	}
 }
-func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(out *jwriter.Writer, in CreateOrderRepoReq) {
+func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalModels3(out *jwriter.Writer, in models.PromoRedemption) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Order\":"
+		const prefix string = ",\"ID\":"
 		out.RawString(prefix[1:])
-		if in.Order == nil {
-			out.RawString("null")
-		} else {
-			(*in.Order).MarshalEasyJSON(out)
-		}
+		out.RawText((in.ID).MarshalText())
 	}
 	{
-		const prefix string = ",\"UpdatedQuantities\":"
+		const prefix string = ",\"PromoID\":"
 		out.RawString(prefix)
-		if in.UpdatedQuantities == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
-			out.RawString(`null`)
-		} else {
-			out.RawByte('{')
-			v8First := true
-			for v8Name, v8Value := range in.UpdatedQuantities {
-				if v8First {
-					v8First = false
-				} else {
-					out.RawByte(',')
-				}
-				out.RawBytesString((v8Name).MarshalText())
-				out.RawByte(':')
-				out.Uint(uint(v8Value))
-			}
-			out.RawByte('}')
-		}
+		out.RawText((in.PromoID).MarshalText())
+	}
+	{
+		const prefix string = ",\"UserID\":"
+		out.RawString(prefix)
+		out.RawText((in.UserID).MarshalText())
+	}
+	{
+		const prefix string = ",\"Discount\":"
+		out.RawString(prefix)
+		(in.Discount).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"UsageLimit\":"
+		out.RawString(prefix)
+		out.Raw((in.UsageLimit).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"PerUserLimit\":"
+		out.RawString(prefix)
+		out.Raw((in.PerUserLimit).MarshalJSON())
 	}
 	out.RawByte('}')
 }
-
-// MarshalJSON supports json.Marshaler interface
-func (v CreateOrderRepoReq) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CreateOrderRepoReq) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *CreateOrderRepoReq) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CreateOrderRepoReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(l, v)
-}
-func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(in *jlexer.Lexer, out *CreateOrderItemDTO) {
+func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto11(in *jlexer.Lexer, out *CreateOrderItemDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -968,8 +2162,20 @@ func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 			if data := in.UnsafeBytes(); in.Ok() {
 				in.AddError((out.ProductID).UnmarshalText(data))
 			}
+		case "variantID":
+			if in.IsNull() {
+				in.Skip()
+				out.VariantID = nil
+			} else {
+				if out.VariantID == nil {
+					out.VariantID = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.VariantID).UnmarshalText(data))
+				}
+			}
 		case "productPrice":
-			out.Price = float64(in.Float64())
+			(out.Price).UnmarshalEasyJSON(in)
 		case "quantity":
 			out.Quantity = uint(in.Uint())
 		default:
@@ -982,7 +2188,7 @@ func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		in.Consumed()
 	}
 }
-func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(out *jwriter.Writer, in CreateOrderItemDTO) {
+func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto11(out *jwriter.Writer, in CreateOrderItemDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -996,10 +2202,15 @@ func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		out.RawString(prefix)
 		out.RawText((in.ProductID).MarshalText())
 	}
+	if in.VariantID != nil {
+		const prefix string = ",\"variantID\":"
+		out.RawString(prefix)
+		out.RawText((*in.VariantID).MarshalText())
+	}
 	{
 		const prefix string = ",\"productPrice\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.Price))
+		(in.Price).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"quantity\":"
@@ -1012,27 +2223,27 @@ func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 // MarshalJSON supports json.Marshaler interface
 func (v CreateOrderItemDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(&w, v)
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CreateOrderItemDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(w, v)
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CreateOrderItemDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(&r, v)
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CreateOrderItemDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(l, v)
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto11(l, v)
 }
-func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(in *jlexer.Lexer, out *CreateOrderDTO) {
+func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto12(in *jlexer.Lexer, out *CreateOrderDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1063,7 +2274,7 @@ func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 				in.Delim('[')
 				if out.Items == nil {
 					if !in.IsDelim(']') {
-						out.Items = make([]CreateOrderItemDTO, 0, 1)
+						out.Items = make([]CreateOrderItemDTO, 0, 0)
 					} else {
 						out.Items = []CreateOrderItemDTO{}
 					}
@@ -1071,9 +2282,9 @@ func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 					out.Items = (out.Items)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v9 CreateOrderItemDTO
-					(v9).UnmarshalEasyJSON(in)
-					out.Items = append(out.Items, v9)
+					var v24 CreateOrderItemDTO
+					(v24).UnmarshalEasyJSON(in)
+					out.Items = append(out.Items, v24)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1082,6 +2293,18 @@ func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 			if data := in.UnsafeBytes(); in.Ok() {
 				in.AddError((out.AddressID).UnmarshalText(data))
 			}
+		case "pickupPointID":
+			if in.IsNull() {
+				in.Skip()
+				out.PickupPointID = nil
+			} else {
+				if out.PickupPointID == nil {
+					out.PickupPointID = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.PickupPointID).UnmarshalText(data))
+				}
+			}
 		case "promoCode":
 			if in.IsNull() {
 				in.Skip()
@@ -1092,6 +2315,18 @@ func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 				}
 				*out.PromoCode = string(in.String())
 			}
+		case "deliverySlotID":
+			if in.IsNull() {
+				in.Skip()
+				out.DeliverySlotID = nil
+			} else {
+				if out.DeliverySlotID == nil {
+					out.DeliverySlotID = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.DeliverySlotID).UnmarshalText(data))
+				}
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1102,7 +2337,7 @@ func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		in.Consumed()
 	}
 }
-func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(out *jwriter.Writer, in CreateOrderDTO) {
+func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto12(out *jwriter.Writer, in CreateOrderDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1118,11 +2353,11 @@ func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v10, v11 := range in.Items {
-				if v10 > 0 {
+			for v25, v26 := range in.Items {
+				if v25 > 0 {
 					out.RawByte(',')
 				}
-				(v11).MarshalEasyJSON(out)
+				(v26).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -1132,34 +2367,258 @@ func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		out.RawString(prefix)
 		out.RawText((in.AddressID).MarshalText())
 	}
+	if in.PickupPointID != nil {
+		const prefix string = ",\"pickupPointID\":"
+		out.RawString(prefix)
+		out.RawText((*in.PickupPointID).MarshalText())
+	}
 	if in.PromoCode != nil {
 		const prefix string = ",\"promoCode\":"
 		out.RawString(prefix)
 		out.String(string(*in.PromoCode))
 	}
+	if in.DeliverySlotID != nil {
+		const prefix string = ",\"deliverySlotID\":"
+		out.RawString(prefix)
+		out.RawText((*in.DeliverySlotID).MarshalText())
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v CreateOrderDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(&w, v)
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto12(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CreateOrderDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(w, v)
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto12(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CreateOrderDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(&r, v)
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto12(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CreateOrderDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(l, v)
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto12(l, v)
+}
+func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto13(in *jlexer.Lexer, out *ConfirmShipmentRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "trackingNumber":
+			if in.IsNull() {
+				in.Skip()
+				out.TrackingNumber = nil
+			} else {
+				if out.TrackingNumber == nil {
+					out.TrackingNumber = new(string)
+				}
+				*out.TrackingNumber = string(in.String())
+			}
+		case "expectedDeliveryAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ExpectedDeliveryAt = nil
+			} else {
+				if out.ExpectedDeliveryAt == nil {
+					out.ExpectedDeliveryAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ExpectedDeliveryAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto13(out *jwriter.Writer, in ConfirmShipmentRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.TrackingNumber != nil {
+		const prefix string = ",\"trackingNumber\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(*in.TrackingNumber))
+	}
+	if in.ExpectedDeliveryAt != nil {
+		const prefix string = ",\"expectedDeliveryAt\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Raw((*in.ExpectedDeliveryAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ConfirmShipmentRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ConfirmShipmentRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ConfirmShipmentRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ConfirmShipmentRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto13(l, v)
+}
+func easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto14(in *jlexer.Lexer, out *ConfirmShipmentRepoReq) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "ShipmentID":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ShipmentID).UnmarshalText(data))
+			}
+		case "SellerID":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.SellerID).UnmarshalText(data))
+			}
+		case "TrackingNumber":
+			if in.IsNull() {
+				in.Skip()
+				out.TrackingNumber = nil
+			} else {
+				if out.TrackingNumber == nil {
+					out.TrackingNumber = new(string)
+				}
+				*out.TrackingNumber = string(in.String())
+			}
+		case "ExpectedDeliveryAt":
+			if in.IsNull() {
+				in.Skip()
+				out.ExpectedDeliveryAt = nil
+			} else {
+				if out.ExpectedDeliveryAt == nil {
+					out.ExpectedDeliveryAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.ExpectedDeliveryAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto14(out *jwriter.Writer, in ConfirmShipmentRepoReq) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"ShipmentID\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ShipmentID).MarshalText())
+	}
+	{
+		const prefix string = ",\"SellerID\":"
+		out.RawString(prefix)
+		out.RawText((in.SellerID).MarshalText())
+	}
+	{
+		const prefix string = ",\"TrackingNumber\":"
+		out.RawString(prefix)
+		if in.TrackingNumber == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.TrackingNumber))
+		}
+	}
+	{
+		const prefix string = ",\"ExpectedDeliveryAt\":"
+		out.RawString(prefix)
+		if in.ExpectedDeliveryAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.ExpectedDeliveryAt).MarshalJSON())
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ConfirmShipmentRepoReq) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto14(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ConfirmShipmentRepoReq) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson120d1ca2EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto14(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ConfirmShipmentRepoReq) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto14(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ConfirmShipmentRepoReq) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson120d1ca2DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto14(l, v)
 }
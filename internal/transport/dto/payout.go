@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+)
+
+// SetCategoryCommissionRequest задаёт комиссию категории в базисных пунктах
+// (1000 = 10%); null возвращает категорию к комиссии родителя
+type SetCategoryCommissionRequest struct {
+	CommissionBps *int `json:"commission_bps"`
+}
+
+// SellerBalanceDTO — суммы к выплате продавцу. Held ещё может уменьшиться
+// из-за возвратов, Available войдёт в ближайшую выписку, Pending ждёт выплаты.
+type SellerBalanceDTO struct {
+	Held      models.Money `json:"held"`
+	Available models.Money `json:"available"`
+	Pending   models.Money `json:"pending"`
+	PaidOut   models.Money `json:"paid_out"`
+}
+
+// PayoutStatementDetailDTO — выписка с начислениями, из которых она собрана
+type PayoutStatementDetailDTO struct {
+	Statement models.PayoutStatement `json:"statement"`
+	Earnings  []models.SellerEarning `json:"earnings"`
+}
+
+func ConvertToSellerBalanceDTO(balance models.SellerBalance) SellerBalanceDTO {
+	return SellerBalanceDTO{
+		Held:      balance.Held,
+		Available: balance.Available,
+		Pending:   balance.Pending,
+		PaidOut:   balance.PaidOut,
+	}
+}
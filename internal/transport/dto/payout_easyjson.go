@@ -0,0 +1,536 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package dto
+
+import (
+	json "encoding/json"
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson77b271faDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(in *jlexer.Lexer, out *SetCategoryCommissionRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "commission_bps":
+			if in.IsNull() {
+				in.Skip()
+				out.CommissionBps = nil
+			} else {
+				if out.CommissionBps == nil {
+					out.CommissionBps = new(int)
+				}
+				*out.CommissionBps = int(in.Int())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson77b271faEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(out *jwriter.Writer, in SetCategoryCommissionRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"commission_bps\":"
+		out.RawString(prefix[1:])
+		if in.CommissionBps == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.CommissionBps))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SetCategoryCommissionRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson77b271faEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SetCategoryCommissionRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson77b271faEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SetCategoryCommissionRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson77b271faDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SetCategoryCommissionRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson77b271faDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(l, v)
+}
+func easyjson77b271faDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(in *jlexer.Lexer, out *SellerBalanceDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "held":
+			(out.Held).UnmarshalEasyJSON(in)
+		case "available":
+			(out.Available).UnmarshalEasyJSON(in)
+		case "pending":
+			(out.Pending).UnmarshalEasyJSON(in)
+		case "paid_out":
+			(out.PaidOut).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson77b271faEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(out *jwriter.Writer, in SellerBalanceDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"held\":"
+		out.RawString(prefix[1:])
+		(in.Held).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"available\":"
+		out.RawString(prefix)
+		(in.Available).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"pending\":"
+		out.RawString(prefix)
+		(in.Pending).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"paid_out\":"
+		out.RawString(prefix)
+		(in.PaidOut).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SellerBalanceDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson77b271faEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SellerBalanceDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson77b271faEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SellerBalanceDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson77b271faDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SellerBalanceDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson77b271faDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(l, v)
+}
+func easyjson77b271faDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(in *jlexer.Lexer, out *PayoutStatementDetailDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "statement":
+			easyjson77b271faDecodeGithubComGoParkMailRu20251ChillGuysInternalModels(in, &out.Statement)
+		case "earnings":
+			if in.IsNull() {
+				in.Skip()
+				out.Earnings = nil
+			} else {
+				in.Delim('[')
+				if out.Earnings == nil {
+					if !in.IsDelim(']') {
+						out.Earnings = make([]models.SellerEarning, 0, 0)
+					} else {
+						out.Earnings = []models.SellerEarning{}
+					}
+				} else {
+					out.Earnings = (out.Earnings)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 models.SellerEarning
+					easyjson77b271faDecodeGithubComGoParkMailRu20251ChillGuysInternalModels1(in, &v1)
+					out.Earnings = append(out.Earnings, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson77b271faEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(out *jwriter.Writer, in PayoutStatementDetailDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"statement\":"
+		out.RawString(prefix[1:])
+		easyjson77b271faEncodeGithubComGoParkMailRu20251ChillGuysInternalModels(out, in.Statement)
+	}
+	{
+		const prefix string = ",\"earnings\":"
+		out.RawString(prefix)
+		if in.Earnings == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Earnings {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				easyjson77b271faEncodeGithubComGoParkMailRu20251ChillGuysInternalModels1(out, v3)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PayoutStatementDetailDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson77b271faEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PayoutStatementDetailDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson77b271faEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PayoutStatementDetailDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson77b271faDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PayoutStatementDetailDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson77b271faDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(l, v)
+}
+func easyjson77b271faDecodeGithubComGoParkMailRu20251ChillGuysInternalModels1(in *jlexer.Lexer, out *models.SellerEarning) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "order_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.OrderID).UnmarshalText(data))
+			}
+		case "product_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ProductID).UnmarshalText(data))
+			}
+		case "product_name":
+			out.ProductName = string(in.String())
+		case "quantity":
+			out.Quantity = int(in.Int())
+		case "gross":
+			(out.Gross).UnmarshalEasyJSON(in)
+		case "commission_bps":
+			out.CommissionBps = int(in.Int())
+		case "commission":
+			(out.Commission).UnmarshalEasyJSON(in)
+		case "net":
+			(out.Net).UnmarshalEasyJSON(in)
+		case "status":
+			out.Status = models.EarningStatus(in.String())
+		case "available_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.AvailableAt).UnmarshalJSON(data))
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson77b271faEncodeGithubComGoParkMailRu20251ChillGuysInternalModels1(out *jwriter.Writer, in models.SellerEarning) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"order_id\":"
+		out.RawString(prefix)
+		out.RawText((in.OrderID).MarshalText())
+	}
+	{
+		const prefix string = ",\"product_id\":"
+		out.RawString(prefix)
+		out.RawText((in.ProductID).MarshalText())
+	}
+	{
+		const prefix string = ",\"product_name\":"
+		out.RawString(prefix)
+		out.String(string(in.ProductName))
+	}
+	{
+		const prefix string = ",\"quantity\":"
+		out.RawString(prefix)
+		out.Int(int(in.Quantity))
+	}
+	{
+		const prefix string = ",\"gross\":"
+		out.RawString(prefix)
+		(in.Gross).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"commission_bps\":"
+		out.RawString(prefix)
+		out.Int(int(in.CommissionBps))
+	}
+	{
+		const prefix string = ",\"commission\":"
+		out.RawString(prefix)
+		(in.Commission).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"net\":"
+		out.RawString(prefix)
+		(in.Net).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"available_at\":"
+		out.RawString(prefix)
+		out.Raw((in.AvailableAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+func easyjson77b271faDecodeGithubComGoParkMailRu20251ChillGuysInternalModels(in *jlexer.Lexer, out *models.PayoutStatement) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "seller_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.SellerID).UnmarshalText(data))
+			}
+		case "period_start":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PeriodStart).UnmarshalJSON(data))
+			}
+		case "period_end":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PeriodEnd).UnmarshalJSON(data))
+			}
+		case "gross":
+			(out.Gross).UnmarshalEasyJSON(in)
+		case "commission":
+			(out.Commission).UnmarshalEasyJSON(in)
+		case "net":
+			(out.Net).UnmarshalEasyJSON(in)
+		case "status":
+			out.Status = models.PayoutStatus(in.String())
+		case "paid_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PaidAt).UnmarshalJSON(data))
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson77b271faEncodeGithubComGoParkMailRu20251ChillGuysInternalModels(out *jwriter.Writer, in models.PayoutStatement) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"seller_id\":"
+		out.RawString(prefix)
+		out.RawText((in.SellerID).MarshalText())
+	}
+	{
+		const prefix string = ",\"period_start\":"
+		out.RawString(prefix)
+		out.Raw((in.PeriodStart).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"period_end\":"
+		out.RawString(prefix)
+		out.Raw((in.PeriodEnd).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"gross\":"
+		out.RawString(prefix)
+		(in.Gross).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"commission\":"
+		out.RawString(prefix)
+		(in.Commission).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"net\":"
+		out.RawString(prefix)
+		(in.Net).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"paid_at\":"
+		out.RawString(prefix)
+		out.Raw((in.PaidAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
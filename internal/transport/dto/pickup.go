@@ -0,0 +1,58 @@
+package dto
+
+import (
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+type PickupPointDTO struct {
+	ID            uuid.UUID   `json:"id"`
+	Name          string      `json:"name"`
+	OpensAt       string      `json:"opensAt"`
+	ClosesAt      string      `json:"closesAt"`
+	AddressID     uuid.UUID   `json:"addressID"`
+	Region        null.String `json:"region" swaggertype:"primitive,string"`
+	City          null.String `json:"city" swaggertype:"primitive,string"`
+	AddressString null.String `json:"addressString" swaggertype:"primitive,string"`
+	Coordinate    null.String `json:"coordinate" swaggertype:"primitive,string"`
+	// DistanceKm — расстояние до точки поиска, заполняется только при поиске ближайших ПВЗ
+	DistanceKm *float64 `json:"distanceKm,omitempty"`
+}
+
+type PickupPointsResponse struct {
+	PickupPoints []PickupPointDTO `json:"pickupPoints"`
+}
+
+// CreatePickupPointRequest — новый ПВЗ; часы работы в формате "15:04"
+type CreatePickupPointRequest struct {
+	Name          string      `json:"name"`
+	Region        null.String `json:"region" swaggertype:"primitive,string"`
+	City          null.String `json:"city" swaggertype:"primitive,string"`
+	AddressString string      `json:"addressString"`
+	Coordinate    string      `json:"coordinate"`
+	OpensAt       string      `json:"opensAt"`
+	ClosesAt      string      `json:"closesAt"`
+}
+
+// UpdatePickupPointRequest меняет название и часы работы ПВЗ. Адрес не меняется:
+// переезд оформляется как новый ПВЗ, чтобы не переписывать адреса прошлых заказов.
+type UpdatePickupPointRequest struct {
+	Name     string `json:"name"`
+	OpensAt  string `json:"opensAt"`
+	ClosesAt string `json:"closesAt"`
+}
+
+func ConvertToPickupPointDTO(point models.PickupPoint) PickupPointDTO {
+	return PickupPointDTO{
+		ID:            point.ID,
+		Name:          point.Name,
+		OpensAt:       point.OpensAt,
+		ClosesAt:      point.ClosesAt,
+		AddressID:     point.Address.ID,
+		Region:        point.Address.Region,
+		City:          point.Address.City,
+		AddressString: point.Address.AddressString,
+		Coordinate:    point.Address.Coordinate,
+	}
+}
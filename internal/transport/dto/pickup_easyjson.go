@@ -0,0 +1,458 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package dto
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonDb6e7538DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(in *jlexer.Lexer, out *UpdatePickupPointRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "opensAt":
+			out.OpensAt = string(in.String())
+		case "closesAt":
+			out.ClosesAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDb6e7538EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(out *jwriter.Writer, in UpdatePickupPointRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"opensAt\":"
+		out.RawString(prefix)
+		out.String(string(in.OpensAt))
+	}
+	{
+		const prefix string = ",\"closesAt\":"
+		out.RawString(prefix)
+		out.String(string(in.ClosesAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UpdatePickupPointRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDb6e7538EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UpdatePickupPointRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDb6e7538EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UpdatePickupPointRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDb6e7538DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UpdatePickupPointRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDb6e7538DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(l, v)
+}
+func easyjsonDb6e7538DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(in *jlexer.Lexer, out *PickupPointsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "pickupPoints":
+			if in.IsNull() {
+				in.Skip()
+				out.PickupPoints = nil
+			} else {
+				in.Delim('[')
+				if out.PickupPoints == nil {
+					if !in.IsDelim(']') {
+						out.PickupPoints = make([]PickupPointDTO, 0, 0)
+					} else {
+						out.PickupPoints = []PickupPointDTO{}
+					}
+				} else {
+					out.PickupPoints = (out.PickupPoints)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 PickupPointDTO
+					(v1).UnmarshalEasyJSON(in)
+					out.PickupPoints = append(out.PickupPoints, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDb6e7538EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(out *jwriter.Writer, in PickupPointsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"pickupPoints\":"
+		out.RawString(prefix[1:])
+		if in.PickupPoints == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.PickupPoints {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PickupPointsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDb6e7538EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PickupPointsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDb6e7538EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PickupPointsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDb6e7538DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PickupPointsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDb6e7538DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(l, v)
+}
+func easyjsonDb6e7538DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(in *jlexer.Lexer, out *PickupPointDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "opensAt":
+			out.OpensAt = string(in.String())
+		case "closesAt":
+			out.ClosesAt = string(in.String())
+		case "addressID":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AddressID).UnmarshalText(data))
+			}
+		case "region":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Region).UnmarshalJSON(data))
+			}
+		case "city":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.City).UnmarshalJSON(data))
+			}
+		case "addressString":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.AddressString).UnmarshalJSON(data))
+			}
+		case "coordinate":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Coordinate).UnmarshalJSON(data))
+			}
+		case "distanceKm":
+			if in.IsNull() {
+				in.Skip()
+				out.DistanceKm = nil
+			} else {
+				if out.DistanceKm == nil {
+					out.DistanceKm = new(float64)
+				}
+				*out.DistanceKm = float64(in.Float64())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDb6e7538EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(out *jwriter.Writer, in PickupPointDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"opensAt\":"
+		out.RawString(prefix)
+		out.String(string(in.OpensAt))
+	}
+	{
+		const prefix string = ",\"closesAt\":"
+		out.RawString(prefix)
+		out.String(string(in.ClosesAt))
+	}
+	{
+		const prefix string = ",\"addressID\":"
+		out.RawString(prefix)
+		out.RawText((in.AddressID).MarshalText())
+	}
+	{
+		const prefix string = ",\"region\":"
+		out.RawString(prefix)
+		out.Raw((in.Region).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		out.Raw((in.City).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"addressString\":"
+		out.RawString(prefix)
+		out.Raw((in.AddressString).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"coordinate\":"
+		out.RawString(prefix)
+		out.Raw((in.Coordinate).MarshalJSON())
+	}
+	if in.DistanceKm != nil {
+		const prefix string = ",\"distanceKm\":"
+		out.RawString(prefix)
+		out.Float64(float64(*in.DistanceKm))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PickupPointDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDb6e7538EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PickupPointDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDb6e7538EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PickupPointDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDb6e7538DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PickupPointDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDb6e7538DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(l, v)
+}
+func easyjsonDb6e7538DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(in *jlexer.Lexer, out *CreatePickupPointRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "region":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Region).UnmarshalJSON(data))
+			}
+		case "city":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.City).UnmarshalJSON(data))
+			}
+		case "addressString":
+			out.AddressString = string(in.String())
+		case "coordinate":
+			out.Coordinate = string(in.String())
+		case "opensAt":
+			out.OpensAt = string(in.String())
+		case "closesAt":
+			out.ClosesAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonDb6e7538EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(out *jwriter.Writer, in CreatePickupPointRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"region\":"
+		out.RawString(prefix)
+		out.Raw((in.Region).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		out.Raw((in.City).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"addressString\":"
+		out.RawString(prefix)
+		out.String(string(in.AddressString))
+	}
+	{
+		const prefix string = ",\"coordinate\":"
+		out.RawString(prefix)
+		out.String(string(in.Coordinate))
+	}
+	{
+		const prefix string = ",\"opensAt\":"
+		out.RawString(prefix)
+		out.String(string(in.OpensAt))
+	}
+	{
+		const prefix string = ",\"closesAt\":"
+		out.RawString(prefix)
+		out.String(string(in.ClosesAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreatePickupPointRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonDb6e7538EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreatePickupPointRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonDb6e7538EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreatePickupPointRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonDb6e7538DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreatePickupPointRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonDb6e7538DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(l, v)
+}
@@ -6,15 +6,15 @@ import (
 )
 
 type BriefProduct struct {
-	ID            uuid.UUID `json:"id"`
-	Name          string    `json:"name"`
-	ImageURL      string    `json:"image"`
-	Price         float64   `json:"price"`
-	PriceDiscount float64   `json:"discount_price"`
-	Quantity      uint      `json:"quantity"`
-	ReviewsCount  uint      `json:"reviews_count"`
-	Rating        float32   `json:"rating"`
-	SellerInfo 	  *SellerInfo `json:"seller_info,omitempty"`
+	ID            uuid.UUID    `json:"id"`
+	Name          string       `json:"name"`
+	ImageURL      string       `json:"image"`
+	Price         models.Money `json:"price"`
+	PriceDiscount models.Money `json:"discount_price"`
+	Quantity      uint         `json:"quantity"`
+	ReviewsCount  uint         `json:"reviews_count"`
+	Rating        float32      `json:"rating"`
+	SellerInfo    *SellerInfo  `json:"seller_info,omitempty"`
 }
 
 func ConvertToBriefProduct(product *models.Product) BriefProduct {
@@ -39,9 +39,28 @@ func ConvertToBriefProduct(product *models.Product) BriefProduct {
 	return briefProduct
 }
 
+// InCurrency возвращает товар с ценами в валюте курса
+func (p BriefProduct) InCurrency(rate models.ExchangeRate) BriefProduct {
+	p.Price = rate.Convert(p.Price)
+	p.PriceDiscount = rate.Convert(p.PriceDiscount)
+	return p
+}
+
 type ProductsResponse struct {
-	Total    int            `json:"total"`
-	Products []BriefProduct `json:"products"`
+	Total    int             `json:"total"`
+	Currency models.Currency `json:"currency"`
+	Products []BriefProduct  `json:"products"`
+}
+
+// InCurrency возвращает список с ценами в валюте курса
+func (r ProductsResponse) InCurrency(rate models.ExchangeRate) ProductsResponse {
+	products := make([]BriefProduct, 0, len(r.Products))
+	for _, product := range r.Products {
+		products = append(products, product.InCurrency(rate))
+	}
+	r.Products = products
+	r.Currency = rate.Code()
+	return r
 }
 
 func ConvertToProductsResponse(products []*models.Product) ProductsResponse {
@@ -52,25 +71,56 @@ func ConvertToProductsResponse(products []*models.Product) ProductsResponse {
 
 	return ProductsResponse{
 		Total:    len(briefProducts),
+		Currency: models.BaseCurrency,
 		Products: briefProducts,
 	}
 }
 
+// ProductResponse — карточка товара с ценами в валюте Currency
+type ProductResponse struct {
+	models.Product
+	Currency models.Currency `json:"currency"`
+}
+
+// ConvertToProductResponse собирает карточку товара, пересчитывая цены
+// товара и его вариантов по курсу
+func ConvertToProductResponse(product *models.Product, rate models.ExchangeRate) ProductResponse {
+	resp := ProductResponse{
+		Product:  *product,
+		Currency: rate.Code(),
+	}
+	resp.Price = rate.Convert(product.Price)
+	resp.PriceDiscount = rate.Convert(product.PriceDiscount)
+
+	if product.Variants != nil {
+		resp.Variants = make([]models.ProductVariant, 0, len(product.Variants))
+		for _, variant := range product.Variants {
+			variant.Price = rate.Convert(variant.Price)
+			variant.PriceDiscount = rate.Convert(variant.PriceDiscount)
+			resp.Variants = append(resp.Variants, variant)
+		}
+	}
+
+	return resp
+}
+
 type GetProductsByIDRequest struct {
 	ProductIDs []uuid.UUID `json:"productIDs" validate:"required,min=1"`
 }
 
 type AddProductRequest struct {
-    Name            string  `json:"name" validate:"required"`
-    SellerID       string  `json:"seller_id" validate:"required,uuid4"`
-    PreviewImageURL string `json:"preview_image_url,omitempty"`
-    Description     string `json:"description,omitempty"`
-    Price          float64 `json:"price" validate:"required,gt=0"`
-    PriceDiscount  float64 `json:"price_discount" validate:"gte=0"`
-    Quantity       uint    `json:"quantity" validate:"gte=0"`
-    Rating         float32 `json:"rating,omitempty" validate:"gte=0,lte=5"`
-    ReviewsCount   uint    `json:"reviews_count,omitempty" validate:"gte=0"`
-    Category       string  `json:"category" validate:"required,uuid4"`
+	Name            string       `json:"name" validate:"required"`
+	SellerID        string       `json:"seller_id" validate:"required,uuid4"`
+	PreviewImageURL string       `json:"preview_image_url,omitempty"`
+	Description     string       `json:"description,omitempty"`
+	Price           models.Money `json:"price" validate:"required,gt=0"`
+	PriceDiscount   models.Money `json:"price_discount" validate:"gte=0"`
+	Quantity        uint         `json:"quantity" validate:"gte=0"`
+	Rating          float32      `json:"rating,omitempty" validate:"gte=0,lte=5"`
+	ReviewsCount    uint         `json:"reviews_count,omitempty" validate:"gte=0"`
+	Category        string       `json:"category" validate:"required,uuid4"`
+	// Attributes — значения характеристик по схеме категории
+	Attributes []models.ProductAttribute `json:"attributes,omitempty"`
 }
 
 type ProductsSellerResponse struct {
@@ -172,9 +172,9 @@ func easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalModels(in
 		case "status":
 			out.Status = models.ProductStatus(in.Int())
 		case "price":
-			out.Price = float64(in.Float64())
+			(out.Price).UnmarshalEasyJSON(in)
 		case "price_discount":
-			out.PriceDiscount = float64(in.Float64())
+			(out.PriceDiscount).UnmarshalEasyJSON(in)
 		case "quantity":
 			out.Quantity = uint(in.Uint())
 		case "updated_at":
@@ -195,6 +195,701 @@ func easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalModels(in
 				}
 				easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalModels1(in, out.Seller)
 			}
+		case "has_variants":
+			out.HasVariants = bool(in.Bool())
+		case "options":
+			if in.IsNull() {
+				in.Skip()
+				out.Options = nil
+			} else {
+				in.Delim('[')
+				if out.Options == nil {
+					if !in.IsDelim(']') {
+						out.Options = make([]models.VariantOption, 0, 1)
+					} else {
+						out.Options = []models.VariantOption{}
+					}
+				} else {
+					out.Options = (out.Options)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 models.VariantOption
+					easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalModels2(in, &v4)
+					out.Options = append(out.Options, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "variants":
+			if in.IsNull() {
+				in.Skip()
+				out.Variants = nil
+			} else {
+				in.Delim('[')
+				if out.Variants == nil {
+					if !in.IsDelim(']') {
+						out.Variants = make([]models.ProductVariant, 0, 0)
+					} else {
+						out.Variants = []models.ProductVariant{}
+					}
+				} else {
+					out.Variants = (out.Variants)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v5 models.ProductVariant
+					easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalModels3(in, &v5)
+					out.Variants = append(out.Variants, v5)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalModels(out *jwriter.Writer, in models.Product) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"seller_id\":"
+		out.RawString(prefix)
+		out.RawText((in.SellerID).MarshalText())
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	if in.PreviewImageURL != "" {
+		const prefix string = ",\"preview_image_url\":"
+		out.RawString(prefix)
+		out.String(string(in.PreviewImageURL))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.Int(int(in.Status))
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		(in.Price).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"price_discount\":"
+		out.RawString(prefix)
+		(in.PriceDiscount).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"quantity\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Quantity))
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.Rating))
+	}
+	{
+		const prefix string = ",\"reviews_count\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.ReviewsCount))
+	}
+	if in.Seller != nil {
+		const prefix string = ",\"seller\":"
+		out.RawString(prefix)
+		easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalModels1(out, *in.Seller)
+	}
+	{
+		const prefix string = ",\"has_variants\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasVariants))
+	}
+	if len(in.Options) != 0 {
+		const prefix string = ",\"options\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v6, v7 := range in.Options {
+				if v6 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalModels2(out, v7)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Variants) != 0 {
+		const prefix string = ",\"variants\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v8, v9 := range in.Variants {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalModels3(out, v9)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalModels3(in *jlexer.Lexer, out *models.ProductVariant) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "product_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ProductID).UnmarshalText(data))
+			}
+		case "sku":
+			out.SKU = string(in.String())
+		case "options":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Options = make(models.VariantOptions)
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v10 string
+					v10 = string(in.String())
+					(out.Options)[key] = v10
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "price":
+			(out.Price).UnmarshalEasyJSON(in)
+		case "price_discount":
+			(out.PriceDiscount).UnmarshalEasyJSON(in)
+		case "quantity":
+			out.Quantity = uint(in.Uint())
+		case "images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				in.Delim('[')
+				if out.Images == nil {
+					if !in.IsDelim(']') {
+						out.Images = make([]string, 0, 4)
+					} else {
+						out.Images = []string{}
+					}
+				} else {
+					out.Images = (out.Images)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v11 string
+					v11 = string(in.String())
+					out.Images = append(out.Images, v11)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "position":
+			out.Position = int(in.Int())
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalModels3(out *jwriter.Writer, in models.ProductVariant) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"product_id\":"
+		out.RawString(prefix)
+		out.RawText((in.ProductID).MarshalText())
+	}
+	{
+		const prefix string = ",\"sku\":"
+		out.RawString(prefix)
+		out.String(string(in.SKU))
+	}
+	{
+		const prefix string = ",\"options\":"
+		out.RawString(prefix)
+		if in.Options == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v12First := true
+			for v12Name, v12Value := range in.Options {
+				if v12First {
+					v12First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v12Name))
+				out.RawByte(':')
+				out.String(string(v12Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"price\":"
+		out.RawString(prefix)
+		(in.Price).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"price_discount\":"
+		out.RawString(prefix)
+		(in.PriceDiscount).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"quantity\":"
+		out.RawString(prefix)
+		out.Uint(uint(in.Quantity))
+	}
+	{
+		const prefix string = ",\"images\":"
+		out.RawString(prefix)
+		if in.Images == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v13, v14 := range in.Images {
+				if v13 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v14))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		out.Int(int(in.Position))
+	}
+	{
+		const prefix string = ",\"updated_at\":"
+		out.RawString(prefix)
+		out.Raw((in.UpdatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+func easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalModels2(in *jlexer.Lexer, out *models.VariantOption) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "values":
+			if in.IsNull() {
+				in.Skip()
+				out.Values = nil
+			} else {
+				in.Delim('[')
+				if out.Values == nil {
+					if !in.IsDelim(']') {
+						out.Values = make([]string, 0, 4)
+					} else {
+						out.Values = []string{}
+					}
+				} else {
+					out.Values = (out.Values)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v15 string
+					v15 = string(in.String())
+					out.Values = append(out.Values, v15)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalModels2(out *jwriter.Writer, in models.VariantOption) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"values\":"
+		out.RawString(prefix)
+		if in.Values == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v16, v17 := range in.Values {
+				if v16 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v17))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+func easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalModels1(in *jlexer.Lexer, out *models.Seller) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "title":
+			out.Title = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalModels1(out *jwriter.Writer, in models.Seller) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix)
+		out.String(string(in.Description))
+	}
+	out.RawByte('}')
+}
+func easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(in *jlexer.Lexer, out *ProductsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "total":
+			out.Total = int(in.Int())
+		case "currency":
+			out.Currency = models.Currency(in.String())
+		case "products":
+			if in.IsNull() {
+				in.Skip()
+				out.Products = nil
+			} else {
+				in.Delim('[')
+				if out.Products == nil {
+					if !in.IsDelim(']') {
+						out.Products = make([]BriefProduct, 0, 0)
+					} else {
+						out.Products = []BriefProduct{}
+					}
+				} else {
+					out.Products = (out.Products)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v18 BriefProduct
+					(v18).UnmarshalEasyJSON(in)
+					out.Products = append(out.Products, v18)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(out *jwriter.Writer, in ProductsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Total))
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"products\":"
+		out.RawString(prefix)
+		if in.Products == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v19, v20 := range in.Products {
+				if v19 > 0 {
+					out.RawByte(',')
+				}
+				(v20).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ProductsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ProductsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ProductsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ProductsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(l, v)
+}
+func easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(in *jlexer.Lexer, out *ProductResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "currency":
+			out.Currency = models.Currency(in.String())
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "seller_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.SellerID).UnmarshalText(data))
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "preview_image_url":
+			out.PreviewImageURL = string(in.String())
+		case "description":
+			out.Description = string(in.String())
+		case "status":
+			out.Status = models.ProductStatus(in.Int())
+		case "price":
+			(out.Price).UnmarshalEasyJSON(in)
+		case "price_discount":
+			(out.PriceDiscount).UnmarshalEasyJSON(in)
+		case "quantity":
+			out.Quantity = uint(in.Uint())
+		case "updated_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.UpdatedAt).UnmarshalJSON(data))
+			}
+		case "rating":
+			out.Rating = float32(in.Float32())
+		case "reviews_count":
+			out.ReviewsCount = uint(in.Uint())
+		case "seller":
+			if in.IsNull() {
+				in.Skip()
+				out.Seller = nil
+			} else {
+				if out.Seller == nil {
+					out.Seller = new(models.Seller)
+				}
+				easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalModels1(in, out.Seller)
+			}
+		case "has_variants":
+			out.HasVariants = bool(in.Bool())
+		case "options":
+			if in.IsNull() {
+				in.Skip()
+				out.Options = nil
+			} else {
+				in.Delim('[')
+				if out.Options == nil {
+					if !in.IsDelim(']') {
+						out.Options = make([]models.VariantOption, 0, 1)
+					} else {
+						out.Options = []models.VariantOption{}
+					}
+				} else {
+					out.Options = (out.Options)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v21 models.VariantOption
+					easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalModels2(in, &v21)
+					out.Options = append(out.Options, v21)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "variants":
+			if in.IsNull() {
+				in.Skip()
+				out.Variants = nil
+			} else {
+				in.Delim('[')
+				if out.Variants == nil {
+					if !in.IsDelim(']') {
+						out.Variants = make([]models.ProductVariant, 0, 0)
+					} else {
+						out.Variants = []models.ProductVariant{}
+					}
+				} else {
+					out.Variants = (out.Variants)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v22 models.ProductVariant
+					easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalModels3(in, &v22)
+					out.Variants = append(out.Variants, v22)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -205,13 +900,18 @@ func easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalModels(in
 		in.Consumed()
 	}
 }
-func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalModels(out *jwriter.Writer, in models.Product) {
+func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(out *jwriter.Writer, in ProductResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"currency\":"
 		out.RawString(prefix[1:])
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
 		out.RawText((in.ID).MarshalText())
 	}
 	{
@@ -242,12 +942,12 @@ func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalModels(out
 	{
 		const prefix string = ",\"price\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.Price))
+		(in.Price).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"price_discount\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.PriceDiscount))
+		(in.PriceDiscount).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"quantity\":"
@@ -274,141 +974,35 @@ func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalModels(out
 		out.RawString(prefix)
 		easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalModels1(out, *in.Seller)
 	}
-	out.RawByte('}')
-}
-func easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalModels1(in *jlexer.Lexer, out *models.Seller) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "id":
-			if data := in.UnsafeBytes(); in.Ok() {
-				in.AddError((out.ID).UnmarshalText(data))
-			}
-		case "title":
-			out.Title = string(in.String())
-		case "description":
-			out.Description = string(in.String())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalModels1(out *jwriter.Writer, in models.Seller) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"id\":"
-		out.RawString(prefix[1:])
-		out.RawText((in.ID).MarshalText())
-	}
 	{
-		const prefix string = ",\"title\":"
+		const prefix string = ",\"has_variants\":"
 		out.RawString(prefix)
-		out.String(string(in.Title))
+		out.Bool(bool(in.HasVariants))
 	}
-	{
-		const prefix string = ",\"description\":"
+	if len(in.Options) != 0 {
+		const prefix string = ",\"options\":"
 		out.RawString(prefix)
-		out.String(string(in.Description))
-	}
-	out.RawByte('}')
-}
-func easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(in *jlexer.Lexer, out *ProductsResponse) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "total":
-			out.Total = int(in.Int())
-		case "products":
-			if in.IsNull() {
-				in.Skip()
-				out.Products = nil
-			} else {
-				in.Delim('[')
-				if out.Products == nil {
-					if !in.IsDelim(']') {
-						out.Products = make([]BriefProduct, 0, 0)
-					} else {
-						out.Products = []BriefProduct{}
-					}
-				} else {
-					out.Products = (out.Products)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v4 BriefProduct
-					(v4).UnmarshalEasyJSON(in)
-					out.Products = append(out.Products, v4)
-					in.WantComma()
+		{
+			out.RawByte('[')
+			for v23, v24 := range in.Options {
+				if v23 > 0 {
+					out.RawByte(',')
 				}
-				in.Delim(']')
+				easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalModels2(out, v24)
 			}
-		default:
-			in.SkipRecursive()
+			out.RawByte(']')
 		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(out *jwriter.Writer, in ProductsResponse) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"total\":"
-		out.RawString(prefix[1:])
-		out.Int(int(in.Total))
 	}
-	{
-		const prefix string = ",\"products\":"
+	if len(in.Variants) != 0 {
+		const prefix string = ",\"variants\":"
 		out.RawString(prefix)
-		if in.Products == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
+		{
 			out.RawByte('[')
-			for v5, v6 := range in.Products {
-				if v5 > 0 {
+			for v25, v26 := range in.Variants {
+				if v25 > 0 {
 					out.RawByte(',')
 				}
-				(v6).MarshalEasyJSON(out)
+				easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalModels3(out, v26)
 			}
 			out.RawByte(']')
 		}
@@ -417,29 +1011,29 @@ func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ProductsResponse) MarshalJSON() ([]byte, error) {
+func (v ProductResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&w, v)
+	easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ProductsResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(w, v)
+func (v ProductResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ProductsResponse) UnmarshalJSON(data []byte) error {
+func (v *ProductResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&r, v)
+	easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ProductsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(l, v)
+func (v *ProductResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(l, v)
 }
-func easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(in *jlexer.Lexer, out *GetProductsByIDRequest) {
+func easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(in *jlexer.Lexer, out *GetProductsByIDRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -474,11 +1068,11 @@ func easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 					out.ProductIDs = (out.ProductIDs)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v7 uuid.UUID
+					var v27 uuid.UUID
 					if data := in.UnsafeBytes(); in.Ok() {
-						in.AddError((v7).UnmarshalText(data))
+						in.AddError((v27).UnmarshalText(data))
 					}
-					out.ProductIDs = append(out.ProductIDs, v7)
+					out.ProductIDs = append(out.ProductIDs, v27)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -493,7 +1087,7 @@ func easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		in.Consumed()
 	}
 }
-func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(out *jwriter.Writer, in GetProductsByIDRequest) {
+func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(out *jwriter.Writer, in GetProductsByIDRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -504,11 +1098,11 @@ func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v8, v9 := range in.ProductIDs {
-				if v8 > 0 {
+			for v28, v29 := range in.ProductIDs {
+				if v28 > 0 {
 					out.RawByte(',')
 				}
-				out.RawText((v9).MarshalText())
+				out.RawText((v29).MarshalText())
 			}
 			out.RawByte(']')
 		}
@@ -519,27 +1113,27 @@ func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 // MarshalJSON supports json.Marshaler interface
 func (v GetProductsByIDRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&w, v)
+	easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v GetProductsByIDRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(w, v)
+	easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *GetProductsByIDRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&r, v)
+	easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *GetProductsByIDRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(l, v)
+	easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(l, v)
 }
-func easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(in *jlexer.Lexer, out *BriefProduct) {
+func easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(in *jlexer.Lexer, out *BriefProduct) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -567,9 +1161,9 @@ func easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		case "image":
 			out.ImageURL = string(in.String())
 		case "price":
-			out.Price = float64(in.Float64())
+			(out.Price).UnmarshalEasyJSON(in)
 		case "discount_price":
-			out.PriceDiscount = float64(in.Float64())
+			(out.PriceDiscount).UnmarshalEasyJSON(in)
 		case "quantity":
 			out.Quantity = uint(in.Uint())
 		case "reviews_count":
@@ -596,7 +1190,7 @@ func easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		in.Consumed()
 	}
 }
-func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(out *jwriter.Writer, in BriefProduct) {
+func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(out *jwriter.Writer, in BriefProduct) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -618,12 +1212,12 @@ func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 	{
 		const prefix string = ",\"price\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.Price))
+		(in.Price).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"discount_price\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.PriceDiscount))
+		(in.PriceDiscount).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"quantity\":"
@@ -651,27 +1245,27 @@ func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 // MarshalJSON supports json.Marshaler interface
 func (v BriefProduct) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&w, v)
+	easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v BriefProduct) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(w, v)
+	easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BriefProduct) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&r, v)
+	easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *BriefProduct) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(l, v)
+	easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(l, v)
 }
-func easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(in *jlexer.Lexer, out *AddProductRequest) {
+func easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(in *jlexer.Lexer, out *AddProductRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -699,9 +1293,9 @@ func easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		case "description":
 			out.Description = string(in.String())
 		case "price":
-			out.Price = float64(in.Float64())
+			(out.Price).UnmarshalEasyJSON(in)
 		case "price_discount":
-			out.PriceDiscount = float64(in.Float64())
+			(out.PriceDiscount).UnmarshalEasyJSON(in)
 		case "quantity":
 			out.Quantity = uint(in.Uint())
 		case "rating":
@@ -710,6 +1304,29 @@ func easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 			out.ReviewsCount = uint(in.Uint())
 		case "category":
 			out.Category = string(in.String())
+		case "attributes":
+			if in.IsNull() {
+				in.Skip()
+				out.Attributes = nil
+			} else {
+				in.Delim('[')
+				if out.Attributes == nil {
+					if !in.IsDelim(']') {
+						out.Attributes = make([]models.ProductAttribute, 0, 1)
+					} else {
+						out.Attributes = []models.ProductAttribute{}
+					}
+				} else {
+					out.Attributes = (out.Attributes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v30 models.ProductAttribute
+					easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalModels4(in, &v30)
+					out.Attributes = append(out.Attributes, v30)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -720,7 +1337,7 @@ func easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		in.Consumed()
 	}
 }
-func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(out *jwriter.Writer, in AddProductRequest) {
+func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(out *jwriter.Writer, in AddProductRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -747,12 +1364,12 @@ func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 	{
 		const prefix string = ",\"price\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.Price))
+		(in.Price).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"price_discount\":"
 		out.RawString(prefix)
-		out.Float64(float64(in.PriceDiscount))
+		(in.PriceDiscount).MarshalEasyJSON(out)
 	}
 	{
 		const prefix string = ",\"quantity\":"
@@ -774,29 +1391,114 @@ func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		out.RawString(prefix)
 		out.String(string(in.Category))
 	}
+	if len(in.Attributes) != 0 {
+		const prefix string = ",\"attributes\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v31, v32 := range in.Attributes {
+				if v31 > 0 {
+					out.RawByte(',')
+				}
+				easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalModels4(out, v32)
+			}
+			out.RawByte(']')
+		}
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v AddProductRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&w, v)
+	easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AddProductRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(w, v)
+	easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AddProductRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&r, v)
+	easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AddProductRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(l, v)
+	easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(l, v)
+}
+func easyjsonCf3f67efDecodeGithubComGoParkMailRu20251ChillGuysInternalModels4(in *jlexer.Lexer, out *models.ProductAttribute) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "attributeId":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AttributeID).UnmarshalText(data))
+			}
+		case "text":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Text).UnmarshalJSON(data))
+			}
+		case "number":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Number).UnmarshalJSON(data))
+			}
+		case "bool":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Bool).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCf3f67efEncodeGithubComGoParkMailRu20251ChillGuysInternalModels4(out *jwriter.Writer, in models.ProductAttribute) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"attributeId\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.AttributeID).MarshalText())
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.Raw((in.Text).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"number\":"
+		out.RawString(prefix)
+		out.Raw((in.Number).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"bool\":"
+		out.RawString(prefix)
+		out.Raw((in.Bool).MarshalJSON())
+	}
+	out.RawByte('}')
 }
@@ -7,19 +7,45 @@ import (
 	"github.com/google/uuid"
 )
 
+type PromoScopeDTO struct {
+	Type string    `json:"type"`
+	ID   uuid.UUID `json:"id"`
+}
+
+// CreatePromoRequest описывает промокод и его правила. Незаданные ограничения
+// (лимиты, максимальная скидка, область действия) означают их отсутствие;
+// по умолчанию промокод процентный и суммируется со скидками на товары.
 type CreatePromoRequest struct {
-	Code      string    `json:"code" validate:"required"`
-	Percent   int       `json:"percent" validate:"required,min=1,max=100"`
-	StartDate time.Time `json:"start_date" validate:"required"`
-	EndDate   time.Time `json:"end_date" validate:"required,gtfield=StartDate"`
+	Code               string          `json:"code" validate:"required"`
+	Percent            int             `json:"percent" validate:"min=0,max=100"`
+	StartDate          time.Time       `json:"start_date" validate:"required"`
+	EndDate            time.Time       `json:"end_date" validate:"required,gtfield=StartDate"`
+	DiscountType       string          `json:"discount_type,omitempty"`
+	Amount             models.Money    `json:"amount,omitempty"`
+	MinOrderAmount     models.Money    `json:"min_order_amount,omitempty"`
+	MaxDiscount        *models.Money   `json:"max_discount,omitempty"`
+	UsageLimit         *int            `json:"usage_limit,omitempty"`
+	PerUserLimit       *int            `json:"per_user_limit,omitempty"`
+	FirstOrderOnly     bool            `json:"first_order_only,omitempty"`
+	StackWithDiscounts *bool           `json:"stack_with_discounts,omitempty"`
+	Scopes             []PromoScopeDTO `json:"scopes,omitempty"`
 }
 
 type PromoResponse struct {
-	ID        uuid.UUID `json:"id"`
-	Code      string    `json:"code"`
-	Percent   int       `json:"percent"`
-	StartDate time.Time `json:"start_date"`
-	EndDate   time.Time `json:"end_date"`
+	ID                 uuid.UUID       `json:"id"`
+	Code               string          `json:"code"`
+	Percent            int             `json:"percent"`
+	StartDate          time.Time       `json:"start_date"`
+	EndDate            time.Time       `json:"end_date"`
+	DiscountType       string          `json:"discount_type"`
+	Amount             models.Money    `json:"amount,omitempty"`
+	MinOrderAmount     models.Money    `json:"min_order_amount,omitempty"`
+	MaxDiscount        *models.Money   `json:"max_discount,omitempty"`
+	UsageLimit         *int            `json:"usage_limit,omitempty"`
+	PerUserLimit       *int            `json:"per_user_limit,omitempty"`
+	FirstOrderOnly     bool            `json:"first_order_only"`
+	StackWithDiscounts bool            `json:"stack_with_discounts"`
+	Scopes             []PromoScopeDTO `json:"scopes,omitempty"`
 }
 
 type PromosResponse struct {
@@ -28,13 +54,40 @@ type PromosResponse struct {
 }
 
 func ConvertToPromoResponse(promo *models.PromoCode) PromoResponse {
-	return PromoResponse{
-		ID:        promo.ID,
-		Code:      promo.Code,
-		Percent:   promo.Percent,
-		StartDate: promo.StartDate,
-		EndDate:   promo.EndDate,
+	resp := PromoResponse{
+		ID:                 promo.ID,
+		Code:               promo.Code,
+		Percent:            promo.Percent,
+		StartDate:          promo.StartDate,
+		EndDate:            promo.EndDate,
+		DiscountType:       string(promo.DiscountType),
+		Amount:             promo.Amount,
+		MinOrderAmount:     promo.MinOrderAmount,
+		FirstOrderOnly:     promo.FirstOrderOnly,
+		StackWithDiscounts: promo.StackWithDiscounts,
+	}
+
+	if promo.MaxDiscount.Valid {
+		maxDiscount := promo.MaxDiscount.Money
+		resp.MaxDiscount = &maxDiscount
+	}
+	if promo.UsageLimit.Valid {
+		usageLimit := int(promo.UsageLimit.Int64)
+		resp.UsageLimit = &usageLimit
 	}
+	if promo.PerUserLimit.Valid {
+		perUserLimit := int(promo.PerUserLimit.Int64)
+		resp.PerUserLimit = &perUserLimit
+	}
+
+	for _, scope := range promo.Scopes {
+		resp.Scopes = append(resp.Scopes, PromoScopeDTO{
+			Type: string(scope.Type),
+			ID:   scope.TargetID,
+		})
+	}
+
+	return resp
 }
 
 func ConvertToPromosResponse(promos []*models.PromoCode) PromosResponse {
@@ -53,7 +106,65 @@ type CheckPromoRequest struct {
     Code string `json:"code" validate:"required"`
 }
 
+// PromoValidityResponse — результат проверки промокода. Discount считается
+// по текущей корзине пользователя; при отказе Reason и Message объясняют причину.
 type PromoValidityResponse struct {
-    IsValid bool `json:"is_valid"`
-    Percent int  `json:"percent,omitempty"` 
-}
\ No newline at end of file
+	IsValid      bool         `json:"is_valid"`
+	Percent      int          `json:"percent,omitempty"`
+	DiscountType string       `json:"discount_type,omitempty"`
+	Amount       models.Money `json:"amount,omitempty"`
+	Discount     models.Money `json:"discount,omitempty"`
+	Reason       string       `json:"reason,omitempty"`
+	Message      string       `json:"message,omitempty"`
+}
+
+// CreatePromoCampaignRequest описывает партию одноразовых промокодов.
+// Rules задаёт правила каждого кода; поле code в них игнорируется.
+type CreatePromoCampaignRequest struct {
+	Name       string             `json:"name"`
+	Prefix     string             `json:"prefix,omitempty"`
+	Alphabet   string             `json:"alphabet,omitempty"`
+	CodeLength int                `json:"code_length,omitempty"`
+	Count      int                `json:"count"`
+	Rules      CreatePromoRequest `json:"rules"`
+}
+
+type PromoCampaignResponse struct {
+	ID         uuid.UUID     `json:"id"`
+	Name       string        `json:"name"`
+	Prefix     string        `json:"prefix,omitempty"`
+	Alphabet   string        `json:"alphabet"`
+	CodeLength int           `json:"code_length"`
+	CodesCount int           `json:"codes_count"`
+	IsActive   bool          `json:"is_active"`
+	CreatedAt  time.Time     `json:"created_at"`
+	Rules      PromoResponse `json:"rules"`
+}
+
+func ConvertToPromoCampaignResponse(campaign *models.PromoCampaign) PromoCampaignResponse {
+	return PromoCampaignResponse{
+		ID:         campaign.ID,
+		Name:       campaign.Name,
+		Prefix:     campaign.Prefix,
+		Alphabet:   campaign.Alphabet,
+		CodeLength: campaign.CodeLength,
+		CodesCount: campaign.CodesCount,
+		IsActive:   campaign.IsActive,
+		CreatedAt:  campaign.CreatedAt,
+		Rules:      ConvertToPromoResponse(&campaign.Template),
+	}
+}
+
+// PromoCampaignStatsResponse — аналитика кампании. Revenue — сумма заказов,
+// оформленных с кодами кампании, после всех скидок.
+type PromoCampaignStatsResponse struct {
+	CampaignID      uuid.UUID    `json:"campaign_id"`
+	Name            string       `json:"name"`
+	IsActive        bool         `json:"is_active"`
+	CodesTotal      int          `json:"codes_total"`
+	Redemptions     int          `json:"redemptions"`
+	RedemptionRate  float64      `json:"redemption_rate"`
+	Revenue         models.Money `json:"revenue"`
+	TotalDiscount   models.Money `json:"total_discount"`
+	AverageDiscount models.Money `json:"average_discount"`
+}
@@ -4,6 +4,7 @@ package dto
 
 import (
 	json "encoding/json"
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
@@ -145,6 +146,16 @@ func easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 			out.IsValid = bool(in.Bool())
 		case "percent":
 			out.Percent = int(in.Int())
+		case "discount_type":
+			out.DiscountType = string(in.String())
+		case "amount":
+			(out.Amount).UnmarshalEasyJSON(in)
+		case "discount":
+			(out.Discount).UnmarshalEasyJSON(in)
+		case "reason":
+			out.Reason = string(in.String())
+		case "message":
+			out.Message = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -169,6 +180,31 @@ func easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		out.RawString(prefix)
 		out.Int(int(in.Percent))
 	}
+	if in.DiscountType != "" {
+		const prefix string = ",\"discount_type\":"
+		out.RawString(prefix)
+		out.String(string(in.DiscountType))
+	}
+	if (in.Amount).IsDefined() {
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		(in.Amount).MarshalEasyJSON(out)
+	}
+	if (in.Discount).IsDefined() {
+		const prefix string = ",\"discount\":"
+		out.RawString(prefix)
+		(in.Discount).MarshalEasyJSON(out)
+	}
+	if in.Reason != "" {
+		const prefix string = ",\"reason\":"
+		out.RawString(prefix)
+		out.String(string(in.Reason))
+	}
+	if in.Message != "" {
+		const prefix string = ",\"message\":"
+		out.RawString(prefix)
+		out.String(string(in.Message))
+	}
 	out.RawByte('}')
 }
 
@@ -195,7 +231,82 @@ func (v *PromoValidityResponse) UnmarshalJSON(data []byte) error {
 func (v *PromoValidityResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(l, v)
 }
-func easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(in *jlexer.Lexer, out *PromoResponse) {
+func easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(in *jlexer.Lexer, out *PromoScopeDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(out *jwriter.Writer, in PromoScopeDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.RawText((in.ID).MarshalText())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PromoScopeDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PromoScopeDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PromoScopeDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PromoScopeDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(l, v)
+}
+func easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(in *jlexer.Lexer, out *PromoResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -230,6 +341,69 @@ func easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.EndDate).UnmarshalJSON(data))
 			}
+		case "discount_type":
+			out.DiscountType = string(in.String())
+		case "amount":
+			(out.Amount).UnmarshalEasyJSON(in)
+		case "min_order_amount":
+			(out.MinOrderAmount).UnmarshalEasyJSON(in)
+		case "max_discount":
+			if in.IsNull() {
+				in.Skip()
+				out.MaxDiscount = nil
+			} else {
+				if out.MaxDiscount == nil {
+					out.MaxDiscount = new(models.Money)
+				}
+				(*out.MaxDiscount).UnmarshalEasyJSON(in)
+			}
+		case "usage_limit":
+			if in.IsNull() {
+				in.Skip()
+				out.UsageLimit = nil
+			} else {
+				if out.UsageLimit == nil {
+					out.UsageLimit = new(int)
+				}
+				*out.UsageLimit = int(in.Int())
+			}
+		case "per_user_limit":
+			if in.IsNull() {
+				in.Skip()
+				out.PerUserLimit = nil
+			} else {
+				if out.PerUserLimit == nil {
+					out.PerUserLimit = new(int)
+				}
+				*out.PerUserLimit = int(in.Int())
+			}
+		case "first_order_only":
+			out.FirstOrderOnly = bool(in.Bool())
+		case "stack_with_discounts":
+			out.StackWithDiscounts = bool(in.Bool())
+		case "scopes":
+			if in.IsNull() {
+				in.Skip()
+				out.Scopes = nil
+			} else {
+				in.Delim('[')
+				if out.Scopes == nil {
+					if !in.IsDelim(']') {
+						out.Scopes = make([]PromoScopeDTO, 0, 2)
+					} else {
+						out.Scopes = []PromoScopeDTO{}
+					}
+				} else {
+					out.Scopes = (out.Scopes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 PromoScopeDTO
+					(v4).UnmarshalEasyJSON(in)
+					out.Scopes = append(out.Scopes, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -240,7 +414,7 @@ func easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		in.Consumed()
 	}
 }
-func easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(out *jwriter.Writer, in PromoResponse) {
+func easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(out *jwriter.Writer, in PromoResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -269,33 +443,337 @@ func easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		out.RawString(prefix)
 		out.Raw((in.EndDate).MarshalJSON())
 	}
+	{
+		const prefix string = ",\"discount_type\":"
+		out.RawString(prefix)
+		out.String(string(in.DiscountType))
+	}
+	if (in.Amount).IsDefined() {
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		(in.Amount).MarshalEasyJSON(out)
+	}
+	if (in.MinOrderAmount).IsDefined() {
+		const prefix string = ",\"min_order_amount\":"
+		out.RawString(prefix)
+		(in.MinOrderAmount).MarshalEasyJSON(out)
+	}
+	if in.MaxDiscount != nil {
+		const prefix string = ",\"max_discount\":"
+		out.RawString(prefix)
+		(*in.MaxDiscount).MarshalEasyJSON(out)
+	}
+	if in.UsageLimit != nil {
+		const prefix string = ",\"usage_limit\":"
+		out.RawString(prefix)
+		out.Int(int(*in.UsageLimit))
+	}
+	if in.PerUserLimit != nil {
+		const prefix string = ",\"per_user_limit\":"
+		out.RawString(prefix)
+		out.Int(int(*in.PerUserLimit))
+	}
+	{
+		const prefix string = ",\"first_order_only\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.FirstOrderOnly))
+	}
+	{
+		const prefix string = ",\"stack_with_discounts\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.StackWithDiscounts))
+	}
+	if len(in.Scopes) != 0 {
+		const prefix string = ",\"scopes\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.Scopes {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v PromoResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&w, v)
+	easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PromoResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(w, v)
+	easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PromoResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&r, v)
+	easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PromoResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(l, v)
+	easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(l, v)
+}
+func easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(in *jlexer.Lexer, out *PromoCampaignStatsResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "campaign_id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.CampaignID).UnmarshalText(data))
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "is_active":
+			out.IsActive = bool(in.Bool())
+		case "codes_total":
+			out.CodesTotal = int(in.Int())
+		case "redemptions":
+			out.Redemptions = int(in.Int())
+		case "redemption_rate":
+			out.RedemptionRate = float64(in.Float64())
+		case "revenue":
+			(out.Revenue).UnmarshalEasyJSON(in)
+		case "total_discount":
+			(out.TotalDiscount).UnmarshalEasyJSON(in)
+		case "average_discount":
+			(out.AverageDiscount).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(out *jwriter.Writer, in PromoCampaignStatsResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"campaign_id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.CampaignID).MarshalText())
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"is_active\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsActive))
+	}
+	{
+		const prefix string = ",\"codes_total\":"
+		out.RawString(prefix)
+		out.Int(int(in.CodesTotal))
+	}
+	{
+		const prefix string = ",\"redemptions\":"
+		out.RawString(prefix)
+		out.Int(int(in.Redemptions))
+	}
+	{
+		const prefix string = ",\"redemption_rate\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.RedemptionRate))
+	}
+	{
+		const prefix string = ",\"revenue\":"
+		out.RawString(prefix)
+		(in.Revenue).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"total_discount\":"
+		out.RawString(prefix)
+		(in.TotalDiscount).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"average_discount\":"
+		out.RawString(prefix)
+		(in.AverageDiscount).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PromoCampaignStatsResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PromoCampaignStatsResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PromoCampaignStatsResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&r, v)
+	return r.Error()
 }
-func easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(in *jlexer.Lexer, out *CreatePromoRequest) {
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PromoCampaignStatsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(l, v)
+}
+func easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(in *jlexer.Lexer, out *PromoCampaignResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "prefix":
+			out.Prefix = string(in.String())
+		case "alphabet":
+			out.Alphabet = string(in.String())
+		case "code_length":
+			out.CodeLength = int(in.Int())
+		case "codes_count":
+			out.CodesCount = int(in.Int())
+		case "is_active":
+			out.IsActive = bool(in.Bool())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "rules":
+			(out.Rules).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(out *jwriter.Writer, in PromoCampaignResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	if in.Prefix != "" {
+		const prefix string = ",\"prefix\":"
+		out.RawString(prefix)
+		out.String(string(in.Prefix))
+	}
+	{
+		const prefix string = ",\"alphabet\":"
+		out.RawString(prefix)
+		out.String(string(in.Alphabet))
+	}
+	{
+		const prefix string = ",\"code_length\":"
+		out.RawString(prefix)
+		out.Int(int(in.CodeLength))
+	}
+	{
+		const prefix string = ",\"codes_count\":"
+		out.RawString(prefix)
+		out.Int(int(in.CodesCount))
+	}
+	{
+		const prefix string = ",\"is_active\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsActive))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"rules\":"
+		out.RawString(prefix)
+		(in.Rules).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PromoCampaignResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PromoCampaignResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PromoCampaignResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PromoCampaignResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(l, v)
+}
+func easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(in *jlexer.Lexer, out *CreatePromoRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -326,6 +804,77 @@ func easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 			if data := in.Raw(); in.Ok() {
 				in.AddError((out.EndDate).UnmarshalJSON(data))
 			}
+		case "discount_type":
+			out.DiscountType = string(in.String())
+		case "amount":
+			(out.Amount).UnmarshalEasyJSON(in)
+		case "min_order_amount":
+			(out.MinOrderAmount).UnmarshalEasyJSON(in)
+		case "max_discount":
+			if in.IsNull() {
+				in.Skip()
+				out.MaxDiscount = nil
+			} else {
+				if out.MaxDiscount == nil {
+					out.MaxDiscount = new(models.Money)
+				}
+				(*out.MaxDiscount).UnmarshalEasyJSON(in)
+			}
+		case "usage_limit":
+			if in.IsNull() {
+				in.Skip()
+				out.UsageLimit = nil
+			} else {
+				if out.UsageLimit == nil {
+					out.UsageLimit = new(int)
+				}
+				*out.UsageLimit = int(in.Int())
+			}
+		case "per_user_limit":
+			if in.IsNull() {
+				in.Skip()
+				out.PerUserLimit = nil
+			} else {
+				if out.PerUserLimit == nil {
+					out.PerUserLimit = new(int)
+				}
+				*out.PerUserLimit = int(in.Int())
+			}
+		case "first_order_only":
+			out.FirstOrderOnly = bool(in.Bool())
+		case "stack_with_discounts":
+			if in.IsNull() {
+				in.Skip()
+				out.StackWithDiscounts = nil
+			} else {
+				if out.StackWithDiscounts == nil {
+					out.StackWithDiscounts = new(bool)
+				}
+				*out.StackWithDiscounts = bool(in.Bool())
+			}
+		case "scopes":
+			if in.IsNull() {
+				in.Skip()
+				out.Scopes = nil
+			} else {
+				in.Delim('[')
+				if out.Scopes == nil {
+					if !in.IsDelim(']') {
+						out.Scopes = make([]PromoScopeDTO, 0, 2)
+					} else {
+						out.Scopes = []PromoScopeDTO{}
+					}
+				} else {
+					out.Scopes = (out.Scopes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 PromoScopeDTO
+					(v7).UnmarshalEasyJSON(in)
+					out.Scopes = append(out.Scopes, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -336,7 +885,7 @@ func easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		in.Consumed()
 	}
 }
-func easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(out *jwriter.Writer, in CreatePromoRequest) {
+func easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(out *jwriter.Writer, in CreatePromoRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -360,33 +909,188 @@ func easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		out.RawString(prefix)
 		out.Raw((in.EndDate).MarshalJSON())
 	}
+	if in.DiscountType != "" {
+		const prefix string = ",\"discount_type\":"
+		out.RawString(prefix)
+		out.String(string(in.DiscountType))
+	}
+	if (in.Amount).IsDefined() {
+		const prefix string = ",\"amount\":"
+		out.RawString(prefix)
+		(in.Amount).MarshalEasyJSON(out)
+	}
+	if (in.MinOrderAmount).IsDefined() {
+		const prefix string = ",\"min_order_amount\":"
+		out.RawString(prefix)
+		(in.MinOrderAmount).MarshalEasyJSON(out)
+	}
+	if in.MaxDiscount != nil {
+		const prefix string = ",\"max_discount\":"
+		out.RawString(prefix)
+		(*in.MaxDiscount).MarshalEasyJSON(out)
+	}
+	if in.UsageLimit != nil {
+		const prefix string = ",\"usage_limit\":"
+		out.RawString(prefix)
+		out.Int(int(*in.UsageLimit))
+	}
+	if in.PerUserLimit != nil {
+		const prefix string = ",\"per_user_limit\":"
+		out.RawString(prefix)
+		out.Int(int(*in.PerUserLimit))
+	}
+	if in.FirstOrderOnly {
+		const prefix string = ",\"first_order_only\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.FirstOrderOnly))
+	}
+	if in.StackWithDiscounts != nil {
+		const prefix string = ",\"stack_with_discounts\":"
+		out.RawString(prefix)
+		out.Bool(bool(*in.StackWithDiscounts))
+	}
+	if len(in.Scopes) != 0 {
+		const prefix string = ",\"scopes\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v8, v9 := range in.Scopes {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				(v9).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v CreatePromoRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&w, v)
+	easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CreatePromoRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(w, v)
+	easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CreatePromoRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&r, v)
+	easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CreatePromoRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(l, v)
+	easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(l, v)
+}
+func easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(in *jlexer.Lexer, out *CreatePromoCampaignRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "prefix":
+			out.Prefix = string(in.String())
+		case "alphabet":
+			out.Alphabet = string(in.String())
+		case "code_length":
+			out.CodeLength = int(in.Int())
+		case "count":
+			out.Count = int(in.Int())
+		case "rules":
+			(out.Rules).UnmarshalEasyJSON(in)
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(out *jwriter.Writer, in CreatePromoCampaignRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	if in.Prefix != "" {
+		const prefix string = ",\"prefix\":"
+		out.RawString(prefix)
+		out.String(string(in.Prefix))
+	}
+	if in.Alphabet != "" {
+		const prefix string = ",\"alphabet\":"
+		out.RawString(prefix)
+		out.String(string(in.Alphabet))
+	}
+	if in.CodeLength != 0 {
+		const prefix string = ",\"code_length\":"
+		out.RawString(prefix)
+		out.Int(int(in.CodeLength))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int(int(in.Count))
+	}
+	{
+		const prefix string = ",\"rules\":"
+		out.RawString(prefix)
+		(in.Rules).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
 }
-func easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(in *jlexer.Lexer, out *CheckPromoRequest) {
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreatePromoCampaignRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreatePromoCampaignRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreatePromoCampaignRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreatePromoCampaignRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(l, v)
+}
+func easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto8(in *jlexer.Lexer, out *CheckPromoRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -417,7 +1121,7 @@ func easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		in.Consumed()
 	}
 }
-func easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(out *jwriter.Writer, in CheckPromoRequest) {
+func easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto8(out *jwriter.Writer, in CheckPromoRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -432,23 +1136,23 @@ func easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 // MarshalJSON supports json.Marshaler interface
 func (v CheckPromoRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&w, v)
+	easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CheckPromoRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(w, v)
+	easyjson47107b8bEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CheckPromoRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&r, v)
+	easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CheckPromoRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(l, v)
+	easyjson47107b8bDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto8(l, v)
 }
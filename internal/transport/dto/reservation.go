@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/google/uuid"
+)
+
+// CheckoutRequest — товары, которые резервируются при начале оформления заказа
+type CheckoutRequest struct {
+	Items []CreateOrderItemDTO `json:"items"`
+}
+
+type ReservedItemResponse struct {
+	ProductID uuid.UUID  `json:"productID"`
+	VariantID *uuid.UUID `json:"variantID,omitempty"`
+	Quantity  uint       `json:"quantity"`
+}
+
+// CheckoutResponse — зарезервированные товары и время, до которого действует резерв
+type CheckoutResponse struct {
+	ExpiresAt time.Time              `json:"expiresAt"`
+	Items     []ReservedItemResponse `json:"items"`
+}
+
+func ConvertToCheckoutResponse(reservations []models.StockReservation, expiresAt time.Time) CheckoutResponse {
+	items := make([]ReservedItemResponse, 0, len(reservations))
+	for _, reservation := range reservations {
+		item := ReservedItemResponse{
+			ProductID: reservation.ProductID,
+			Quantity:  reservation.Quantity,
+		}
+		if reservation.VariantID.Valid {
+			variantID := reservation.VariantID.UUID
+			item.VariantID = &variantID
+		}
+		items = append(items, item)
+	}
+
+	return CheckoutResponse{
+		ExpiresAt: expiresAt,
+		Items:     items,
+	}
+}
@@ -1,6 +1,8 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/generated/review"
 	"github.com/google/uuid"
@@ -22,9 +24,15 @@ func ConvertAddReviewRequestToGRPC(dtoReq AddReviewRequest) *review.AddReviewReq
 	}
 }
 
+// AddReviewResponse сообщает, опубликован отзыв сразу или ушёл на модерацию
+type AddReviewResponse struct {
+	Status string `json:"status"`
+}
+
 type GetReviewRequest struct {
 	ProductID uuid.UUID `json:"productID" db:"review_id"`
 	Offset    int       `json:"offset"`
+	Sort      string    `json:"sort"`
 }
 
 // ConvertGetReviewRequestToGRPC преобразует dto.GetReviewRequest в gen.GetReviewsRequest
@@ -32,30 +40,79 @@ func ConvertGetReviewRequestToGRPC(dtoReq GetReviewRequest) *review.GetReviewsRe
 	return &review.GetReviewsRequest{
 		ProductId: dtoReq.ProductID.String(),
 		Offset:    int32(dtoReq.Offset),
+		Sort:      dtoReq.Sort,
 	}
 }
 
-type ReviewDTO struct {
-	ID       uuid.UUID `json:"id"`
-	Name     string    `json:"name"`
-	Surname  null.String    `json:"surname"`
-	ImageURL null.String    `json:"imageURL"`
+type UpdateReviewRequest struct {
+	ReviewID uuid.UUID `json:"-"`
 	Rating   int       `json:"rating"`
 	Comment  string    `json:"comment"`
 }
 
+// ConvertUpdateReviewRequestToGRPC преобразует dto.UpdateReviewRequest в gen.UpdateReviewRequest
+func ConvertUpdateReviewRequestToGRPC(dtoReq UpdateReviewRequest) *review.UpdateReviewRequest {
+	return &review.UpdateReviewRequest{
+		ReviewId: dtoReq.ReviewID.String(),
+		Rating:   int32(dtoReq.Rating),
+		Comment:  dtoReq.Comment,
+	}
+}
+
+type VoteReviewRequest struct {
+	Helpful bool `json:"helpful"`
+}
+
+type ReplyReviewRequest struct {
+	Text string `json:"text"`
+}
+
+type ReviewReplyDTO struct {
+	Text      string    `json:"text"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type ReviewDTO struct {
+	ID               uuid.UUID       `json:"id"`
+	Name             string          `json:"name"`
+	Surname          null.String     `json:"surname"`
+	ImageURL         null.String     `json:"imageURL"`
+	Rating           int             `json:"rating"`
+	Comment          string          `json:"comment"`
+	Photos           []string        `json:"photos"`
+	HelpfulCount     int             `json:"helpfulCount"`
+	UnhelpfulCount   int             `json:"unhelpfulCount"`
+	Reply            *ReviewReplyDTO `json:"reply,omitempty"`
+	CreatedAt        time.Time       `json:"createdAt"`
+	VerifiedPurchase bool            `json:"verifiedPurchase"`
+}
+
 type ReviewsResponse struct {
 	Reviews []ReviewDTO `json:"reviews"`
 }
 
 func ConvertToReviewDTO(review *models.Review) ReviewDTO {
+	var reply *ReviewReplyDTO
+	if review.Reply != nil {
+		reply = &ReviewReplyDTO{
+			Text:      review.Reply.Text,
+			CreatedAt: review.Reply.CreatedAt,
+		}
+	}
+
 	return ReviewDTO{
-		ID:            review.ID,
-		Name:          review.Name,
-		Surname:       review.Surname,
-		ImageURL:      review.ImageURL,
-		Rating:        review.Rating,
-		Comment:       review.Comment,
+		ID:               review.ID,
+		Name:             review.Name,
+		Surname:          review.Surname,
+		ImageURL:         review.ImageURL,
+		Rating:           review.Rating,
+		Comment:          review.Comment,
+		Photos:           review.Photos,
+		HelpfulCount:     review.HelpfulCount,
+		UnhelpfulCount:   review.UnhelpfulCount,
+		Reply:            reply,
+		CreatedAt:        review.CreatedAt,
+		VerifiedPurchase: review.VerifiedPurchase,
 	}
 }
 
@@ -72,11 +129,15 @@ func ConvertToReviewsResponse(reviews []*models.Review) ReviewsResponse {
 
 // ConvertReviewsResponseToProto преобразует dto.ReviewsResponse в gen.GetReviewsResponse
 func ModelsToGRPC(dtoResp []*models.Review) *review.GetReviewsResponse {
-	protoResp := &review.GetReviewsResponse{
-		Reviews: make([]*review.Review, 0, len(dtoResp)),
+	return &review.GetReviewsResponse{
+		Reviews: reviewsToGRPC(dtoResp),
 	}
+}
+
+func reviewsToGRPC(reviews []*models.Review) []*review.Review {
+	protoReviews := make([]*review.Review, 0, len(reviews))
 
-	for _, dtoReview := range dtoResp {
+	for _, dtoReview := range reviews {
 		var surname string
 		if dtoReview.Surname.Valid {
 			surname = dtoReview.Surname.String
@@ -87,27 +148,45 @@ func ModelsToGRPC(dtoResp []*models.Review) *review.GetReviewsResponse {
 			imageURL = dtoReview.ImageURL.String
 		}
 
+		var reply *review.SellerReply
+		if dtoReview.Reply != nil {
+			reply = &review.SellerReply{
+				Text:      dtoReview.Reply.Text,
+				CreatedAt: dtoReview.Reply.CreatedAt.Format(time.RFC3339),
+			}
+		}
+
 		protoReview := &review.Review{
-			Id:       dtoReview.ID.String(),
-			Name:     dtoReview.Name,
-			Surname:  surname,
-			ImageUrl: imageURL,
-			Rating:   int32(dtoReview.Rating),
-			Comment:  dtoReview.Comment,
+			Id:               dtoReview.ID.String(),
+			Name:             dtoReview.Name,
+			Surname:          surname,
+			ImageUrl:         imageURL,
+			Rating:           int32(dtoReview.Rating),
+			Comment:          dtoReview.Comment,
+			Photos:           dtoReview.Photos,
+			HelpfulCount:     int32(dtoReview.HelpfulCount),
+			UnhelpfulCount:   int32(dtoReview.UnhelpfulCount),
+			Reply:            reply,
+			CreatedAt:        dtoReview.CreatedAt.Format(time.RFC3339),
+			VerifiedPurchase: dtoReview.VerifiedPurchase,
 		}
-		protoResp.Reviews = append(protoResp.Reviews, protoReview)
+		protoReviews = append(protoReviews, protoReview)
 	}
 
-	return protoResp
+	return protoReviews
 }
 
 // ConvertProtoToReviewsResponse преобразует gen.GetReviewsResponse в dto.ReviewsResponse
 func ConvertGRPCToReviewsResponse(protoResp *review.GetReviewsResponse) ReviewsResponse {
-	dtoResp := ReviewsResponse{
-		Reviews: make([]ReviewDTO, 0, len(protoResp.GetReviews())),
+	return ReviewsResponse{
+		Reviews: reviewsFromGRPC(protoResp.GetReviews()),
 	}
+}
+
+func reviewsFromGRPC(protoReviews []*review.Review) []ReviewDTO {
+	dtoReviews := make([]ReviewDTO, 0, len(protoReviews))
 
-	for _, protoReview := range protoResp.GetReviews() {
+	for _, protoReview := range protoReviews {
 		id, _ := uuid.Parse(protoReview.GetId())
 
 		var surname null.String
@@ -119,17 +198,91 @@ func ConvertGRPCToReviewsResponse(protoResp *review.GetReviewsResponse) ReviewsR
 		if protoReview.ImageUrl != "" {
 			imageURL = null.StringFrom(protoReview.ImageUrl)
 		}
-		
+
+		var reply *ReviewReplyDTO
+		if protoReview.GetReply() != nil {
+			replyCreatedAt, _ := time.Parse(time.RFC3339, protoReview.GetReply().GetCreatedAt())
+			reply = &ReviewReplyDTO{
+				Text:      protoReview.GetReply().GetText(),
+				CreatedAt: replyCreatedAt,
+			}
+		}
+
+		photos := protoReview.GetPhotos()
+		if photos == nil {
+			photos = []string{}
+		}
+
+		createdAt, _ := time.Parse(time.RFC3339, protoReview.GetCreatedAt())
+
 		dtoReview := ReviewDTO{
-			ID:       id,
-			Name:     protoReview.GetName(),
-			Surname:  surname,
-			ImageURL: imageURL,
-			Rating:   int(protoReview.GetRating()),
-			Comment:  protoReview.GetComment(),
+			ID:               id,
+			Name:             protoReview.GetName(),
+			Surname:          surname,
+			ImageURL:         imageURL,
+			Rating:           int(protoReview.GetRating()),
+			Comment:          protoReview.GetComment(),
+			Photos:           photos,
+			HelpfulCount:     int(protoReview.GetHelpfulCount()),
+			UnhelpfulCount:   int(protoReview.GetUnhelpfulCount()),
+			Reply:            reply,
+			CreatedAt:        createdAt,
+			VerifiedPurchase: protoReview.GetVerifiedPurchase(),
+		}
+		dtoReviews = append(dtoReviews, dtoReview)
+	}
+
+	return dtoReviews
+}
+
+// ReviewSummaryResponse — распределение оценок и последние отзывы товара.
+// Stars содержит количество отзывов по каждой оценке от 1 до 5.
+type ReviewSummaryResponse struct {
+	ProductID       uuid.UUID   `json:"productID"`
+	Total           int         `json:"total"`
+	Stars           map[int]int `json:"stars"`
+	WithPhotosShare float64     `json:"withPhotosShare"`
+	VerifiedShare   float64     `json:"verifiedShare"`
+	Recent          []ReviewDTO `json:"recent"`
+}
+
+// ReviewSummaryToGRPC преобразует models.ReviewSummary в gen.ReviewSummary
+func ReviewSummaryToGRPC(summary *models.ReviewSummary) *review.ReviewSummary {
+	starCounts := make([]int32, 0, len(summary.StarCounts))
+	for _, count := range summary.StarCounts {
+		starCounts = append(starCounts, int32(count))
+	}
+
+	return &review.ReviewSummary{
+		ProductId:       summary.ProductID.String(),
+		Total:           int32(summary.Total),
+		StarCounts:      starCounts,
+		WithPhotosShare: summary.WithPhotosShare,
+		VerifiedShare:   summary.VerifiedShare,
+		Recent:          reviewsToGRPC(summary.Recent),
+	}
+}
+
+// ConvertGRPCToReviewSummaryResponse преобразует gen.ReviewSummary в dto.ReviewSummaryResponse
+func ConvertGRPCToReviewSummaryResponse(protoSummary *review.ReviewSummary) ReviewSummaryResponse {
+	productID, _ := uuid.Parse(protoSummary.GetProductId())
+
+	stars := make(map[int]int, 5)
+	for rating := 1; rating <= 5; rating++ {
+		stars[rating] = 0
+	}
+	for i, count := range protoSummary.GetStarCounts() {
+		if i < 5 {
+			stars[i+1] = int(count)
 		}
-		dtoResp.Reviews = append(dtoResp.Reviews, dtoReview)
 	}
 
-	return dtoResp
-}
\ No newline at end of file
+	return ReviewSummaryResponse{
+		ProductID:       productID,
+		Total:           int(protoSummary.GetTotal()),
+		Stars:           stars,
+		WithPhotosShare: protoSummary.GetWithPhotosShare(),
+		VerifiedShare:   protoSummary.GetVerifiedShare(),
+		Recent:          reviewsFromGRPC(protoSummary.GetRecent()),
+	}
+}
@@ -17,7 +17,151 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(in *jlexer.Lexer, out *ReviewsResponse) {
+func easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(in *jlexer.Lexer, out *VoteReviewRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "helpful":
+			out.Helpful = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(out *jwriter.Writer, in VoteReviewRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"helpful\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Helpful))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v VoteReviewRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v VoteReviewRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *VoteReviewRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *VoteReviewRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(l, v)
+}
+func easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(in *jlexer.Lexer, out *UpdateReviewRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "rating":
+			out.Rating = int(in.Int())
+		case "comment":
+			out.Comment = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(out *jwriter.Writer, in UpdateReviewRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"rating\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		out.Int(int(in.Rating))
+	}
+	{
+		const prefix string = ",\"comment\":"
+		out.RawString(prefix)
+		out.String(string(in.Comment))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UpdateReviewRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UpdateReviewRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UpdateReviewRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UpdateReviewRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(l, v)
+}
+func easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(in *jlexer.Lexer, out *ReviewsResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -69,7 +213,7 @@ func easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		in.Consumed()
 	}
 }
-func easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(out *jwriter.Writer, in ReviewsResponse) {
+func easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(out *jwriter.Writer, in ReviewsResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -95,27 +239,267 @@ func easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 // MarshalJSON supports json.Marshaler interface
 func (v ReviewsResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(&w, v)
+	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ReviewsResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(w, v)
+	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ReviewsResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(&r, v)
+	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ReviewsResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(l, v)
+	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(l, v)
 }
-func easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(in *jlexer.Lexer, out *ReviewDTO) {
+func easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(in *jlexer.Lexer, out *ReviewSummaryResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "productID":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ProductID).UnmarshalText(data))
+			}
+		case "total":
+			out.Total = int(in.Int())
+		case "stars":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				out.Stars = make(map[int]int)
+				for !in.IsDelim('}') {
+					key := int(in.IntStr())
+					in.WantColon()
+					var v4 int
+					v4 = int(in.Int())
+					(out.Stars)[key] = v4
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "withPhotosShare":
+			out.WithPhotosShare = float64(in.Float64())
+		case "verifiedShare":
+			out.VerifiedShare = float64(in.Float64())
+		case "recent":
+			if in.IsNull() {
+				in.Skip()
+				out.Recent = nil
+			} else {
+				in.Delim('[')
+				if out.Recent == nil {
+					if !in.IsDelim(']') {
+						out.Recent = make([]ReviewDTO, 0, 0)
+					} else {
+						out.Recent = []ReviewDTO{}
+					}
+				} else {
+					out.Recent = (out.Recent)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v5 ReviewDTO
+					(v5).UnmarshalEasyJSON(in)
+					out.Recent = append(out.Recent, v5)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(out *jwriter.Writer, in ReviewSummaryResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"productID\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ProductID).MarshalText())
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
+	}
+	{
+		const prefix string = ",\"stars\":"
+		out.RawString(prefix)
+		if in.Stars == nil && (out.Flags&jwriter.NilMapAsEmpty) == 0 {
+			out.RawString(`null`)
+		} else {
+			out.RawByte('{')
+			v6First := true
+			for v6Name, v6Value := range in.Stars {
+				if v6First {
+					v6First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.IntStr(int(v6Name))
+				out.RawByte(':')
+				out.Int(int(v6Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	{
+		const prefix string = ",\"withPhotosShare\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.WithPhotosShare))
+	}
+	{
+		const prefix string = ",\"verifiedShare\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.VerifiedShare))
+	}
+	{
+		const prefix string = ",\"recent\":"
+		out.RawString(prefix)
+		if in.Recent == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v7, v8 := range in.Recent {
+				if v7 > 0 {
+					out.RawByte(',')
+				}
+				(v8).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewSummaryResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewSummaryResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewSummaryResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewSummaryResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(l, v)
+}
+func easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(in *jlexer.Lexer, out *ReviewReplyDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "text":
+			out.Text = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(out *jwriter.Writer, in ReviewReplyDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewReplyDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewReplyDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewReplyDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewReplyDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(l, v)
+}
+func easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(in *jlexer.Lexer, out *ReviewDTO) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -152,6 +536,49 @@ func easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 			out.Rating = int(in.Int())
 		case "comment":
 			out.Comment = string(in.String())
+		case "photos":
+			if in.IsNull() {
+				in.Skip()
+				out.Photos = nil
+			} else {
+				in.Delim('[')
+				if out.Photos == nil {
+					if !in.IsDelim(']') {
+						out.Photos = make([]string, 0, 4)
+					} else {
+						out.Photos = []string{}
+					}
+				} else {
+					out.Photos = (out.Photos)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v9 string
+					v9 = string(in.String())
+					out.Photos = append(out.Photos, v9)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "helpfulCount":
+			out.HelpfulCount = int(in.Int())
+		case "unhelpfulCount":
+			out.UnhelpfulCount = int(in.Int())
+		case "reply":
+			if in.IsNull() {
+				in.Skip()
+				out.Reply = nil
+			} else {
+				if out.Reply == nil {
+					out.Reply = new(ReviewReplyDTO)
+				}
+				(*out.Reply).UnmarshalEasyJSON(in)
+			}
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "verifiedPurchase":
+			out.VerifiedPurchase = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -162,7 +589,7 @@ func easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		in.Consumed()
 	}
 }
-func easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(out *jwriter.Writer, in ReviewDTO) {
+func easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(out *jwriter.Writer, in ReviewDTO) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -196,33 +623,140 @@ func easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		out.RawString(prefix)
 		out.String(string(in.Comment))
 	}
+	{
+		const prefix string = ",\"photos\":"
+		out.RawString(prefix)
+		if in.Photos == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v10, v11 := range in.Photos {
+				if v10 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v11))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"helpfulCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.HelpfulCount))
+	}
+	{
+		const prefix string = ",\"unhelpfulCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.UnhelpfulCount))
+	}
+	if in.Reply != nil {
+		const prefix string = ",\"reply\":"
+		out.RawString(prefix)
+		(*in.Reply).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"verifiedPurchase\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.VerifiedPurchase))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v ReviewDTO) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&w, v)
+	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ReviewDTO) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(w, v)
+	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ReviewDTO) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&r, v)
+	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ReviewDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(l, v)
+	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto5(l, v)
+}
+func easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(in *jlexer.Lexer, out *ReplyReviewRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "text":
+			out.Text = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
 }
-func easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(in *jlexer.Lexer, out *GetReviewRequest) {
+func easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(out *jwriter.Writer, in ReplyReviewRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReplyReviewRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReplyReviewRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReplyReviewRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReplyReviewRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto6(l, v)
+}
+func easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(in *jlexer.Lexer, out *GetReviewRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -247,6 +781,8 @@ func easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 			}
 		case "offset":
 			out.Offset = int(in.Int())
+		case "sort":
+			out.Sort = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -257,7 +793,7 @@ func easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		in.Consumed()
 	}
 }
-func easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(out *jwriter.Writer, in GetReviewRequest) {
+func easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(out *jwriter.Writer, in GetReviewRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -271,33 +807,104 @@ func easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		out.RawString(prefix)
 		out.Int(int(in.Offset))
 	}
+	{
+		const prefix string = ",\"sort\":"
+		out.RawString(prefix)
+		out.String(string(in.Sort))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v GetReviewRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&w, v)
+	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v GetReviewRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(w, v)
+	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *GetReviewRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&r, v)
+	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *GetReviewRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(l, v)
+	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto7(l, v)
+}
+func easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto8(in *jlexer.Lexer, out *AddReviewResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
 }
-func easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(in *jlexer.Lexer, out *AddReviewRequest) {
+func easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto8(out *jwriter.Writer, in AddReviewResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AddReviewResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AddReviewResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AddReviewResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AddReviewResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto8(l, v)
+}
+func easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto9(in *jlexer.Lexer, out *AddReviewRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -334,7 +941,7 @@ func easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		in.Consumed()
 	}
 }
-func easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(out *jwriter.Writer, in AddReviewRequest) {
+func easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto9(out *jwriter.Writer, in AddReviewRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -359,23 +966,23 @@ func easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 // MarshalJSON supports json.Marshaler interface
 func (v AddReviewRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&w, v)
+	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AddReviewRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(w, v)
+	easyjson2f096870EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AddReviewRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&r, v)
+	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AddReviewRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(l, v)
+	easyjson2f096870DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto9(l, v)
 }
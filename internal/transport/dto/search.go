@@ -1,15 +1,33 @@
 package dto
 
 import (
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/guregu/null"
 )
 
 type SearchReq struct {
-	CategoryID null.String `json:"category_id"`
-	SubString  string      `json:"sub_string"`
+	CategoryID null.String          `json:"category_id"`
+	SubString  string               `json:"sub_string"`
+	Attributes []AttributeFilterDTO `json:"attributes,omitempty"`
 }
 
 type SearchResponse struct {
-	Categories CategoryResponse `json:"categories"`
-	Products   ProductsResponse `json:"products"`
+	Categories CategoryResponse    `json:"categories"`
+	Products   ProductsResponse    `json:"products"`
+	Facets     []AttributeFacetDTO `json:"facets"`
+}
+
+// ConvertToAttributeFilters переводит фильтры запроса в условия поиска
+func ConvertToAttributeFilters(filters []AttributeFilterDTO) []models.AttributeFilter {
+	res := make([]models.AttributeFilter, 0, len(filters))
+	for _, f := range filters {
+		res = append(res, models.AttributeFilter{
+			AttributeID: f.AttributeID,
+			Values:      f.Values,
+			Min:         f.Min,
+			Max:         f.Max,
+			Bool:        f.Bool,
+		})
+	}
+	return res
 }
@@ -40,6 +40,29 @@ func easyjsonD4176298DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 			(out.Categories).UnmarshalEasyJSON(in)
 		case "products":
 			(out.Products).UnmarshalEasyJSON(in)
+		case "facets":
+			if in.IsNull() {
+				in.Skip()
+				out.Facets = nil
+			} else {
+				in.Delim('[')
+				if out.Facets == nil {
+					if !in.IsDelim(']') {
+						out.Facets = make([]AttributeFacetDTO, 0, 0)
+					} else {
+						out.Facets = []AttributeFacetDTO{}
+					}
+				} else {
+					out.Facets = (out.Facets)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 AttributeFacetDTO
+					(v1).UnmarshalEasyJSON(in)
+					out.Facets = append(out.Facets, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -64,6 +87,22 @@ func easyjsonD4176298EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		out.RawString(prefix)
 		(in.Products).MarshalEasyJSON(out)
 	}
+	{
+		const prefix string = ",\"facets\":"
+		out.RawString(prefix)
+		if in.Facets == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Facets {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
 	out.RawByte('}')
 }
 
@@ -115,6 +154,29 @@ func easyjsonD4176298DecodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 			}
 		case "sub_string":
 			out.SubString = string(in.String())
+		case "attributes":
+			if in.IsNull() {
+				in.Skip()
+				out.Attributes = nil
+			} else {
+				in.Delim('[')
+				if out.Attributes == nil {
+					if !in.IsDelim(']') {
+						out.Attributes = make([]AttributeFilterDTO, 0, 0)
+					} else {
+						out.Attributes = []AttributeFilterDTO{}
+					}
+				} else {
+					out.Attributes = (out.Attributes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 AttributeFilterDTO
+					(v4).UnmarshalEasyJSON(in)
+					out.Attributes = append(out.Attributes, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -139,6 +201,20 @@ func easyjsonD4176298EncodeGithubComGoParkMailRu20251ChillGuysInternalTransportD
 		out.RawString(prefix)
 		out.String(string(in.SubString))
 	}
+	if len(in.Attributes) != 0 {
+		const prefix string = ",\"attributes\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.Attributes {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
 	out.RawByte('}')
 }
 
@@ -0,0 +1,19 @@
+package dto
+
+import "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+
+// AddVariantRequest — новый SKU товара. Набор опций у всех SKU товара одинаковый
+type AddVariantRequest struct {
+	SKU      string                `json:"sku"`
+	Options  models.VariantOptions `json:"options"`
+	Price    models.Money          `json:"price"`
+	Quantity uint                  `json:"quantity"`
+	Images   []string              `json:"images,omitempty"`
+}
+
+// UpdateVariantRequest — новые цена, остаток и изображения SKU; артикул и опции не меняются
+type UpdateVariantRequest struct {
+	Price    models.Money `json:"price"`
+	Quantity uint         `json:"quantity"`
+	Images   []string     `json:"images,omitempty"`
+}
@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+type WarehouseDTO struct {
+	ID            uuid.UUID   `json:"id"`
+	Name          string      `json:"name"`
+	AddressID     uuid.UUID   `json:"addressID"`
+	Region        null.String `json:"region" swaggertype:"primitive,string"`
+	City          null.String `json:"city" swaggertype:"primitive,string"`
+	AddressString null.String `json:"addressString" swaggertype:"primitive,string"`
+	Coordinate    null.String `json:"coordinate" swaggertype:"primitive,string"`
+	CreatedAt     time.Time   `json:"createdAt"`
+}
+
+// CreateWarehouseRequest — новый склад; координата в формате "широта,долгота"
+type CreateWarehouseRequest struct {
+	Name          string      `json:"name"`
+	Region        null.String `json:"region" swaggertype:"primitive,string"`
+	City          null.String `json:"city" swaggertype:"primitive,string"`
+	AddressString string      `json:"addressString"`
+	Coordinate    string      `json:"coordinate"`
+}
+
+// AssignWarehouseStaffRequest прикрепляет работника к складу; null открепляет его
+type AssignWarehouseStaffRequest struct {
+	WarehouseID *uuid.UUID `json:"warehouseID"`
+}
+
+// SetWarehouseStockRequest задаёт остаток товара на складе работника.
+// Для товара с вариантами указывается SKU (variantID).
+type SetWarehouseStockRequest struct {
+	ProductID uuid.UUID  `json:"productID"`
+	VariantID *uuid.UUID `json:"variantID,omitempty"`
+	Quantity  int        `json:"quantity"`
+}
+
+type RouteOrderResponse struct {
+	WarehouseID uuid.UUID `json:"warehouseID"`
+}
+
+func ConvertToWarehouseDTO(warehouse models.Warehouse) WarehouseDTO {
+	return WarehouseDTO{
+		ID:            warehouse.ID,
+		Name:          warehouse.Name,
+		AddressID:     warehouse.Address.ID,
+		Region:        warehouse.Address.Region,
+		City:          warehouse.Address.City,
+		AddressString: warehouse.Address.AddressString,
+		Coordinate:    warehouse.Address.Coordinate,
+		CreatedAt:     warehouse.CreatedAt,
+	}
+}
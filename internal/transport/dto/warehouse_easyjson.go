@@ -0,0 +1,487 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package dto
+
+import (
+	json "encoding/json"
+	uuid "github.com/google/uuid"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson2acc2aabDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(in *jlexer.Lexer, out *WarehouseDTO) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ID).UnmarshalText(data))
+			}
+		case "name":
+			out.Name = string(in.String())
+		case "addressID":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.AddressID).UnmarshalText(data))
+			}
+		case "region":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Region).UnmarshalJSON(data))
+			}
+		case "city":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.City).UnmarshalJSON(data))
+			}
+		case "addressString":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.AddressString).UnmarshalJSON(data))
+			}
+		case "coordinate":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Coordinate).UnmarshalJSON(data))
+			}
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2acc2aabEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(out *jwriter.Writer, in WarehouseDTO) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ID).MarshalText())
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"addressID\":"
+		out.RawString(prefix)
+		out.RawText((in.AddressID).MarshalText())
+	}
+	{
+		const prefix string = ",\"region\":"
+		out.RawString(prefix)
+		out.Raw((in.Region).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		out.Raw((in.City).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"addressString\":"
+		out.RawString(prefix)
+		out.Raw((in.AddressString).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"coordinate\":"
+		out.RawString(prefix)
+		out.Raw((in.Coordinate).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WarehouseDTO) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2acc2aabEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WarehouseDTO) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2acc2aabEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WarehouseDTO) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2acc2aabDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WarehouseDTO) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2acc2aabDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto(l, v)
+}
+func easyjson2acc2aabDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(in *jlexer.Lexer, out *SetWarehouseStockRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "productID":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.ProductID).UnmarshalText(data))
+			}
+		case "variantID":
+			if in.IsNull() {
+				in.Skip()
+				out.VariantID = nil
+			} else {
+				if out.VariantID == nil {
+					out.VariantID = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.VariantID).UnmarshalText(data))
+				}
+			}
+		case "quantity":
+			out.Quantity = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2acc2aabEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(out *jwriter.Writer, in SetWarehouseStockRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"productID\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.ProductID).MarshalText())
+	}
+	if in.VariantID != nil {
+		const prefix string = ",\"variantID\":"
+		out.RawString(prefix)
+		out.RawText((*in.VariantID).MarshalText())
+	}
+	{
+		const prefix string = ",\"quantity\":"
+		out.RawString(prefix)
+		out.Int(int(in.Quantity))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SetWarehouseStockRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2acc2aabEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SetWarehouseStockRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2acc2aabEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SetWarehouseStockRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2acc2aabDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SetWarehouseStockRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2acc2aabDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto1(l, v)
+}
+func easyjson2acc2aabDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(in *jlexer.Lexer, out *RouteOrderResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "warehouseID":
+			if data := in.UnsafeBytes(); in.Ok() {
+				in.AddError((out.WarehouseID).UnmarshalText(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2acc2aabEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(out *jwriter.Writer, in RouteOrderResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"warehouseID\":"
+		out.RawString(prefix[1:])
+		out.RawText((in.WarehouseID).MarshalText())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RouteOrderResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2acc2aabEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RouteOrderResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2acc2aabEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RouteOrderResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2acc2aabDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RouteOrderResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2acc2aabDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto2(l, v)
+}
+func easyjson2acc2aabDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(in *jlexer.Lexer, out *CreateWarehouseRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "region":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Region).UnmarshalJSON(data))
+			}
+		case "city":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.City).UnmarshalJSON(data))
+			}
+		case "addressString":
+			out.AddressString = string(in.String())
+		case "coordinate":
+			out.Coordinate = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2acc2aabEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(out *jwriter.Writer, in CreateWarehouseRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"region\":"
+		out.RawString(prefix)
+		out.Raw((in.Region).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix)
+		out.Raw((in.City).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"addressString\":"
+		out.RawString(prefix)
+		out.String(string(in.AddressString))
+	}
+	{
+		const prefix string = ",\"coordinate\":"
+		out.RawString(prefix)
+		out.String(string(in.Coordinate))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreateWarehouseRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2acc2aabEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreateWarehouseRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2acc2aabEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreateWarehouseRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2acc2aabDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreateWarehouseRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2acc2aabDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto3(l, v)
+}
+func easyjson2acc2aabDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(in *jlexer.Lexer, out *AssignWarehouseStaffRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "warehouseID":
+			if in.IsNull() {
+				in.Skip()
+				out.WarehouseID = nil
+			} else {
+				if out.WarehouseID == nil {
+					out.WarehouseID = new(uuid.UUID)
+				}
+				if data := in.UnsafeBytes(); in.Ok() {
+					in.AddError((*out.WarehouseID).UnmarshalText(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2acc2aabEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(out *jwriter.Writer, in AssignWarehouseStaffRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"warehouseID\":"
+		out.RawString(prefix[1:])
+		if in.WarehouseID == nil {
+			out.RawString("null")
+		} else {
+			out.RawText((*in.WarehouseID).MarshalText())
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AssignWarehouseStaffRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2acc2aabEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AssignWarehouseStaffRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2acc2aabEncodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AssignWarehouseStaffRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2acc2aabDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AssignWarehouseStaffRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2acc2aabDecodeGithubComGoParkMailRu20251ChillGuysInternalTransportDto4(l, v)
+}
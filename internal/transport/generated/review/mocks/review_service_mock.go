@@ -37,14 +37,14 @@ func (m *MockReviewServiceClient) EXPECT() *MockReviewServiceClientMockRecorder
 }
 
 // AddReview mocks base method.
-func (m *MockReviewServiceClient) AddReview(ctx context.Context, in *review.AddReviewRequest, opts ...grpc.CallOption) (*review.EmptyResponse, error) {
+func (m *MockReviewServiceClient) AddReview(ctx context.Context, in *review.AddReviewRequest, opts ...grpc.CallOption) (*review.AddReviewResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "AddReview", varargs...)
-	ret0, _ := ret[0].(*review.EmptyResponse)
+	ret0, _ := ret[0].(*review.AddReviewResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -56,6 +56,66 @@ func (mr *MockReviewServiceClientMockRecorder) AddReview(ctx, in interface{}, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReview", reflect.TypeOf((*MockReviewServiceClient)(nil).AddReview), varargs...)
 }
 
+// AddReviewPhoto mocks base method.
+func (m *MockReviewServiceClient) AddReviewPhoto(ctx context.Context, in *review.AddReviewPhotoRequest, opts ...grpc.CallOption) (*review.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddReviewPhoto", varargs...)
+	ret0, _ := ret[0].(*review.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReviewPhoto indicates an expected call of AddReviewPhoto.
+func (mr *MockReviewServiceClientMockRecorder) AddReviewPhoto(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReviewPhoto", reflect.TypeOf((*MockReviewServiceClient)(nil).AddReviewPhoto), varargs...)
+}
+
+// DeleteReview mocks base method.
+func (m *MockReviewServiceClient) DeleteReview(ctx context.Context, in *review.DeleteReviewRequest, opts ...grpc.CallOption) (*review.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteReview", varargs...)
+	ret0, _ := ret[0].(*review.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReview indicates an expected call of DeleteReview.
+func (mr *MockReviewServiceClientMockRecorder) DeleteReview(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReview", reflect.TypeOf((*MockReviewServiceClient)(nil).DeleteReview), varargs...)
+}
+
+// GetReviewSummary mocks base method.
+func (m *MockReviewServiceClient) GetReviewSummary(ctx context.Context, in *review.GetReviewSummaryRequest, opts ...grpc.CallOption) (*review.ReviewSummary, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReviewSummary", varargs...)
+	ret0, _ := ret[0].(*review.ReviewSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewSummary indicates an expected call of GetReviewSummary.
+func (mr *MockReviewServiceClientMockRecorder) GetReviewSummary(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewSummary", reflect.TypeOf((*MockReviewServiceClient)(nil).GetReviewSummary), varargs...)
+}
+
 // GetReviews mocks base method.
 func (m *MockReviewServiceClient) GetReviews(ctx context.Context, in *review.GetReviewsRequest, opts ...grpc.CallOption) (*review.GetReviewsResponse, error) {
 	m.ctrl.T.Helper()
@@ -76,6 +136,66 @@ func (mr *MockReviewServiceClientMockRecorder) GetReviews(ctx, in interface{}, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviews", reflect.TypeOf((*MockReviewServiceClient)(nil).GetReviews), varargs...)
 }
 
+// ReplyReview mocks base method.
+func (m *MockReviewServiceClient) ReplyReview(ctx context.Context, in *review.ReplyReviewRequest, opts ...grpc.CallOption) (*review.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReplyReview", varargs...)
+	ret0, _ := ret[0].(*review.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplyReview indicates an expected call of ReplyReview.
+func (mr *MockReviewServiceClientMockRecorder) ReplyReview(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplyReview", reflect.TypeOf((*MockReviewServiceClient)(nil).ReplyReview), varargs...)
+}
+
+// UpdateReview mocks base method.
+func (m *MockReviewServiceClient) UpdateReview(ctx context.Context, in *review.UpdateReviewRequest, opts ...grpc.CallOption) (*review.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateReview", varargs...)
+	ret0, _ := ret[0].(*review.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReview indicates an expected call of UpdateReview.
+func (mr *MockReviewServiceClientMockRecorder) UpdateReview(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockReviewServiceClient)(nil).UpdateReview), varargs...)
+}
+
+// VoteReview mocks base method.
+func (m *MockReviewServiceClient) VoteReview(ctx context.Context, in *review.VoteReviewRequest, opts ...grpc.CallOption) (*review.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VoteReview", varargs...)
+	ret0, _ := ret[0].(*review.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VoteReview indicates an expected call of VoteReview.
+func (mr *MockReviewServiceClientMockRecorder) VoteReview(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoteReview", reflect.TypeOf((*MockReviewServiceClient)(nil).VoteReview), varargs...)
+}
+
 // MockReviewServiceServer is a mock of ReviewServiceServer interface.
 type MockReviewServiceServer struct {
 	ctrl     *gomock.Controller
@@ -100,10 +220,10 @@ func (m *MockReviewServiceServer) EXPECT() *MockReviewServiceServerMockRecorder
 }
 
 // AddReview mocks base method.
-func (m *MockReviewServiceServer) AddReview(arg0 context.Context, arg1 *review.AddReviewRequest) (*review.EmptyResponse, error) {
+func (m *MockReviewServiceServer) AddReview(arg0 context.Context, arg1 *review.AddReviewRequest) (*review.AddReviewResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AddReview", arg0, arg1)
-	ret0, _ := ret[0].(*review.EmptyResponse)
+	ret0, _ := ret[0].(*review.AddReviewResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -114,6 +234,51 @@ func (mr *MockReviewServiceServerMockRecorder) AddReview(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReview", reflect.TypeOf((*MockReviewServiceServer)(nil).AddReview), arg0, arg1)
 }
 
+// AddReviewPhoto mocks base method.
+func (m *MockReviewServiceServer) AddReviewPhoto(arg0 context.Context, arg1 *review.AddReviewPhotoRequest) (*review.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReviewPhoto", arg0, arg1)
+	ret0, _ := ret[0].(*review.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReviewPhoto indicates an expected call of AddReviewPhoto.
+func (mr *MockReviewServiceServerMockRecorder) AddReviewPhoto(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReviewPhoto", reflect.TypeOf((*MockReviewServiceServer)(nil).AddReviewPhoto), arg0, arg1)
+}
+
+// DeleteReview mocks base method.
+func (m *MockReviewServiceServer) DeleteReview(arg0 context.Context, arg1 *review.DeleteReviewRequest) (*review.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReview", arg0, arg1)
+	ret0, _ := ret[0].(*review.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReview indicates an expected call of DeleteReview.
+func (mr *MockReviewServiceServerMockRecorder) DeleteReview(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReview", reflect.TypeOf((*MockReviewServiceServer)(nil).DeleteReview), arg0, arg1)
+}
+
+// GetReviewSummary mocks base method.
+func (m *MockReviewServiceServer) GetReviewSummary(arg0 context.Context, arg1 *review.GetReviewSummaryRequest) (*review.ReviewSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewSummary", arg0, arg1)
+	ret0, _ := ret[0].(*review.ReviewSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewSummary indicates an expected call of GetReviewSummary.
+func (mr *MockReviewServiceServerMockRecorder) GetReviewSummary(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewSummary", reflect.TypeOf((*MockReviewServiceServer)(nil).GetReviewSummary), arg0, arg1)
+}
+
 // GetReviews mocks base method.
 func (m *MockReviewServiceServer) GetReviews(arg0 context.Context, arg1 *review.GetReviewsRequest) (*review.GetReviewsResponse, error) {
 	m.ctrl.T.Helper()
@@ -129,6 +294,51 @@ func (mr *MockReviewServiceServerMockRecorder) GetReviews(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviews", reflect.TypeOf((*MockReviewServiceServer)(nil).GetReviews), arg0, arg1)
 }
 
+// ReplyReview mocks base method.
+func (m *MockReviewServiceServer) ReplyReview(arg0 context.Context, arg1 *review.ReplyReviewRequest) (*review.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplyReview", arg0, arg1)
+	ret0, _ := ret[0].(*review.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplyReview indicates an expected call of ReplyReview.
+func (mr *MockReviewServiceServerMockRecorder) ReplyReview(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplyReview", reflect.TypeOf((*MockReviewServiceServer)(nil).ReplyReview), arg0, arg1)
+}
+
+// UpdateReview mocks base method.
+func (m *MockReviewServiceServer) UpdateReview(arg0 context.Context, arg1 *review.UpdateReviewRequest) (*review.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReview", arg0, arg1)
+	ret0, _ := ret[0].(*review.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReview indicates an expected call of UpdateReview.
+func (mr *MockReviewServiceServerMockRecorder) UpdateReview(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockReviewServiceServer)(nil).UpdateReview), arg0, arg1)
+}
+
+// VoteReview mocks base method.
+func (m *MockReviewServiceServer) VoteReview(arg0 context.Context, arg1 *review.VoteReviewRequest) (*review.EmptyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoteReview", arg0, arg1)
+	ret0, _ := ret[0].(*review.EmptyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VoteReview indicates an expected call of VoteReview.
+func (mr *MockReviewServiceServerMockRecorder) VoteReview(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoteReview", reflect.TypeOf((*MockReviewServiceServer)(nil).VoteReview), arg0, arg1)
+}
+
 // mustEmbedUnimplementedReviewServiceServer mocks base method.
 func (m *MockReviewServiceServer) mustEmbedUnimplementedReviewServiceServer() {
 	m.ctrl.T.Helper()
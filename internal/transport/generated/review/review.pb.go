@@ -117,17 +117,62 @@ func (x *AddReviewRequest) GetComment() string {
 	return ""
 }
 
+type AddReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddReviewResponse) Reset() {
+	*x = AddReviewResponse{}
+	mi := &file_review_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReviewResponse) ProtoMessage() {}
+
+func (x *AddReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReviewResponse.ProtoReflect.Descriptor instead.
+func (*AddReviewResponse) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddReviewResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
 type GetReviewsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Sort          string                 `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetReviewsRequest) Reset() {
 	*x = GetReviewsRequest{}
-	mi := &file_review_proto_msgTypes[2]
+	mi := &file_review_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -139,7 +184,7 @@ func (x *GetReviewsRequest) String() string {
 func (*GetReviewsRequest) ProtoMessage() {}
 
 func (x *GetReviewsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_review_proto_msgTypes[2]
+	mi := &file_review_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -152,7 +197,7 @@ func (x *GetReviewsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReviewsRequest.ProtoReflect.Descriptor instead.
 func (*GetReviewsRequest) Descriptor() ([]byte, []int) {
-	return file_review_proto_rawDescGZIP(), []int{2}
+	return file_review_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetReviewsRequest) GetProductId() string {
@@ -169,21 +214,346 @@ func (x *GetReviewsRequest) GetOffset() int32 {
 	return 0
 }
 
-type Review struct {
+func (x *GetReviewsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type UpdateReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReviewRequest) Reset() {
+	*x = UpdateReviewRequest{}
+	mi := &file_review_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReviewRequest) ProtoMessage() {}
+
+func (x *UpdateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReviewRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateReviewRequest) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+func (x *UpdateReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *UpdateReviewRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type DeleteReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReviewRequest) Reset() {
+	*x = DeleteReviewRequest{}
+	mi := &file_review_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReviewRequest) ProtoMessage() {}
+
+func (x *DeleteReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReviewRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReviewRequest) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteReviewRequest) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+type AddReviewPhotoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,2,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddReviewPhotoRequest) Reset() {
+	*x = AddReviewPhotoRequest{}
+	mi := &file_review_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddReviewPhotoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddReviewPhotoRequest) ProtoMessage() {}
+
+func (x *AddReviewPhotoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddReviewPhotoRequest.ProtoReflect.Descriptor instead.
+func (*AddReviewPhotoRequest) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AddReviewPhotoRequest) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+func (x *AddReviewPhotoRequest) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+type VoteReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Helpful       bool                   `protobuf:"varint,2,opt,name=helpful,proto3" json:"helpful,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoteReviewRequest) Reset() {
+	*x = VoteReviewRequest{}
+	mi := &file_review_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteReviewRequest) ProtoMessage() {}
+
+func (x *VoteReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteReviewRequest.ProtoReflect.Descriptor instead.
+func (*VoteReviewRequest) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *VoteReviewRequest) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+func (x *VoteReviewRequest) GetHelpful() bool {
+	if x != nil {
+		return x.Helpful
+	}
+	return false
+}
+
+type ReplyReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewId      string                 `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplyReviewRequest) Reset() {
+	*x = ReplyReviewRequest{}
+	mi := &file_review_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplyReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyReviewRequest) ProtoMessage() {}
+
+func (x *ReplyReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyReviewRequest.ProtoReflect.Descriptor instead.
+func (*ReplyReviewRequest) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReplyReviewRequest) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+func (x *ReplyReviewRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type SellerReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Surname       string                 `protobuf:"bytes,3,opt,name=surname,proto3" json:"surname,omitempty"`
-	ImageUrl      string                 `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	Rating        int32                  `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"`
-	Comment       string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *SellerReply) Reset() {
+	*x = SellerReply{}
+	mi := &file_review_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SellerReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SellerReply) ProtoMessage() {}
+
+func (x *SellerReply) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SellerReply.ProtoReflect.Descriptor instead.
+func (*SellerReply) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SellerReply) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SellerReply) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type Review struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Surname          string                 `protobuf:"bytes,3,opt,name=surname,proto3" json:"surname,omitempty"`
+	ImageUrl         string                 `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Rating           int32                  `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"`
+	Comment          string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	Photos           []string               `protobuf:"bytes,7,rep,name=photos,proto3" json:"photos,omitempty"`
+	HelpfulCount     int32                  `protobuf:"varint,8,opt,name=helpful_count,json=helpfulCount,proto3" json:"helpful_count,omitempty"`
+	UnhelpfulCount   int32                  `protobuf:"varint,9,opt,name=unhelpful_count,json=unhelpfulCount,proto3" json:"unhelpful_count,omitempty"`
+	Reply            *SellerReply           `protobuf:"bytes,10,opt,name=reply,proto3" json:"reply,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	VerifiedPurchase bool                   `protobuf:"varint,12,opt,name=verified_purchase,json=verifiedPurchase,proto3" json:"verified_purchase,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
 func (x *Review) Reset() {
 	*x = Review{}
-	mi := &file_review_proto_msgTypes[3]
+	mi := &file_review_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -195,7 +565,7 @@ func (x *Review) String() string {
 func (*Review) ProtoMessage() {}
 
 func (x *Review) ProtoReflect() protoreflect.Message {
-	mi := &file_review_proto_msgTypes[3]
+	mi := &file_review_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -208,7 +578,7 @@ func (x *Review) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Review.ProtoReflect.Descriptor instead.
 func (*Review) Descriptor() ([]byte, []int) {
-	return file_review_proto_rawDescGZIP(), []int{3}
+	return file_review_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Review) GetId() string {
@@ -253,6 +623,48 @@ func (x *Review) GetComment() string {
 	return ""
 }
 
+func (x *Review) GetPhotos() []string {
+	if x != nil {
+		return x.Photos
+	}
+	return nil
+}
+
+func (x *Review) GetHelpfulCount() int32 {
+	if x != nil {
+		return x.HelpfulCount
+	}
+	return 0
+}
+
+func (x *Review) GetUnhelpfulCount() int32 {
+	if x != nil {
+		return x.UnhelpfulCount
+	}
+	return 0
+}
+
+func (x *Review) GetReply() *SellerReply {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+func (x *Review) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Review) GetVerifiedPurchase() bool {
+	if x != nil {
+		return x.VerifiedPurchase
+	}
+	return false
+}
+
 type GetReviewsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Reviews       []*Review              `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
@@ -262,7 +674,7 @@ type GetReviewsResponse struct {
 
 func (x *GetReviewsResponse) Reset() {
 	*x = GetReviewsResponse{}
-	mi := &file_review_proto_msgTypes[4]
+	mi := &file_review_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -274,7 +686,7 @@ func (x *GetReviewsResponse) String() string {
 func (*GetReviewsResponse) ProtoMessage() {}
 
 func (x *GetReviewsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_review_proto_msgTypes[4]
+	mi := &file_review_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -287,7 +699,7 @@ func (x *GetReviewsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReviewsResponse.ProtoReflect.Descriptor instead.
 func (*GetReviewsResponse) Descriptor() ([]byte, []int) {
-	return file_review_proto_rawDescGZIP(), []int{4}
+	return file_review_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetReviewsResponse) GetReviews() []*Review {
@@ -297,6 +709,135 @@ func (x *GetReviewsResponse) GetReviews() []*Review {
 	return nil
 }
 
+type GetReviewSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewSummaryRequest) Reset() {
+	*x = GetReviewSummaryRequest{}
+	mi := &file_review_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewSummaryRequest) ProtoMessage() {}
+
+func (x *GetReviewSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetReviewSummaryRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type ReviewSummary struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Total     int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// star_counts[i] — количество отзывов с оценкой i+1
+	StarCounts      []int32   `protobuf:"varint,3,rep,packed,name=star_counts,json=starCounts,proto3" json:"star_counts,omitempty"`
+	WithPhotosShare float64   `protobuf:"fixed64,4,opt,name=with_photos_share,json=withPhotosShare,proto3" json:"with_photos_share,omitempty"`
+	VerifiedShare   float64   `protobuf:"fixed64,5,opt,name=verified_share,json=verifiedShare,proto3" json:"verified_share,omitempty"`
+	Recent          []*Review `protobuf:"bytes,6,rep,name=recent,proto3" json:"recent,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReviewSummary) Reset() {
+	*x = ReviewSummary{}
+	mi := &file_review_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewSummary) ProtoMessage() {}
+
+func (x *ReviewSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_review_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewSummary.ProtoReflect.Descriptor instead.
+func (*ReviewSummary) Descriptor() ([]byte, []int) {
+	return file_review_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReviewSummary) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ReviewSummary) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ReviewSummary) GetStarCounts() []int32 {
+	if x != nil {
+		return x.StarCounts
+	}
+	return nil
+}
+
+func (x *ReviewSummary) GetWithPhotosShare() float64 {
+	if x != nil {
+		return x.WithPhotosShare
+	}
+	return 0
+}
+
+func (x *ReviewSummary) GetVerifiedShare() float64 {
+	if x != nil {
+		return x.VerifiedShare
+	}
+	return 0
+}
+
+func (x *ReviewSummary) GetRecent() []*Review {
+	if x != nil {
+		return x.Recent
+	}
+	return nil
+}
+
 var File_review_proto protoreflect.FileDescriptor
 
 const file_review_proto_rawDesc = "" +
@@ -307,24 +848,73 @@ const file_review_proto_rawDesc = "" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x16\n" +
 	"\x06rating\x18\x02 \x01(\x05R\x06rating\x12\x18\n" +
-	"\acomment\x18\x03 \x01(\tR\acomment\"J\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\"+\n" +
+	"\x11AddReviewResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"^\n" +
 	"\x11GetReviewsRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x05R\x06offset\"\x95\x01\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\tR\x04sort\"d\n" +
+	"\x13UpdateReviewRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\tR\breviewId\x12\x16\n" +
+	"\x06rating\x18\x02 \x01(\x05R\x06rating\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\"2\n" +
+	"\x13DeleteReviewRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\tR\breviewId\"Q\n" +
+	"\x15AddReviewPhotoRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\tR\breviewId\x12\x1b\n" +
+	"\timage_url\x18\x02 \x01(\tR\bimageUrl\"J\n" +
+	"\x11VoteReviewRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\tR\breviewId\x12\x18\n" +
+	"\ahelpful\x18\x02 \x01(\bR\ahelpful\"E\n" +
+	"\x12ReplyReviewRequest\x12\x1b\n" +
+	"\treview_id\x18\x01 \x01(\tR\breviewId\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"@\n" +
+	"\vSellerReply\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\"\xf2\x02\n" +
 	"\x06Review\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
 	"\asurname\x18\x03 \x01(\tR\asurname\x12\x1b\n" +
 	"\timage_url\x18\x04 \x01(\tR\bimageUrl\x12\x16\n" +
 	"\x06rating\x18\x05 \x01(\x05R\x06rating\x12\x18\n" +
-	"\acomment\x18\x06 \x01(\tR\acomment\">\n" +
+	"\acomment\x18\x06 \x01(\tR\acomment\x12\x16\n" +
+	"\x06photos\x18\a \x03(\tR\x06photos\x12#\n" +
+	"\rhelpful_count\x18\b \x01(\x05R\fhelpfulCount\x12'\n" +
+	"\x0funhelpful_count\x18\t \x01(\x05R\x0eunhelpfulCount\x12)\n" +
+	"\x05reply\x18\n" +
+	" \x01(\v2\x13.review.SellerReplyR\x05reply\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12+\n" +
+	"\x11verified_purchase\x18\f \x01(\bR\x10verifiedPurchase\">\n" +
 	"\x12GetReviewsResponse\x12(\n" +
-	"\areviews\x18\x01 \x03(\v2\x0e.review.ReviewR\areviews2\x92\x01\n" +
-	"\rReviewService\x12<\n" +
-	"\tAddReview\x12\x18.review.AddReviewRequest\x1a\x15.review.EmptyResponse\x12C\n" +
+	"\areviews\x18\x01 \x03(\v2\x0e.review.ReviewR\areviews\"8\n" +
+	"\x17GetReviewSummaryRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"\xe0\x01\n" +
+	"\rReviewSummary\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x1f\n" +
+	"\vstar_counts\x18\x03 \x03(\x05R\n" +
+	"starCounts\x12*\n" +
+	"\x11with_photos_share\x18\x04 \x01(\x01R\x0fwithPhotosShare\x12%\n" +
+	"\x0everified_share\x18\x05 \x01(\x01R\rverifiedShare\x12&\n" +
+	"\x06recent\x18\x06 \x03(\v2\x0e.review.ReviewR\x06recent2\xb4\x04\n" +
+	"\rReviewService\x12@\n" +
+	"\tAddReview\x12\x18.review.AddReviewRequest\x1a\x19.review.AddReviewResponse\x12C\n" +
+	"\n" +
+	"GetReviews\x12\x19.review.GetReviewsRequest\x1a\x1a.review.GetReviewsResponse\x12J\n" +
+	"\x10GetReviewSummary\x12\x1f.review.GetReviewSummaryRequest\x1a\x15.review.ReviewSummary\x12B\n" +
+	"\fUpdateReview\x12\x1b.review.UpdateReviewRequest\x1a\x15.review.EmptyResponse\x12B\n" +
+	"\fDeleteReview\x12\x1b.review.DeleteReviewRequest\x1a\x15.review.EmptyResponse\x12F\n" +
+	"\x0eAddReviewPhoto\x12\x1d.review.AddReviewPhotoRequest\x1a\x15.review.EmptyResponse\x12>\n" +
 	"\n" +
-	"GetReviews\x12\x19.review.GetReviewsRequest\x1a\x1a.review.GetReviewsResponseB6Z42025_1_ChillGuys/internal/transport/generated/reviewb\x06proto3"
+	"VoteReview\x12\x19.review.VoteReviewRequest\x1a\x15.review.EmptyResponse\x12@\n" +
+	"\vReplyReview\x12\x1a.review.ReplyReviewRequest\x1a\x15.review.EmptyResponseB6Z42025_1_ChillGuys/internal/transport/generated/reviewb\x06proto3"
 
 var (
 	file_review_proto_rawDescOnce sync.Once
@@ -338,25 +928,48 @@ func file_review_proto_rawDescGZIP() []byte {
 	return file_review_proto_rawDescData
 }
 
-var file_review_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_review_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_review_proto_goTypes = []any{
-	(*EmptyResponse)(nil),      // 0: review.EmptyResponse
-	(*AddReviewRequest)(nil),   // 1: review.AddReviewRequest
-	(*GetReviewsRequest)(nil),  // 2: review.GetReviewsRequest
-	(*Review)(nil),             // 3: review.Review
-	(*GetReviewsResponse)(nil), // 4: review.GetReviewsResponse
+	(*EmptyResponse)(nil),           // 0: review.EmptyResponse
+	(*AddReviewRequest)(nil),        // 1: review.AddReviewRequest
+	(*AddReviewResponse)(nil),       // 2: review.AddReviewResponse
+	(*GetReviewsRequest)(nil),       // 3: review.GetReviewsRequest
+	(*UpdateReviewRequest)(nil),     // 4: review.UpdateReviewRequest
+	(*DeleteReviewRequest)(nil),     // 5: review.DeleteReviewRequest
+	(*AddReviewPhotoRequest)(nil),   // 6: review.AddReviewPhotoRequest
+	(*VoteReviewRequest)(nil),       // 7: review.VoteReviewRequest
+	(*ReplyReviewRequest)(nil),      // 8: review.ReplyReviewRequest
+	(*SellerReply)(nil),             // 9: review.SellerReply
+	(*Review)(nil),                  // 10: review.Review
+	(*GetReviewsResponse)(nil),      // 11: review.GetReviewsResponse
+	(*GetReviewSummaryRequest)(nil), // 12: review.GetReviewSummaryRequest
+	(*ReviewSummary)(nil),           // 13: review.ReviewSummary
 }
 var file_review_proto_depIdxs = []int32{
-	3, // 0: review.GetReviewsResponse.reviews:type_name -> review.Review
-	1, // 1: review.ReviewService.AddReview:input_type -> review.AddReviewRequest
-	2, // 2: review.ReviewService.GetReviews:input_type -> review.GetReviewsRequest
-	0, // 3: review.ReviewService.AddReview:output_type -> review.EmptyResponse
-	4, // 4: review.ReviewService.GetReviews:output_type -> review.GetReviewsResponse
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	9,  // 0: review.Review.reply:type_name -> review.SellerReply
+	10, // 1: review.GetReviewsResponse.reviews:type_name -> review.Review
+	10, // 2: review.ReviewSummary.recent:type_name -> review.Review
+	1,  // 3: review.ReviewService.AddReview:input_type -> review.AddReviewRequest
+	3,  // 4: review.ReviewService.GetReviews:input_type -> review.GetReviewsRequest
+	12, // 5: review.ReviewService.GetReviewSummary:input_type -> review.GetReviewSummaryRequest
+	4,  // 6: review.ReviewService.UpdateReview:input_type -> review.UpdateReviewRequest
+	5,  // 7: review.ReviewService.DeleteReview:input_type -> review.DeleteReviewRequest
+	6,  // 8: review.ReviewService.AddReviewPhoto:input_type -> review.AddReviewPhotoRequest
+	7,  // 9: review.ReviewService.VoteReview:input_type -> review.VoteReviewRequest
+	8,  // 10: review.ReviewService.ReplyReview:input_type -> review.ReplyReviewRequest
+	2,  // 11: review.ReviewService.AddReview:output_type -> review.AddReviewResponse
+	11, // 12: review.ReviewService.GetReviews:output_type -> review.GetReviewsResponse
+	13, // 13: review.ReviewService.GetReviewSummary:output_type -> review.ReviewSummary
+	0,  // 14: review.ReviewService.UpdateReview:output_type -> review.EmptyResponse
+	0,  // 15: review.ReviewService.DeleteReview:output_type -> review.EmptyResponse
+	0,  // 16: review.ReviewService.AddReviewPhoto:output_type -> review.EmptyResponse
+	0,  // 17: review.ReviewService.VoteReview:output_type -> review.EmptyResponse
+	0,  // 18: review.ReviewService.ReplyReview:output_type -> review.EmptyResponse
+	11, // [11:19] is the sub-list for method output_type
+	3,  // [3:11] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_review_proto_init() }
@@ -370,7 +983,7 @@ func file_review_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_review_proto_rawDesc), len(file_review_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
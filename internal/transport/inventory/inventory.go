@@ -0,0 +1,213 @@
+package inventory
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/utils/response"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/helpers"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+)
+
+//go:generate mockgen -source=inventory.go -destination=../../usecase/mocks/inventory_usecase_mock.go -package=mocks IInventoryUsecase
+type IInventoryUsecase interface {
+	AdjustStock(ctx context.Context, sellerID, productID uuid.UUID, req dto.StockAdjustmentRequest) (*models.StockMovement, error)
+	GetMovements(ctx context.Context, sellerID, productID uuid.UUID, offset int) ([]models.StockMovement, error)
+	SetLowStockThreshold(ctx context.Context, sellerID, productID uuid.UUID, threshold *int) error
+	GetDiscrepancies(ctx context.Context, sellerID uuid.UUID) ([]models.StockDiscrepancy, error)
+}
+
+type InventoryService struct {
+	u IInventoryUsecase
+}
+
+func NewInventoryService(u IInventoryUsecase) *InventoryService {
+	return &InventoryService{
+		u: u,
+	}
+}
+
+// AdjustStock godoc
+//
+//	@Summary		Изменить остаток товара
+//	@Description	Увеличивает или уменьшает остаток товара либо его SKU (variant_id) на delta.
+//	@Description	kind — adjustment (по умолчанию) или return; причина попадает в журнал остатков.
+//	@Tags			seller
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		string						true	"ID товара"
+//	@Param			X-Csrf-Token	header		string						true	"CSRF-токен для защиты от подделки запросов"
+//	@Param			request			body		dto.StockAdjustmentRequest	true	"Изменение остатка"
+//	@Success		201				{object}	models.StockMovement
+//	@Failure		400				{object}	object
+//	@Failure		404				{object}	object
+//	@Failure		422				{object}	object	"Остаток ушёл бы в минус или не указана причина"
+//	@Failure		500				{object}	object
+//	@Router			/seller/products/{id}/stock/adjustments [post]
+func (h *InventoryService) AdjustStock(w http.ResponseWriter, r *http.Request) {
+	const op = "InventoryService.AdjustStock"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	sellerID, productID, ok := h.productRequest(w, r, op)
+	if !ok {
+		return
+	}
+
+	var req dto.StockAdjustmentRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Error("parse request data")
+		response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+		return
+	}
+
+	movement, err := h.u.AdjustStock(r.Context(), sellerID, productID, req)
+	if err != nil {
+		logger.WithError(err).Error("adjust stock")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusCreated, movement)
+}
+
+// GetMovements godoc
+//
+//	@Summary		Журнал остатков товара
+//	@Description	Возвращает движения остатка товара и его SKU, новые первыми, по 20 записей
+//	@Tags			seller
+//	@Produce		json
+//	@Param			id		path		string	true	"ID товара"
+//	@Param			offset	query		int		false	"Смещение для пагинации"
+//	@Success		200		{array}		models.StockMovement
+//	@Failure		400		{object}	object
+//	@Failure		500		{object}	object
+//	@Router			/seller/products/{id}/stock/movements [get]
+func (h *InventoryService) GetMovements(w http.ResponseWriter, r *http.Request) {
+	const op = "InventoryService.GetMovements"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	sellerID, productID, ok := h.productRequest(w, r, op)
+	if !ok {
+		return
+	}
+
+	offset := 0
+	if raw := r.URL.Query().Get("offset"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 {
+			logger.WithField("offset", raw).Warn("invalid offset")
+			response.SendJSONError(r.Context(), w, http.StatusBadRequest, "invalid offset")
+			return
+		}
+		offset = parsed
+	}
+
+	movements, err := h.u.GetMovements(r.Context(), sellerID, productID, offset)
+	if err != nil {
+		logger.WithError(err).Error("get stock movements")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, movements)
+}
+
+// SetLowStockThreshold godoc
+//
+//	@Summary		Порог низкого остатка
+//	@Description	Когда остаток товара или любого его SKU опускается до порога, продавец получает уведомление.
+//	@Description	threshold: null снимает порог.
+//	@Tags			seller
+//	@Accept			json
+//	@Param			id				path	string							true	"ID товара"
+//	@Param			X-Csrf-Token	header	string							true	"CSRF-токен для защиты от подделки запросов"
+//	@Param			request			body	dto.LowStockThresholdRequest	true	"Порог"
+//	@Success		204				"Порог сохранён"
+//	@Failure		400				{object}	object
+//	@Failure		404				{object}	object
+//	@Failure		422				{object}	object
+//	@Failure		500				{object}	object
+//	@Router			/seller/products/{id}/low-stock-threshold [put]
+func (h *InventoryService) SetLowStockThreshold(w http.ResponseWriter, r *http.Request) {
+	const op = "InventoryService.SetLowStockThreshold"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	sellerID, productID, ok := h.productRequest(w, r, op)
+	if !ok {
+		return
+	}
+
+	var req dto.LowStockThresholdRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Error("parse request data")
+		response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+		return
+	}
+
+	if err := h.u.SetLowStockThreshold(r.Context(), sellerID, productID, req.Threshold); err != nil {
+		logger.WithError(err).Error("set low stock threshold")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetDiscrepancies godoc
+//
+//	@Summary		Сверка остатков с журналом
+//	@Description	Возвращает товары и SKU продавца, остаток которых не равен сумме движений в журнале
+//	@Tags			seller
+//	@Produce		json
+//	@Success		200	{array}		models.StockDiscrepancy
+//	@Failure		500	{object}	object
+//	@Router			/seller/stock/reconciliation [get]
+func (h *InventoryService) GetDiscrepancies(w http.ResponseWriter, r *http.Request) {
+	const op = "InventoryService.GetDiscrepancies"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	sellerID, err := helpers.GetUserIDFromContext(r.Context())
+	if err != nil {
+		logger.WithError(err).Error("get user ID from context")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	discrepancies, err := h.u.GetDiscrepancies(r.Context(), sellerID)
+	if err != nil {
+		logger.WithError(err).Error("get stock discrepancies")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, discrepancies)
+}
+
+// productRequest достаёт продавца из контекста и ID товара из пути; при
+// ошибке сам отвечает клиенту
+func (h *InventoryService) productRequest(w http.ResponseWriter, r *http.Request, op string) (uuid.UUID, uuid.UUID, bool) {
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	sellerID, err := helpers.GetUserIDFromContext(r.Context())
+	if err != nil {
+		logger.WithError(err).Error("get user ID from context")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	productID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse product ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return sellerID, productID, true
+}
@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+)
+
+// ExchangeRateProvider возвращает курс валюты для показа цен
+type ExchangeRateProvider interface {
+	GetRate(ctx context.Context, currency models.Currency) (models.ExchangeRate, error)
+}
+
+// CurrencyMiddleware определяет валюту, в которой покупатель смотрит цены:
+// из заголовка X-Currency, иначе из куки currency. Курс передаётся дальше
+// в контексте. Неизвестная валюта не считается ошибкой — цены показываются
+// в рублях, чтобы устаревшая кука не ломала каталог. Валюта, в которой
+// показаны цены, возвращается в заголовке ответа X-Currency.
+func CurrencyMiddleware(rates ExchangeRateProvider, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		code := r.Header.Get(domains.CurrencyHeader)
+		if code == "" {
+			if cookie, err := r.Cookie(domains.CurrencyCookieName); err == nil {
+				code = cookie.Value
+			}
+		}
+
+		var rate models.ExchangeRate
+		if code != "" {
+			rate = resolveRate(r.Context(), rates, code)
+		}
+
+		w.Header().Set(domains.CurrencyHeader, string(rate.Code()))
+		ctx := context.WithValue(r.Context(), domains.ExchangeRateKey{}, rate)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func resolveRate(ctx context.Context, rates ExchangeRateProvider, code string) models.ExchangeRate {
+	logger := logctx.GetLogger(ctx).WithField("currency", code)
+
+	currency, err := models.ParseCurrency(code)
+	if err != nil {
+		logger.WithError(err).Debug("invalid currency requested")
+		return models.ExchangeRate{}
+	}
+
+	rate, err := rates.GetRate(ctx, currency)
+	if err != nil {
+		logger.WithError(err).Warn("get exchange rate")
+		return models.ExchangeRate{}
+	}
+
+	return rate
+}
@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/domains"
+	"github.com/google/uuid"
+)
+
+var errInvalidGuestCookie = errors.New("invalid guest basket cookie")
+
+// GuestBasketMiddleware направляет запросы с JWT-токеном в user, а анонимные — в guest.
+// Анонимный посетитель получает подписанную куку с идентификатором гостевой корзины,
+// который передаётся дальше в контексте вместо идентификатора пользователя.
+// Если рядом с токеном есть действующая гостевая кука, токен проверяется validToken:
+// с истёкшим или недействительным токеном посетитель остаётся в гостевой корзине
+// вместо ответа 401.
+func GuestBasketMiddleware(cfg *config.GuestBasketConfig, validToken func(ctx context.Context, token string) bool, user, guest http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		guestID, guestErr := GuestIDFromRequest(r, cfg.SecretKey)
+
+		if tokenCookie, err := r.Cookie(domains.TokenCookieName); err == nil && tokenCookie.Value != "" {
+			if guestErr != nil || validToken(r.Context(), tokenCookie.Value) {
+				user.ServeHTTP(w, r)
+				return
+			}
+		}
+
+		if guestErr != nil {
+			guestID = uuid.New()
+		}
+
+		// Кука продлевается при каждом обращении, как и корзина в хранилище
+		http.SetCookie(w, &http.Cookie{
+			Name:     domains.GuestBasketCookieName,
+			Value:    SignGuestID(guestID, cfg.SecretKey),
+			Path:     "/",
+			SameSite: http.SameSiteStrictMode,
+			HttpOnly: true,
+			Expires:  time.Now().UTC().Add(cfg.TTL),
+		})
+
+		ctx := context.WithValue(r.Context(), domains.UserIDKey{}, guestID.String())
+		guest.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// GuestIDFromRequest возвращает идентификатор гостевой корзины из куки, проверив подпись
+func GuestIDFromRequest(r *http.Request, secretKey string) (uuid.UUID, error) {
+	guestCookie, err := r.Cookie(domains.GuestBasketCookieName)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	data := strings.Split(guestCookie.Value, ".")
+	if len(data) != 2 {
+		return uuid.Nil, errInvalidGuestCookie
+	}
+
+	guestID, err := uuid.Parse(data[0])
+	if err != nil {
+		return uuid.Nil, errInvalidGuestCookie
+	}
+
+	messageMAC, err := hex.DecodeString(data[1])
+	if err != nil || !hmac.Equal(messageMAC, guestMAC(guestID, secretKey)) {
+		return uuid.Nil, errInvalidGuestCookie
+	}
+
+	return guestID, nil
+}
+
+// SignGuestID возвращает значение куки гостевой корзины: идентификатор и его подпись
+func SignGuestID(guestID uuid.UUID, secretKey string) string {
+	return guestID.String() + "." + hex.EncodeToString(guestMAC(guestID, secretKey))
+}
+
+func guestMAC(guestID uuid.UUID, secretKey string) []byte {
+	h := hmac.New(sha256.New, []byte(secretKey))
+	h.Write([]byte(guestID.String()))
+	return h.Sum(nil)
+}
@@ -96,3 +96,21 @@ func JWTMiddleware(authClient gen.AuthServiceClient, tokenator *jwt.Tokenator, n
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
+
+// TokenValidator возвращает проверку токена по тем же правилам, что и JWTMiddleware,
+// но без ответа клиенту: вызывающий сам решает, что делать с недействительным токеном
+func TokenValidator(authClient gen.AuthServiceClient, tokenator *jwt.Tokenator) func(ctx context.Context, token string) bool {
+	return func(ctx context.Context, token string) bool {
+		checkResp, err := authClient.CheckToken(ctx, &gen.CheckTokenReq{Token: token})
+		if err != nil || !checkResp.Valid {
+			return false
+		}
+
+		claims, err := tokenator.ParseJWT(token)
+		if err != nil {
+			return false
+		}
+
+		return claims.ExpiresAt >= time.Now().Unix()
+	}
+}
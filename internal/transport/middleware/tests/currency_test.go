@@ -0,0 +1,88 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/helpers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubRates map[models.Currency]string
+
+func (s stubRates) GetRate(_ context.Context, currency models.Currency) (models.ExchangeRate, error) {
+	raw, ok := s[currency]
+	if !ok {
+		return models.ExchangeRate{}, errs.NewNotFoundError("exchange rate not found")
+	}
+	rate, err := models.ParseRate(raw)
+	return models.ExchangeRate{Currency: currency, Rate: rate}, err
+}
+
+func TestCurrencyMiddleware(t *testing.T) {
+	var got models.ExchangeRate
+	handler := middleware.CurrencyMiddleware(stubRates{"USD": "92.5", "EUR": "100"},
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = helpers.GetExchangeRateFromContext(r.Context())
+		}))
+
+	serve := func(r *http.Request) *httptest.ResponseRecorder {
+		got = models.ExchangeRate{Currency: "-"}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	t.Run("base currency by default", func(t *testing.T) {
+		w := serve(httptest.NewRequest(http.MethodGet, "/products", nil))
+
+		assert.True(t, got.IsBase())
+		assert.Equal(t, "RUB", w.Header().Get(domains.CurrencyHeader))
+	})
+
+	t.Run("header", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/products", nil)
+		r.Header.Set(domains.CurrencyHeader, "usd")
+		w := serve(r)
+
+		require.Equal(t, models.Currency("USD"), got.Currency)
+		assert.Equal(t, "92.5", got.Rate.String())
+		assert.Equal(t, "USD", w.Header().Get(domains.CurrencyHeader))
+	})
+
+	t.Run("header takes precedence over cookie", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/products", nil)
+		r.Header.Set(domains.CurrencyHeader, "USD")
+		r.AddCookie(&http.Cookie{Name: domains.CurrencyCookieName, Value: "EUR"})
+		serve(r)
+
+		assert.Equal(t, models.Currency("USD"), got.Currency)
+	})
+
+	t.Run("cookie", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodGet, "/products", nil)
+		r.AddCookie(&http.Cookie{Name: domains.CurrencyCookieName, Value: "EUR"})
+		w := serve(r)
+
+		assert.Equal(t, models.Currency("EUR"), got.Currency)
+		assert.Equal(t, "EUR", w.Header().Get(domains.CurrencyHeader))
+	})
+
+	t.Run("unknown or invalid currency falls back to base", func(t *testing.T) {
+		for _, code := range []string{"JPY", "dollars"} {
+			r := httptest.NewRequest(http.MethodGet, "/products", nil)
+			r.Header.Set(domains.CurrencyHeader, code)
+			w := serve(r)
+
+			assert.True(t, got.IsBase(), code)
+			assert.Equal(t, "RUB", w.Header().Get(domains.CurrencyHeader), code)
+		}
+	})
+}
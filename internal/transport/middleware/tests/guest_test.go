@@ -0,0 +1,189 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGuestBasketMiddleware(t *testing.T) {
+	conf := &config.GuestBasketConfig{SecretKey: "guest-secret", TTL: time.Hour}
+
+	var (
+		userCalled  bool
+		guestUserID string
+	)
+	userHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userCalled = true
+		w.WriteHeader(http.StatusOK)
+	})
+	guestHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		guestUserID, _ = r.Context().Value(domains.UserIDKey{}).(string)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	validToken := func(_ context.Context, token string) bool {
+		return token == "jwt-token"
+	}
+	handler := middleware.GuestBasketMiddleware(conf, validToken, userHandler, guestHandler)
+
+	findCookie := func(resp *http.Response) *http.Cookie {
+		for _, c := range resp.Cookies() {
+			if c.Name == domains.GuestBasketCookieName {
+				return c
+			}
+		}
+		return nil
+	}
+
+	t.Run("authorized user", func(t *testing.T) {
+		userCalled, guestUserID = false, ""
+
+		req := httptest.NewRequest(http.MethodGet, "/basket", nil)
+		req.AddCookie(&http.Cookie{Name: domains.TokenCookieName, Value: "jwt-token"})
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		assert.True(t, userCalled)
+		assert.Empty(t, guestUserID)
+		assert.Nil(t, findCookie(w.Result()))
+	})
+
+	t.Run("new guest", func(t *testing.T) {
+		userCalled, guestUserID = false, ""
+
+		req := httptest.NewRequest(http.MethodGet, "/basket", nil)
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		assert.False(t, userCalled)
+		guestID, err := uuid.Parse(guestUserID)
+		require.NoError(t, err)
+
+		guestCookie := findCookie(w.Result())
+		require.NotNil(t, guestCookie)
+		assert.Equal(t, middleware.SignGuestID(guestID, conf.SecretKey), guestCookie.Value)
+		assert.True(t, guestCookie.HttpOnly)
+	})
+
+	t.Run("returning guest", func(t *testing.T) {
+		guestID := uuid.New()
+
+		req := httptest.NewRequest(http.MethodPost, "/basket/quote", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  domains.GuestBasketCookieName,
+			Value: middleware.SignGuestID(guestID, conf.SecretKey),
+		})
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		assert.Equal(t, guestID.String(), guestUserID)
+	})
+
+	t.Run("forged cookie", func(t *testing.T) {
+		guestID := uuid.New()
+
+		req := httptest.NewRequest(http.MethodGet, "/basket", nil)
+		req.AddCookie(&http.Cookie{
+			Name:  domains.GuestBasketCookieName,
+			Value: middleware.SignGuestID(guestID, "other-secret"),
+		})
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		assert.NotEqual(t, guestID.String(), guestUserID)
+		assert.NotEmpty(t, guestUserID)
+	})
+
+	t.Run("expired token keeps guest basket", func(t *testing.T) {
+		userCalled, guestUserID = false, ""
+		guestID := uuid.New()
+
+		req := httptest.NewRequest(http.MethodGet, "/basket", nil)
+		req.AddCookie(&http.Cookie{Name: domains.TokenCookieName, Value: "expired-token"})
+		req.AddCookie(&http.Cookie{
+			Name:  domains.GuestBasketCookieName,
+			Value: middleware.SignGuestID(guestID, conf.SecretKey),
+		})
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		assert.False(t, userCalled)
+		assert.Equal(t, guestID.String(), guestUserID)
+	})
+
+	t.Run("expired token without guest basket", func(t *testing.T) {
+		userCalled, guestUserID = false, ""
+
+		req := httptest.NewRequest(http.MethodGet, "/basket", nil)
+		req.AddCookie(&http.Cookie{Name: domains.TokenCookieName, Value: "expired-token"})
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		assert.True(t, userCalled)
+		assert.Empty(t, guestUserID)
+	})
+
+	t.Run("valid token with stale guest cookie", func(t *testing.T) {
+		userCalled, guestUserID = false, ""
+
+		req := httptest.NewRequest(http.MethodGet, "/basket", nil)
+		req.AddCookie(&http.Cookie{Name: domains.TokenCookieName, Value: "jwt-token"})
+		req.AddCookie(&http.Cookie{
+			Name:  domains.GuestBasketCookieName,
+			Value: middleware.SignGuestID(uuid.New(), conf.SecretKey),
+		})
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		assert.True(t, userCalled)
+		assert.Empty(t, guestUserID)
+	})
+}
+
+func TestGuestIDFromRequest(t *testing.T) {
+	guestID := uuid.New()
+
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{"valid", middleware.SignGuestID(guestID, "secret"), false},
+		{"wrong secret", middleware.SignGuestID(guestID, "other"), true},
+		{"no signature", guestID.String(), true},
+		{"bad id", "not-a-uuid.abcdef", true},
+		{"bad signature encoding", guestID.String() + ".zz", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/basket", nil)
+			req.AddCookie(&http.Cookie{Name: domains.GuestBasketCookieName, Value: tt.value})
+
+			got, err := middleware.GuestIDFromRequest(req, "secret")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, guestID, got)
+		})
+	}
+}
@@ -2,8 +2,10 @@ package order
 
 import (
 	"fmt"
+	"github.com/gorilla/mux"
 	"github.com/mailru/easyjson"
 	"net/http"
+	"strconv"
 
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/utils/validator"
 
@@ -133,6 +135,11 @@ func (o *OrderService) GetOrders(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// История показывается по курсам на момент оформления каждого заказа
+	for i := range *orders {
+		(*orders)[i] = (*orders)[i].InDisplayCurrency()
+	}
+
 	response.SendJSONResponse(r.Context(), w, http.StatusOK, map[string]*[]dto.OrderPreviewDTO{
 		"orders": orders,
 	})
@@ -165,9 +172,204 @@ func (h *OrderService) GetOrdersPlaced(w http.ResponseWriter, r *http.Request) {
 	orders, err := h.u.GetOrdersPlaced(r.Context())
 	if err != nil {
 		logger.WithError(err).Error("get placed orders")
-		response.HandleDomainError(r.Context(), w, errs.ErrNotFound, op)
+		response.HandleDomainError(r.Context(), w, err, op)
 		return
 	}
 
 	response.SendJSONResponse(r.Context(), w, http.StatusOK, orders)
 }
+
+// GetSellerOrders godoc
+//
+//	@Summary		Получить заказы продавца
+//	@Description	Возвращает отправления текущего продавца, новые первыми, по 20 на страницу
+//	@Tags			seller
+//	@Produce		json
+//	@Param			offset	path		int						true	"Смещение для пагинации"
+//	@Success		200		{object}	map[string][]dto.SellerOrderDTO	"Список отправлений"
+//	@Failure		401		{object}	object					"Пользователь не авторизован"
+//	@Failure		403		{object}	object					"Недостаточно прав"
+//	@Failure		500		{object}	object					"Внутренняя ошибка сервера"
+//	@Security		TokenAuth
+//	@Router			/seller/orders/{offset} [get]
+func (o *OrderService) GetSellerOrders(w http.ResponseWriter, r *http.Request) {
+	const op = "OrderService.GetSellerOrders"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	offsetStr := mux.Vars(r)["offset"]
+	offset, err := strconv.Atoi(offsetStr)
+	if err != nil || offset < 0 {
+		logger.WithField("offset", offsetStr).Error("parse offset")
+		response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+		return
+	}
+
+	orders, err := o.u.GetSellerOrders(r.Context(), offset)
+	if err != nil {
+		logger.WithError(err).Error("get seller orders")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, map[string][]dto.SellerOrderDTO{
+		"orders": orders,
+	})
+}
+
+// ConfirmShipment godoc
+//
+//	@Summary		Подтвердить отправление
+//	@Description	Продавец принимает отправление в работу и может указать трек-номер и ожидаемую дату доставки
+//	@Tags			seller
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path	string						true	"ID отправления"
+//	@Param			request			body	dto.ConfirmShipmentRequest	false	"Трек-номер и дата доставки"
+//	@Param			X-Csrf-Token	header	string						true	"CSRF-токен для защиты от подделки запросов"
+//	@Success		200				"Отправление подтверждено"
+//	@Failure		400				{object}	object	"Некорректный ID отправления"
+//	@Failure		404				{object}	object	"Отправление не найдено"
+//	@Failure		422				{object}	object	"Отправление нельзя подтвердить в текущем статусе"
+//	@Failure		500				{object}	object	"Внутренняя ошибка сервера"
+//	@Security		TokenAuth
+//	@Router			/seller/orders/{id}/confirm [post]
+func (o *OrderService) ConfirmShipment(w http.ResponseWriter, r *http.Request) {
+	const op = "OrderService.ConfirmShipment"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	shipmentID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse shipment ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	// Тело необязательно: продавец может подтвердить отправление без трек-номера
+	var req dto.ConfirmShipmentRequest
+	if r.ContentLength != 0 {
+		if err = easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+			logger.WithError(err).Error("parse request data")
+			response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+			return
+		}
+	}
+
+	if err = o.u.ConfirmShipment(r.Context(), shipmentID, req); err != nil {
+		logger.WithError(err).Error("confirm shipment")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, nil)
+}
+
+// CancelShipment godoc
+//
+//	@Summary		Отменить отправление
+//	@Description	Продавец отменяет отправление, пока оно не передано в доставку. Товары возвращаются в остаток.
+//	@Tags			seller
+//	@Produce		json
+//	@Param			id				path	string	true	"ID отправления"
+//	@Param			X-Csrf-Token	header	string	true	"CSRF-токен для защиты от подделки запросов"
+//	@Success		200				"Отправление отменено"
+//	@Failure		400				{object}	object	"Некорректный ID отправления"
+//	@Failure		404				{object}	object	"Отправление не найдено"
+//	@Failure		422				{object}	object	"Отправление нельзя отменить в текущем статусе"
+//	@Failure		500				{object}	object	"Внутренняя ошибка сервера"
+//	@Security		TokenAuth
+//	@Router			/seller/orders/{id}/cancel [post]
+func (o *OrderService) CancelShipment(w http.ResponseWriter, r *http.Request) {
+	const op = "OrderService.CancelShipment"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	shipmentID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse shipment ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	if err = o.u.CancelShipment(r.Context(), shipmentID); err != nil {
+		logger.WithError(err).Error("cancel shipment")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, nil)
+}
+
+// GetOrder godoc
+//
+//	@Summary		Получить заказ
+//	@Description	Возвращает заказ текущего пользователя с отправлениями, скидками, промокодом и итоговой суммой.
+//	@Description	Суммы показываются в валюте и по курсу, которые покупатель видел при оформлении.
+//	@Tags			order
+//	@Produce		json
+//	@Param			id	path		string				true	"ID заказа"
+//	@Success		200	{object}	dto.OrderDetailDTO	"Заказ"
+//	@Failure		400	{object}	object				"Некорректный ID заказа"
+//	@Failure		404	{object}	object				"Заказ не найден"
+//	@Failure		500	{object}	object				"Внутренняя ошибка сервера"
+//	@Security		TokenAuth
+//	@Router			/orders/{id} [get]
+func (o *OrderService) GetOrder(w http.ResponseWriter, r *http.Request) {
+	const op = "OrderService.GetOrder"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	orderID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse order ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	order, err := o.u.GetOrder(r.Context(), orderID)
+	if err != nil {
+		logger.WithError(err).Error("get order")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, order.InDisplayCurrency())
+}
+
+// GetInvoice godoc
+//
+//	@Summary		Скачать счёт по заказу
+//	@Description	Возвращает PDF-счёт по заказу. Покупателю доступны его заказы, администратору и работнику склада — любые.
+//	@Tags			order
+//	@Produce		application/pdf
+//	@Param			id	path		string	true	"ID заказа"
+//	@Success		200	{file}		file	"Счёт в формате PDF"
+//	@Failure		400	{object}	object	"Некорректный ID заказа"
+//	@Failure		404	{object}	object	"Заказ не найден"
+//	@Failure		500	{object}	object	"Внутренняя ошибка сервера"
+//	@Security		TokenAuth
+//	@Router			/orders/{id}/invoice.pdf [get]
+func (o *OrderService) GetInvoice(w http.ResponseWriter, r *http.Request) {
+	const op = "OrderService.GetInvoice"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	orderID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse order ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	invoice, err := o.u.GetInvoice(r.Context(), orderID)
+	if err != nil {
+		logger.WithError(err).Error("get invoice")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="invoice-%s.pdf"`, orderID))
+	w.Header().Set("Content-Length", strconv.Itoa(len(invoice)))
+	w.WriteHeader(http.StatusOK)
+
+	if _, err = w.Write(invoice); err != nil {
+		logger.WithError(err).Error("write invoice")
+	}
+}
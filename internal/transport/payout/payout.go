@@ -0,0 +1,223 @@
+package payout
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/utils/response"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/helpers"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+)
+
+//go:generate mockgen -source=payout.go -destination=../../usecase/mocks/payout_usecase_mock.go -package=mocks IPayoutUsecase
+type IPayoutUsecase interface {
+	SetCategoryCommission(ctx context.Context, categoryID uuid.UUID, req dto.SetCategoryCommissionRequest) error
+	GetBalance(ctx context.Context, sellerID uuid.UUID) (dto.SellerBalanceDTO, error)
+	GetStatements(ctx context.Context, sellerID uuid.UUID, offset int) ([]models.PayoutStatement, error)
+	GetStatement(ctx context.Context, sellerID, statementID uuid.UUID) (dto.PayoutStatementDetailDTO, error)
+	MarkStatementPaid(ctx context.Context, statementID uuid.UUID) error
+}
+
+type PayoutService struct {
+	u IPayoutUsecase
+}
+
+func NewPayoutService(u IPayoutUsecase) *PayoutService {
+	return &PayoutService{
+		u: u,
+	}
+}
+
+// GetBalance godoc
+//
+//	@Summary		Баланс продавца
+//	@Description	Суммы к выплате за вычетом комиссии площадки: в окне возврата, доступные,
+//	@Description	ожидающие выплаты по выпискам и уже выплаченные
+//	@Tags			seller
+//	@Produce		json
+//	@Success		200	{object}	dto.SellerBalanceDTO
+//	@Failure		500	{object}	object
+//	@Router			/seller/balance [get]
+func (h *PayoutService) GetBalance(w http.ResponseWriter, r *http.Request) {
+	const op = "PayoutService.GetBalance"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	sellerID, err := helpers.GetUserIDFromContext(r.Context())
+	if err != nil {
+		logger.WithError(err).Error("get user ID from context")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	balance, err := h.u.GetBalance(r.Context(), sellerID)
+	if err != nil {
+		logger.WithError(err).Error("get seller balance")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, balance)
+}
+
+// GetStatements godoc
+//
+//	@Summary	Выписки к выплате
+//	@Tags		seller
+//	@Produce	json
+//	@Param		offset	query		int	false	"Смещение для пагинации, по 20 выписок"
+//	@Success	200		{array}		models.PayoutStatement
+//	@Failure	400		{object}	object
+//	@Failure	500		{object}	object
+//	@Router		/seller/payouts [get]
+func (h *PayoutService) GetStatements(w http.ResponseWriter, r *http.Request) {
+	const op = "PayoutService.GetStatements"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	sellerID, err := helpers.GetUserIDFromContext(r.Context())
+	if err != nil {
+		logger.WithError(err).Error("get user ID from context")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	offset := 0
+	if raw := r.URL.Query().Get("offset"); raw != "" {
+		offset, err = strconv.Atoi(raw)
+		if err != nil || offset < 0 {
+			logger.WithField("offset", raw).Warn("invalid offset")
+			response.SendJSONError(r.Context(), w, http.StatusBadRequest, "invalid offset")
+			return
+		}
+	}
+
+	statements, err := h.u.GetStatements(r.Context(), sellerID, offset)
+	if err != nil {
+		logger.WithError(err).Error("get payout statements")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, statements)
+}
+
+// GetStatement godoc
+//
+//	@Summary	Выписка к выплате с начислениями
+//	@Tags		seller
+//	@Produce	json
+//	@Param		id	path		string	true	"ID выписки"
+//	@Success	200	{object}	dto.PayoutStatementDetailDTO
+//	@Failure	400	{object}	object
+//	@Failure	403	{object}	object	"Выписка другого продавца"
+//	@Failure	404	{object}	object
+//	@Failure	500	{object}	object
+//	@Router		/seller/payouts/{id} [get]
+func (h *PayoutService) GetStatement(w http.ResponseWriter, r *http.Request) {
+	const op = "PayoutService.GetStatement"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	sellerID, err := helpers.GetUserIDFromContext(r.Context())
+	if err != nil {
+		logger.WithError(err).Error("get user ID from context")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	statementID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse statement ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	statement, err := h.u.GetStatement(r.Context(), sellerID, statementID)
+	if err != nil {
+		logger.WithError(err).Error("get payout statement")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, statement)
+}
+
+// SetCategoryCommission godoc
+//
+//	@Summary		Комиссия категории
+//	@Description	Задаёт комиссию площадки в базисных пунктах (1000 = 10%) для категории и её потомков
+//	@Description	без собственной комиссии. null возвращает категорию к комиссии родителя.
+//	@Description	Уже сделанные начисления не пересчитываются.
+//	@Tags			admin
+//	@Accept			json
+//	@Param			id				path	string							true	"ID категории"
+//	@Param			X-Csrf-Token	header	string							true	"CSRF-токен для защиты от подделки запросов"
+//	@Param			request			body	dto.SetCategoryCommissionRequest	true	"Комиссия"
+//	@Success		204				"Комиссия сохранена"
+//	@Failure		400				{object}	object
+//	@Failure		404				{object}	object
+//	@Failure		422				{object}	object	"Комиссия вне диапазона 0–10000"
+//	@Failure		500				{object}	object
+//	@Router			/admin/categories/{id}/commission [put]
+func (h *PayoutService) SetCategoryCommission(w http.ResponseWriter, r *http.Request) {
+	const op = "PayoutService.SetCategoryCommission"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	categoryID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse category ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	var req dto.SetCategoryCommissionRequest
+	if err = easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Error("parse request data")
+		response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+		return
+	}
+
+	if err = h.u.SetCategoryCommission(r.Context(), categoryID, req); err != nil {
+		logger.WithError(err).Error("set category commission")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// MarkStatementPaid godoc
+//
+//	@Summary	Отметить выписку выплаченной
+//	@Tags		admin
+//	@Param		id				path	string	true	"ID выписки"
+//	@Param		X-Csrf-Token	header	string	true	"CSRF-токен для защиты от подделки запросов"
+//	@Success	204				"Выписка выплачена"
+//	@Failure	400				{object}	object
+//	@Failure	404				{object}	object	"Выписка не найдена или уже выплачена"
+//	@Failure	500				{object}	object
+//	@Router		/admin/payouts/{id}/paid [post]
+func (h *PayoutService) MarkStatementPaid(w http.ResponseWriter, r *http.Request) {
+	const op = "PayoutService.MarkStatementPaid"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	statementID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse statement ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	if err = h.u.MarkStatementPaid(r.Context(), statementID); err != nil {
+		logger.WithError(err).Error("mark statement paid")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,270 @@
+package pickup
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/utils/response"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+)
+
+//go:generate mockgen -source=pickup.go -destination=../../usecase/mocks/pickup_usecase_mock.go -package=mocks IPickupUsecase
+type IPickupUsecase interface {
+	GetNearest(ctx context.Context, from models.Coordinate, limit int) (dto.PickupPointsResponse, error)
+	GetFavorites(ctx context.Context) (dto.PickupPointsResponse, error)
+	AddFavorite(ctx context.Context, pointID uuid.UUID) error
+	RemoveFavorite(ctx context.Context, pointID uuid.UUID) error
+	CreatePickupPoint(ctx context.Context, req dto.CreatePickupPointRequest) (dto.PickupPointDTO, error)
+	UpdatePickupPoint(ctx context.Context, pointID uuid.UUID, req dto.UpdatePickupPointRequest) (dto.PickupPointDTO, error)
+	DeletePickupPoint(ctx context.Context, pointID uuid.UUID) error
+}
+
+type PickupService struct {
+	u IPickupUsecase
+}
+
+func NewPickupService(u IPickupUsecase) *PickupService {
+	return &PickupService{
+		u: u,
+	}
+}
+
+// GetNearest godoc
+//
+//	@Summary		Ближайшие ПВЗ
+//	@Description	Возвращает ПВЗ, отсортированные по расстоянию до точки
+//	@Tags			pickup
+//	@Produce		json
+//	@Param			lat		query		number						true	"Широта"
+//	@Param			lon		query		number						true	"Долгота"
+//	@Param			limit	query		int							false	"Количество ПВЗ (по умолчанию 5, не больше 50)"
+//	@Success		200		{object}	dto.PickupPointsResponse	"Ближайшие ПВЗ"
+//	@Failure		400		{object}	object						"Некорректная координата"
+//	@Failure		500		{object}	object						"Внутренняя ошибка сервера"
+//	@Router			/pickup-points/nearest [get]
+func (h *PickupService) GetNearest(w http.ResponseWriter, r *http.Request) {
+	const op = "PickupService.GetNearest"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	query := r.URL.Query()
+	lat, latErr := strconv.ParseFloat(query.Get("lat"), 64)
+	lon, lonErr := strconv.ParseFloat(query.Get("lon"), 64)
+	if latErr != nil || lonErr != nil {
+		logger.Warn("invalid coordinate")
+		response.SendJSONError(r.Context(), w, http.StatusBadRequest, "lat and lon are required")
+		return
+	}
+	limit, _ := strconv.Atoi(query.Get("limit"))
+
+	points, err := h.u.GetNearest(r.Context(), models.Coordinate{Lat: lat, Lon: lon}, limit)
+	if err != nil {
+		logger.WithError(err).Error("get nearest pickup points")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, points)
+}
+
+// GetFavorites godoc
+//
+//	@Summary		Избранные ПВЗ
+//	@Description	Возвращает ПВЗ, сохранённые пользователем
+//	@Tags			pickup
+//	@Produce		json
+//	@Success		200	{object}	dto.PickupPointsResponse	"Избранные ПВЗ"
+//	@Failure		401	{object}	object						"Пользователь не авторизован"
+//	@Failure		500	{object}	object						"Внутренняя ошибка сервера"
+//	@Security		TokenAuth
+//	@Router			/pickup-points/favorites [get]
+func (h *PickupService) GetFavorites(w http.ResponseWriter, r *http.Request) {
+	const op = "PickupService.GetFavorites"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	points, err := h.u.GetFavorites(r.Context())
+	if err != nil {
+		logger.WithError(err).Error("get favorite pickup points")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, points)
+}
+
+// AddFavorite godoc
+//
+//	@Summary		Сохранить ПВЗ
+//	@Description	Добавляет ПВЗ в избранные пользователя
+//	@Tags			pickup
+//	@Param			id				path	string	true	"ID ПВЗ"
+//	@Param			X-Csrf-Token	header	string	true	"CSRF-токен для защиты от подделки запросов"
+//	@Success		204				"ПВЗ сохранён"
+//	@Failure		400				{object}	object	"Некорректный ID"
+//	@Failure		404				{object}	object	"ПВЗ не найден"
+//	@Security		TokenAuth
+//	@Router			/pickup-points/{id}/favorite [post]
+func (h *PickupService) AddFavorite(w http.ResponseWriter, r *http.Request) {
+	const op = "PickupService.AddFavorite"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	pointID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse pickup point ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	if err = h.u.AddFavorite(r.Context(), pointID); err != nil {
+		logger.WithError(err).Error("add favorite pickup point")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusNoContent, nil)
+}
+
+// RemoveFavorite godoc
+//
+//	@Summary		Удалить ПВЗ из избранных
+//	@Tags			pickup
+//	@Param			id				path	string	true	"ID ПВЗ"
+//	@Param			X-Csrf-Token	header	string	true	"CSRF-токен для защиты от подделки запросов"
+//	@Success		204				"ПВЗ удалён из избранных"
+//	@Failure		400				{object}	object	"Некорректный ID"
+//	@Failure		404				{object}	object	"ПВЗ не сохранён"
+//	@Security		TokenAuth
+//	@Router			/pickup-points/{id}/favorite [delete]
+func (h *PickupService) RemoveFavorite(w http.ResponseWriter, r *http.Request) {
+	const op = "PickupService.RemoveFavorite"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	pointID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse pickup point ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	if err = h.u.RemoveFavorite(r.Context(), pointID); err != nil {
+		logger.WithError(err).Error("remove favorite pickup point")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusNoContent, nil)
+}
+
+// CreatePickupPoint godoc
+//
+//	@Summary		Создать ПВЗ
+//	@Tags			pickup
+//	@Accept			json
+//	@Produce		json
+//	@Param			request			body		dto.CreatePickupPointRequest	true	"ПВЗ"
+//	@Param			X-Csrf-Token	header		string							true	"CSRF-токен для защиты от подделки запросов"
+//	@Success		201				{object}	dto.PickupPointDTO				"ПВЗ создан"
+//	@Failure		422				{object}	object							"Некорректные данные ПВЗ"
+//	@Failure		500				{object}	object							"Внутренняя ошибка сервера"
+//	@Security		TokenAuth
+//	@Router			/admin/pickup-points [post]
+func (h *PickupService) CreatePickupPoint(w http.ResponseWriter, r *http.Request) {
+	const op = "PickupService.CreatePickupPoint"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	var req dto.CreatePickupPointRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Error("parse request data")
+		response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+		return
+	}
+
+	point, err := h.u.CreatePickupPoint(r.Context(), req)
+	if err != nil {
+		logger.WithError(err).Error("create pickup point")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusCreated, point)
+}
+
+// UpdatePickupPoint godoc
+//
+//	@Summary		Изменить ПВЗ
+//	@Description	Меняет название и часы работы ПВЗ
+//	@Tags			pickup
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		string							true	"ID ПВЗ"
+//	@Param			request			body		dto.UpdatePickupPointRequest	true	"Новые данные ПВЗ"
+//	@Param			X-Csrf-Token	header		string							true	"CSRF-токен для защиты от подделки запросов"
+//	@Success		200				{object}	dto.PickupPointDTO				"ПВЗ изменён"
+//	@Failure		404				{object}	object							"ПВЗ не найден"
+//	@Failure		422				{object}	object							"Некорректные данные ПВЗ"
+//	@Security		TokenAuth
+//	@Router			/admin/pickup-points/{id} [put]
+func (h *PickupService) UpdatePickupPoint(w http.ResponseWriter, r *http.Request) {
+	const op = "PickupService.UpdatePickupPoint"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	pointID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse pickup point ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	var req dto.UpdatePickupPointRequest
+	if err = easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Error("parse request data")
+		response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+		return
+	}
+
+	point, err := h.u.UpdatePickupPoint(r.Context(), pointID, req)
+	if err != nil {
+		logger.WithError(err).Error("update pickup point")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, point)
+}
+
+// DeletePickupPoint godoc
+//
+//	@Summary		Удалить ПВЗ
+//	@Description	Удаляет ПВЗ; оформленные заказы сохраняют его адрес
+//	@Tags			pickup
+//	@Param			id				path	string	true	"ID ПВЗ"
+//	@Param			X-Csrf-Token	header	string	true	"CSRF-токен для защиты от подделки запросов"
+//	@Success		204				"ПВЗ удалён"
+//	@Failure		404				{object}	object	"ПВЗ не найден"
+//	@Security		TokenAuth
+//	@Router			/admin/pickup-points/{id} [delete]
+func (h *PickupService) DeletePickupPoint(w http.ResponseWriter, r *http.Request) {
+	const op = "PickupService.DeletePickupPoint"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	pointID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse pickup point ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	if err = h.u.DeletePickupPoint(r.Context(), pointID); err != nil {
+		logger.WithError(err).Error("delete pickup point")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusNoContent, nil)
+}
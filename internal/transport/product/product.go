@@ -13,6 +13,7 @@ import (
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/utils/response"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/helpers"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
@@ -28,8 +29,9 @@ type IProductUsecase interface {
 	GetProductsByCategory(
 		ctx context.Context,
 		id uuid.UUID,
+		includeDescendants bool,
 		offset int,
-		minPrice, maxPrice float64,
+		minPrice, maxPrice models.Money,
 		minRating float32,
 		sortOption models.SortOption,
 	) ([]*models.Product, error)
@@ -81,7 +83,8 @@ func (h *ProductService) GetAllProducts(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	productResponse := dto.ConvertToProductsResponse(products)
+	rate := helpers.GetExchangeRateFromContext(r.Context())
+	productResponse := dto.ConvertToProductsResponse(products).InCurrency(rate)
 
 	response.SendJSONResponse(r.Context(), w, http.StatusOK, productResponse)
 }
@@ -93,7 +96,7 @@ func (h *ProductService) GetAllProducts(w http.ResponseWriter, r *http.Request)
 //	@Tags			products
 //	@Produce		json
 //	@Param			id	path		string	true	"UUID продукта"
-//	@Success		200	{object}	models.Product
+//	@Success		200	{object}	dto.ProductResponse
 //	@Failure		400	{object}	object	"Некорректный формат UUID"
 //	@Failure		404	{object}	object	"Продукт не найден"
 //	@Router			/products/{id} [get]
@@ -118,7 +121,8 @@ func (h *ProductService) GetProductByID(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	response.SendJSONResponse(r.Context(), w, http.StatusOK, product)
+	rate := helpers.GetExchangeRateFromContext(r.Context())
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, dto.ConvertToProductResponse(product, rate))
 }
 
 // CreateOne godoc
@@ -210,7 +214,8 @@ func (p *ProductService) GetProductsByIDs(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	response.SendJSONResponse(r.Context(), w, http.StatusOK, dto.ConvertToProductsResponse(products))
+	rate := helpers.GetExchangeRateFromContext(r.Context())
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, dto.ConvertToProductsResponse(products).InCurrency(rate))
 }
 
 func (h *ProductService) GetProductsByCategory(w http.ResponseWriter, r *http.Request) {
@@ -240,10 +245,16 @@ func (h *ProductService) GetProductsByCategory(w http.ResponseWriter, r *http.Re
 	}
 
 	// Парсинг фильтров
-	minPrice, _ := strconv.ParseFloat(r.URL.Query().Get("min_price"), 64)
-	maxPrice, _ := strconv.ParseFloat(r.URL.Query().Get("max_price"), 64)
+	minPrice, _ := models.ParseMoney(r.URL.Query().Get("min_price"))
+	maxPrice, _ := models.ParseMoney(r.URL.Query().Get("max_price"))
+	// Границы цены покупатель задаёт в той валюте, в которой видит цены
+	rate := helpers.GetExchangeRateFromContext(r.Context())
+	minPrice, maxPrice = rate.ToBase(minPrice), rate.ToBase(maxPrice)
 	minRating, _ := strconv.ParseFloat(r.URL.Query().Get("min_rating"), 32)
 
+	// С descendants=true выдаются также товары всех подкатегорий
+	includeDescendants, _ := strconv.ParseBool(r.URL.Query().Get("descendants"))
+
 	// Парсинг параметра сортировки
 	sortOption := models.SortOption(r.URL.Query().Get("sort"))
 	switch sortOption {
@@ -256,6 +267,7 @@ func (h *ProductService) GetProductsByCategory(w http.ResponseWriter, r *http.Re
 	products, err := h.u.GetProductsByCategory(
 		r.Context(),
 		id,
+		includeDescendants,
 		offset,
 		minPrice,
 		maxPrice,
@@ -268,7 +280,7 @@ func (h *ProductService) GetProductsByCategory(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	productResponse := dto.ConvertToProductsResponse(products)
+	productResponse := dto.ConvertToProductsResponse(products).InCurrency(rate)
 	response.SendJSONResponse(r.Context(), w, http.StatusOK, productResponse)
 }
 
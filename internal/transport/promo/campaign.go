@@ -0,0 +1,123 @@
+package promo
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/utils/response"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+)
+
+func (h *PromoService) CreateCampaign(w http.ResponseWriter, r *http.Request) {
+	const op = "PromoService.CreateCampaign"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	var req dto.CreatePromoCampaignRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Error("parse request data")
+		response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+		return
+	}
+
+	resp, err := h.uc.CreateCampaign(r.Context(), req)
+	if err != nil {
+		logger.WithError(err).Error("create campaign")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusCreated, resp)
+}
+
+// ExportCampaignCodes отдаёт коды кампании в CSV: code, redeemed, active
+func (h *PromoService) ExportCampaignCodes(w http.ResponseWriter, r *http.Request) {
+	const op = "PromoService.ExportCampaignCodes"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	campaignID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse campaign ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	codes, err := h.uc.ExportCampaignCodes(r.Context(), campaignID)
+	if err != nil {
+		logger.WithError(err).Error("export campaign codes")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="campaign-%s.csv"`, campaignID))
+	w.WriteHeader(http.StatusOK)
+
+	writer := csv.NewWriter(w)
+	if err = writer.Write([]string{"code", "redeemed", "active"}); err != nil {
+		logger.WithError(err).Error("write csv header")
+		return
+	}
+	for _, code := range codes {
+		if err = writer.Write([]string{
+			code.Code,
+			strconv.FormatBool(code.Redeemed),
+			strconv.FormatBool(code.IsActive),
+		}); err != nil {
+			logger.WithError(err).Error("write csv row")
+			return
+		}
+	}
+
+	writer.Flush()
+	if err = writer.Error(); err != nil {
+		logger.WithError(err).Error("flush csv")
+	}
+}
+
+func (h *PromoService) DeactivateCampaign(w http.ResponseWriter, r *http.Request) {
+	const op = "PromoService.DeactivateCampaign"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	campaignID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse campaign ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	if err = h.uc.DeactivateCampaign(r.Context(), campaignID); err != nil {
+		logger.WithError(err).Error("deactivate campaign")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusNoContent, nil)
+}
+
+func (h *PromoService) GetCampaignStats(w http.ResponseWriter, r *http.Request) {
+	const op = "PromoService.GetCampaignStats"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	campaignID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse campaign ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	stats, err := h.uc.GetCampaignStats(r.Context(), campaignID)
+	if err != nil {
+		logger.WithError(err).Error("get campaign stats")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, stats)
+}
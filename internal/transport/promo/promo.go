@@ -6,10 +6,12 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/utils/response"
+	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 )
 
@@ -18,6 +20,10 @@ type IPromoUsecase interface {
 	CreatePromo(ctx context.Context, req dto.CreatePromoRequest) (dto.PromoResponse, error)
 	GetAllPromos(ctx context.Context, offset int) (dto.PromosResponse, error)
 	CheckPromoCode(ctx context.Context, code string) (dto.PromoValidityResponse, error)
+	CreateCampaign(ctx context.Context, req dto.CreatePromoCampaignRequest) (dto.PromoCampaignResponse, error)
+	ExportCampaignCodes(ctx context.Context, campaignID uuid.UUID) ([]models.PromoCampaignCode, error)
+	DeactivateCampaign(ctx context.Context, campaignID uuid.UUID) error
+	GetCampaignStats(ctx context.Context, campaignID uuid.UUID) (dto.PromoCampaignStatsResponse, error)
 }
 
 type PromoService struct {
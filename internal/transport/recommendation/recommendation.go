@@ -5,6 +5,7 @@ import (
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/utils/response"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/helpers"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/recommendation"
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
@@ -42,7 +43,25 @@ func (h *RecommendationServise) GetRecommendations(w http.ResponseWriter, r *htt
 		return
 	}
 
-	recommendationsResp := dto.ConvertToProductsResponse(recommendations)
+	rate := helpers.GetExchangeRateFromContext(r.Context())
+	recommendationsResp := dto.ConvertToProductsResponse(recommendations).InCurrency(rate)
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, recommendationsResp)
+}
+
+func (h *RecommendationServise) GetPersonalRecommendations(w http.ResponseWriter, r *http.Request) {
+	const op = "RecommendationServise.GetPersonalRecommendations"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	recommendations, err := h.r.GetPersonalRecommendations(r.Context())
+	if err != nil {
+		logger.WithError(err).Error("get personal recommendations")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	rate := helpers.GetExchangeRateFromContext(r.Context())
+	recommendationsResp := dto.ConvertToProductsResponse(recommendations).InCurrency(rate)
 
 	response.SendJSONResponse(r.Context(), w, http.StatusOK, recommendationsResp)
 }
@@ -0,0 +1,64 @@
+package reservation
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/utils/response"
+	"github.com/mailru/easyjson"
+)
+
+//go:generate mockgen -source=reservation.go -destination=../../usecase/mocks/reservation_usecase_mock.go -package=mocks IReservationUsecase
+type IReservationUsecase interface {
+	Hold(ctx context.Context, req dto.CheckoutRequest) (dto.CheckoutResponse, error)
+}
+
+type ReservationService struct {
+	u IReservationUsecase
+}
+
+func NewReservationService(u IReservationUsecase) *ReservationService {
+	return &ReservationService{
+		u: u,
+	}
+}
+
+// Hold godoc
+//
+//	@Summary		Начать оформление заказа
+//	@Description	Резервирует товары на время оформления заказа. Повторный вызов заменяет прежний резерв.
+//	@Tags			order
+//	@Accept			json
+//	@Produce		json
+//	@Param			request			body		dto.CheckoutRequest		true	"Резервируемые товары"
+//	@Param			X-Csrf-Token	header		string					true	"CSRF-токен для защиты от подделки запросов"
+//	@Success		200				{object}	dto.CheckoutResponse	"Товары зарезервированы"
+//	@Failure		400				{object}	object					"Недостаточно товара"
+//	@Failure		401				{object}	object					"Пользователь не авторизован"
+//	@Failure		422				{object}	object					"Некорректные позиции"
+//	@Failure		500				{object}	object					"Внутренняя ошибка сервера"
+//	@Security		TokenAuth
+//	@Router			/orders/checkout [post]
+func (h *ReservationService) Hold(w http.ResponseWriter, r *http.Request) {
+	const op = "ReservationService.Hold"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	var req dto.CheckoutRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Error("parse request data")
+		response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+		return
+	}
+
+	resp, err := h.u.Hold(r.Context(), req)
+	if err != nil {
+		logger.WithError(err).Error("hold stock")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, resp)
+}
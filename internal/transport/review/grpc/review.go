@@ -26,20 +26,20 @@ func NewReviewGRPCServer (u review.IReviewUsecase) *ReviewGRPCServer {
 	}
 }
 
-func (s *ReviewGRPCServer) AddReview (ctx context.Context, req *gen.AddReviewRequest) (*gen.EmptyResponse, error) {
+func (s *ReviewGRPCServer) AddReview (ctx context.Context, req *gen.AddReviewRequest) (*gen.AddReviewResponse, error) {
 	const op = "ReviewGRPCServer.AddReview"
 	logger := logctx.GetLogger(ctx).WithField("op", op)
 
 	productID, err := uuid.Parse(req.ProductId)
 	if err != nil {
 		logger.WithError(err).Error("invalid prouct ID format")
-		return &gen.EmptyResponse{}, fmt.Errorf("%s: %w", op, errs.ErrInvalidID)
+		return &gen.AddReviewResponse{}, fmt.Errorf("%s: %w", op, errs.ErrInvalidID)
 	}
 
 	rating := req.Rating
-	if 1 < rating && rating > 5 {
+	if rating < 1 || rating > 5 {
 		logger.Error("invalid rating")
-		return &gen.EmptyResponse{}, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("invalid rating"))
+		return &gen.AddReviewResponse{}, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("invalid rating"))
 	}
 
 	request := dto.AddReviewRequest {
@@ -48,16 +48,19 @@ func (s *ReviewGRPCServer) AddReview (ctx context.Context, req *gen.AddReviewReq
 		Comment: req.Comment,
 	}
 
-	err = s.reviewUsecase.Add(ctx, request)
+	reviewStatus, err := s.reviewUsecase.Add(ctx, request)
 	if err != nil {
 		logger.WithError(err).Error("add review")
 		if errors.Is(err, errs.ErrAlreadyExists) {
-			return &gen.EmptyResponse{}, status.Error(codes.AlreadyExists, "user has already reviewed this product")
+			return &gen.AddReviewResponse{}, status.Error(codes.AlreadyExists, "user has already reviewed this product")
 		}
-		return &gen.EmptyResponse{}, status.Error(codes.Internal, "internal server error")
+		if errors.Is(err, errs.ErrForbidden) {
+			return &gen.AddReviewResponse{}, status.Error(codes.PermissionDenied, "only customers who received the product can review it")
+		}
+		return &gen.AddReviewResponse{}, status.Error(codes.Internal, "internal server error")
 	}
 
-	return &gen.EmptyResponse{}, nil
+	return &gen.AddReviewResponse{Status: string(reviewStatus)}, nil
 }
 
 func (s *ReviewGRPCServer) GetReviews (ctx context.Context, req *gen.GetReviewsRequest) (*gen.GetReviewsResponse, error) {
@@ -73,6 +76,7 @@ func (s *ReviewGRPCServer) GetReviews (ctx context.Context, req *gen.GetReviewsR
 	request := dto.GetReviewRequest {
 		ProductID: productID,
 		Offset: int(req.Offset),
+		Sort: req.Sort,
 	}
 
 	reviews, err := s.reviewUsecase.Get(ctx, request)
@@ -82,4 +86,134 @@ func (s *ReviewGRPCServer) GetReviews (ctx context.Context, req *gen.GetReviewsR
 	}
 
 	return dto.ModelsToGRPC(reviews), nil
-}
\ No newline at end of file
+}
+
+func (s *ReviewGRPCServer) GetReviewSummary(ctx context.Context, req *gen.GetReviewSummaryRequest) (*gen.ReviewSummary, error) {
+	const op = "ReviewGRPCServer.GetReviewSummary"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	productID, err := uuid.Parse(req.ProductId)
+	if err != nil {
+		logger.WithError(err).Error("invalid product ID format")
+		return nil, status.Error(codes.InvalidArgument, errs.ErrInvalidID.Error())
+	}
+
+	summary, err := s.reviewUsecase.Summary(ctx, productID)
+	if err != nil {
+		logger.WithError(err).Error("get review summary")
+		return nil, errs.MapErrorToGRPC(err)
+	}
+
+	return dto.ReviewSummaryToGRPC(summary), nil
+}
+
+func (s *ReviewGRPCServer) UpdateReview(ctx context.Context, req *gen.UpdateReviewRequest) (*gen.EmptyResponse, error) {
+	const op = "ReviewGRPCServer.UpdateReview"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	reviewID, err := uuid.Parse(req.ReviewId)
+	if err != nil {
+		logger.WithError(err).Error("invalid review ID format")
+		return nil, status.Error(codes.InvalidArgument, errs.ErrInvalidID.Error())
+	}
+
+	if req.Rating < 1 || req.Rating > 5 {
+		logger.Error("invalid rating")
+		return nil, status.Error(codes.InvalidArgument, "invalid rating")
+	}
+
+	request := dto.UpdateReviewRequest{
+		ReviewID: reviewID,
+		Rating:   int(req.Rating),
+		Comment:  req.Comment,
+	}
+
+	if err := s.reviewUsecase.Update(ctx, request); err != nil {
+		logger.WithError(err).Error("update review")
+		return nil, errs.MapErrorToGRPC(err)
+	}
+
+	return &gen.EmptyResponse{}, nil
+}
+
+func (s *ReviewGRPCServer) DeleteReview(ctx context.Context, req *gen.DeleteReviewRequest) (*gen.EmptyResponse, error) {
+	const op = "ReviewGRPCServer.DeleteReview"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	reviewID, err := uuid.Parse(req.ReviewId)
+	if err != nil {
+		logger.WithError(err).Error("invalid review ID format")
+		return nil, status.Error(codes.InvalidArgument, errs.ErrInvalidID.Error())
+	}
+
+	if err := s.reviewUsecase.Delete(ctx, reviewID); err != nil {
+		logger.WithError(err).Error("delete review")
+		return nil, errs.MapErrorToGRPC(err)
+	}
+
+	return &gen.EmptyResponse{}, nil
+}
+
+func (s *ReviewGRPCServer) AddReviewPhoto(ctx context.Context, req *gen.AddReviewPhotoRequest) (*gen.EmptyResponse, error) {
+	const op = "ReviewGRPCServer.AddReviewPhoto"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	reviewID, err := uuid.Parse(req.ReviewId)
+	if err != nil {
+		logger.WithError(err).Error("invalid review ID format")
+		return nil, status.Error(codes.InvalidArgument, errs.ErrInvalidID.Error())
+	}
+
+	if req.ImageUrl == "" {
+		logger.Error("empty image url")
+		return nil, status.Error(codes.InvalidArgument, "empty image url")
+	}
+
+	if err := s.reviewUsecase.AddPhoto(ctx, reviewID, req.ImageUrl); err != nil {
+		logger.WithError(err).Error("add review photo")
+		return nil, errs.MapErrorToGRPC(err)
+	}
+
+	return &gen.EmptyResponse{}, nil
+}
+
+func (s *ReviewGRPCServer) VoteReview(ctx context.Context, req *gen.VoteReviewRequest) (*gen.EmptyResponse, error) {
+	const op = "ReviewGRPCServer.VoteReview"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	reviewID, err := uuid.Parse(req.ReviewId)
+	if err != nil {
+		logger.WithError(err).Error("invalid review ID format")
+		return nil, status.Error(codes.InvalidArgument, errs.ErrInvalidID.Error())
+	}
+
+	if err := s.reviewUsecase.Vote(ctx, reviewID, req.Helpful); err != nil {
+		logger.WithError(err).Error("vote review")
+		return nil, errs.MapErrorToGRPC(err)
+	}
+
+	return &gen.EmptyResponse{}, nil
+}
+
+func (s *ReviewGRPCServer) ReplyReview(ctx context.Context, req *gen.ReplyReviewRequest) (*gen.EmptyResponse, error) {
+	const op = "ReviewGRPCServer.ReplyReview"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	reviewID, err := uuid.Parse(req.ReviewId)
+	if err != nil {
+		logger.WithError(err).Error("invalid review ID format")
+		return nil, status.Error(codes.InvalidArgument, errs.ErrInvalidID.Error())
+	}
+
+	if req.Text == "" {
+		logger.Error("empty reply text")
+		return nil, status.Error(codes.InvalidArgument, "empty reply text")
+	}
+
+	if err := s.reviewUsecase.Reply(ctx, reviewID, req.Text); err != nil {
+		logger.WithError(err).Error("reply review")
+		return nil, errs.MapErrorToGRPC(err)
+	}
+
+	return &gen.EmptyResponse{}, nil
+}
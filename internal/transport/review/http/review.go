@@ -1,22 +1,29 @@
 package review
 
 import (
+	"context"
 	"github.com/mailru/easyjson"
+	"io"
 	"net/http"
 
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/minio"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	gen "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/generated/review"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/utils/response"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
 )
 
 type ReviewHandler struct {
 	reviewClient gen.ReviewServiceClient
+	minioService minio.Provider
 }
 
-func NewReviewHandler(rc gen.ReviewServiceClient) *ReviewHandler {
+func NewReviewHandler(rc gen.ReviewServiceClient, ms minio.Provider) *ReviewHandler {
 	return &ReviewHandler{
 		reviewClient: rc,
+		minioService: ms,
 	}
 }
 
@@ -31,14 +38,14 @@ func (h *ReviewHandler) Add(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, err := h.reviewClient.AddReview(r.Context(), dto.ConvertAddReviewRequestToGRPC(addReq))
+	res, err := h.reviewClient.AddReview(r.Context(), dto.ConvertAddReviewRequestToGRPC(addReq))
 	if err != nil {
 		response.HandleGRPCError(r.Context(), w, err, op)
 		logger.Error("add review")
 		return
 	}
 
-	response.SendJSONResponse(r.Context(), w, http.StatusCreated, nil)
+	response.SendJSONResponse(r.Context(), w, http.StatusCreated, dto.AddReviewResponse{Status: res.GetStatus()})
 }
 
 func (h *ReviewHandler) Get(w http.ResponseWriter, r *http.Request) {
@@ -61,3 +68,198 @@ func (h *ReviewHandler) Get(w http.ResponseWriter, r *http.Request) {
 
 	response.SendJSONResponse(r.Context(), w, http.StatusOK, dto.ConvertGRPCToReviewsResponse(reviews))
 }
+
+func (h *ReviewHandler) Summary(w http.ResponseWriter, r *http.Request) {
+	const op = "ReviewHandler.Summary"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	productID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse product ID")
+		response.SendJSONError(r.Context(), w, http.StatusBadRequest, "invalid product ID")
+		return
+	}
+
+	summary, err := h.reviewClient.GetReviewSummary(r.Context(), &gen.GetReviewSummaryRequest{
+		ProductId: productID.String(),
+	})
+	if err != nil {
+		response.HandleGRPCError(r.Context(), w, err, op)
+		logger.Error("get review summary")
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, dto.ConvertGRPCToReviewSummaryResponse(summary))
+}
+
+func (h *ReviewHandler) Update(w http.ResponseWriter, r *http.Request) {
+	const op = "ReviewHandler.Update"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	reviewID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse review ID")
+		response.SendJSONError(r.Context(), w, http.StatusBadRequest, "invalid review ID")
+		return
+	}
+
+	var updateReq dto.UpdateReviewRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &updateReq); err != nil {
+		logger.WithError(err).Error("failed to parse request data")
+		response.SendJSONError(r.Context(), w, http.StatusBadRequest, err.Error())
+		return
+	}
+	updateReq.ReviewID = reviewID
+
+	_, err = h.reviewClient.UpdateReview(r.Context(), dto.ConvertUpdateReviewRequestToGRPC(updateReq))
+	if err != nil {
+		response.HandleGRPCError(r.Context(), w, err, op)
+		logger.Error("update review")
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, nil)
+}
+
+func (h *ReviewHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	const op = "ReviewHandler.Delete"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	reviewID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse review ID")
+		response.SendJSONError(r.Context(), w, http.StatusBadRequest, "invalid review ID")
+		return
+	}
+
+	_, err = h.reviewClient.DeleteReview(r.Context(), &gen.DeleteReviewRequest{ReviewId: reviewID.String()})
+	if err != nil {
+		response.HandleGRPCError(r.Context(), w, err, op)
+		logger.Error("delete review")
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusNoContent, nil)
+}
+
+func (h *ReviewHandler) UploadPhoto(w http.ResponseWriter, r *http.Request) {
+	const op = "ReviewHandler.UploadPhoto"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	reviewID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse review ID")
+		response.SendJSONError(r.Context(), w, http.StatusBadRequest, "invalid review ID")
+		return
+	}
+
+	if err := r.ParseMultipartForm(10 << 20); err != nil { // 10 MB max
+		logger.WithError(err).Error("parse multipart form")
+		response.SendJSONError(r.Context(), w, http.StatusBadRequest, "failed to parse form")
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		logger.WithError(err).Error("get file from form")
+		response.SendJSONError(r.Context(), w, http.StatusBadRequest, "no file uploaded")
+		return
+	}
+	defer file.Close()
+
+	fileBytes, err := io.ReadAll(file)
+	if err != nil {
+		logger.WithError(err).Error("read file content")
+		response.SendJSONError(r.Context(), w, http.StatusBadRequest, "failed to read file")
+		return
+	}
+
+	uploadResponse, err := h.minioService.CreateOne(r.Context(), minio.FileData{
+		Name: header.Filename,
+		Data: fileBytes,
+	})
+	if err != nil {
+		logger.WithError(err).Error("upload file to minio")
+		response.SendJSONError(r.Context(), w, http.StatusInternalServerError, "failed to upload file")
+		return
+	}
+
+	_, err = h.reviewClient.AddReviewPhoto(r.Context(), &gen.AddReviewPhotoRequest{
+		ReviewId: reviewID.String(),
+		ImageUrl: uploadResponse.URL,
+	})
+	if err != nil {
+		// Фото не привязано к отзыву (чужой отзыв, лимит фото и т.п.) —
+		// удаляем загруженный объект, чтобы он не остался в бакете
+		if delErr := h.minioService.DeleteOne(context.WithoutCancel(r.Context()), uploadResponse.ObjectID); delErr != nil {
+			logger.WithError(delErr).Warn("delete orphan review photo")
+		}
+		response.HandleGRPCError(r.Context(), w, err, op)
+		logger.Error("add review photo")
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, uploadResponse)
+}
+
+func (h *ReviewHandler) Vote(w http.ResponseWriter, r *http.Request) {
+	const op = "ReviewHandler.Vote"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	reviewID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse review ID")
+		response.SendJSONError(r.Context(), w, http.StatusBadRequest, "invalid review ID")
+		return
+	}
+
+	var voteReq dto.VoteReviewRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &voteReq); err != nil {
+		logger.WithError(err).Error("failed to parse request data")
+		response.SendJSONError(r.Context(), w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	_, err = h.reviewClient.VoteReview(r.Context(), &gen.VoteReviewRequest{
+		ReviewId: reviewID.String(),
+		Helpful:  voteReq.Helpful,
+	})
+	if err != nil {
+		response.HandleGRPCError(r.Context(), w, err, op)
+		logger.Error("vote review")
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, nil)
+}
+
+func (h *ReviewHandler) Reply(w http.ResponseWriter, r *http.Request) {
+	const op = "ReviewHandler.Reply"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	reviewID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse review ID")
+		response.SendJSONError(r.Context(), w, http.StatusBadRequest, "invalid review ID")
+		return
+	}
+
+	var replyReq dto.ReplyReviewRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &replyReq); err != nil {
+		logger.WithError(err).Error("failed to parse request data")
+		response.SendJSONError(r.Context(), w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	_, err = h.reviewClient.ReplyReview(r.Context(), &gen.ReplyReviewRequest{
+		ReviewId: reviewID.String(),
+		Text:     replyReq.Text,
+	})
+	if err != nil {
+		response.HandleGRPCError(r.Context(), w, err, op)
+		logger.Error("reply review")
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusCreated, nil)
+}
@@ -5,9 +5,16 @@ import (
 
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/google/uuid"
 )
 
 type IReviewUsecase interface {
-	Add(ctx context.Context, req dto.AddReviewRequest) error
+	Add(ctx context.Context, req dto.AddReviewRequest) (models.ReviewStatus, error)
 	Get(ctx context.Context, req dto.GetReviewRequest) ([]*models.Review, error)
+	Summary(ctx context.Context, productID uuid.UUID) (*models.ReviewSummary, error)
+	Update(ctx context.Context, req dto.UpdateReviewRequest) error
+	Delete(ctx context.Context, reviewID uuid.UUID) error
+	AddPhoto(ctx context.Context, reviewID uuid.UUID, imageURL string) error
+	Vote(ctx context.Context, reviewID uuid.UUID, helpful bool) error
+	Reply(ctx context.Context, reviewID uuid.UUID, text string) error
 }
\ No newline at end of file
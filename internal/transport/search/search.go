@@ -13,6 +13,7 @@ import (
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/suggestions"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/utils/response"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/helpers"
 	"github.com/gorilla/mux"
 )
 
@@ -24,10 +25,19 @@ type ISearchUsecase interface {
 		categoryID null.String,
 		subString string,
 		offset int,
-		minPrice, maxPrice float64,
+		minPrice, maxPrice models.Money,
 		minRating float32,
+		attributes []models.AttributeFilter,
 		sortOption models.SortOption,
 	) ([]*models.Product, error)
+	GetAttributeFacets(
+		ctx context.Context,
+		categoryID null.String,
+		subString string,
+		minPrice, maxPrice models.Money,
+		minRating float32,
+		attributes []models.AttributeFilter,
+	) ([]models.AttributeFacet, error)
 }
 
 type SearchService struct {
@@ -61,8 +71,11 @@ func (h *SearchService) SearchWithFilterAndSort(w http.ResponseWriter, r *http.R
 	}
 
 	// Парсинг фильтров
-	minPrice, _ := strconv.ParseFloat(r.URL.Query().Get("min_price"), 64)
-	maxPrice, _ := strconv.ParseFloat(r.URL.Query().Get("max_price"), 64)
+	minPrice, _ := models.ParseMoney(r.URL.Query().Get("min_price"))
+	maxPrice, _ := models.ParseMoney(r.URL.Query().Get("max_price"))
+	// Границы цены покупатель задаёт в той валюте, в которой видит цены
+	rate := helpers.GetExchangeRateFromContext(r.Context())
+	minPrice, maxPrice = rate.ToBase(minPrice), rate.ToBase(maxPrice)
 	minRating, _ := strconv.ParseFloat(r.URL.Query().Get("min_rating"), 32)
 
 	// Парсинг параметра сортировки
@@ -99,6 +112,8 @@ func (h *SearchService) SearchWithFilterAndSort(w http.ResponseWriter, r *http.R
 		}
 	}
 
+	attributes := dto.ConvertToAttributeFilters(req.Attributes)
+
 	// Получение продуктов с фильтрацией и сортировкой
 	products, err := h.u.SearchProductsByNameWithFilterAndSort(
 		r.Context(),
@@ -108,6 +123,7 @@ func (h *SearchService) SearchWithFilterAndSort(w http.ResponseWriter, r *http.R
 		minPrice,
 		maxPrice,
 		float32(minRating),
+		attributes,
 		sortOption,
 	)
 	if err != nil {
@@ -116,10 +132,27 @@ func (h *SearchService) SearchWithFilterAndSort(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	// Значения характеристик для панели фильтров
+	facets, err := h.u.GetAttributeFacets(
+		r.Context(),
+		req.CategoryID,
+		req.SubString,
+		minPrice,
+		maxPrice,
+		float32(minRating),
+		attributes,
+	)
+	if err != nil {
+		logger.WithError(err).Error("failed to get attribute facets")
+		response.HandleDomainError(r.Context(), w, err, "get attribute facets")
+		return
+	}
+
 	// Формирование ответа
 	searchResponse := dto.SearchResponse{
 		Categories: dto.ConvertToCategoriesResponse(categories),
-		Products:   dto.ConvertToProductsResponse(products),
+		Products:   dto.ConvertToProductsResponse(products).InCurrency(rate),
+		Facets:     dto.ConvertToAttributeFacetsDTO(facets),
 	}
 
 	response.SendJSONResponse(r.Context(), w, http.StatusOK, searchResponse)
@@ -20,10 +20,13 @@ import (
 
 //go:generate mockgen -source=seller.go -destination=../../usecase/mocks/seller_usecase_mock.go -package=mocks ISellerUsecase
 type ISellerUsecase interface {
-	AddProduct(ctx context.Context, product *models.Product, categoryID uuid.UUID) (*models.Product, error)
+	AddProduct(ctx context.Context, product *models.Product, categoryID uuid.UUID, attributes []models.ProductAttribute) (*models.Product, error)
 	UploadProductImage(ctx context.Context, productID uuid.UUID, imageURL string) error
 	GetSellerProducts(ctx context.Context, sellerID uuid.UUID, offset int) ([]*models.Product, error)
 	CheckProductBelongs(ctx context.Context, productID, sellerID uuid.UUID) (bool, error)
+	AddVariant(ctx context.Context, sellerID, productID uuid.UUID, req dto.AddVariantRequest) (*models.ProductVariant, error)
+	UpdateVariant(ctx context.Context, sellerID, variantID uuid.UUID, req dto.UpdateVariantRequest) (*models.ProductVariant, error)
+	DeleteVariant(ctx context.Context, sellerID, variantID uuid.UUID) error
 }
 
 type SellerHandler struct {
@@ -48,6 +51,7 @@ func NewSellerHandler(u ISellerUsecase, ms minio.Provider) *SellerHandler {
 // @Success 201 {object} models.Product
 // @Failure 400 {object} object
 // @Failure 403 {object} object
+// @Failure 422 {object} object "Характеристики не соответствуют схеме категории"
 // @Failure 500 {object} object
 // @Router /seller/products [post]
 func (h *SellerHandler) AddProduct(w http.ResponseWriter, r *http.Request) {
@@ -85,7 +89,7 @@ func (h *SellerHandler) AddProduct(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	newProduct, err := h.usecase.AddProduct(r.Context(), product, categoryID)
+	newProduct, err := h.usecase.AddProduct(r.Context(), product, categoryID, req.Attributes)
 	if err != nil {
 		logger.WithError(err).Error("add product")
 		response.HandleDomainError(r.Context(), w, err, op)
@@ -225,3 +229,143 @@ func (h *SellerHandler) GetSellerProducts(w http.ResponseWriter, r *http.Request
 	productResponse := dto.ConvertToSellerProductsResponse(products)
 	response.SendJSONResponse(r.Context(), w, http.StatusOK, productResponse)
 }
+
+// AddVariant godoc
+// @Summary Добавить вариант товара
+// @Description Добавляет SKU со своими опциями, ценой, остатком и изображениями. Цена и остаток товара пересчитываются по вариантам
+// @Tags seller
+// @Accept json
+// @Produce json
+// @Param id path string true "ID товара"
+// @Param request body dto.AddVariantRequest true "Данные варианта"
+// @Param X-Csrf-Token header string true "CSRF-токен для защиты от подделки запросов"
+// @Success 201 {object} models.ProductVariant
+// @Failure 400 {object} object
+// @Failure 403 {object} object "Товар не принадлежит продавцу"
+// @Failure 409 {object} object "Вариант с таким артикулом или опциями уже есть"
+// @Failure 422 {object} object "Некорректные опции варианта"
+// @Security TokenAuth
+// @Router /seller/products/{id}/variants [post]
+func (h *SellerHandler) AddVariant(w http.ResponseWriter, r *http.Request) {
+	const op = "SellerHandler.AddVariant"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	sellerID, err := helpers.GetUserIDFromContext(r.Context())
+	if err != nil {
+		logger.WithError(err).Error("get user ID from context")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	productID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse product ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	var req dto.AddVariantRequest
+	if err = easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Error("parse request data")
+		response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+		return
+	}
+
+	variant, err := h.usecase.AddVariant(r.Context(), sellerID, productID, req)
+	if err != nil {
+		logger.WithError(err).Error("add variant")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusCreated, variant)
+}
+
+// UpdateVariant godoc
+// @Summary Изменить вариант товара
+// @Description Меняет цену, остаток и изображения SKU; артикул и опции не меняются
+// @Tags seller
+// @Accept json
+// @Produce json
+// @Param id path string true "ID варианта"
+// @Param request body dto.UpdateVariantRequest true "Новые данные варианта"
+// @Param X-Csrf-Token header string true "CSRF-токен для защиты от подделки запросов"
+// @Success 200 {object} models.ProductVariant
+// @Failure 400 {object} object
+// @Failure 403 {object} object "Товар не принадлежит продавцу"
+// @Failure 404 {object} object "Вариант не найден"
+// @Security TokenAuth
+// @Router /seller/variants/{id} [put]
+func (h *SellerHandler) UpdateVariant(w http.ResponseWriter, r *http.Request) {
+	const op = "SellerHandler.UpdateVariant"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	sellerID, err := helpers.GetUserIDFromContext(r.Context())
+	if err != nil {
+		logger.WithError(err).Error("get user ID from context")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	variantID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse variant ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	var req dto.UpdateVariantRequest
+	if err = easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Error("parse request data")
+		response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+		return
+	}
+
+	variant, err := h.usecase.UpdateVariant(r.Context(), sellerID, variantID, req)
+	if err != nil {
+		logger.WithError(err).Error("update variant")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, variant)
+}
+
+// DeleteVariant godoc
+// @Summary Удалить вариант товара
+// @Description Удаляет SKU вместе с его позициями в корзинах; в оформленных заказах позиции сохраняются
+// @Tags seller
+// @Param id path string true "ID варианта"
+// @Param X-Csrf-Token header string true "CSRF-токен для защиты от подделки запросов"
+// @Success 204 "Вариант удалён"
+// @Failure 400 {object} object
+// @Failure 403 {object} object "Товар не принадлежит продавцу"
+// @Failure 404 {object} object "Вариант не найден"
+// @Security TokenAuth
+// @Router /seller/variants/{id} [delete]
+func (h *SellerHandler) DeleteVariant(w http.ResponseWriter, r *http.Request) {
+	const op = "SellerHandler.DeleteVariant"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	sellerID, err := helpers.GetUserIDFromContext(r.Context())
+	if err != nil {
+		logger.WithError(err).Error("get user ID from context")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	variantID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse variant ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	if err = h.usecase.DeleteVariant(r.Context(), sellerID, variantID); err != nil {
+		logger.WithError(err).Error("delete variant")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusNoContent, nil)
+}
@@ -24,7 +24,7 @@ func TestGetAddress(t *testing.T) {
 
 	// Создаем мок репозитория и юзкейса
 	addressUsecase := mocks.NewMockIAddressUsecase(ctrl)
-	addressHandler := address.NewAddressHandler(addressUsecase, "geoapify-api-key")
+	addressHandler := address.NewAddressHandler(addressUsecase)
 
 	// Данные для теста
 	userID := uuid.New()
@@ -73,7 +73,7 @@ func TestGetPickupPoints(t *testing.T) {
 
 	// Создаем мок репозитория и юзкейса
 	addressUsecase := mocks.NewMockIAddressUsecase(ctrl)
-	addressHandler := address.NewAddressHandler(addressUsecase, "geoapify-api-key")
+	addressHandler := address.NewAddressHandler(addressUsecase)
 
 	// Данные для теста
 	points := []dto.GetPointAddressResDTO{
@@ -117,7 +117,7 @@ func TestCreateAddress(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUsecase := mocks.NewMockIAddressUsecase(ctrl)
-	handler := address.NewAddressHandler(mockUsecase, "test-api-key")
+	handler := address.NewAddressHandler(mockUsecase)
 
 	userID := uuid.New()
 	addressReq := dto.AddressReqDTO{
@@ -227,3 +227,68 @@ func TestAdminService_GetPendingUsers(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
 	})
 }
+
+func TestAdminService_GetPendingReviews(t *testing.T) {
+	mockUsecase, service := setupTestAdmin(t)
+
+	t.Run("success", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			GetPendingReviews(gomock.Any(), 0).
+			Return(dto.ModerationReviewsResponse{Total: 1, Reviews: []dto.ModerationReviewDTO{{ID: uuid.New(), Reason: "link"}}}, nil)
+
+		req := httptest.NewRequest("GET", "/api/v1/admin/reviews/0", nil)
+		req = mux.SetURLVars(req, map[string]string{"offset": "0"})
+		w := httptest.NewRecorder()
+
+		service.GetPendingReviews(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+		assert.Contains(t, w.Body.String(), `"reason":"link"`)
+	})
+
+	t.Run("invalid offset", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/admin/reviews/abc", nil)
+		req = mux.SetURLVars(req, map[string]string{"offset": "abc"})
+		w := httptest.NewRecorder()
+
+		service.GetPendingReviews(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Result().StatusCode)
+	})
+}
+
+func TestAdminService_UpdateReviewStatus(t *testing.T) {
+	mockUsecase, service := setupTestAdmin(t)
+
+	t.Run("success", func(t *testing.T) {
+		reqBody := dto.UpdateReviewStatusRequest{ReviewID: uuid.New(), Update: 1}
+		body, _ := json.Marshal(reqBody)
+
+		mockUsecase.EXPECT().
+			UpdateReviewStatus(gomock.Any(), reqBody).
+			Return(nil)
+
+		req := httptest.NewRequest("POST", "/api/v1/admin/review/update", bytes.NewReader(body))
+		w := httptest.NewRecorder()
+
+		service.UpdateReviewStatus(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+	})
+
+	t.Run("internal error", func(t *testing.T) {
+		reqBody := dto.UpdateReviewStatusRequest{ReviewID: uuid.New(), Update: 0}
+		body, _ := json.Marshal(reqBody)
+
+		mockUsecase.EXPECT().
+			UpdateReviewStatus(gomock.Any(), reqBody).
+			Return(errors.New("fail"))
+
+		req := httptest.NewRequest("POST", "/api/v1/admin/review/update", bytes.NewReader(body))
+		w := httptest.NewRecorder()
+
+		service.UpdateReviewStatus(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Result().StatusCode)
+	})
+}
@@ -0,0 +1,128 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/analytics"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyticsService_GetSummary(t *testing.T) {
+	sellerID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIAnalyticsUsecase(ctrl)
+		handler := analytics.NewAnalyticsService(mockUsecase)
+
+		period := models.AnalyticsPeriod{
+			From:   time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC),
+			To:     time.Date(2025, 5, 31, 0, 0, 0, 0, time.UTC),
+			Bucket: models.AnalyticsWeek,
+		}
+		mockUsecase.EXPECT().GetSummary(gomock.Any(), sellerID, period).
+			Return(dto.SellerAnalyticsDTO{From: "2025-05-01", To: "2025-05-31", Bucket: "week"}, nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/seller/analytics/summary?from=2025-05-01&to=2025-05-31&bucket=week", nil)
+		w := httptest.NewRecorder()
+		handler.GetSummary(w, withSeller(r, sellerID))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp dto.SellerAnalyticsDTO
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, "week", resp.Bucket)
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		handler := analytics.NewAnalyticsService(nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/seller/analytics/summary?from=01.05.2025", nil)
+		w := httptest.NewRecorder()
+		handler.GetSummary(w, withSeller(r, sellerID))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid period", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIAnalyticsUsecase(ctrl)
+		handler := analytics.NewAnalyticsService(mockUsecase)
+
+		mockUsecase.EXPECT().GetSummary(gomock.Any(), sellerID, gomock.Any()).
+			Return(dto.SellerAnalyticsDTO{}, errs.NewBusinessLogicError("bucket must be day, week or month"))
+
+		r := httptest.NewRequest(http.MethodGet, "/seller/analytics/summary?bucket=year", nil)
+		w := httptest.NewRecorder()
+		handler.GetSummary(w, withSeller(r, sellerID))
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+}
+
+func TestAnalyticsService_GetTopProducts(t *testing.T) {
+	sellerID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIAnalyticsUsecase(ctrl)
+		handler := analytics.NewAnalyticsService(mockUsecase)
+
+		productID := uuid.New()
+		mockUsecase.EXPECT().GetTopProducts(gomock.Any(), sellerID, models.AnalyticsPeriod{}, 5).
+			Return([]models.TopProduct{{ProductID: productID, Name: "Кружка", Revenue: models.Rubles(900), Units: 3}}, nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/seller/analytics/top-products?limit=5", nil)
+		w := httptest.NewRecorder()
+		handler.GetTopProducts(w, withSeller(r, sellerID))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp []models.TopProduct
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.Len(t, resp, 1)
+		assert.Equal(t, productID, resp[0].ProductID)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		handler := analytics.NewAnalyticsService(nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/seller/analytics/top-products?limit=many", nil)
+		w := httptest.NewRecorder()
+		handler.GetTopProducts(w, withSeller(r, sellerID))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestAnalyticsService_GetStockOuts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := mocks.NewMockIAnalyticsUsecase(ctrl)
+	handler := analytics.NewAnalyticsService(mockUsecase)
+
+	sellerID := uuid.New()
+	mockUsecase.EXPECT().GetStockOuts(gomock.Any(), sellerID, models.AnalyticsPeriod{}, 20).
+		Return([]models.StockOutProduct{{ProductID: uuid.New(), Name: "Кружка", Days: 4}}, nil)
+
+	r := httptest.NewRequest(http.MethodGet, "/seller/analytics/stock-outs?offset=20", nil)
+	w := httptest.NewRecorder()
+	handler.GetStockOuts(w, withSeller(r, sellerID))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp []models.StockOutProduct
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, 4, resp[0].Days)
+}
@@ -0,0 +1,130 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/attribute"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttributeService_GetCategoryAttributes(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIAttributeUsecase(ctrl)
+		handler := attribute.NewAttributeService(mockUsecase)
+
+		categoryID := uuid.New()
+		mockUsecase.EXPECT().GetCategoryAttributes(gomock.Any(), categoryID).
+			Return(dto.CategoryAttributesResponse{Attributes: []models.CategoryAttribute{
+				{ID: uuid.New(), CategoryID: categoryID, Name: "Бренд", Type: models.AttributeEnum, Options: []string{"Apple"}},
+			}}, nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/categories/"+categoryID.String()+"/attributes", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": categoryID.String()})
+		w := httptest.NewRecorder()
+		handler.GetCategoryAttributes(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp dto.CategoryAttributesResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.Len(t, resp.Attributes, 1)
+		assert.Equal(t, models.AttributeEnum, resp.Attributes[0].Type)
+	})
+
+	t.Run("invalid ID", func(t *testing.T) {
+		handler := attribute.NewAttributeService(nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/categories/abc/attributes", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": "abc"})
+		w := httptest.NewRecorder()
+		handler.GetCategoryAttributes(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestAttributeService_CreateAttribute(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIAttributeUsecase(ctrl)
+		handler := attribute.NewAttributeService(mockUsecase)
+
+		categoryID := uuid.New()
+		req := dto.CreateAttributeRequest{Name: "Вес", Type: models.AttributeNumber}
+		mockUsecase.EXPECT().CreateAttribute(gomock.Any(), categoryID, req).
+			Return(&models.CategoryAttribute{ID: uuid.New(), CategoryID: categoryID, Name: "Вес", Type: models.AttributeNumber}, nil)
+
+		body, _ := json.Marshal(req)
+		r := httptest.NewRequest(http.MethodPost, "/admin/categories/"+categoryID.String()+"/attributes", bytes.NewReader(body))
+		r = mux.SetURLVars(r, map[string]string{"id": categoryID.String()})
+		w := httptest.NewRecorder()
+		handler.CreateAttribute(w, r)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("invalid schema", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIAttributeUsecase(ctrl)
+		handler := attribute.NewAttributeService(mockUsecase)
+
+		categoryID := uuid.New()
+		mockUsecase.EXPECT().CreateAttribute(gomock.Any(), categoryID, gomock.Any()).
+			Return(nil, errs.NewBusinessLogicError("enum attribute requires options"))
+
+		r := httptest.NewRequest(http.MethodPost, "/admin/categories/"+categoryID.String()+"/attributes",
+			bytes.NewReader([]byte(`{"name":"Бренд","type":"enum"}`)))
+		r = mux.SetURLVars(r, map[string]string{"id": categoryID.String()})
+		w := httptest.NewRecorder()
+		handler.CreateAttribute(w, r)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("duplicate name", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIAttributeUsecase(ctrl)
+		handler := attribute.NewAttributeService(mockUsecase)
+
+		categoryID := uuid.New()
+		mockUsecase.EXPECT().CreateAttribute(gomock.Any(), categoryID, gomock.Any()).
+			Return(nil, errs.NewAlreadyExistsError("attribute with this name already exists in category"))
+
+		r := httptest.NewRequest(http.MethodPost, "/admin/categories/"+categoryID.String()+"/attributes",
+			bytes.NewReader([]byte(`{"name":"Бренд","type":"enum","options":["Apple"]}`)))
+		r = mux.SetURLVars(r, map[string]string{"id": categoryID.String()})
+		w := httptest.NewRecorder()
+		handler.CreateAttribute(w, r)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestAttributeService_DeleteAttribute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := mocks.NewMockIAttributeUsecase(ctrl)
+	handler := attribute.NewAttributeService(mockUsecase)
+
+	id := uuid.New()
+	mockUsecase.EXPECT().DeleteAttribute(gomock.Any(), id).Return(nil)
+
+	r := httptest.NewRequest(http.MethodDelete, "/admin/attributes/"+id.String(), nil)
+	r = mux.SetURLVars(r, map[string]string{"id": id.String()})
+	w := httptest.NewRecorder()
+	handler.DeleteAttribute(w, r)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
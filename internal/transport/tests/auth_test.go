@@ -3,12 +3,17 @@ package tests
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/domains"
 	authhttp "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/auth/http"
 	gen "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/generated/auth"
 	genmock "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/generated/auth/mocks"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/jwt"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/mocks"
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -30,7 +35,7 @@ func TestAuthHandler_Login(t *testing.T) {
 		CSRFConfig: &config.CSRFConfig{SecretKey: "secret-key", TokenExpiry: time.Hour},
 	}
 
-	handler := authhttp.NewAuthHandler(mockClient, cfg)
+	handler := authhttp.NewAuthHandler(mockClient, cfg, nil, mocks.NewMockIGuestBasketMerger(ctrl))
 
 	t.Run("success", func(t *testing.T) {
 		reqData := map[string]string{
@@ -86,6 +91,95 @@ func TestAuthHandler_Login(t *testing.T) {
 	})
 }
 
+func TestAuthHandler_LoginMergesGuestBasket(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := genmock.NewMockAuthServiceClient(ctrl)
+	mockMerger := mocks.NewMockIGuestBasketMerger(ctrl)
+
+	cfg := &config.Config{
+		CSRFConfig:        &config.CSRFConfig{SecretKey: "secret-key", TokenExpiry: time.Hour},
+		GuestBasketConfig: &config.GuestBasketConfig{SecretKey: "guest-secret", TTL: time.Hour},
+	}
+	tokenator := jwt.NewTokenator(&config.JWTConfig{Signature: "jwt-secret", TokenLifeSpan: time.Hour})
+
+	handler := authhttp.NewAuthHandler(mockClient, cfg, tokenator, mockMerger)
+
+	userID := uuid.New()
+	token, err := tokenator.CreateJWT(userID.String(), "buyer")
+	assert.NoError(t, err)
+
+	body, _ := json.Marshal(map[string]string{
+		"email":    "test@example.com",
+		"password": "password123",
+	})
+
+	t.Run("merge guest basket", func(t *testing.T) {
+		guestID := uuid.New()
+		mockClient.EXPECT().Login(gomock.Any(), gomock.Any()).Return(&gen.LoginRes{Token: token}, nil)
+		mockMerger.EXPECT().MergeGuest(gomock.Any(), guestID, userID).Return(nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/auth/login", bytes.NewReader(body))
+		req.AddCookie(&http.Cookie{
+			Name:  domains.GuestBasketCookieName,
+			Value: middleware.SignGuestID(guestID, "guest-secret"),
+		})
+		w := httptest.NewRecorder()
+
+		handler.Login(w, req)
+
+		resp := w.Result()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var guestCookie *http.Cookie
+		for _, c := range resp.Cookies() {
+			if c.Name == domains.GuestBasketCookieName {
+				guestCookie = c
+			}
+		}
+		if assert.NotNil(t, guestCookie) {
+			assert.Empty(t, guestCookie.Value)
+		}
+	})
+
+	t.Run("forged guest cookie is ignored", func(t *testing.T) {
+		mockClient.EXPECT().Login(gomock.Any(), gomock.Any()).Return(&gen.LoginRes{Token: token}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/auth/login", bytes.NewReader(body))
+		req.AddCookie(&http.Cookie{
+			Name:  domains.GuestBasketCookieName,
+			Value: middleware.SignGuestID(uuid.New(), "other-secret"),
+		})
+		w := httptest.NewRecorder()
+
+		handler.Login(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+	})
+
+	t.Run("merge error does not break login", func(t *testing.T) {
+		guestID := uuid.New()
+		mockClient.EXPECT().Login(gomock.Any(), gomock.Any()).Return(&gen.LoginRes{Token: token}, nil)
+		mockMerger.EXPECT().MergeGuest(gomock.Any(), guestID, userID).Return(errors.New("db error"))
+
+		req := httptest.NewRequest(http.MethodPost, "/auth/login", bytes.NewReader(body))
+		req.AddCookie(&http.Cookie{
+			Name:  domains.GuestBasketCookieName,
+			Value: middleware.SignGuestID(guestID, "guest-secret"),
+		})
+		w := httptest.NewRecorder()
+
+		handler.Login(w, req)
+
+		resp := w.Result()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		for _, c := range resp.Cookies() {
+			assert.NotEqual(t, domains.GuestBasketCookieName, c.Name)
+		}
+	})
+}
+
 func TestAuthHandler_Register(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -97,7 +191,7 @@ func TestAuthHandler_Register(t *testing.T) {
 		CSRFConfig: &config.CSRFConfig{SecretKey: "secret-key", TokenExpiry: time.Hour},
 	}
 
-	handler := authhttp.NewAuthHandler(mockClient, cfg)
+	handler := authhttp.NewAuthHandler(mockClient, cfg, nil, mocks.NewMockIGuestBasketMerger(ctrl))
 
 	t.Run("invalid body", func(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/auth/register", bytes.NewReader([]byte("invalid-json")))
@@ -138,7 +232,7 @@ func TestAuthHandler_Logout(t *testing.T) {
 		CSRFConfig: &config.CSRFConfig{SecretKey: "secret-key", TokenExpiry: time.Hour},
 	}
 
-	handler := authhttp.NewAuthHandler(mockClient, cfg)
+	handler := authhttp.NewAuthHandler(mockClient, cfg, nil, mocks.NewMockIGuestBasketMerger(ctrl))
 
 	t.Run("success", func(t *testing.T) {
 		// Mock JWT token
@@ -36,14 +36,14 @@ func TestBasketService_Get(t *testing.T) {
 				ID:            uuid.New(),
 				ProductID:     uuid.New(),
 				Quantity:      2,
-				Price:         10.5,
-				PriceDiscount: 9.5,
+				Price:         models.Kopecks(1050),
+				PriceDiscount: models.Kopecks(950),
 			},
 			{
 				ID:        uuid.New(),
 				ProductID: uuid.New(),
 				Quantity:  1,
-				Price:     15.0,
+				Price:     models.Rubles(15),
 			},
 		}
 
@@ -65,8 +65,8 @@ func TestBasketService_Get(t *testing.T) {
 
 		// Проверяем правильность преобразования в DTO
 		assert.Equal(t, len(expectedItems), responseData.Total)
-		assert.Equal(t, 36.0, responseData.TotalPrice)         // 10.5*2 + 15.0*1
-		assert.Equal(t, 34.0, responseData.TotalPriceDiscount) // 9.5*2 + 15.0*1
+		assert.Equal(t, models.Rubles(36), responseData.TotalPrice)         // 10.5*2 + 15.0*1
+		assert.Equal(t, models.Rubles(34), responseData.TotalPriceDiscount) // 9.5*2 + 15.0*1
 		assert.Equal(t, *expectedItems[0], responseData.Products[0])
 		assert.Equal(t, *expectedItems[1], responseData.Products[1])
 	})
@@ -88,8 +88,8 @@ func TestBasketService_Get(t *testing.T) {
 		err := json.NewDecoder(resp.Body).Decode(&responseData)
 		assert.NoError(t, err)
 		assert.Equal(t, 0, responseData.Total)
-		assert.Equal(t, 0.0, responseData.TotalPrice)
-		assert.Equal(t, 0.0, responseData.TotalPriceDiscount)
+		assert.Equal(t, models.Money{}, responseData.TotalPrice)
+		assert.Equal(t, models.Money{}, responseData.TotalPriceDiscount)
 		assert.Empty(t, responseData.Products)
 	})
 }
@@ -103,11 +103,11 @@ func TestBasketService_Add(t *testing.T) {
 			ID:        uuid.New(),
 			ProductID: productID,
 			Quantity:  1,
-			Price:     10.99,
+			Price:     models.Kopecks(1099),
 		}
 
 		mockUsecase.EXPECT().
-			Add(gomock.Any(), productID).
+			Add(gomock.Any(), productID, uuid.NullUUID{}).
 			Return(expectedItem, nil)
 
 		req := httptest.NewRequest("POST", "/api/v1/basket/"+productID.String(), nil)
@@ -125,6 +125,40 @@ func TestBasketService_Add(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, *expectedItem, responseData)
 	})
+
+	t.Run("with variant", func(t *testing.T) {
+		variantID := uuid.New()
+		expectedItem := &models.BasketItem{
+			ID:        uuid.New(),
+			ProductID: productID,
+			VariantID: uuid.NullUUID{UUID: variantID, Valid: true},
+			Quantity:  1,
+		}
+
+		mockUsecase.EXPECT().
+			Add(gomock.Any(), productID, uuid.NullUUID{UUID: variantID, Valid: true}).
+			Return(expectedItem, nil)
+
+		req := httptest.NewRequest("POST", "/api/v1/basket/"+productID.String()+"?variant="+variantID.String(), nil)
+		w := httptest.NewRecorder()
+
+		req = mux.SetURLVars(req, map[string]string{"id": productID.String()})
+
+		service.Add(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Result().StatusCode)
+	})
+
+	t.Run("invalid variant id", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/basket/"+productID.String()+"?variant=invalid", nil)
+		w := httptest.NewRecorder()
+
+		req = mux.SetURLVars(req, map[string]string{"id": productID.String()})
+
+		service.Add(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	})
 }
 
 func TestBasketService_UpdateQuantity(t *testing.T) {
@@ -137,12 +171,12 @@ func TestBasketService_UpdateQuantity(t *testing.T) {
 			ID:        uuid.New(),
 			ProductID: productID,
 			Quantity:  quantity,
-			Price:     12.5,
+			Price:     models.Kopecks(1250),
 		}
 
 		// Мокируем вызов usecase
 		mockUsecase.EXPECT().
-			UpdateQuantity(gomock.Any(), productID, quantity).
+			UpdateQuantity(gomock.Any(), productID, uuid.NullUUID{}, quantity).
 			Return(expectedItem, nil)
 
 		// Подготавливаем запрос
@@ -189,7 +223,7 @@ func TestBasketService_UpdateQuantity(t *testing.T) {
 	t.Run("usecase error", func(t *testing.T) {
 		quantity := 2
 		mockUsecase.EXPECT().
-			UpdateQuantity(gomock.Any(), productID, quantity).
+			UpdateQuantity(gomock.Any(), productID, uuid.NullUUID{}, quantity).
 			Return(nil, errs.NewNotFoundError("product not found")) // ✅ теперь 2 аргумента
 
 		requestBody := dto.UpdateQuantityRequest{Quantity: quantity}
@@ -215,22 +249,22 @@ func TestConvertToBasketResponse(t *testing.T) {
 				ID:            uuid.New(),
 				ProductID:     uuid.New(),
 				Quantity:      2,
-				Price:         10.0,
-				PriceDiscount: 8.0,
+				Price:         models.Rubles(10),
+				PriceDiscount: models.Rubles(8),
 			},
 			{
 				ID:        uuid.New(),
 				ProductID: uuid.New(),
 				Quantity:  1,
-				Price:     15.0,
+				Price:     models.Rubles(15),
 			},
 		}
 
 		result := dto.ConvertToBasketResponse(items)
 
 		assert.Equal(t, 2, result.Total)
-		assert.Equal(t, 35.0, result.TotalPrice)         // 10*2 + 15*1
-		assert.Equal(t, 31.0, result.TotalPriceDiscount) // 8*2 + 15*1
+		assert.Equal(t, models.Rubles(35), result.TotalPrice)         // 10*2 + 15*1
+		assert.Equal(t, models.Rubles(31), result.TotalPriceDiscount) // 8*2 + 15*1
 		assert.Equal(t, *items[0], result.Products[0])
 		assert.Equal(t, *items[1], result.Products[1])
 	})
@@ -240,8 +274,8 @@ func TestConvertToBasketResponse(t *testing.T) {
 		result := dto.ConvertToBasketResponse(items)
 
 		assert.Equal(t, 0, result.Total)
-		assert.Equal(t, 0.0, result.TotalPrice)
-		assert.Equal(t, 0.0, result.TotalPriceDiscount)
+		assert.Equal(t, models.Money{}, result.TotalPrice)
+		assert.Equal(t, models.Money{}, result.TotalPriceDiscount)
 		assert.Empty(t, result.Products)
 	})
 }
@@ -298,7 +332,7 @@ func TestBasketService_Delete(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		mockUsecase.EXPECT().
-			Delete(gomock.Any(), productID).
+			Delete(gomock.Any(), productID, uuid.NullUUID{}).
 			Return(nil)
 
 		req := httptest.NewRequest("DELETE", "/api/v1/basket/"+productID.String(), nil)
@@ -326,7 +360,7 @@ func TestBasketService_Delete(t *testing.T) {
 
 	t.Run("product not found", func(t *testing.T) {
 		mockUsecase.EXPECT().
-			Delete(gomock.Any(), productID).
+			Delete(gomock.Any(), productID, uuid.NullUUID{}).
 			Return(errs.NewNotFoundError("product not found"))
 
 		req := httptest.NewRequest("DELETE", "/api/v1/basket/"+productID.String(), nil)
@@ -342,7 +376,7 @@ func TestBasketService_Delete(t *testing.T) {
 
 	t.Run("unauthorized", func(t *testing.T) {
 		mockUsecase.EXPECT().
-			Delete(gomock.Any(), productID).
+			Delete(gomock.Any(), productID, uuid.NullUUID{}).
 			Return(errs.ErrInvalidToken)
 
 		req := httptest.NewRequest("DELETE", "/api/v1/basket/"+productID.String(), nil)
@@ -358,7 +392,7 @@ func TestBasketService_Delete(t *testing.T) {
 
 	t.Run("internal error", func(t *testing.T) {
 		mockUsecase.EXPECT().
-			Delete(gomock.Any(), productID).
+			Delete(gomock.Any(), productID, uuid.NullUUID{}).
 			Return(errors.New("internal error"))
 
 		req := httptest.NewRequest("DELETE", "/api/v1/basket/"+productID.String(), nil)
@@ -372,3 +406,68 @@ func TestBasketService_Delete(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
 	})
 }
+
+func TestBasketService_Quote(t *testing.T) {
+	mockUsecase, service := setupTestBasket(t)
+
+	t.Run("success with promo", func(t *testing.T) {
+		expected := dto.BasketQuoteResponse{
+			Items:    []dto.QuoteLineResponse{{ProductID: uuid.New(), Quantity: 1, UnitPrice: models.Rubles(500), FinalUnitPrice: models.Rubles(500), LineTotal: models.Rubles(500), Available: true}},
+			Currency: models.BaseCurrency,
+			Subtotal: models.Rubles(500),
+			Promo:    &dto.QuotePromoResponse{Code: "SALE", Reason: "expired", Message: "promo code expired"},
+			Total:    models.Rubles(500),
+		}
+		mockUsecase.EXPECT().
+			Quote(gomock.Any(), "SALE").
+			Return(expected, nil)
+
+		req := httptest.NewRequest("POST", "/basket/quote", strings.NewReader(`{"promo_code":"SALE"}`))
+		w := httptest.NewRecorder()
+
+		service.Quote(w, req)
+
+		resp := w.Result()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result dto.BasketQuoteResponse
+		err := json.NewDecoder(resp.Body).Decode(&result)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("empty body", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Quote(gomock.Any(), "").
+			Return(dto.BasketQuoteResponse{Items: []dto.QuoteLineResponse{}}, nil)
+
+		req := httptest.NewRequest("POST", "/basket/quote", nil)
+		w := httptest.NewRecorder()
+
+		service.Quote(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/basket/quote", strings.NewReader(`{invalid`))
+		w := httptest.NewRecorder()
+
+		service.Quote(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Result().StatusCode)
+	})
+
+	t.Run("internal error", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			Quote(gomock.Any(), "").
+			Return(dto.BasketQuoteResponse{}, errors.New("internal error"))
+
+		req := httptest.NewRequest("POST", "/basket/quote", nil)
+		w := httptest.NewRecorder()
+
+		service.Quote(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Result().StatusCode)
+	})
+}
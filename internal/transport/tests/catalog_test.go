@@ -0,0 +1,203 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/catalog"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var catalogImportConf = &config.CatalogImportConfig{MaxFileSize: 1 << 10}
+
+func withSeller(r *http.Request, sellerID uuid.UUID) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), domains.UserIDKey{}, sellerID.String()))
+}
+
+func TestCatalogService_StartImport(t *testing.T) {
+	sellerID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockICatalogUsecase(ctrl)
+		handler := catalog.NewCatalogService(mockUsecase, catalogImportConf)
+
+		var body bytes.Buffer
+		form := multipart.NewWriter(&body)
+		part, err := form.CreateFormFile("file", "catalog.csv")
+		require.NoError(t, err)
+		_, err = part.Write([]byte("sku,name\n"))
+		require.NoError(t, err)
+		require.NoError(t, form.Close())
+
+		importID := uuid.New()
+		mockUsecase.EXPECT().StartImport(gomock.Any(), sellerID, "catalog.csv", []byte("sku,name\n")).
+			Return(&models.CatalogImport{ID: importID, Format: models.CatalogCSV, Status: models.ImportPending}, nil)
+
+		r := httptest.NewRequest(http.MethodPost, "/seller/catalog/imports", &body)
+		r.Header.Set("Content-Type", form.FormDataContentType())
+		w := httptest.NewRecorder()
+		handler.StartImport(w, withSeller(r, sellerID))
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+
+		var resp models.CatalogImport
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, importID, resp.ID)
+		assert.Equal(t, models.ImportPending, resp.Status)
+	})
+
+	t.Run("no file", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		handler := catalog.NewCatalogService(mocks.NewMockICatalogUsecase(ctrl), catalogImportConf)
+
+		r := httptest.NewRequest(http.MethodPost, "/seller/catalog/imports", nil)
+		w := httptest.NewRecorder()
+		handler.StartImport(w, withSeller(r, sellerID))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("file too large", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		// Usecase не вызывается: тело обрезается ещё при разборе формы
+		handler := catalog.NewCatalogService(mocks.NewMockICatalogUsecase(ctrl), catalogImportConf)
+
+		var body bytes.Buffer
+		form := multipart.NewWriter(&body)
+		part, err := form.CreateFormFile("file", "catalog.csv")
+		require.NoError(t, err)
+		_, err = part.Write(bytes.Repeat([]byte("a"), int(catalogImportConf.MaxFileSize)+2<<20))
+		require.NoError(t, err)
+		require.NoError(t, form.Close())
+
+		r := httptest.NewRequest(http.MethodPost, "/seller/catalog/imports", &body)
+		r.Header.Set("Content-Type", form.FormDataContentType())
+		w := httptest.NewRecorder()
+		handler.StartImport(w, withSeller(r, sellerID))
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+}
+
+func TestCatalogService_GetImport(t *testing.T) {
+	sellerID := uuid.New()
+	importID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockICatalogUsecase(ctrl)
+		handler := catalog.NewCatalogService(mockUsecase, catalogImportConf)
+
+		mockUsecase.EXPECT().GetImport(gomock.Any(), sellerID, importID).
+			Return(&models.CatalogImport{ID: importID, Status: models.ImportDone, TotalRows: 2, FailedRows: 1}, nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/seller/catalog/imports/"+importID.String(), nil)
+		r = mux.SetURLVars(r, map[string]string{"id": importID.String()})
+		w := httptest.NewRecorder()
+		handler.GetImport(w, withSeller(r, sellerID))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp models.CatalogImport
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, 1, resp.FailedRows)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		handler := catalog.NewCatalogService(mocks.NewMockICatalogUsecase(ctrl), catalogImportConf)
+
+		r := httptest.NewRequest(http.MethodGet, "/seller/catalog/imports/bad", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": "bad"})
+		w := httptest.NewRecorder()
+		handler.GetImport(w, withSeller(r, sellerID))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestCatalogService_GetImportReport(t *testing.T) {
+	sellerID := uuid.New()
+	importID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockICatalogUsecase(ctrl)
+		handler := catalog.NewCatalogService(mockUsecase, catalogImportConf)
+
+		mockUsecase.EXPECT().GetImportReport(gomock.Any(), sellerID, importID).
+			Return([]byte("row,sku,message\n"), models.CatalogCSV, nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/seller/catalog/imports/"+importID.String()+"/report", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": importID.String()})
+		w := httptest.NewRecorder()
+		handler.GetImportReport(w, withSeller(r, sellerID))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Header().Get("Content-Disposition"), "errors.csv")
+		assert.Equal(t, "row,sku,message\n", w.Body.String())
+	})
+
+	t.Run("not finished", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockICatalogUsecase(ctrl)
+		handler := catalog.NewCatalogService(mockUsecase, catalogImportConf)
+
+		mockUsecase.EXPECT().GetImportReport(gomock.Any(), sellerID, importID).
+			Return(nil, models.CatalogFormat(""), errs.NewBusinessLogicError("import is not finished yet"))
+
+		r := httptest.NewRequest(http.MethodGet, "/seller/catalog/imports/"+importID.String()+"/report", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": importID.String()})
+		w := httptest.NewRecorder()
+		handler.GetImportReport(w, withSeller(r, sellerID))
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+}
+
+func TestCatalogService_Export(t *testing.T) {
+	sellerID := uuid.New()
+
+	t.Run("xlsx", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockICatalogUsecase(ctrl)
+		handler := catalog.NewCatalogService(mockUsecase, catalogImportConf)
+
+		mockUsecase.EXPECT().Export(gomock.Any(), sellerID, models.CatalogXLSX).Return([]byte("PK"), nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/seller/catalog/export?format=XLSX", nil)
+		w := httptest.NewRecorder()
+		handler.Export(w, withSeller(r, sellerID))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, models.CatalogXLSX.ContentType(), w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Header().Get("Content-Disposition"), "catalog.xlsx")
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		handler := catalog.NewCatalogService(mocks.NewMockICatalogUsecase(ctrl), catalogImportConf)
+
+		r := httptest.NewRequest(http.MethodGet, "/seller/catalog/export?format=pdf", nil)
+		w := httptest.NewRecorder()
+		handler.Export(w, withSeller(r, sellerID))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
@@ -21,7 +22,7 @@ import (
 func setupTestCategory(t *testing.T) (*mocks.MockICategoryUsecase, *category.CategoryService) {
 	ctrl := gomock.NewController(t)
 	mockUsecase := mocks.NewMockICategoryUsecase(ctrl)
-	service := category.NewCategoryService(mockUsecase)
+	service := category.NewCategoryService(mockUsecase, nil)
 	return mockUsecase, service
 }
 
@@ -159,3 +160,116 @@ func TestCategoryService_GetNameSubcategory(t *testing.T) {
 		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
 	})
 }
+
+func TestCategoryService_GetCategoryTree(t *testing.T) {
+	mockUsecase, service := setupTestCategory(t)
+
+	mockUsecase.EXPECT().GetCategoryTree(gomock.Any()).Return(dto.CategoryTreeResponse{
+		Categories: []dto.CategoryTreeNode{{
+			ID:   uuid.New(),
+			Name: "Электроника",
+			Slug: "elektronika",
+			Children: []dto.CategoryTreeNode{
+				{ID: uuid.New(), Name: "Смартфоны", Slug: "smartfony", Children: []dto.CategoryTreeNode{}},
+			},
+		}},
+	}, nil)
+
+	req := httptest.NewRequest("GET", "/api/v1/categories/tree", nil)
+	w := httptest.NewRecorder()
+
+	service.GetCategoryTree(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var result dto.CategoryTreeResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	assert.Equal(t, "smartfony", result.Categories[0].Children[0].Slug)
+}
+
+func TestCategoryService_GetBreadcrumbs(t *testing.T) {
+	mockUsecase, service := setupTestCategory(t)
+	categoryID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockUsecase.EXPECT().GetBreadcrumbs(gomock.Any(), categoryID).Return(dto.BreadcrumbsResponse{
+			Breadcrumbs: []dto.BreadcrumbDTO{
+				{ID: uuid.New(), Name: "Электроника", Slug: "elektronika"},
+				{ID: categoryID, Name: "Смартфоны", Slug: "smartfony"},
+			},
+		}, nil)
+
+		req := httptest.NewRequest("GET", "/api/v1/categories/"+categoryID.String()+"/breadcrumbs", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": categoryID.String()})
+		w := httptest.NewRecorder()
+
+		service.GetBreadcrumbs(w, req)
+
+		resp := w.Result()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result dto.BreadcrumbsResponse
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		assert.Len(t, result.Breadcrumbs, 2)
+	})
+
+	t.Run("invalid ID", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/api/v1/categories/invalid-id/breadcrumbs", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": "invalid-id"})
+		w := httptest.NewRecorder()
+
+		service.GetBreadcrumbs(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	})
+}
+
+func TestCategoryService_CreateCategory(t *testing.T) {
+	mockUsecase, service := setupTestCategory(t)
+	parentID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			CreateCategory(gomock.Any(), dto.CreateCategoryRequest{Name: "Смартфоны", ParentID: &parentID}).
+			Return(&models.Category{ID: uuid.New(), Name: "Смартфоны", Slug: "smartfony"}, nil)
+
+		body := `{"name": "Смартфоны", "parentId": "` + parentID.String() + `"}`
+		req := httptest.NewRequest("POST", "/api/v1/admin/categories", strings.NewReader(body))
+		w := httptest.NewRecorder()
+
+		service.CreateCategory(w, req)
+
+		assert.Equal(t, http.StatusCreated, w.Result().StatusCode)
+	})
+
+	t.Run("slug taken", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			CreateCategory(gomock.Any(), gomock.Any()).
+			Return(nil, errs.NewAlreadyExistsError("category with this slug or name already exists"))
+
+		req := httptest.NewRequest("POST", "/api/v1/admin/categories", strings.NewReader(`{"name": "Смартфоны", "slug": "phones"}`))
+		w := httptest.NewRecorder()
+
+		service.CreateCategory(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Result().StatusCode)
+	})
+}
+
+func TestCategoryService_MoveCategory(t *testing.T) {
+	mockUsecase, service := setupTestCategory(t)
+	categoryID := uuid.New()
+
+	mockUsecase.EXPECT().
+		MoveCategory(gomock.Any(), categoryID, dto.MoveCategoryRequest{Position: 1}).
+		Return(errs.NewBusinessLogicError("category cannot be moved into its own subtree"))
+
+	req := httptest.NewRequest("POST", "/api/v1/admin/categories/"+categoryID.String()+"/move", strings.NewReader(`{"position": 1}`))
+	req = mux.SetURLVars(req, map[string]string{"id": categoryID.String()})
+	w := httptest.NewRecorder()
+
+	service.MoveCategory(w, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Result().StatusCode)
+}
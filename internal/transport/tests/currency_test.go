@@ -0,0 +1,174 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	minio_mocks "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/minio/mocks"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/currency"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/product"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestCurrency(t *testing.T) (*mocks.MockICurrencyUsecase, *currency.CurrencyService) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := mocks.NewMockICurrencyUsecase(ctrl)
+	return mockUsecase, currency.NewCurrencyService(mockUsecase)
+}
+
+func TestCurrencyService_GetRates(t *testing.T) {
+	mockUsecase, handler := setupTestCurrency(t)
+
+	one, err := models.ParseRate("1")
+	require.NoError(t, err)
+	usd, err := models.ParseRate("92.5")
+	require.NoError(t, err)
+	mockUsecase.EXPECT().GetRates(gomock.Any()).Return([]models.ExchangeRate{
+		{Currency: models.BaseCurrency, Rate: one},
+		{Currency: "USD", Rate: usd},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	handler.GetRates(w, httptest.NewRequest(http.MethodGet, "/currencies", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `[{"currency":"RUB","rate":1},{"currency":"USD","rate":92.5}]`, w.Body.String())
+}
+
+func TestCurrencyService_SetRate(t *testing.T) {
+	setRate := func(handler *currency.CurrencyService, body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPut, "/admin/currencies/usd", strings.NewReader(body))
+		r = mux.SetURLVars(r, map[string]string{"code": "usd"})
+		w := httptest.NewRecorder()
+		handler.SetRate(w, r)
+		return w
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockUsecase, handler := setupTestCurrency(t)
+		rate, err := models.ParseRate("92.5")
+		require.NoError(t, err)
+		mockUsecase.EXPECT().SetRate(gomock.Any(), "usd", dto.SetExchangeRateRequest{Rate: rate}).Return(nil)
+
+		w := setRate(handler, `{"rate":92.5}`)
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("invalid rate", func(t *testing.T) {
+		_, handler := setupTestCurrency(t)
+
+		w := setRate(handler, `{"rate":-1}`)
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("rejected by usecase", func(t *testing.T) {
+		mockUsecase, handler := setupTestCurrency(t)
+		mockUsecase.EXPECT().SetRate(gomock.Any(), "usd", gomock.Any()).
+			Return(errs.NewBusinessLogicError("base currency rate is fixed"))
+
+		w := setRate(handler, `{"rate":"1"}`)
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+}
+
+func TestCurrencyService_ImportRates(t *testing.T) {
+	upload := func(handler *currency.CurrencyService, content string) *httptest.ResponseRecorder {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		part, err := writer.CreateFormFile("file", "rates.csv")
+		require.NoError(t, err)
+		_, err = part.Write([]byte(content))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+
+		r := httptest.NewRequest(http.MethodPost, "/admin/currencies/import", body)
+		r.Header.Set("Content-Type", writer.FormDataContentType())
+		w := httptest.NewRecorder()
+		handler.ImportRates(w, r)
+		return w
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockUsecase, handler := setupTestCurrency(t)
+		mockUsecase.EXPECT().ImportRates(gomock.Any(), []byte("USD,92.5\n")).
+			Return(dto.ImportRatesResponse{Imported: 1}, nil)
+
+		w := upload(handler, "USD,92.5\n")
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"imported":1}`, w.Body.String())
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		mockUsecase, handler := setupTestCurrency(t)
+		mockUsecase.EXPECT().ImportRates(gomock.Any(), gomock.Any()).
+			Return(dto.ImportRatesResponse{}, errs.NewBusinessLogicError("row 1: invalid exchange rate"))
+
+		w := upload(handler, "USD,abc\n")
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("no file", func(t *testing.T) {
+		_, handler := setupTestCurrency(t)
+
+		r := httptest.NewRequest(http.MethodPost, "/admin/currencies/import", nil)
+		w := httptest.NewRecorder()
+		handler.ImportRates(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("usecase error", func(t *testing.T) {
+		mockUsecase, handler := setupTestCurrency(t)
+		mockUsecase.EXPECT().ImportRates(gomock.Any(), gomock.Any()).
+			Return(dto.ImportRatesResponse{}, errors.New("db error"))
+
+		w := upload(handler, "USD,92.5\n")
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestProductService_GetProductByID_InCurrency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := mocks.NewMockIProductUsecase(ctrl)
+	service := product.NewProductService(mockUsecase, minio_mocks.NewMockProvider(ctrl))
+
+	productID := uuid.New()
+	mockUsecase.EXPECT().GetProductByID(gomock.Any(), productID).
+		Return(&models.Product{
+			ID:            productID,
+			Name:          "Test Product",
+			Price:         models.Rubles(1000),
+			PriceDiscount: models.Rubles(925),
+			Status:        models.ProductApproved,
+		}, nil)
+
+	usd, err := models.ParseRate("92.5")
+	require.NoError(t, err)
+	ctx := context.WithValue(createTestContext(), domains.ExchangeRateKey{}, models.ExchangeRate{Currency: "USD", Rate: usd})
+
+	r := httptest.NewRequest(http.MethodGet, "/products/"+productID.String(), nil).WithContext(ctx)
+	w := httptest.NewRecorder()
+	router := mux.NewRouter()
+	router.HandleFunc("/products/{id}", service.GetProductByID).Methods("GET")
+	router.ServeHTTP(w, r)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"price":10.81`)
+	assert.Contains(t, w.Body.String(), `"price_discount":10.00`)
+	assert.Contains(t, w.Body.String(), `"currency":"USD"`)
+}
@@ -0,0 +1,132 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/delivery"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeliveryService_GetOptions(t *testing.T) {
+	addressID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIDeliveryUsecase(ctrl)
+		handler := delivery.NewDeliveryService(mockUsecase)
+
+		slotID := uuid.New()
+		expected := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+		mockUsecase.EXPECT().GetOptions(gomock.Any(), addressID).
+			Return(dto.DeliveryOptionsDTO{
+				Zone:               "Москва",
+				ExpectedDeliveryAt: expected,
+				Slots:              []dto.DeliverySlotDTO{{ID: slotID, Free: 3}},
+			}, nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/delivery/options?addressID="+addressID.String(), nil)
+		r = addUserIDToContext(r, uuid.New())
+		w := httptest.NewRecorder()
+		handler.GetOptions(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp dto.DeliveryOptionsDTO
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, "Москва", resp.Zone)
+		assert.True(t, expected.Equal(resp.ExpectedDeliveryAt))
+		require.Len(t, resp.Slots, 1)
+		assert.Equal(t, slotID, resp.Slots[0].ID)
+	})
+
+	t.Run("invalid address id", func(t *testing.T) {
+		handler := delivery.NewDeliveryService(nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/delivery/options?addressID=bad", nil)
+		w := httptest.NewRecorder()
+		handler.GetOptions(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestDeliveryService_CreateSlot(t *testing.T) {
+	startsAt := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	reqBody := dto.CreateDeliverySlotRequest{
+		ZoneID:   uuid.New(),
+		StartsAt: startsAt,
+		EndsAt:   startsAt.Add(4 * time.Hour),
+		Capacity: 20,
+	}
+	body, _ := json.Marshal(reqBody)
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIDeliveryUsecase(ctrl)
+		handler := delivery.NewDeliveryService(mockUsecase)
+
+		mockUsecase.EXPECT().CreateSlot(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ interface{}, req dto.CreateDeliverySlotRequest) (dto.DeliverySlotDTO, error) {
+				assert.Equal(t, reqBody.ZoneID, req.ZoneID)
+				assert.True(t, startsAt.Equal(req.StartsAt))
+				return dto.DeliverySlotDTO{ID: uuid.New(), ZoneID: req.ZoneID, Free: req.Capacity}, nil
+			})
+
+		r := httptest.NewRequest(http.MethodPost, "/admin/delivery/slots", bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		handler.CreateSlot(w, r)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("duplicate slot", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIDeliveryUsecase(ctrl)
+		handler := delivery.NewDeliveryService(mockUsecase)
+
+		mockUsecase.EXPECT().CreateSlot(gomock.Any(), gomock.Any()).
+			Return(dto.DeliverySlotDTO{}, errs.NewAlreadyExistsError("delivery slot already exists"))
+
+		r := httptest.NewRequest(http.MethodPost, "/admin/delivery/slots", bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		handler.CreateSlot(w, r)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		handler := delivery.NewDeliveryService(nil)
+
+		r := httptest.NewRequest(http.MethodPost, "/admin/delivery/slots", bytes.NewReader([]byte("{")))
+		w := httptest.NewRecorder()
+		handler.CreateSlot(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestDeliveryService_CreateZone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := mocks.NewMockIDeliveryUsecase(ctrl)
+	handler := delivery.NewDeliveryService(mockUsecase)
+
+	mockUsecase.EXPECT().CreateZone(gomock.Any(), gomock.Any()).
+		Return(dto.DeliveryZoneDTO{}, errs.NewBusinessLogicError("zone name is required"))
+
+	r := httptest.NewRequest(http.MethodPost, "/admin/delivery/zones", bytes.NewReader([]byte(`{"name":""}`)))
+	w := httptest.NewRecorder()
+	handler.CreateZone(w, r)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
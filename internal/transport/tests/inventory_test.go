@@ -0,0 +1,182 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/inventory"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInventoryService_AdjustStock(t *testing.T) {
+	sellerID := uuid.New()
+	productID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIInventoryUsecase(ctrl)
+		handler := inventory.NewInventoryService(mockUsecase)
+
+		variantID := uuid.New()
+		mockUsecase.EXPECT().
+			AdjustStock(gomock.Any(), sellerID, productID, dto.StockAdjustmentRequest{
+				VariantID: &variantID,
+				Delta:     -2,
+				Reason:    "damaged",
+			}).
+			Return(&models.StockMovement{ID: 5, ProductID: productID, Delta: -2, Balance: 3, Kind: models.StockAdjustment}, nil)
+
+		body := `{"variant_id":"` + variantID.String() + `","delta":-2,"reason":"damaged"}`
+		r := httptest.NewRequest(http.MethodPost, "/seller/products/"+productID.String()+"/stock/adjustments", strings.NewReader(body))
+		r = mux.SetURLVars(r, map[string]string{"id": productID.String()})
+		w := httptest.NewRecorder()
+		handler.AdjustStock(w, withSeller(r, sellerID))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var resp models.StockMovement
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, int64(5), resp.ID)
+		assert.Equal(t, 3, resp.Balance)
+	})
+
+	t.Run("negative stock", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIInventoryUsecase(ctrl)
+		handler := inventory.NewInventoryService(mockUsecase)
+
+		mockUsecase.EXPECT().AdjustStock(gomock.Any(), sellerID, productID, gomock.Any()).
+			Return(nil, errs.NewBusinessLogicError("stock cannot become negative"))
+
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"delta":-10,"reason":"count"}`))
+		r = mux.SetURLVars(r, map[string]string{"id": productID.String()})
+		w := httptest.NewRecorder()
+		handler.AdjustStock(w, withSeller(r, sellerID))
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		handler := inventory.NewInventoryService(mocks.NewMockIInventoryUsecase(ctrl))
+
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{}`))
+		r = mux.SetURLVars(r, map[string]string{"id": "bad"})
+		w := httptest.NewRecorder()
+		handler.AdjustStock(w, withSeller(r, sellerID))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestInventoryService_GetMovements(t *testing.T) {
+	sellerID := uuid.New()
+	productID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIInventoryUsecase(ctrl)
+		handler := inventory.NewInventoryService(mockUsecase)
+
+		mockUsecase.EXPECT().GetMovements(gomock.Any(), sellerID, productID, 20).
+			Return([]models.StockMovement{{ID: 2, Kind: models.StockSale}}, nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/seller/products/"+productID.String()+"/stock/movements?offset=20", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": productID.String()})
+		w := httptest.NewRecorder()
+		handler.GetMovements(w, withSeller(r, sellerID))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp []models.StockMovement
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.Len(t, resp, 1)
+		assert.Equal(t, models.StockSale, resp[0].Kind)
+	})
+
+	t.Run("invalid offset", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		handler := inventory.NewInventoryService(mocks.NewMockIInventoryUsecase(ctrl))
+
+		r := httptest.NewRequest(http.MethodGet, "/seller/products/"+productID.String()+"/stock/movements?offset=-1", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": productID.String()})
+		w := httptest.NewRecorder()
+		handler.GetMovements(w, withSeller(r, sellerID))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestInventoryService_SetLowStockThreshold(t *testing.T) {
+	sellerID := uuid.New()
+	productID := uuid.New()
+
+	t.Run("set", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIInventoryUsecase(ctrl)
+		handler := inventory.NewInventoryService(mockUsecase)
+
+		mockUsecase.EXPECT().SetLowStockThreshold(gomock.Any(), sellerID, productID, gomock.Any()).
+			DoAndReturn(func(_ interface{}, _, _ uuid.UUID, threshold *int) error {
+				require.NotNil(t, threshold)
+				assert.Equal(t, 3, *threshold)
+				return nil
+			})
+
+		r := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(`{"threshold":3}`))
+		r = mux.SetURLVars(r, map[string]string{"id": productID.String()})
+		w := httptest.NewRecorder()
+		handler.SetLowStockThreshold(w, withSeller(r, sellerID))
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIInventoryUsecase(ctrl)
+		handler := inventory.NewInventoryService(mockUsecase)
+
+		mockUsecase.EXPECT().SetLowStockThreshold(gomock.Any(), sellerID, productID, (*int)(nil)).
+			Return(errs.NewNotFoundError("product not found"))
+
+		r := httptest.NewRequest(http.MethodPut, "/", strings.NewReader(`{"threshold":null}`))
+		r = mux.SetURLVars(r, map[string]string{"id": productID.String()})
+		w := httptest.NewRecorder()
+		handler.SetLowStockThreshold(w, withSeller(r, sellerID))
+
+		// HandleDomainError отвечает на ErrNotFound статусом 401
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestInventoryService_GetDiscrepancies(t *testing.T) {
+	sellerID := uuid.New()
+	ctrl := gomock.NewController(t)
+	mockUsecase := mocks.NewMockIInventoryUsecase(ctrl)
+	handler := inventory.NewInventoryService(mockUsecase)
+
+	mockUsecase.EXPECT().GetDiscrepancies(gomock.Any(), sellerID).
+		Return([]models.StockDiscrepancy{{ProductID: uuid.New(), Name: "Phone", Quantity: 4, LedgerBalance: 3}}, nil)
+
+	r := httptest.NewRequest(http.MethodGet, "/seller/stock/reconciliation", nil)
+	w := httptest.NewRecorder()
+	handler.GetDiscrepancies(w, withSeller(r, sellerID))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp []models.StockDiscrepancy
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, 3, resp[0].LedgerBalance)
+}
@@ -5,12 +5,15 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/domains"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/order"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/mocks"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"net/http/httptest"
@@ -37,7 +40,7 @@ func TestCreateOrder_Success(t *testing.T) {
 		Items: []dto.CreateOrderItemDTO{
 			{
 				ProductID: productID,
-				Price:     100,
+				Price:     models.Rubles(100),
 				Quantity:  2,
 			},
 		},
@@ -228,3 +231,226 @@ func TestGetOrdersPlaced_Success(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expectedOrders, &resp)
 }
+
+func TestGetSellerOrders(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIOrderUsecase(ctrl)
+		handler := order.NewOrderService(mockUsecase)
+
+		shipmentID := uuid.New()
+		mockUsecase.EXPECT().
+			GetSellerOrders(gomock.Any(), 20).
+			Return([]dto.SellerOrderDTO{{
+				ShipmentPreviewDTO: dto.ShipmentPreviewDTO{ID: shipmentID},
+				OrderID:            uuid.New(),
+			}}, nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/seller/orders/20", nil)
+		r = mux.SetURLVars(r, map[string]string{"offset": "20"})
+		w := httptest.NewRecorder()
+		handler.GetSellerOrders(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp map[string][]map[string]interface{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		if assert.Len(t, resp["orders"], 1) {
+			assert.Equal(t, shipmentID.String(), resp["orders"][0]["id"])
+		}
+	})
+
+	t.Run("invalid offset", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIOrderUsecase(ctrl)
+		handler := order.NewOrderService(mockUsecase)
+
+		r := httptest.NewRequest(http.MethodGet, "/seller/orders/bad", nil)
+		r = mux.SetURLVars(r, map[string]string{"offset": "bad"})
+		w := httptest.NewRecorder()
+		handler.GetSellerOrders(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestConfirmShipment(t *testing.T) {
+	shipmentID := uuid.New()
+
+	t.Run("success with tracking number", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIOrderUsecase(ctrl)
+		handler := order.NewOrderService(mockUsecase)
+
+		tracking := "TRACK-1"
+		mockUsecase.EXPECT().
+			ConfirmShipment(gomock.Any(), shipmentID, dto.ConfirmShipmentRequest{TrackingNumber: &tracking}).
+			Return(nil)
+
+		body, _ := json.Marshal(dto.ConfirmShipmentRequest{TrackingNumber: &tracking})
+		r := httptest.NewRequest(http.MethodPost, "/seller/orders/"+shipmentID.String()+"/confirm", bytes.NewReader(body))
+		r = mux.SetURLVars(r, map[string]string{"id": shipmentID.String()})
+		w := httptest.NewRecorder()
+		handler.ConfirmShipment(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("success without body", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIOrderUsecase(ctrl)
+		handler := order.NewOrderService(mockUsecase)
+
+		mockUsecase.EXPECT().
+			ConfirmShipment(gomock.Any(), shipmentID, dto.ConfirmShipmentRequest{}).
+			Return(nil)
+
+		r := httptest.NewRequest(http.MethodPost, "/seller/orders/"+shipmentID.String()+"/confirm", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": shipmentID.String()})
+		w := httptest.NewRecorder()
+		handler.ConfirmShipment(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("wrong status", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIOrderUsecase(ctrl)
+		handler := order.NewOrderService(mockUsecase)
+
+		mockUsecase.EXPECT().
+			ConfirmShipment(gomock.Any(), shipmentID, gomock.Any()).
+			Return(errs.NewBusinessLogicError("shipment cannot be confirmed"))
+
+		r := httptest.NewRequest(http.MethodPost, "/seller/orders/"+shipmentID.String()+"/confirm", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": shipmentID.String()})
+		w := httptest.NewRecorder()
+		handler.ConfirmShipment(w, r)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+}
+
+func TestCancelShipment(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIOrderUsecase(ctrl)
+		handler := order.NewOrderService(mockUsecase)
+
+		shipmentID := uuid.New()
+		mockUsecase.EXPECT().CancelShipment(gomock.Any(), shipmentID).Return(nil)
+
+		r := httptest.NewRequest(http.MethodPost, "/seller/orders/"+shipmentID.String()+"/cancel", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": shipmentID.String()})
+		w := httptest.NewRecorder()
+		handler.CancelShipment(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIOrderUsecase(ctrl)
+		handler := order.NewOrderService(mockUsecase)
+
+		shipmentID := uuid.New()
+		mockUsecase.EXPECT().CancelShipment(gomock.Any(), shipmentID).
+			Return(errs.NewNotFoundError("shipment not found"))
+
+		r := httptest.NewRequest(http.MethodPost, "/seller/orders/"+shipmentID.String()+"/cancel", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": shipmentID.String()})
+		w := httptest.NewRecorder()
+		handler.CancelShipment(w, r)
+
+		// HandleDomainError отвечает на ErrNotFound статусом 401
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIOrderUsecase(ctrl)
+		handler := order.NewOrderService(mockUsecase)
+
+		r := httptest.NewRequest(http.MethodPost, "/seller/orders/bad/cancel", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": "bad"})
+		w := httptest.NewRecorder()
+		handler.CancelShipment(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetOrder(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIOrderUsecase(ctrl)
+		handler := order.NewOrderService(mockUsecase)
+
+		orderID := uuid.New()
+		mockUsecase.EXPECT().GetOrder(gomock.Any(), orderID).
+			Return(dto.OrderDetailDTO{ID: orderID, Subtotal: models.Rubles(300), Total: models.Rubles(339)}, nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/orders/"+orderID.String(), nil)
+		r = mux.SetURLVars(r, map[string]string{"id": orderID.String()})
+		w := httptest.NewRecorder()
+		handler.GetOrder(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp map[string]interface{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, orderID.String(), resp["id"])
+		assert.Equal(t, 339.0, resp["total"])
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIOrderUsecase(ctrl)
+		handler := order.NewOrderService(mockUsecase)
+
+		r := httptest.NewRequest(http.MethodGet, "/orders/bad", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": "bad"})
+		w := httptest.NewRecorder()
+		handler.GetOrder(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetInvoice(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIOrderUsecase(ctrl)
+		handler := order.NewOrderService(mockUsecase)
+
+		orderID := uuid.New()
+		mockUsecase.EXPECT().GetInvoice(gomock.Any(), orderID).Return([]byte("%PDF-1.3"), nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/orders/"+orderID.String()+"/invoice.pdf", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": orderID.String()})
+		w := httptest.NewRecorder()
+		handler.GetInvoice(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/pdf", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Header().Get("Content-Disposition"), "invoice-"+orderID.String()+".pdf")
+		assert.Equal(t, "%PDF-1.3", w.Body.String())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIOrderUsecase(ctrl)
+		handler := order.NewOrderService(mockUsecase)
+
+		orderID := uuid.New()
+		mockUsecase.EXPECT().GetInvoice(gomock.Any(), orderID).Return(nil, errs.NewNotFoundError("order not found"))
+
+		r := httptest.NewRequest(http.MethodGet, "/orders/"+orderID.String()+"/invoice.pdf", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": orderID.String()})
+		w := httptest.NewRecorder()
+		handler.GetInvoice(w, r)
+
+		assert.NotEqual(t, http.StatusOK, w.Code)
+		assert.NotEqual(t, "application/pdf", w.Header().Get("Content-Type"))
+	})
+}
@@ -0,0 +1,160 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/payout"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayoutService_GetBalance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := mocks.NewMockIPayoutUsecase(ctrl)
+	handler := payout.NewPayoutService(mockUsecase)
+
+	sellerID := uuid.New()
+	mockUsecase.EXPECT().GetBalance(gomock.Any(), sellerID).
+		Return(dto.SellerBalanceDTO{Held: models.Rubles(1350), Available: models.Kopecks(5), PaidOut: models.Kopecks(1234567)}, nil)
+
+	r := httptest.NewRequest(http.MethodGet, "/seller/balance", nil)
+	w := httptest.NewRecorder()
+	handler.GetBalance(w, withSeller(r, sellerID))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"held":1350.00,"available":0.05,"pending":0.00,"paid_out":12345.67}`, w.Body.String())
+}
+
+func TestPayoutService_GetStatements(t *testing.T) {
+	sellerID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIPayoutUsecase(ctrl)
+		handler := payout.NewPayoutService(mockUsecase)
+
+		statementID := uuid.New()
+		mockUsecase.EXPECT().GetStatements(gomock.Any(), sellerID, 20).
+			Return([]models.PayoutStatement{{ID: statementID, SellerID: sellerID, Net: models.Rubles(900), Status: models.PayoutPending}}, nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/seller/payouts?offset=20", nil)
+		w := httptest.NewRecorder()
+		handler.GetStatements(w, withSeller(r, sellerID))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp []models.PayoutStatement
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.Len(t, resp, 1)
+		assert.Equal(t, statementID, resp[0].ID)
+		assert.Equal(t, models.Rubles(900), resp[0].Net)
+	})
+
+	t.Run("invalid offset", func(t *testing.T) {
+		handler := payout.NewPayoutService(nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/seller/payouts?offset=-1", nil)
+		w := httptest.NewRecorder()
+		handler.GetStatements(w, withSeller(r, sellerID))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestPayoutService_GetStatement(t *testing.T) {
+	sellerID := uuid.New()
+	statementID := uuid.New()
+
+	t.Run("another seller", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIPayoutUsecase(ctrl)
+		handler := payout.NewPayoutService(mockUsecase)
+
+		mockUsecase.EXPECT().GetStatement(gomock.Any(), sellerID, statementID).
+			Return(dto.PayoutStatementDetailDTO{}, errs.ErrForbidden)
+
+		r := httptest.NewRequest(http.MethodGet, "/seller/payouts/"+statementID.String(), nil)
+		r = mux.SetURLVars(r, map[string]string{"id": statementID.String()})
+		w := httptest.NewRecorder()
+		handler.GetStatement(w, withSeller(r, sellerID))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("invalid ID", func(t *testing.T) {
+		handler := payout.NewPayoutService(nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/seller/payouts/abc", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": "abc"})
+		w := httptest.NewRecorder()
+		handler.GetStatement(w, withSeller(r, sellerID))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestPayoutService_SetCategoryCommission(t *testing.T) {
+	categoryID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIPayoutUsecase(ctrl)
+		handler := payout.NewPayoutService(mockUsecase)
+
+		bps := 1500
+		mockUsecase.EXPECT().SetCategoryCommission(gomock.Any(), categoryID, dto.SetCategoryCommissionRequest{CommissionBps: &bps}).
+			Return(nil)
+
+		r := httptest.NewRequest(http.MethodPut, "/admin/categories/"+categoryID.String()+"/commission",
+			bytes.NewBufferString(`{"commission_bps":1500}`))
+		r = mux.SetURLVars(r, map[string]string{"id": categoryID.String()})
+		w := httptest.NewRecorder()
+		handler.SetCategoryCommission(w, r)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIPayoutUsecase(ctrl)
+		handler := payout.NewPayoutService(mockUsecase)
+
+		mockUsecase.EXPECT().SetCategoryCommission(gomock.Any(), categoryID, gomock.Any()).
+			Return(errs.NewBusinessLogicError("commission must be between 0 and 10000 basis points"))
+
+		r := httptest.NewRequest(http.MethodPut, "/admin/categories/"+categoryID.String()+"/commission",
+			bytes.NewBufferString(`{"commission_bps":20000}`))
+		r = mux.SetURLVars(r, map[string]string{"id": categoryID.String()})
+		w := httptest.NewRecorder()
+		handler.SetCategoryCommission(w, r)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+}
+
+func TestPayoutService_MarkStatementPaid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := mocks.NewMockIPayoutUsecase(ctrl)
+	handler := payout.NewPayoutService(mockUsecase)
+
+	statementID := uuid.New()
+	mockUsecase.EXPECT().MarkStatementPaid(gomock.Any(), statementID).Return(nil)
+
+	r := httptest.NewRequest(http.MethodPost, "/admin/payouts/"+statementID.String()+"/paid", nil)
+	r = mux.SetURLVars(r, map[string]string{"id": statementID.String()})
+	w := httptest.NewRecorder()
+	handler.MarkStatementPaid(w, r)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
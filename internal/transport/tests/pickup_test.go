@@ -0,0 +1,140 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/pickup"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPickupService_GetNearest(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIPickupUsecase(ctrl)
+		handler := pickup.NewPickupService(mockUsecase)
+
+		distance := 0.4
+		pointID := uuid.New()
+		mockUsecase.EXPECT().GetNearest(gomock.Any(), models.Coordinate{Lat: 55.75, Lon: 37.62}, 3).
+			Return(dto.PickupPointsResponse{PickupPoints: []dto.PickupPointDTO{{ID: pointID, DistanceKm: &distance}}}, nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/pickup-points/nearest?lat=55.75&lon=37.62&limit=3", nil)
+		w := httptest.NewRecorder()
+		handler.GetNearest(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp dto.PickupPointsResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.Len(t, resp.PickupPoints, 1)
+		assert.Equal(t, pointID, resp.PickupPoints[0].ID)
+	})
+
+	t.Run("missing coordinate", func(t *testing.T) {
+		handler := pickup.NewPickupService(nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/pickup-points/nearest?lat=abc", nil)
+		w := httptest.NewRecorder()
+		handler.GetNearest(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("coordinate out of range", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIPickupUsecase(ctrl)
+		handler := pickup.NewPickupService(mockUsecase)
+
+		mockUsecase.EXPECT().GetNearest(gomock.Any(), gomock.Any(), 0).
+			Return(dto.PickupPointsResponse{}, errs.NewBusinessLogicError("invalid coordinate"))
+
+		r := httptest.NewRequest(http.MethodGet, "/pickup-points/nearest?lat=120&lon=0", nil)
+		w := httptest.NewRecorder()
+		handler.GetNearest(w, r)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+}
+
+func TestPickupService_AddFavorite(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIPickupUsecase(ctrl)
+		handler := pickup.NewPickupService(mockUsecase)
+
+		pointID := uuid.New()
+		mockUsecase.EXPECT().AddFavorite(gomock.Any(), pointID).Return(nil)
+
+		r := httptest.NewRequest(http.MethodPost, "/pickup-points/"+pointID.String()+"/favorite", nil)
+		r = addUserIDToContext(r, uuid.New())
+		r = mux.SetURLVars(r, map[string]string{"id": pointID.String()})
+		w := httptest.NewRecorder()
+		handler.AddFavorite(w, r)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		handler := pickup.NewPickupService(nil)
+
+		r := httptest.NewRequest(http.MethodPost, "/pickup-points/bad/favorite", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": "bad"})
+		w := httptest.NewRecorder()
+		handler.AddFavorite(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestPickupService_CreatePickupPoint(t *testing.T) {
+	reqBody := dto.CreatePickupPointRequest{
+		Name:          "ПВЗ на Ленина",
+		AddressString: "ул. Ленина, д. 1",
+		Coordinate:    "55.7558,37.6176",
+		OpensAt:       "09:00",
+		ClosesAt:      "21:00",
+	}
+	body, _ := json.Marshal(reqBody)
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIPickupUsecase(ctrl)
+		handler := pickup.NewPickupService(mockUsecase)
+
+		pointID := uuid.New()
+		mockUsecase.EXPECT().CreatePickupPoint(gomock.Any(), gomock.Any()).
+			Return(dto.PickupPointDTO{ID: pointID, Name: reqBody.Name}, nil)
+
+		r := httptest.NewRequest(http.MethodPost, "/admin/pickup-points", bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		handler.CreatePickupPoint(w, r)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var resp dto.PickupPointDTO
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, pointID, resp.ID)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		handler := pickup.NewPickupService(nil)
+
+		r := httptest.NewRequest(http.MethodPost, "/admin/pickup-points", bytes.NewReader([]byte("{")))
+		w := httptest.NewRecorder()
+		handler.CreatePickupPoint(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
@@ -42,7 +42,7 @@ func TestProductService_GetAllProducts(t *testing.T) {
             ID:              uuid.New(),
             Name:            "Product 1",
             PreviewImageURL: "url1",
-            Price:           10.5,
+            Price:           models.Kopecks(1050),
             Status:          models.ProductApproved,
         },
     }
@@ -115,7 +115,7 @@ func TestProductService_GetProductByID(t *testing.T) {
                 ID:              testID,
                 Name:            "Test Product",
                 PreviewImageURL: "test_url",
-                Price:           15.99,
+                Price:           models.Kopecks(1599),
                 Status:          models.ProductApproved,
             }, nil)
 
@@ -194,7 +194,7 @@ func TestProductService_GetProductsByCategory(t *testing.T) {
             ID:              uuid.New(),
             Name:            "Test Product",
             PreviewImageURL: "test_url",
-            Price:           15.99,
+            Price:           models.Kopecks(1599),
             Status:          models.ProductApproved,
         },
     }
@@ -204,9 +204,10 @@ func TestProductService_GetProductsByCategory(t *testing.T) {
             GetProductsByCategory(
                 gomock.Any(), 
                 categoryID, 
+                false,
                 0, 
-                0.0,  // minPrice as float64
-                0.0,  // maxPrice as float64
+                models.Money{},  // minPrice
+                models.Money{},  // maxPrice
                 float32(0),  // minRating as float32
                 models.SortByDefault,
             ).
@@ -259,8 +260,8 @@ func TestProductService_AddProduct(t *testing.T) {
 			Name:           "Test Product",
 			PreviewImageURL: "http://test.com/image.jpg",
 			Description:    "Test description",
-			Price:          19.99,
-			PriceDiscount:   15.99,
+			Price:          models.Kopecks(1999),
+			PriceDiscount:   models.Kopecks(1599),
 			Quantity:       100,
 			Rating:         4.5,
 			ReviewsCount:   10,
@@ -277,8 +278,8 @@ func TestProductService_AddProduct(t *testing.T) {
 			SellerID:        validSellerID.String(),
 			PreviewImageURL: "http://test.com/image.jpg",
 			Description:     "Test description",
-			Price:           19.99,
-			PriceDiscount:   15.99,
+			Price:           models.Kopecks(1999),
+			PriceDiscount:   models.Kopecks(1599),
 			Quantity:        100,
 			Rating:         4.5,
 			ReviewsCount:    10,
@@ -385,14 +386,14 @@ func TestProductService_GetProductsByIDs(t *testing.T) {
             ID:              productID1,
             Name:            "Product 1",
             PreviewImageURL: "url1",
-            Price:           10.5,
+            Price:           models.Kopecks(1050),
             Status:          models.ProductApproved,
         },
         {
             ID:              productID2,
             Name:            "Product 2",
             PreviewImageURL: "url2",
-            Price:           20.5,
+            Price:           models.Kopecks(2050),
             Status:          models.ProductApproved,
         },
     }
@@ -15,13 +15,14 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/mailru/easyjson"
 
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/promo"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/mocks"
 )
 
 func stripMono(t time.Time) time.Time {
-	return t.Round(0)
+	return t.Round(0).UTC()
 }
 
 func TestPromoService_Create(t *testing.T) {
@@ -199,3 +200,67 @@ func TestPromoService_CheckPromoCode(t *testing.T) {
 		t.Errorf("expected status %d on usecase error, got %d", http.StatusInternalServerError, w.Code)
 	}
 }
+
+func TestPromoService_ExportCampaignCodes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := mocks.NewMockIPromoUsecase(ctrl)
+	service := promo.NewPromoService(mockUC)
+
+	campaignID := uuid.New()
+	mockUC.EXPECT().
+		ExportCampaignCodes(gomock.Any(), campaignID).
+		Return([]models.PromoCampaignCode{
+			{Code: "BF-AAAAAA", IsActive: true, Redeemed: true},
+			{Code: "BF-BBBBBB", IsActive: false},
+		}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/promo/campaigns/"+campaignID.String()+"/codes", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": campaignID.String()})
+	w := httptest.NewRecorder()
+
+	service.ExportCampaignCodes(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/csv") {
+		t.Errorf("unexpected content type %q", ct)
+	}
+
+	expected := "code,redeemed,active\nBF-AAAAAA,true,true\nBF-BBBBBB,false,false\n"
+	if w.Body.String() != expected {
+		t.Errorf("unexpected csv body:\n%s", w.Body.String())
+	}
+
+	// неверный id
+	req = httptest.NewRequest(http.MethodGet, "/promo/campaigns/bad/codes", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "bad"})
+	w = httptest.NewRecorder()
+	service.ExportCampaignCodes(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d on bad id, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestPromoService_DeactivateCampaign(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := mocks.NewMockIPromoUsecase(ctrl)
+	service := promo.NewPromoService(mockUC)
+
+	campaignID := uuid.New()
+	mockUC.EXPECT().DeactivateCampaign(gomock.Any(), campaignID).Return(nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/promo/campaigns/"+campaignID.String()+"/deactivate", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": campaignID.String()})
+	w := httptest.NewRecorder()
+
+	service.DeactivateCampaign(w, req)
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf("expected status %d, got %d", http.StatusNoContent, w.Code)
+	}
+}
@@ -29,8 +29,8 @@ func TestGetRecommendations_Success(t *testing.T) {
 			ID:              uuid.New(),
 			Name:            "Test Product",
 			PreviewImageURL: "/img/test.png",
-			Price:           100.0,
-			PriceDiscount:   90.0,
+			Price:           models.Rubles(100),
+			PriceDiscount:   models.Rubles(90),
 			Quantity:        10,
 			Rating:          4.5,
 			ReviewsCount:    5,
@@ -94,3 +94,52 @@ func TestGetRecommendations_UsecaseError(t *testing.T) {
 
 	assert.Equal(t, http.StatusInternalServerError, rec.Code)
 }
+
+func TestGetPersonalRecommendations_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mockRecommendation.NewMockIRecommendationUsecase(ctrl)
+	handler := recommendation.NewRecommendationService(mockUsecase)
+
+	expectedProducts := []*models.Product{
+		{ID: uuid.New(), Name: "Test Product", Price: models.Rubles(100)},
+		{ID: uuid.New(), Name: "Another Product", Price: models.Rubles(50)},
+	}
+
+	mockUsecase.EXPECT().
+		GetPersonalRecommendations(gomock.Any()).
+		Return(expectedProducts, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/recommendation/me", nil)
+
+	rec := httptest.NewRecorder()
+	handler.GetPersonalRecommendations(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var respBody map[string]interface{}
+	err := json.NewDecoder(rec.Body).Decode(&respBody)
+	require.NoError(t, err)
+
+	assert.Equal(t, float64(2), respBody["total"])
+}
+
+func TestGetPersonalRecommendations_UsecaseError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mockRecommendation.NewMockIRecommendationUsecase(ctrl)
+	handler := recommendation.NewRecommendationService(mockUsecase)
+
+	mockUsecase.EXPECT().
+		GetPersonalRecommendations(gomock.Any()).
+		Return(nil, errors.New("something went wrong"))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/recommendation/me", nil)
+
+	rec := httptest.NewRecorder()
+	handler.GetPersonalRecommendations(rec, req)
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+}
@@ -0,0 +1,82 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/reservation"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReservationService_Hold(t *testing.T) {
+	productID := uuid.New()
+	reqBody := dto.CheckoutRequest{
+		Items: []dto.CreateOrderItemDTO{{ProductID: productID, Quantity: 2}},
+	}
+	body, _ := json.Marshal(reqBody)
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIReservationUsecase(ctrl)
+		handler := reservation.NewReservationService(mockUsecase)
+
+		expiresAt := time.Now().Add(15 * time.Minute).UTC().Truncate(time.Second)
+		mockUsecase.EXPECT().
+			Hold(gomock.Any(), reqBody).
+			Return(dto.CheckoutResponse{
+				ExpiresAt: expiresAt,
+				Items:     []dto.ReservedItemResponse{{ProductID: productID, Quantity: 2}},
+			}, nil)
+
+		r := httptest.NewRequest(http.MethodPost, "/orders/checkout", bytes.NewReader(body))
+		r = addUserIDToContext(r, uuid.New())
+		w := httptest.NewRecorder()
+		handler.Hold(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp dto.CheckoutResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.True(t, expiresAt.Equal(resp.ExpiresAt))
+		require.Len(t, resp.Items, 1)
+		assert.Equal(t, productID, resp.Items[0].ProductID)
+	})
+
+	t.Run("not enough stock", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIReservationUsecase(ctrl)
+		handler := reservation.NewReservationService(mockUsecase)
+
+		mockUsecase.EXPECT().
+			Hold(gomock.Any(), reqBody).
+			Return(dto.CheckoutResponse{}, errs.ErrNotEnoughStock)
+
+		r := httptest.NewRequest(http.MethodPost, "/orders/checkout", bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		handler.Hold(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIReservationUsecase(ctrl)
+		handler := reservation.NewReservationService(mockUsecase)
+
+		r := httptest.NewRequest(http.MethodPost, "/orders/checkout", bytes.NewReader([]byte("{")))
+		w := httptest.NewRecorder()
+		handler.Hold(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
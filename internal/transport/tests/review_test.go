@@ -3,12 +3,20 @@ package tests
 import (
 	"bytes"
 	"encoding/json"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/minio"
+	minio_mocks "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/minio/mocks"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	gen "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/generated/review"
 	genmock "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/generated/review/mocks"
 	review "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/review/http"
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/stretchr/testify/assert"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -20,7 +28,7 @@ func TestReviewHandler_Get(t *testing.T) {
 
 	mockClient := genmock.NewMockReviewServiceClient(ctrl)
 
-	handler := review.NewReviewHandler(mockClient)
+	handler := review.NewReviewHandler(mockClient, nil)
 
 	t.Run("invalid body", func(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/reviews/get", bytes.NewReader([]byte("invalid-json")))
@@ -72,7 +80,7 @@ func TestReviewHandler_Add(t *testing.T) {
 
 	t.Run("invalid body", func(t *testing.T) {
 		mockClient := genmock.NewMockReviewServiceClient(ctrl)
-		handler := review.NewReviewHandler(mockClient)
+		handler := review.NewReviewHandler(mockClient, nil)
 
 		req := httptest.NewRequest(http.MethodPost, "/reviews/add", bytes.NewReader([]byte("invalid-json")))
 		w := httptest.NewRecorder()
@@ -84,7 +92,7 @@ func TestReviewHandler_Add(t *testing.T) {
 
 	t.Run("add review failure", func(t *testing.T) {
 		mockClient := genmock.NewMockReviewServiceClient(ctrl)
-		handler := review.NewReviewHandler(mockClient)
+		handler := review.NewReviewHandler(mockClient, nil)
 
 		reqData := map[string]interface{}{
 			"product_id": "42",
@@ -106,7 +114,7 @@ func TestReviewHandler_Add(t *testing.T) {
 
 	t.Run("successful add review", func(t *testing.T) {
 		mockClient := genmock.NewMockReviewServiceClient(ctrl)
-		handler := review.NewReviewHandler(mockClient)
+		handler := review.NewReviewHandler(mockClient, nil)
 
 		reqData := map[string]interface{}{
 			"product_id": "42",
@@ -116,7 +124,7 @@ func TestReviewHandler_Add(t *testing.T) {
 		body, _ := json.Marshal(reqData)
 
 		// Mock successful AddReview response
-		mockClient.EXPECT().AddReview(gomock.Any(), gomock.Any()).Return(&gen.EmptyResponse{}, nil)
+		mockClient.EXPECT().AddReview(gomock.Any(), gomock.Any()).Return(&gen.AddReviewResponse{Status: "pending"}, nil)
 
 		req := httptest.NewRequest(http.MethodPost, "/reviews/add", bytes.NewReader(body))
 		w := httptest.NewRecorder()
@@ -124,11 +132,12 @@ func TestReviewHandler_Add(t *testing.T) {
 		handler.Add(w, req)
 
 		assert.Equal(t, http.StatusCreated, w.Result().StatusCode)
+		assert.Contains(t, w.Body.String(), `"status":"pending"`)
 	})
 
 	//t.Run("invalid rating value", func(t *testing.T) {
 	//	mockClient := genmock.NewMockReviewServiceClient(ctrl)
-	//	handler := review.NewReviewHandler(mockClient)
+	//	handler := review.NewReviewHandler(mockClient, nil)
 	//
 	//	reqData := map[string]interface{}{
 	//		"product_id": "42",
@@ -147,7 +156,7 @@ func TestReviewHandler_Add(t *testing.T) {
 	//
 	//t.Run("missing required fields", func(t *testing.T) {
 	//	mockClient := genmock.NewMockReviewServiceClient(ctrl)
-	//	handler := review.NewReviewHandler(mockClient)
+	//	handler := review.NewReviewHandler(mockClient, nil)
 	//
 	//	reqData := map[string]interface{}{
 	//		"product_id": "42",
@@ -164,3 +173,248 @@ func TestReviewHandler_Add(t *testing.T) {
 	//	assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
 	//})
 }
+
+func TestReviewHandler_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := genmock.NewMockReviewServiceClient(ctrl)
+	handler := review.NewReviewHandler(mockClient, nil)
+
+	t.Run("invalid review id", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/review/bad", bytes.NewReader([]byte(`{}`)))
+		req = mux.SetURLVars(req, map[string]string{"id": "bad"})
+		w := httptest.NewRecorder()
+
+		handler.Update(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	})
+
+	t.Run("not an author", func(t *testing.T) {
+		reviewID := uuid.New()
+		mockClient.EXPECT().
+			UpdateReview(gomock.Any(), &gen.UpdateReviewRequest{ReviewId: reviewID.String(), Rating: 4, Comment: "ok"}).
+			Return(nil, status.Error(codes.NotFound, "review not found"))
+
+		req := httptest.NewRequest(http.MethodPut, "/review/"+reviewID.String(),
+			bytes.NewReader([]byte(`{"rating":4,"comment":"ok"}`)))
+		req = mux.SetURLVars(req, map[string]string{"id": reviewID.String()})
+		w := httptest.NewRecorder()
+
+		handler.Update(w, req)
+
+		assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		reviewID := uuid.New()
+		mockClient.EXPECT().UpdateReview(gomock.Any(), gomock.Any()).Return(&gen.EmptyResponse{}, nil)
+
+		req := httptest.NewRequest(http.MethodPut, "/review/"+reviewID.String(),
+			bytes.NewReader([]byte(`{"rating":5,"comment":"great"}`)))
+		req = mux.SetURLVars(req, map[string]string{"id": reviewID.String()})
+		w := httptest.NewRecorder()
+
+		handler.Update(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+	})
+}
+
+func TestReviewHandler_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := genmock.NewMockReviewServiceClient(ctrl)
+	handler := review.NewReviewHandler(mockClient, nil)
+
+	reviewID := uuid.New()
+	mockClient.EXPECT().
+		DeleteReview(gomock.Any(), &gen.DeleteReviewRequest{ReviewId: reviewID.String()}).
+		Return(&gen.EmptyResponse{}, nil)
+
+	req := httptest.NewRequest(http.MethodDelete, "/review/"+reviewID.String(), nil)
+	req = mux.SetURLVars(req, map[string]string{"id": reviewID.String()})
+	w := httptest.NewRecorder()
+
+	handler.Delete(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Result().StatusCode)
+}
+
+func TestReviewHandler_Vote(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := genmock.NewMockReviewServiceClient(ctrl)
+	handler := review.NewReviewHandler(mockClient, nil)
+
+	reviewID := uuid.New()
+	mockClient.EXPECT().
+		VoteReview(gomock.Any(), &gen.VoteReviewRequest{ReviewId: reviewID.String(), Helpful: true}).
+		Return(&gen.EmptyResponse{}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/review/"+reviewID.String()+"/vote",
+		bytes.NewReader([]byte(`{"helpful":true}`)))
+	req = mux.SetURLVars(req, map[string]string{"id": reviewID.String()})
+	w := httptest.NewRecorder()
+
+	handler.Vote(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+}
+
+func TestReviewHandler_Reply(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockClient := genmock.NewMockReviewServiceClient(ctrl)
+	handler := review.NewReviewHandler(mockClient, nil)
+
+	t.Run("foreign product", func(t *testing.T) {
+		reviewID := uuid.New()
+		mockClient.EXPECT().
+			ReplyReview(gomock.Any(), gomock.Any()).
+			Return(nil, status.Error(codes.PermissionDenied, "forbidden"))
+
+		req := httptest.NewRequest(http.MethodPost, "/review/"+reviewID.String()+"/reply",
+			bytes.NewReader([]byte(`{"text":"Спасибо"}`)))
+		req = mux.SetURLVars(req, map[string]string{"id": reviewID.String()})
+		w := httptest.NewRecorder()
+
+		handler.Reply(w, req)
+
+		assert.Equal(t, http.StatusForbidden, w.Result().StatusCode)
+	})
+
+	t.Run("already replied", func(t *testing.T) {
+		reviewID := uuid.New()
+		mockClient.EXPECT().
+			ReplyReview(gomock.Any(), gomock.Any()).
+			Return(nil, status.Error(codes.AlreadyExists, "already exists"))
+
+		req := httptest.NewRequest(http.MethodPost, "/review/"+reviewID.String()+"/reply",
+			bytes.NewReader([]byte(`{"text":"Спасибо"}`)))
+		req = mux.SetURLVars(req, map[string]string{"id": reviewID.String()})
+		w := httptest.NewRecorder()
+
+		handler.Reply(w, req)
+
+		assert.Equal(t, http.StatusConflict, w.Result().StatusCode)
+	})
+}
+
+func TestReviewHandler_Summary(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("success", func(t *testing.T) {
+		mockClient := genmock.NewMockReviewServiceClient(ctrl)
+		handler := review.NewReviewHandler(mockClient, nil)
+
+		productID := uuid.New()
+		mockClient.EXPECT().
+			GetReviewSummary(gomock.Any(), &gen.GetReviewSummaryRequest{ProductId: productID.String()}).
+			Return(&gen.ReviewSummary{
+				ProductId:       productID.String(),
+				Total:           3,
+				StarCounts:      []int32{0, 0, 1, 0, 2},
+				WithPhotosShare: 0.5,
+				VerifiedShare:   1,
+				Recent:          []*gen.Review{{Id: uuid.New().String(), Name: "John", Rating: 5}},
+			}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/review/summary/"+productID.String(), nil)
+		req = mux.SetURLVars(req, map[string]string{"id": productID.String()})
+		w := httptest.NewRecorder()
+
+		handler.Summary(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+		var body struct {
+			Total  int            `json:"total"`
+			Stars  map[string]int `json:"stars"`
+			Recent []interface{}  `json:"recent"`
+		}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, 3, body.Total)
+		assert.Equal(t, map[string]int{"1": 0, "2": 0, "3": 1, "4": 0, "5": 2}, body.Stars)
+		assert.Len(t, body.Recent, 1)
+	})
+
+	t.Run("invalid product id", func(t *testing.T) {
+		mockClient := genmock.NewMockReviewServiceClient(ctrl)
+		handler := review.NewReviewHandler(mockClient, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/review/summary/abc", nil)
+		req = mux.SetURLVars(req, map[string]string{"id": "abc"})
+		w := httptest.NewRecorder()
+
+		handler.Summary(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	})
+}
+
+func TestReviewHandler_UploadPhoto(t *testing.T) {
+	newRequest := func(t *testing.T, reviewID uuid.UUID) *http.Request {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		part, err := writer.CreateFormFile("file", "photo.jpg")
+		assert.NoError(t, err)
+		_, err = part.Write([]byte("image"))
+		assert.NoError(t, err)
+		assert.NoError(t, writer.Close())
+
+		req := httptest.NewRequest(http.MethodPost, "/review/"+reviewID.String()+"/photo", body)
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		return mux.SetURLVars(req, map[string]string{"id": reviewID.String()})
+	}
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := genmock.NewMockReviewServiceClient(ctrl)
+		mockMinio := minio_mocks.NewMockProvider(ctrl)
+		handler := review.NewReviewHandler(mockClient, mockMinio)
+
+		reviewID := uuid.New()
+		mockMinio.EXPECT().
+			CreateOne(gomock.Any(), minio.FileData{Name: "photo.jpg", Data: []byte("image")}).
+			Return(&dto.UploadResponse{URL: "http://minio/photo.jpg", ObjectID: "photo.jpg"}, nil)
+		mockClient.EXPECT().
+			AddReviewPhoto(gomock.Any(), &gen.AddReviewPhotoRequest{ReviewId: reviewID.String(), ImageUrl: "http://minio/photo.jpg"}).
+			Return(&gen.EmptyResponse{}, nil)
+
+		w := httptest.NewRecorder()
+		handler.UploadPhoto(w, newRequest(t, reviewID))
+
+		assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+	})
+
+	t.Run("rejected photo is removed from storage", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockClient := genmock.NewMockReviewServiceClient(ctrl)
+		mockMinio := minio_mocks.NewMockProvider(ctrl)
+		handler := review.NewReviewHandler(mockClient, mockMinio)
+
+		reviewID := uuid.New()
+		mockMinio.EXPECT().
+			CreateOne(gomock.Any(), gomock.Any()).
+			Return(&dto.UploadResponse{URL: "http://minio/photo.jpg", ObjectID: "photo.jpg"}, nil)
+		mockClient.EXPECT().
+			AddReviewPhoto(gomock.Any(), gomock.Any()).
+			Return(nil, status.Error(codes.PermissionDenied, "forbidden"))
+		mockMinio.EXPECT().DeleteOne(gomock.Any(), "photo.jpg").Return(nil)
+
+		w := httptest.NewRecorder()
+		handler.UploadPhoto(w, newRequest(t, reviewID))
+
+		assert.Equal(t, http.StatusForbidden, w.Result().StatusCode)
+	})
+}
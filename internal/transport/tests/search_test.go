@@ -55,14 +55,20 @@ func TestSearchWithFilterAndSort_Success(t *testing.T) {
 
 	// Products search
 	mockSearchUC.EXPECT().SearchProductsByNameWithFilterAndSort(
-		gomock.Any(), null.String{}, "phone", 0, 100.0, 1000.0, float32(3.0), models.SortByPriceAsc,
+		gomock.Any(), null.String{}, "phone", 0, models.Rubles(100), models.Rubles(1000), float32(3.0), gomock.Any(), models.SortByPriceAsc,
 	).Return([]*models.Product{}, nil)
 
+	// Attribute facets
+	mockSearchUC.EXPECT().GetAttributeFacets(
+		gomock.Any(), null.String{}, "phone", models.Rubles(100), models.Rubles(1000), float32(3.0), gomock.Any(),
+	).Return([]models.AttributeFacet{{Name: "Бренд", Type: models.AttributeEnum}}, nil)
+
 	rr := httptest.NewRecorder()
 	handler.SearchWithFilterAndSort(rr, req)
 
 	assert.Equal(t, http.StatusOK, rr.Code)
 	assert.Contains(t, rr.Body.String(), `"categories"`)
+	assert.Contains(t, rr.Body.String(), `"facets"`)
 }
 
 //func TestSearchWithFilterAndSort_ParseError(t *testing.T) {
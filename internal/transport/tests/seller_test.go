@@ -1,12 +1,22 @@
 package tests
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	sellerTransport "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/seller"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/mocks"
+	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,8 +30,8 @@ func TestConvertToBriefProduct(t *testing.T) {
 			SellerID:        sellerID,
 			Name:            "Test Product",
 			Description:     "Test Description",
-			Price:           100.0,
-			PriceDiscount:   90.0,
+			Price:           models.Rubles(100),
+			PriceDiscount:   models.Rubles(90),
 			Quantity:        10,
 			PreviewImageURL: "http://example.com/image.jpg",
 			ReviewsCount:    5,
@@ -37,8 +47,8 @@ func TestConvertToBriefProduct(t *testing.T) {
 		assert.Equal(t, productID, briefProduct.ID)
 		assert.Equal(t, "Test Product", briefProduct.Name)
 		assert.Equal(t, "http://example.com/image.jpg", briefProduct.ImageURL)
-		assert.Equal(t, 100.0, briefProduct.Price)
-		assert.Equal(t, 90.0, briefProduct.PriceDiscount)
+		assert.Equal(t, models.Rubles(100), briefProduct.Price)
+		assert.Equal(t, models.Rubles(90), briefProduct.PriceDiscount)
 		assert.Equal(t, uint(10), briefProduct.Quantity)
 		assert.Equal(t, uint(5), briefProduct.ReviewsCount)
 		assert.Equal(t, float32(4.5), briefProduct.Rating)
@@ -56,8 +66,8 @@ func TestConvertToBriefProduct(t *testing.T) {
 			SellerID:        sellerID,
 			Name:            "Test Product",
 			Description:     "Test Description",
-			Price:           100.0,
-			PriceDiscount:   90.0,
+			Price:           models.Rubles(100),
+			PriceDiscount:   models.Rubles(90),
 			Quantity:        10,
 			PreviewImageURL: "http://example.com/image.jpg",
 			ReviewsCount:    5,
@@ -77,8 +87,8 @@ func TestConvertToProductsResponse(t *testing.T) {
 		ID:              uuid.New(),
 		Name:            "Product 1",
 		PreviewImageURL: "http://example.com/image1.jpg",
-		Price:           100.0,
-		PriceDiscount:   90.0,
+		Price:           models.Rubles(100),
+		PriceDiscount:   models.Rubles(90),
 		Quantity:        10,
 		ReviewsCount:    5,
 		Rating:          4.5,
@@ -88,8 +98,8 @@ func TestConvertToProductsResponse(t *testing.T) {
 		ID:              uuid.New(),
 		Name:            "Product 2",
 		PreviewImageURL: "http://example.com/image2.jpg",
-		Price:           200.0,
-		PriceDiscount:   180.0,
+		Price:           models.Rubles(200),
+		PriceDiscount:   models.Rubles(180),
 		Quantity:        20,
 		ReviewsCount:    10,
 		Rating:          4.0,
@@ -110,8 +120,8 @@ func TestConvertToSellerProductsResponse(t *testing.T) {
 		ID:              uuid.New(),
 		Name:            "Product 1",
 		PreviewImageURL: "http://example.com/image1.jpg",
-		Price:           100.0,
-		PriceDiscount:   90.0,
+		Price:           models.Rubles(100),
+		PriceDiscount:   models.Rubles(90),
 		Quantity:        10,
 		ReviewsCount:    5,
 		Rating:          4.5,
@@ -121,8 +131,8 @@ func TestConvertToSellerProductsResponse(t *testing.T) {
 		ID:              uuid.New(),
 		Name:            "Product 2",
 		PreviewImageURL: "http://example.com/image2.jpg",
-		Price:           200.0,
-		PriceDiscount:   180.0,
+		Price:           models.Rubles(200),
+		PriceDiscount:   models.Rubles(180),
 		Quantity:        20,
 		ReviewsCount:    10,
 		Rating:          4.0,
@@ -144,8 +154,8 @@ func TestDTOJsonMarshaling(t *testing.T) {
 			ID:            uuid.New(),
 			Name:          "Test Product",
 			ImageURL:      "http://example.com/image.jpg",
-			Price:         100.0,
-			PriceDiscount: 90.0,
+			Price:         models.Rubles(100),
+			PriceDiscount: models.Rubles(90),
 			Quantity:      10,
 			ReviewsCount:  5,
 			Rating:        4.5,
@@ -189,3 +199,60 @@ func TestDTOJsonMarshaling(t *testing.T) {
 		assert.Equal(t, len(response.Products), len(decoded.Products))
 	})
 }
+
+func TestSellerHandler_AddVariant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := mocks.NewMockISellerUsecase(ctrl)
+	handler := sellerTransport.NewSellerHandler(mockUsecase, nil)
+
+	sellerID := uuid.New()
+	productID := uuid.New()
+
+	newRequest := func(productID, body string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/seller/products/"+productID+"/variants", strings.NewReader(body))
+		req = req.WithContext(context.WithValue(req.Context(), domains.UserIDKey{}, sellerID.String()))
+		return mux.SetURLVars(req, map[string]string{"id": productID})
+	}
+
+	t.Run("success", func(t *testing.T) {
+		expected := dto.AddVariantRequest{
+			SKU:      "TS-M-WHITE",
+			Options:  models.VariantOptions{"Размер": "M"},
+			Price:    models.Rubles(1000),
+			Quantity: 3,
+		}
+		mockUsecase.EXPECT().
+			AddVariant(gomock.Any(), sellerID, productID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ uuid.UUID, req dto.AddVariantRequest) (*models.ProductVariant, error) {
+				assert.Equal(t, expected, req)
+				return &models.ProductVariant{ID: uuid.New(), ProductID: productID, SKU: req.SKU, Options: req.Options}, nil
+			})
+
+		w := httptest.NewRecorder()
+		handler.AddVariant(w, newRequest(productID.String(),
+			`{"sku":"TS-M-WHITE","options":{"Размер":"M"},"price":1000,"quantity":3}`))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var variant models.ProductVariant
+		assert.NoError(t, json.NewDecoder(w.Body).Decode(&variant))
+		assert.Equal(t, "TS-M-WHITE", variant.SKU)
+	})
+
+	t.Run("invalid product id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		handler.AddVariant(w, newRequest("invalid", `{}`))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("foreign product", func(t *testing.T) {
+		mockUsecase.EXPECT().
+			AddVariant(gomock.Any(), sellerID, productID, gomock.Any()).
+			Return(nil, errs.ErrForbidden)
+
+		w := httptest.NewRecorder()
+		handler.AddVariant(w, newRequest(productID.String(), `{"sku":"TS-M-WHITE"}`))
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
@@ -0,0 +1,168 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/warehouse"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarehouseService_SetStock(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIWarehouseUsecase(ctrl)
+		handler := warehouse.NewWarehouseService(mockUsecase)
+
+		productID := uuid.New()
+		mockUsecase.EXPECT().SetStock(gomock.Any(), dto.SetWarehouseStockRequest{ProductID: productID, Quantity: 12}).
+			Return(nil)
+
+		body, _ := json.Marshal(map[string]any{"productID": productID, "quantity": 12})
+		r := httptest.NewRequest(http.MethodPut, "/warehouse/stock", bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		handler.SetStock(w, r)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("not assigned to warehouse", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIWarehouseUsecase(ctrl)
+		handler := warehouse.NewWarehouseService(mockUsecase)
+
+		mockUsecase.EXPECT().SetStock(gomock.Any(), gomock.Any()).Return(errs.ErrForbidden)
+
+		r := httptest.NewRequest(http.MethodPut, "/warehouse/stock", bytes.NewBufferString(`{"quantity":1}`))
+		w := httptest.NewRecorder()
+		handler.SetStock(w, r)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		handler := warehouse.NewWarehouseService(nil)
+
+		r := httptest.NewRequest(http.MethodPut, "/warehouse/stock", bytes.NewBufferString("{"))
+		w := httptest.NewRecorder()
+		handler.SetStock(w, r)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
+func TestWarehouseService_GetStock(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIWarehouseUsecase(ctrl)
+		handler := warehouse.NewWarehouseService(mockUsecase)
+
+		mockUsecase.EXPECT().GetStock(gomock.Any(), 20).
+			Return([]models.WarehouseStock{{ProductID: uuid.New(), Name: "Кружка", Quantity: 3}}, nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/warehouse/stock?offset=20", nil)
+		w := httptest.NewRecorder()
+		handler.GetStock(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp []models.WarehouseStock
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		require.Len(t, resp, 1)
+		assert.Equal(t, 3, resp[0].Quantity)
+	})
+
+	t.Run("invalid offset", func(t *testing.T) {
+		handler := warehouse.NewWarehouseService(nil)
+
+		r := httptest.NewRequest(http.MethodGet, "/warehouse/stock?offset=-1", nil)
+		w := httptest.NewRecorder()
+		handler.GetStock(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestWarehouseService_GetPickList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := mocks.NewMockIWarehouseUsecase(ctrl)
+	handler := warehouse.NewWarehouseService(mockUsecase)
+
+	mockUsecase.EXPECT().GetPickList(gomock.Any()).Return([]models.PickListItem{
+		{ProductID: uuid.New(), Name: "Футболка", SKU: "TS-M", Options: models.VariantOptions{"Размер": "M"}, Quantity: 5, Orders: 3},
+	}, nil)
+
+	r := httptest.NewRequest(http.MethodGet, "/warehouse/pick-list", nil)
+	w := httptest.NewRecorder()
+	handler.GetPickList(w, r)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp []models.PickListItem
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp, 1)
+	assert.Equal(t, 5, resp[0].Quantity)
+	assert.Equal(t, "M", resp[0].Options["Размер"])
+}
+
+func TestWarehouseService_RouteOrder(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIWarehouseUsecase(ctrl)
+		handler := warehouse.NewWarehouseService(mockUsecase)
+
+		orderID := uuid.New()
+		warehouseID := uuid.New()
+		mockUsecase.EXPECT().RouteOrder(gomock.Any(), orderID).Return(warehouseID, nil)
+
+		r := httptest.NewRequest(http.MethodPost, "/admin/orders/"+orderID.String()+"/warehouse", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": orderID.String()})
+		w := httptest.NewRecorder()
+		handler.RouteOrder(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp dto.RouteOrderResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, warehouseID, resp.WarehouseID)
+	})
+
+	t.Run("no warehouse can fulfil", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockUsecase := mocks.NewMockIWarehouseUsecase(ctrl)
+		handler := warehouse.NewWarehouseService(mockUsecase)
+
+		orderID := uuid.New()
+		mockUsecase.EXPECT().RouteOrder(gomock.Any(), orderID).
+			Return(uuid.Nil, errs.NewBusinessLogicError("no warehouse can fulfil the order"))
+
+		r := httptest.NewRequest(http.MethodPost, "/admin/orders/"+orderID.String()+"/warehouse", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": orderID.String()})
+		w := httptest.NewRecorder()
+		handler.RouteOrder(w, r)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		handler := warehouse.NewWarehouseService(nil)
+
+		r := httptest.NewRequest(http.MethodPost, "/admin/orders/abc/warehouse", nil)
+		r = mux.SetURLVars(r, map[string]string{"id": "abc"})
+		w := httptest.NewRecorder()
+		handler.RouteOrder(w, r)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -92,6 +92,10 @@ func HandleDomainError(ctx context.Context, w http.ResponseWriter, err error, de
 		SendJSONError(ctx, w, http.StatusNotFound, fmt.Sprintf("%s: %v", description, err))
 		log.Debug("product not found: ", description, err.Error())
 
+	case errors.Is(err, errs.ErrForbidden):
+		SendJSONError(ctx, w, http.StatusForbidden, fmt.Sprintf("%s: %v", description, err))
+		log.Debug("forbidden: ", description, err.Error())
+
 	case errors.Is(err, errs.ErrProductNotApproved):
 		SendJSONError(ctx, w, http.StatusForbidden, fmt.Sprintf("%s: %v", description, err))
 		log.Debug("product not approved: ", description, err.Error())
@@ -99,6 +103,10 @@ func HandleDomainError(ctx context.Context, w http.ResponseWriter, err error, de
 	case errors.Is(err, errs.ErrNotEnoughStock):
 		SendJSONError(ctx, w, http.StatusBadRequest, fmt.Sprintf("%s: %v", description, err))
 		log.Debug("not enough stock: ", description, err.Error())
+
+	case errors.Is(err, errs.ErrSlotUnavailable):
+		SendJSONError(ctx, w, http.StatusConflict, fmt.Sprintf("%s: %v", description, err))
+		log.Debug("delivery slot unavailable: ", description, err.Error())
 	
 	case errors.Is(err, errs.ErrInvalidProductPrice):
 		SendJSONError(ctx, w, http.StatusBadRequest, fmt.Sprintf("%s: %v", description, err))
@@ -136,6 +144,8 @@ func HandleGRPCError(ctx context.Context, w http.ResponseWriter, err error, op s
 		SendJSONError(ctx, w, http.StatusNotFound, st.Message())
 	case codes.InvalidArgument:
 		SendJSONError(ctx, w, http.StatusBadRequest, st.Message())
+	case codes.PermissionDenied:
+		SendJSONError(ctx, w, http.StatusForbidden, st.Message())
 	default:
 		logger.WithError(err).Error(op + ": unexpected gRPC status code")
 		SendJSONError(ctx, w, http.StatusInternalServerError, "internal server error")
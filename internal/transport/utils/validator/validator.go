@@ -174,8 +174,12 @@ func ValidateLabel(label string) error {
 }
 
 func ValidateCreateOrderDTO(req dto.CreateOrderDTO) error {
-	if req.AddressID == uuid.Nil {
-		return errors.New("addressID is required")
+	hasPickupPoint := req.PickupPointID != nil && *req.PickupPointID != uuid.Nil
+	if req.AddressID == uuid.Nil && !hasPickupPoint {
+		return errors.New("addressID or pickupPointID is required")
+	}
+	if req.AddressID != uuid.Nil && hasPickupPoint {
+		return errors.New("only one of addressID and pickupPointID can be set")
 	}
 
 	if len(req.Items) == 0 {
@@ -0,0 +1,265 @@
+package warehouse
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/utils/response"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/mailru/easyjson"
+)
+
+//go:generate mockgen -source=warehouse.go -destination=../../usecase/mocks/warehouse_usecase_mock.go -package=mocks IWarehouseUsecase
+type IWarehouseUsecase interface {
+	GetWarehouses(ctx context.Context) ([]dto.WarehouseDTO, error)
+	CreateWarehouse(ctx context.Context, req dto.CreateWarehouseRequest) (dto.WarehouseDTO, error)
+	AssignStaff(ctx context.Context, userID uuid.UUID, req dto.AssignWarehouseStaffRequest) error
+	SetStock(ctx context.Context, req dto.SetWarehouseStockRequest) error
+	GetStock(ctx context.Context, offset int) ([]models.WarehouseStock, error)
+	GetPickList(ctx context.Context) ([]models.PickListItem, error)
+	RouteOrder(ctx context.Context, orderID uuid.UUID) (uuid.UUID, error)
+}
+
+type WarehouseService struct {
+	u IWarehouseUsecase
+}
+
+func NewWarehouseService(u IWarehouseUsecase) *WarehouseService {
+	return &WarehouseService{
+		u: u,
+	}
+}
+
+// GetWarehouses godoc
+//
+//	@Summary	Список складов
+//	@Tags		warehouse
+//	@Produce	json
+//	@Success	200	{array}		dto.WarehouseDTO
+//	@Failure	500	{object}	object
+//	@Router		/admin/warehouses [get]
+func (h *WarehouseService) GetWarehouses(w http.ResponseWriter, r *http.Request) {
+	const op = "WarehouseService.GetWarehouses"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	warehouses, err := h.u.GetWarehouses(r.Context())
+	if err != nil {
+		logger.WithError(err).Error("get warehouses")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, warehouses)
+}
+
+// CreateWarehouse godoc
+//
+//	@Summary	Создать склад
+//	@Tags		warehouse
+//	@Accept		json
+//	@Produce	json
+//	@Param		request			body		dto.CreateWarehouseRequest	true	"Склад"
+//	@Param		X-Csrf-Token	header		string						true	"CSRF-токен для защиты от подделки запросов"
+//	@Success	201				{object}	dto.WarehouseDTO			"Склад создан"
+//	@Failure	422				{object}	object						"Некорректные данные склада"
+//	@Failure	500				{object}	object						"Внутренняя ошибка сервера"
+//	@Router		/admin/warehouses [post]
+func (h *WarehouseService) CreateWarehouse(w http.ResponseWriter, r *http.Request) {
+	const op = "WarehouseService.CreateWarehouse"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	var req dto.CreateWarehouseRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Error("parse request data")
+		response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+		return
+	}
+
+	warehouse, err := h.u.CreateWarehouse(r.Context(), req)
+	if err != nil {
+		logger.WithError(err).Error("create warehouse")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusCreated, warehouse)
+}
+
+// AssignStaff godoc
+//
+//	@Summary		Прикрепить работника к складу
+//	@Description	Работник склада видит очередь и остатки только своего склада. warehouseID: null открепляет работника.
+//	@Tags			warehouse
+//	@Accept			json
+//	@Param			id				path	string							true	"ID работника склада"
+//	@Param			X-Csrf-Token	header	string							true	"CSRF-токен для защиты от подделки запросов"
+//	@Param			request			body	dto.AssignWarehouseStaffRequest	true	"Склад"
+//	@Success		204				"Работник прикреплён"
+//	@Failure		400				{object}	object
+//	@Failure		404				{object}	object	"Работник склада или склад не найден"
+//	@Failure		500				{object}	object
+//	@Router			/admin/warehouses/staff/{id} [put]
+func (h *WarehouseService) AssignStaff(w http.ResponseWriter, r *http.Request) {
+	const op = "WarehouseService.AssignStaff"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	userID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse user ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	var req dto.AssignWarehouseStaffRequest
+	if err = easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Error("parse request data")
+		response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+		return
+	}
+
+	if err = h.u.AssignStaff(r.Context(), userID, req); err != nil {
+		logger.WithError(err).Error("assign staff")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RouteOrder godoc
+//
+//	@Summary		Направить заказ на склад
+//	@Description	Направляет оформленный заказ, который не удалось распределить при оформлении,
+//	@Description	на ближайший склад, где хватает товара для всего заказа
+//	@Tags			warehouse
+//	@Produce		json
+//	@Param			id				path		string	true	"ID заказа"
+//	@Param			X-Csrf-Token	header		string	true	"CSRF-токен для защиты от подделки запросов"
+//	@Success		200				{object}	dto.RouteOrderResponse
+//	@Failure		400				{object}	object
+//	@Failure		404				{object}	object
+//	@Failure		422				{object}	object	"Заказ уже на складе или ни один склад не может его собрать"
+//	@Failure		500				{object}	object
+//	@Router			/admin/orders/{id}/warehouse [post]
+func (h *WarehouseService) RouteOrder(w http.ResponseWriter, r *http.Request) {
+	const op = "WarehouseService.RouteOrder"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	orderID, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		logger.WithError(err).Error("parse order ID")
+		response.HandleDomainError(r.Context(), w, errs.ErrInvalidID, op)
+		return
+	}
+
+	warehouseID, err := h.u.RouteOrder(r.Context(), orderID)
+	if err != nil {
+		logger.WithError(err).Error("route order")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, dto.RouteOrderResponse{WarehouseID: warehouseID})
+}
+
+// SetStock godoc
+//
+//	@Summary		Задать остаток на складе
+//	@Description	Задаёт остаток товара или SKU на складе текущего работника
+//	@Tags			warehouse
+//	@Accept			json
+//	@Param			X-Csrf-Token	header	string							true	"CSRF-токен для защиты от подделки запросов"
+//	@Param			request			body	dto.SetWarehouseStockRequest	true	"Остаток"
+//	@Success		204				"Остаток сохранён"
+//	@Failure		400				{object}	object
+//	@Failure		403				{object}	object	"Работник не прикреплён к складу"
+//	@Failure		404				{object}	object
+//	@Failure		422				{object}	object
+//	@Failure		500				{object}	object
+//	@Router			/warehouse/stock [put]
+func (h *WarehouseService) SetStock(w http.ResponseWriter, r *http.Request) {
+	const op = "WarehouseService.SetStock"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	var req dto.SetWarehouseStockRequest
+	if err := easyjson.UnmarshalFromReader(r.Body, &req); err != nil {
+		logger.WithError(err).Error("parse request data")
+		response.HandleDomainError(r.Context(), w, errs.ErrParseRequestData, op)
+		return
+	}
+
+	if err := h.u.SetStock(r.Context(), req); err != nil {
+		logger.WithError(err).Error("set warehouse stock")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetStock godoc
+//
+//	@Summary		Остатки склада
+//	@Description	Возвращает остатки склада текущего работника по 20 записей
+//	@Tags			warehouse
+//	@Produce		json
+//	@Param			offset	query		int	false	"Смещение для пагинации"
+//	@Success		200		{array}		models.WarehouseStock
+//	@Failure		400		{object}	object
+//	@Failure		403		{object}	object	"Работник не прикреплён к складу"
+//	@Failure		500		{object}	object
+//	@Router			/warehouse/stock [get]
+func (h *WarehouseService) GetStock(w http.ResponseWriter, r *http.Request) {
+	const op = "WarehouseService.GetStock"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	offset := 0
+	if raw := r.URL.Query().Get("offset"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 {
+			logger.WithField("offset", raw).Warn("invalid offset")
+			response.SendJSONError(r.Context(), w, http.StatusBadRequest, "invalid offset")
+			return
+		}
+		offset = parsed
+	}
+
+	stock, err := h.u.GetStock(r.Context(), offset)
+	if err != nil {
+		logger.WithError(err).Error("get warehouse stock")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, stock)
+}
+
+// GetPickList godoc
+//
+//	@Summary		Лист сборки
+//	@Description	Суммирует позиции оформленных заказов склада текущего работника по товарам и SKU
+//	@Tags			warehouse
+//	@Produce		json
+//	@Success		200	{array}		models.PickListItem
+//	@Failure		403	{object}	object	"Работник не прикреплён к складу"
+//	@Failure		500	{object}	object
+//	@Router			/warehouse/pick-list [get]
+func (h *WarehouseService) GetPickList(w http.ResponseWriter, r *http.Request) {
+	const op = "WarehouseService.GetPickList"
+	logger := logctx.GetLogger(r.Context()).WithField("op", op)
+
+	items, err := h.u.GetPickList(r.Context())
+	if err != nil {
+		logger.WithError(err).Error("get pick list")
+		response.HandleDomainError(r.Context(), w, err, op)
+		return
+	}
+
+	response.SendJSONResponse(r.Context(), w, http.StatusOK, items)
+}
@@ -4,13 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/address"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/geocoder"
 	"github.com/google/uuid"
+	"github.com/guregu/null"
 )
 
 //go:generate mockgen -source=address.go -destination=../mocks/address_usecase_mock.go -package=mocks IAddressUsecase
@@ -21,14 +24,17 @@ type IAddressUsecase interface {
 }
 
 type AddressUsecase struct {
-	Repo address.IAddressRepository
+	Repo     address.IAddressRepository
+	geocoder geocoder.IGeocoder
 }
 
 func NewAddressUsecase(
 	repo address.IAddressRepository,
+	geocoder geocoder.IGeocoder,
 ) *AddressUsecase {
 	return &AddressUsecase{
-		Repo: repo,
+		Repo:     repo,
+		geocoder: geocoder,
 	}
 }
 
@@ -38,13 +44,24 @@ func (u *AddressUsecase) CreateAddress(ctx context.Context, userID uuid.UUID, in
 		WithField("user_id", userID).
 		WithField("address", in)
 
+	// Адрес сохраняется только в виде, нормализованном геокодером; координата клиента игнорируется
+	geocoded, err := u.geocoder.Geocode(ctx, geocodeQuery(in))
+	if err != nil {
+		if errors.Is(err, models.ErrAddressNotGeocoded) {
+			logger.Warn("address not geocoded")
+			return fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("address not found"))
+		}
+		logger.WithError(err).Error("geocode address")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	addressID := uuid.New()
 	addr := models.AddressDB{
 		ID:            addressID,
-		Region:        in.Region,
-		City:          in.City,
-		AddressString: in.AddressString,
-		Coordinate:    in.Coordinate,
+		Region:        orInput(geocoded.Region, in.Region),
+		City:          orInput(geocoded.City, in.City),
+		AddressString: null.StringFrom(geocoded.AddressString),
+		Coordinate:    null.StringFrom(geocoded.Coordinate.String()),
 	}
 
 	addrID, err := u.Repo.CheckAddressExists(ctx, addr)
@@ -128,3 +145,22 @@ func (u *AddressUsecase) GetPickupPoints(ctx context.Context) ([]dto.GetPointAdd
 
 	return res, nil
 }
+
+// geocodeQuery собирает запрос к геокодеру из региона, города и строки адреса
+func geocodeQuery(in dto.AddressDTO) string {
+	parts := make([]string, 0, 3)
+	for _, part := range []null.String{in.Region, in.City, in.AddressString} {
+		if part.Valid && strings.TrimSpace(part.String) != "" {
+			parts = append(parts, part.String)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// orInput возвращает значение геокодера, а если он его не определил — введённое пользователем
+func orInput(geocoded string, input null.String) null.String {
+	if geocoded != "" {
+		return null.StringFrom(geocoded)
+	}
+	return input
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/redis"
 	productRepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/product"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/review"
 
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
@@ -19,19 +20,25 @@ type IAdminRepository interface {
 	UpdateProductStatus(ctx context.Context, productID uuid.UUID, status models.ProductStatus) error
 	GetPendingUsers(ctx context.Context, offset int) ([]*models.User, error)
 	UpdateUserRole(ctx context.Context, userID uuid.UUID, role models.UserRole) error
+	GetPendingReviews(ctx context.Context, offset int) ([]*models.ModerationReview, error)
+	UpdateReviewStatus(ctx context.Context, reviewID uuid.UUID, status models.ReviewStatus) (uuid.UUID, error)
 }
 
 type AdminUsecase struct {
 	repo        IAdminRepository
 	repoProduct productRepo.IProductRepository
 	redisRepo   *redis.SuggestionsRepository
+	reviewCache review.IReviewSummaryCache
 }
 
-func NewAdminUsecase(r IAdminRepository, redisRepo *redis.SuggestionsRepository, repoProduct productRepo.IProductRepository) *AdminUsecase {
+// NewAdminUsecase создаёт usecase администратора. reviewCache может быть nil —
+// тогда сводки по отзывам не кэшируются и сбрасывать нечего.
+func NewAdminUsecase(r IAdminRepository, redisRepo *redis.SuggestionsRepository, repoProduct productRepo.IProductRepository, reviewCache review.IReviewSummaryCache) *AdminUsecase {
 	return &AdminUsecase{
 		repo:        r,
 		repoProduct: repoProduct,
 		redisRepo:   redisRepo,
+		reviewCache: reviewCache,
 	}
 }
 
@@ -122,3 +129,47 @@ func (u *AdminUsecase) UpdateUserRole(ctx context.Context, req dto.UpdateUserRol
 
 	return nil
 }
+
+func (u *AdminUsecase) GetPendingReviews(ctx context.Context, offset int) (dto.ModerationReviewsResponse, error) {
+	const op = "AdminUsecase.GetPendingReviews"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	reviews, err := u.repo.GetPendingReviews(ctx, offset)
+	if err != nil {
+		logger.WithError(err).Error("failed to get pending reviews")
+		return dto.ModerationReviewsResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dto.ConvertToModerationReviewsResponse(reviews), nil
+}
+
+func (u *AdminUsecase) UpdateReviewStatus(ctx context.Context, req dto.UpdateReviewStatusRequest) error {
+	const op = "AdminUsecase.UpdateReviewStatus"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("review_id", req.ReviewID)
+
+	var status models.ReviewStatus
+	switch req.Update {
+	case 0:
+		status = models.ReviewRejected
+	case 1:
+		status = models.ReviewPublished
+	default:
+		logger.Error("invalid update status value")
+		return errs.ErrParseRequestData
+	}
+
+	productID, err := u.repo.UpdateReviewStatus(ctx, req.ReviewID, status)
+	if err != nil {
+		logger.WithError(err).Error("failed to update review status")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Решение модератора меняет набор опубликованных отзывов товара
+	if u.reviewCache != nil {
+		if err = u.reviewCache.InvalidateSummary(ctx, productID); err != nil {
+			logger.WithError(err).Warn("invalidate review summary")
+		}
+	}
+
+	return nil
+}
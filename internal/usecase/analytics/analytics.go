@@ -0,0 +1,215 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultPeriodDays — длина периода, если продавец не указал его начало
+	defaultPeriodDays = 30
+	maxPeriodDays     = 366
+
+	defaultTopProducts = 10
+	maxTopProducts     = 50
+)
+
+//go:generate mockgen -source=analytics.go -destination=../../infrastructure/repository/postgres/mocks/analytics_repository_mock.go -package=mocks IAnalyticsRepository
+type IAnalyticsRepository interface {
+	RefreshRollup(ctx context.Context, lookbackDays int) (bool, error)
+	GetSalesStats(ctx context.Context, sellerID uuid.UUID, from, to time.Time, bucket models.AnalyticsBucket) ([]models.SalesStats, error)
+	GetTopProducts(ctx context.Context, sellerID uuid.UUID, from, to time.Time, limit int) ([]models.TopProduct, error)
+	GetStockOuts(ctx context.Context, sellerID uuid.UUID, from, to time.Time, offset int) ([]models.StockOutProduct, error)
+}
+
+type AnalyticsUsecase struct {
+	repo IAnalyticsRepository
+	conf *config.AnalyticsConfig
+}
+
+func NewAnalyticsUsecase(repo IAnalyticsRepository, conf *config.AnalyticsConfig) *AnalyticsUsecase {
+	return &AnalyticsUsecase{
+		repo: repo,
+		conf: conf,
+	}
+}
+
+// GetSummary возвращает итоги продавца за период и временной ряд с шагом
+// period.Bucket. Показатели берутся из дневных агрегатов, поэтому данные
+// отстают от заказов не больше чем на RollupInterval.
+func (u *AnalyticsUsecase) GetSummary(
+	ctx context.Context,
+	sellerID uuid.UUID,
+	period models.AnalyticsPeriod,
+) (dto.SellerAnalyticsDTO, error) {
+	const op = "AnalyticsUsecase.GetSummary"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("seller_id", sellerID)
+
+	period, err := resolvePeriod(period)
+	if err != nil {
+		return dto.SellerAnalyticsDTO{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	stats, err := u.repo.GetSalesStats(ctx, sellerID, period.From, period.To, period.Bucket)
+	if err != nil {
+		logger.WithError(err).Error("get sales stats")
+		return dto.SellerAnalyticsDTO{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	byPeriod := make(map[string]models.SalesStats, len(stats))
+	for _, s := range stats {
+		byPeriod[s.Period.Format(time.DateOnly)] = s
+	}
+
+	totals := models.SalesStats{Period: period.From}
+	series := make([]dto.AnalyticsPointDTO, 0)
+	for start := period.Bucket.Start(period.From); !start.After(period.To); start = period.Bucket.Next(start) {
+		s := byPeriod[start.Format(time.DateOnly)]
+		s.Period = start
+		series = append(series, dto.ConvertToAnalyticsPointDTO(s))
+
+		totals.Revenue = totals.Revenue.Add(s.Revenue)
+		totals.Units += s.Units
+		totals.Orders += s.Orders
+		totals.ReturnedUnits += s.ReturnedUnits
+		totals.BasketAdds += s.BasketAdds
+		totals.RatingSum += s.RatingSum
+		totals.RatingCount += s.RatingCount
+	}
+
+	return dto.SellerAnalyticsDTO{
+		From:   period.From.Format(time.DateOnly),
+		To:     period.To.Format(time.DateOnly),
+		Bucket: string(period.Bucket),
+		Totals: dto.ConvertToAnalyticsPointDTO(totals),
+		Series: series,
+	}, nil
+}
+
+// GetTopProducts возвращает самые продаваемые товары продавца за период по выручке
+func (u *AnalyticsUsecase) GetTopProducts(
+	ctx context.Context,
+	sellerID uuid.UUID,
+	period models.AnalyticsPeriod,
+	limit int,
+) ([]models.TopProduct, error) {
+	const op = "AnalyticsUsecase.GetTopProducts"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("seller_id", sellerID)
+
+	period, err := resolvePeriod(period)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	switch {
+	case limit <= 0:
+		limit = defaultTopProducts
+	case limit > maxTopProducts:
+		limit = maxTopProducts
+	}
+
+	products, err := u.repo.GetTopProducts(ctx, sellerID, period.From, period.To, limit)
+	if err != nil {
+		logger.WithError(err).Error("get top products")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return products, nil
+}
+
+// GetStockOuts возвращает товары продавца, которых не было в наличии за период
+func (u *AnalyticsUsecase) GetStockOuts(
+	ctx context.Context,
+	sellerID uuid.UUID,
+	period models.AnalyticsPeriod,
+	offset int,
+) ([]models.StockOutProduct, error) {
+	const op = "AnalyticsUsecase.GetStockOuts"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("seller_id", sellerID)
+
+	period, err := resolvePeriod(period)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	products, err := u.repo.GetStockOuts(ctx, sellerID, period.From, period.To, offset)
+	if err != nil {
+		logger.WithError(err).Error("get stock outs")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return products, nil
+}
+
+// RunRollup пересчитывает дневные показатели сразу и затем с периодом
+// RollupInterval до отмены контекста. Неположительный интервал отключает пересчёт.
+func (u *AnalyticsUsecase) RunRollup(ctx context.Context) {
+	const op = "AnalyticsUsecase.RunRollup"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	if u.conf.RollupInterval <= 0 {
+		logger.Warn("seller analytics rollup disabled")
+		return
+	}
+
+	ticker := time.NewTicker(u.conf.RollupInterval)
+	defer ticker.Stop()
+
+	for {
+		refreshed, err := u.repo.RefreshRollup(ctx, u.conf.LookbackDays)
+		switch {
+		case err != nil:
+			logger.WithError(err).Error("refresh seller analytics rollup")
+		case refreshed:
+			logger.Info("seller analytics rollup refreshed")
+		default:
+			logger.Debug("seller analytics rollup is being refreshed by another instance")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// resolvePeriod подставляет значения по умолчанию — последние 30 дней по
+// сегодняшний с шагом в день — и проверяет границы периода
+func resolvePeriod(period models.AnalyticsPeriod) (models.AnalyticsPeriod, error) {
+	if period.Bucket == "" {
+		period.Bucket = models.AnalyticsDay
+	}
+	if !period.Bucket.Valid() {
+		return period, errs.NewBusinessLogicError("bucket must be day, week or month")
+	}
+
+	if period.To.IsZero() {
+		period.To = time.Now().UTC()
+	}
+	period.To = models.AnalyticsDay.Start(period.To)
+	if period.From.IsZero() {
+		period.From = period.To.AddDate(0, 0, 1-defaultPeriodDays)
+	}
+	period.From = models.AnalyticsDay.Start(period.From)
+
+	if period.From.After(period.To) {
+		return period, errs.NewBusinessLogicError("period start must not be after its end")
+	}
+	if period.To.Sub(period.From) >= maxPeriodDays*24*time.Hour {
+		return period, errs.NewBusinessLogicError(fmt.Sprintf("period must not exceed %d days", maxPeriodDays))
+	}
+
+	return period, nil
+}
@@ -0,0 +1,202 @@
+package attribute
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+//go:generate mockgen -source=attribute.go -destination=../../infrastructure/repository/postgres/mocks/attribute_repository_mock.go -package=mocks IAttributeRepository
+type IAttributeRepository interface {
+	GetCategoryAttributes(ctx context.Context, categoryID uuid.UUID) ([]models.CategoryAttribute, error)
+	GetAttribute(ctx context.Context, id uuid.UUID) (*models.CategoryAttribute, error)
+	CreateAttribute(ctx context.Context, attribute *models.CategoryAttribute) error
+	UpdateAttribute(ctx context.Context, attribute *models.CategoryAttribute) error
+	DeleteAttribute(ctx context.Context, id uuid.UUID) error
+	GetUsedAttributeValues(ctx context.Context, id uuid.UUID) ([]string, error)
+	GetProductAttributes(ctx context.Context, productID uuid.UUID) ([]models.ProductSpecification, error)
+}
+
+type AttributeUsecase struct {
+	repo IAttributeRepository
+}
+
+func NewAttributeUsecase(repo IAttributeRepository) *AttributeUsecase {
+	return &AttributeUsecase{
+		repo: repo,
+	}
+}
+
+// GetCategoryAttributes возвращает схему категории вместе с характеристиками её предков
+func (u *AttributeUsecase) GetCategoryAttributes(ctx context.Context, categoryID uuid.UUID) (dto.CategoryAttributesResponse, error) {
+	const op = "AttributeUsecase.GetCategoryAttributes"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("category_id", categoryID)
+
+	attributes, err := u.repo.GetCategoryAttributes(ctx, categoryID)
+	if err != nil {
+		logger.WithError(err).Error("get category attributes")
+		return dto.CategoryAttributesResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dto.CategoryAttributesResponse{Attributes: attributes}, nil
+}
+
+func (u *AttributeUsecase) GetProductAttributes(ctx context.Context, productID uuid.UUID) (dto.ProductAttributesResponse, error) {
+	const op = "AttributeUsecase.GetProductAttributes"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("product_id", productID)
+
+	specifications, err := u.repo.GetProductAttributes(ctx, productID)
+	if err != nil {
+		logger.WithError(err).Error("get product attributes")
+		return dto.ProductAttributesResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dto.ConvertToProductAttributesResponse(specifications), nil
+}
+
+func (u *AttributeUsecase) CreateAttribute(ctx context.Context, categoryID uuid.UUID, req dto.CreateAttributeRequest) (*models.CategoryAttribute, error) {
+	const op = "AttributeUsecase.CreateAttribute"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("category_id", categoryID)
+
+	if !req.Type.Valid() {
+		return nil, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("unknown attribute type"))
+	}
+
+	attribute := &models.CategoryAttribute{
+		ID:         uuid.New(),
+		CategoryID: categoryID,
+		Name:       strings.TrimSpace(req.Name),
+		Type:       req.Type,
+		Unit:       trimUnit(req.Unit),
+		Options:    normalizeOptions(req.Options),
+		Required:   req.Required,
+		Filterable: req.Type != models.AttributeText,
+	}
+	if req.Filterable != nil {
+		attribute.Filterable = *req.Filterable
+	}
+	if err := validateAttribute(attribute); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Характеристика не должна дублировать унаследованную от предков
+	inherited, err := u.repo.GetCategoryAttributes(ctx, categoryID)
+	if err != nil {
+		logger.WithError(err).Error("get category attributes")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	for _, existing := range inherited {
+		if strings.EqualFold(existing.Name, attribute.Name) {
+			return nil, fmt.Errorf("%s: %w", op, errs.NewAlreadyExistsError("attribute with this name already exists in category"))
+		}
+	}
+
+	if err = u.repo.CreateAttribute(ctx, attribute); err != nil {
+		logger.WithError(err).Error("create attribute")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return attribute, nil
+}
+
+func (u *AttributeUsecase) UpdateAttribute(ctx context.Context, id uuid.UUID, req dto.UpdateAttributeRequest) (*models.CategoryAttribute, error) {
+	const op = "AttributeUsecase.UpdateAttribute"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("attribute_id", id)
+
+	attribute, err := u.repo.GetAttribute(ctx, id)
+	if err != nil {
+		logger.WithError(err).Error("get attribute")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	attribute.Name = strings.TrimSpace(req.Name)
+	attribute.Unit = trimUnit(req.Unit)
+	attribute.Options = normalizeOptions(req.Options)
+	attribute.Required = req.Required
+	attribute.Filterable = req.Filterable
+	attribute.Position = req.Position
+	if err = validateAttribute(attribute); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Вариант списка нельзя убрать, пока он указан у товаров
+	if attribute.Type == models.AttributeEnum {
+		used, err := u.repo.GetUsedAttributeValues(ctx, id)
+		if err != nil {
+			logger.WithError(err).Error("get used attribute values")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		for _, value := range used {
+			if !slices.Contains(attribute.Options, value) {
+				return nil, fmt.Errorf("%s: %w", op,
+					errs.NewBusinessLogicError(fmt.Sprintf("option %q is used by products", value)))
+			}
+		}
+	}
+
+	if err = u.repo.UpdateAttribute(ctx, attribute); err != nil {
+		logger.WithError(err).Error("update attribute")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return attribute, nil
+}
+
+func (u *AttributeUsecase) DeleteAttribute(ctx context.Context, id uuid.UUID) error {
+	const op = "AttributeUsecase.DeleteAttribute"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("attribute_id", id)
+
+	if err := u.repo.DeleteAttribute(ctx, id); err != nil {
+		logger.WithError(err).Error("delete attribute")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func validateAttribute(attribute *models.CategoryAttribute) error {
+	if attribute.Name == "" {
+		return errs.NewBusinessLogicError("attribute name is required")
+	}
+	if attribute.Position < 0 {
+		return errs.NewBusinessLogicError("position must not be negative")
+	}
+	if attribute.Type == models.AttributeEnum && len(attribute.Options) == 0 {
+		return errs.NewBusinessLogicError("enum attribute requires options")
+	}
+	if attribute.Type != models.AttributeEnum && len(attribute.Options) > 0 {
+		return errs.NewBusinessLogicError("options are allowed only for enum attributes")
+	}
+	if attribute.Type != models.AttributeNumber && attribute.Unit.Valid {
+		return errs.NewBusinessLogicError("unit is allowed only for number attributes")
+	}
+
+	return nil
+}
+
+// normalizeOptions убирает пустые и повторяющиеся варианты, сохраняя порядок
+func normalizeOptions(options []string) []string {
+	res := make([]string, 0, len(options))
+	for _, option := range options {
+		option = strings.TrimSpace(option)
+		if option != "" && !slices.Contains(res, option) {
+			res = append(res, option)
+		}
+	}
+	return res
+}
+
+func trimUnit(unit null.String) null.String {
+	if !unit.Valid || strings.TrimSpace(unit.String) == "" {
+		return null.String{}
+	}
+	return null.StringFrom(strings.TrimSpace(unit.String))
+}
@@ -2,31 +2,49 @@ package basket
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/helpers"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/promo"
 	"github.com/google/uuid"
 )
 
 //go:generate mockgen -source=basket.go -destination=../../infrastructure/repository/postgres/mocks/basket_repository_mock.go -package=mocks IBasketRepository
 type IBasketRepository interface{
 	Get(ctx context.Context, userID uuid.UUID) ([]*models.BasketItem, error)
-	Add(ctx context.Context, userID uuid.UUID, productID uuid.UUID) (*models.BasketItem, error)
-	Delete(ctx context.Context, userID uuid.UUID, productID uuid.UUID) error
-	UpdateQuantity(ctx context.Context, userID uuid.UUID, productID uuid.UUID, quantity int) (*models.BasketItem, error)
+	Add(ctx context.Context, userID uuid.UUID, productID uuid.UUID, variantID uuid.NullUUID) (*models.BasketItem, error)
+	Delete(ctx context.Context, userID uuid.UUID, productID uuid.UUID, variantID uuid.NullUUID) error
+	UpdateQuantity(ctx context.Context, userID uuid.UUID, productID uuid.UUID, variantID uuid.NullUUID, quantity int) (*models.BasketItem, error)
 	Clear(ctx context.Context, userID uuid.UUID) error
+	UpdateTotals(ctx context.Context, userID uuid.UUID, totalPrice, totalPriceDiscount models.Money) error
+}
+
+// IBasketMergeRepository — корзина пользователя, в которую переносится гостевая
+type IBasketMergeRepository interface {
+	IBasketRepository
+	Merge(ctx context.Context, userID uuid.UUID, items []models.PricingItem) error
+}
+
+// IPricingEngine считает стоимость товаров; реализован в pricing.Engine
+type IPricingEngine interface {
+	Price(ctx context.Context, items []models.PricingItem) (*models.Quote, error)
+	ApplyPromo(ctx context.Context, userID uuid.UUID, code string, quote *models.Quote) error
 }
 
 type BasketUsecase struct {
-	repo IBasketRepository
+	repo    IBasketRepository
+	pricing IPricingEngine
 }
 
-func NewBasketUsecase(repo IBasketRepository) *BasketUsecase {
+func NewBasketUsecase(repo IBasketRepository, pricing IPricingEngine) *BasketUsecase {
 	return &BasketUsecase{
-		repo: repo,
+		repo:    repo,
+		pricing: pricing,
 	}
 }
 
@@ -51,7 +69,8 @@ func (u *BasketUsecase)Get(ctx context.Context)([]*models.BasketItem, error){
 }
 
 
-func (u *BasketUsecase)Add(ctx context.Context, productID uuid.UUID)(*models.BasketItem, error){
+// Add кладёт товар в корзину; товар с вариантами кладётся конкретным SKU
+func (u *BasketUsecase)Add(ctx context.Context, productID uuid.UUID, variantID uuid.NullUUID)(*models.BasketItem, error){
 	const op = "BasketUsecase.Add"
     logger := logctx.GetLogger(ctx).WithField("op", op)
 
@@ -68,16 +87,18 @@ func (u *BasketUsecase)Add(ctx context.Context, productID uuid.UUID)(*models.Bas
 
 	logger.WithField("user_id", userID).WithField("product_id", productID)
 
-	item, err := u.repo.Add(ctx, userID, productID)
+	item, err := u.repo.Add(ctx, userID, productID, variantID)
     if err != nil {
         logger.WithError(err).Error("add product to basket")
         return nil, fmt.Errorf("%s: %w", op, err)
     }
 
+	syncTotals(ctx, u.repo, u.pricing, userID)
+
 	return item, nil
 }
 
-func (u *BasketUsecase)Delete(ctx context.Context, productID uuid.UUID)(error){
+func (u *BasketUsecase)Delete(ctx context.Context, productID uuid.UUID, variantID uuid.NullUUID)(error){
 	const op = "BasketUsecase.Delete"
     logger := logctx.GetLogger(ctx).WithField("op", op)
 
@@ -89,16 +110,18 @@ func (u *BasketUsecase)Delete(ctx context.Context, productID uuid.UUID)(error){
 
 	logger.WithField("user_id", userID).WithField("product_id", productID)
 
-	err = u.repo.Delete(ctx, userID, productID)
+	err = u.repo.Delete(ctx, userID, productID, variantID)
 	if err != nil {
         logger.WithError(err).Error("delete product from basket")
         return fmt.Errorf("%s: %w", op, err)
     }
 
+	syncTotals(ctx, u.repo, u.pricing, userID)
+
 	return nil
 }
 
-func (u *BasketUsecase)UpdateQuantity(ctx context.Context, productID uuid.UUID, quantity int)(*models.BasketItem, error){
+func (u *BasketUsecase)UpdateQuantity(ctx context.Context, productID uuid.UUID, variantID uuid.NullUUID, quantity int)(*models.BasketItem, error){
 	const op = "BasketUsecase.UpdateQuantity"
     logger := logctx.GetLogger(ctx).WithField("op", op)
 
@@ -120,12 +143,14 @@ func (u *BasketUsecase)UpdateQuantity(ctx context.Context, productID uuid.UUID,
 
 	logger.WithField("user_id", userID).WithField("product_id", productID)
 
-	item, err := u.repo.UpdateQuantity(ctx, userID, productID, quantity)
+	item, err := u.repo.UpdateQuantity(ctx, userID, productID, variantID, quantity)
     if err != nil {
         logger.WithError(err).Error("update product quantity")
         return nil, fmt.Errorf("%s: %w", op, err)
     }
 
+	syncTotals(ctx, u.repo, u.pricing, userID)
+
 	return item, nil
 }
 
@@ -146,5 +171,95 @@ func (u *BasketUsecase)Clear(ctx context.Context,)(error){
         return fmt.Errorf("%s: %w", op, err)
     }
 
+	if err = u.repo.UpdateTotals(ctx, userID, models.Money{}, models.Money{}); err != nil {
+		logger.WithError(err).Warn("reset basket totals")
+	}
+
 	return nil
-}
\ No newline at end of file
+}
+
+// Quote считает корзину так же, как её посчитает оформление заказа:
+// скидки на товары, промокод и доставка. Отказ по промокоду не считается
+// ошибкой и возвращается в ответе.
+func (u *BasketUsecase) Quote(ctx context.Context, promoCode string) (dto.BasketQuoteResponse, error) {
+	const op = "BasketUsecase.Quote"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	userID, err := helpers.GetUserIDFromContext(ctx)
+	if err != nil {
+		logger.WithError(err).Error("get user ID from context")
+		return dto.BasketQuoteResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger = logger.WithField("user_id", userID)
+	items, err := u.repo.Get(ctx, userID)
+	if err != nil {
+		logger.WithError(err).Error("get basket items from repo")
+		return dto.BasketQuoteResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	quote, err := u.pricing.Price(ctx, pricingItems(items))
+	if err != nil {
+		logger.WithError(err).Error("price basket")
+		return dto.BasketQuoteResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var promoResp *dto.QuotePromoResponse
+	if promoCode != "" {
+		promoResp = &dto.QuotePromoResponse{Code: promoCode}
+
+		err = u.pricing.ApplyPromo(ctx, userID, promoCode, quote)
+		var rejected *promo.RejectedError
+		switch {
+		case err == nil:
+			promoResp.Applied = true
+			promoResp.Discount = quote.PromoDiscount
+		case errors.As(err, &rejected):
+			promoResp.Reason = string(rejected.Reason)
+			promoResp.Message = rejected.Message()
+		default:
+			logger.WithError(err).Error("apply promo code")
+			return dto.BasketQuoteResponse{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	resp := dto.ConvertToBasketQuoteResponse(quote, items)
+	resp.Promo = promoResp
+
+	return resp, nil
+}
+
+// syncTotals пересчитывает сохранённые суммы корзины после её изменения.
+// Ошибка не прерывает операцию: суммы пересчитаются при следующем изменении.
+func syncTotals(ctx context.Context, repo IBasketRepository, pricing IPricingEngine, userID uuid.UUID) {
+	const op = "BasketUsecase.syncTotals"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("user_id", userID)
+
+	items, err := repo.Get(ctx, userID)
+	if err != nil {
+		logger.WithError(err).Warn("get basket items")
+		return
+	}
+
+	quote, err := pricing.Price(ctx, pricingItems(items))
+	if err != nil {
+		logger.WithError(err).Warn("price basket")
+		return
+	}
+
+	if err = repo.UpdateTotals(ctx, userID, quote.Subtotal, quote.GoodsTotal()); err != nil {
+		logger.WithError(err).Warn("update basket totals")
+	}
+}
+
+func pricingItems(items []*models.BasketItem) []models.PricingItem {
+	result := make([]models.PricingItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, models.PricingItem{
+			ProductID: item.ProductID,
+			VariantID: item.VariantID,
+			Quantity:  uint(item.Quantity),
+		})
+	}
+	return result
+}
@@ -0,0 +1,56 @@
+package basket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/google/uuid"
+)
+
+// GuestBasketMerger переносит гостевую корзину в корзину пользователя после входа
+type GuestBasketMerger struct {
+	guestRepo IBasketRepository
+	repo      IBasketMergeRepository
+	pricing   IPricingEngine
+}
+
+func NewGuestBasketMerger(guestRepo IBasketRepository, repo IBasketMergeRepository, pricing IPricingEngine) *GuestBasketMerger {
+	return &GuestBasketMerger{
+		guestRepo: guestRepo,
+		repo:      repo,
+		pricing:   pricing,
+	}
+}
+
+// MergeGuest складывает позиции гостевой корзины с корзиной пользователя
+// (количество ограничивается остатком) и очищает гостевую корзину
+func (m *GuestBasketMerger) MergeGuest(ctx context.Context, guestID, userID uuid.UUID) error {
+	const op = "GuestBasketMerger.MergeGuest"
+	logger := logctx.GetLogger(ctx).WithField("op", op).
+		WithField("guest_id", guestID).
+		WithField("user_id", userID)
+
+	items, err := m.guestRepo.Get(ctx, guestID)
+	if err != nil {
+		logger.WithError(err).Error("get guest basket")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	if err = m.repo.Merge(ctx, userID, pricingItems(items)); err != nil {
+		logger.WithError(err).Error("merge guest basket")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = m.guestRepo.Clear(ctx, guestID); err != nil {
+		logger.WithError(err).Warn("clear guest basket")
+	}
+
+	syncTotals(ctx, m.repo, m.pricing, userID)
+
+	return nil
+}
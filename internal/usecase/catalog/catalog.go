@@ -0,0 +1,412 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/minio"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/seller"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=catalog.go -destination=../../infrastructure/repository/postgres/mocks/catalog_repository_mock.go -package=mocks ICatalogRepository
+type ICatalogRepository interface {
+	CreateImport(ctx context.Context, job *models.CatalogImport) error
+	GetImport(ctx context.Context, id, sellerID uuid.UUID) (*models.CatalogImport, error)
+	ClaimImport(ctx context.Context, staleAfter time.Duration) (*models.CatalogImport, error)
+	FinishImport(ctx context.Context, job *models.CatalogImport, rowErrors []models.ImportRowError) error
+	GetImportErrors(ctx context.Context, id uuid.UUID) ([]models.ImportRowError, error)
+	FindCategories(ctx context.Context, names []string) (map[string][]uuid.UUID, error)
+	GetProductCategories(ctx context.Context, sellerID uuid.UUID, skus []string) (map[string]uuid.UUID, error)
+	UpsertProduct(ctx context.Context, sellerID uuid.UUID, row models.CatalogRow, categoryID uuid.UUID) (bool, error)
+	ExportProducts(ctx context.Context, sellerID uuid.UUID) ([]models.CatalogRow, error)
+}
+
+// numericColumns — колонки выгрузки, которые в XLSX записываются числами
+var numericColumns = map[int]bool{4: true, 5: true}
+
+type CatalogUsecase struct {
+	repo       ICatalogRepository
+	attributes seller.IAttributeSchemaRepository
+	storage    minio.Provider
+	conf       *config.CatalogImportConfig
+}
+
+func NewCatalogUsecase(
+	repo ICatalogRepository,
+	attributes seller.IAttributeSchemaRepository,
+	storage minio.Provider,
+	conf *config.CatalogImportConfig,
+) *CatalogUsecase {
+	return &CatalogUsecase{
+		repo:       repo,
+		attributes: attributes,
+		storage:    storage,
+		conf:       conf,
+	}
+}
+
+// StartImport сохраняет файл каталога в хранилище и ставит его в очередь
+// на обработку. Формат файла определяется по расширению.
+func (u *CatalogUsecase) StartImport(
+	ctx context.Context,
+	sellerID uuid.UUID,
+	fileName string,
+	data []byte,
+) (*models.CatalogImport, error) {
+	const op = "CatalogUsecase.StartImport"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("seller_id", sellerID)
+
+	format, err := models.CatalogFormatFromFileName(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("file must be .csv or .xlsx"))
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("file is empty"))
+	}
+	if int64(len(data)) > u.conf.MaxFileSize {
+		return nil, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError(
+			fmt.Sprintf("file is larger than %d bytes", u.conf.MaxFileSize)))
+	}
+
+	uploaded, err := u.storage.CreateOne(ctx, minio.FileData{
+		Name:        fileName,
+		Data:        data,
+		ContentType: format.ContentType(),
+	})
+	if err != nil {
+		logger.WithError(err).Error("upload catalog file")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	job := &models.CatalogImport{
+		ID:       uuid.New(),
+		SellerID: sellerID,
+		Format:   format,
+		FileName: fileName,
+		FileKey:  uploaded.ObjectID,
+		Status:   models.ImportPending,
+	}
+	if err = u.repo.CreateImport(ctx, job); err != nil {
+		logger.WithError(err).Error("create import job")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return job, nil
+}
+
+// GetImport возвращает состояние задачи импорта продавца
+func (u *CatalogUsecase) GetImport(ctx context.Context, sellerID, id uuid.UUID) (*models.CatalogImport, error) {
+	const op = "CatalogUsecase.GetImport"
+
+	job, err := u.repo.GetImport(ctx, id, sellerID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return job, nil
+}
+
+// GetImportReport собирает отчёт об отклонённых строках в формате исходного файла
+func (u *CatalogUsecase) GetImportReport(
+	ctx context.Context,
+	sellerID, id uuid.UUID,
+) ([]byte, models.CatalogFormat, error) {
+	const op = "CatalogUsecase.GetImportReport"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("import_id", id)
+
+	job, err := u.repo.GetImport(ctx, id, sellerID)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+	if !job.Finished() {
+		return nil, "", fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("import is not finished yet"))
+	}
+
+	rowErrors, err := u.repo.GetImportErrors(ctx, id)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	report, err := writeTable(job.Format, "errors", reportTable(rowErrors), map[int]bool{0: true})
+	if err != nil {
+		logger.WithError(err).Error("write import report")
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	return report, job.Format, nil
+}
+
+// Export выгружает каталог продавца в формате, пригодном для повторного импорта
+func (u *CatalogUsecase) Export(ctx context.Context, sellerID uuid.UUID, format models.CatalogFormat) ([]byte, error) {
+	const op = "CatalogUsecase.Export"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("seller_id", sellerID)
+
+	rows, err := u.repo.ExportProducts(ctx, sellerID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	data, err := writeTable(format, "catalog", catalogTable(rows), numericColumns)
+	if err != nil {
+		logger.WithError(err).Error("write catalog")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return data, nil
+}
+
+// RunWorker обрабатывает очередь импорта с периодом PollInterval до отмены
+// контекста. Неположительный интервал отключает обработку.
+func (u *CatalogUsecase) RunWorker(ctx context.Context) {
+	const op = "CatalogUsecase.RunWorker"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	if u.conf.PollInterval <= 0 {
+		logger.Warn("catalog import worker disabled")
+		return
+	}
+
+	ticker := time.NewTicker(u.conf.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for ctx.Err() == nil {
+			processed, err := u.ProcessNext(ctx)
+			if err != nil {
+				logger.WithError(err).Error("process catalog import")
+			}
+			if !processed || err != nil {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessNext обрабатывает одну задачу из очереди и сообщает, нашлась ли она
+func (u *CatalogUsecase) ProcessNext(ctx context.Context) (bool, error) {
+	const op = "CatalogUsecase.ProcessNext"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	job, err := u.repo.ClaimImport(ctx, u.conf.StaleAfter)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	logger = logger.WithField("import_id", job.ID)
+
+	data, err := u.storage.GetOne(ctx, job.FileKey)
+	if err != nil {
+		// Задача останется в processing и будет забрана повторно через StaleAfter
+		logger.WithError(err).Error("download catalog file")
+		return true, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowErrors, err := u.importFileRecovered(ctx, job, data)
+	if err != nil {
+		return true, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = u.repo.FinishImport(ctx, job, rowErrors); err != nil {
+		logger.WithError(err).Error("finish import job")
+		return true, fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.WithFields(map[string]interface{}{
+		"status":  job.Status,
+		"created": job.CreatedRows,
+		"updated": job.UpdatedRows,
+		"failed":  job.FailedRows,
+	}).Info("catalog import finished")
+
+	return true, nil
+}
+
+// importFileRecovered вызывает importFile и переводит панику при обработке файла
+// в отказ задачи: обработчик очереди запущен отдельной горутиной, и паника
+// на одном файле остановила бы весь сервис
+func (u *CatalogUsecase) importFileRecovered(
+	ctx context.Context,
+	job *models.CatalogImport,
+	data []byte,
+) (rowErrors []models.ImportRowError, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			logctx.GetLogger(ctx).WithField("import_id", job.ID).WithField("panic", p).Error("catalog import panicked")
+			job.Status, job.Error = models.ImportFailed, "file cannot be processed"
+			rowErrors, err = nil, nil
+		}
+	}()
+
+	return u.importFile(ctx, job, data)
+}
+
+// importFile загружает строки файла в каталог и заполняет итоги задачи.
+// Возвращаемая ошибка означает сбой базы: задача будет обработана заново.
+func (u *CatalogUsecase) importFile(
+	ctx context.Context,
+	job *models.CatalogImport,
+	data []byte,
+) ([]models.ImportRowError, error) {
+	job.Status = models.ImportDone
+	job.TotalRows, job.CreatedRows, job.UpdatedRows, job.FailedRows = 0, 0, 0, 0
+	job.Error = ""
+
+	table, err := readTable(job.Format, data, u.conf.MaxRows)
+	if err != nil {
+		job.Status, job.Error = models.ImportFailed, "file cannot be read"
+		return nil, nil
+	}
+	lines, err := parseCatalogTable(table, u.conf.MaxRows)
+	if err != nil {
+		job.Status, job.Error = models.ImportFailed, err.Error()
+		return nil, nil
+	}
+	job.TotalRows = len(lines)
+
+	validator, err := u.newRowValidator(ctx, job.SellerID, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	var rowErrors []models.ImportRowError
+	reject := func(line catalogLine, message string) {
+		rowErrors = append(rowErrors, models.ImportRowError{Row: line.Row, SKU: line.SKU, Message: message})
+		job.FailedRows++
+	}
+
+	for _, line := range lines {
+		categoryID, message, err := validator.validate(ctx, line)
+		if err != nil {
+			return nil, err
+		}
+		if message != "" {
+			reject(line, message)
+			continue
+		}
+
+		created, err := u.repo.UpsertProduct(ctx, job.SellerID, line.CatalogRow, categoryID)
+		if err != nil {
+			// Сбой одной строки не должен останавливать весь файл
+			logctx.GetLogger(ctx).WithError(err).WithField("sku", line.SKU).Error("save catalog row")
+			reject(line, "product cannot be saved")
+			continue
+		}
+		if created {
+			job.CreatedRows++
+		} else {
+			job.UpdatedRows++
+		}
+	}
+
+	return rowErrors, nil
+}
+
+// rowValidator проверяет строки файла против текущего каталога продавца
+type rowValidator struct {
+	usecase    *CatalogUsecase
+	categories map[string][]uuid.UUID
+	existing   map[string]uuid.UUID
+	schemas    map[uuid.UUID][]models.CategoryAttribute
+	seen       map[string]int
+}
+
+func (u *CatalogUsecase) newRowValidator(
+	ctx context.Context,
+	sellerID uuid.UUID,
+	lines []catalogLine,
+) (*rowValidator, error) {
+	names := make([]string, 0, len(lines))
+	skus := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if line.Category != "" {
+			names = append(names, strings.ToLower(line.Category))
+		}
+		if line.SKU != "" {
+			skus = append(skus, line.SKU)
+		}
+	}
+
+	categories, err := u.repo.FindCategories(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := u.repo.GetProductCategories(ctx, sellerID, skus)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rowValidator{
+		usecase:    u,
+		categories: categories,
+		existing:   existing,
+		schemas:    make(map[uuid.UUID][]models.CategoryAttribute),
+		seen:       make(map[string]int, len(lines)),
+	}, nil
+}
+
+// validate возвращает подкатегорию товара или причину отказа для строки
+func (v *rowValidator) validate(ctx context.Context, line catalogLine) (uuid.UUID, string, error) {
+	if line.SKU == "" {
+		return uuid.Nil, "sku is required", nil
+	}
+	if row, ok := v.seen[line.SKU]; ok {
+		return uuid.Nil, fmt.Sprintf("sku is already used in row %d", row), nil
+	}
+	v.seen[line.SKU] = line.Row
+
+	if line.Err != "" {
+		return uuid.Nil, line.Err, nil
+	}
+	if line.Name == "" {
+		return uuid.Nil, errs.ErrEmptyProductName.Error(), nil
+	}
+
+	matches := v.categories[strings.ToLower(line.Category)]
+	switch {
+	case line.Category == "":
+		return uuid.Nil, "category is required", nil
+	case len(matches) == 0:
+		return uuid.Nil, fmt.Sprintf("category %q not found", line.Category), nil
+	case len(matches) > 1:
+		return uuid.Nil, fmt.Sprintf("category name %q is ambiguous", line.Category), nil
+	}
+	categoryID := matches[0]
+
+	// Характеристики через файл не задаются: у существующего товара в той же
+	// категории они сохраняются, а новому товару обязательные значения взять неоткуда
+	if current, ok := v.existing[line.SKU]; ok && current == categoryID {
+		return categoryID, "", nil
+	}
+
+	schema, ok := v.schemas[categoryID]
+	if !ok {
+		var err error
+		if schema, err = v.usecase.attributes.GetCategoryAttributes(ctx, categoryID); err != nil {
+			return uuid.Nil, "", err
+		}
+		v.schemas[categoryID] = schema
+	}
+	for _, attribute := range schema {
+		if attribute.Required {
+			return uuid.Nil, fmt.Sprintf("category %q requires attribute %q; add the product manually", line.Category, attribute.Name), nil
+		}
+	}
+
+	return categoryID, "", nil
+}
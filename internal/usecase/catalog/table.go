@@ -0,0 +1,218 @@
+package catalog
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+)
+
+const (
+	columnSKU         = "sku"
+	columnName        = "name"
+	columnDescription = "description"
+	columnCategory    = "category"
+	columnPrice       = "price"
+	columnQuantity    = "quantity"
+)
+
+// catalogHeader — порядок колонок в выгрузке и в шаблоне для импорта
+var catalogHeader = []string{columnSKU, columnName, columnDescription, columnCategory, columnPrice, columnQuantity}
+
+// requiredColumns — колонки, без которых файл не принимается; описание можно опустить
+var requiredColumns = []string{columnSKU, columnName, columnCategory, columnPrice, columnQuantity}
+
+var reportHeader = []string{"row", "sku", "message"}
+
+// catalogLine — строка файла после разбора. Err заполняется, если значения
+// строки не удалось разобрать, и тогда строка в каталог не попадает.
+type catalogLine struct {
+	models.CatalogRow
+	Err string
+}
+
+// readTable читает файл в указанном формате в таблицу строк
+func readTable(format models.CatalogFormat, data []byte, maxRows int) ([][]string, error) {
+	if format == models.CatalogXLSX {
+		return readXLSX(data, maxRows)
+	}
+	return readCSV(data)
+}
+
+// readCSV читает CSV с разделителем "," или ";" — второй по умолчанию
+// сохраняет Excel с русской локалью
+func readCSV(data []byte) ([][]string, error) {
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	firstLine, _, _ := bytes.Cut(data, []byte("\n"))
+	if bytes.Count(firstLine, []byte(";")) > bytes.Count(firstLine, []byte(",")) {
+		reader.Comma = ';'
+	}
+
+	var table [][]string
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return table, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read csv: %w", err)
+		}
+
+		// Пустые строки reader пропускает; пропуски восстанавливаются,
+		// чтобы номера строк в отчёте совпадали с файлом
+		line, _ := reader.FieldPos(0)
+		for len(table) < line-1 {
+			table = append(table, nil)
+		}
+		table = append(table, record)
+	}
+}
+
+// writeTable записывает таблицу в указанном формате. Колонки из numeric
+// в XLSX записываются числами.
+func writeTable(format models.CatalogFormat, sheetName string, table [][]string, numeric map[int]bool) ([]byte, error) {
+	if format == models.CatalogXLSX {
+		return writeXLSX(sheetName, table, numeric)
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.WriteAll(table); err != nil {
+		return nil, fmt.Errorf("write csv: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// parseCatalogTable разбирает таблицу каталога. Ошибка возвращается, если
+// файл нельзя обработать целиком; ошибки значений отдельных строк
+// записываются в сами строки.
+func parseCatalogTable(table [][]string, maxRows int) ([]catalogLine, error) {
+	if len(table) == 0 {
+		return nil, errors.New("file is empty")
+	}
+
+	columns := make(map[string]int, len(table[0]))
+	for i, title := range table[0] {
+		title = strings.ToLower(strings.TrimSpace(title))
+		if _, ok := columns[title]; title != "" && !ok {
+			columns[title] = i
+		}
+	}
+	var missing []string
+	for _, column := range requiredColumns {
+		if _, ok := columns[column]; !ok {
+			missing = append(missing, column)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing columns: %s", strings.Join(missing, ", "))
+	}
+
+	value := func(record []string, column string) string {
+		i, ok := columns[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	lines := make([]catalogLine, 0, len(table)-1)
+	for i, record := range table[1:] {
+		if isBlank(record) {
+			continue
+		}
+		if len(lines) == maxRows {
+			return nil, fmt.Errorf("file has more than %d products", maxRows)
+		}
+
+		line := catalogLine{CatalogRow: models.CatalogRow{
+			Row:         i + 2,
+			SKU:         value(record, columnSKU),
+			Name:        value(record, columnName),
+			Description: value(record, columnDescription),
+			Category:    value(record, columnCategory),
+		}}
+
+		price, err := parsePrice(value(record, columnPrice))
+		if err != nil || !price.IsPositive() {
+			line.Err = errs.ErrInvalidProductPrice.Error()
+		}
+		line.Price = price
+
+		quantity, err := parseNumber(value(record, columnQuantity))
+		if line.Err == "" && (err != nil || quantity < 0 || quantity != math.Trunc(quantity) || quantity > math.MaxUint32) {
+			line.Err = errs.ErrInvalidProductQuantity.Error()
+		}
+		if line.Err == "" {
+			line.Quantity = uint(quantity)
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines, nil
+}
+
+// parseNumber принимает и десятичную запятую
+func parseNumber(value string) (float64, error) {
+	value = strings.ReplaceAll(strings.ReplaceAll(value, " ", ""), ",", ".")
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+		return 0, fmt.Errorf("invalid number %q", value)
+	}
+	return number, nil
+}
+
+// parsePrice разбирает цену точно, без округления через float64
+func parsePrice(value string) (models.Money, error) {
+	value = strings.ReplaceAll(strings.ReplaceAll(value, " ", ""), ",", ".")
+	return models.ParseMoney(value)
+}
+
+func isBlank(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// catalogTable собирает таблицу выгрузки каталога
+func catalogTable(rows []models.CatalogRow) [][]string {
+	table := make([][]string, 0, len(rows)+1)
+	table = append(table, catalogHeader)
+	for _, row := range rows {
+		table = append(table, []string{
+			row.SKU,
+			row.Name,
+			row.Description,
+			row.Category,
+			row.Price.String(),
+			strconv.FormatUint(uint64(row.Quantity), 10),
+		})
+	}
+	return table
+}
+
+// reportTable собирает таблицу отчёта об ошибках импорта
+func reportTable(rowErrors []models.ImportRowError) [][]string {
+	table := make([][]string, 0, len(rowErrors)+1)
+	table = append(table, reportHeader)
+	for _, rowError := range rowErrors {
+		table = append(table, []string{strconv.Itoa(rowError.Row), rowError.SKU, rowError.Message})
+	}
+	return table
+}
@@ -0,0 +1,317 @@
+package catalog
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// Минимальная поддержка XLSX без сторонних библиотек: читается первый лист
+// книги, записывается книга из одного листа. Стили, формулы и объединённые
+// ячейки не поддерживаются — для каталога они не нужны.
+
+const (
+	xlsxWorkbook = "xl/workbook.xml"
+	xlsxRels     = "xl/_rels/workbook.xml.rels"
+	xlsxStrings  = "xl/sharedStrings.xml"
+)
+
+// Пределы формата XLSX и распакованного размера одной части архива: адреса и
+// сжатые данные берутся из файла пользователя, поэтому им нельзя доверять
+const (
+	xlsxMaxRows     = 1048576
+	xlsxMaxColumns  = 16384
+	xlsxMaxPartSize = 64 << 20
+)
+
+type xlsxWorkbookXML struct {
+	Sheets []struct {
+		RelID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelsXML struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+// xlsxText — текст ячейки или общей строки: простой <t> либо набор форматированных фрагментов <r><t>
+type xlsxText struct {
+	Text string   `xml:"t"`
+	Runs []string `xml:"r>t"`
+}
+
+func (t xlsxText) String() string {
+	if len(t.Runs) == 0 {
+		return t.Text
+	}
+	return t.Text + strings.Join(t.Runs, "")
+}
+
+type xlsxStringsXML struct {
+	Items []xlsxText `xml:"si"`
+}
+
+type xlsxSheetXML struct {
+	Rows []struct {
+		Number int `xml:"r,attr"`
+		Cells  []struct {
+			Ref    string   `xml:"r,attr"`
+			Type   string   `xml:"t,attr"`
+			Value  string   `xml:"v"`
+			Inline xlsxText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// readXLSX возвращает значения ячеек первого листа книги; строки дальше
+// maxRows строк данных (не считая заголовка) отклоняются до выделения памяти
+func readXLSX(data []byte, maxRows int) ([][]string, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("open xlsx: %w", err)
+	}
+
+	files := make(map[string]*zip.File, len(archive.File))
+	for _, file := range archive.File {
+		files[file.Name] = file
+	}
+
+	sheetPath, err := firstSheetPath(files)
+	if err != nil {
+		return nil, err
+	}
+
+	var shared xlsxStringsXML
+	if file, ok := files[xlsxStrings]; ok {
+		if err = decodeZipXML(file, &shared); err != nil {
+			return nil, err
+		}
+	}
+
+	file, ok := files[sheetPath]
+	if !ok {
+		return nil, fmt.Errorf("xlsx sheet %s not found", sheetPath)
+	}
+	var sheet xlsxSheetXML
+	if err = decodeZipXML(file, &sheet); err != nil {
+		return nil, err
+	}
+
+	rowLimit := xlsxMaxRows
+	if maxRows > 0 && maxRows < xlsxMaxRows {
+		rowLimit = maxRows + 1
+	}
+
+	table := make([][]string, 0, len(sheet.Rows))
+	for _, row := range sheet.Rows {
+		if row.Number > rowLimit || len(table) >= rowLimit {
+			return nil, fmt.Errorf("xlsx sheet has more than %d rows", rowLimit)
+		}
+		// Пустые строки в листе не хранятся; пропуски восстанавливаются,
+		// чтобы номера строк в отчёте совпадали с файлом
+		for len(table) < row.Number-1 {
+			table = append(table, nil)
+		}
+
+		var values []string
+		for i, cell := range row.Cells {
+			column := i
+			if column >= xlsxMaxColumns {
+				return nil, fmt.Errorf("xlsx row %d has more than %d columns", row.Number, xlsxMaxColumns)
+			}
+			if cell.Ref != "" {
+				if column, err = columnIndex(cell.Ref); err != nil {
+					return nil, err
+				}
+			}
+			for len(values) <= column {
+				values = append(values, "")
+			}
+
+			switch cell.Type {
+			case "s":
+				index, err := strconv.Atoi(cell.Value)
+				if err != nil || index < 0 || index >= len(shared.Items) {
+					return nil, fmt.Errorf("xlsx cell %s: invalid shared string %q", cell.Ref, cell.Value)
+				}
+				values[column] = shared.Items[index].String()
+			case "inlineStr":
+				values[column] = cell.Inline.String()
+			default:
+				values[column] = cell.Value
+			}
+		}
+		table = append(table, values)
+	}
+
+	return table, nil
+}
+
+func firstSheetPath(files map[string]*zip.File) (string, error) {
+	workbookFile, ok := files[xlsxWorkbook]
+	if !ok {
+		return "", errors.New("xlsx workbook not found")
+	}
+	var workbook xlsxWorkbookXML
+	if err := decodeZipXML(workbookFile, &workbook); err != nil {
+		return "", err
+	}
+	if len(workbook.Sheets) == 0 {
+		return "", errors.New("xlsx workbook has no sheets")
+	}
+
+	relsFile, ok := files[xlsxRels]
+	if !ok {
+		return "", errors.New("xlsx workbook relationships not found")
+	}
+	var rels xlsxRelsXML
+	if err := decodeZipXML(relsFile, &rels); err != nil {
+		return "", err
+	}
+
+	for _, rel := range rels.Relationships {
+		if rel.ID != workbook.Sheets[0].RelID {
+			continue
+		}
+		// Путь задаётся либо от корня архива, либо относительно каталога xl/
+		if strings.HasPrefix(rel.Target, "/") {
+			return strings.TrimPrefix(rel.Target, "/"), nil
+		}
+		return path.Join("xl", rel.Target), nil
+	}
+
+	return "", errors.New("xlsx sheet relationship not found")
+}
+
+func decodeZipXML(file *zip.File, v interface{}) error {
+	reader, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("open %s: %w", file.Name, err)
+	}
+	defer reader.Close()
+
+	limited := io.LimitReader(reader, xlsxMaxPartSize)
+	if err = xml.NewDecoder(limited).Decode(v); err != nil {
+		return fmt.Errorf("decode %s: %w", file.Name, err)
+	}
+	return nil
+}
+
+// columnIndex переводит адрес ячейки вида "AB12" в номер колонки с нуля
+func columnIndex(ref string) (int, error) {
+	column := 0
+	letters := 0
+	for _, ch := range ref {
+		if ch < 'A' || ch > 'Z' {
+			break
+		}
+		column = column*26 + int(ch-'A'+1)
+		letters++
+		if column > xlsxMaxColumns {
+			return 0, fmt.Errorf("xlsx cell reference %q is out of range", ref)
+		}
+	}
+	if letters == 0 {
+		return 0, fmt.Errorf("invalid xlsx cell reference %q", ref)
+	}
+	return column - 1, nil
+}
+
+// columnLetters переводит номер колонки с нуля в буквенное обозначение
+func columnLetters(index int) string {
+	name := ""
+	for index++; index > 0; index = (index - 1) / 26 {
+		name = string(rune('A'+(index-1)%26)) + name
+	}
+	return name
+}
+
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`
+
+	xlsxRootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`
+
+	xlsxWorkbookContent = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets>` +
+		`</workbook>`
+
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`
+)
+
+// writeXLSX собирает книгу из одного листа. Колонки из numeric записываются
+// числами, остальные — строками, чтобы артикулы вроде "000123" не теряли нули.
+func writeXLSX(sheetName string, rows [][]string, numeric map[int]bool) ([]byte, error) {
+	var sheet bytes.Buffer
+	sheet.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n")
+	sheet.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	for i, row := range rows {
+		fmt.Fprintf(&sheet, `<row r="%d">`, i+1)
+		for j, value := range row {
+			ref := columnLetters(j) + strconv.Itoa(i+1)
+			// Заголовок всегда текстовый
+			if numeric[j] && i > 0 {
+				fmt.Fprintf(&sheet, `<c r="%s"><v>%s</v></c>`, ref, escapeXML(value))
+				continue
+			}
+			fmt.Fprintf(&sheet, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, ref, escapeXML(value))
+		}
+		sheet.WriteString(`</row>`)
+	}
+	sheet.WriteString(`</sheetData></worksheet>`)
+
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRootRels},
+		{xlsxWorkbook, fmt.Sprintf(xlsxWorkbookContent, escapeXML(sheetName))},
+		{xlsxRels, xlsxWorkbookRels},
+		{"xl/worksheets/sheet1.xml", sheet.String()},
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, part := range parts {
+		writer, err := archive.Create(part.name)
+		if err != nil {
+			return nil, fmt.Errorf("create %s: %w", part.name, err)
+		}
+		if _, err = io.WriteString(writer, part.content); err != nil {
+			return nil, fmt.Errorf("write %s: %w", part.name, err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, fmt.Errorf("close xlsx: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func escapeXML(value string) string {
+	var buf strings.Builder
+	_ = xml.EscapeText(&buf, []byte(value))
+	return buf.String()
+}
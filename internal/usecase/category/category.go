@@ -2,18 +2,34 @@ package category
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
 	"github.com/google/uuid"
 )
 
+// maxSlugAttempts ограничивает перебор суффиксов при генерации уникального slug
+const maxSlugAttempts = 100
+
 //go:generate mockgen -source=category.go -destination=../../infrastructure/repository/postgres/mocks/category_repository_mock.go -package=mocks ICategoryRepository
 type ICategoryRepository interface {
 	GetAllCategories(ctx context.Context) ([]*models.Category, error)
 	GetAllSubcategories(ctx context.Context, category_id uuid.UUID) ([]*models.Category, error)
 	GetNameSubcategory(ctx context.Context, id uuid.UUID) (string, error)
+	GetCategory(ctx context.Context, id uuid.UUID) (*models.Category, error)
+	GetCategoryBySlug(ctx context.Context, slug string) (*models.Category, error)
+	GetCategoryTree(ctx context.Context) ([]*models.Category, error)
+	GetBreadcrumbs(ctx context.Context, id uuid.UUID) ([]*models.Category, error)
+	CreateCategory(ctx context.Context, category *models.Category) error
+	UpdateCategory(ctx context.Context, category *models.Category) error
+	SetCategoryImage(ctx context.Context, id uuid.UUID, imageURL string) error
+	MoveCategory(ctx context.Context, id uuid.UUID, parentID uuid.NullUUID, position int) error
+	DeleteCategory(ctx context.Context, id uuid.UUID) error
 }
 
 type CategoryUsecase struct {
@@ -64,3 +80,189 @@ func (u *CategoryUsecase) GetNameSubcategory(ctx context.Context, id uuid.UUID)
 
 	return name, nil
 }
+
+func (u *CategoryUsecase) GetCategoryTree(ctx context.Context) (dto.CategoryTreeResponse, error) {
+	const op = "CategoryUsecase.GetCategoryTree"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	categories, err := u.repo.GetCategoryTree(ctx)
+	if err != nil {
+		logger.WithError(err).Error("get category tree from repository")
+		return dto.CategoryTreeResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dto.ConvertToCategoryTree(categories), nil
+}
+
+func (u *CategoryUsecase) GetCategoryBySlug(ctx context.Context, slug string) (*models.Category, error) {
+	const op = "CategoryUsecase.GetCategoryBySlug"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("slug", slug)
+
+	category, err := u.repo.GetCategoryBySlug(ctx, slug)
+	if err != nil {
+		logger.WithError(err).Error("get category by slug")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return category, nil
+}
+
+func (u *CategoryUsecase) GetBreadcrumbs(ctx context.Context, id uuid.UUID) (dto.BreadcrumbsResponse, error) {
+	const op = "CategoryUsecase.GetBreadcrumbs"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("category_id", id)
+
+	path, err := u.repo.GetBreadcrumbs(ctx, id)
+	if err != nil {
+		logger.WithError(err).Error("get breadcrumbs from repository")
+		return dto.BreadcrumbsResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dto.ConvertToBreadcrumbsResponse(path), nil
+}
+
+func (u *CategoryUsecase) CreateCategory(ctx context.Context, req dto.CreateCategoryRequest) (*models.Category, error) {
+	const op = "CategoryUsecase.CreateCategory"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("category name is required"))
+	}
+
+	category := &models.Category{
+		ID:       uuid.New(),
+		Name:     name,
+		ImageURL: req.ImageURL,
+	}
+
+	if req.ParentID != nil {
+		if _, err := u.repo.GetCategory(ctx, *req.ParentID); err != nil {
+			logger.WithError(err).Error("get parent category")
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		category.ParentID = uuid.NullUUID{UUID: *req.ParentID, Valid: true}
+	}
+
+	slug, err := u.resolveSlug(ctx, req.Slug, name)
+	if err != nil {
+		logger.WithError(err).Error("resolve category slug")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	category.Slug = slug
+
+	if err = u.repo.CreateCategory(ctx, category); err != nil {
+		logger.WithError(err).Error("create category")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return category, nil
+}
+
+func (u *CategoryUsecase) UpdateCategory(ctx context.Context, id uuid.UUID, req dto.UpdateCategoryRequest) (*models.Category, error) {
+	const op = "CategoryUsecase.UpdateCategory"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("category_id", id)
+
+	category, err := u.repo.GetCategory(ctx, id)
+	if err != nil {
+		logger.WithError(err).Error("get category")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if name := strings.TrimSpace(req.Name); name != "" {
+		category.Name = name
+	}
+	if req.Slug != "" {
+		if !ValidSlug(req.Slug) {
+			return nil, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("invalid category slug"))
+		}
+		category.Slug = req.Slug
+	}
+	if req.ImageURL.Valid {
+		category.ImageURL = req.ImageURL
+	}
+
+	if err = u.repo.UpdateCategory(ctx, category); err != nil {
+		logger.WithError(err).Error("update category")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return category, nil
+}
+
+func (u *CategoryUsecase) SetCategoryImage(ctx context.Context, id uuid.UUID, imageURL string) error {
+	const op = "CategoryUsecase.SetCategoryImage"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("category_id", id)
+
+	if err := u.repo.SetCategoryImage(ctx, id, imageURL); err != nil {
+		logger.WithError(err).Error("set category image")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// MoveCategory переносит категорию вместе с поддеревом. Перенос узла внутрь
+// собственного поддерева запрещён: он образовал бы цикл, поэтому репозиторий
+// проверяет это в той же транзакции, что и перенос.
+func (u *CategoryUsecase) MoveCategory(ctx context.Context, id uuid.UUID, req dto.MoveCategoryRequest) error {
+	const op = "CategoryUsecase.MoveCategory"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("category_id", id)
+
+	if req.Position < 0 {
+		return fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("position must not be negative"))
+	}
+
+	parentID := uuid.NullUUID{}
+	if req.ParentID != nil {
+		parentID = uuid.NullUUID{UUID: *req.ParentID, Valid: true}
+	}
+
+	if err := u.repo.MoveCategory(ctx, id, parentID, req.Position); err != nil {
+		logger.WithError(err).Error("move category")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (u *CategoryUsecase) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	const op = "CategoryUsecase.DeleteCategory"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("category_id", id)
+
+	if err := u.repo.DeleteCategory(ctx, id); err != nil {
+		logger.WithError(err).Error("delete category")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// resolveSlug проверяет явно заданный slug или генерирует его из названия,
+// добавляя суффикс "-2", "-3", ... пока slug не станет свободным
+func (u *CategoryUsecase) resolveSlug(ctx context.Context, slug, name string) (string, error) {
+	if slug != "" {
+		if !ValidSlug(slug) {
+			return "", errs.NewBusinessLogicError("invalid category slug")
+		}
+		return slug, nil
+	}
+
+	base := Slugify(name)
+	if base == "" {
+		return "", errs.NewBusinessLogicError("cannot generate slug from category name")
+	}
+
+	candidate := base
+	for i := 2; i <= maxSlugAttempts; i++ {
+		_, err := u.repo.GetCategoryBySlug(ctx, candidate)
+		if errors.Is(err, errs.ErrNotFound) {
+			return candidate, nil
+		}
+		if err != nil {
+			return "", err
+		}
+		candidate = fmt.Sprintf("%s-%d", base, i)
+	}
+
+	return "", errs.NewAlreadyExistsError("category slug is taken")
+}
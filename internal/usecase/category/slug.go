@@ -0,0 +1,40 @@
+package category
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	slugPattern    = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	slugSeparators = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// translit совпадает с таблицей функции bazaar.slugify из миграции дерева категорий,
+// чтобы slug-и новых категорий не отличались от перенесённых
+var translit = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh",
+	'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+	'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts",
+	'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu",
+	'я': "ya",
+}
+
+// Slugify транслитерирует название в URL-slug: "Умные часы" → "umnye-chasy"
+func Slugify(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if latin, ok := translit[r]; ok {
+			b.WriteString(latin)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+
+	return strings.Trim(slugSeparators.ReplaceAllString(b.String(), "-"), "-")
+}
+
+// ValidSlug проверяет, что slug состоит из латиницы и цифр, разделённых одиночными дефисами
+func ValidSlug(slug string) bool {
+	return slugPattern.MatchString(slug)
+}
@@ -0,0 +1,227 @@
+package currency
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+)
+
+//go:generate mockgen -source=currency.go -destination=../../infrastructure/repository/postgres/mocks/currency_repository_mock.go -package=mocks ICurrencyRepository
+type ICurrencyRepository interface {
+	GetRate(ctx context.Context, currency models.Currency) (models.ExchangeRate, error)
+	GetRates(ctx context.Context) ([]models.ExchangeRate, error)
+	SaveRates(ctx context.Context, rates []models.ExchangeRate) error
+}
+
+type cachedRate struct {
+	rate      models.ExchangeRate
+	expiresAt time.Time
+}
+
+type CurrencyUsecase struct {
+	repo ICurrencyRepository
+	conf *config.CurrencyConfig
+
+	mu    sync.RWMutex
+	cache map[models.Currency]cachedRate
+}
+
+func NewCurrencyUsecase(repo ICurrencyRepository, conf *config.CurrencyConfig) *CurrencyUsecase {
+	return &CurrencyUsecase{
+		repo:  repo,
+		conf:  conf,
+		cache: make(map[models.Currency]cachedRate),
+	}
+}
+
+// GetRate возвращает курс для показа цен в валюте currency. Для базовой
+// валюты возвращается нулевой курс — суммы не пересчитываются. Курс
+// запрашивается на каждый запрос покупателя, поэтому хранится в памяти
+// RatesCacheTTL.
+func (u *CurrencyUsecase) GetRate(ctx context.Context, currency models.Currency) (models.ExchangeRate, error) {
+	const op = "CurrencyUsecase.GetRate"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("currency", currency)
+
+	if currency == models.BaseCurrency {
+		return models.ExchangeRate{}, nil
+	}
+
+	u.mu.RLock()
+	cached, ok := u.cache[currency]
+	u.mu.RUnlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached.rate, nil
+	}
+
+	rate, err := u.repo.GetRate(ctx, currency)
+	if err != nil {
+		logger.WithError(err).Warn("get exchange rate")
+		return models.ExchangeRate{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	u.mu.Lock()
+	u.cache[currency] = cachedRate{rate: rate, expiresAt: time.Now().Add(u.conf.RatesCacheTTL)}
+	u.mu.Unlock()
+
+	return rate, nil
+}
+
+// GetRates возвращает валюты, в которых можно смотреть цены; базовая — первой
+func (u *CurrencyUsecase) GetRates(ctx context.Context) ([]models.ExchangeRate, error) {
+	const op = "CurrencyUsecase.GetRates"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	rates, err := u.repo.GetRates(ctx)
+	if err != nil {
+		logger.WithError(err).Error("get exchange rates")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	base := models.ExchangeRate{Currency: models.BaseCurrency}
+	base.Rate, _ = models.ParseRate("1")
+
+	return append([]models.ExchangeRate{base}, rates...), nil
+}
+
+// SetRate задаёт курс валюты. Оформленные заказы хранят курс на момент
+// покупки, поэтому изменение курса их не затрагивает.
+func (u *CurrencyUsecase) SetRate(ctx context.Context, code string, req dto.SetExchangeRateRequest) error {
+	const op = "CurrencyUsecase.SetRate"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("currency", code)
+
+	rate, err := newRate(code, req.Rate)
+	if err != nil {
+		logger.WithError(err).Warn("invalid exchange rate")
+		return fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError(err.Error()))
+	}
+
+	if err = u.saveRates(ctx, []models.ExchangeRate{rate}); err != nil {
+		logger.WithError(err).Error("save exchange rate")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// ImportRates обновляет курсы из CSV с колонками currency и rate. Файл
+// применяется целиком: при ошибке в любой строке курсы не меняются.
+func (u *CurrencyUsecase) ImportRates(ctx context.Context, data []byte) (dto.ImportRatesResponse, error) {
+	const op = "CurrencyUsecase.ImportRates"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	rates, err := parseRates(data, u.conf.MaxImportRows)
+	if err != nil {
+		logger.WithError(err).Warn("parse exchange rates file")
+		return dto.ImportRatesResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = u.saveRates(ctx, rates); err != nil {
+		logger.WithError(err).Error("save exchange rates")
+		return dto.ImportRatesResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.WithField("imported", len(rates)).Info("exchange rates imported")
+	return dto.ImportRatesResponse{Imported: len(rates)}, nil
+}
+
+func (u *CurrencyUsecase) saveRates(ctx context.Context, rates []models.ExchangeRate) error {
+	if err := u.repo.SaveRates(ctx, rates); err != nil {
+		return err
+	}
+
+	u.mu.Lock()
+	for _, rate := range rates {
+		delete(u.cache, rate.Currency)
+	}
+	u.mu.Unlock()
+
+	return nil
+}
+
+// newRate проверяет код валюты и курс, заданные администратором
+func newRate(code string, rate models.Rate) (models.ExchangeRate, error) {
+	currency, err := models.ParseCurrency(code)
+	if err != nil {
+		return models.ExchangeRate{}, err
+	}
+	if currency == models.BaseCurrency {
+		return models.ExchangeRate{}, errors.New("base currency rate is fixed")
+	}
+	if rate.IsZero() {
+		return models.ExchangeRate{}, errors.New("rate must be positive")
+	}
+	return models.ExchangeRate{Currency: currency, Rate: rate}, nil
+}
+
+// parseRates разбирает файл курсов. Разделитель — "," или ";", строка
+// заголовка необязательна; при разделителе ";" курс можно записать с запятой.
+func parseRates(data []byte, maxRows int) ([]models.ExchangeRate, error) {
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	// в файле с разделителем ";" запятая в курсе — десятичная
+	firstLine, _, _ := bytes.Cut(data, []byte("\n"))
+	if bytes.Contains(firstLine, []byte(";")) {
+		reader.Comma = ';'
+	}
+
+	var (
+		rates []models.ExchangeRate
+		seen  = make(map[models.Currency]struct{})
+	)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, errs.NewBusinessLogicError(fmt.Sprintf("read csv: %v", err))
+		}
+
+		row, _ := reader.FieldPos(0)
+		if row == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "currency") {
+			continue
+		}
+		if len(record) < 2 {
+			return nil, errs.NewBusinessLogicError(fmt.Sprintf("row %d: expected currency and rate", row))
+		}
+
+		value, err := models.ParseRate(strings.ReplaceAll(strings.TrimSpace(record[1]), ",", "."))
+		if err != nil {
+			return nil, errs.NewBusinessLogicError(fmt.Sprintf("row %d: %v", row, err))
+		}
+		rate, err := newRate(record[0], value)
+		if err != nil {
+			return nil, errs.NewBusinessLogicError(fmt.Sprintf("row %d: %v", row, err))
+		}
+		if _, ok := seen[rate.Currency]; ok {
+			return nil, errs.NewBusinessLogicError(fmt.Sprintf("row %d: duplicate currency %s", row, rate.Currency))
+		}
+		seen[rate.Currency] = struct{}{}
+
+		rates = append(rates, rate)
+		if len(rates) > maxRows {
+			return nil, errs.NewBusinessLogicError(fmt.Sprintf("too many rates, at most %d allowed", maxRows))
+		}
+	}
+
+	if len(rates) == 0 {
+		return nil, errs.NewBusinessLogicError("no exchange rates in file")
+	}
+	return rates, nil
+}
@@ -0,0 +1,185 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+//go:generate mockgen -source=delivery.go -destination=../../infrastructure/repository/postgres/mocks/delivery_repository_mock.go -package=mocks IDeliveryRepository
+type IDeliveryRepository interface {
+	GetAddressZone(ctx context.Context, addressID uuid.UUID) (*models.DeliveryZone, error)
+	GetAvailableSlots(ctx context.Context, zoneID uuid.UUID, from, to time.Time) ([]models.DeliverySlot, error)
+	GetSlot(ctx context.Context, slotID uuid.UUID) (*models.DeliverySlot, error)
+	CreateZone(ctx context.Context, zone models.DeliveryZone) error
+	CreateSlot(ctx context.Context, slot models.DeliverySlot) error
+}
+
+type DeliveryUsecase struct {
+	repo IDeliveryRepository
+	conf *config.DeliveryConfig
+	now  func() time.Time
+}
+
+func NewDeliveryUsecase(repo IDeliveryRepository, conf *config.DeliveryConfig) *DeliveryUsecase {
+	return &DeliveryUsecase{
+		repo: repo,
+		conf: conf,
+		now:  time.Now,
+	}
+}
+
+// GetOptions возвращает ближайшую дату доставки по адресу и свободные интервалы,
+// которые начинаются не раньше неё
+func (u *DeliveryUsecase) GetOptions(ctx context.Context, addressID uuid.UUID) (dto.DeliveryOptionsDTO, error) {
+	const op = "DeliveryUsecase.GetOptions"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("address_id", addressID)
+
+	zone, err := u.repo.GetAddressZone(ctx, addressID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrNotFound) {
+			logger.WithError(err).Error("get address zone")
+			return dto.DeliveryOptionsDTO{}, fmt.Errorf("%s: %w", op, err)
+		}
+		// Адрес вне зон: доставка возможна, но без выбора интервала
+		return dto.DeliveryOptionsDTO{
+			ExpectedDeliveryAt: u.now().Add(u.conf.DefaultLeadTime),
+			Slots:              []dto.DeliverySlotDTO{},
+		}, nil
+	}
+
+	earliest := u.now().Add(zone.LeadTime())
+	slots, err := u.repo.GetAvailableSlots(ctx, zone.ID, earliest, earliest.Add(u.conf.SlotHorizon))
+	if err != nil {
+		logger.WithError(err).Error("get available slots")
+		return dto.DeliveryOptionsDTO{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	options := dto.DeliveryOptionsDTO{
+		Zone:               zone.Name,
+		ExpectedDeliveryAt: earliest,
+		Slots:              make([]dto.DeliverySlotDTO, 0, len(slots)),
+	}
+	for _, slot := range slots {
+		options.Slots = append(options.Slots, dto.ConvertToDeliverySlotDTO(slot))
+	}
+
+	return options, nil
+}
+
+// Plan рассчитывает доставку заказа. Без интервала заказ доставляется в ближайшую
+// возможную дату зоны; выбранный интервал должен относиться к зоне адреса и
+// начинаться не раньше этой даты. Место в интервале занимается при сохранении заказа.
+func (u *DeliveryUsecase) Plan(ctx context.Context, addressID uuid.UUID, slotID *uuid.UUID) (models.DeliveryPlan, error) {
+	const op = "DeliveryUsecase.Plan"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("address_id", addressID)
+
+	zone, err := u.repo.GetAddressZone(ctx, addressID)
+	if err != nil {
+		if !errors.Is(err, errs.ErrNotFound) {
+			logger.WithError(err).Error("get address zone")
+			return models.DeliveryPlan{}, fmt.Errorf("%s: %w", op, err)
+		}
+		if slotID != nil {
+			return models.DeliveryPlan{}, fmt.Errorf("%s: %w", op, errs.ErrSlotUnavailable)
+		}
+		return models.DeliveryPlan{ExpectedDeliveryAt: u.now().Add(u.conf.DefaultLeadTime)}, nil
+	}
+
+	earliest := u.now().Add(zone.LeadTime())
+	if slotID == nil {
+		return models.DeliveryPlan{ExpectedDeliveryAt: earliest}, nil
+	}
+
+	slot, err := u.repo.GetSlot(ctx, *slotID)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return models.DeliveryPlan{}, fmt.Errorf("%s: %w", op, errs.ErrSlotUnavailable)
+		}
+		logger.WithError(err).WithField("slot_id", *slotID).Error("get slot")
+		return models.DeliveryPlan{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if slot.ZoneID != zone.ID || slot.StartsAt.Before(earliest) || !slot.Available() {
+		logger.WithField("slot_id", slot.ID).Warn("slot is not available for the address")
+		return models.DeliveryPlan{}, fmt.Errorf("%s: %w", op, errs.ErrSlotUnavailable)
+	}
+
+	return models.DeliveryPlan{
+		SlotID:             uuid.NullUUID{UUID: slot.ID, Valid: true},
+		ExpectedDeliveryAt: slot.EndsAt,
+	}, nil
+}
+
+func (u *DeliveryUsecase) CreateZone(ctx context.Context, req dto.CreateDeliveryZoneRequest) (dto.DeliveryZoneDTO, error) {
+	const op = "DeliveryUsecase.CreateZone"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return dto.DeliveryZoneDTO{}, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("zone name is required"))
+	}
+	if req.LeadTimeDays < 0 {
+		return dto.DeliveryZoneDTO{}, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("lead time cannot be negative"))
+	}
+
+	zone := models.DeliveryZone{
+		ID:           uuid.New(),
+		Name:         name,
+		Region:       optionalString(req.Region),
+		City:         optionalString(req.City),
+		LeadTimeDays: req.LeadTimeDays,
+	}
+	if err := u.repo.CreateZone(ctx, zone); err != nil {
+		logger.WithError(err).Error("create zone")
+		return dto.DeliveryZoneDTO{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dto.ConvertToDeliveryZoneDTO(zone), nil
+}
+
+func (u *DeliveryUsecase) CreateSlot(ctx context.Context, req dto.CreateDeliverySlotRequest) (dto.DeliverySlotDTO, error) {
+	const op = "DeliveryUsecase.CreateSlot"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	switch {
+	case req.ZoneID == uuid.Nil:
+		return dto.DeliverySlotDTO{}, fmt.Errorf("%s: %w", op, errs.ErrInvalidID)
+	case !req.EndsAt.After(req.StartsAt):
+		return dto.DeliverySlotDTO{}, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("slot must end after it starts"))
+	case req.Capacity <= 0:
+		return dto.DeliverySlotDTO{}, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("slot capacity must be positive"))
+	}
+
+	slot := models.DeliverySlot{
+		ID:       uuid.New(),
+		ZoneID:   req.ZoneID,
+		StartsAt: req.StartsAt,
+		EndsAt:   req.EndsAt,
+		Capacity: req.Capacity,
+	}
+	if err := u.repo.CreateSlot(ctx, slot); err != nil {
+		logger.WithError(err).Error("create slot")
+		return dto.DeliverySlotDTO{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dto.ConvertToDeliverySlotDTO(slot), nil
+}
+
+// optionalString превращает пустое или отсутствующее значение в NULL
+func optionalString(value *string) null.String {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return null.String{}
+	}
+	return null.StringFrom(strings.TrimSpace(*value))
+}
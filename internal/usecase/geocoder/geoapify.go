@@ -0,0 +1,98 @@
+package geocoder
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/mailru/easyjson"
+)
+
+// geoapifyBuildingType — адрес принимается, только если найден конкретный дом
+const geoapifyBuildingType = "building"
+
+// GeoapifyGeocoder ищет адреса через Geoapify Geocoding API
+type GeoapifyGeocoder struct {
+	conf       *config.GeoapifyConfig
+	httpClient *http.Client
+}
+
+func NewGeoapifyGeocoder(conf *config.GeoapifyConfig) *GeoapifyGeocoder {
+	return &GeoapifyGeocoder{
+		conf:       conf,
+		httpClient: &http.Client{Timeout: conf.Timeout},
+	}
+}
+
+// Geocode выбирает среди найденных зданий самое значимое.
+// Совпадения значимостью ниже conf.MinImportance отбрасываются.
+func (g *GeoapifyGeocoder) Geocode(ctx context.Context, query string) (*models.GeocodedAddress, error) {
+	const op = "GeoapifyGeocoder.Geocode"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	params := url.Values{}
+	params.Set("text", query)
+	params.Set("lang", "ru")
+	params.Set("apiKey", g.conf.APIKey)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.conf.BaseURL+"?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to create request: %w", op, err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := g.httpClient.Do(req)
+	if err != nil {
+		logger.WithError(err).Error("call Geoapify API")
+		return nil, fmt.Errorf("%s: failed to call Geoapify API: %w", op, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: Geoapify API returned status %d", op, resp.StatusCode)
+	}
+
+	var geoResponse dto.GeoapifyResponse
+	if err = easyjson.UnmarshalFromReader(resp.Body, &geoResponse); err != nil {
+		logger.WithError(err).Error("decode Geoapify response")
+		return nil, fmt.Errorf("%s: failed to decode Geoapify response: %w", op, err)
+	}
+
+	var bestMatch *dto.GeoapifyFeature
+	for i := range geoResponse.Features {
+		feature := &geoResponse.Features[i]
+		if feature.Properties.ResultType != geoapifyBuildingType ||
+			feature.Properties.Rank.Importance < g.conf.MinImportance {
+			continue
+		}
+		if bestMatch == nil || feature.Properties.Rank.Importance > bestMatch.Properties.Rank.Importance {
+			bestMatch = feature
+		}
+	}
+
+	if bestMatch == nil {
+		return nil, fmt.Errorf("%s: %w", op, models.ErrAddressNotGeocoded)
+	}
+
+	coordinate := models.Coordinate{Lat: bestMatch.Properties.Lat, Lon: bestMatch.Properties.Lon}
+	if !coordinate.Valid() {
+		return nil, fmt.Errorf("%s: %w", op, models.ErrAddressNotGeocoded)
+	}
+
+	addressString := bestMatch.Properties.Formatted
+	if addressString == "" {
+		addressString = query
+	}
+
+	return &models.GeocodedAddress{
+		Region:        bestMatch.Properties.State,
+		City:          bestMatch.Properties.City,
+		AddressString: addressString,
+		Coordinate:    coordinate,
+	}, nil
+}
@@ -0,0 +1,86 @@
+package geocoder
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+)
+
+// IGeocoder сопоставляет текстовый адрес с точкой на карте.
+// Если адрес не найден, возвращает models.ErrAddressNotGeocoded.
+//
+//go:generate mockgen -source=geocoder.go -destination=../mocks/geocoder_mock.go -package=mocks IGeocoder
+type IGeocoder interface {
+	Geocode(ctx context.Context, query string) (*models.GeocodedAddress, error)
+}
+
+// IGeocodeCache хранит ответы геокодера по нормализованному запросу.
+// При промахе Get возвращает nil без ошибки.
+type IGeocodeCache interface {
+	Get(ctx context.Context, key string) (*models.GeocodedAddress, error)
+	Set(ctx context.Context, key string, address *models.GeocodedAddress) error
+}
+
+var (
+	spacesPattern = regexp.MustCompile(`\s+`)
+	commasPattern = regexp.MustCompile(`\s*,[\s,]*`)
+)
+
+// NormalizeQuery схлопывает пробелы и лишние запятые: " Москва ,, ул.  Ленина " → "Москва, ул. Ленина"
+func NormalizeQuery(query string) string {
+	query = spacesPattern.ReplaceAllString(query, " ")
+	query = commasPattern.ReplaceAllString(query, ", ")
+	return strings.Trim(query, " ,")
+}
+
+// CacheKey — ключ кэша для запроса: нормализованный запрос без учёта регистра и "ё"
+func CacheKey(query string) string {
+	return strings.ReplaceAll(strings.ToLower(NormalizeQuery(query)), "ё", "е")
+}
+
+// CachedGeocoder кэширует найденные адреса, чтобы не обращаться к провайдеру
+// повторно. Ошибки кэша не мешают геокодированию.
+type CachedGeocoder struct {
+	next  IGeocoder
+	cache IGeocodeCache
+}
+
+func NewCachedGeocoder(next IGeocoder, cache IGeocodeCache) *CachedGeocoder {
+	return &CachedGeocoder{
+		next:  next,
+		cache: cache,
+	}
+}
+
+func (g *CachedGeocoder) Geocode(ctx context.Context, query string) (*models.GeocodedAddress, error) {
+	const op = "CachedGeocoder.Geocode"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	key := CacheKey(query)
+	if key == "" {
+		return nil, fmt.Errorf("%s: %w", op, models.ErrAddressNotGeocoded)
+	}
+
+	cached, err := g.cache.Get(ctx, key)
+	if err != nil {
+		logger.WithError(err).Warn("get geocode cache")
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	address, err := g.next.Geocode(ctx, NormalizeQuery(query))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = g.cache.Set(ctx, key, address); err != nil {
+		logger.WithError(err).Warn("set geocode cache")
+	}
+
+	return address, nil
+}
@@ -0,0 +1,56 @@
+package geocoder
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+)
+
+// Границы, в которые StubGeocoder помещает координаты, — примерно европейская часть России
+const (
+	stubMinLat  = 43.0
+	stubLatSpan = 25.0
+	stubMinLon  = 30.0
+	stubLonSpan = 30.0
+)
+
+// StubGeocoder — детерминированный геокодер для тестов и запуска без сети.
+// Один и тот же запрос всегда даёт одну и ту же координату; адрес без номера
+// дома считается ненайденным, как и у настоящего провайдера.
+type StubGeocoder struct{}
+
+func NewStubGeocoder() *StubGeocoder {
+	return &StubGeocoder{}
+}
+
+func (StubGeocoder) Geocode(_ context.Context, query string) (*models.GeocodedAddress, error) {
+	const op = "StubGeocoder.Geocode"
+
+	addressString := NormalizeQuery(query)
+	if !strings.ContainsFunc(addressString, unicode.IsLetter) || !strings.ContainsFunc(addressString, unicode.IsDigit) {
+		return nil, fmt.Errorf("%s: %w", op, models.ErrAddressNotGeocoded)
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(CacheKey(query)))
+	sum := h.Sum64()
+
+	return &models.GeocodedAddress{
+		AddressString: addressString,
+		Coordinate: models.Coordinate{
+			Lat: stubDegrees(stubMinLat, stubLatSpan, sum),
+			Lon: stubDegrees(stubMinLon, stubLonSpan, sum>>32),
+		},
+	}, nil
+}
+
+// stubDegrees переводит младшие 32 бита хэша в градусы внутри [min, min+span) с точностью до 1e-6
+func stubDegrees(min, span float64, bits uint64) float64 {
+	fraction := float64(bits&math.MaxUint32) / (math.MaxUint32 + 1)
+	return math.Round((min+fraction*span)*1e6) / 1e6
+}
@@ -0,0 +1,15 @@
+package helpers
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/domains"
+)
+
+// GetExchangeRateFromContext возвращает курс валюты, выбранной покупателем.
+// Если валюта не выбрана, возвращается нулевой курс — цены в рублях.
+func GetExchangeRateFromContext(ctx context.Context) models.ExchangeRate {
+	rate, _ := ctx.Value(domains.ExchangeRateKey{}).(models.ExchangeRate)
+	return rate
+}
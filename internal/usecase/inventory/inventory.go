@@ -0,0 +1,226 @@
+package inventory
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/notification"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=inventory.go -destination=../../infrastructure/repository/postgres/mocks/inventory_repository_mock.go -package=mocks IInventoryRepository
+type IInventoryRepository interface {
+	AdjustStock(
+		ctx context.Context,
+		sellerID, productID uuid.UUID,
+		variantID uuid.NullUUID,
+		delta int,
+		change models.StockChange,
+	) (*models.StockMovement, error)
+	GetMovements(ctx context.Context, sellerID, productID uuid.UUID, offset int) ([]models.StockMovement, error)
+	SetLowStockThreshold(ctx context.Context, sellerID, productID uuid.UUID, threshold sql.NullInt64) error
+	GetDiscrepancies(ctx context.Context, sellerID uuid.UUID) ([]models.StockDiscrepancy, error)
+	ClaimLowStockAlerts(ctx context.Context, limit int) ([]models.LowStockAlert, error)
+}
+
+type InventoryUsecase struct {
+	repo             IInventoryRepository
+	notificationRepo notification.INotificationRepository
+	conf             *config.InventoryConfig
+}
+
+func NewInventoryUsecase(
+	repo IInventoryRepository,
+	notificationRepo notification.INotificationRepository,
+	conf *config.InventoryConfig,
+) *InventoryUsecase {
+	return &InventoryUsecase{
+		repo:             repo,
+		notificationRepo: notificationRepo,
+		conf:             conf,
+	}
+}
+
+// AdjustStock записывает ручное изменение остатка товара или SKU продавца.
+// Причина обязательна: по ней продавец потом разбирает журнал.
+func (u *InventoryUsecase) AdjustStock(
+	ctx context.Context,
+	sellerID, productID uuid.UUID,
+	req dto.StockAdjustmentRequest,
+) (*models.StockMovement, error) {
+	const op = "InventoryUsecase.AdjustStock"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("product_id", productID)
+
+	kind := models.StockAdjustment
+	if req.Kind != "" {
+		kind = models.StockMovementKind(req.Kind)
+	}
+	if !kind.ManualStockKind() {
+		return nil, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("unsupported stock movement kind"))
+	}
+
+	switch {
+	case req.Delta == 0:
+		return nil, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("stock delta must not be zero"))
+	case kind == models.StockReturn && req.Delta < 0:
+		return nil, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("return must increase stock"))
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("stock adjustment reason is required"))
+	}
+
+	var variantID uuid.NullUUID
+	if req.VariantID != nil {
+		variantID = uuid.NullUUID{UUID: *req.VariantID, Valid: true}
+	}
+
+	movement, err := u.repo.AdjustStock(ctx, sellerID, productID, variantID, req.Delta, models.StockChange{
+		Kind:    kind,
+		ActorID: uuid.NullUUID{UUID: sellerID, Valid: true},
+		Reason:  reason,
+	})
+	if err != nil {
+		logger.WithError(err).Warn("adjust stock")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return movement, nil
+}
+
+// GetMovements возвращает страницу журнала остатков товара продавца
+func (u *InventoryUsecase) GetMovements(ctx context.Context, sellerID, productID uuid.UUID, offset int) ([]models.StockMovement, error) {
+	const op = "InventoryUsecase.GetMovements"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("product_id", productID)
+
+	if offset < 0 {
+		offset = 0
+	}
+
+	movements, err := u.repo.GetMovements(ctx, sellerID, productID, offset)
+	if err != nil {
+		logger.WithError(err).Error("get stock movements")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return movements, nil
+}
+
+// SetLowStockThreshold задаёт порог, при достижении которого продавец получает
+// уведомление; nil снимает порог
+func (u *InventoryUsecase) SetLowStockThreshold(ctx context.Context, sellerID, productID uuid.UUID, threshold *int) error {
+	const op = "InventoryUsecase.SetLowStockThreshold"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("product_id", productID)
+
+	var value sql.NullInt64
+	if threshold != nil {
+		if *threshold < 0 {
+			return fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("low stock threshold must not be negative"))
+		}
+		value = sql.NullInt64{Int64: int64(*threshold), Valid: true}
+	}
+
+	if err := u.repo.SetLowStockThreshold(ctx, sellerID, productID, value); err != nil {
+		logger.WithError(err).Warn("set low stock threshold")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetDiscrepancies сверяет остатки продавца с журналом и возвращает расхождения
+func (u *InventoryUsecase) GetDiscrepancies(ctx context.Context, sellerID uuid.UUID) ([]models.StockDiscrepancy, error) {
+	const op = "InventoryUsecase.GetDiscrepancies"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("seller_id", sellerID)
+
+	discrepancies, err := u.repo.GetDiscrepancies(ctx, sellerID)
+	if err != nil {
+		logger.WithError(err).Error("get stock discrepancies")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(discrepancies) > 0 {
+		logger.WithField("count", len(discrepancies)).Warn("stock does not match ledger")
+	}
+
+	return discrepancies, nil
+}
+
+// RunAlerts рассылает продавцам уведомления о низком остатке с периодом
+// AlertInterval до отмены контекста. Неположительный интервал отключает рассылку.
+func (u *InventoryUsecase) RunAlerts(ctx context.Context) {
+	const op = "InventoryUsecase.RunAlerts"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	if u.conf.AlertInterval <= 0 {
+		logger.Warn("low stock alerts disabled")
+		return
+	}
+
+	ticker := time.NewTicker(u.conf.AlertInterval)
+	defer ticker.Stop()
+
+	for {
+		sent, err := u.SendLowStockAlerts(ctx)
+		if err != nil {
+			logger.WithError(err).Error("send low stock alerts")
+		} else if sent > 0 {
+			logger.WithField("sent", sent).Info("low stock alerts sent")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendLowStockAlerts отправляет накопившиеся уведомления пачками по AlertBatch
+// и возвращает их количество. Уведомление, которое не удалось создать, только логируется.
+func (u *InventoryUsecase) SendLowStockAlerts(ctx context.Context) (int, error) {
+	const op = "InventoryUsecase.SendLowStockAlerts"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	var total int
+	for {
+		alerts, err := u.repo.ClaimLowStockAlerts(ctx, u.conf.AlertBatch)
+		if err != nil {
+			return total, fmt.Errorf("%s: %w", op, err)
+		}
+
+		for _, alert := range alerts {
+			if err = u.notificationRepo.Create(ctx, models.Notification{
+				ID:     uuid.New(),
+				UserID: alert.SellerID,
+				Title:  "Товар заканчивается",
+				Text:   lowStockText(alert),
+				IsRead: false,
+			}); err != nil {
+				logger.WithError(err).WithField("alert_id", alert.ID).Warn("create notification")
+				continue
+			}
+			total++
+		}
+
+		if len(alerts) < u.conf.AlertBatch || ctx.Err() != nil {
+			return total, nil
+		}
+	}
+}
+
+func lowStockText(alert models.LowStockAlert) string {
+	name := fmt.Sprintf("«%s»", alert.ProductName)
+	if alert.SKU != "" {
+		name += fmt.Sprintf(" (артикул %s)", alert.SKU)
+	}
+	return fmt.Sprintf("Остаток товара %s — %d шт., порог — %d шт.", name, alert.Quantity, alert.Threshold)
+}
@@ -0,0 +1,201 @@
+package invoice
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-pdf/fpdf"
+	"rsc.io/qr"
+)
+
+const (
+	fontFamily = "DejaVu"
+	qrImage    = "order-qr"
+
+	pageMargin = 15.0
+	lineHeight = 6.0
+	qrSize     = 30.0
+)
+
+// Ширины колонок таблицы товаров: наименование, количество, цена, скидка, сумма
+var columnWidths = [...]float64{80, 20, 28, 26, 26}
+
+// Generator собирает PDF-счёт по заказу. Шрифты читаются с диска один раз
+// при первой генерации и переиспользуются.
+type Generator struct {
+	conf *config.InvoiceConfig
+
+	fontsOnce sync.Once
+	regular   []byte
+	bold      []byte
+	fontsErr  error
+}
+
+func NewGenerator(conf *config.InvoiceConfig) *Generator {
+	return &Generator{
+		conf: conf,
+	}
+}
+
+func (g *Generator) loadFonts() error {
+	g.fontsOnce.Do(func() {
+		if g.regular, g.fontsErr = os.ReadFile(g.conf.FontPath); g.fontsErr != nil {
+			return
+		}
+		g.bold, g.fontsErr = os.ReadFile(g.conf.BoldFontPath)
+	})
+	return g.fontsErr
+}
+
+// Render возвращает счёт в формате PDF: продавцы с их товарами, скидки,
+// итоговые суммы и QR-код с номером заказа
+func (g *Generator) Render(order dto.OrderDetailDTO) ([]byte, error) {
+	const op = "Generator.Render"
+
+	if err := g.loadFonts(); err != nil {
+		return nil, fmt.Errorf("%s: load fonts: %w", op, err)
+	}
+
+	code, err := qr.Encode(order.ID.String(), qr.M)
+	if err != nil {
+		return nil, fmt.Errorf("%s: encode qr: %w", op, err)
+	}
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pageMargin, pageMargin, pageMargin)
+	pdf.SetAutoPageBreak(true, pageMargin)
+	pdf.SetTitle("Счёт по заказу "+order.ID.String(), true)
+	if order.CreatedAt != nil {
+		pdf.SetCreationDate(*order.CreatedAt)
+	}
+	pdf.AddUTF8FontFromBytes(fontFamily, "", g.regular)
+	pdf.AddUTF8FontFromBytes(fontFamily, "B", g.bold)
+	pdf.RegisterImageOptionsReader(qrImage, fpdf.ImageOptions{ImageType: "PNG"}, bytes.NewReader(code.PNG()))
+	pdf.AddPage()
+
+	writeHeader(pdf, order)
+	for _, shipment := range order.Shipments {
+		writeShipment(pdf, shipment)
+	}
+	writeTotals(pdf, order)
+
+	var buf bytes.Buffer
+	if err = pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeHeader(pdf *fpdf.Fpdf, order dto.OrderDetailDTO) {
+	pageWidth, _ := pdf.GetPageSize()
+	pdf.ImageOptions(qrImage, pageWidth-pageMargin-qrSize, pageMargin, qrSize, qrSize, false,
+		fpdf.ImageOptions{ImageType: "PNG"}, 0, "")
+
+	pdf.SetFont(fontFamily, "B", 16)
+	pdf.CellFormat(0, 10, "Счёт по заказу", "", 1, "L", false, 0, "")
+
+	pdf.SetFont(fontFamily, "", 10)
+	pdf.CellFormat(0, lineHeight, "Номер заказа: "+order.ID.String(), "", 1, "L", false, 0, "")
+	if order.CreatedAt != nil {
+		pdf.CellFormat(0, lineHeight, "Дата оформления: "+order.CreatedAt.Format("02.01.2006 15:04"), "", 1, "L", false, 0, "")
+	}
+	pdf.CellFormat(0, lineHeight, "Статус: "+order.Status.String(), "", 1, "L", false, 0, "")
+	if address := formatAddress(order); address != "" {
+		pdf.MultiCell(pageWidth-2*pageMargin-qrSize-5, lineHeight, "Адрес доставки: "+address, "", "L", false)
+	}
+
+	// Таблицы начинаются под QR-кодом, даже если заголовок короче
+	if y := pageMargin + qrSize + 5; pdf.GetY() < y {
+		pdf.SetY(y)
+	}
+}
+
+func writeShipment(pdf *fpdf.Fpdf, shipment dto.ShipmentPreviewDTO) {
+	pdf.Ln(4)
+	pdf.SetFont(fontFamily, "B", 11)
+	seller := shipment.SellerName
+	if seller == "" {
+		seller = "—"
+	}
+	pdf.CellFormat(0, lineHeight, "Продавец: "+seller, "", 1, "L", false, 0, "")
+	pdf.SetFont(fontFamily, "", 8)
+	pdf.CellFormat(0, 5, "ID продавца: "+shipment.SellerID.String(), "", 1, "L", false, 0, "")
+
+	pdf.SetFont(fontFamily, "B", 9)
+	headers := [...]string{"Наименование", "Кол-во", "Цена", "Скидка", "Сумма"}
+	for i, header := range headers {
+		pdf.CellFormat(columnWidths[i], 7, header, "1", 0, "C", false, 0, "")
+	}
+	pdf.Ln(-1)
+
+	pdf.SetFont(fontFamily, "", 9)
+	for _, item := range shipment.Products {
+		// Длинные названия обрезаются до ширины колонки
+		name := item.ProductName
+		for len(name) > 0 && pdf.GetStringWidth(name) > columnWidths[0]-2 {
+			runes := []rune(name)
+			name = string(runes[:len(runes)-1])
+		}
+
+		pdf.CellFormat(columnWidths[0], 7, name, "1", 0, "L", false, 0, "")
+		pdf.CellFormat(columnWidths[1], 7, fmt.Sprintf("%d", item.Quantity), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(columnWidths[2], 7, formatMoney(item.BasePrice), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(columnWidths[3], 7, formatMoney(item.Discount()), "1", 0, "R", false, 0, "")
+		pdf.CellFormat(columnWidths[4], 7, formatMoney(item.Total()), "1", 1, "R", false, 0, "")
+	}
+
+	pdf.SetFont(fontFamily, "B", 9)
+	var tableWidth float64
+	for _, width := range columnWidths[:len(columnWidths)-1] {
+		tableWidth += width
+	}
+	pdf.CellFormat(tableWidth, 7, "Итого по продавцу", "1", 0, "R", false, 0, "")
+	pdf.CellFormat(columnWidths[len(columnWidths)-1], 7, formatMoney(shipment.TotalDiscountPrice), "1", 1, "R", false, 0, "")
+}
+
+func writeTotals(pdf *fpdf.Fpdf, order dto.OrderDetailDTO) {
+	pdf.Ln(6)
+
+	row := func(label, value string) {
+		pdf.CellFormat(130, lineHeight, label, "", 0, "R", false, 0, "")
+		pdf.CellFormat(0, lineHeight, value, "", 1, "R", false, 0, "")
+	}
+
+	pdf.SetFont(fontFamily, "", 10)
+	row("Товары без скидки:", formatMoney(order.Subtotal))
+	if order.ProductDiscount.IsPositive() {
+		row("Скидки на товары:", "−"+formatMoney(order.ProductDiscount))
+	}
+	if order.PromoCode.Valid {
+		row("Промокод "+order.PromoCode.String+":", "−"+formatMoney(order.PromoDiscount))
+	}
+	row("Доставка:", formatMoney(order.DeliveryCost))
+
+	pdf.SetFont(fontFamily, "B", 12)
+	row("К оплате:", formatMoney(order.Total))
+}
+
+func formatAddress(order dto.OrderDetailDTO) string {
+	if order.Address.AddressString.Valid {
+		return order.Address.AddressString.String
+	}
+
+	address := order.Address.Region.String
+	if order.Address.City.Valid {
+		if address != "" {
+			address += ", "
+		}
+		address += order.Address.City.String
+	}
+	return address
+}
+
+func formatMoney(value models.Money) string {
+	return value.String() + " руб."
+}
@@ -50,6 +50,21 @@ func (mr *MockIAdminUsecaseMockRecorder) GetPendingProducts(ctx, offset interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingProducts", reflect.TypeOf((*MockIAdminUsecase)(nil).GetPendingProducts), ctx, offset)
 }
 
+// GetPendingReviews mocks base method.
+func (m *MockIAdminUsecase) GetPendingReviews(ctx context.Context, offset int) (dto.ModerationReviewsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingReviews", ctx, offset)
+	ret0, _ := ret[0].(dto.ModerationReviewsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingReviews indicates an expected call of GetPendingReviews.
+func (mr *MockIAdminUsecaseMockRecorder) GetPendingReviews(ctx, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingReviews", reflect.TypeOf((*MockIAdminUsecase)(nil).GetPendingReviews), ctx, offset)
+}
+
 // GetPendingUsers mocks base method.
 func (m *MockIAdminUsecase) GetPendingUsers(ctx context.Context, offset int) (dto.UsersResponse, error) {
 	m.ctrl.T.Helper()
@@ -79,6 +94,20 @@ func (mr *MockIAdminUsecaseMockRecorder) UpdateProductStatus(ctx, req interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductStatus", reflect.TypeOf((*MockIAdminUsecase)(nil).UpdateProductStatus), ctx, req)
 }
 
+// UpdateReviewStatus mocks base method.
+func (m *MockIAdminUsecase) UpdateReviewStatus(ctx context.Context, req dto.UpdateReviewStatusRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReviewStatus", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReviewStatus indicates an expected call of UpdateReviewStatus.
+func (mr *MockIAdminUsecaseMockRecorder) UpdateReviewStatus(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReviewStatus", reflect.TypeOf((*MockIAdminUsecase)(nil).UpdateReviewStatus), ctx, req)
+}
+
 // UpdateUserRole mocks base method.
 func (m *MockIAdminUsecase) UpdateUserRole(ctx context.Context, req dto.UpdateUserRoleRequest) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: analytics.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	dto "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIAnalyticsUsecase is a mock of IAnalyticsUsecase interface.
+type MockIAnalyticsUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAnalyticsUsecaseMockRecorder
+}
+
+// MockIAnalyticsUsecaseMockRecorder is the mock recorder for MockIAnalyticsUsecase.
+type MockIAnalyticsUsecaseMockRecorder struct {
+	mock *MockIAnalyticsUsecase
+}
+
+// NewMockIAnalyticsUsecase creates a new mock instance.
+func NewMockIAnalyticsUsecase(ctrl *gomock.Controller) *MockIAnalyticsUsecase {
+	mock := &MockIAnalyticsUsecase{ctrl: ctrl}
+	mock.recorder = &MockIAnalyticsUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAnalyticsUsecase) EXPECT() *MockIAnalyticsUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetStockOuts mocks base method.
+func (m *MockIAnalyticsUsecase) GetStockOuts(ctx context.Context, sellerID uuid.UUID, period models.AnalyticsPeriod, offset int) ([]models.StockOutProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStockOuts", ctx, sellerID, period, offset)
+	ret0, _ := ret[0].([]models.StockOutProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStockOuts indicates an expected call of GetStockOuts.
+func (mr *MockIAnalyticsUsecaseMockRecorder) GetStockOuts(ctx, sellerID, period, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStockOuts", reflect.TypeOf((*MockIAnalyticsUsecase)(nil).GetStockOuts), ctx, sellerID, period, offset)
+}
+
+// GetSummary mocks base method.
+func (m *MockIAnalyticsUsecase) GetSummary(ctx context.Context, sellerID uuid.UUID, period models.AnalyticsPeriod) (dto.SellerAnalyticsDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSummary", ctx, sellerID, period)
+	ret0, _ := ret[0].(dto.SellerAnalyticsDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSummary indicates an expected call of GetSummary.
+func (mr *MockIAnalyticsUsecaseMockRecorder) GetSummary(ctx, sellerID, period interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSummary", reflect.TypeOf((*MockIAnalyticsUsecase)(nil).GetSummary), ctx, sellerID, period)
+}
+
+// GetTopProducts mocks base method.
+func (m *MockIAnalyticsUsecase) GetTopProducts(ctx context.Context, sellerID uuid.UUID, period models.AnalyticsPeriod, limit int) ([]models.TopProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopProducts", ctx, sellerID, period, limit)
+	ret0, _ := ret[0].([]models.TopProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopProducts indicates an expected call of GetTopProducts.
+func (mr *MockIAnalyticsUsecaseMockRecorder) GetTopProducts(ctx, sellerID, period, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopProducts", reflect.TypeOf((*MockIAnalyticsUsecase)(nil).GetTopProducts), ctx, sellerID, period, limit)
+}
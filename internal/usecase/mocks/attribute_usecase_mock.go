@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: attribute.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	dto "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIAttributeUsecase is a mock of IAttributeUsecase interface.
+type MockIAttributeUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIAttributeUsecaseMockRecorder
+}
+
+// MockIAttributeUsecaseMockRecorder is the mock recorder for MockIAttributeUsecase.
+type MockIAttributeUsecaseMockRecorder struct {
+	mock *MockIAttributeUsecase
+}
+
+// NewMockIAttributeUsecase creates a new mock instance.
+func NewMockIAttributeUsecase(ctrl *gomock.Controller) *MockIAttributeUsecase {
+	mock := &MockIAttributeUsecase{ctrl: ctrl}
+	mock.recorder = &MockIAttributeUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIAttributeUsecase) EXPECT() *MockIAttributeUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateAttribute mocks base method.
+func (m *MockIAttributeUsecase) CreateAttribute(ctx context.Context, categoryID uuid.UUID, req dto.CreateAttributeRequest) (*models.CategoryAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttribute", ctx, categoryID, req)
+	ret0, _ := ret[0].(*models.CategoryAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAttribute indicates an expected call of CreateAttribute.
+func (mr *MockIAttributeUsecaseMockRecorder) CreateAttribute(ctx, categoryID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttribute", reflect.TypeOf((*MockIAttributeUsecase)(nil).CreateAttribute), ctx, categoryID, req)
+}
+
+// DeleteAttribute mocks base method.
+func (m *MockIAttributeUsecase) DeleteAttribute(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttribute", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttribute indicates an expected call of DeleteAttribute.
+func (mr *MockIAttributeUsecaseMockRecorder) DeleteAttribute(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttribute", reflect.TypeOf((*MockIAttributeUsecase)(nil).DeleteAttribute), ctx, id)
+}
+
+// GetCategoryAttributes mocks base method.
+func (m *MockIAttributeUsecase) GetCategoryAttributes(ctx context.Context, categoryID uuid.UUID) (dto.CategoryAttributesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryAttributes", ctx, categoryID)
+	ret0, _ := ret[0].(dto.CategoryAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryAttributes indicates an expected call of GetCategoryAttributes.
+func (mr *MockIAttributeUsecaseMockRecorder) GetCategoryAttributes(ctx, categoryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryAttributes", reflect.TypeOf((*MockIAttributeUsecase)(nil).GetCategoryAttributes), ctx, categoryID)
+}
+
+// GetProductAttributes mocks base method.
+func (m *MockIAttributeUsecase) GetProductAttributes(ctx context.Context, productID uuid.UUID) (dto.ProductAttributesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductAttributes", ctx, productID)
+	ret0, _ := ret[0].(dto.ProductAttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductAttributes indicates an expected call of GetProductAttributes.
+func (mr *MockIAttributeUsecaseMockRecorder) GetProductAttributes(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductAttributes", reflect.TypeOf((*MockIAttributeUsecase)(nil).GetProductAttributes), ctx, productID)
+}
+
+// UpdateAttribute mocks base method.
+func (m *MockIAttributeUsecase) UpdateAttribute(ctx context.Context, id uuid.UUID, req dto.UpdateAttributeRequest) (*models.CategoryAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttribute", ctx, id, req)
+	ret0, _ := ret[0].(*models.CategoryAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAttribute indicates an expected call of UpdateAttribute.
+func (mr *MockIAttributeUsecaseMockRecorder) UpdateAttribute(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttribute", reflect.TypeOf((*MockIAttributeUsecase)(nil).UpdateAttribute), ctx, id, req)
+}
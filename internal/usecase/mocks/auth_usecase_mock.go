@@ -10,6 +10,7 @@ import (
 
 	dto "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
 )
 
 // MockIAuthUsecase is a mock of IAuthUsecase interface.
@@ -78,3 +79,40 @@ func (mr *MockIAuthUsecaseMockRecorder) Register(arg0, arg1 interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockIAuthUsecase)(nil).Register), arg0, arg1)
 }
+
+// MockIGuestBasketMerger is a mock of IGuestBasketMerger interface.
+type MockIGuestBasketMerger struct {
+	ctrl     *gomock.Controller
+	recorder *MockIGuestBasketMergerMockRecorder
+}
+
+// MockIGuestBasketMergerMockRecorder is the mock recorder for MockIGuestBasketMerger.
+type MockIGuestBasketMergerMockRecorder struct {
+	mock *MockIGuestBasketMerger
+}
+
+// NewMockIGuestBasketMerger creates a new mock instance.
+func NewMockIGuestBasketMerger(ctrl *gomock.Controller) *MockIGuestBasketMerger {
+	mock := &MockIGuestBasketMerger{ctrl: ctrl}
+	mock.recorder = &MockIGuestBasketMergerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIGuestBasketMerger) EXPECT() *MockIGuestBasketMergerMockRecorder {
+	return m.recorder
+}
+
+// MergeGuest mocks base method.
+func (m *MockIGuestBasketMerger) MergeGuest(ctx context.Context, guestID, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeGuest", ctx, guestID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeGuest indicates an expected call of MergeGuest.
+func (mr *MockIGuestBasketMergerMockRecorder) MergeGuest(ctx, guestID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeGuest", reflect.TypeOf((*MockIGuestBasketMerger)(nil).MergeGuest), ctx, guestID, userID)
+}
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	dto "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 )
@@ -37,18 +38,18 @@ func (m *MockIBasketUsecase) EXPECT() *MockIBasketUsecaseMockRecorder {
 }
 
 // Add mocks base method.
-func (m *MockIBasketUsecase) Add(ctx context.Context, productID uuid.UUID) (*models.BasketItem, error) {
+func (m *MockIBasketUsecase) Add(ctx context.Context, productID uuid.UUID, variantID uuid.NullUUID) (*models.BasketItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Add", ctx, productID)
+	ret := m.ctrl.Call(m, "Add", ctx, productID, variantID)
 	ret0, _ := ret[0].(*models.BasketItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Add indicates an expected call of Add.
-func (mr *MockIBasketUsecaseMockRecorder) Add(ctx, productID interface{}) *gomock.Call {
+func (mr *MockIBasketUsecaseMockRecorder) Add(ctx, productID, variantID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockIBasketUsecase)(nil).Add), ctx, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockIBasketUsecase)(nil).Add), ctx, productID, variantID)
 }
 
 // Clear mocks base method.
@@ -66,17 +67,17 @@ func (mr *MockIBasketUsecaseMockRecorder) Clear(ctx interface{}) *gomock.Call {
 }
 
 // Delete mocks base method.
-func (m *MockIBasketUsecase) Delete(ctx context.Context, productID uuid.UUID) error {
+func (m *MockIBasketUsecase) Delete(ctx context.Context, productID uuid.UUID, variantID uuid.NullUUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, productID)
+	ret := m.ctrl.Call(m, "Delete", ctx, productID, variantID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockIBasketUsecaseMockRecorder) Delete(ctx, productID interface{}) *gomock.Call {
+func (mr *MockIBasketUsecaseMockRecorder) Delete(ctx, productID, variantID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIBasketUsecase)(nil).Delete), ctx, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIBasketUsecase)(nil).Delete), ctx, productID, variantID)
 }
 
 // Get mocks base method.
@@ -94,17 +95,32 @@ func (mr *MockIBasketUsecaseMockRecorder) Get(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIBasketUsecase)(nil).Get), ctx)
 }
 
+// Quote mocks base method.
+func (m *MockIBasketUsecase) Quote(ctx context.Context, promoCode string) (dto.BasketQuoteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quote", ctx, promoCode)
+	ret0, _ := ret[0].(dto.BasketQuoteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Quote indicates an expected call of Quote.
+func (mr *MockIBasketUsecaseMockRecorder) Quote(ctx, promoCode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quote", reflect.TypeOf((*MockIBasketUsecase)(nil).Quote), ctx, promoCode)
+}
+
 // UpdateQuantity mocks base method.
-func (m *MockIBasketUsecase) UpdateQuantity(ctx context.Context, productID uuid.UUID, quantity int) (*models.BasketItem, error) {
+func (m *MockIBasketUsecase) UpdateQuantity(ctx context.Context, productID uuid.UUID, variantID uuid.NullUUID, quantity int) (*models.BasketItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateQuantity", ctx, productID, quantity)
+	ret := m.ctrl.Call(m, "UpdateQuantity", ctx, productID, variantID, quantity)
 	ret0, _ := ret[0].(*models.BasketItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateQuantity indicates an expected call of UpdateQuantity.
-func (mr *MockIBasketUsecaseMockRecorder) UpdateQuantity(ctx, productID, quantity interface{}) *gomock.Call {
+func (mr *MockIBasketUsecaseMockRecorder) UpdateQuantity(ctx, productID, variantID, quantity interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQuantity", reflect.TypeOf((*MockIBasketUsecase)(nil).UpdateQuantity), ctx, productID, quantity)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQuantity", reflect.TypeOf((*MockIBasketUsecase)(nil).UpdateQuantity), ctx, productID, variantID, quantity)
 }
@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: catalog.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockICatalogUsecase is a mock of ICatalogUsecase interface.
+type MockICatalogUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockICatalogUsecaseMockRecorder
+}
+
+// MockICatalogUsecaseMockRecorder is the mock recorder for MockICatalogUsecase.
+type MockICatalogUsecaseMockRecorder struct {
+	mock *MockICatalogUsecase
+}
+
+// NewMockICatalogUsecase creates a new mock instance.
+func NewMockICatalogUsecase(ctrl *gomock.Controller) *MockICatalogUsecase {
+	mock := &MockICatalogUsecase{ctrl: ctrl}
+	mock.recorder = &MockICatalogUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICatalogUsecase) EXPECT() *MockICatalogUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MockICatalogUsecase) Export(ctx context.Context, sellerID uuid.UUID, format models.CatalogFormat) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, sellerID, format)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockICatalogUsecaseMockRecorder) Export(ctx, sellerID, format interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockICatalogUsecase)(nil).Export), ctx, sellerID, format)
+}
+
+// GetImport mocks base method.
+func (m *MockICatalogUsecase) GetImport(ctx context.Context, sellerID, id uuid.UUID) (*models.CatalogImport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImport", ctx, sellerID, id)
+	ret0, _ := ret[0].(*models.CatalogImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImport indicates an expected call of GetImport.
+func (mr *MockICatalogUsecaseMockRecorder) GetImport(ctx, sellerID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImport", reflect.TypeOf((*MockICatalogUsecase)(nil).GetImport), ctx, sellerID, id)
+}
+
+// GetImportReport mocks base method.
+func (m *MockICatalogUsecase) GetImportReport(ctx context.Context, sellerID, id uuid.UUID) ([]byte, models.CatalogFormat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImportReport", ctx, sellerID, id)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(models.CatalogFormat)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetImportReport indicates an expected call of GetImportReport.
+func (mr *MockICatalogUsecaseMockRecorder) GetImportReport(ctx, sellerID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImportReport", reflect.TypeOf((*MockICatalogUsecase)(nil).GetImportReport), ctx, sellerID, id)
+}
+
+// StartImport mocks base method.
+func (m *MockICatalogUsecase) StartImport(ctx context.Context, sellerID uuid.UUID, fileName string, data []byte) (*models.CatalogImport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartImport", ctx, sellerID, fileName, data)
+	ret0, _ := ret[0].(*models.CatalogImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartImport indicates an expected call of StartImport.
+func (mr *MockICatalogUsecaseMockRecorder) StartImport(ctx, sellerID, fileName, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartImport", reflect.TypeOf((*MockICatalogUsecase)(nil).StartImport), ctx, sellerID, fileName, data)
+}
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	dto "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 )
@@ -36,6 +37,35 @@ func (m *MockICategoryUsecase) EXPECT() *MockICategoryUsecaseMockRecorder {
 	return m.recorder
 }
 
+// CreateCategory mocks base method.
+func (m *MockICategoryUsecase) CreateCategory(ctx context.Context, req dto.CreateCategoryRequest) (*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCategory", ctx, req)
+	ret0, _ := ret[0].(*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCategory indicates an expected call of CreateCategory.
+func (mr *MockICategoryUsecaseMockRecorder) CreateCategory(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCategory", reflect.TypeOf((*MockICategoryUsecase)(nil).CreateCategory), ctx, req)
+}
+
+// DeleteCategory mocks base method.
+func (m *MockICategoryUsecase) DeleteCategory(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCategory", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCategory indicates an expected call of DeleteCategory.
+func (mr *MockICategoryUsecaseMockRecorder) DeleteCategory(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCategory", reflect.TypeOf((*MockICategoryUsecase)(nil).DeleteCategory), ctx, id)
+}
+
 // GetAllCategories mocks base method.
 func (m *MockICategoryUsecase) GetAllCategories(ctx context.Context) ([]*models.Category, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +96,51 @@ func (mr *MockICategoryUsecaseMockRecorder) GetAllSubategories(ctx, category_id
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllSubategories", reflect.TypeOf((*MockICategoryUsecase)(nil).GetAllSubategories), ctx, category_id)
 }
 
+// GetBreadcrumbs mocks base method.
+func (m *MockICategoryUsecase) GetBreadcrumbs(ctx context.Context, id uuid.UUID) (dto.BreadcrumbsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBreadcrumbs", ctx, id)
+	ret0, _ := ret[0].(dto.BreadcrumbsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBreadcrumbs indicates an expected call of GetBreadcrumbs.
+func (mr *MockICategoryUsecaseMockRecorder) GetBreadcrumbs(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBreadcrumbs", reflect.TypeOf((*MockICategoryUsecase)(nil).GetBreadcrumbs), ctx, id)
+}
+
+// GetCategoryBySlug mocks base method.
+func (m *MockICategoryUsecase) GetCategoryBySlug(ctx context.Context, slug string) (*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryBySlug", ctx, slug)
+	ret0, _ := ret[0].(*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryBySlug indicates an expected call of GetCategoryBySlug.
+func (mr *MockICategoryUsecaseMockRecorder) GetCategoryBySlug(ctx, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryBySlug", reflect.TypeOf((*MockICategoryUsecase)(nil).GetCategoryBySlug), ctx, slug)
+}
+
+// GetCategoryTree mocks base method.
+func (m *MockICategoryUsecase) GetCategoryTree(ctx context.Context) (dto.CategoryTreeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategoryTree", ctx)
+	ret0, _ := ret[0].(dto.CategoryTreeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategoryTree indicates an expected call of GetCategoryTree.
+func (mr *MockICategoryUsecaseMockRecorder) GetCategoryTree(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategoryTree", reflect.TypeOf((*MockICategoryUsecase)(nil).GetCategoryTree), ctx)
+}
+
 // GetNameSubcategory mocks base method.
 func (m *MockICategoryUsecase) GetNameSubcategory(ctx context.Context, id uuid.UUID) (string, error) {
 	m.ctrl.T.Helper()
@@ -80,3 +155,46 @@ func (mr *MockICategoryUsecaseMockRecorder) GetNameSubcategory(ctx, id interface
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNameSubcategory", reflect.TypeOf((*MockICategoryUsecase)(nil).GetNameSubcategory), ctx, id)
 }
+
+// MoveCategory mocks base method.
+func (m *MockICategoryUsecase) MoveCategory(ctx context.Context, id uuid.UUID, req dto.MoveCategoryRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveCategory", ctx, id, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveCategory indicates an expected call of MoveCategory.
+func (mr *MockICategoryUsecaseMockRecorder) MoveCategory(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveCategory", reflect.TypeOf((*MockICategoryUsecase)(nil).MoveCategory), ctx, id, req)
+}
+
+// SetCategoryImage mocks base method.
+func (m *MockICategoryUsecase) SetCategoryImage(ctx context.Context, id uuid.UUID, imageURL string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCategoryImage", ctx, id, imageURL)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCategoryImage indicates an expected call of SetCategoryImage.
+func (mr *MockICategoryUsecaseMockRecorder) SetCategoryImage(ctx, id, imageURL interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCategoryImage", reflect.TypeOf((*MockICategoryUsecase)(nil).SetCategoryImage), ctx, id, imageURL)
+}
+
+// UpdateCategory mocks base method.
+func (m *MockICategoryUsecase) UpdateCategory(ctx context.Context, id uuid.UUID, req dto.UpdateCategoryRequest) (*models.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCategory", ctx, id, req)
+	ret0, _ := ret[0].(*models.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCategory indicates an expected call of UpdateCategory.
+func (mr *MockICategoryUsecaseMockRecorder) UpdateCategory(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategory", reflect.TypeOf((*MockICategoryUsecase)(nil).UpdateCategory), ctx, id, req)
+}
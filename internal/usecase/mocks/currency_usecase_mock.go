@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: currency.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	dto "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockICurrencyUsecase is a mock of ICurrencyUsecase interface.
+type MockICurrencyUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockICurrencyUsecaseMockRecorder
+}
+
+// MockICurrencyUsecaseMockRecorder is the mock recorder for MockICurrencyUsecase.
+type MockICurrencyUsecaseMockRecorder struct {
+	mock *MockICurrencyUsecase
+}
+
+// NewMockICurrencyUsecase creates a new mock instance.
+func NewMockICurrencyUsecase(ctrl *gomock.Controller) *MockICurrencyUsecase {
+	mock := &MockICurrencyUsecase{ctrl: ctrl}
+	mock.recorder = &MockICurrencyUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICurrencyUsecase) EXPECT() *MockICurrencyUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetRates mocks base method.
+func (m *MockICurrencyUsecase) GetRates(ctx context.Context) ([]models.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRates", ctx)
+	ret0, _ := ret[0].([]models.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRates indicates an expected call of GetRates.
+func (mr *MockICurrencyUsecaseMockRecorder) GetRates(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRates", reflect.TypeOf((*MockICurrencyUsecase)(nil).GetRates), ctx)
+}
+
+// ImportRates mocks base method.
+func (m *MockICurrencyUsecase) ImportRates(ctx context.Context, data []byte) (dto.ImportRatesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportRates", ctx, data)
+	ret0, _ := ret[0].(dto.ImportRatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportRates indicates an expected call of ImportRates.
+func (mr *MockICurrencyUsecaseMockRecorder) ImportRates(ctx, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportRates", reflect.TypeOf((*MockICurrencyUsecase)(nil).ImportRates), ctx, data)
+}
+
+// SetRate mocks base method.
+func (m *MockICurrencyUsecase) SetRate(ctx context.Context, code string, req dto.SetExchangeRateRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRate", ctx, code, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRate indicates an expected call of SetRate.
+func (mr *MockICurrencyUsecaseMockRecorder) SetRate(ctx, code, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRate", reflect.TypeOf((*MockICurrencyUsecase)(nil).SetRate), ctx, code, req)
+}
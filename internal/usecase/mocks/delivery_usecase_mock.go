@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: delivery.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIDeliveryUsecase is a mock of IDeliveryUsecase interface.
+type MockIDeliveryUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDeliveryUsecaseMockRecorder
+}
+
+// MockIDeliveryUsecaseMockRecorder is the mock recorder for MockIDeliveryUsecase.
+type MockIDeliveryUsecaseMockRecorder struct {
+	mock *MockIDeliveryUsecase
+}
+
+// NewMockIDeliveryUsecase creates a new mock instance.
+func NewMockIDeliveryUsecase(ctrl *gomock.Controller) *MockIDeliveryUsecase {
+	mock := &MockIDeliveryUsecase{ctrl: ctrl}
+	mock.recorder = &MockIDeliveryUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDeliveryUsecase) EXPECT() *MockIDeliveryUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CreateSlot mocks base method.
+func (m *MockIDeliveryUsecase) CreateSlot(ctx context.Context, req dto.CreateDeliverySlotRequest) (dto.DeliverySlotDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSlot", ctx, req)
+	ret0, _ := ret[0].(dto.DeliverySlotDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSlot indicates an expected call of CreateSlot.
+func (mr *MockIDeliveryUsecaseMockRecorder) CreateSlot(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSlot", reflect.TypeOf((*MockIDeliveryUsecase)(nil).CreateSlot), ctx, req)
+}
+
+// CreateZone mocks base method.
+func (m *MockIDeliveryUsecase) CreateZone(ctx context.Context, req dto.CreateDeliveryZoneRequest) (dto.DeliveryZoneDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateZone", ctx, req)
+	ret0, _ := ret[0].(dto.DeliveryZoneDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateZone indicates an expected call of CreateZone.
+func (mr *MockIDeliveryUsecaseMockRecorder) CreateZone(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateZone", reflect.TypeOf((*MockIDeliveryUsecase)(nil).CreateZone), ctx, req)
+}
+
+// GetOptions mocks base method.
+func (m *MockIDeliveryUsecase) GetOptions(ctx context.Context, addressID uuid.UUID) (dto.DeliveryOptionsDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOptions", ctx, addressID)
+	ret0, _ := ret[0].(dto.DeliveryOptionsDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOptions indicates an expected call of GetOptions.
+func (mr *MockIDeliveryUsecaseMockRecorder) GetOptions(ctx, addressID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptions", reflect.TypeOf((*MockIDeliveryUsecase)(nil).GetOptions), ctx, addressID)
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: geocoder.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIGeocoder is a mock of IGeocoder interface.
+type MockIGeocoder struct {
+	ctrl     *gomock.Controller
+	recorder *MockIGeocoderMockRecorder
+}
+
+// MockIGeocoderMockRecorder is the mock recorder for MockIGeocoder.
+type MockIGeocoderMockRecorder struct {
+	mock *MockIGeocoder
+}
+
+// NewMockIGeocoder creates a new mock instance.
+func NewMockIGeocoder(ctrl *gomock.Controller) *MockIGeocoder {
+	mock := &MockIGeocoder{ctrl: ctrl}
+	mock.recorder = &MockIGeocoderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIGeocoder) EXPECT() *MockIGeocoderMockRecorder {
+	return m.recorder
+}
+
+// Geocode mocks base method.
+func (m *MockIGeocoder) Geocode(ctx context.Context, query string) (*models.GeocodedAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Geocode", ctx, query)
+	ret0, _ := ret[0].(*models.GeocodedAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Geocode indicates an expected call of Geocode.
+func (mr *MockIGeocoderMockRecorder) Geocode(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Geocode", reflect.TypeOf((*MockIGeocoder)(nil).Geocode), ctx, query)
+}
+
+// MockIGeocodeCache is a mock of IGeocodeCache interface.
+type MockIGeocodeCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockIGeocodeCacheMockRecorder
+}
+
+// MockIGeocodeCacheMockRecorder is the mock recorder for MockIGeocodeCache.
+type MockIGeocodeCacheMockRecorder struct {
+	mock *MockIGeocodeCache
+}
+
+// NewMockIGeocodeCache creates a new mock instance.
+func NewMockIGeocodeCache(ctrl *gomock.Controller) *MockIGeocodeCache {
+	mock := &MockIGeocodeCache{ctrl: ctrl}
+	mock.recorder = &MockIGeocodeCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIGeocodeCache) EXPECT() *MockIGeocodeCacheMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockIGeocodeCache) Get(ctx context.Context, key string) (*models.GeocodedAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].(*models.GeocodedAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockIGeocodeCacheMockRecorder) Get(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIGeocodeCache)(nil).Get), ctx, key)
+}
+
+// Set mocks base method.
+func (m *MockIGeocodeCache) Set(ctx context.Context, key string, address *models.GeocodedAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, key, address)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockIGeocodeCacheMockRecorder) Set(ctx, key, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockIGeocodeCache)(nil).Set), ctx, key, address)
+}
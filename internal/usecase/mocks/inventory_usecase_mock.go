@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: inventory.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	dto "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIInventoryUsecase is a mock of IInventoryUsecase interface.
+type MockIInventoryUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIInventoryUsecaseMockRecorder
+}
+
+// MockIInventoryUsecaseMockRecorder is the mock recorder for MockIInventoryUsecase.
+type MockIInventoryUsecaseMockRecorder struct {
+	mock *MockIInventoryUsecase
+}
+
+// NewMockIInventoryUsecase creates a new mock instance.
+func NewMockIInventoryUsecase(ctrl *gomock.Controller) *MockIInventoryUsecase {
+	mock := &MockIInventoryUsecase{ctrl: ctrl}
+	mock.recorder = &MockIInventoryUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIInventoryUsecase) EXPECT() *MockIInventoryUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AdjustStock mocks base method.
+func (m *MockIInventoryUsecase) AdjustStock(ctx context.Context, sellerID, productID uuid.UUID, req dto.StockAdjustmentRequest) (*models.StockMovement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustStock", ctx, sellerID, productID, req)
+	ret0, _ := ret[0].(*models.StockMovement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdjustStock indicates an expected call of AdjustStock.
+func (mr *MockIInventoryUsecaseMockRecorder) AdjustStock(ctx, sellerID, productID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustStock", reflect.TypeOf((*MockIInventoryUsecase)(nil).AdjustStock), ctx, sellerID, productID, req)
+}
+
+// GetDiscrepancies mocks base method.
+func (m *MockIInventoryUsecase) GetDiscrepancies(ctx context.Context, sellerID uuid.UUID) ([]models.StockDiscrepancy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDiscrepancies", ctx, sellerID)
+	ret0, _ := ret[0].([]models.StockDiscrepancy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDiscrepancies indicates an expected call of GetDiscrepancies.
+func (mr *MockIInventoryUsecaseMockRecorder) GetDiscrepancies(ctx, sellerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiscrepancies", reflect.TypeOf((*MockIInventoryUsecase)(nil).GetDiscrepancies), ctx, sellerID)
+}
+
+// GetMovements mocks base method.
+func (m *MockIInventoryUsecase) GetMovements(ctx context.Context, sellerID, productID uuid.UUID, offset int) ([]models.StockMovement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMovements", ctx, sellerID, productID, offset)
+	ret0, _ := ret[0].([]models.StockMovement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMovements indicates an expected call of GetMovements.
+func (mr *MockIInventoryUsecaseMockRecorder) GetMovements(ctx, sellerID, productID, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMovements", reflect.TypeOf((*MockIInventoryUsecase)(nil).GetMovements), ctx, sellerID, productID, offset)
+}
+
+// SetLowStockThreshold mocks base method.
+func (m *MockIInventoryUsecase) SetLowStockThreshold(ctx context.Context, sellerID, productID uuid.UUID, threshold *int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLowStockThreshold", ctx, sellerID, productID, threshold)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLowStockThreshold indicates an expected call of SetLowStockThreshold.
+func (mr *MockIInventoryUsecaseMockRecorder) SetLowStockThreshold(ctx, sellerID, productID, threshold interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLowStockThreshold", reflect.TypeOf((*MockIInventoryUsecase)(nil).SetLowStockThreshold), ctx, sellerID, productID, threshold)
+}
@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	dto "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -36,6 +37,34 @@ func (m *MockIOrderUsecase) EXPECT() *MockIOrderUsecaseMockRecorder {
 	return m.recorder
 }
 
+// CancelShipment mocks base method.
+func (m *MockIOrderUsecase) CancelShipment(ctx context.Context, shipmentID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelShipment", ctx, shipmentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelShipment indicates an expected call of CancelShipment.
+func (mr *MockIOrderUsecaseMockRecorder) CancelShipment(ctx, shipmentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelShipment", reflect.TypeOf((*MockIOrderUsecase)(nil).CancelShipment), ctx, shipmentID)
+}
+
+// ConfirmShipment mocks base method.
+func (m *MockIOrderUsecase) ConfirmShipment(ctx context.Context, shipmentID uuid.UUID, req dto.ConfirmShipmentRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmShipment", ctx, shipmentID, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmShipment indicates an expected call of ConfirmShipment.
+func (mr *MockIOrderUsecaseMockRecorder) ConfirmShipment(ctx, shipmentID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmShipment", reflect.TypeOf((*MockIOrderUsecase)(nil).ConfirmShipment), ctx, shipmentID, req)
+}
+
 // CreateOrder mocks base method.
 func (m *MockIOrderUsecase) CreateOrder(arg0 context.Context, arg1 dto.CreateOrderDTO) error {
 	m.ctrl.T.Helper()
@@ -50,6 +79,36 @@ func (mr *MockIOrderUsecaseMockRecorder) CreateOrder(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockIOrderUsecase)(nil).CreateOrder), arg0, arg1)
 }
 
+// GetInvoice mocks base method.
+func (m *MockIOrderUsecase) GetInvoice(ctx context.Context, orderID uuid.UUID) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvoice", ctx, orderID)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvoice indicates an expected call of GetInvoice.
+func (mr *MockIOrderUsecaseMockRecorder) GetInvoice(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvoice", reflect.TypeOf((*MockIOrderUsecase)(nil).GetInvoice), ctx, orderID)
+}
+
+// GetOrder mocks base method.
+func (m *MockIOrderUsecase) GetOrder(ctx context.Context, orderID uuid.UUID) (dto.OrderDetailDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrder", ctx, orderID)
+	ret0, _ := ret[0].(dto.OrderDetailDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrder indicates an expected call of GetOrder.
+func (mr *MockIOrderUsecaseMockRecorder) GetOrder(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrder", reflect.TypeOf((*MockIOrderUsecase)(nil).GetOrder), ctx, orderID)
+}
+
 // GetOrdersPlaced mocks base method.
 func (m *MockIOrderUsecase) GetOrdersPlaced(ctx context.Context) (*[]dto.OrderPreviewDTO, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +124,21 @@ func (mr *MockIOrderUsecaseMockRecorder) GetOrdersPlaced(ctx interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrdersPlaced", reflect.TypeOf((*MockIOrderUsecase)(nil).GetOrdersPlaced), ctx)
 }
 
+// GetSellerOrders mocks base method.
+func (m *MockIOrderUsecase) GetSellerOrders(ctx context.Context, offset int) ([]dto.SellerOrderDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSellerOrders", ctx, offset)
+	ret0, _ := ret[0].([]dto.SellerOrderDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSellerOrders indicates an expected call of GetSellerOrders.
+func (mr *MockIOrderUsecaseMockRecorder) GetSellerOrders(ctx, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSellerOrders", reflect.TypeOf((*MockIOrderUsecase)(nil).GetSellerOrders), ctx, offset)
+}
+
 // GetUserOrders mocks base method.
 func (m *MockIOrderUsecase) GetUserOrders(arg0 context.Context, arg1 uuid.UUID) (*[]dto.OrderPreviewDTO, error) {
 	m.ctrl.T.Helper()
@@ -93,3 +167,169 @@ func (mr *MockIOrderUsecaseMockRecorder) UpdateStatus(ctx, req interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockIOrderUsecase)(nil).UpdateStatus), ctx, req)
 }
+
+// MockIInvoiceRenderer is a mock of IInvoiceRenderer interface.
+type MockIInvoiceRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockIInvoiceRendererMockRecorder
+}
+
+// MockIInvoiceRendererMockRecorder is the mock recorder for MockIInvoiceRenderer.
+type MockIInvoiceRendererMockRecorder struct {
+	mock *MockIInvoiceRenderer
+}
+
+// NewMockIInvoiceRenderer creates a new mock instance.
+func NewMockIInvoiceRenderer(ctrl *gomock.Controller) *MockIInvoiceRenderer {
+	mock := &MockIInvoiceRenderer{ctrl: ctrl}
+	mock.recorder = &MockIInvoiceRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIInvoiceRenderer) EXPECT() *MockIInvoiceRendererMockRecorder {
+	return m.recorder
+}
+
+// Render mocks base method.
+func (m *MockIInvoiceRenderer) Render(order dto.OrderDetailDTO) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Render", order)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Render indicates an expected call of Render.
+func (mr *MockIInvoiceRendererMockRecorder) Render(order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockIInvoiceRenderer)(nil).Render), order)
+}
+
+// MockIDeliveryPlanner is a mock of IDeliveryPlanner interface.
+type MockIDeliveryPlanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDeliveryPlannerMockRecorder
+}
+
+// MockIDeliveryPlannerMockRecorder is the mock recorder for MockIDeliveryPlanner.
+type MockIDeliveryPlannerMockRecorder struct {
+	mock *MockIDeliveryPlanner
+}
+
+// NewMockIDeliveryPlanner creates a new mock instance.
+func NewMockIDeliveryPlanner(ctrl *gomock.Controller) *MockIDeliveryPlanner {
+	mock := &MockIDeliveryPlanner{ctrl: ctrl}
+	mock.recorder = &MockIDeliveryPlannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDeliveryPlanner) EXPECT() *MockIDeliveryPlannerMockRecorder {
+	return m.recorder
+}
+
+// Plan mocks base method.
+func (m *MockIDeliveryPlanner) Plan(ctx context.Context, addressID uuid.UUID, slotID *uuid.UUID) (models.DeliveryPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Plan", ctx, addressID, slotID)
+	ret0, _ := ret[0].(models.DeliveryPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Plan indicates an expected call of Plan.
+func (mr *MockIDeliveryPlannerMockRecorder) Plan(ctx, addressID, slotID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Plan", reflect.TypeOf((*MockIDeliveryPlanner)(nil).Plan), ctx, addressID, slotID)
+}
+
+// MockIWarehouseRouter is a mock of IWarehouseRouter interface.
+type MockIWarehouseRouter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWarehouseRouterMockRecorder
+}
+
+// MockIWarehouseRouterMockRecorder is the mock recorder for MockIWarehouseRouter.
+type MockIWarehouseRouterMockRecorder struct {
+	mock *MockIWarehouseRouter
+}
+
+// NewMockIWarehouseRouter creates a new mock instance.
+func NewMockIWarehouseRouter(ctrl *gomock.Controller) *MockIWarehouseRouter {
+	mock := &MockIWarehouseRouter{ctrl: ctrl}
+	mock.recorder = &MockIWarehouseRouterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWarehouseRouter) EXPECT() *MockIWarehouseRouterMockRecorder {
+	return m.recorder
+}
+
+// RouteOrder mocks base method.
+func (m *MockIWarehouseRouter) RouteOrder(ctx context.Context, orderID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RouteOrder", ctx, orderID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RouteOrder indicates an expected call of RouteOrder.
+func (mr *MockIWarehouseRouterMockRecorder) RouteOrder(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RouteOrder", reflect.TypeOf((*MockIWarehouseRouter)(nil).RouteOrder), ctx, orderID)
+}
+
+// StaffWarehouse mocks base method.
+func (m *MockIWarehouseRouter) StaffWarehouse(ctx context.Context) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StaffWarehouse", ctx)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StaffWarehouse indicates an expected call of StaffWarehouse.
+func (mr *MockIWarehouseRouterMockRecorder) StaffWarehouse(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StaffWarehouse", reflect.TypeOf((*MockIWarehouseRouter)(nil).StaffWarehouse), ctx)
+}
+
+// MockIEarningsRecorder is a mock of IEarningsRecorder interface.
+type MockIEarningsRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockIEarningsRecorderMockRecorder
+}
+
+// MockIEarningsRecorderMockRecorder is the mock recorder for MockIEarningsRecorder.
+type MockIEarningsRecorderMockRecorder struct {
+	mock *MockIEarningsRecorder
+}
+
+// NewMockIEarningsRecorder creates a new mock instance.
+func NewMockIEarningsRecorder(ctrl *gomock.Controller) *MockIEarningsRecorder {
+	mock := &MockIEarningsRecorder{ctrl: ctrl}
+	mock.recorder = &MockIEarningsRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIEarningsRecorder) EXPECT() *MockIEarningsRecorderMockRecorder {
+	return m.recorder
+}
+
+// RecordEarnings mocks base method.
+func (m *MockIEarningsRecorder) RecordEarnings(ctx context.Context, orderID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordEarnings", ctx, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordEarnings indicates an expected call of RecordEarnings.
+func (mr *MockIEarningsRecorderMockRecorder) RecordEarnings(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordEarnings", reflect.TypeOf((*MockIEarningsRecorder)(nil).RecordEarnings), ctx, orderID)
+}
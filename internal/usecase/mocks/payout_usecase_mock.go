@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: payout.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	dto "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIPayoutUsecase is a mock of IPayoutUsecase interface.
+type MockIPayoutUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPayoutUsecaseMockRecorder
+}
+
+// MockIPayoutUsecaseMockRecorder is the mock recorder for MockIPayoutUsecase.
+type MockIPayoutUsecaseMockRecorder struct {
+	mock *MockIPayoutUsecase
+}
+
+// NewMockIPayoutUsecase creates a new mock instance.
+func NewMockIPayoutUsecase(ctrl *gomock.Controller) *MockIPayoutUsecase {
+	mock := &MockIPayoutUsecase{ctrl: ctrl}
+	mock.recorder = &MockIPayoutUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPayoutUsecase) EXPECT() *MockIPayoutUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetBalance mocks base method.
+func (m *MockIPayoutUsecase) GetBalance(ctx context.Context, sellerID uuid.UUID) (dto.SellerBalanceDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", ctx, sellerID)
+	ret0, _ := ret[0].(dto.SellerBalanceDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockIPayoutUsecaseMockRecorder) GetBalance(ctx, sellerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockIPayoutUsecase)(nil).GetBalance), ctx, sellerID)
+}
+
+// GetStatement mocks base method.
+func (m *MockIPayoutUsecase) GetStatement(ctx context.Context, sellerID, statementID uuid.UUID) (dto.PayoutStatementDetailDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatement", ctx, sellerID, statementID)
+	ret0, _ := ret[0].(dto.PayoutStatementDetailDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatement indicates an expected call of GetStatement.
+func (mr *MockIPayoutUsecaseMockRecorder) GetStatement(ctx, sellerID, statementID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatement", reflect.TypeOf((*MockIPayoutUsecase)(nil).GetStatement), ctx, sellerID, statementID)
+}
+
+// GetStatements mocks base method.
+func (m *MockIPayoutUsecase) GetStatements(ctx context.Context, sellerID uuid.UUID, offset int) ([]models.PayoutStatement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatements", ctx, sellerID, offset)
+	ret0, _ := ret[0].([]models.PayoutStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatements indicates an expected call of GetStatements.
+func (mr *MockIPayoutUsecaseMockRecorder) GetStatements(ctx, sellerID, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatements", reflect.TypeOf((*MockIPayoutUsecase)(nil).GetStatements), ctx, sellerID, offset)
+}
+
+// MarkStatementPaid mocks base method.
+func (m *MockIPayoutUsecase) MarkStatementPaid(ctx context.Context, statementID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkStatementPaid", ctx, statementID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkStatementPaid indicates an expected call of MarkStatementPaid.
+func (mr *MockIPayoutUsecaseMockRecorder) MarkStatementPaid(ctx, statementID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkStatementPaid", reflect.TypeOf((*MockIPayoutUsecase)(nil).MarkStatementPaid), ctx, statementID)
+}
+
+// SetCategoryCommission mocks base method.
+func (m *MockIPayoutUsecase) SetCategoryCommission(ctx context.Context, categoryID uuid.UUID, req dto.SetCategoryCommissionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCategoryCommission", ctx, categoryID, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCategoryCommission indicates an expected call of SetCategoryCommission.
+func (mr *MockIPayoutUsecaseMockRecorder) SetCategoryCommission(ctx, categoryID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCategoryCommission", reflect.TypeOf((*MockIPayoutUsecase)(nil).SetCategoryCommission), ctx, categoryID, req)
+}
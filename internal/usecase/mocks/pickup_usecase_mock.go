@@ -0,0 +1,140 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pickup.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	dto "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIPickupUsecase is a mock of IPickupUsecase interface.
+type MockIPickupUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPickupUsecaseMockRecorder
+}
+
+// MockIPickupUsecaseMockRecorder is the mock recorder for MockIPickupUsecase.
+type MockIPickupUsecaseMockRecorder struct {
+	mock *MockIPickupUsecase
+}
+
+// NewMockIPickupUsecase creates a new mock instance.
+func NewMockIPickupUsecase(ctrl *gomock.Controller) *MockIPickupUsecase {
+	mock := &MockIPickupUsecase{ctrl: ctrl}
+	mock.recorder = &MockIPickupUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPickupUsecase) EXPECT() *MockIPickupUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AddFavorite mocks base method.
+func (m *MockIPickupUsecase) AddFavorite(ctx context.Context, pointID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFavorite", ctx, pointID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddFavorite indicates an expected call of AddFavorite.
+func (mr *MockIPickupUsecaseMockRecorder) AddFavorite(ctx, pointID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFavorite", reflect.TypeOf((*MockIPickupUsecase)(nil).AddFavorite), ctx, pointID)
+}
+
+// CreatePickupPoint mocks base method.
+func (m *MockIPickupUsecase) CreatePickupPoint(ctx context.Context, req dto.CreatePickupPointRequest) (dto.PickupPointDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePickupPoint", ctx, req)
+	ret0, _ := ret[0].(dto.PickupPointDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePickupPoint indicates an expected call of CreatePickupPoint.
+func (mr *MockIPickupUsecaseMockRecorder) CreatePickupPoint(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePickupPoint", reflect.TypeOf((*MockIPickupUsecase)(nil).CreatePickupPoint), ctx, req)
+}
+
+// DeletePickupPoint mocks base method.
+func (m *MockIPickupUsecase) DeletePickupPoint(ctx context.Context, pointID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePickupPoint", ctx, pointID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePickupPoint indicates an expected call of DeletePickupPoint.
+func (mr *MockIPickupUsecaseMockRecorder) DeletePickupPoint(ctx, pointID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePickupPoint", reflect.TypeOf((*MockIPickupUsecase)(nil).DeletePickupPoint), ctx, pointID)
+}
+
+// GetFavorites mocks base method.
+func (m *MockIPickupUsecase) GetFavorites(ctx context.Context) (dto.PickupPointsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFavorites", ctx)
+	ret0, _ := ret[0].(dto.PickupPointsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFavorites indicates an expected call of GetFavorites.
+func (mr *MockIPickupUsecaseMockRecorder) GetFavorites(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavorites", reflect.TypeOf((*MockIPickupUsecase)(nil).GetFavorites), ctx)
+}
+
+// GetNearest mocks base method.
+func (m *MockIPickupUsecase) GetNearest(ctx context.Context, from models.Coordinate, limit int) (dto.PickupPointsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNearest", ctx, from, limit)
+	ret0, _ := ret[0].(dto.PickupPointsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNearest indicates an expected call of GetNearest.
+func (mr *MockIPickupUsecaseMockRecorder) GetNearest(ctx, from, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNearest", reflect.TypeOf((*MockIPickupUsecase)(nil).GetNearest), ctx, from, limit)
+}
+
+// RemoveFavorite mocks base method.
+func (m *MockIPickupUsecase) RemoveFavorite(ctx context.Context, pointID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFavorite", ctx, pointID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveFavorite indicates an expected call of RemoveFavorite.
+func (mr *MockIPickupUsecaseMockRecorder) RemoveFavorite(ctx, pointID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFavorite", reflect.TypeOf((*MockIPickupUsecase)(nil).RemoveFavorite), ctx, pointID)
+}
+
+// UpdatePickupPoint mocks base method.
+func (m *MockIPickupUsecase) UpdatePickupPoint(ctx context.Context, pointID uuid.UUID, req dto.UpdatePickupPointRequest) (dto.PickupPointDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePickupPoint", ctx, pointID, req)
+	ret0, _ := ret[0].(dto.PickupPointDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePickupPoint indicates an expected call of UpdatePickupPoint.
+func (mr *MockIPickupUsecaseMockRecorder) UpdatePickupPoint(ctx, pointID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePickupPoint", reflect.TypeOf((*MockIPickupUsecase)(nil).UpdatePickupPoint), ctx, pointID, req)
+}
@@ -82,18 +82,18 @@ func (mr *MockIProductUsecaseMockRecorder) GetProductByID(ctx, id interface{}) *
 }
 
 // GetProductsByCategory mocks base method.
-func (m *MockIProductUsecase) GetProductsByCategory(ctx context.Context, id uuid.UUID, offset int, minPrice, maxPrice float64, minRating float32, sortOption models.SortOption) ([]*models.Product, error) {
+func (m *MockIProductUsecase) GetProductsByCategory(ctx context.Context, id uuid.UUID, includeDescendants bool, offset int, minPrice, maxPrice models.Money, minRating float32, sortOption models.SortOption) ([]*models.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProductsByCategory", ctx, id, offset, minPrice, maxPrice, minRating, sortOption)
+	ret := m.ctrl.Call(m, "GetProductsByCategory", ctx, id, includeDescendants, offset, minPrice, maxPrice, minRating, sortOption)
 	ret0, _ := ret[0].([]*models.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetProductsByCategory indicates an expected call of GetProductsByCategory.
-func (mr *MockIProductUsecaseMockRecorder) GetProductsByCategory(ctx, id, offset, minPrice, maxPrice, minRating, sortOption interface{}) *gomock.Call {
+func (mr *MockIProductUsecaseMockRecorder) GetProductsByCategory(ctx, id, includeDescendants, offset, minPrice, maxPrice, minRating, sortOption interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsByCategory", reflect.TypeOf((*MockIProductUsecase)(nil).GetProductsByCategory), ctx, id, offset, minPrice, maxPrice, minRating, sortOption)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsByCategory", reflect.TypeOf((*MockIProductUsecase)(nil).GetProductsByCategory), ctx, id, includeDescendants, offset, minPrice, maxPrice, minRating, sortOption)
 }
 
 // GetProductsByIDs mocks base method.
@@ -8,8 +8,10 @@ import (
 	context "context"
 	reflect "reflect"
 
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	dto "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
 )
 
 // MockIPromoUsecase is a mock of IPromoUsecase interface.
@@ -50,6 +52,21 @@ func (mr *MockIPromoUsecaseMockRecorder) CheckPromoCode(ctx, code interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPromoCode", reflect.TypeOf((*MockIPromoUsecase)(nil).CheckPromoCode), ctx, code)
 }
 
+// CreateCampaign mocks base method.
+func (m *MockIPromoUsecase) CreateCampaign(ctx context.Context, req dto.CreatePromoCampaignRequest) (dto.PromoCampaignResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCampaign", ctx, req)
+	ret0, _ := ret[0].(dto.PromoCampaignResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCampaign indicates an expected call of CreateCampaign.
+func (mr *MockIPromoUsecaseMockRecorder) CreateCampaign(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCampaign", reflect.TypeOf((*MockIPromoUsecase)(nil).CreateCampaign), ctx, req)
+}
+
 // CreatePromo mocks base method.
 func (m *MockIPromoUsecase) CreatePromo(ctx context.Context, req dto.CreatePromoRequest) (dto.PromoResponse, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +82,35 @@ func (mr *MockIPromoUsecaseMockRecorder) CreatePromo(ctx, req interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePromo", reflect.TypeOf((*MockIPromoUsecase)(nil).CreatePromo), ctx, req)
 }
 
+// DeactivateCampaign mocks base method.
+func (m *MockIPromoUsecase) DeactivateCampaign(ctx context.Context, campaignID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateCampaign", ctx, campaignID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeactivateCampaign indicates an expected call of DeactivateCampaign.
+func (mr *MockIPromoUsecaseMockRecorder) DeactivateCampaign(ctx, campaignID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateCampaign", reflect.TypeOf((*MockIPromoUsecase)(nil).DeactivateCampaign), ctx, campaignID)
+}
+
+// ExportCampaignCodes mocks base method.
+func (m *MockIPromoUsecase) ExportCampaignCodes(ctx context.Context, campaignID uuid.UUID) ([]models.PromoCampaignCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportCampaignCodes", ctx, campaignID)
+	ret0, _ := ret[0].([]models.PromoCampaignCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportCampaignCodes indicates an expected call of ExportCampaignCodes.
+func (mr *MockIPromoUsecaseMockRecorder) ExportCampaignCodes(ctx, campaignID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCampaignCodes", reflect.TypeOf((*MockIPromoUsecase)(nil).ExportCampaignCodes), ctx, campaignID)
+}
+
 // GetAllPromos mocks base method.
 func (m *MockIPromoUsecase) GetAllPromos(ctx context.Context, offset int) (dto.PromosResponse, error) {
 	m.ctrl.T.Helper()
@@ -79,3 +125,18 @@ func (mr *MockIPromoUsecaseMockRecorder) GetAllPromos(ctx, offset interface{}) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPromos", reflect.TypeOf((*MockIPromoUsecase)(nil).GetAllPromos), ctx, offset)
 }
+
+// GetCampaignStats mocks base method.
+func (m *MockIPromoUsecase) GetCampaignStats(ctx context.Context, campaignID uuid.UUID) (dto.PromoCampaignStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCampaignStats", ctx, campaignID)
+	ret0, _ := ret[0].(dto.PromoCampaignStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCampaignStats indicates an expected call of GetCampaignStats.
+func (mr *MockIPromoUsecaseMockRecorder) GetCampaignStats(ctx, campaignID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCampaignStats", reflect.TypeOf((*MockIPromoUsecase)(nil).GetCampaignStats), ctx, campaignID)
+}
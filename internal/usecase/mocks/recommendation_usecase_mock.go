@@ -36,6 +36,21 @@ func (m *MockIRecommendationUsecase) EXPECT() *MockIRecommendationUsecaseMockRec
 	return m.recorder
 }
 
+// GetPersonalRecommendations mocks base method.
+func (m *MockIRecommendationUsecase) GetPersonalRecommendations(ctx context.Context) ([]*models.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonalRecommendations", ctx)
+	ret0, _ := ret[0].([]*models.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonalRecommendations indicates an expected call of GetPersonalRecommendations.
+func (mr *MockIRecommendationUsecaseMockRecorder) GetPersonalRecommendations(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalRecommendations", reflect.TypeOf((*MockIRecommendationUsecase)(nil).GetPersonalRecommendations), ctx)
+}
+
 // GetRecommendations mocks base method.
 func (m *MockIRecommendationUsecase) GetRecommendations(ctx context.Context, productID uuid.UUID) ([]*models.Product, error) {
 	m.ctrl.T.Helper()
@@ -50,3 +65,55 @@ func (mr *MockIRecommendationUsecaseMockRecorder) GetRecommendations(ctx, produc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecommendations", reflect.TypeOf((*MockIRecommendationUsecase)(nil).GetRecommendations), ctx, productID)
 }
+
+// MockIRecommendationCache is a mock of IRecommendationCache interface.
+type MockIRecommendationCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRecommendationCacheMockRecorder
+}
+
+// MockIRecommendationCacheMockRecorder is the mock recorder for MockIRecommendationCache.
+type MockIRecommendationCacheMockRecorder struct {
+	mock *MockIRecommendationCache
+}
+
+// NewMockIRecommendationCache creates a new mock instance.
+func NewMockIRecommendationCache(ctrl *gomock.Controller) *MockIRecommendationCache {
+	mock := &MockIRecommendationCache{ctrl: ctrl}
+	mock.recorder = &MockIRecommendationCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRecommendationCache) EXPECT() *MockIRecommendationCacheMockRecorder {
+	return m.recorder
+}
+
+// GetProductIDs mocks base method.
+func (m *MockIRecommendationCache) GetProductIDs(ctx context.Context, key string) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductIDs", ctx, key)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductIDs indicates an expected call of GetProductIDs.
+func (mr *MockIRecommendationCacheMockRecorder) GetProductIDs(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductIDs", reflect.TypeOf((*MockIRecommendationCache)(nil).GetProductIDs), ctx, key)
+}
+
+// SetProductIDs mocks base method.
+func (m *MockIRecommendationCache) SetProductIDs(ctx context.Context, key string, ids []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProductIDs", ctx, key, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProductIDs indicates an expected call of SetProductIDs.
+func (mr *MockIRecommendationCacheMockRecorder) SetProductIDs(ctx, key, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProductIDs", reflect.TypeOf((*MockIRecommendationCache)(nil).SetProductIDs), ctx, key, ids)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: reservation.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIReservationUsecase is a mock of IReservationUsecase interface.
+type MockIReservationUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReservationUsecaseMockRecorder
+}
+
+// MockIReservationUsecaseMockRecorder is the mock recorder for MockIReservationUsecase.
+type MockIReservationUsecaseMockRecorder struct {
+	mock *MockIReservationUsecase
+}
+
+// NewMockIReservationUsecase creates a new mock instance.
+func NewMockIReservationUsecase(ctrl *gomock.Controller) *MockIReservationUsecase {
+	mock := &MockIReservationUsecase{ctrl: ctrl}
+	mock.recorder = &MockIReservationUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReservationUsecase) EXPECT() *MockIReservationUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Hold mocks base method.
+func (m *MockIReservationUsecase) Hold(ctx context.Context, req dto.CheckoutRequest) (dto.CheckoutResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hold", ctx, req)
+	ret0, _ := ret[0].(dto.CheckoutResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Hold indicates an expected call of Hold.
+func (mr *MockIReservationUsecaseMockRecorder) Hold(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hold", reflect.TypeOf((*MockIReservationUsecase)(nil).Hold), ctx, req)
+}
@@ -0,0 +1,106 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: screening.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIReviewScreener is a mock of IReviewScreener interface.
+type MockIReviewScreener struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReviewScreenerMockRecorder
+}
+
+// MockIReviewScreenerMockRecorder is the mock recorder for MockIReviewScreener.
+type MockIReviewScreenerMockRecorder struct {
+	mock *MockIReviewScreener
+}
+
+// NewMockIReviewScreener creates a new mock instance.
+func NewMockIReviewScreener(ctrl *gomock.Controller) *MockIReviewScreener {
+	mock := &MockIReviewScreener{ctrl: ctrl}
+	mock.recorder = &MockIReviewScreenerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReviewScreener) EXPECT() *MockIReviewScreenerMockRecorder {
+	return m.recorder
+}
+
+// Screen mocks base method.
+func (m *MockIReviewScreener) Screen(ctx context.Context, review models.ReviewDB) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Screen", ctx, review)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Screen indicates an expected call of Screen.
+func (mr *MockIReviewScreenerMockRecorder) Screen(ctx, review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Screen", reflect.TypeOf((*MockIReviewScreener)(nil).Screen), ctx, review)
+}
+
+// MockIScreeningRepository is a mock of IScreeningRepository interface.
+type MockIScreeningRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIScreeningRepositoryMockRecorder
+}
+
+// MockIScreeningRepositoryMockRecorder is the mock recorder for MockIScreeningRepository.
+type MockIScreeningRepositoryMockRecorder struct {
+	mock *MockIScreeningRepository
+}
+
+// NewMockIScreeningRepository creates a new mock instance.
+func NewMockIScreeningRepository(ctrl *gomock.Controller) *MockIScreeningRepository {
+	mock := &MockIScreeningRepository{ctrl: ctrl}
+	mock.recorder = &MockIScreeningRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIScreeningRepository) EXPECT() *MockIScreeningRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountUserReviewsSince mocks base method.
+func (m *MockIScreeningRepository) CountUserReviewsSince(ctx context.Context, userID, excludeID uuid.UUID, since time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUserReviewsSince", ctx, userID, excludeID, since)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUserReviewsSince indicates an expected call of CountUserReviewsSince.
+func (mr *MockIScreeningRepositoryMockRecorder) CountUserReviewsSince(ctx, userID, excludeID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUserReviewsSince", reflect.TypeOf((*MockIScreeningRepository)(nil).CountUserReviewsSince), ctx, userID, excludeID, since)
+}
+
+// HasDuplicateReview mocks base method.
+func (m *MockIScreeningRepository) HasDuplicateReview(ctx context.Context, review models.ReviewDB) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasDuplicateReview", ctx, review)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasDuplicateReview indicates an expected call of HasDuplicateReview.
+func (mr *MockIScreeningRepositoryMockRecorder) HasDuplicateReview(ctx, review interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasDuplicateReview", reflect.TypeOf((*MockIScreeningRepository)(nil).HasDuplicateReview), ctx, review)
+}
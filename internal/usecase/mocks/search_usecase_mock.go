@@ -37,6 +37,21 @@ func (m *MockISearchUsecase) EXPECT() *MockISearchUsecaseMockRecorder {
 	return m.recorder
 }
 
+// GetAttributeFacets mocks base method.
+func (m *MockISearchUsecase) GetAttributeFacets(ctx context.Context, categoryID null.String, subString string, minPrice, maxPrice models.Money, minRating float32, attributes []models.AttributeFilter) ([]models.AttributeFacet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttributeFacets", ctx, categoryID, subString, minPrice, maxPrice, minRating, attributes)
+	ret0, _ := ret[0].([]models.AttributeFacet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttributeFacets indicates an expected call of GetAttributeFacets.
+func (mr *MockISearchUsecaseMockRecorder) GetAttributeFacets(ctx, categoryID, subString, minPrice, maxPrice, minRating, attributes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttributeFacets", reflect.TypeOf((*MockISearchUsecase)(nil).GetAttributeFacets), ctx, categoryID, subString, minPrice, maxPrice, minRating, attributes)
+}
+
 // SearchCategoryByName mocks base method.
 func (m *MockISearchUsecase) SearchCategoryByName(arg0 context.Context, arg1 dto.CategoryNameResponse) ([]*models.Category, error) {
 	m.ctrl.T.Helper()
@@ -53,16 +68,16 @@ func (mr *MockISearchUsecaseMockRecorder) SearchCategoryByName(arg0, arg1 interf
 }
 
 // SearchProductsByNameWithFilterAndSort mocks base method.
-func (m *MockISearchUsecase) SearchProductsByNameWithFilterAndSort(ctx context.Context, categoryID null.String, subString string, offset int, minPrice, maxPrice float64, minRating float32, sortOption models.SortOption) ([]*models.Product, error) {
+func (m *MockISearchUsecase) SearchProductsByNameWithFilterAndSort(ctx context.Context, categoryID null.String, subString string, offset int, minPrice, maxPrice models.Money, minRating float32, attributes []models.AttributeFilter, sortOption models.SortOption) ([]*models.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchProductsByNameWithFilterAndSort", ctx, categoryID, subString, offset, minPrice, maxPrice, minRating, sortOption)
+	ret := m.ctrl.Call(m, "SearchProductsByNameWithFilterAndSort", ctx, categoryID, subString, offset, minPrice, maxPrice, minRating, attributes, sortOption)
 	ret0, _ := ret[0].([]*models.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchProductsByNameWithFilterAndSort indicates an expected call of SearchProductsByNameWithFilterAndSort.
-func (mr *MockISearchUsecaseMockRecorder) SearchProductsByNameWithFilterAndSort(ctx, categoryID, subString, offset, minPrice, maxPrice, minRating, sortOption interface{}) *gomock.Call {
+func (mr *MockISearchUsecaseMockRecorder) SearchProductsByNameWithFilterAndSort(ctx, categoryID, subString, offset, minPrice, maxPrice, minRating, attributes, sortOption interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchProductsByNameWithFilterAndSort", reflect.TypeOf((*MockISearchUsecase)(nil).SearchProductsByNameWithFilterAndSort), ctx, categoryID, subString, offset, minPrice, maxPrice, minRating, sortOption)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchProductsByNameWithFilterAndSort", reflect.TypeOf((*MockISearchUsecase)(nil).SearchProductsByNameWithFilterAndSort), ctx, categoryID, subString, offset, minPrice, maxPrice, minRating, attributes, sortOption)
 }
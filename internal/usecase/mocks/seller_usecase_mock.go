@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	dto "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
 )
@@ -37,18 +38,33 @@ func (m *MockISellerUsecase) EXPECT() *MockISellerUsecaseMockRecorder {
 }
 
 // AddProduct mocks base method.
-func (m *MockISellerUsecase) AddProduct(ctx context.Context, product *models.Product, categoryID uuid.UUID) (*models.Product, error) {
+func (m *MockISellerUsecase) AddProduct(ctx context.Context, product *models.Product, categoryID uuid.UUID, attributes []models.ProductAttribute) (*models.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddProduct", ctx, product, categoryID)
+	ret := m.ctrl.Call(m, "AddProduct", ctx, product, categoryID, attributes)
 	ret0, _ := ret[0].(*models.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddProduct indicates an expected call of AddProduct.
-func (mr *MockISellerUsecaseMockRecorder) AddProduct(ctx, product, categoryID interface{}) *gomock.Call {
+func (mr *MockISellerUsecaseMockRecorder) AddProduct(ctx, product, categoryID, attributes interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProduct", reflect.TypeOf((*MockISellerUsecase)(nil).AddProduct), ctx, product, categoryID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProduct", reflect.TypeOf((*MockISellerUsecase)(nil).AddProduct), ctx, product, categoryID, attributes)
+}
+
+// AddVariant mocks base method.
+func (m *MockISellerUsecase) AddVariant(ctx context.Context, sellerID, productID uuid.UUID, req dto.AddVariantRequest) (*models.ProductVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddVariant", ctx, sellerID, productID, req)
+	ret0, _ := ret[0].(*models.ProductVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddVariant indicates an expected call of AddVariant.
+func (mr *MockISellerUsecaseMockRecorder) AddVariant(ctx, sellerID, productID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVariant", reflect.TypeOf((*MockISellerUsecase)(nil).AddVariant), ctx, sellerID, productID, req)
 }
 
 // CheckProductBelongs mocks base method.
@@ -66,6 +82,20 @@ func (mr *MockISellerUsecaseMockRecorder) CheckProductBelongs(ctx, productID, se
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckProductBelongs", reflect.TypeOf((*MockISellerUsecase)(nil).CheckProductBelongs), ctx, productID, sellerID)
 }
 
+// DeleteVariant mocks base method.
+func (m *MockISellerUsecase) DeleteVariant(ctx context.Context, sellerID, variantID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVariant", ctx, sellerID, variantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVariant indicates an expected call of DeleteVariant.
+func (mr *MockISellerUsecaseMockRecorder) DeleteVariant(ctx, sellerID, variantID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVariant", reflect.TypeOf((*MockISellerUsecase)(nil).DeleteVariant), ctx, sellerID, variantID)
+}
+
 // GetSellerProducts mocks base method.
 func (m *MockISellerUsecase) GetSellerProducts(ctx context.Context, sellerID uuid.UUID, offset int) ([]*models.Product, error) {
 	m.ctrl.T.Helper()
@@ -81,6 +111,21 @@ func (mr *MockISellerUsecaseMockRecorder) GetSellerProducts(ctx, sellerID, offse
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSellerProducts", reflect.TypeOf((*MockISellerUsecase)(nil).GetSellerProducts), ctx, sellerID, offset)
 }
 
+// UpdateVariant mocks base method.
+func (m *MockISellerUsecase) UpdateVariant(ctx context.Context, sellerID, variantID uuid.UUID, req dto.UpdateVariantRequest) (*models.ProductVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVariant", ctx, sellerID, variantID, req)
+	ret0, _ := ret[0].(*models.ProductVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVariant indicates an expected call of UpdateVariant.
+func (mr *MockISellerUsecaseMockRecorder) UpdateVariant(ctx, sellerID, variantID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVariant", reflect.TypeOf((*MockISellerUsecase)(nil).UpdateVariant), ctx, sellerID, variantID, req)
+}
+
 // UploadProductImage mocks base method.
 func (m *MockISellerUsecase) UploadProductImage(ctx context.Context, productID uuid.UUID, imageURL string) error {
 	m.ctrl.T.Helper()
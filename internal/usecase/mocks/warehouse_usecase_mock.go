@@ -0,0 +1,141 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: warehouse.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	dto "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockIWarehouseUsecase is a mock of IWarehouseUsecase interface.
+type MockIWarehouseUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIWarehouseUsecaseMockRecorder
+}
+
+// MockIWarehouseUsecaseMockRecorder is the mock recorder for MockIWarehouseUsecase.
+type MockIWarehouseUsecaseMockRecorder struct {
+	mock *MockIWarehouseUsecase
+}
+
+// NewMockIWarehouseUsecase creates a new mock instance.
+func NewMockIWarehouseUsecase(ctrl *gomock.Controller) *MockIWarehouseUsecase {
+	mock := &MockIWarehouseUsecase{ctrl: ctrl}
+	mock.recorder = &MockIWarehouseUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIWarehouseUsecase) EXPECT() *MockIWarehouseUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AssignStaff mocks base method.
+func (m *MockIWarehouseUsecase) AssignStaff(ctx context.Context, userID uuid.UUID, req dto.AssignWarehouseStaffRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignStaff", ctx, userID, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignStaff indicates an expected call of AssignStaff.
+func (mr *MockIWarehouseUsecaseMockRecorder) AssignStaff(ctx, userID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignStaff", reflect.TypeOf((*MockIWarehouseUsecase)(nil).AssignStaff), ctx, userID, req)
+}
+
+// CreateWarehouse mocks base method.
+func (m *MockIWarehouseUsecase) CreateWarehouse(ctx context.Context, req dto.CreateWarehouseRequest) (dto.WarehouseDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWarehouse", ctx, req)
+	ret0, _ := ret[0].(dto.WarehouseDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWarehouse indicates an expected call of CreateWarehouse.
+func (mr *MockIWarehouseUsecaseMockRecorder) CreateWarehouse(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWarehouse", reflect.TypeOf((*MockIWarehouseUsecase)(nil).CreateWarehouse), ctx, req)
+}
+
+// GetPickList mocks base method.
+func (m *MockIWarehouseUsecase) GetPickList(ctx context.Context) ([]models.PickListItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPickList", ctx)
+	ret0, _ := ret[0].([]models.PickListItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPickList indicates an expected call of GetPickList.
+func (mr *MockIWarehouseUsecaseMockRecorder) GetPickList(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPickList", reflect.TypeOf((*MockIWarehouseUsecase)(nil).GetPickList), ctx)
+}
+
+// GetStock mocks base method.
+func (m *MockIWarehouseUsecase) GetStock(ctx context.Context, offset int) ([]models.WarehouseStock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStock", ctx, offset)
+	ret0, _ := ret[0].([]models.WarehouseStock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStock indicates an expected call of GetStock.
+func (mr *MockIWarehouseUsecaseMockRecorder) GetStock(ctx, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStock", reflect.TypeOf((*MockIWarehouseUsecase)(nil).GetStock), ctx, offset)
+}
+
+// GetWarehouses mocks base method.
+func (m *MockIWarehouseUsecase) GetWarehouses(ctx context.Context) ([]dto.WarehouseDTO, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWarehouses", ctx)
+	ret0, _ := ret[0].([]dto.WarehouseDTO)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWarehouses indicates an expected call of GetWarehouses.
+func (mr *MockIWarehouseUsecaseMockRecorder) GetWarehouses(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWarehouses", reflect.TypeOf((*MockIWarehouseUsecase)(nil).GetWarehouses), ctx)
+}
+
+// RouteOrder mocks base method.
+func (m *MockIWarehouseUsecase) RouteOrder(ctx context.Context, orderID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RouteOrder", ctx, orderID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RouteOrder indicates an expected call of RouteOrder.
+func (mr *MockIWarehouseUsecaseMockRecorder) RouteOrder(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RouteOrder", reflect.TypeOf((*MockIWarehouseUsecase)(nil).RouteOrder), ctx, orderID)
+}
+
+// SetStock mocks base method.
+func (m *MockIWarehouseUsecase) SetStock(ctx context.Context, req dto.SetWarehouseStockRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStock", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStock indicates an expected call of SetStock.
+func (mr *MockIWarehouseUsecaseMockRecorder) SetStock(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStock", reflect.TypeOf((*MockIWarehouseUsecase)(nil).SetStock), ctx, req)
+}
@@ -5,15 +5,16 @@ import (
 	"errors"
 	"fmt"
 	"sync"
-	"time"
 
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/notification"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/order"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/domains"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
-	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/promo"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/helpers"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/pricing"
 	"github.com/google/uuid"
 	"github.com/guregu/null"
 )
@@ -24,186 +25,187 @@ type IOrderUsecase interface {
 	GetUserOrders(context.Context, uuid.UUID) (*[]dto.OrderPreviewDTO, error)
 	UpdateStatus(ctx context.Context, req dto.UpdateOrderStatusRequest) error
 	GetOrdersPlaced(ctx context.Context) (*[]dto.OrderPreviewDTO, error)
+	GetSellerOrders(ctx context.Context, offset int) ([]dto.SellerOrderDTO, error)
+	ConfirmShipment(ctx context.Context, shipmentID uuid.UUID, req dto.ConfirmShipmentRequest) error
+	CancelShipment(ctx context.Context, shipmentID uuid.UUID) error
+	GetOrder(ctx context.Context, orderID uuid.UUID) (dto.OrderDetailDTO, error)
+	GetInvoice(ctx context.Context, orderID uuid.UUID) ([]byte, error)
+}
+
+// IInvoiceRenderer собирает печатный счёт по заказу
+type IInvoiceRenderer interface {
+	Render(order dto.OrderDetailDTO) ([]byte, error)
+}
+
+// IDeliveryPlanner рассчитывает ожидаемую дату доставки и проверяет выбранный интервал
+type IDeliveryPlanner interface {
+	Plan(ctx context.Context, addressID uuid.UUID, slotID *uuid.UUID) (models.DeliveryPlan, error)
+}
+
+// IWarehouseRouter направляет заказы на склады и определяет склад работника
+type IWarehouseRouter interface {
+	RouteOrder(ctx context.Context, orderID uuid.UUID) (uuid.UUID, error)
+	StaffWarehouse(ctx context.Context) (uuid.UUID, error)
+}
+
+// IEarningsRecorder начисляет продавцам за позиции доставленного заказа
+type IEarningsRecorder interface {
+	RecordEarnings(ctx context.Context, orderID uuid.UUID) error
+}
+
+// Роли, которым доступны счета любых заказов
+var invoiceRoles = map[string]struct{}{
+	"admin":        {},
+	"warehouseman": {},
 }
 
 type OrderUsecase struct {
 	repo order.IOrderRepository
-	promoRepo promo.IPromoRepository
+	pricing *pricing.Engine
 	notificationRepo notification.INotificationRepository
+	invoice IInvoiceRenderer
+	delivery IDeliveryPlanner
+	warehouses IWarehouseRouter
+	earnings IEarningsRecorder
 }
 
 func NewOrderUsecase(
     repo order.IOrderRepository,
-    promoRepo promo.IPromoRepository,
+    pricing *pricing.Engine,
 	notificationRepo notification.INotificationRepository,
+	invoice IInvoiceRenderer,
+	delivery IDeliveryPlanner,
+	warehouses IWarehouseRouter,
+	earnings IEarningsRecorder,
 ) *OrderUsecase {
     return &OrderUsecase{
         repo:      repo,
-        promoRepo: promoRepo,
+        pricing:   pricing,
 		notificationRepo: notificationRepo,
+		invoice:   invoice,
+		delivery:  delivery,
+		warehouses: warehouses,
+		earnings:  earnings,
     }
 }
 
 func (u *OrderUsecase) CreateOrder(ctx context.Context, in dto.CreateOrderDTO) error {
 	const op = "OrderUsecase.CreateOrder"
 	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("user_id", in.UserID)
-	
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-
-	orderItems := make([]dto.CreateOrderItemDTO, len(in.Items))
-	now := time.Now()
-
-	var totalPrice float64 = 0
-	var totalDiscountedPrice float64 = 0
-
-	newQuantities := make(map[uuid.UUID]uint)
-
-	mu := &sync.Mutex{}
-	var totalWg sync.WaitGroup
-	errCh := make(chan error, 1)
 
+	pricingItems := make([]models.PricingItem, len(in.Items))
 	for i, item := range in.Items {
-		item.ID = uuid.New()
-		orderItems[i] = item
-
-		totalWg.Add(1)
-		go func(i int, item dto.CreateOrderItemDTO) {
-			defer totalWg.Done()
-
-			var innerWg sync.WaitGroup
-			innerWg.Add(2)
-
-			var (
-				product     *models.Product
-				productErr  error
-				discounts   []models.ProductDiscount
-				discountErr error
-			)
-
-			// Получаем статус количество и цену товара
-			go func() {
-				defer innerWg.Done()
-				if ctx.Err() != nil {
-					return
-				}
-
-				product, productErr = u.repo.ProductPrice(ctx, item.ProductID)
-				if productErr != nil {
-					logger.WithError(productErr).
-						WithField("product_id", item.ProductID).
-						Error("failed to fetch product price")
-					trySendError(productErr, errCh, cancel)
-					return
-				}
-			}()
-
-			// Получаем скидку товара, если она есть
-			go func() {
-				defer innerWg.Done()
-				if ctx.Err() != nil {
-					return
-				}
-
-				discounts, discountErr = u.repo.ProductDiscounts(ctx, item.ProductID)
-				if discountErr != nil && !errors.Is(discountErr, errs.ErrNotFound) {
-					logger.WithError(discountErr).
-						WithField("product_id", item.ProductID).
-						Error("failed to fetch product discount")
-					trySendError(discountErr, errCh, cancel)
-					return
-				}
-			}()
-
-			innerWg.Wait()
-
-			// Проверяем отмену после запросов
-			if ctx.Err() != nil {
-				return
-			}
-
-			if product.Status != models.ProductApproved {
-				logger.WithFields(map[string]interface{}{
-					"product_id":      item.ProductID,
-					"status":          product.Status,
-					"required_status": models.ProductApproved,
-				}).Warn("product not approved")
-				trySendError(errs.ErrProductNotApproved, errCh, cancel)
-				return
-			}
-			if product.Quantity < item.Quantity {
-				logger.WithFields(map[string]interface{}{
-					"product_id":       item.ProductID,
-					"requested":        item.Quantity,
-					"available":        product.Quantity,
-				}).Warn("not enough stock")
-				trySendError(errs.ErrNotEnoughStock, errCh, cancel)
-				return
-			}
-
-			discount, _ := findLatestDiscount(discounts)
+		pricingItems[i] = models.PricingItem{
+			ProductID: item.ProductID,
+			VariantID: item.VariantNullID(),
+			Quantity:  item.Quantity,
+		}
+	}
 
-			mu.Lock()
+	quote, err := u.pricing.Price(ctx, pricingItems)
+	if err != nil {
+		logger.WithError(err).Error("failed to price order")
+		return fmt.Errorf("%s: %w", op, err)
+	}
 
-			var priceToSave float64
-			totalPrice += product.Price * float64(item.Quantity)
-			newQuantities[item.ProductID] = product.Quantity - item.Quantity
-
-			// Если есть скидка и она активна
-			if discount.DiscountedPrice != 0 && discount.DiscountEndDate.After(now) {
-				totalDiscountedPrice += discount.DiscountedPrice * float64(item.Quantity)
-				priceToSave = discount.DiscountedPrice
-			} else {
-				totalDiscountedPrice += product.Price * float64(item.Quantity)
-				priceToSave = product.Price
-			}
-			orderItems[i].Price = priceToSave
-			mu.Unlock()
+	// Остаток здесь не проверяется: товар, зарезервированный пользователем при начале
+	// оформления, уже списан из остатка. Репозиторий засчитывает резерв и атомарно
+	// списывает недостающее, возвращая errs.ErrNotEnoughStock.
+	orderItems := make([]dto.CreateOrderItemDTO, len(in.Items))
+	// Позиции раскладываются по продавцам: каждый продавец получает своё отправление
+	shipments := make([]dto.Shipment, 0, 1)
+	shipmentIndex := make(map[uuid.UUID]int)
+	for i, line := range quote.Lines {
+		if line.Status != models.ProductApproved {
+			logger.WithFields(map[string]interface{}{
+				"product_id":      line.ProductID,
+				"status":          line.Status,
+				"required_status": models.ProductApproved,
+			}).Warn("product not approved")
+			return errs.ErrProductNotApproved
+		}
 
-		}(i, item)
+		j, ok := shipmentIndex[line.SellerID]
+		if !ok {
+			j = len(shipments)
+			shipmentIndex[line.SellerID] = j
+			shipments = append(shipments, dto.Shipment{
+				ID:       uuid.New(),
+				SellerID: line.SellerID,
+				Status:   models.AwaitingConfirmation,
+			})
+		}
+		shipments[j].TotalPrice = shipments[j].TotalPrice.Add(line.UnitPrice.Mul(int64(line.Quantity)))
+		shipments[j].TotalPriceDiscount = shipments[j].TotalPriceDiscount.Add(line.LineTotal)
+
+		orderItems[i] = in.Items[i]
+		orderItems[i].ID = uuid.New()
+		orderItems[i].ShipmentID = shipments[j].ID
+		orderItems[i].Price = line.FinalUnitPrice
+		orderItems[i].BasePrice = line.UnitPrice
 	}
 
-	// Горутина для закрытия канала после завершения всех операций
-	go func() {
-		totalWg.Wait()
-		close(errCh)
-	}()
+	if in.PromoCode != nil && *in.PromoCode != "" {
+		if err = u.pricing.ApplyPromo(ctx, in.UserID, *in.PromoCode, quote); err != nil {
+			logger.WithError(err).Warn("promo code application failed")
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
 
-	// Возвращаем первую ошибку (если есть)
-	if err := <-errCh; err != nil {
-		return err
+	// При самовывозе заказ доставляется по адресу ПВЗ
+	addressID := in.AddressID
+	var pickupPointID uuid.NullUUID
+	if in.PickupPointID != nil {
+		if addressID != uuid.Nil {
+			return fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("either address or pickup point must be set"))
+		}
+		addressID, err = u.repo.GetPickupPointAddressID(ctx, *in.PickupPointID)
+		if err != nil {
+			logger.WithError(err).Warn("failed to get pickup point")
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		pickupPointID = uuid.NullUUID{UUID: *in.PickupPointID, Valid: true}
 	}
 
-	if in.PromoCode != nil && *in.PromoCode != "" {
-        discountedPrice, err := u.applyPromoDiscount(ctx, *in.PromoCode, totalDiscountedPrice)
-        if err != nil {
-            logctx.GetLogger(ctx).WithError(err).Warn("promo code application failed")
-            return fmt.Errorf("%s: %w", op, errs.NewNotFoundError(op))
-        } else {
-            totalPrice = discountedPrice
-			totalDiscountedPrice = discountedPrice
-        }
-    }
+	// Место в интервале занимается репозиторием в транзакции заказа,
+	// здесь интервал только проверяется
+	plan, err := u.delivery.Plan(ctx, addressID, in.DeliverySlotID)
+	if err != nil {
+		logger.WithError(err).Warn("failed to plan delivery")
+		return fmt.Errorf("%s: %w", op, err)
+	}
 
 	order := &dto.Order{
 		ID:                 uuid.New(),
 		UserID:             in.UserID,
 		Status:             models.Placed,
-		TotalPrice:         totalPrice,
-		TotalPriceDiscount: totalDiscountedPrice,
-		AddressID:          in.AddressID,
+		TotalPrice:         quote.Subtotal,
+		TotalPriceDiscount: quote.GoodsTotal(),
+		DeliveryCost:       quote.DeliveryCost,
+		AddressID:          addressID,
+		ExpectedDeliveryAt: &plan.ExpectedDeliveryAt,
+		DeliverySlotID:     plan.SlotID,
+		PickupPointID:      pickupPointID,
 		Items:              orderItems,
+		Shipments:          shipments,
+		DisplayRate:        helpers.GetExchangeRateFromContext(ctx),
 	}
 
-	err := u.repo.CreateOrder(ctx, dto.CreateOrderRepoReq{
-		Order:             order,
-		UpdatedQuantities: newQuantities,
+	err = u.repo.CreateOrder(ctx, dto.CreateOrderRepoReq{
+		Order:           order,
+		PromoRedemption: quote.Promo,
 	})
 	if err != nil {
 		logger.WithError(err).Error("failed to create order")
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	// Заказ, который сейчас не может собрать ни один склад, остаётся оформленным:
+	// администратор направит его на склад позже
+	if _, err = u.warehouses.RouteOrder(ctx, order.ID); err != nil {
+		logger.WithError(err).WithField("order_id", order.ID).Warn("failed to route order to warehouse")
+	}
+
 	notification := models.Notification{
 		ID:     uuid.New(),
 		UserID: in.UserID,
@@ -250,11 +252,12 @@ func (u *OrderUsecase) GetUserOrders(ctx context.Context, userID uuid.UUID) (*[]
 
 			innerWg := sync.WaitGroup{}
 			var (
-				address  *models.AddressDB
-				products []models.OrderPreviewProductDTO
+				address   *models.AddressDB
+				products  []models.OrderPreviewProductDTO
+				shipments []models.OrderShipment
 			)
 
-			innerWg.Add(2)
+			innerWg.Add(3)
 			go func() {
 				defer innerWg.Done()
 				if ctx.Err() != nil {
@@ -330,6 +333,24 @@ func (u *OrderUsecase) GetUserOrders(ctx context.Context, userID uuid.UUID) (*[]
 				address.ID = orderItem.AddressID
 			}()
 
+			go func() {
+				defer innerWg.Done()
+				if ctx.Err() != nil {
+					return
+				}
+
+				shipmentsRes, shipmentsErr := u.repo.GetOrderShipments(ctx, orderItem.ID)
+				if shipmentsErr != nil {
+					logger.WithError(shipmentsErr).
+						WithField("order_id", orderItem.ID).
+						Error("failed to get order shipments")
+					trySendError(shipmentsErr, errCh, cancel)
+					return
+				}
+
+				shipments = shipmentsRes
+			}()
+
 			innerWg.Wait()
 			if ctx.Err() != nil || address == nil {
 				return
@@ -337,6 +358,7 @@ func (u *OrderUsecase) GetUserOrders(ctx context.Context, userID uuid.UUID) (*[]
 
 			mu.Lock()
 			ordersPreview[i] = orderItem.ConvertToGetOrderByUserIDResDTO(address, products)
+			ordersPreview[i].Shipments = dto.ConvertToShipmentPreviews(shipments)
 			mu.Unlock()
 		}()
 	}
@@ -366,32 +388,43 @@ func trySendError(err error, errCh chan<- error, cancel context.CancelFunc) {
 	}
 }
 
-// findLatestDiscount Достаём последнюю созданную скидку
-func findLatestDiscount(discounts []models.ProductDiscount) (models.ProductDiscount, bool) {
-	if len(discounts) == 0 {
-		return models.ProductDiscount{}, false
-	}
+func (u *OrderUsecase) UpdateStatus(ctx context.Context, req dto.UpdateOrderStatusRequest) error {
+	const op = "WarehouseUsecase.Update"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
 
-	latest := discounts[0]
-	for _, discount := range discounts[1:] {
-		if discount.DiscountStartDate.After(latest.DiscountStartDate) {
-			latest = discount
+	// Склад переводит заказ в доставку, а затем отмечает его доставленным
+	status := models.InTransit
+	text := "Статус вашего заказа изменен с 'Оформлен' на 'В доставке'"
+	if req.Status != "" {
+		parsed, err := models.ParseOrderStatus(req.Status)
+		if _, ok := parsed.WarehousePrevious(); err != nil || !ok {
+			return fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("unsupported order status"))
 		}
+		status = parsed
+	}
+	if status == models.Delivered {
+		text = "Ваш заказ доставлен"
 	}
 
-	return latest, true
-}
+	warehouseID, err := u.warehouses.StaffWarehouse(ctx)
+	if err != nil {
+		logger.WithError(err).Warn("failed to get staff warehouse")
+		return fmt.Errorf("%s: %w", op, err)
+	}
 
-func (u *OrderUsecase) UpdateStatus(ctx context.Context, req dto.UpdateOrderStatusRequest) error {
-	const op = "WarehouseUsecase.Update"
-	logger := logctx.GetLogger(ctx).WithField("op", op)
-	
-	err := u.repo.UpdateStatus(ctx, req.OrderID, models.InTransit)
+	err = u.repo.UpdateStatus(ctx, req.OrderID, warehouseID, status)
 	if err != nil {
 		logger.WithError(err).Error("failed update status order")
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	// Начисления, которые не удалось записать сейчас, допишет фоновый расчёт с продавцами
+	if status == models.Delivered {
+		if err = u.earnings.RecordEarnings(ctx, req.OrderID); err != nil {
+			logger.WithError(err).Error("failed to record seller earnings")
+		}
+	}
+
 	userID, err := u.repo.GetUserIDByOrderID(ctx, req.OrderID)
 	if err != nil {
 		logger.WithError(err).Warn("failed to get order for notification")
@@ -401,7 +434,7 @@ func (u *OrderUsecase) UpdateStatus(ctx context.Context, req dto.UpdateOrderStat
 	notification := models.Notification{
 		ID:     uuid.New(),
 		UserID: userID,
-		Text:   "Статус вашего заказа изменен с 'Оформлен' на 'В доставке'",
+		Text:   text,
 		Title:  "Статус заказа изменен",
 		IsRead: false,
 	}
@@ -419,10 +452,16 @@ func (u *OrderUsecase) GetOrdersPlaced(ctx context.Context) (*[]dto.OrderPreview
 	const op = "OrderUsecase.GetUserOrders"
 	logger := logctx.GetLogger(ctx).WithField("op", op)
 
+	warehouseID, err := u.warehouses.StaffWarehouse(ctx)
+	if err != nil {
+		logger.WithError(err).Warn("failed to get staff warehouse")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	orders, err := u.repo.GetOrdersPlaced(ctx)
+	orders, err := u.repo.GetOrdersPlaced(ctx, warehouseID)
 	if err != nil {
 		if errors.Is(err, errs.ErrNotFound) {
 			logger.Warn("no orders found for user")
@@ -550,21 +589,222 @@ func (u *OrderUsecase) GetOrdersPlaced(ctx context.Context) (*[]dto.OrderPreview
 	return &ordersPreview, nil
 }
 
-func (u *OrderUsecase) applyPromoDiscount(ctx context.Context, promoCode string, totalPrice float64) (float64, error) {
-    if promoCode == "" {
-        return totalPrice, nil
-    }
+// GetSellerOrders возвращает страницу отправлений текущего продавца с адресами доставки
+func (u *OrderUsecase) GetSellerOrders(ctx context.Context, offset int) ([]dto.SellerOrderDTO, error) {
+	const op = "OrderUsecase.GetSellerOrders"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
 
-    promo, err := u.promoRepo.CheckPromoCode(ctx, promoCode)
-    if err != nil {
-        return 0, fmt.Errorf("promo code check failed: %w", err)
-    }
+	sellerID, err := helpers.GetUserIDFromContext(ctx)
+	if err != nil {
+		logger.WithError(err).Error("get seller ID from context")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
 
-    now := time.Now()
-    if now.Before(promo.StartDate) || now.After(promo.EndDate) {
-        return 0, fmt.Errorf("promo code expired")
-    }
+	logger = logger.WithField("seller_id", sellerID)
+	shipments, err := u.repo.GetSellerShipments(ctx, sellerID, offset)
+	if err != nil {
+		logger.WithError(err).Error("get seller shipments")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// У нескольких отправлений может быть один адрес, запрашиваем каждый один раз
+	addresses := make(map[uuid.UUID]*models.AddressDB)
+	orders := make([]dto.SellerOrderDTO, 0, len(shipments))
+	for _, shipment := range shipments {
+		address, ok := addresses[shipment.AddressID]
+		if !ok {
+			address, err = u.repo.GetOrderAddress(ctx, shipment.AddressID)
+			if err != nil && !errors.Is(err, errs.ErrNotFound) {
+				logger.WithError(err).WithField("address_id", shipment.AddressID).Error("get order address")
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			if address != nil {
+				address.ID = shipment.AddressID
+			}
+			addresses[shipment.AddressID] = address
+		}
+
+		orders = append(orders, dto.ConvertToSellerOrderDTO(shipment, address))
+	}
+
+	return orders, nil
+}
+
+// ConfirmShipment принимает отправление текущего продавца в работу
+func (u *OrderUsecase) ConfirmShipment(ctx context.Context, shipmentID uuid.UUID, req dto.ConfirmShipmentRequest) error {
+	const op = "OrderUsecase.ConfirmShipment"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("shipment_id", shipmentID)
+
+	sellerID, err := helpers.GetUserIDFromContext(ctx)
+	if err != nil {
+		logger.WithError(err).Error("get seller ID from context")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if req.TrackingNumber != nil && *req.TrackingNumber == "" {
+		req.TrackingNumber = nil
+	}
+
+	buyerID, err := u.repo.ConfirmShipment(ctx, dto.ConfirmShipmentRepoReq{
+		ShipmentID:         shipmentID,
+		SellerID:           sellerID,
+		TrackingNumber:     req.TrackingNumber,
+		ExpectedDeliveryAt: req.ExpectedDeliveryAt,
+	})
+	if err != nil {
+		logger.WithError(err).Warn("confirm shipment")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	u.notifyBuyer(ctx, buyerID, "Статус отправления изменен",
+		"Продавец подтвердил отправление из вашего заказа и начал его собирать")
+
+	return nil
+}
+
+// CancelShipment отменяет отправление текущего продавца
+func (u *OrderUsecase) CancelShipment(ctx context.Context, shipmentID uuid.UUID) error {
+	const op = "OrderUsecase.CancelShipment"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("shipment_id", shipmentID)
+
+	sellerID, err := helpers.GetUserIDFromContext(ctx)
+	if err != nil {
+		logger.WithError(err).Error("get seller ID from context")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	buyerID, err := u.repo.CancelShipment(ctx, shipmentID, sellerID)
+	if err != nil {
+		logger.WithError(err).Warn("cancel shipment")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	u.notifyBuyer(ctx, buyerID, "Отправление отменено",
+		"Продавец отменил отправление из вашего заказа. Товары из него не будут доставлены")
+
+	return nil
+}
+
+// notifyBuyer отправляет уведомление покупателю. Ошибка не отменяет уже
+// выполненное действие продавца и только логируется.
+func (u *OrderUsecase) notifyBuyer(ctx context.Context, userID uuid.UUID, title, text string) {
+	const op = "OrderUsecase.notifyBuyer"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	if err := u.notificationRepo.Create(ctx, models.Notification{
+		ID:     uuid.New(),
+		UserID: userID,
+		Text:   text,
+		Title:  title,
+		IsRead: false,
+	}); err != nil {
+		logger.WithError(err).WithField("user_id", userID).Warn("create notification")
+	}
+}
+
+
+// GetOrder возвращает заказ текущего пользователя с полной разбивкой стоимости.
+// Чужой заказ неотличим от несуществующего.
+func (u *OrderUsecase) GetOrder(ctx context.Context, orderID uuid.UUID) (dto.OrderDetailDTO, error) {
+	const op = "OrderUsecase.GetOrder"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("order_id", orderID)
+
+	userID, err := helpers.GetUserIDFromContext(ctx)
+	if err != nil {
+		logger.WithError(err).Error("get user ID from context")
+		return dto.OrderDetailDTO{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	detail, err := u.getOrderDetail(ctx, orderID, func(owner uuid.UUID) bool {
+		return owner == userID
+	})
+	if err != nil {
+		return dto.OrderDetailDTO{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return detail, nil
+}
+
+// GetInvoice возвращает PDF-счёт по заказу. Покупателю доступны только его
+// заказы, администратору и работнику склада — любые.
+func (u *OrderUsecase) GetInvoice(ctx context.Context, orderID uuid.UUID) ([]byte, error) {
+	const op = "OrderUsecase.GetInvoice"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("order_id", orderID)
 
-    discount := 1 - float64(promo.Percent)/100
-    return totalPrice * discount, nil
-}
\ No newline at end of file
+	userID, err := helpers.GetUserIDFromContext(ctx)
+	if err != nil {
+		logger.WithError(err).Error("get user ID from context")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	role, _ := ctx.Value(domains.RoleKey{}).(string)
+	_, privileged := invoiceRoles[role]
+
+	detail, err := u.getOrderDetail(ctx, orderID, func(owner uuid.UUID) bool {
+		return privileged || owner == userID
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	invoice, err := u.invoice.Render(detail)
+	if err != nil {
+		logger.WithError(err).Error("render invoice")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return invoice, nil
+}
+
+// getOrderDetail загружает заказ с адресом и считает итоговые суммы.
+// canView решает по владельцу заказа, можно ли его показать.
+func (u *OrderUsecase) getOrderDetail(
+	ctx context.Context,
+	orderID uuid.UUID,
+	canView func(owner uuid.UUID) bool,
+) (dto.OrderDetailDTO, error) {
+	const op = "OrderUsecase.getOrderDetail"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("order_id", orderID)
+
+	detail, err := u.repo.GetOrderDetail(ctx, orderID)
+	if err != nil {
+		logger.WithError(err).Warn("get order detail")
+		return dto.OrderDetailDTO{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if !canView(detail.UserID) {
+		logger.Warn("order belongs to another user")
+		return dto.OrderDetailDTO{}, fmt.Errorf("%s: %w", op, errs.NewNotFoundError("order not found"))
+	}
+
+	result := dto.OrderDetailDTO{
+		ID:                 detail.ID,
+		Status:             detail.Status,
+		PickupPointID:      detail.PickupPointID,
+		Shipments:          dto.ConvertToShipmentPreviews(detail.Shipments),
+		Subtotal:           detail.TotalPrice,
+		ProductDiscount:    detail.TotalPrice.Sub(detail.TotalPriceDiscount).Sub(detail.PromoDiscount),
+		PromoCode:          detail.PromoCode,
+		PromoDiscount:      detail.PromoDiscount,
+		DeliveryCost:       detail.DeliveryCost,
+		Total:              detail.TotalPriceDiscount.Add(detail.DeliveryCost),
+		ChargeTotal:        detail.TotalPriceDiscount.Add(detail.DeliveryCost),
+		Currency:           models.BaseCurrency,
+		DisplayRate:        detail.DisplayRate,
+		ExpectedDeliveryAt: detail.ExpectedDeliveryAt,
+		ActualDeliveryAt:   detail.ActualDeliveryAt,
+		CreatedAt:          detail.CreatedAt,
+	}
+
+	address, err := u.repo.GetOrderAddress(ctx, detail.AddressID)
+	if err != nil && !errors.Is(err, errs.ErrNotFound) {
+		logger.WithError(err).WithField("address_id", detail.AddressID).Error("get order address")
+		return dto.OrderDetailDTO{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if address != nil {
+		result.Address = *address
+		result.Address.ID = detail.AddressID
+	}
+
+	return result, nil
+}
@@ -0,0 +1,201 @@
+package payout
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/google/uuid"
+)
+
+// maxCommissionBps — комиссия 100%
+const maxCommissionBps = 10000
+
+//go:generate mockgen -source=payout.go -destination=../../infrastructure/repository/postgres/mocks/payout_repository_mock.go -package=mocks IPayoutRepository
+type IPayoutRepository interface {
+	SetCategoryCommission(ctx context.Context, categoryID uuid.UUID, bps sql.NullInt32) error
+	RecordEarnings(ctx context.Context, orderID uuid.NullUUID, defaultBps int, holdPeriod time.Duration) (int64, error)
+	Settle(ctx context.Context, defaultBps int, holdPeriod, statementPeriod time.Duration) (models.SettlementResult, bool, error)
+	GetBalance(ctx context.Context, sellerID uuid.UUID) (models.SellerBalance, error)
+	GetStatements(ctx context.Context, sellerID uuid.UUID, offset int) ([]models.PayoutStatement, error)
+	GetStatement(ctx context.Context, statementID uuid.UUID) (models.PayoutStatement, error)
+	GetStatementEarnings(ctx context.Context, statementID uuid.UUID) ([]models.SellerEarning, error)
+	MarkStatementPaid(ctx context.Context, statementID uuid.UUID) error
+}
+
+type PayoutUsecase struct {
+	repo IPayoutRepository
+	conf *config.PayoutConfig
+}
+
+func NewPayoutUsecase(repo IPayoutRepository, conf *config.PayoutConfig) *PayoutUsecase {
+	return &PayoutUsecase{
+		repo: repo,
+		conf: conf,
+	}
+}
+
+// RecordEarnings начисляет продавцам за позиции доставленного заказа.
+// Начисления удерживаются до конца окна возврата.
+func (u *PayoutUsecase) RecordEarnings(ctx context.Context, orderID uuid.UUID) error {
+	const op = "PayoutUsecase.RecordEarnings"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("order_id", orderID)
+
+	recorded, err := u.repo.RecordEarnings(
+		ctx,
+		uuid.NullUUID{UUID: orderID, Valid: true},
+		u.conf.DefaultCommissionBps,
+		u.conf.ReturnWindow,
+	)
+	if err != nil {
+		logger.WithError(err).Error("record earnings")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	logger.WithField("recorded", recorded).Debug("earnings recorded")
+	return nil
+}
+
+// SetCategoryCommission задаёт комиссию категории; она действует для
+// заказов, доставленных после изменения
+func (u *PayoutUsecase) SetCategoryCommission(
+	ctx context.Context,
+	categoryID uuid.UUID,
+	req dto.SetCategoryCommissionRequest,
+) error {
+	const op = "PayoutUsecase.SetCategoryCommission"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("category_id", categoryID)
+
+	var bps sql.NullInt32
+	if req.CommissionBps != nil {
+		if *req.CommissionBps < 0 || *req.CommissionBps > maxCommissionBps {
+			return fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError(
+				fmt.Sprintf("commission must be between 0 and %d basis points", maxCommissionBps)))
+		}
+		bps = sql.NullInt32{Int32: int32(*req.CommissionBps), Valid: true}
+	}
+
+	if err := u.repo.SetCategoryCommission(ctx, categoryID, bps); err != nil {
+		logger.WithError(err).Error("set category commission")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (u *PayoutUsecase) GetBalance(ctx context.Context, sellerID uuid.UUID) (dto.SellerBalanceDTO, error) {
+	const op = "PayoutUsecase.GetBalance"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("seller_id", sellerID)
+
+	balance, err := u.repo.GetBalance(ctx, sellerID)
+	if err != nil {
+		logger.WithError(err).Error("get balance")
+		return dto.SellerBalanceDTO{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dto.ConvertToSellerBalanceDTO(balance), nil
+}
+
+func (u *PayoutUsecase) GetStatements(ctx context.Context, sellerID uuid.UUID, offset int) ([]models.PayoutStatement, error) {
+	const op = "PayoutUsecase.GetStatements"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("seller_id", sellerID)
+
+	if offset < 0 {
+		offset = 0
+	}
+
+	statements, err := u.repo.GetStatements(ctx, sellerID, offset)
+	if err != nil {
+		logger.WithError(err).Error("get statements")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return statements, nil
+}
+
+// GetStatement возвращает выписку продавца вместе с начислениями.
+// Чужая выписка недоступна.
+func (u *PayoutUsecase) GetStatement(
+	ctx context.Context,
+	sellerID, statementID uuid.UUID,
+) (dto.PayoutStatementDetailDTO, error) {
+	const op = "PayoutUsecase.GetStatement"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("statement_id", statementID)
+
+	statement, err := u.repo.GetStatement(ctx, statementID)
+	if err != nil {
+		logger.WithError(err).Warn("get statement")
+		return dto.PayoutStatementDetailDTO{}, fmt.Errorf("%s: %w", op, err)
+	}
+	if statement.SellerID != sellerID {
+		logger.WithField("seller_id", sellerID).Warn("statement belongs to another seller")
+		return dto.PayoutStatementDetailDTO{}, fmt.Errorf("%s: %w", op, errs.ErrForbidden)
+	}
+
+	earnings, err := u.repo.GetStatementEarnings(ctx, statementID)
+	if err != nil {
+		logger.WithError(err).Error("get statement earnings")
+		return dto.PayoutStatementDetailDTO{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dto.PayoutStatementDetailDTO{
+		Statement: statement,
+		Earnings:  earnings,
+	}, nil
+}
+
+func (u *PayoutUsecase) MarkStatementPaid(ctx context.Context, statementID uuid.UUID) error {
+	const op = "PayoutUsecase.MarkStatementPaid"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("statement_id", statementID)
+
+	if err := u.repo.MarkStatementPaid(ctx, statementID); err != nil {
+		logger.WithError(err).Warn("mark statement paid")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// RunSettlement проводит расчёты с продавцами сразу и затем с периодом
+// SettlementInterval до отмены контекста. Неположительный интервал отключает расчёты.
+func (u *PayoutUsecase) RunSettlement(ctx context.Context) {
+	const op = "PayoutUsecase.RunSettlement"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	if u.conf.SettlementInterval <= 0 {
+		logger.Warn("seller settlement disabled")
+		return
+	}
+
+	ticker := time.NewTicker(u.conf.SettlementInterval)
+	defer ticker.Stop()
+
+	for {
+		result, settled, err := u.repo.Settle(ctx, u.conf.DefaultCommissionBps, u.conf.ReturnWindow, u.conf.StatementPeriod)
+		switch {
+		case err != nil:
+			logger.WithError(err).Error("settle seller earnings")
+		case settled:
+			logger.WithFields(map[string]interface{}{
+				"recorded":   result.Recorded,
+				"reversed":   result.Reversed,
+				"released":   result.Released,
+				"statements": result.Statements,
+			}).Info("seller earnings settled")
+		default:
+			logger.Debug("seller settlement is being run by another instance")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
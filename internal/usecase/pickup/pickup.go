@@ -0,0 +1,255 @@
+package pickup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/helpers"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+const (
+	defaultNearestLimit = 5
+	maxNearestLimit     = 50
+	hoursLayout         = "15:04"
+)
+
+//go:generate mockgen -source=pickup.go -destination=../../infrastructure/repository/postgres/mocks/pickup_repository_mock.go -package=mocks IPickupRepository
+type IPickupRepository interface {
+	GetPickupPoints(ctx context.Context) ([]models.PickupPoint, error)
+	GetPickupPoint(ctx context.Context, pointID uuid.UUID) (*models.PickupPoint, error)
+	GetFavoritePickupPoints(ctx context.Context, userID uuid.UUID) ([]models.PickupPoint, error)
+	CreatePickupPoint(ctx context.Context, point models.PickupPoint) (models.PickupPoint, error)
+	UpdatePickupPoint(ctx context.Context, point models.PickupPoint) error
+	DeletePickupPoint(ctx context.Context, pointID uuid.UUID) error
+	AddFavoritePickupPoint(ctx context.Context, userID, pointID uuid.UUID) error
+	RemoveFavoritePickupPoint(ctx context.Context, userID, pointID uuid.UUID) error
+}
+
+type PickupUsecase struct {
+	repo IPickupRepository
+}
+
+func NewPickupUsecase(repo IPickupRepository) *PickupUsecase {
+	return &PickupUsecase{
+		repo: repo,
+	}
+}
+
+// GetNearest возвращает ближайшие к точке ПВЗ, отсортированные по расстоянию.
+// ПВЗ с некорректной координатой в выдачу не попадают.
+func (u *PickupUsecase) GetNearest(ctx context.Context, from models.Coordinate, limit int) (dto.PickupPointsResponse, error) {
+	const op = "PickupUsecase.GetNearest"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	if !from.Valid() {
+		return dto.PickupPointsResponse{}, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("invalid coordinate"))
+	}
+	if limit <= 0 {
+		limit = defaultNearestLimit
+	}
+	if limit > maxNearestLimit {
+		limit = maxNearestLimit
+	}
+
+	points, err := u.repo.GetPickupPoints(ctx)
+	if err != nil {
+		logger.WithError(err).Error("get pickup points")
+		return dto.PickupPointsResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	nearest := make([]dto.PickupPointDTO, 0, len(points))
+	for _, point := range points {
+		coordinate, err := models.ParseCoordinate(point.Address.Coordinate.String)
+		if err != nil {
+			logger.WithField("pickup_point_id", point.ID).Warn("pickup point has invalid coordinate")
+			continue
+		}
+
+		distance := from.DistanceKm(coordinate)
+		pointDTO := dto.ConvertToPickupPointDTO(point)
+		pointDTO.DistanceKm = &distance
+		nearest = append(nearest, pointDTO)
+	}
+
+	sort.SliceStable(nearest, func(i, j int) bool {
+		return *nearest[i].DistanceKm < *nearest[j].DistanceKm
+	})
+	if len(nearest) > limit {
+		nearest = nearest[:limit]
+	}
+
+	return dto.PickupPointsResponse{PickupPoints: nearest}, nil
+}
+
+func (u *PickupUsecase) GetFavorites(ctx context.Context) (dto.PickupPointsResponse, error) {
+	const op = "PickupUsecase.GetFavorites"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	userID, err := helpers.GetUserIDFromContext(ctx)
+	if err != nil {
+		logger.WithError(err).Error("get user ID from context")
+		return dto.PickupPointsResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	points, err := u.repo.GetFavoritePickupPoints(ctx, userID)
+	if err != nil {
+		logger.WithError(err).Error("get favorite pickup points")
+		return dto.PickupPointsResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return convertToPickupPointsResponse(points), nil
+}
+
+func (u *PickupUsecase) AddFavorite(ctx context.Context, pointID uuid.UUID) error {
+	const op = "PickupUsecase.AddFavorite"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("pickup_point_id", pointID)
+
+	userID, err := helpers.GetUserIDFromContext(ctx)
+	if err != nil {
+		logger.WithError(err).Error("get user ID from context")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = u.repo.AddFavoritePickupPoint(ctx, userID, pointID); err != nil {
+		logger.WithError(err).Error("add favorite pickup point")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (u *PickupUsecase) RemoveFavorite(ctx context.Context, pointID uuid.UUID) error {
+	const op = "PickupUsecase.RemoveFavorite"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("pickup_point_id", pointID)
+
+	userID, err := helpers.GetUserIDFromContext(ctx)
+	if err != nil {
+		logger.WithError(err).Error("get user ID from context")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = u.repo.RemoveFavoritePickupPoint(ctx, userID, pointID); err != nil {
+		logger.WithError(err).Error("remove favorite pickup point")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (u *PickupUsecase) CreatePickupPoint(ctx context.Context, req dto.CreatePickupPointRequest) (dto.PickupPointDTO, error) {
+	const op = "PickupUsecase.CreatePickupPoint"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return dto.PickupPointDTO{}, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("pickup point name is required"))
+	}
+	addressString := strings.TrimSpace(req.AddressString)
+	if addressString == "" {
+		return dto.PickupPointDTO{}, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("pickup point address is required"))
+	}
+	coordinate, err := models.ParseCoordinate(req.Coordinate)
+	if err != nil {
+		return dto.PickupPointDTO{}, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("invalid coordinate"))
+	}
+	if err = validateWorkingHours(req.OpensAt, req.ClosesAt); err != nil {
+		return dto.PickupPointDTO{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	point, err := u.repo.CreatePickupPoint(ctx, models.PickupPoint{
+		ID:       uuid.New(),
+		Name:     name,
+		OpensAt:  req.OpensAt,
+		ClosesAt: req.ClosesAt,
+		Address: models.AddressDB{
+			ID:            uuid.New(),
+			Region:        req.Region,
+			City:          req.City,
+			AddressString: null.StringFrom(addressString),
+			Coordinate:    null.StringFrom(coordinate.String()),
+		},
+	})
+	if err != nil {
+		logger.WithError(err).Error("create pickup point")
+		return dto.PickupPointDTO{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dto.ConvertToPickupPointDTO(point), nil
+}
+
+func (u *PickupUsecase) UpdatePickupPoint(ctx context.Context, pointID uuid.UUID, req dto.UpdatePickupPointRequest) (dto.PickupPointDTO, error) {
+	const op = "PickupUsecase.UpdatePickupPoint"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("pickup_point_id", pointID)
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return dto.PickupPointDTO{}, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("pickup point name is required"))
+	}
+	if err := validateWorkingHours(req.OpensAt, req.ClosesAt); err != nil {
+		return dto.PickupPointDTO{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := u.repo.UpdatePickupPoint(ctx, models.PickupPoint{
+		ID:       pointID,
+		Name:     name,
+		OpensAt:  req.OpensAt,
+		ClosesAt: req.ClosesAt,
+	}); err != nil {
+		logger.WithError(err).Error("update pickup point")
+		return dto.PickupPointDTO{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	point, err := u.repo.GetPickupPoint(ctx, pointID)
+	if err != nil {
+		logger.WithError(err).Error("get pickup point")
+		return dto.PickupPointDTO{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dto.ConvertToPickupPointDTO(*point), nil
+}
+
+func (u *PickupUsecase) DeletePickupPoint(ctx context.Context, pointID uuid.UUID) error {
+	const op = "PickupUsecase.DeletePickupPoint"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("pickup_point_id", pointID)
+
+	if err := u.repo.DeletePickupPoint(ctx, pointID); err != nil {
+		logger.WithError(err).Error("delete pickup point")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// validateWorkingHours проверяет часы работы в формате "15:04"; ПВЗ закрывается в тот же день
+func validateWorkingHours(opensAt, closesAt string) error {
+	opens, err := time.Parse(hoursLayout, opensAt)
+	if err != nil {
+		return errs.NewBusinessLogicError("invalid opening time")
+	}
+	closes, err := time.Parse(hoursLayout, closesAt)
+	if err != nil {
+		return errs.NewBusinessLogicError("invalid closing time")
+	}
+	if !closes.After(opens) {
+		return errs.NewBusinessLogicError("pickup point must close after it opens")
+	}
+
+	return nil
+}
+
+func convertToPickupPointsResponse(points []models.PickupPoint) dto.PickupPointsResponse {
+	res := dto.PickupPointsResponse{PickupPoints: make([]dto.PickupPointDTO, 0, len(points))}
+	for _, point := range points {
+		res.PickupPoints = append(res.PickupPoints, dto.ConvertToPickupPointDTO(point))
+	}
+	return res
+}
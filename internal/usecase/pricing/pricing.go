@@ -0,0 +1,308 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/promo"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=pricing.go -destination=../../infrastructure/repository/postgres/mocks/pricing_repository_mock.go -package=mocks IPricingRepository
+type IPricingRepository interface {
+	ProductPrice(context.Context, uuid.UUID) (*models.Product, error)
+	VariantPrice(ctx context.Context, productID, variantID uuid.UUID) (*models.ProductVariant, error)
+	ProductDiscounts(ctx context.Context, productID uuid.UUID, variantID uuid.NullUUID) ([]models.ProductDiscount, error)
+}
+
+// Engine считает стоимость корзины и заказа по одним и тем же правилам:
+// действующая скидка на товар, промокод и доставка.
+type Engine struct {
+	repo      IPricingRepository
+	promoRepo promo.IPromoRepository
+	delivery  *config.DeliveryConfig
+	now       func() time.Time
+}
+
+func NewEngine(repo IPricingRepository, promoRepo promo.IPromoRepository, delivery *config.DeliveryConfig) *Engine {
+	return &Engine{
+		repo:      repo,
+		promoRepo: promoRepo,
+		delivery:  delivery,
+		now:       time.Now,
+	}
+}
+
+// Price загружает цены и скидки товаров и считает стоимость без промокода.
+// Наличие и статус товара не проверяются: они возвращаются в позициях.
+// Товар с вариантами считается по цене и остатку выбранного SKU; если SKU
+// не выбран, возвращается ошибка бизнес-логики.
+func (e *Engine) Price(ctx context.Context, items []models.PricingItem) (*models.Quote, error) {
+	const op = "PricingEngine.Price"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	now := e.now()
+	lines := make([]models.QuoteLine, len(items))
+
+	var wg sync.WaitGroup
+	errCh := make(chan error, 1)
+
+	for i, item := range items {
+		wg.Add(1)
+		go func(i int, item models.PricingItem) {
+			defer wg.Done()
+
+			var innerWg sync.WaitGroup
+			innerWg.Add(2)
+
+			var (
+				product     *models.Product
+				productErr  error
+				discounts   []models.ProductDiscount
+				discountErr error
+			)
+
+			// Получаем статус, количество и цену товара
+			go func() {
+				defer innerWg.Done()
+				if ctx.Err() != nil {
+					return
+				}
+
+				product, productErr = e.productPrice(ctx, item)
+				if productErr != nil {
+					logger.WithError(productErr).
+						WithField("product_id", item.ProductID).
+						Error("failed to fetch product price")
+					trySendError(productErr, errCh, cancel)
+				}
+			}()
+
+			// Получаем скидки товара, если они есть
+			go func() {
+				defer innerWg.Done()
+				if ctx.Err() != nil {
+					return
+				}
+
+				discounts, discountErr = e.repo.ProductDiscounts(ctx, item.ProductID, item.VariantID)
+				if discountErr != nil && !errors.Is(discountErr, errs.ErrNotFound) {
+					logger.WithError(discountErr).
+						WithField("product_id", item.ProductID).
+						Error("failed to fetch product discount")
+					trySendError(discountErr, errCh, cancel)
+				}
+			}()
+
+			innerWg.Wait()
+			if ctx.Err() != nil {
+				return
+			}
+
+			lines[i] = priceLine(item, product, discounts, now)
+		}(i, item)
+	}
+
+	// Горутина для закрытия канала после завершения всех операций
+	go func() {
+		wg.Wait()
+		close(errCh)
+	}()
+
+	// Возвращаем первую ошибку (если есть)
+	if err := <-errCh; err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	quote := &models.Quote{Lines: lines}
+	e.finalize(quote)
+
+	return quote, nil
+}
+
+// productPrice возвращает цену, остаток и статус позиции. Для SKU цена
+// и остаток берутся у варианта, статус и продавец — у родительского товара
+func (e *Engine) productPrice(ctx context.Context, item models.PricingItem) (*models.Product, error) {
+	product, err := e.repo.ProductPrice(ctx, item.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !item.VariantID.Valid {
+		if product.HasVariants {
+			return nil, errs.NewBusinessLogicError(fmt.Sprintf("product %s is sold by variants, variant is required", item.ProductID))
+		}
+		return product, nil
+	}
+
+	variant, err := e.repo.VariantPrice(ctx, item.ProductID, item.VariantID.UUID)
+	if err != nil {
+		return nil, err
+	}
+	product.Price = variant.Price
+	product.Quantity = variant.Quantity
+
+	return product, nil
+}
+
+// ApplyPromo проверяет правила промокода для позиций расчёта и вычитает скидку.
+// Отказ возвращается как *promo.RejectedError, расчёт при этом не меняется.
+func (e *Engine) ApplyPromo(ctx context.Context, userID uuid.UUID, code string, quote *models.Quote) error {
+	const op = "PricingEngine.ApplyPromo"
+
+	promoCode, err := e.promoRepo.CheckPromoCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return &promo.RejectedError{Reason: promo.ReasonNotFound}
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	usage, err := e.promoRepo.GetPromoUsage(ctx, promoCode.ID, userID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = promo.CheckAvailability(promoCode, usage, e.now()); err != nil {
+		return err
+	}
+
+	items := make([]models.PromoCartItem, len(quote.Lines))
+	for i, line := range quote.Lines {
+		items[i] = models.PromoCartItem{
+			ProductID:  line.ProductID,
+			Price:      line.UnitPrice,
+			FinalPrice: line.FinalUnitPrice,
+			Quantity:   line.Quantity,
+		}
+	}
+
+	if len(promoCode.Scopes) > 0 {
+		productIDs := make([]uuid.UUID, 0, len(items))
+		for _, item := range items {
+			productIDs = append(productIDs, item.ProductID)
+		}
+
+		scopes, err := e.promoRepo.GetProductScopes(ctx, productIDs)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		for i := range items {
+			scope := scopes[items[i].ProductID]
+			items[i].SellerID = scope.SellerID
+			items[i].CategoryIDs = scope.CategoryIDs
+		}
+	}
+
+	discount, err := promo.CalculateDiscount(promoCode, items)
+	if err != nil {
+		return err
+	}
+
+	quote.Promo = &models.PromoRedemption{
+		ID:             uuid.New(),
+		PromoID:        promoCode.ID,
+		UserID:         userID,
+		Discount:       discount,
+		UsageLimit:     promoCode.UsageLimit,
+		PerUserLimit:   promoCode.PerUserLimit,
+		FirstOrderOnly: promoCode.FirstOrderOnly,
+	}
+	e.finalize(quote)
+
+	return nil
+}
+
+// finalize пересчитывает итоги расчёта по позициям, промокоду и доставке
+func (e *Engine) finalize(quote *models.Quote) {
+	var subtotal, productDiscount models.Money
+	for _, line := range quote.Lines {
+		subtotal = subtotal.Add(line.UnitPrice.Mul(int64(line.Quantity)))
+		productDiscount = productDiscount.Add(line.LineDiscount)
+	}
+
+	quote.Subtotal = subtotal
+	quote.ProductDiscount = productDiscount
+	quote.PromoDiscount = models.Money{}
+	if quote.Promo != nil {
+		quote.PromoDiscount = quote.Promo.Discount
+	}
+
+	quote.DeliveryCost = e.deliveryCost(quote.GoodsTotal(), len(quote.Lines))
+	quote.Total = quote.GoodsTotal().Add(quote.DeliveryCost)
+}
+
+func (e *Engine) deliveryCost(goodsTotal models.Money, lines int) models.Money {
+	if e.delivery == nil || lines == 0 {
+		return models.Money{}
+	}
+	if e.delivery.FreeThreshold.IsPositive() && !goodsTotal.LessThan(e.delivery.FreeThreshold) {
+		return models.Money{}
+	}
+	return e.delivery.Cost
+}
+
+func priceLine(item models.PricingItem, product *models.Product, discounts []models.ProductDiscount, now time.Time) models.QuoteLine {
+	line := models.QuoteLine{
+		ProductID:      item.ProductID,
+		VariantID:      item.VariantID,
+		SellerID:       product.SellerID,
+		Quantity:       item.Quantity,
+		Status:         product.Status,
+		Stock:          product.Quantity,
+		UnitPrice:      product.Price,
+		FinalUnitPrice: product.Price,
+	}
+
+	if discount, ok := ActiveDiscount(discounts, now); ok && discount.DiscountedPrice.LessThan(product.Price) {
+		line.Discount = &discount
+		line.FinalUnitPrice = discount.DiscountedPrice
+	}
+
+	line.LineTotal = line.FinalUnitPrice.Mul(int64(line.Quantity))
+	line.LineDiscount = line.UnitPrice.Sub(line.FinalUnitPrice).Mul(int64(line.Quantity))
+
+	return line
+}
+
+// ActiveDiscount возвращает скидку, действующую в момент now.
+// Если действуют несколько, выбирается начавшаяся последней.
+func ActiveDiscount(discounts []models.ProductDiscount, now time.Time) (models.ProductDiscount, bool) {
+	var (
+		latest models.ProductDiscount
+		found  bool
+	)
+
+	for _, discount := range discounts {
+		if now.Before(discount.DiscountStartDate) || now.After(discount.DiscountEndDate) {
+			continue
+		}
+		if !found || discount.DiscountStartDate.After(latest.DiscountStartDate) {
+			latest = discount
+			found = true
+		}
+	}
+
+	return latest, found
+}
+
+// trySendError Вспомогательная функция для безопасной отправки ошибки
+func trySendError(err error, errCh chan<- error, cancel context.CancelFunc) {
+	select {
+	case errCh <- err:
+		cancel()
+	default:
+		// Если ошибка уже есть - игнорируем (сохраняем первую)
+	}
+}
@@ -18,11 +18,13 @@ import (
 type IProductRepository interface {
 	GetAllProducts(ctx context.Context, offset int) ([]*models.Product, error)
 	GetProductByID(ctx context.Context, id uuid.UUID) (*models.Product, error)
+	GetProductVariants(ctx context.Context, productID uuid.UUID) ([]models.ProductVariant, error)
 	GetProductsByCategory(
 		ctx context.Context,
 		id uuid.UUID,
+		includeDescendants bool,
 		offset int,
-		minPrice, maxPrice float64,
+		minPrice, maxPrice models.Money,
 		minRating float32,
 		sortOption models.SortOption,
 	) ([]*models.Product, error)
@@ -62,6 +64,19 @@ func (u *ProductUsecase) GetProductByID(ctx context.Context, id uuid.UUID) (*mod
 		logger.WithError(err).Error("get product by ID from repository")
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+
+	// Карточка товара показывает его SKU и матрицу опций для выбора варианта
+	variants, err := u.repo.GetProductVariants(ctx, id)
+	if err != nil {
+		logger.WithError(err).Error("get product variants from repository")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(variants) > 0 {
+		product.HasVariants = true
+		product.Variants = variants
+		product.Options = models.BuildOptionMatrix(variants)
+	}
+
 	return product, nil
 }
 
@@ -139,8 +154,9 @@ func trySendError(err error, errCh chan<- error, cancel context.CancelFunc) {
 func (u *ProductUsecase) GetProductsByCategory(
 	ctx context.Context,
 	id uuid.UUID,
+	includeDescendants bool,
 	offset int,
-	minPrice, maxPrice float64,
+	minPrice, maxPrice models.Money,
 	minRating float32,
 	sortOption models.SortOption,
 ) ([]*models.Product, error) {
@@ -150,6 +166,7 @@ func (u *ProductUsecase) GetProductsByCategory(
 	products, err := u.repo.GetProductsByCategory(
 		ctx,
 		id,
+		includeDescendants,
 		offset,
 		minPrice,
 		maxPrice,
@@ -166,13 +183,13 @@ func (u *ProductUsecase) GetProductsByCategory(
 		sort.Slice(products, func(i, j int) bool {
 			priceI := helpers.GetFinalPrice(products[i])
 			priceJ := helpers.GetFinalPrice(products[j])
-			return priceI < priceJ
+			return priceI.LessThan(priceJ)
 		})
 	case models.SortByPriceDesc:
 		sort.Slice(products, func(i, j int) bool {
 			priceI := helpers.GetFinalPrice(products[i])
 			priceJ := helpers.GetFinalPrice(products[j])
-			return priceI > priceJ
+			return priceI.GreaterThan(priceJ)
 		})
 	case models.SortByRatingAsc:
 		sort.Slice(products, func(i, j int) bool {
@@ -196,7 +213,7 @@ func (u *ProductUsecase) AddProduct(ctx context.Context, product *models.Product
 		logger.Error("empty product name")
 		return nil, fmt.Errorf("%s: %w", op, errs.ErrEmptyProductName)
 	}
-	if product.Price <= 0 {
+	if !product.Price.IsPositive() {
 		logger.Error("invalid product price")
 		return nil, fmt.Errorf("%s: %w", op, errs.ErrInvalidProductPrice)
 	}
@@ -0,0 +1,242 @@
+package promo
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+const (
+	// Без похожих друг на друга символов (0/O, 1/I)
+	defaultCampaignAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+	defaultCampaignCodeLength = 8
+	minCampaignCodeLength     = 4
+	maxCampaignCodeLength     = 32
+	maxCampaignPrefixLength   = 16
+	maxCampaignCodes          = 10000
+
+	// Пространство кодов должно быть намного больше партии,
+	// чтобы коллизии с уже выданными кодами были редкими
+	campaignCodeSpaceFactor = 1000
+	campaignCreateAttempts  = 3
+)
+
+// CreateCampaign генерирует партию одноразовых промокодов с общими правилами.
+// При совпадении кода с уже существующим партия генерируется заново.
+func (uc *PromoUsecase) CreateCampaign(ctx context.Context, req dto.CreatePromoCampaignRequest) (dto.PromoCampaignResponse, error) {
+	const op = "PromoUsecase.CreateCampaign"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	campaign, err := campaignFromRequest(req)
+	if err != nil {
+		logger.WithError(err).Warn("invalid campaign")
+		return dto.PromoCampaignResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for attempt := 0; attempt < campaignCreateAttempts; attempt++ {
+		codes, err := GenerateCodes(campaign.Prefix, campaign.Alphabet, campaign.CodeLength, campaign.CodesCount)
+		if err != nil {
+			logger.WithError(err).Error("generate codes")
+			return dto.PromoCampaignResponse{}, fmt.Errorf("%s: %w", op, err)
+		}
+
+		err = uc.repo.CreateCampaign(ctx, campaign, codes)
+		if err == nil {
+			return dto.ConvertToPromoCampaignResponse(&campaign), nil
+		}
+		if !errors.Is(err, errs.ErrAlreadyExists) {
+			logger.WithError(err).Error("failed to create campaign")
+			return dto.PromoCampaignResponse{}, fmt.Errorf("%s: %w", op, err)
+		}
+
+		logger.WithField("attempt", attempt+1).Warn("generated code collision, retrying")
+	}
+
+	return dto.PromoCampaignResponse{}, fmt.Errorf("%s: %w", op, errs.NewAlreadyExistsError("failed to generate unique promo codes"))
+}
+
+// ExportCampaignCodes возвращает все коды кампании для выгрузки
+func (uc *PromoUsecase) ExportCampaignCodes(ctx context.Context, campaignID uuid.UUID) ([]models.PromoCampaignCode, error) {
+	const op = "PromoUsecase.ExportCampaignCodes"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	if _, err := uc.repo.GetCampaign(ctx, campaignID); err != nil {
+		logger.WithError(err).Error("failed to get campaign")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	codes, err := uc.repo.GetCampaignCodes(ctx, campaignID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get campaign codes")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return codes, nil
+}
+
+func (uc *PromoUsecase) DeactivateCampaign(ctx context.Context, campaignID uuid.UUID) error {
+	const op = "PromoUsecase.DeactivateCampaign"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	if err := uc.repo.DeactivateCampaign(ctx, campaignID); err != nil {
+		logger.WithError(err).Error("failed to deactivate campaign")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (uc *PromoUsecase) GetCampaignStats(ctx context.Context, campaignID uuid.UUID) (dto.PromoCampaignStatsResponse, error) {
+	const op = "PromoUsecase.GetCampaignStats"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	stats, err := uc.repo.GetCampaignStats(ctx, campaignID)
+	if err != nil {
+		logger.WithError(err).Error("failed to get campaign stats")
+		return dto.PromoCampaignStatsResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	resp := dto.PromoCampaignStatsResponse{
+		CampaignID:      stats.CampaignID,
+		Name:            stats.Name,
+		IsActive:        stats.IsActive,
+		CodesTotal:      stats.CodesTotal,
+		Redemptions:     stats.Redemptions,
+		Revenue:         stats.Revenue,
+		TotalDiscount:   stats.TotalDiscount,
+		AverageDiscount: stats.AverageDiscount,
+	}
+	if stats.CodesTotal > 0 {
+		resp.RedemptionRate = math.Round(float64(stats.Redemptions)/float64(stats.CodesTotal)*10000) / 10000
+	}
+
+	return resp, nil
+}
+
+// GenerateCodes возвращает count различных кодов вида prefix + length случайных символов алфавита
+func GenerateCodes(prefix, alphabet string, length, count int) ([]string, error) {
+	symbols := []rune(alphabet)
+	limit := big.NewInt(int64(len(symbols)))
+
+	seen := make(map[string]struct{}, count)
+	codes := make([]string, 0, count)
+
+	var sb strings.Builder
+	for len(codes) < count {
+		sb.Reset()
+		sb.WriteString(prefix)
+		for i := 0; i < length; i++ {
+			n, err := rand.Int(rand.Reader, limit)
+			if err != nil {
+				return nil, err
+			}
+			sb.WriteRune(symbols[n.Int64()])
+		}
+
+		code := sb.String()
+		if _, ok := seen[code]; ok {
+			continue
+		}
+		seen[code] = struct{}{}
+		codes = append(codes, code)
+	}
+
+	return codes, nil
+}
+
+func campaignFromRequest(req dto.CreatePromoCampaignRequest) (models.PromoCampaign, error) {
+	campaign := models.PromoCampaign{
+		ID:         uuid.New(),
+		Name:       strings.TrimSpace(req.Name),
+		Prefix:     req.Prefix,
+		Alphabet:   uniqueSymbols(req.Alphabet),
+		CodeLength: req.CodeLength,
+		CodesCount: req.Count,
+		IsActive:   true,
+		CreatedAt:  time.Now(),
+	}
+
+	if campaign.Name == "" {
+		return models.PromoCampaign{}, errs.NewBusinessLogicError("campaign name is required")
+	}
+	if campaign.CodesCount < 1 || campaign.CodesCount > maxCampaignCodes {
+		return models.PromoCampaign{}, errs.NewBusinessLogicError(
+			fmt.Sprintf("codes count must be between 1 and %d", maxCampaignCodes))
+	}
+
+	if campaign.Alphabet == "" {
+		campaign.Alphabet = defaultCampaignAlphabet
+	}
+	if len([]rune(campaign.Alphabet)) < 2 || !isCodeText(campaign.Alphabet) {
+		return models.PromoCampaign{}, errs.NewBusinessLogicError("alphabet must contain at least two printable non-space symbols")
+	}
+
+	if campaign.CodeLength == 0 {
+		campaign.CodeLength = defaultCampaignCodeLength
+	}
+	if campaign.CodeLength < minCampaignCodeLength || campaign.CodeLength > maxCampaignCodeLength {
+		return models.PromoCampaign{}, errs.NewBusinessLogicError(
+			fmt.Sprintf("code length must be between %d and %d", minCampaignCodeLength, maxCampaignCodeLength))
+	}
+
+	if len([]rune(campaign.Prefix)) > maxCampaignPrefixLength || !isCodeText(campaign.Prefix) {
+		return models.PromoCampaign{}, errs.NewBusinessLogicError("prefix is too long or contains invalid symbols")
+	}
+
+	space := math.Pow(float64(len([]rune(campaign.Alphabet))), float64(campaign.CodeLength))
+	if space < float64(campaign.CodesCount)*campaignCodeSpaceFactor {
+		return models.PromoCampaign{}, errs.NewBusinessLogicError("alphabet or code length is too small for the requested count")
+	}
+
+	template, err := promoFromRequest(req.Rules)
+	if err != nil {
+		return models.PromoCampaign{}, err
+	}
+
+	// Коды кампании одноразовые независимо от переданных лимитов
+	template.ID = uuid.Nil
+	template.Code = ""
+	template.UsageLimit = null.IntFrom(1)
+	template.PerUserLimit = null.IntFrom(1)
+	campaign.Template = template
+
+	return campaign, nil
+}
+
+func uniqueSymbols(alphabet string) string {
+	seen := make(map[rune]struct{}, len(alphabet))
+
+	var sb strings.Builder
+	for _, r := range alphabet {
+		if _, ok := seen[r]; ok {
+			continue
+		}
+		seen[r] = struct{}{}
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+func isCodeText(s string) bool {
+	for _, r := range s {
+		if unicode.IsSpace(r) || !unicode.IsPrint(r) || r == ',' || r == '"' {
+			return false
+		}
+	}
+	return true
+}
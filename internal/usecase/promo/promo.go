@@ -2,6 +2,7 @@ package promo
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -9,7 +10,9 @@ import (
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/helpers"
 	"github.com/google/uuid"
+	"github.com/guregu/null"
 )
 
 //go:generate mockgen -source=promo.go -destination=../../infrastructure/repository/postgres/mocks/promo_repository_mock.go -package=mocks IPromoRepository
@@ -17,13 +20,21 @@ type IPromoRepository interface {
 	Create(ctx context.Context, promo models.PromoCode) error
 	GetAll(ctx context.Context, offset int) ([]*models.PromoCode, error)
 	CheckPromoCode(ctx context.Context, code string) (*models.PromoCode, error)
+	GetPromoUsage(ctx context.Context, promoID, userID uuid.UUID) (models.PromoUsage, error)
+	GetUserCart(ctx context.Context, userID uuid.UUID) ([]models.PromoCartItem, error)
+	GetProductScopes(ctx context.Context, productIDs []uuid.UUID) (map[uuid.UUID]models.PromoProductScope, error)
+	CreateCampaign(ctx context.Context, campaign models.PromoCampaign, codes []string) error
+	GetCampaign(ctx context.Context, campaignID uuid.UUID) (*models.PromoCampaign, error)
+	DeactivateCampaign(ctx context.Context, campaignID uuid.UUID) error
+	GetCampaignCodes(ctx context.Context, campaignID uuid.UUID) ([]models.PromoCampaignCode, error)
+	GetCampaignStats(ctx context.Context, campaignID uuid.UUID) (models.PromoCampaignStats, error)
 }
 
 type PromoUsecase struct {
 	repo IPromoRepository
 }
 
-func NewPromoUsecase(repo IPromoRepository) *PromoUsecase{
+func NewPromoUsecase(repo IPromoRepository) *PromoUsecase {
 	return &PromoUsecase{repo: repo}
 }
 
@@ -31,12 +42,10 @@ func (uc *PromoUsecase) CreatePromo(ctx context.Context, req dto.CreatePromoRequ
 	const op = "PromoUsecase.CreatePromo"
 	logger := logctx.GetLogger(ctx).WithField("op", op)
 
-	promoDB := models.PromoCode{
-		ID:        uuid.New(),
-		Code:      req.Code,
-		Percent:   req.Percent,
-		StartDate: req.StartDate,
-		EndDate:   req.EndDate,
+	promoDB, err := promoFromRequest(req)
+	if err != nil {
+		logger.WithError(err).Warn("invalid promo rules")
+		return dto.PromoResponse{}, fmt.Errorf("%s: %w", op, err)
 	}
 
 	if err := uc.repo.Create(ctx, promoDB); err != nil {
@@ -44,13 +53,7 @@ func (uc *PromoUsecase) CreatePromo(ctx context.Context, req dto.CreatePromoRequ
 		return dto.PromoResponse{}, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return dto.PromoResponse{
-		ID:        promoDB.ID,
-		Code:      promoDB.Code,
-		Percent:   promoDB.Percent,
-		StartDate: promoDB.StartDate,
-		EndDate:   promoDB.EndDate,
-	}, nil
+	return dto.ConvertToPromoResponse(&promoDB), nil
 }
 
 func (uc *PromoUsecase) GetAllPromos(ctx context.Context, offset int) (dto.PromosResponse, error) {
@@ -70,26 +73,153 @@ func (uc *PromoUsecase) GetAllPromos(ctx context.Context, offset int) (dto.Promo
 	return dto.ConvertToPromosResponse(promos), nil
 }
 
+// CheckPromoCode проверяет промокод для текущего пользователя и его корзины.
+// Отказ не считается ошибкой: причина возвращается в ответе.
 func (uc *PromoUsecase) CheckPromoCode(ctx context.Context, code string) (dto.PromoValidityResponse, error) {
-    const op = "PromoUsecase.GetAllPromos"
+	const op = "PromoUsecase.CheckPromoCode"
 	logger := logctx.GetLogger(ctx).WithField("op", op)
-	
+
 	promo, err := uc.repo.CheckPromoCode(ctx, code)
-    if err != nil {
+	if err != nil {
+		if errors.Is(err, errs.ErrNotFound) {
+			return rejectedResponse(reject(ReasonNotFound)), nil
+		}
 		logger.WithError(err).Error("failed to get promo")
-        return dto.PromoValidityResponse{IsValid: false}, nil
-    }
-    
-    now := time.Now()
-    isValid := now.After(promo.StartDate) && now.Before(promo.EndDate)
-    
-    response := dto.PromoValidityResponse{
-        IsValid: isValid,
-    }
-    
-    if isValid {
-        response.Percent = promo.Percent
-    }
-    
-    return response, nil
-}
\ No newline at end of file
+		return dto.PromoValidityResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var (
+		usage models.PromoUsage
+		cart  []models.PromoCartItem
+	)
+
+	// Без пользователя проверяются только правила самого промокода
+	userID, userErr := helpers.GetUserIDFromContext(ctx)
+	if userErr == nil {
+		if usage, err = uc.repo.GetPromoUsage(ctx, promo.ID, userID); err != nil {
+			logger.WithError(err).Error("failed to get promo usage")
+			return dto.PromoValidityResponse{}, fmt.Errorf("%s: %w", op, err)
+		}
+		if cart, err = uc.repo.GetUserCart(ctx, userID); err != nil {
+			logger.WithError(err).Error("failed to get user cart")
+			return dto.PromoValidityResponse{}, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err = CheckAvailability(promo, usage, time.Now()); err != nil {
+		return rejectedResponse(err), nil
+	}
+
+	var discount models.Money
+	if len(cart) > 0 {
+		if discount, err = CalculateDiscount(promo, cart); err != nil {
+			return rejectedResponse(err), nil
+		}
+	}
+
+	response := dto.PromoValidityResponse{
+		IsValid:      true,
+		DiscountType: string(promo.DiscountType),
+		Discount:     discount,
+	}
+
+	if promo.DiscountType == models.PromoDiscountFixed {
+		response.Amount = promo.Amount
+	} else {
+		response.Percent = promo.Percent
+	}
+
+	return response, nil
+}
+
+func rejectedResponse(err error) dto.PromoValidityResponse {
+	var rejected *RejectedError
+	if !errors.As(err, &rejected) {
+		return dto.PromoValidityResponse{IsValid: false}
+	}
+
+	return dto.PromoValidityResponse{
+		IsValid: false,
+		Reason:  string(rejected.Reason),
+		Message: rejected.Message(),
+	}
+}
+
+func promoFromRequest(req dto.CreatePromoRequest) (models.PromoCode, error) {
+	promo := models.PromoCode{
+		ID:                 uuid.New(),
+		Code:               req.Code,
+		Percent:            req.Percent,
+		StartDate:          req.StartDate,
+		EndDate:            req.EndDate,
+		DiscountType:       models.PromoDiscountPercent,
+		Amount:             req.Amount,
+		MinOrderAmount:     req.MinOrderAmount,
+		FirstOrderOnly:     req.FirstOrderOnly,
+		StackWithDiscounts: true,
+	}
+
+	if !promo.EndDate.After(promo.StartDate) {
+		return models.PromoCode{}, errs.NewBusinessLogicError("end date must be after start date")
+	}
+
+	if req.DiscountType != "" {
+		promo.DiscountType = models.PromoDiscountType(req.DiscountType)
+	}
+
+	switch promo.DiscountType {
+	case models.PromoDiscountPercent:
+		if promo.Percent < 1 || promo.Percent > 100 {
+			return models.PromoCode{}, errs.NewBusinessLogicError("percent must be between 1 and 100")
+		}
+		promo.Amount = models.Money{}
+	case models.PromoDiscountFixed:
+		if !promo.Amount.IsPositive() {
+			return models.PromoCode{}, errs.NewBusinessLogicError("fixed discount amount must be positive")
+		}
+		promo.Percent = 0
+	default:
+		return models.PromoCode{}, errs.NewBusinessLogicError("unknown discount type")
+	}
+
+	if promo.MinOrderAmount.IsNegative() {
+		return models.PromoCode{}, errs.NewBusinessLogicError("minimum order amount must not be negative")
+	}
+
+	if req.MaxDiscount != nil {
+		if !req.MaxDiscount.IsPositive() {
+			return models.PromoCode{}, errs.NewBusinessLogicError("max discount must be positive")
+		}
+		promo.MaxDiscount = models.NullMoneyFrom(*req.MaxDiscount)
+	}
+	if req.UsageLimit != nil {
+		if *req.UsageLimit <= 0 {
+			return models.PromoCode{}, errs.NewBusinessLogicError("usage limit must be positive")
+		}
+		promo.UsageLimit = null.IntFrom(int64(*req.UsageLimit))
+	}
+	if req.PerUserLimit != nil {
+		if *req.PerUserLimit <= 0 {
+			return models.PromoCode{}, errs.NewBusinessLogicError("per-user limit must be positive")
+		}
+		promo.PerUserLimit = null.IntFrom(int64(*req.PerUserLimit))
+	}
+	if req.StackWithDiscounts != nil {
+		promo.StackWithDiscounts = *req.StackWithDiscounts
+	}
+
+	for _, scope := range req.Scopes {
+		scopeType := models.PromoScopeType(scope.Type)
+		switch scopeType {
+		case models.PromoScopeCategory, models.PromoScopeSeller, models.PromoScopeProduct:
+		default:
+			return models.PromoCode{}, errs.NewBusinessLogicError("unknown promo scope type")
+		}
+		promo.Scopes = append(promo.Scopes, models.PromoScope{
+			Type:     scopeType,
+			TargetID: scope.ID,
+		})
+	}
+
+	return promo, nil
+}
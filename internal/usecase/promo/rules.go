@@ -0,0 +1,151 @@
+package promo
+
+import (
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/google/uuid"
+)
+
+// RejectReason — машиночитаемая причина отказа в применении промокода
+type RejectReason string
+
+const (
+	ReasonNotFound       RejectReason = "not_found"
+	ReasonNotStarted     RejectReason = "not_started"
+	ReasonExpired        RejectReason = "expired"
+	ReasonUsageLimit     RejectReason = "usage_limit_reached"
+	ReasonUserLimit      RejectReason = "user_limit_reached"
+	ReasonFirstOrderOnly RejectReason = "first_order_only"
+	ReasonMinOrderAmount RejectReason = "min_order_amount"
+	ReasonNotApplicable  RejectReason = "not_applicable"
+)
+
+var reasonMessages = map[RejectReason]string{
+	ReasonNotFound:       "Промокод не найден",
+	ReasonNotStarted:     "Промокод ещё не действует",
+	ReasonExpired:        "Срок действия промокода истёк",
+	ReasonUsageLimit:     "Промокод больше недоступен",
+	ReasonUserLimit:      "Вы уже использовали этот промокод максимальное число раз",
+	ReasonFirstOrderOnly: "Промокод действует только на первый заказ",
+	ReasonMinOrderAmount: "Сумма заказа меньше минимальной для промокода",
+	ReasonNotApplicable:  "Промокод не распространяется на товары в корзине",
+}
+
+// RejectedError сообщает, почему промокод не может быть применён.
+// Оборачивает errs.ErrBusinessLogic, поэтому транспорт отвечает 422.
+type RejectedError struct {
+	Reason RejectReason
+}
+
+func (e *RejectedError) Error() string {
+	return "promo code rejected: " + string(e.Reason)
+}
+
+func (e *RejectedError) Unwrap() error {
+	return errs.ErrBusinessLogic
+}
+
+// Message возвращает описание причины для пользователя
+func (e *RejectedError) Message() string {
+	return reasonMessages[e.Reason]
+}
+
+func reject(reason RejectReason) error {
+	return &RejectedError{Reason: reason}
+}
+
+// CheckAvailability проверяет правила промокода, не зависящие от состава корзины:
+// срок действия, лимиты использования и ограничение на первый заказ
+func CheckAvailability(promo *models.PromoCode, usage models.PromoUsage, now time.Time) error {
+	switch {
+	case now.Before(promo.StartDate):
+		return reject(ReasonNotStarted)
+	case now.After(promo.EndDate):
+		return reject(ReasonExpired)
+	case promo.UsageLimit.Valid && int64(usage.Total) >= promo.UsageLimit.Int64:
+		return reject(ReasonUsageLimit)
+	case promo.PerUserLimit.Valid && int64(usage.ByUser) >= promo.PerUserLimit.Int64:
+		return reject(ReasonUserLimit)
+	case promo.FirstOrderOnly && usage.UserOrders > 0:
+		return reject(ReasonFirstOrderOnly)
+	}
+
+	return nil
+}
+
+// CalculateDiscount считает скидку по промокоду для набора позиций.
+// Минимальная сумма сравнивается с суммой всей корзины с учётом скидок на товары.
+// Промокод, несовместимый со скидками, применяется только к товарам без скидки.
+func CalculateDiscount(promo *models.PromoCode, items []models.PromoCartItem) (models.Money, error) {
+	var cartTotal, eligibleTotal models.Money
+
+	for _, item := range items {
+		lineTotal := item.FinalPrice.Mul(int64(item.Quantity))
+		cartTotal = cartTotal.Add(lineTotal)
+
+		if !inScope(promo.Scopes, item) {
+			continue
+		}
+		if !promo.StackWithDiscounts && item.FinalPrice.LessThan(item.Price) {
+			continue
+		}
+		eligibleTotal = eligibleTotal.Add(lineTotal)
+	}
+
+	if cartTotal.LessThan(promo.MinOrderAmount) {
+		return models.Money{}, reject(ReasonMinOrderAmount)
+	}
+	if !eligibleTotal.IsPositive() {
+		return models.Money{}, reject(ReasonNotApplicable)
+	}
+
+	var discount models.Money
+	switch promo.DiscountType {
+	case models.PromoDiscountFixed:
+		discount = promo.Amount
+	default:
+		discount = eligibleTotal.Percent(promo.Percent)
+	}
+
+	if promo.MaxDiscount.Valid {
+		discount = models.MinMoney(discount, promo.MaxDiscount.Money)
+	}
+
+	return models.MinMoney(discount, eligibleTotal), nil
+}
+
+func inScope(scopes []models.PromoScope, item models.PromoCartItem) bool {
+	if len(scopes) == 0 {
+		return true
+	}
+
+	for _, scope := range scopes {
+		switch scope.Type {
+		case models.PromoScopeProduct:
+			if scope.TargetID == item.ProductID {
+				return true
+			}
+		case models.PromoScopeSeller:
+			if scope.TargetID == item.SellerID {
+				return true
+			}
+		case models.PromoScopeCategory:
+			if containsID(item.CategoryIDs, scope.TargetID) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func containsID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
@@ -3,42 +3,170 @@ package recommendation
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
 	recommendationRepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/recommendation"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/product"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/helpers"
 	"github.com/google/uuid"
-	"sync"
 )
 
+// recommendationsLimit — размер одной подборки рекомендаций
+const recommendationsLimit = 10
+
 //go:generate mockgen -source=recommendation.go -destination=../mocks/recommendation_usecase_mock.go -package=mocks IRecommendationUsecase
 type IRecommendationUsecase interface {
 	GetRecommendations(ctx context.Context, productID uuid.UUID) ([]*models.Product, error)
+	GetPersonalRecommendations(ctx context.Context) ([]*models.Product, error)
+}
+
+// IRecommendationCache хранит готовые подборки идентификаторов товаров
+type IRecommendationCache interface {
+	GetProductIDs(ctx context.Context, key string) ([]uuid.UUID, error)
+	SetProductIDs(ctx context.Context, key string, ids []uuid.UUID) error
 }
 
 type RecommendationUsecase struct {
-	pu product.IProductUsecase
-	rr recommendationRepo.IRecommendationRepository
+	pu    product.IProductUsecase
+	rr    recommendationRepo.IRecommendationRepository
+	cache IRecommendationCache
+	conf  *config.RecommendationConfig
 }
 
+// NewRecommendationUsecase создаёт usecase рекомендаций. cache может быть nil —
+// тогда подборки считаются при каждом запросе.
 func NewRecommendationUsecase(
 	productUscase product.IProductUsecase,
 	recommendationRepo recommendationRepo.IRecommendationRepository,
+	cache IRecommendationCache,
+	conf *config.RecommendationConfig,
 ) *RecommendationUsecase {
 	return &RecommendationUsecase{
-		rr: recommendationRepo,
-		pu: productUscase,
+		rr:    recommendationRepo,
+		pu:    productUscase,
+		cache: cache,
+		conf:  conf,
 	}
 }
 
+// GetRecommendations подбирает товары для карточки товара: сначала "с этим покупают",
+// затем популярные товары из тех же подкатегорий и, наконец, самые популярные товары
 func (u *RecommendationUsecase) GetRecommendations(ctx context.Context, productID uuid.UUID) ([]*models.Product, error) {
 	const op = "RecommendationUsecase.GetRecommendations"
 	logger := logctx.GetLogger(ctx).WithField("op", op)
 
+	cacheKey := "product:" + productID.String()
+	if ids, ok := u.getCached(ctx, cacheKey); ok {
+		return u.loadProducts(ctx, op, ids)
+	}
+
+	picker := newIDPicker(recommendationsLimit, productID)
+
+	similarIDs, err := u.rr.GetSimilarProductIDs(ctx, productID, recommendationsLimit)
+	if err != nil {
+		logger.WithError(err).Error("get similar product ids")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	picker.add(similarIDs)
+
+	if !picker.full() {
+		categoryIDs, err := u.getProductIDsByCategories(ctx, productID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		picker.add(categoryIDs)
+	}
+
+	if err := u.fillWithPopular(ctx, picker); err != nil {
+		logger.WithError(err).Error("get popular product ids")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	u.setCached(ctx, cacheKey, picker.ids)
+
+	return u.loadProducts(ctx, op, picker.ids)
+}
+
+// GetPersonalRecommendations строит ленту для текущего пользователя по истории заказов
+// и корзины; при отсутствии истории возвращает популярные товары
+func (u *RecommendationUsecase) GetPersonalRecommendations(ctx context.Context) ([]*models.Product, error) {
+	const op = "RecommendationUsecase.GetPersonalRecommendations"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	userID, err := helpers.GetUserIDFromContext(ctx)
+	if err != nil {
+		logger.WithError(err).Warn("user ID not found in context")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	cacheKey := "user:" + userID.String()
+	if ids, ok := u.getCached(ctx, cacheKey); ok {
+		return u.loadProducts(ctx, op, ids)
+	}
+
+	picker := newIDPicker(recommendationsLimit, uuid.Nil)
+
+	personalIDs, err := u.rr.GetPersonalProductIDs(ctx, userID, recommendationsLimit)
+	if err != nil {
+		logger.WithError(err).Error("get personal product ids")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	picker.add(personalIDs)
+
+	if err := u.fillWithPopular(ctx, picker); err != nil {
+		logger.WithError(err).Error("get popular product ids")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	u.setCached(ctx, cacheKey, picker.ids)
+
+	return u.loadProducts(ctx, op, picker.ids)
+}
+
+// RunRefresher пересчитывает таблицы похожести сразу и затем с периодом RefreshInterval
+// до отмены контекста. Неположительный интервал отключает пересчёт.
+func (u *RecommendationUsecase) RunRefresher(ctx context.Context) {
+	const op = "RecommendationUsecase.RunRefresher"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	if u.conf.RefreshInterval <= 0 {
+		logger.Warn("recommendation refresh disabled")
+		return
+	}
+
+	ticker := time.NewTicker(u.conf.RefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		refreshed, err := u.rr.RefreshSimilarity(ctx, u.conf.SimilarPerProduct)
+		switch {
+		case err != nil:
+			logger.WithError(err).Error("refresh product similarity")
+		case refreshed:
+			logger.Info("product similarity refreshed")
+		default:
+			logger.Debug("product similarity is being refreshed by another instance")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (u *RecommendationUsecase) getProductIDsByCategories(ctx context.Context, productID uuid.UUID) ([]uuid.UUID, error) {
+	logger := logctx.GetLogger(ctx)
+
 	subcatIDs, err := u.rr.GetCategoryIDsByProductID(ctx, productID)
 	if err != nil {
 		logger.WithError(err).Error("get subcategory ids from repository")
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, err
 	}
 
 	var mu sync.Mutex
@@ -48,7 +176,6 @@ func (u *RecommendationUsecase) GetRecommendations(ctx context.Context, productI
 	defer cancel()
 
 	var productIDsByCategory []uuid.UUID
-	var productIDRec []uuid.UUID
 	savedProductIDs := make(map[uuid.UUID]bool)
 
 	for _, subcatID := range subcatIDs {
@@ -59,7 +186,7 @@ func (u *RecommendationUsecase) GetRecommendations(ctx context.Context, productI
 				return
 			}
 
-			productIDs, err := u.rr.GetProductIDsBySubcategoryID(ctx, subcatID, 10)
+			productIDs, err := u.rr.GetProductIDsBySubcategoryID(ctx, subcatID, recommendationsLimit)
 			if err != nil {
 				logger.WithError(err).WithField("product_ids", productIDs).Warn("failed to get product IDs by Subcategory IDs")
 				return
@@ -78,23 +205,90 @@ func (u *RecommendationUsecase) GetRecommendations(ctx context.Context, productI
 
 	wg.Wait()
 
-	if len(productIDsByCategory) == 0 {
-		return nil, nil
+	return productIDsByCategory, nil
+}
+
+func (u *RecommendationUsecase) fillWithPopular(ctx context.Context, picker *idPicker) error {
+	if picker.full() {
+		return nil
 	}
 
-	productIDsByCategory = productIDsByCategory[:min(10, len(productIDsByCategory))]
+	// Запрашиваем с запасом: часть популярных товаров может уже быть в подборке
+	popularIDs, err := u.rr.GetPopularProductIDs(ctx, recommendationsLimit*2)
+	if err != nil {
+		return err
+	}
+	picker.add(popularIDs)
 
-	for _, id := range productIDsByCategory {
-		if id != productID {
-			productIDRec = append(productIDRec, id)
-		}
+	return nil
+}
+
+func (u *RecommendationUsecase) loadProducts(ctx context.Context, op string, ids []uuid.UUID) ([]*models.Product, error) {
+	if len(ids) == 0 {
+		return nil, nil
 	}
 
-	productsRecommendation, err := u.pu.GetProductsByIDs(ctx, productIDRec)
+	products, err := u.pu.GetProductsByIDs(ctx, ids)
 	if err != nil {
-		logger.WithError(err).Error("get productsRecommendation by IDs")
+		logctx.GetLogger(ctx).WithField("op", op).WithError(err).Error("get productsRecommendation by IDs")
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	return productsRecommendation, nil
+	return products, nil
+}
+
+func (u *RecommendationUsecase) getCached(ctx context.Context, key string) ([]uuid.UUID, bool) {
+	if u.cache == nil {
+		return nil, false
+	}
+
+	ids, err := u.cache.GetProductIDs(ctx, key)
+	if err != nil {
+		logctx.GetLogger(ctx).WithError(err).Warn("get cached recommendations")
+		return nil, false
+	}
+
+	return ids, ids != nil
+}
+
+func (u *RecommendationUsecase) setCached(ctx context.Context, key string, ids []uuid.UUID) {
+	if u.cache == nil {
+		return
+	}
+
+	if err := u.cache.SetProductIDs(ctx, key, ids); err != nil {
+		logctx.GetLogger(ctx).WithError(err).Warn("cache recommendations")
+	}
+}
+
+// idPicker собирает подборку без повторов, ограниченную limit элементами
+type idPicker struct {
+	ids   []uuid.UUID
+	seen  map[uuid.UUID]bool
+	limit int
+}
+
+func newIDPicker(limit int, exclude uuid.UUID) *idPicker {
+	return &idPicker{
+		ids:   make([]uuid.UUID, 0, limit),
+		seen:  map[uuid.UUID]bool{exclude: true},
+		limit: limit,
+	}
+}
+
+func (p *idPicker) add(ids []uuid.UUID) {
+	for _, id := range ids {
+		if p.full() {
+			return
+		}
+		if p.seen[id] {
+			continue
+		}
+		p.seen[id] = true
+		p.ids = append(p.ids, id)
+	}
+}
+
+func (p *idPicker) full() bool {
+	return len(p.ids) >= p.limit
 }
@@ -0,0 +1,130 @@
+package reservation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/helpers"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=reservation.go -destination=../../infrastructure/repository/postgres/mocks/reservation_repository_mock.go -package=mocks IReservationRepository
+type IReservationRepository interface {
+	Hold(ctx context.Context, userID uuid.UUID, items []models.PricingItem, expiresAt time.Time) ([]models.StockReservation, error)
+	ReleaseExpired(ctx context.Context, limit int) (int64, error)
+}
+
+type ReservationUsecase struct {
+	repo IReservationRepository
+	conf *config.ReservationConfig
+	now  func() time.Time
+}
+
+func NewReservationUsecase(repo IReservationRepository, conf *config.ReservationConfig) *ReservationUsecase {
+	return &ReservationUsecase{
+		repo: repo,
+		conf: conf,
+		now:  time.Now,
+	}
+}
+
+// Hold резервирует товары на время оформления заказа. Повторный вызов
+// заменяет прежний резерв пользователя.
+func (u *ReservationUsecase) Hold(ctx context.Context, req dto.CheckoutRequest) (dto.CheckoutResponse, error) {
+	const op = "ReservationUsecase.Hold"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	userID, err := helpers.GetUserIDFromContext(ctx)
+	if err != nil {
+		logger.WithError(err).Error("get user ID from context")
+		return dto.CheckoutResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(req.Items) == 0 {
+		return dto.CheckoutResponse{}, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("no items to reserve"))
+	}
+
+	// Одинаковые товары (SKU) складываются в одну позицию резерва
+	quantities := make(map[models.StockKey]uint, len(req.Items))
+	items := make([]models.PricingItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		if item.ProductID == uuid.Nil {
+			return dto.CheckoutResponse{}, fmt.Errorf("%s: %w", op, errs.ErrInvalidID)
+		}
+		if item.Quantity == 0 {
+			return dto.CheckoutResponse{}, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("invalid quantity"))
+		}
+		key := models.StockKey{ProductID: item.ProductID, VariantID: item.VariantNullID()}
+		if _, ok := quantities[key]; !ok {
+			items = append(items, models.PricingItem{ProductID: key.ProductID, VariantID: key.VariantID})
+		}
+		quantities[key] += item.Quantity
+	}
+	for i := range items {
+		items[i].Quantity = quantities[items[i].Key()]
+	}
+
+	expiresAt := u.now().Add(u.conf.TTL)
+	reservations, err := u.repo.Hold(ctx, userID, items, expiresAt)
+	if err != nil {
+		logger.WithError(err).WithField("user_id", userID).Warn("hold stock")
+		return dto.CheckoutResponse{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dto.ConvertToCheckoutResponse(reservations, expiresAt), nil
+}
+
+// RunSweeper возвращает просроченные резервы в остаток с периодом SweepInterval
+// до отмены контекста. Неположительный интервал отключает очистку.
+func (u *ReservationUsecase) RunSweeper(ctx context.Context) {
+	const op = "ReservationUsecase.RunSweeper"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	if u.conf.SweepInterval <= 0 {
+		logger.Warn("reservation sweeper disabled")
+		return
+	}
+
+	ticker := time.NewTicker(u.conf.SweepInterval)
+	defer ticker.Stop()
+
+	for {
+		released, err := u.ReleaseExpired(ctx)
+		if err != nil {
+			logger.WithError(err).Error("release expired reservations")
+		} else if released > 0 {
+			logger.WithField("released", released).Info("expired reservations released")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ReleaseExpired освобождает просроченные резервы пачками по SweepBatch,
+// пока не останется ни одного, и возвращает их количество
+func (u *ReservationUsecase) ReleaseExpired(ctx context.Context) (int64, error) {
+	const op = "ReservationUsecase.ReleaseExpired"
+
+	var total int64
+	for {
+		released, err := u.repo.ReleaseExpired(ctx, u.conf.SweepBatch)
+		if err != nil {
+			return total, fmt.Errorf("%s: %w", op, err)
+		}
+		total += released
+
+		if released < int64(u.conf.SweepBatch) || ctx.Err() != nil {
+			return total, nil
+		}
+	}
+}
@@ -4,66 +4,372 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/domains"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/helpers"
 	"github.com/google/uuid"
 )
 
 //go:generate mockgen -source=review.go -destination=../../infrastructure/repository/postgres/mocks/review_repository_mock.go -package=mocks IReviewRepository
 type IReviewRepository interface{
 	AddReview(ctx context.Context, review models.ReviewDB) error
-	GetReview(ctx context.Context, productID uuid.UUID, offset int) ([]*models.Review, error)
+	GetReview(ctx context.Context, productID uuid.UUID, offset int, sort models.ReviewSort) ([]*models.Review, error)
+	UpdateReview(ctx context.Context, review models.ReviewDB) error
+	DeleteReview(ctx context.Context, reviewID, userID uuid.UUID) error
+	GetReviewAuthorID(ctx context.Context, reviewID uuid.UUID) (uuid.UUID, error)
+	GetReviewProductID(ctx context.Context, reviewID uuid.UUID) (uuid.UUID, error)
+	GetReviewSellerID(ctx context.Context, reviewID uuid.UUID) (uuid.UUID, error)
+	AddReviewPhoto(ctx context.Context, photo models.ReviewPhotoDB, limit int) error
+	VoteReview(ctx context.Context, vote models.ReviewVoteDB) error
+	AddReply(ctx context.Context, reply models.ReviewReplyDB) error
+	HasDeliveredPurchase(ctx context.Context, userID, productID uuid.UUID) (bool, error)
+	GetReviewSummary(ctx context.Context, productID uuid.UUID) (*models.ReviewSummary, error)
+}
+
+// IReviewSummaryCache хранит готовые сводки по отзывам товара
+type IReviewSummaryCache interface {
+	GetSummary(ctx context.Context, productID uuid.UUID) (*models.ReviewSummary, error)
+	SetSummary(ctx context.Context, summary *models.ReviewSummary) error
+	InvalidateSummary(ctx context.Context, productID uuid.UUID) error
 }
 
 type ReviewUsecase struct {
-	repo IReviewRepository
+	repo     IReviewRepository
+	conf     *config.ReviewConfig
+	screener IReviewScreener
+	cache    IReviewSummaryCache
 }
 
-func NewReviewUsecase(repo IReviewRepository) *ReviewUsecase {
+// NewReviewUsecase создаёт usecase отзывов. cache может быть nil — тогда сводки
+// считаются при каждом запросе.
+func NewReviewUsecase(repo IReviewRepository, conf *config.ReviewConfig, screener IReviewScreener, cache IReviewSummaryCache) *ReviewUsecase {
 	return &ReviewUsecase{
-		repo : repo,
+		repo:     repo,
+		conf:     conf,
+		screener: screener,
+		cache:    cache,
+	}
+}
+
+// invalidateSummary сбрасывает сводку товара после изменения его отзывов.
+// Ошибка кэша не мешает изменению: сводка устареет не дольше чем на TTL.
+func (u *ReviewUsecase) invalidateSummary(ctx context.Context, productID uuid.UUID) {
+	if u.cache == nil {
+		return
+	}
+	if err := u.cache.InvalidateSummary(ctx, productID); err != nil {
+		logctx.GetLogger(ctx).WithError(err).WithField("product_id", productID).Warn("invalidate review summary")
+	}
+}
+
+// invalidateReviewSummary сбрасывает сводку товара, к которому относится отзыв
+func (u *ReviewUsecase) invalidateReviewSummary(ctx context.Context, reviewID uuid.UUID) {
+	if u.cache == nil {
+		return
+	}
+	productID, err := u.repo.GetReviewProductID(ctx, reviewID)
+	if err != nil {
+		logctx.GetLogger(ctx).WithError(err).WithField("review_id", reviewID).Warn("get review product")
+		return
+	}
+	u.invalidateSummary(ctx, productID)
+}
+
+// moderate прогоняет отзыв через проверки: чистый отзыв публикуется сразу,
+// подозрительный уходит в очередь модерации с указанием причины
+func (u *ReviewUsecase) moderate(ctx context.Context, review *models.ReviewDB) error {
+	reason, err := u.screener.Screen(ctx, *review)
+	if err != nil {
+		return err
+	}
+
+	if reason != "" {
+		review.Status = models.ReviewPending
+		review.ModerationReason = reason
+		return nil
 	}
+
+	review.Status = models.ReviewPublished
+	review.ModerationReason = ""
+	return nil
 }
 
-func (u *ReviewUsecase) Add(ctx context.Context, req dto.AddReviewRequest) error {
+func (u *ReviewUsecase) Add(ctx context.Context, req dto.AddReviewRequest) (models.ReviewStatus, error) {
 	const op = "ReviewUsecase.Add"
 	logger := logctx.GetLogger(ctx).WithField("op", op)
 
 	userIDStr, isExist := ctx.Value(domains.UserIDKey{}).(string)
 	if !isExist || userIDStr == "" {
 		logger.Warn("user ID not found in context")
-		return fmt.Errorf("%s: %w", op, errs.ErrNotFound)
+		return "", fmt.Errorf("%s: %w", op, errs.ErrNotFound)
 	}
 
 	userID, err := uuid.Parse(userIDStr)
 	if err != nil {
 		logger.WithError(err).Error("invalid user ID format")
-		return fmt.Errorf("%s: %w", op, errs.ErrInvalidID)
+		return "", fmt.Errorf("%s: %w", op, errs.ErrInvalidID)
+	}
+
+	purchased, err := u.repo.HasDeliveredPurchase(ctx, userID, req.ProductID)
+	if err != nil {
+		logger.WithError(err).Error("check purchase")
+		return "", fmt.Errorf("%s: %w", op, err)
 	}
-	
+
+	if !purchased && u.conf.RequireVerifiedPurchase {
+		logger.Warn("review without delivered purchase")
+		return "", fmt.Errorf("%s: %w: product was not purchased", op, errs.ErrForbidden)
+	}
+
 	review := models.ReviewDB{
-		ID:        uuid.New(),
-		UserID:    userID,
-		ProductID: req.ProductID,
-		Rating:    req.Rating,
-		Comment:   req.Comment,
+		ID:               uuid.New(),
+		UserID:           userID,
+		ProductID:        req.ProductID,
+		Rating:           req.Rating,
+		Comment:          req.Comment,
+		VerifiedPurchase: purchased,
+	}
+
+	if err := u.moderate(ctx, &review); err != nil {
+		logger.WithError(err).Error("screen review")
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	if review.Status == models.ReviewPending {
+		logger.WithField("reason", review.ModerationReason).Info("review sent to moderation")
 	}
 
 	if err := u.repo.AddReview(ctx, review); err != nil {
-		return err
+		return "", err
 	}
 
-	return nil
+	// Отзыв на модерации не влияет на сводку, пока его не опубликуют
+	if review.Status == models.ReviewPublished {
+		u.invalidateSummary(ctx, review.ProductID)
+	}
+
+	return review.Status, nil
 }
 
 func (u *ReviewUsecase) Get(ctx context.Context, req dto.GetReviewRequest) ([]*models.Review, error) {
-	reviews, err := u.repo.GetReview(ctx, req.ProductID, req.Offset)
+	sort := models.ReviewSort(req.Sort)
+	if !sort.IsValid() {
+		sort = models.ReviewSortNewest
+	}
+
+	reviews, err := u.repo.GetReview(ctx, req.ProductID, req.Offset, sort)
 	if err != nil {
 		return nil, err
 	}
 
 	return reviews, nil
-}
\ No newline at end of file
+}
+
+// Summary возвращает распределение оценок и последние отзывы товара, используя кэш при наличии
+func (u *ReviewUsecase) Summary(ctx context.Context, productID uuid.UUID) (*models.ReviewSummary, error) {
+	const op = "ReviewUsecase.Summary"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("productID", productID)
+
+	if u.cache != nil {
+		cached, err := u.cache.GetSummary(ctx, productID)
+		if err != nil {
+			logger.WithError(err).Warn("get cached review summary")
+		} else if cached != nil {
+			return cached, nil
+		}
+	}
+
+	summary, err := u.repo.GetReviewSummary(ctx, productID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	recent, err := u.repo.GetReview(ctx, productID, 0, models.ReviewSortNewest)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(recent) > u.conf.SummaryRecentCount {
+		recent = recent[:u.conf.SummaryRecentCount]
+	}
+	summary.Recent = recent
+
+	if u.cache != nil {
+		if err := u.cache.SetSummary(ctx, summary); err != nil {
+			logger.WithError(err).Warn("cache review summary")
+		}
+	}
+
+	return summary, nil
+}
+
+// Update меняет отзыв автора. Проверка правки может опубликовать только уже
+// опубликованный отзыв: отклонённый модератором отзыв правкой не возвращается.
+func (u *ReviewUsecase) Update(ctx context.Context, req dto.UpdateReviewRequest) error {
+	const op = "ReviewUsecase.Update"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	userID, err := helpers.GetUserIDFromContext(ctx)
+	if err != nil {
+		logger.WithError(err).Warn("user ID not found in context")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	review := models.ReviewDB{
+		ID:      req.ReviewID,
+		UserID:  userID,
+		Rating:  req.Rating,
+		Comment: req.Comment,
+	}
+
+	if err := u.moderate(ctx, &review); err != nil {
+		logger.WithError(err).Error("screen review")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := u.repo.UpdateReview(ctx, review); err != nil {
+		return err
+	}
+
+	// Изменённая оценка или ушедший на модерацию отзыв меняют сводку
+	u.invalidateReviewSummary(ctx, review.ID)
+
+	return nil
+}
+
+func (u *ReviewUsecase) Delete(ctx context.Context, reviewID uuid.UUID) error {
+	const op = "ReviewUsecase.Delete"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	userID, err := helpers.GetUserIDFromContext(ctx)
+	if err != nil {
+		logger.WithError(err).Warn("user ID not found in context")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Товар нужно узнать до удаления, иначе сводку будет не найти
+	var productID uuid.UUID
+	if u.cache != nil {
+		if productID, err = u.repo.GetReviewProductID(ctx, reviewID); err != nil {
+			return err
+		}
+	}
+
+	if err := u.repo.DeleteReview(ctx, reviewID, userID); err != nil {
+		return err
+	}
+
+	if u.cache != nil {
+		u.invalidateSummary(ctx, productID)
+	}
+
+	return nil
+}
+
+func (u *ReviewUsecase) AddPhoto(ctx context.Context, reviewID uuid.UUID, imageURL string) error {
+	const op = "ReviewUsecase.AddPhoto"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	userID, err := helpers.GetUserIDFromContext(ctx)
+	if err != nil {
+		logger.WithError(err).Warn("user ID not found in context")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	authorID, err := u.repo.GetReviewAuthorID(ctx, reviewID)
+	if err != nil {
+		return err
+	}
+	if authorID != userID {
+		logger.Warn("review belongs to another user")
+		return fmt.Errorf("%s: %w", op, errs.ErrForbidden)
+	}
+
+	photo := models.ReviewPhotoDB{
+		ID:       uuid.New(),
+		ReviewID: reviewID,
+		ImageURL: imageURL,
+	}
+
+	if err := u.repo.AddReviewPhoto(ctx, photo, models.MaxReviewPhotos); err != nil {
+		return err
+	}
+
+	// Сводка учитывает долю отзывов с фото
+	u.invalidateReviewSummary(ctx, reviewID)
+
+	return nil
+}
+
+func (u *ReviewUsecase) Vote(ctx context.Context, reviewID uuid.UUID, helpful bool) error {
+	const op = "ReviewUsecase.Vote"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	userID, err := helpers.GetUserIDFromContext(ctx)
+	if err != nil {
+		logger.WithError(err).Warn("user ID not found in context")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	authorID, err := u.repo.GetReviewAuthorID(ctx, reviewID)
+	if err != nil {
+		return err
+	}
+	if authorID == userID {
+		return fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("cannot vote for own review"))
+	}
+
+	vote := models.ReviewVoteDB{
+		ID:        uuid.New(),
+		ReviewID:  reviewID,
+		UserID:    userID,
+		IsHelpful: helpful,
+	}
+
+	if err := u.repo.VoteReview(ctx, vote); err != nil {
+		return err
+	}
+
+	// Свежие отзывы в сводке показываются со счётчиками полезности
+	u.invalidateReviewSummary(ctx, reviewID)
+
+	return nil
+}
+
+func (u *ReviewUsecase) Reply(ctx context.Context, reviewID uuid.UUID, text string) error {
+	const op = "ReviewUsecase.Reply"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	sellerID, err := helpers.GetUserIDFromContext(ctx)
+	if err != nil {
+		logger.WithError(err).Warn("user ID not found in context")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	productSellerID, err := u.repo.GetReviewSellerID(ctx, reviewID)
+	if err != nil {
+		return err
+	}
+	if productSellerID != sellerID {
+		logger.Warn("product belongs to another seller")
+		return fmt.Errorf("%s: %w", op, errs.ErrForbidden)
+	}
+
+	reply := models.ReviewReplyDB{
+		ID:       uuid.New(),
+		ReviewID: reviewID,
+		SellerID: sellerID,
+		Text:     text,
+	}
+
+	if err := u.repo.AddReply(ctx, reply); err != nil {
+		return err
+	}
+
+	// Свежие отзывы в сводке показываются вместе с ответом продавца
+	u.invalidateReviewSummary(ctx, reviewID)
+
+	return nil
+}
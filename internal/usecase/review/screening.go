@@ -0,0 +1,181 @@
+package review
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/google/uuid"
+)
+
+// IReviewScreener проверяет отзыв перед публикацией.
+// Пустая причина означает, что проверка пройдена.
+//
+//go:generate mockgen -source=screening.go -destination=../mocks/review_screener_mock.go -package=mocks IReviewScreener
+type IReviewScreener interface {
+	Screen(ctx context.Context, review models.ReviewDB) (string, error)
+}
+
+// IScreeningRepository — данные, нужные проверкам на дубликаты и частоту отзывов
+type IScreeningRepository interface {
+	CountUserReviewsSince(ctx context.Context, userID, excludeID uuid.UUID, since time.Time) (int, error)
+	HasDuplicateReview(ctx context.Context, review models.ReviewDB) (bool, error)
+}
+
+// ScreeningPipeline последовательно запускает проверки и возвращает причину первой сработавшей
+type ScreeningPipeline []IReviewScreener
+
+func (p ScreeningPipeline) Screen(ctx context.Context, review models.ReviewDB) (string, error) {
+	for _, screener := range p {
+		reason, err := screener.Screen(ctx, review)
+		if err != nil {
+			return "", err
+		}
+		if reason != "" {
+			return reason, nil
+		}
+	}
+
+	return "", nil
+}
+
+// NewDefaultScreening собирает стандартный набор проверок: ненормативная лексика,
+// ссылки, повторяющийся текст и слишком частые отзывы
+func NewDefaultScreening(repo IScreeningRepository, limit int, window time.Duration) ScreeningPipeline {
+	return ScreeningPipeline{
+		NewProfanityScreener(defaultProfanityStems),
+		LinkScreener{},
+		NewDuplicateScreener(repo),
+		NewRateScreener(repo, limit, window),
+	}
+}
+
+// defaultProfanityStems — корни ненормативной лексики (RU и EN).
+// Слово считается нецензурным, если начинается с одного из корней.
+var defaultProfanityStems = []string{
+	// RU
+	"хуй", "хуе", "хуя", "пизд", "ебан", "ебат", "ебал", "ебну", "ебуч", "еблан",
+	"выеб", "заеб", "отъеб", "уеб", "наеб", "бляд", "блят", "мудак", "мудил",
+	"залуп", "гандон", "пидор", "пидар", "шлюх", "сука", "суки", "сучк",
+	// EN
+	"fuck", "motherfuck", "shit", "bullshit", "bitch", "cunt", "asshole",
+	"bastard", "dickhead", "whore", "slut",
+}
+
+type ProfanityScreener struct {
+	stems []string
+}
+
+func NewProfanityScreener(stems []string) *ProfanityScreener {
+	normalized := make([]string, 0, len(stems))
+	for _, stem := range stems {
+		normalized = append(normalized, normalizeWord(stem))
+	}
+
+	return &ProfanityScreener{stems: normalized}
+}
+
+func (s *ProfanityScreener) Screen(_ context.Context, review models.ReviewDB) (string, error) {
+	words := strings.FieldsFunc(review.Comment, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+
+	for _, word := range words {
+		word = normalizeWord(word)
+		for _, stem := range s.stems {
+			if strings.HasPrefix(word, stem) {
+				return "profanity", nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+// normalizeWord приводит слово к нижнему регистру и заменяет "ё" на "е"
+func normalizeWord(word string) string {
+	return strings.ReplaceAll(strings.ToLower(word), "ё", "е")
+}
+
+var linkPattern = regexp.MustCompile(
+	`(?i)(https?://|www\.|t\.me/|[\p{L}0-9-]+\.(ru|com|net|org|io|info|biz|xyz|me|su|рф)(/|[^\p{L}\p{N}]|$))`,
+)
+
+// LinkScreener отправляет на модерацию отзывы со ссылками — типичный признак спама
+type LinkScreener struct{}
+
+func (LinkScreener) Screen(_ context.Context, review models.ReviewDB) (string, error) {
+	if linkPattern.MatchString(review.Comment) {
+		return "link", nil
+	}
+
+	return "", nil
+}
+
+// minDuplicateLength — короткие отзывы вроде "Отлично" совпадают естественным образом,
+// поэтому на дубликаты проверяются только достаточно длинные тексты
+const minDuplicateLength = 20
+
+type DuplicateScreener struct {
+	repo IScreeningRepository
+}
+
+func NewDuplicateScreener(repo IScreeningRepository) *DuplicateScreener {
+	return &DuplicateScreener{repo: repo}
+}
+
+func (s *DuplicateScreener) Screen(ctx context.Context, review models.ReviewDB) (string, error) {
+	const op = "DuplicateScreener.Screen"
+
+	if utf8.RuneCountInString(strings.TrimSpace(review.Comment)) < minDuplicateLength {
+		return "", nil
+	}
+
+	duplicate, err := s.repo.HasDuplicateReview(ctx, review)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	if duplicate {
+		return "duplicate text", nil
+	}
+
+	return "", nil
+}
+
+// RateScreener отправляет на модерацию отзывы пользователя, превысившего лимит за окно времени
+type RateScreener struct {
+	repo   IScreeningRepository
+	limit  int
+	window time.Duration
+}
+
+func NewRateScreener(repo IScreeningRepository, limit int, window time.Duration) *RateScreener {
+	return &RateScreener{
+		repo:   repo,
+		limit:  limit,
+		window: window,
+	}
+}
+
+func (s *RateScreener) Screen(ctx context.Context, review models.ReviewDB) (string, error) {
+	const op = "RateScreener.Screen"
+
+	if s.limit <= 0 {
+		return "", nil
+	}
+
+	count, err := s.repo.CountUserReviewsSince(ctx, review.UserID, review.ID, time.Now().Add(-s.window))
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	if count >= s.limit {
+		return "too many reviews", nil
+	}
+
+	return "", nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/helpers"
@@ -20,10 +21,19 @@ type ISearchRepository interface {
 		name string,
 		categoryID null.String,
 		offset int,
-		minPrice, maxPrice float64,
+		minPrice, maxPrice models.Money,
 		minRating float32,
+		attributes []models.AttributeFilter,
 		sortOption models.SortOption,
 	) ([]*models.Product, error)
+	GetAttributeFacets(
+		ctx context.Context,
+		name string,
+		categoryID null.String,
+		minPrice, maxPrice models.Money,
+		minRating float32,
+		attributes []models.AttributeFilter,
+	) ([]models.AttributeFacet, error)
 }
 
 type SearchUsecase struct {
@@ -41,14 +51,15 @@ func (u *SearchUsecase) SearchProductsByNameWithFilterAndSort(
 	categoryID null.String,
 	subString string,
 	offset int,
-	minPrice, maxPrice float64,
+	minPrice, maxPrice models.Money,
 	minRating float32,
+	attributes []models.AttributeFilter,
 	sortOption models.SortOption,
 ) ([]*models.Product, error) {
 	const op = "SearchUsecase.SearchProductsByNameWithFilterAndSort"
 	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("sub_string", subString)
 
-	products, err := u.repo.GetProductsByNameWithFilterAndSort(ctx, subString, categoryID, offset, minPrice, maxPrice, minRating, sortOption)
+	products, err := u.repo.GetProductsByNameWithFilterAndSort(ctx, subString, categoryID, offset, minPrice, maxPrice, minRating, attributes, sortOption)
 	if err != nil {
 		logger.WithError(err).Warn("failed to search products with filter and sort")
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -58,11 +69,11 @@ func (u *SearchUsecase) SearchProductsByNameWithFilterAndSort(
 	switch sortOption {
 	case models.SortByPriceAsc:
 		sort.Slice(products, func(i, j int) bool {
-			return helpers.GetFinalPrice(products[i]) < helpers.GetFinalPrice(products[j])
+			return helpers.GetFinalPrice(products[i]).LessThan(helpers.GetFinalPrice(products[j]))
 		})
 	case models.SortByPriceDesc:
 		sort.Slice(products, func(i, j int) bool {
-			return helpers.GetFinalPrice(products[i]) > helpers.GetFinalPrice(products[j])
+			return helpers.GetFinalPrice(products[i]).GreaterThan(helpers.GetFinalPrice(products[j]))
 		})
 	case models.SortByRatingAsc:
 		sort.Slice(products, func(i, j int) bool {
@@ -77,6 +88,33 @@ func (u *SearchUsecase) SearchProductsByNameWithFilterAndSort(
 	return products, nil
 }
 
+// GetAttributeFacets возвращает значения характеристик среди товаров, найденных с теми же фильтрами
+func (u *SearchUsecase) GetAttributeFacets(
+	ctx context.Context,
+	categoryID null.String,
+	subString string,
+	minPrice, maxPrice models.Money,
+	minRating float32,
+	attributes []models.AttributeFilter,
+) ([]models.AttributeFacet, error) {
+	const op = "SearchUsecase.GetAttributeFacets"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("sub_string", subString)
+
+	for _, f := range attributes {
+		if f.Min.Valid && f.Max.Valid && f.Min.Float64 > f.Max.Float64 {
+			return nil, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("attribute filter min is greater than max"))
+		}
+	}
+
+	facets, err := u.repo.GetAttributeFacets(ctx, subString, categoryID, minPrice, maxPrice, minRating, attributes)
+	if err != nil {
+		logger.WithError(err).Warn("failed to get attribute facets")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return facets, nil
+}
+
 // trySendError Вспомогательная функция для безопасной отправки ошибки
 func trySendError(err error, errCh chan<- error, cancel context.CancelFunc) {
 	select {
@@ -3,33 +3,52 @@ package seller
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
 )
 
 //go:generate mockgen -source=seller.go -destination=../../infrastructure/repository/postgres/mocks/seller_repository_mock.go -package=mocks ISellerRepository
 type ISellerRepository interface {
-	AddProduct(ctx context.Context, product *models.Product, categoryID uuid.UUID) (*models.Product, error)
+	AddProduct(ctx context.Context, product *models.Product, categoryID uuid.UUID, attributes []models.ProductAttribute) (*models.Product, error)
 	UploadProductImage(ctx context.Context, productID uuid.UUID, imageURL string) error
 	GetSellerProducts(ctx context.Context, sellerID uuid.UUID, offset int) ([]*models.Product, error)
 	CheckProductBelongs(ctx context.Context, productID, sellerID uuid.UUID) (bool, error)
+	AddVariant(ctx context.Context, variant *models.ProductVariant) error
+	GetVariant(ctx context.Context, id uuid.UUID) (*models.ProductVariant, error)
+	GetVariantOptions(ctx context.Context, productID uuid.UUID) ([]models.VariantOptions, error)
+	UpdateVariant(ctx context.Context, sellerID uuid.UUID, variant *models.ProductVariant) error
+	DeleteVariant(ctx context.Context, sellerID, id uuid.UUID) error
+}
+
+// IAttributeSchemaRepository отдаёт схему характеристик категории для проверки товара
+type IAttributeSchemaRepository interface {
+	GetCategoryAttributes(ctx context.Context, categoryID uuid.UUID) ([]models.CategoryAttribute, error)
 }
 
 type SellerUsecase struct {
-	repo ISellerRepository
+	repo       ISellerRepository
+	attributes IAttributeSchemaRepository
 }
 
-func NewSellerUsecase(repo ISellerRepository) *SellerUsecase {
+func NewSellerUsecase(repo ISellerRepository, attributes IAttributeSchemaRepository) *SellerUsecase {
 	return &SellerUsecase{
-		repo: repo,
+		repo:       repo,
+		attributes: attributes,
 	}
 }
 
-func (u *SellerUsecase) AddProduct(ctx context.Context, product *models.Product, categoryID uuid.UUID) (*models.Product, error) {
+func (u *SellerUsecase) AddProduct(
+	ctx context.Context,
+	product *models.Product,
+	categoryID uuid.UUID,
+	attributes []models.ProductAttribute,
+) (*models.Product, error) {
 	const op = "SellerUsecase.AddProduct"
 	logger := logctx.GetLogger(ctx).WithField("op", op)
 
@@ -38,13 +57,24 @@ func (u *SellerUsecase) AddProduct(ctx context.Context, product *models.Product,
 		logger.Error("empty product name")
 		return nil, fmt.Errorf("%s: %w", op, errs.ErrEmptyProductName)
 	}
-	if product.Price <= 0 {
+	if !product.Price.IsPositive() {
 		logger.Error("invalid product price")
 		return nil, fmt.Errorf("%s: %w", op, errs.ErrInvalidProductPrice)
 	}
 
+	// Проверяем характеристики по схеме категории
+	schema, err := u.attributes.GetCategoryAttributes(ctx, categoryID)
+	if err != nil {
+		logger.WithError(err).Error("get category attributes")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err = validateAttributes(schema, attributes); err != nil {
+		logger.WithError(err).Warn("invalid product attributes")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	// Добавляем продукт
-	newProduct, err := u.repo.AddProduct(ctx, product, categoryID)
+	newProduct, err := u.repo.AddProduct(ctx, product, categoryID, attributes)
 	if err != nil {
 		logger.WithError(err).Error("add product to repository")
 		return nil, fmt.Errorf("%s: %w", op, err)
@@ -90,4 +120,175 @@ func (u *SellerUsecase) CheckProductBelongs(ctx context.Context, productID, sell
 	}
 
 	return belongs, nil
-}
\ No newline at end of file
+}
+
+// AddVariant добавляет SKU к товару продавца. Опции варианта должны
+// совпадать по названиям с опциями уже существующих SKU товара
+func (u *SellerUsecase) AddVariant(
+	ctx context.Context,
+	sellerID, productID uuid.UUID,
+	req dto.AddVariantRequest,
+) (*models.ProductVariant, error) {
+	const op = "SellerUsecase.AddVariant"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("product_id", productID)
+
+	variant := &models.ProductVariant{
+		ID:        uuid.New(),
+		ProductID: productID,
+		SKU:       strings.TrimSpace(req.SKU),
+		Options:   make(models.VariantOptions, len(req.Options)),
+		Price:     req.Price,
+		Quantity:  req.Quantity,
+		Images:    cleanImages(req.Images),
+	}
+	for name, value := range req.Options {
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if name == "" || value == "" {
+			return nil, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("variant option name and value are required"))
+		}
+		variant.Options[name] = value
+	}
+	if variant.SKU == "" {
+		return nil, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("variant SKU is required"))
+	}
+	if len(variant.Options) == 0 {
+		return nil, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("variant requires at least one option"))
+	}
+	if !variant.Price.IsPositive() {
+		return nil, fmt.Errorf("%s: %w", op, errs.ErrInvalidProductPrice)
+	}
+
+	if err := u.checkOwner(ctx, productID, sellerID); err != nil {
+		logger.WithError(err).Warn("check product owner")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	existing, err := u.repo.GetVariantOptions(ctx, productID)
+	if err != nil {
+		logger.WithError(err).Error("get variant options")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(existing) > 0 && !existing[0].SameNames(variant.Options) {
+		return nil, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError(
+			fmt.Sprintf("variant options must be: %s", strings.Join(existing[0].Names(), ", "))))
+	}
+
+	if err = u.repo.AddVariant(ctx, variant); err != nil {
+		logger.WithError(err).Error("add variant to repository")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return variant, nil
+}
+
+// UpdateVariant меняет цену, остаток и изображения SKU товара продавца
+func (u *SellerUsecase) UpdateVariant(
+	ctx context.Context,
+	sellerID, variantID uuid.UUID,
+	req dto.UpdateVariantRequest,
+) (*models.ProductVariant, error) {
+	const op = "SellerUsecase.UpdateVariant"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("variant_id", variantID)
+
+	if !req.Price.IsPositive() {
+		return nil, fmt.Errorf("%s: %w", op, errs.ErrInvalidProductPrice)
+	}
+
+	variant, err := u.repo.GetVariant(ctx, variantID)
+	if err != nil {
+		logger.WithError(err).Warn("get variant")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err = u.checkOwner(ctx, variant.ProductID, sellerID); err != nil {
+		logger.WithError(err).Warn("check product owner")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	variant.Price = req.Price
+	variant.Quantity = req.Quantity
+	variant.Images = cleanImages(req.Images)
+	if err = u.repo.UpdateVariant(ctx, sellerID, variant); err != nil {
+		logger.WithError(err).Error("update variant in repository")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return variant, nil
+}
+
+func (u *SellerUsecase) DeleteVariant(ctx context.Context, sellerID, variantID uuid.UUID) error {
+	const op = "SellerUsecase.DeleteVariant"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("variant_id", variantID)
+
+	variant, err := u.repo.GetVariant(ctx, variantID)
+	if err != nil {
+		logger.WithError(err).Warn("get variant")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err = u.checkOwner(ctx, variant.ProductID, sellerID); err != nil {
+		logger.WithError(err).Warn("check product owner")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err = u.repo.DeleteVariant(ctx, sellerID, variantID); err != nil {
+		logger.WithError(err).Error("delete variant from repository")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// checkOwner возвращает errs.ErrForbidden, если товар не принадлежит продавцу
+func (u *SellerUsecase) checkOwner(ctx context.Context, productID, sellerID uuid.UUID) error {
+	belongs, err := u.repo.CheckProductBelongs(ctx, productID, sellerID)
+	if err != nil {
+		return err
+	}
+	if !belongs {
+		return errs.ErrForbidden
+	}
+	return nil
+}
+
+// cleanImages убирает пустые ссылки на изображения
+func cleanImages(images []string) []string {
+	result := make([]string, 0, len(images))
+	for _, image := range images {
+		if image = strings.TrimSpace(image); image != "" {
+			result = append(result, image)
+		}
+	}
+	return result
+}
+
+// validateAttributes проверяет, что каждое значение относится к схеме категории,
+// указано один раз и подходит по типу, а все обязательные характеристики заполнены
+func validateAttributes(schema []models.CategoryAttribute, values []models.ProductAttribute) error {
+	byID := make(map[uuid.UUID]models.CategoryAttribute, len(schema))
+	for _, attribute := range schema {
+		byID[attribute.ID] = attribute
+	}
+
+	seen := make(map[uuid.UUID]struct{}, len(values))
+	for _, value := range values {
+		attribute, ok := byID[value.AttributeID]
+		if !ok {
+			return errs.NewBusinessLogicError(fmt.Sprintf("attribute %s is not in category schema", value.AttributeID))
+		}
+		if _, ok = seen[value.AttributeID]; ok {
+			return errs.NewBusinessLogicError(fmt.Sprintf("attribute %q is set twice", attribute.Name))
+		}
+		seen[value.AttributeID] = struct{}{}
+
+		if err := attribute.Validate(value); err != nil {
+			return errs.NewBusinessLogicError(err.Error())
+		}
+	}
+
+	for _, attribute := range schema {
+		if _, ok := seen[attribute.ID]; attribute.Required && !ok {
+			return errs.NewBusinessLogicError(fmt.Sprintf("attribute %q is required", attribute.Name))
+		}
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	addressus "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/address"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/geocoder"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -98,7 +99,7 @@ func TestCreateAddress(t *testing.T) {
 			repo := mocks.NewMockIAddressRepository(ctrl)
 			tt.mockSetup(repo)
 
-			uc := addressus.NewAddressUsecase(repo)
+			uc := addressus.NewAddressUsecase(repo, geocoder.NewStubGeocoder())
 			err := uc.CreateAddress(context.Background(), tt.userID, tt.input)
 
 			if tt.expectedError != nil {
@@ -166,7 +167,7 @@ func TestGetAddresses(t *testing.T) {
 			repo := mocks.NewMockIAddressRepository(ctrl)
 			tt.mockSetup(repo)
 
-			uc := addressus.NewAddressUsecase(repo)
+			uc := addressus.NewAddressUsecase(repo, geocoder.NewStubGeocoder())
 			res, err := uc.GetAddresses(context.Background(), tt.userID)
 
 			if tt.expectedError != nil {
@@ -229,7 +230,7 @@ func TestGetPickupPoints(t *testing.T) {
 			repo := mocks.NewMockIAddressRepository(ctrl)
 			tt.mockSetup(repo)
 
-			uc := addressus.NewAddressUsecase(repo)
+			uc := addressus.NewAddressUsecase(repo, geocoder.NewStubGeocoder())
 			res, err := uc.GetPickupPoints(context.Background())
 
 			if tt.expectedError != nil {
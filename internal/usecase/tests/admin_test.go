@@ -52,8 +52,8 @@ func TestAdminUsecase_GetPendingProducts(t *testing.T) {
 					PreviewImageURL: "image1.jpg",
 					Description:     "Description 1",
 					Status:          models.ProductPending,
-					Price:           100.0,
-					PriceDiscount:   90.0,
+					Price:           models.Rubles(100),
+					PriceDiscount:   models.Rubles(90),
 					Quantity:        10,
 					Rating:          4.5,
 					ReviewsCount:    20,
@@ -66,8 +66,8 @@ func TestAdminUsecase_GetPendingProducts(t *testing.T) {
 					PreviewImageURL: "image2.jpg",
 					Description:     "Description 2",
 					Status:          models.ProductPending,
-					Price:           200.0,
-					PriceDiscount:   180.0,
+					Price:           models.Rubles(200),
+					PriceDiscount:   models.Rubles(180),
 					Quantity:        20,
 					Rating:          4.8,
 					ReviewsCount:    30,
@@ -82,8 +82,8 @@ func TestAdminUsecase_GetPendingProducts(t *testing.T) {
 						ID:            productID1,
 						Name:          "Product 1",
 						ImageURL:      "image1.jpg",
-						Price:         100.0,
-						PriceDiscount: 90.0,
+						Price:         models.Rubles(100),
+						PriceDiscount: models.Rubles(90),
 						Quantity:      10,
 						Rating:        4.5,
 						ReviewsCount:  20,
@@ -92,8 +92,8 @@ func TestAdminUsecase_GetPendingProducts(t *testing.T) {
 						ID:            productID2,
 						Name:          "Product 2",
 						ImageURL:      "image2.jpg",
-						Price:         200.0,
-						PriceDiscount: 180.0,
+						Price:         models.Rubles(200),
+						PriceDiscount: models.Rubles(180),
 						Quantity:      20,
 						Rating:        4.8,
 						ReviewsCount:  30,
@@ -116,7 +116,7 @@ func TestAdminUsecase_GetPendingProducts(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo.EXPECT().GetPendingProducts(ctx, tt.offset).Return(tt.mockProducts, tt.mockError)
 
-			uc := admin.NewAdminUsecase(mockRepo, mockRedisRepo, mockProductRepo)
+			uc := admin.NewAdminUsecase(mockRepo, mockRedisRepo, mockProductRepo, nil)
 			resp, err := uc.GetPendingProducts(ctx, tt.offset)
 
 			if tt.expectedError != nil {
@@ -191,7 +191,7 @@ func TestAdminUsecase_GetPendingUsers(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo.EXPECT().GetPendingUsers(ctx, tt.offset).Return(tt.mockUsers, tt.mockError)
 
-			uc := admin.NewAdminUsecase(mockRepo, mockRedisRepo, mockProductRepo)
+			uc := admin.NewAdminUsecase(mockRepo, mockRedisRepo, mockProductRepo, nil)
 			resp, err := uc.GetPendingUsers(ctx, tt.offset)
 
 			if tt.expectedErr != nil {
@@ -277,7 +277,7 @@ func TestAdminUsecase_UpdateUserRole(t *testing.T) {
 				mockRepo.EXPECT().UpdateUserRole(ctx, tt.req.UserID, expectedRole).Return(tt.mockError)
 			}
 
-			uc := admin.NewAdminUsecase(mockRepo, mockRedisRepo, mockProductRepo)
+			uc := admin.NewAdminUsecase(mockRepo, mockRedisRepo, mockProductRepo, nil)
 			err := uc.UpdateUserRole(ctx, tt.req)
 
 			if tt.expectedError != nil {
@@ -297,7 +297,7 @@ func TestAdminUsecase_UpdateProductStatus(t *testing.T) {
 	mockProductRepo := mocks.NewMockIProductRepository(ctrl)
 	mockRedisRepo := &redis.SuggestionsRepository{} // конкретная реализация, как в твоем успешном примере
 
-	uc := admin.NewAdminUsecase(mockRepo, mockRedisRepo, mockProductRepo)
+	uc := admin.NewAdminUsecase(mockRepo, mockRedisRepo, mockProductRepo, nil)
 
 	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
 	productID := uuid.New() // UUID вместо int64
@@ -359,3 +359,67 @@ func TestAdminUsecase_UpdateProductStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestAdminUsecase_GetPendingReviews(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIAdminRepository(ctrl)
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	uc := admin.NewAdminUsecase(mockRepo, &redis.SuggestionsRepository{}, mocks.NewMockIProductRepository(ctrl), nil)
+
+	t.Run("Success", func(t *testing.T) {
+		reviews := []*models.ModerationReview{
+			{ID: uuid.New(), ProductName: "Phone", Rating: 1, Comment: "spam", Reason: "link", CreatedAt: time.Now()},
+		}
+		mockRepo.EXPECT().GetPendingReviews(ctx, 0).Return(reviews, nil)
+
+		res, err := uc.GetPendingReviews(ctx, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, res.Total)
+		assert.Equal(t, "link", res.Reviews[0].Reason)
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		mockRepo.EXPECT().GetPendingReviews(ctx, 20).Return(nil, errors.New("repository error"))
+
+		_, err := uc.GetPendingReviews(ctx, 20)
+		assert.EqualError(t, err, "AdminUsecase.GetPendingReviews: repository error")
+	})
+}
+
+func TestAdminUsecase_UpdateReviewStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIAdminRepository(ctrl)
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	mockCache := mocks.NewMockIReviewSummaryCache(ctrl)
+	uc := admin.NewAdminUsecase(mockRepo, &redis.SuggestionsRepository{}, mocks.NewMockIProductRepository(ctrl), mockCache)
+
+	reviewID := uuid.New()
+	productID := uuid.New()
+
+	t.Run("Approve", func(t *testing.T) {
+		mockRepo.EXPECT().UpdateReviewStatus(ctx, reviewID, models.ReviewPublished).Return(productID, nil)
+		mockCache.EXPECT().InvalidateSummary(ctx, productID).Return(nil)
+		assert.NoError(t, uc.UpdateReviewStatus(ctx, dto.UpdateReviewStatusRequest{ReviewID: reviewID, Update: 1}))
+	})
+
+	t.Run("Reject", func(t *testing.T) {
+		mockRepo.EXPECT().UpdateReviewStatus(ctx, reviewID, models.ReviewRejected).Return(productID, nil)
+		mockCache.EXPECT().InvalidateSummary(ctx, productID).Return(errors.New("redis down"))
+		assert.NoError(t, uc.UpdateReviewStatus(ctx, dto.UpdateReviewStatusRequest{ReviewID: reviewID, Update: 0}))
+	})
+
+	t.Run("Invalid Update Value", func(t *testing.T) {
+		err := uc.UpdateReviewStatus(ctx, dto.UpdateReviewStatusRequest{ReviewID: reviewID, Update: 5})
+		assert.ErrorIs(t, err, errs.ErrParseRequestData)
+	})
+
+	t.Run("Repository Error", func(t *testing.T) {
+		mockRepo.EXPECT().UpdateReviewStatus(ctx, reviewID, models.ReviewPublished).Return(uuid.Nil, errors.New("repository error"))
+		err := uc.UpdateReviewStatus(ctx, dto.UpdateReviewStatusRequest{ReviewID: reviewID, Update: 1})
+		assert.EqualError(t, err, "AdminUsecase.UpdateReviewStatus: repository error")
+	})
+}
@@ -0,0 +1,143 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/mocks"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/analytics"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestAnalytics(t *testing.T) (*mocks.MockIAnalyticsRepository, *analytics.AnalyticsUsecase) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockIAnalyticsRepository(ctrl)
+	return mockRepo, analytics.NewAnalyticsUsecase(mockRepo, &config.AnalyticsConfig{LookbackDays: 30})
+}
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestAnalyticsBucket_Start(t *testing.T) {
+	// 2025-05-15 — четверг
+	moment := time.Date(2025, 5, 15, 18, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, day(2025, 5, 15), models.AnalyticsDay.Start(moment))
+	assert.Equal(t, day(2025, 5, 12), models.AnalyticsWeek.Start(moment))
+	assert.Equal(t, day(2025, 5, 1), models.AnalyticsMonth.Start(moment))
+	assert.Equal(t, day(2025, 5, 12), models.AnalyticsWeek.Start(day(2025, 5, 18)))
+	assert.Equal(t, day(2025, 6, 1), models.AnalyticsMonth.Next(day(2025, 5, 1)))
+}
+
+func TestAnalyticsUsecase_GetSummary(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	sellerID := uuid.New()
+
+	t.Run("fills empty periods and totals", func(t *testing.T) {
+		mockRepo, uc := setupTestAnalytics(t)
+
+		from, to := day(2025, 5, 1), day(2025, 5, 3)
+		mockRepo.EXPECT().GetSalesStats(gomock.Any(), sellerID, from, to, models.AnalyticsDay).
+			Return([]models.SalesStats{
+				{Period: day(2025, 5, 1), Revenue: models.Kopecks(10010), Units: 4, Orders: 2, ReturnedUnits: 1, BasketAdds: 8, RatingSum: 9, RatingCount: 2},
+				{Period: day(2025, 5, 3), Revenue: models.Kopecks(5020), Units: 1, Orders: 1, BasketAdds: 2},
+			}, nil)
+
+		summary, err := uc.GetSummary(ctx, sellerID, models.AnalyticsPeriod{From: from, To: to})
+		require.NoError(t, err)
+
+		assert.Equal(t, "2025-05-01", summary.From)
+		assert.Equal(t, "2025-05-03", summary.To)
+		assert.Equal(t, "day", summary.Bucket)
+		require.Len(t, summary.Series, 3)
+		assert.Equal(t, "2025-05-02", summary.Series[1].Period)
+		assert.Zero(t, summary.Series[1].Units)
+		assert.Nil(t, summary.Series[1].ConversionRate)
+		require.NotNil(t, summary.Series[0].AverageRating)
+		assert.Equal(t, 4.5, *summary.Series[0].AverageRating)
+
+		totals := summary.Totals
+		assert.Equal(t, models.Kopecks(15030), totals.Revenue)
+		assert.Equal(t, 5, totals.Units)
+		assert.Equal(t, 3, totals.Orders)
+		require.NotNil(t, totals.ConversionRate)
+		assert.Equal(t, 0.3, *totals.ConversionRate)
+		require.NotNil(t, totals.ReturnRate)
+		assert.Equal(t, 0.2, *totals.ReturnRate)
+	})
+
+	t.Run("weekly series starts on monday", func(t *testing.T) {
+		mockRepo, uc := setupTestAnalytics(t)
+
+		from, to := day(2025, 5, 1), day(2025, 5, 14)
+		mockRepo.EXPECT().GetSalesStats(gomock.Any(), sellerID, from, to, models.AnalyticsWeek).Return(nil, nil)
+
+		summary, err := uc.GetSummary(ctx, sellerID, models.AnalyticsPeriod{From: from, To: to, Bucket: models.AnalyticsWeek})
+		require.NoError(t, err)
+
+		periods := make([]string, 0, len(summary.Series))
+		for _, point := range summary.Series {
+			periods = append(periods, point.Period)
+		}
+		assert.Equal(t, []string{"2025-04-28", "2025-05-05", "2025-05-12"}, periods)
+	})
+
+	t.Run("defaults to last 30 days", func(t *testing.T) {
+		mockRepo, uc := setupTestAnalytics(t)
+
+		to := day(2025, 5, 30)
+		mockRepo.EXPECT().GetSalesStats(gomock.Any(), sellerID, day(2025, 5, 1), to, models.AnalyticsDay).Return(nil, nil)
+
+		summary, err := uc.GetSummary(ctx, sellerID, models.AnalyticsPeriod{To: to})
+		require.NoError(t, err)
+		assert.Len(t, summary.Series, 30)
+	})
+
+	t.Run("invalid period", func(t *testing.T) {
+		for name, period := range map[string]models.AnalyticsPeriod{
+			"unknown bucket": {Bucket: "year"},
+			"reversed":       {From: day(2025, 5, 2), To: day(2025, 5, 1)},
+			"too long":       {From: day(2024, 1, 1), To: day(2025, 5, 1)},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, uc := setupTestAnalytics(t)
+
+				_, err := uc.GetSummary(ctx, sellerID, period)
+				assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+			})
+		}
+	})
+}
+
+func TestAnalyticsUsecase_GetTopProducts(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	sellerID := uuid.New()
+	period := models.AnalyticsPeriod{From: day(2025, 5, 1), To: day(2025, 5, 31)}
+
+	for name, tc := range map[string]struct{ limit, expected int }{
+		"default": {0, 10},
+		"custom":  {5, 5},
+		"capped":  {500, 50},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mockRepo, uc := setupTestAnalytics(t)
+
+			mockRepo.EXPECT().GetTopProducts(gomock.Any(), sellerID, period.From, period.To, tc.expected).
+				Return([]models.TopProduct{{ProductID: uuid.New(), Name: "Кружка", Revenue: models.Rubles(900), Units: 3}}, nil)
+
+			products, err := uc.GetTopProducts(ctx, sellerID, period, tc.limit)
+			require.NoError(t, err)
+			assert.Len(t, products, 1)
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/mocks"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/attribute"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestAttribute(t *testing.T) (*mocks.MockIAttributeRepository, *attribute.AttributeUsecase) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockIAttributeRepository(ctrl)
+	return mockRepo, attribute.NewAttributeUsecase(mockRepo)
+}
+
+func TestCategoryAttribute_Validate(t *testing.T) {
+	enum := models.CategoryAttribute{Type: models.AttributeEnum, Options: []string{"Apple", "Samsung"}}
+	number := models.CategoryAttribute{Type: models.AttributeNumber}
+	boolean := models.CategoryAttribute{Type: models.AttributeBoolean}
+	text := models.CategoryAttribute{Type: models.AttributeText}
+
+	assert.NoError(t, enum.Validate(models.ProductAttribute{Text: null.StringFrom("Apple")}))
+	assert.NoError(t, number.Validate(models.ProductAttribute{Number: null.FloatFrom(1.5)}))
+	assert.NoError(t, boolean.Validate(models.ProductAttribute{Bool: null.BoolFrom(false)}))
+	assert.NoError(t, text.Validate(models.ProductAttribute{Text: null.StringFrom("Алюминий")}))
+
+	invalid := []struct {
+		attribute models.CategoryAttribute
+		value     models.ProductAttribute
+	}{
+		{enum, models.ProductAttribute{Text: null.StringFrom("Nokia")}},
+		{enum, models.ProductAttribute{}},
+		{number, models.ProductAttribute{Text: null.StringFrom("1.5")}},
+		{number, models.ProductAttribute{Number: null.FloatFrom(1), Bool: null.BoolFrom(true)}},
+		{boolean, models.ProductAttribute{Number: null.FloatFrom(1)}},
+		{text, models.ProductAttribute{Text: null.StringFrom("  ")}},
+	}
+	for _, tt := range invalid {
+		assert.ErrorIs(t, tt.attribute.Validate(tt.value), models.ErrInvalidAttributeValue, tt.attribute.Type)
+	}
+}
+
+func TestAttributeUsecase_CreateAttribute(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	categoryID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo, uc := setupTestAttribute(t)
+
+		mockRepo.EXPECT().GetCategoryAttributes(gomock.Any(), categoryID).
+			Return([]models.CategoryAttribute{{Name: "Бренд"}}, nil)
+		mockRepo.EXPECT().CreateAttribute(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, a *models.CategoryAttribute) error {
+				assert.Equal(t, categoryID, a.CategoryID)
+				assert.Equal(t, "Вес", a.Name)
+				assert.Equal(t, null.StringFrom("г"), a.Unit)
+				assert.True(t, a.Filterable)
+				return nil
+			})
+
+		attr, err := uc.CreateAttribute(ctx, categoryID, dto.CreateAttributeRequest{
+			Name: " Вес ",
+			Type: models.AttributeNumber,
+			Unit: null.StringFrom(" г "),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, models.AttributeNumber, attr.Type)
+	})
+
+	t.Run("text is not filterable by default", func(t *testing.T) {
+		mockRepo, uc := setupTestAttribute(t)
+
+		mockRepo.EXPECT().GetCategoryAttributes(gomock.Any(), categoryID).Return(nil, nil)
+		mockRepo.EXPECT().CreateAttribute(gomock.Any(), gomock.Any()).Return(nil)
+
+		attr, err := uc.CreateAttribute(ctx, categoryID, dto.CreateAttributeRequest{
+			Name: "Материал",
+			Type: models.AttributeText,
+		})
+		require.NoError(t, err)
+		assert.False(t, attr.Filterable)
+	})
+
+	t.Run("invalid schema", func(t *testing.T) {
+		_, uc := setupTestAttribute(t)
+
+		requests := []dto.CreateAttributeRequest{
+			{Name: "Цвет", Type: "color"},
+			{Name: "", Type: models.AttributeText},
+			{Name: "Бренд", Type: models.AttributeEnum, Options: []string{" ", ""}},
+			{Name: "Вес", Type: models.AttributeNumber, Options: []string{"1"}},
+			{Name: "Материал", Type: models.AttributeText, Unit: null.StringFrom("г")},
+		}
+		for _, req := range requests {
+			_, err := uc.CreateAttribute(ctx, categoryID, req)
+			assert.ErrorIs(t, err, errs.ErrBusinessLogic, req.Name)
+		}
+	})
+
+	t.Run("name clashes with inherited attribute", func(t *testing.T) {
+		mockRepo, uc := setupTestAttribute(t)
+
+		mockRepo.EXPECT().GetCategoryAttributes(gomock.Any(), categoryID).
+			Return([]models.CategoryAttribute{{Name: "Бренд"}}, nil)
+
+		_, err := uc.CreateAttribute(ctx, categoryID, dto.CreateAttributeRequest{
+			Name:    "бренд",
+			Type:    models.AttributeEnum,
+			Options: []string{"Apple"},
+		})
+		assert.ErrorIs(t, err, errs.ErrAlreadyExists)
+	})
+}
+
+func TestAttributeUsecase_UpdateAttribute(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	id := uuid.New()
+	current := func() *models.CategoryAttribute {
+		return &models.CategoryAttribute{
+			ID:      id,
+			Name:    "Бренд",
+			Type:    models.AttributeEnum,
+			Options: []string{"Apple", "Samsung"},
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo, uc := setupTestAttribute(t)
+
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), id).Return(current(), nil)
+		mockRepo.EXPECT().GetUsedAttributeValues(gomock.Any(), id).Return([]string{"Apple"}, nil)
+		mockRepo.EXPECT().UpdateAttribute(gomock.Any(), gomock.Any()).Return(nil)
+
+		attr, err := uc.UpdateAttribute(ctx, id, dto.UpdateAttributeRequest{
+			Name:       "Бренд",
+			Options:    []string{"Apple", "Xiaomi", "Apple"},
+			Filterable: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Apple", "Xiaomi"}, attr.Options)
+	})
+
+	t.Run("removed option is used by products", func(t *testing.T) {
+		mockRepo, uc := setupTestAttribute(t)
+
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), id).Return(current(), nil)
+		mockRepo.EXPECT().GetUsedAttributeValues(gomock.Any(), id).Return([]string{"Apple", "Samsung"}, nil)
+
+		_, err := uc.UpdateAttribute(ctx, id, dto.UpdateAttributeRequest{
+			Name:    "Бренд",
+			Options: []string{"Apple"},
+		})
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepo, uc := setupTestAttribute(t)
+
+		mockRepo.EXPECT().GetAttribute(gomock.Any(), id).Return(nil, errs.NewNotFoundError("attribute not found"))
+
+		_, err := uc.UpdateAttribute(ctx, id, dto.UpdateAttributeRequest{Name: "Бренд"})
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+}
+
+func TestAttributeUsecase_GetProductAttributes(t *testing.T) {
+	mockRepo, uc := setupTestAttribute(t)
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	productID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		attr := models.CategoryAttribute{ID: uuid.New(), Name: "Вес", Type: models.AttributeNumber, Unit: null.StringFrom("г")}
+		mockRepo.EXPECT().GetProductAttributes(gomock.Any(), productID).Return([]models.ProductSpecification{{
+			Attribute: attr,
+			Value:     models.ProductAttribute{AttributeID: attr.ID, Number: null.FloatFrom(180)},
+		}}, nil)
+
+		res, err := uc.GetProductAttributes(ctx, productID)
+		require.NoError(t, err)
+		require.Len(t, res.Attributes, 1)
+		assert.Equal(t, "Вес", res.Attributes[0].Name)
+		assert.Equal(t, null.FloatFrom(180), res.Attributes[0].Number)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		mockRepo.EXPECT().GetProductAttributes(gomock.Any(), productID).Return(nil, errors.New("db error"))
+
+		_, err := uc.GetProductAttributes(ctx, productID)
+		assert.Error(t, err)
+	})
+}
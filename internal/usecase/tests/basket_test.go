@@ -10,6 +10,7 @@ import (
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/domains"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/basket"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/promo"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -19,11 +20,23 @@ func ContextWithUserID(ctx context.Context, userID uuid.UUID) context.Context {
 	return context.WithValue(ctx, domains.UserIDKey{}, userID.String())
 }
 
-func setupTestBasket(t *testing.T) (*mocks.MockIBasketRepository, *basket.BasketUsecase) {
+func setupTestBasket(t *testing.T) (*mocks.MockIBasketRepository, *mocks.MockIPricingEngine, *basket.BasketUsecase) {
 	ctrl := gomock.NewController(t)
 	mockRepo := mocks.NewMockIBasketRepository(ctrl)
-	uc := basket.NewBasketUsecase(mockRepo)
-	return mockRepo, uc
+	mockPricing := mocks.NewMockIPricingEngine(ctrl)
+	uc := basket.NewBasketUsecase(mockRepo, mockPricing)
+	return mockRepo, mockPricing, uc
+}
+
+// expectTotalsSync ожидает пересчёт сумм корзины после её изменения
+func expectTotalsSync(mockRepo *mocks.MockIBasketRepository, mockPricing *mocks.MockIPricingEngine, userID uuid.UUID) {
+	items := []*models.BasketItem{{ProductID: uuid.New(), Quantity: 2}}
+
+	mockRepo.EXPECT().Get(gomock.Any(), userID).Return(items, nil)
+	mockPricing.EXPECT().
+		Price(gomock.Any(), []models.PricingItem{{ProductID: items[0].ProductID, Quantity: 2}}).
+		Return(&models.Quote{Subtotal: models.Rubles(200), ProductDiscount: models.Rubles(40)}, nil)
+	mockRepo.EXPECT().UpdateTotals(gomock.Any(), userID, models.Rubles(200), models.Rubles(160)).Return(nil)
 }
 
 func TestBasketUsecase_Get(t *testing.T) {
@@ -31,7 +44,7 @@ func TestBasketUsecase_Get(t *testing.T) {
 	ctx := ContextWithUserID(context.Background(), userID)
 
 	t.Run("success", func(t *testing.T) {
-		mockRepo, uc := setupTestBasket(t)
+		mockRepo, _, uc := setupTestBasket(t)
 		expectedItems := []*models.BasketItem{
 			{
 				ID:        uuid.New(),
@@ -51,14 +64,14 @@ func TestBasketUsecase_Get(t *testing.T) {
 	})
 
 	t.Run("no user in context", func(t *testing.T) {
-		_, uc := setupTestBasket(t)
+		_, _, uc := setupTestBasket(t)
 		_, err := uc.Get(context.Background())
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "user not found")
 	})
 
 	t.Run("repository error", func(t *testing.T) {
-		mockRepo, uc := setupTestBasket(t)
+		mockRepo, _, uc := setupTestBasket(t)
 		mockRepo.EXPECT().
 			Get(gomock.Any(), userID).
 			Return(nil, errors.New("db error"))
@@ -74,7 +87,7 @@ func TestBasketUsecase_Add(t *testing.T) {
 	ctx := ContextWithUserID(context.Background(), userID)
 
 	t.Run("success", func(t *testing.T) {
-		mockRepo, uc := setupTestBasket(t)
+		mockRepo, mockPricing, uc := setupTestBasket(t)
 		expectedItem := &models.BasketItem{
 			ID:        uuid.New(),
 			BasketID:  uuid.New(),
@@ -83,34 +96,35 @@ func TestBasketUsecase_Add(t *testing.T) {
 		}
 
 		mockRepo.EXPECT().
-			Add(gomock.Any(), userID, productID).
+			Add(gomock.Any(), userID, productID, uuid.NullUUID{}).
 			Return(expectedItem, nil)
+		expectTotalsSync(mockRepo, mockPricing, userID)
 
-		item, err := uc.Add(ctx, productID)
+		item, err := uc.Add(ctx, productID, uuid.NullUUID{})
 		assert.NoError(t, err)
 		assert.Equal(t, expectedItem, item)
 	})
 
 	t.Run("invalid product id", func(t *testing.T) {
-		_, uc := setupTestBasket(t)
-		_, err := uc.Add(ctx, uuid.Nil)
+		_, _, uc := setupTestBasket(t)
+		_, err := uc.Add(ctx, uuid.Nil, uuid.NullUUID{})
 		assert.ErrorIs(t, err, errs.ErrInvalidID)
 	})
 
 	// t.Run("no user in context", func(t *testing.T) {
-	// 	_, uc := setupTestBasket(t)
-	// 	_, err := uc.Add(context.Background(), productID)
+	// 	_, _, uc := setupTestBasket(t)
+	// 	_, err := uc.Add(context.Background(), productID, uuid.NullUUID{})
 	// 	assert.Error(t, err)
 	// 	assert.Contains(t, err.Error(), errs.ErrNotFound)
 	// })
 
 	t.Run("repository error", func(t *testing.T) {
-		mockRepo, uc := setupTestBasket(t)
+		mockRepo, _, uc := setupTestBasket(t)
 		mockRepo.EXPECT().
-			Add(gomock.Any(), userID, productID).
+			Add(gomock.Any(), userID, productID, uuid.NullUUID{}).
 			Return(nil, errors.New("db error"))
 
-		_, err := uc.Add(ctx, productID)
+		_, err := uc.Add(ctx, productID, uuid.NullUUID{})
 		assert.Error(t, err)
 	})
 }
@@ -121,29 +135,30 @@ func TestBasketUsecase_Delete(t *testing.T) {
 	ctx := ContextWithUserID(context.Background(), userID)
 
 	t.Run("success", func(t *testing.T) {
-		mockRepo, uc := setupTestBasket(t)
+		mockRepo, mockPricing, uc := setupTestBasket(t)
 		mockRepo.EXPECT().
-			Delete(gomock.Any(), userID, productID).
+			Delete(gomock.Any(), userID, productID, uuid.NullUUID{}).
 			Return(nil)
+		expectTotalsSync(mockRepo, mockPricing, userID)
 
-		err := uc.Delete(ctx, productID)
+		err := uc.Delete(ctx, productID, uuid.NullUUID{})
 		assert.NoError(t, err)
 	})
 
 	t.Run("no user in context", func(t *testing.T) {
-		_, uc := setupTestBasket(t)
-		err := uc.Delete(context.Background(), productID)
+		_, _, uc := setupTestBasket(t)
+		err := uc.Delete(context.Background(), productID, uuid.NullUUID{})
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "user not found")
 	})
 
 	t.Run("repository error", func(t *testing.T) {
-		mockRepo, uc := setupTestBasket(t)
+		mockRepo, _, uc := setupTestBasket(t)
 		mockRepo.EXPECT().
-			Delete(gomock.Any(), userID, productID).
+			Delete(gomock.Any(), userID, productID, uuid.NullUUID{}).
 			Return(errors.New("db error"))
 
-		err := uc.Delete(ctx, productID)
+		err := uc.Delete(ctx, productID, uuid.NullUUID{})
 		assert.Error(t, err)
 	})
 }
@@ -155,7 +170,7 @@ func TestBasketUsecase_UpdateQuantity(t *testing.T) {
 	ctx := ContextWithUserID(context.Background(), userID)
 
 	t.Run("success", func(t *testing.T) {
-		mockRepo, uc := setupTestBasket(t)
+		mockRepo, mockPricing, uc := setupTestBasket(t)
 		expectedItem := &models.BasketItem{
 			ID:        uuid.New(),
 			BasketID:  uuid.New(),
@@ -164,40 +179,41 @@ func TestBasketUsecase_UpdateQuantity(t *testing.T) {
 		}
 
 		mockRepo.EXPECT().
-			UpdateQuantity(gomock.Any(), userID, productID, quantity).
+			UpdateQuantity(gomock.Any(), userID, productID, uuid.NullUUID{}, quantity).
 			Return(expectedItem, nil) // Возвращаем только два значения: item и error
+		expectTotalsSync(mockRepo, mockPricing, userID)
 
-		item, err := uc.UpdateQuantity(ctx, productID, quantity) // Исправлено на два возвращаемых значения
+		item, err := uc.UpdateQuantity(ctx, productID, uuid.NullUUID{}, quantity) // Исправлено на два возвращаемых значения
 		assert.NoError(t, err)
 		assert.Equal(t, expectedItem, item)
 	})
 
 	t.Run("invalid quantity", func(t *testing.T) {
-		_, uc := setupTestBasket(t)
-		_, err := uc.UpdateQuantity(ctx, productID, 0) // Исправлено на два возвращаемых значения
+		_, _, uc := setupTestBasket(t)
+		_, err := uc.UpdateQuantity(ctx, productID, uuid.NullUUID{}, 0) // Исправлено на два возвращаемых значения
 		assert.Error(t, err)
 	})
 
 	t.Run("invalid product id", func(t *testing.T) {
-		_, uc := setupTestBasket(t)
-		_, err := uc.UpdateQuantity(ctx, uuid.Nil, quantity) // Исправлено на два возвращаемых значения
+		_, _, uc := setupTestBasket(t)
+		_, err := uc.UpdateQuantity(ctx, uuid.Nil, uuid.NullUUID{}, quantity) // Исправлено на два возвращаемых значения
 		assert.ErrorIs(t, err, errs.ErrInvalidID)
 	})
 
 	t.Run("no user in context", func(t *testing.T) {
-		_, uc := setupTestBasket(t)
-		_, err := uc.UpdateQuantity(context.Background(), productID, quantity) // Исправлено на два возвращаемых значения
+		_, _, uc := setupTestBasket(t)
+		_, err := uc.UpdateQuantity(context.Background(), productID, uuid.NullUUID{}, quantity) // Исправлено на два возвращаемых значения
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "user not found")
 	})
 
 	t.Run("repository error", func(t *testing.T) {
-		mockRepo, uc := setupTestBasket(t)
+		mockRepo, _, uc := setupTestBasket(t)
 		mockRepo.EXPECT().
-			UpdateQuantity(gomock.Any(), userID, productID, quantity).
+			UpdateQuantity(gomock.Any(), userID, productID, uuid.NullUUID{}, quantity).
 			Return(nil, errors.New("db error")) // Возвращаем только item и error
 
-		_, err := uc.UpdateQuantity(ctx, productID, quantity) // Исправлено на два возвращаемых значения
+		_, err := uc.UpdateQuantity(ctx, productID, uuid.NullUUID{}, quantity) // Исправлено на два возвращаемых значения
 		assert.Error(t, err)
 	})
 }
@@ -207,24 +223,27 @@ func TestBasketUsecase_Clear(t *testing.T) {
 	ctx := ContextWithUserID(context.Background(), userID)
 
 	t.Run("success", func(t *testing.T) {
-		mockRepo, uc := setupTestBasket(t)
+		mockRepo, _, uc := setupTestBasket(t)
 		mockRepo.EXPECT().
 			Clear(gomock.Any(), userID).
 			Return(nil)
+		mockRepo.EXPECT().
+			UpdateTotals(gomock.Any(), userID, models.Money{}, models.Money{}).
+			Return(nil)
 
 		err := uc.Clear(ctx)
 		assert.NoError(t, err)
 	})
 
 	t.Run("no user in context", func(t *testing.T) {
-		_, uc := setupTestBasket(t)
+		_, _, uc := setupTestBasket(t)
 		err := uc.Clear(context.Background())
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "user not found")
 	})
 
 	t.Run("repository error", func(t *testing.T) {
-		mockRepo, uc := setupTestBasket(t)
+		mockRepo, _, uc := setupTestBasket(t)
 		mockRepo.EXPECT().
 			Clear(gomock.Any(), userID).
 			Return(errors.New("db error"))
@@ -233,3 +252,149 @@ func TestBasketUsecase_Clear(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestBasketUsecase_Quote(t *testing.T) {
+	userID := uuid.New()
+	productID := uuid.New()
+	ctx := ContextWithUserID(context.Background(), userID)
+
+	items := []*models.BasketItem{
+		{ProductID: productID, Quantity: 2, ProductName: "Чайник", ProductImage: "kettle.png"},
+	}
+	newQuote := func() *models.Quote {
+		return &models.Quote{
+			Lines: []models.QuoteLine{{
+				ProductID:      productID,
+				Quantity:       2,
+				Status:         models.ProductApproved,
+				Stock:          5,
+				UnitPrice:      models.Rubles(1000),
+				FinalUnitPrice: models.Rubles(800),
+				LineTotal:      models.Rubles(1600),
+				LineDiscount:   models.Rubles(400),
+			}},
+			Subtotal:        models.Rubles(2000),
+			ProductDiscount: models.Rubles(400),
+			DeliveryCost:    models.Rubles(300),
+			Total:           models.Rubles(1900),
+		}
+	}
+
+	t.Run("without promo", func(t *testing.T) {
+		mockRepo, mockPricing, uc := setupTestBasket(t)
+		mockRepo.EXPECT().Get(gomock.Any(), userID).Return(items, nil)
+		mockPricing.EXPECT().Price(gomock.Any(), gomock.Any()).Return(newQuote(), nil)
+
+		result, err := uc.Quote(ctx, "")
+		assert.NoError(t, err)
+		assert.Nil(t, result.Promo)
+		assert.Equal(t, models.Rubles(1900), result.Total)
+		assert.Equal(t, models.Rubles(300), result.DeliveryCost)
+		if assert.Len(t, result.Items, 1) {
+			assert.Equal(t, "Чайник", result.Items[0].ProductName)
+			assert.Equal(t, models.Rubles(1600), result.Items[0].LineTotal)
+			assert.True(t, result.Items[0].Available)
+		}
+	})
+
+	t.Run("promo applied", func(t *testing.T) {
+		mockRepo, mockPricing, uc := setupTestBasket(t)
+		mockRepo.EXPECT().Get(gomock.Any(), userID).Return(items, nil)
+		mockPricing.EXPECT().Price(gomock.Any(), gomock.Any()).Return(newQuote(), nil)
+		mockPricing.EXPECT().
+			ApplyPromo(gomock.Any(), userID, "SALE", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uuid.UUID, _ string, q *models.Quote) error {
+				q.PromoDiscount = models.Rubles(160)
+				q.Total = models.Rubles(1740)
+				return nil
+			})
+
+		result, err := uc.Quote(ctx, "SALE")
+		assert.NoError(t, err)
+		if assert.NotNil(t, result.Promo) {
+			assert.True(t, result.Promo.Applied)
+			assert.Equal(t, models.Rubles(160), result.Promo.Discount)
+		}
+		assert.Equal(t, models.Rubles(1740), result.Total)
+	})
+
+	t.Run("promo rejected", func(t *testing.T) {
+		mockRepo, mockPricing, uc := setupTestBasket(t)
+		mockRepo.EXPECT().Get(gomock.Any(), userID).Return(items, nil)
+		mockPricing.EXPECT().Price(gomock.Any(), gomock.Any()).Return(newQuote(), nil)
+		mockPricing.EXPECT().
+			ApplyPromo(gomock.Any(), userID, "OLD", gomock.Any()).
+			Return(&promo.RejectedError{Reason: promo.ReasonExpired})
+
+		result, err := uc.Quote(ctx, "OLD")
+		assert.NoError(t, err)
+		if assert.NotNil(t, result.Promo) {
+			assert.False(t, result.Promo.Applied)
+			assert.Equal(t, string(promo.ReasonExpired), result.Promo.Reason)
+		}
+		assert.Equal(t, models.Rubles(1900), result.Total)
+	})
+
+	t.Run("pricing error", func(t *testing.T) {
+		mockRepo, mockPricing, uc := setupTestBasket(t)
+		mockRepo.EXPECT().Get(gomock.Any(), userID).Return(items, nil)
+		mockPricing.EXPECT().Price(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+		_, err := uc.Quote(ctx, "")
+		assert.Error(t, err)
+	})
+}
+
+func TestGuestBasketMerger_MergeGuest(t *testing.T) {
+	ctx := context.Background()
+	guestID := uuid.New()
+	userID := uuid.New()
+	productID := uuid.New()
+
+	setup := func(t *testing.T) (*mocks.MockIBasketRepository, *mocks.MockIBasketMergeRepository, *mocks.MockIPricingEngine, *basket.GuestBasketMerger) {
+		ctrl := gomock.NewController(t)
+		guestRepo := mocks.NewMockIBasketRepository(ctrl)
+		userRepo := mocks.NewMockIBasketMergeRepository(ctrl)
+		pricing := mocks.NewMockIPricingEngine(ctrl)
+		return guestRepo, userRepo, pricing, basket.NewGuestBasketMerger(guestRepo, userRepo, pricing)
+	}
+
+	t.Run("success", func(t *testing.T) {
+		guestRepo, userRepo, pricing, merger := setup(t)
+
+		guestRepo.EXPECT().Get(gomock.Any(), guestID).
+			Return([]*models.BasketItem{{ProductID: productID, Quantity: 3}}, nil)
+		userRepo.EXPECT().Merge(gomock.Any(), userID, []models.PricingItem{{ProductID: productID, Quantity: 3}}).
+			Return(nil)
+		guestRepo.EXPECT().Clear(gomock.Any(), guestID).Return(nil)
+
+		userRepo.EXPECT().Get(gomock.Any(), userID).
+			Return([]*models.BasketItem{{ProductID: productID, Quantity: 5}}, nil)
+		pricing.EXPECT().Price(gomock.Any(), gomock.Any()).
+			Return(&models.Quote{Subtotal: models.Rubles(500)}, nil)
+		userRepo.EXPECT().UpdateTotals(gomock.Any(), userID, models.Rubles(500), models.Rubles(500)).Return(nil)
+
+		err := merger.MergeGuest(ctx, guestID, userID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("empty guest basket", func(t *testing.T) {
+		guestRepo, _, _, merger := setup(t)
+
+		guestRepo.EXPECT().Get(gomock.Any(), guestID).Return([]*models.BasketItem{}, nil)
+
+		err := merger.MergeGuest(ctx, guestID, userID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("merge error keeps guest basket", func(t *testing.T) {
+		guestRepo, userRepo, _, merger := setup(t)
+
+		guestRepo.EXPECT().Get(gomock.Any(), guestID).
+			Return([]*models.BasketItem{{ProductID: productID, Quantity: 1}}, nil)
+		userRepo.EXPECT().Merge(gomock.Any(), userID, gomock.Any()).Return(errors.New("db error"))
+
+		err := merger.MergeGuest(ctx, guestID, userID)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,318 @@
+package tests
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/minio"
+	minioMocks "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/minio/mocks"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/mocks"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/catalog"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type catalogMocks struct {
+	repo       *mocks.MockICatalogRepository
+	attributes *mocks.MockIAttributeSchemaRepository
+	storage    *minioMocks.MockProvider
+}
+
+func newCatalogUsecase(t *testing.T) (*catalog.CatalogUsecase, catalogMocks) {
+	ctrl := gomock.NewController(t)
+	m := catalogMocks{
+		repo:       mocks.NewMockICatalogRepository(ctrl),
+		attributes: mocks.NewMockIAttributeSchemaRepository(ctrl),
+		storage:    minioMocks.NewMockProvider(ctrl),
+	}
+	conf := &config.CatalogImportConfig{
+		PollInterval: time.Second,
+		StaleAfter:   time.Minute,
+		MaxFileSize:  1 << 20,
+		MaxRows:      100,
+	}
+	return catalog.NewCatalogUsecase(m.repo, m.attributes, m.storage, conf), m
+}
+
+func TestCatalogUsecase_StartImport(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	sellerID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		uc, m := newCatalogUsecase(t)
+
+		m.storage.EXPECT().
+			CreateOne(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, file minio.FileData) (*dto.UploadResponse, error) {
+				assert.Equal(t, models.CatalogXLSX.ContentType(), file.ContentType)
+				return &dto.UploadResponse{ObjectID: "object"}, nil
+			})
+		m.repo.EXPECT().CreateImport(gomock.Any(), gomock.Any()).Return(nil)
+
+		job, err := uc.StartImport(ctx, sellerID, "Catalog.XLSX", []byte("data"))
+		require.NoError(t, err)
+		assert.Equal(t, models.CatalogXLSX, job.Format)
+		assert.Equal(t, models.ImportPending, job.Status)
+		assert.Equal(t, "object", job.FileKey)
+		assert.Equal(t, sellerID, job.SellerID)
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		uc, _ := newCatalogUsecase(t)
+
+		_, err := uc.StartImport(ctx, sellerID, "catalog.xls", []byte("data"))
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+	})
+
+	t.Run("file too large", func(t *testing.T) {
+		uc, _ := newCatalogUsecase(t)
+
+		_, err := uc.StartImport(ctx, sellerID, "catalog.csv", make([]byte, 2<<20))
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+	})
+}
+
+// xlsxWithSheet собирает минимальную книгу с одним листом из разметки sheetData
+func xlsxWithSheet(t *testing.T, sheetData string) []byte {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	archive := zip.NewWriter(buf)
+	for name, content := range map[string]string{
+		"xl/workbook.xml": `<workbook xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<Relationships><Relationship Id="rId1" Target="worksheets/sheet1.xml"/></Relationships>`,
+		"xl/worksheets/sheet1.xml":   `<worksheet><sheetData>` + sheetData + `</sheetData></worksheet>`,
+	} {
+		w, err := archive.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, archive.Close())
+
+	return buf.Bytes()
+}
+
+func TestCatalogUsecase_ProcessNext(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	sellerID := uuid.New()
+	phonesID := uuid.New()
+	laptopsID := uuid.New()
+
+	t.Run("empty queue", func(t *testing.T) {
+		uc, m := newCatalogUsecase(t)
+
+		m.repo.EXPECT().ClaimImport(gomock.Any(), time.Minute).Return(nil, errs.NewNotFoundError("queue"))
+
+		processed, err := uc.ProcessNext(ctx)
+		require.NoError(t, err)
+		assert.False(t, processed)
+	})
+
+	t.Run("valid and invalid rows", func(t *testing.T) {
+		uc, m := newCatalogUsecase(t)
+		job := &models.CatalogImport{ID: uuid.New(), SellerID: sellerID, Format: models.CatalogCSV, FileKey: "key"}
+
+		file := "\ufeffSKU;Name;Category;Price;Quantity\n" +
+			"A-1;Phone;phones;1 999,5;3\n" +
+			"A-2;Old phone;Phones;100;1\n" +
+			";No sku;Phones;100;1\n" +
+			"A-1;Duplicate;Phones;100;1\n" +
+			"A-3;;Phones;100;1\n" +
+			"A-4;Free;Phones;0;1\n" +
+			"A-5;Half;Phones;10;1.5\n" +
+			"A-6;Unknown;Tablets;10;1\n" +
+			"\n" +
+			"A-7;Laptop;Laptops;10;1\n"
+
+		m.repo.EXPECT().ClaimImport(gomock.Any(), time.Minute).Return(job, nil)
+		m.storage.EXPECT().GetOne(gomock.Any(), "key").Return([]byte(file), nil)
+		m.repo.EXPECT().FindCategories(gomock.Any(), gomock.Any()).
+			Return(map[string][]uuid.UUID{"phones": {phonesID}, "laptops": {laptopsID}}, nil)
+		m.repo.EXPECT().GetProductCategories(gomock.Any(), sellerID, gomock.Any()).
+			Return(map[string]uuid.UUID{"A-2": phonesID}, nil)
+		// Схема загружается один раз на категорию; существующий товар без смены категории её не проверяет
+		m.attributes.EXPECT().GetCategoryAttributes(gomock.Any(), phonesID).Return(nil, nil)
+		m.attributes.EXPECT().GetCategoryAttributes(gomock.Any(), laptopsID).
+			Return([]models.CategoryAttribute{{Name: "RAM", Required: true}}, nil)
+		m.repo.EXPECT().
+			UpsertProduct(gomock.Any(), sellerID, gomock.Any(), phonesID).
+			DoAndReturn(func(_ context.Context, _ uuid.UUID, row models.CatalogRow, _ uuid.UUID) (bool, error) {
+				assert.Equal(t, "A-1", row.SKU)
+				assert.Equal(t, models.Kopecks(199950), row.Price)
+				assert.Equal(t, uint(3), row.Quantity)
+				return true, nil
+			})
+		m.repo.EXPECT().UpsertProduct(gomock.Any(), sellerID, gomock.Any(), phonesID).Return(false, nil)
+		m.repo.EXPECT().
+			FinishImport(gomock.Any(), job, gomock.Any()).
+			DoAndReturn(func(_ context.Context, job *models.CatalogImport, rowErrors []models.ImportRowError) error {
+				assert.Equal(t, models.ImportDone, job.Status)
+				assert.Equal(t, 9, job.TotalRows)
+				assert.Equal(t, 1, job.CreatedRows)
+				assert.Equal(t, 1, job.UpdatedRows)
+				assert.Equal(t, 7, job.FailedRows)
+
+				rows := make([]int, 0, len(rowErrors))
+				for _, rowError := range rowErrors {
+					rows = append(rows, rowError.Row)
+				}
+				assert.Equal(t, []int{4, 5, 6, 7, 8, 9, 11}, rows)
+				assert.Equal(t, errs.ErrEmptyProductName.Error(), rowErrors[2].Message)
+				assert.Equal(t, errs.ErrInvalidProductPrice.Error(), rowErrors[3].Message)
+				assert.Equal(t, errs.ErrInvalidProductQuantity.Error(), rowErrors[4].Message)
+				return nil
+			})
+
+		processed, err := uc.ProcessNext(ctx)
+		require.NoError(t, err)
+		assert.True(t, processed)
+	})
+
+	t.Run("missing columns fails job", func(t *testing.T) {
+		uc, m := newCatalogUsecase(t)
+		job := &models.CatalogImport{ID: uuid.New(), SellerID: sellerID, Format: models.CatalogCSV, FileKey: "key"}
+
+		m.repo.EXPECT().ClaimImport(gomock.Any(), time.Minute).Return(job, nil)
+		m.storage.EXPECT().GetOne(gomock.Any(), "key").Return([]byte("sku,name\nA-1,Phone\n"), nil)
+		m.repo.EXPECT().
+			FinishImport(gomock.Any(), job, gomock.Nil()).
+			DoAndReturn(func(_ context.Context, job *models.CatalogImport, _ []models.ImportRowError) error {
+				assert.Equal(t, models.ImportFailed, job.Status)
+				assert.Contains(t, job.Error, "category, price, quantity")
+				return nil
+			})
+
+		processed, err := uc.ProcessNext(ctx)
+		require.NoError(t, err)
+		assert.True(t, processed)
+	})
+
+	t.Run("exported xlsx imports back", func(t *testing.T) {
+		uc, m := newCatalogUsecase(t)
+		rows := []models.CatalogRow{
+			{SKU: "000123", Name: "Phone & case", Description: "<b>new</b>", Category: "Phones", Price: models.Kopecks(199950), Quantity: 3},
+			{SKU: "B-2", Name: "Charger", Category: "Phones", Price: models.Rubles(10), Quantity: 0},
+		}
+
+		m.repo.EXPECT().ExportProducts(gomock.Any(), sellerID).Return(rows, nil)
+		data, err := uc.Export(ctx, sellerID, models.CatalogXLSX)
+		require.NoError(t, err)
+
+		job := &models.CatalogImport{ID: uuid.New(), SellerID: sellerID, Format: models.CatalogXLSX, FileKey: "key"}
+		m.repo.EXPECT().ClaimImport(gomock.Any(), time.Minute).Return(job, nil)
+		m.storage.EXPECT().GetOne(gomock.Any(), "key").Return(data, nil)
+		m.repo.EXPECT().FindCategories(gomock.Any(), []string{"phones", "phones"}).
+			Return(map[string][]uuid.UUID{"phones": {phonesID}}, nil)
+		m.repo.EXPECT().GetProductCategories(gomock.Any(), sellerID, []string{"000123", "B-2"}).
+			Return(map[string]uuid.UUID{"000123": phonesID, "B-2": phonesID}, nil)
+
+		var imported []models.CatalogRow
+		m.repo.EXPECT().
+			UpsertProduct(gomock.Any(), sellerID, gomock.Any(), phonesID).
+			DoAndReturn(func(_ context.Context, _ uuid.UUID, row models.CatalogRow, _ uuid.UUID) (bool, error) {
+				imported = append(imported, row)
+				return false, nil
+			}).Times(2)
+		m.repo.EXPECT().FinishImport(gomock.Any(), job, gomock.Nil()).Return(nil)
+
+		_, err = uc.ProcessNext(ctx)
+		require.NoError(t, err)
+		require.Len(t, imported, 2)
+		for i := range rows {
+			rows[i].Row = i + 2
+		}
+		assert.Equal(t, rows, imported)
+		assert.Equal(t, 2, job.UpdatedRows)
+	})
+
+	t.Run("xlsx out of bounds fails job", func(t *testing.T) {
+		header := `<row r="1">`
+		for i, column := range []string{"sku", "name", "category", "price", "quantity"} {
+			header += `<c r="` + string(rune('A'+i)) + `1" t="inlineStr"><is><t>` + column + `</t></is></c>`
+		}
+		header += `</row>`
+
+		for name, sheetData := range map[string]string{
+			"row":    header + `<row r="1048576"><c r="A1048576"><v>1</v></c></row>`,
+			"column": header + `<row r="2"><c r="ZZZZZZZZZZZZZZ2"><v>1</v></c></row>`,
+		} {
+			uc, m := newCatalogUsecase(t)
+			job := &models.CatalogImport{ID: uuid.New(), SellerID: sellerID, Format: models.CatalogXLSX, FileKey: "key"}
+
+			m.repo.EXPECT().ClaimImport(gomock.Any(), time.Minute).Return(job, nil)
+			m.storage.EXPECT().GetOne(gomock.Any(), "key").Return(xlsxWithSheet(t, sheetData), nil)
+			m.repo.EXPECT().FinishImport(gomock.Any(), job, gomock.Nil()).Return(nil)
+
+			processed, err := uc.ProcessNext(ctx)
+			require.NoError(t, err, name)
+			assert.True(t, processed, name)
+			assert.Equal(t, models.ImportFailed, job.Status, name)
+		}
+	})
+
+	t.Run("panic fails job", func(t *testing.T) {
+		uc, m := newCatalogUsecase(t)
+		job := &models.CatalogImport{ID: uuid.New(), SellerID: sellerID, Format: models.CatalogCSV, FileKey: "key"}
+
+		m.repo.EXPECT().ClaimImport(gomock.Any(), time.Minute).Return(job, nil)
+		m.storage.EXPECT().GetOne(gomock.Any(), "key").
+			Return([]byte("sku,name,category,price,quantity\nA-1,Phone,Phones,10,1\n"), nil)
+		m.repo.EXPECT().FindCategories(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(context.Context, []string) (map[string][]uuid.UUID, error) {
+				panic("unexpected state")
+			})
+		m.repo.EXPECT().
+			FinishImport(gomock.Any(), job, gomock.Nil()).
+			DoAndReturn(func(_ context.Context, job *models.CatalogImport, _ []models.ImportRowError) error {
+				assert.Equal(t, models.ImportFailed, job.Status)
+				return nil
+			})
+
+		processed, err := uc.ProcessNext(ctx)
+		require.NoError(t, err)
+		assert.True(t, processed)
+	})
+}
+
+func TestCatalogUsecase_GetImportReport(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	sellerID := uuid.New()
+	importID := uuid.New()
+
+	t.Run("not finished", func(t *testing.T) {
+		uc, m := newCatalogUsecase(t)
+
+		m.repo.EXPECT().GetImport(gomock.Any(), importID, sellerID).
+			Return(&models.CatalogImport{ID: importID, Status: models.ImportProcessing}, nil)
+
+		_, _, err := uc.GetImportReport(ctx, sellerID, importID)
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+	})
+
+	t.Run("csv report", func(t *testing.T) {
+		uc, m := newCatalogUsecase(t)
+
+		m.repo.EXPECT().GetImport(gomock.Any(), importID, sellerID).
+			Return(&models.CatalogImport{ID: importID, Format: models.CatalogCSV, Status: models.ImportDone}, nil)
+		m.repo.EXPECT().GetImportErrors(gomock.Any(), importID).
+			Return([]models.ImportRowError{{Row: 3, SKU: "A-1", Message: "category \"x\" not found"}}, nil)
+
+		report, format, err := uc.GetImportReport(ctx, sellerID, importID)
+		require.NoError(t, err)
+		assert.Equal(t, models.CatalogCSV, format)
+		assert.Equal(t, "row,sku,message\n3,A-1,\"category \"\"x\"\" not found\"\n", string(report))
+	})
+}
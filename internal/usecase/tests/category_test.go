@@ -7,10 +7,13 @@ import (
 
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/mocks"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/category"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCategoryUsecase_GetAllCategories(t *testing.T) {
@@ -157,3 +160,159 @@ func TestCategoryUsecase_GetNameSubcategory(t *testing.T) {
 		assert.Empty(t, name)
 	})
 }
+
+func TestSlugify(t *testing.T) {
+	assert.Equal(t, "umnye-chasy-i-braslety", category.Slugify("Умные часы и браслеты"))
+	assert.Equal(t, "tv-audio", category.Slugify("  ТВ & аудио! "))
+	assert.Equal(t, "elka-2025", category.Slugify("Ёлка 2025"))
+	assert.Empty(t, category.Slugify("!!!"))
+
+	assert.True(t, category.ValidSlug("smartfony-i-gadzhety"))
+	assert.False(t, category.ValidSlug("Smartfony"))
+	assert.False(t, category.ValidSlug("smartfony--gadzhety"))
+	assert.False(t, category.ValidSlug("-smartfony"))
+}
+
+func TestCategoryUsecase_CreateCategory(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("generates unique slug", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockICategoryRepository(ctrl)
+		parentID := uuid.New()
+
+		mockRepo.EXPECT().GetCategory(gomock.Any(), parentID).Return(&models.Category{ID: parentID}, nil)
+		mockRepo.EXPECT().GetCategoryBySlug(gomock.Any(), "smartfony").Return(&models.Category{}, nil)
+		mockRepo.EXPECT().GetCategoryBySlug(gomock.Any(), "smartfony-2").Return(nil, errs.NewNotFoundError("category not found"))
+		mockRepo.EXPECT().CreateCategory(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, c *models.Category) error {
+				c.Position = 4
+				return nil
+			})
+
+		created, err := category.NewCategoryUsecase(mockRepo).CreateCategory(ctx, dto.CreateCategoryRequest{
+			Name:     " Смартфоны ",
+			ParentID: &parentID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Смартфоны", created.Name)
+		assert.Equal(t, "smartfony-2", created.Slug)
+		assert.Equal(t, uuid.NullUUID{UUID: parentID, Valid: true}, created.ParentID)
+		assert.Equal(t, 4, created.Position)
+	})
+
+	t.Run("explicit slug", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockICategoryRepository(ctrl)
+
+		mockRepo.EXPECT().CreateCategory(gomock.Any(), gomock.Any()).Return(nil)
+
+		created, err := category.NewCategoryUsecase(mockRepo).CreateCategory(ctx, dto.CreateCategoryRequest{
+			Name: "Смартфоны",
+			Slug: "phones",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "phones", created.Slug)
+		assert.False(t, created.ParentID.Valid)
+	})
+
+	t.Run("invalid slug", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockICategoryRepository(ctrl)
+
+		_, err := category.NewCategoryUsecase(mockRepo).CreateCategory(ctx, dto.CreateCategoryRequest{
+			Name: "Смартфоны",
+			Slug: "Phones!",
+		})
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+	})
+
+	t.Run("missing parent", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockICategoryRepository(ctrl)
+		parentID := uuid.New()
+
+		mockRepo.EXPECT().GetCategory(gomock.Any(), parentID).Return(nil, errs.NewNotFoundError("category not found"))
+
+		_, err := category.NewCategoryUsecase(mockRepo).CreateCategory(ctx, dto.CreateCategoryRequest{
+			Name:     "Смартфоны",
+			ParentID: &parentID,
+		})
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockICategoryRepository(ctrl)
+
+		_, err := category.NewCategoryUsecase(mockRepo).CreateCategory(ctx, dto.CreateCategoryRequest{Name: "  "})
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+	})
+}
+
+func TestCategoryUsecase_MoveCategory(t *testing.T) {
+	ctx := context.Background()
+	id, parentID := uuid.New(), uuid.New()
+
+	t.Run("into own subtree", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockICategoryRepository(ctrl)
+
+		mockRepo.EXPECT().MoveCategory(gomock.Any(), id, uuid.NullUUID{UUID: parentID, Valid: true}, 0).
+			Return(errs.NewBusinessLogicError("category cannot be moved into its own subtree"))
+
+		err := category.NewCategoryUsecase(mockRepo).MoveCategory(ctx, id, dto.MoveCategoryRequest{ParentID: &parentID})
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+	})
+
+	t.Run("to another parent", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockICategoryRepository(ctrl)
+
+		mockRepo.EXPECT().MoveCategory(gomock.Any(), id, uuid.NullUUID{UUID: parentID, Valid: true}, 2).Return(nil)
+
+		err := category.NewCategoryUsecase(mockRepo).MoveCategory(ctx, id, dto.MoveCategoryRequest{ParentID: &parentID, Position: 2})
+		assert.NoError(t, err)
+	})
+
+	t.Run("to root", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockICategoryRepository(ctrl)
+
+		mockRepo.EXPECT().MoveCategory(gomock.Any(), id, uuid.NullUUID{}, 0).Return(nil)
+
+		err := category.NewCategoryUsecase(mockRepo).MoveCategory(ctx, id, dto.MoveCategoryRequest{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("negative position", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockICategoryRepository(ctrl)
+
+		err := category.NewCategoryUsecase(mockRepo).MoveCategory(ctx, id, dto.MoveCategoryRequest{Position: -1})
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+	})
+}
+
+func TestCategoryUsecase_GetCategoryTree(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockICategoryRepository(ctrl)
+
+	rootID, childID, grandchildID := uuid.New(), uuid.New(), uuid.New()
+	mockRepo.EXPECT().GetCategoryTree(gomock.Any()).Return([]*models.Category{
+		{ID: rootID, Name: "Электроника", Slug: "elektronika"},
+		{ID: childID, Name: "Смартфоны", Slug: "smartfony", ParentID: uuid.NullUUID{UUID: rootID, Valid: true}},
+		{ID: grandchildID, Name: "Чехлы", Slug: "chekhly", ParentID: uuid.NullUUID{UUID: childID, Valid: true}},
+		{ID: uuid.New(), Name: "Одежда", Slug: "odezhda", Position: 1},
+	}, nil)
+
+	tree, err := category.NewCategoryUsecase(mockRepo).GetCategoryTree(context.Background())
+	require.NoError(t, err)
+	require.Len(t, tree.Categories, 2)
+	assert.Equal(t, "Электроника", tree.Categories[0].Name)
+	assert.Equal(t, "Одежда", tree.Categories[1].Name)
+	assert.Empty(t, tree.Categories[1].Children)
+	require.Len(t, tree.Categories[0].Children, 1)
+	require.Len(t, tree.Categories[0].Children[0].Children, 1)
+	assert.Equal(t, grandchildID, tree.Categories[0].Children[0].Children[0].ID)
+}
@@ -0,0 +1,214 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/mocks"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/currency"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestCurrency(t *testing.T) (*mocks.MockICurrencyRepository, *currency.CurrencyUsecase) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockICurrencyRepository(ctrl)
+	conf := &config.CurrencyConfig{RatesCacheTTL: time.Minute, MaxImportRows: 3}
+	return mockRepo, currency.NewCurrencyUsecase(mockRepo, conf)
+}
+
+func mustRate(t *testing.T, currency models.Currency, rate string) models.ExchangeRate {
+	t.Helper()
+	parsed, err := models.ParseRate(rate)
+	require.NoError(t, err)
+	return models.ExchangeRate{Currency: currency, Rate: parsed}
+}
+
+func TestExchangeRate(t *testing.T) {
+	t.Run("parse rate", func(t *testing.T) {
+		for raw, expected := range map[string]string{
+			"92.5":     "92.5",
+			"0.012345": "0.012345",
+			"100":      "100",
+			"1.500000": "1.5",
+		} {
+			rate, err := models.ParseRate(raw)
+			require.NoError(t, err, raw)
+			assert.Equal(t, expected, rate.String(), raw)
+		}
+
+		for _, raw := range []string{"", "0", "-1", "1.0000001", "abc"} {
+			_, err := models.ParseRate(raw)
+			assert.ErrorIs(t, err, models.ErrInvalidRate, raw)
+		}
+	})
+
+	t.Run("parse currency", func(t *testing.T) {
+		code, err := models.ParseCurrency(" usd ")
+		require.NoError(t, err)
+		assert.Equal(t, models.Currency("USD"), code)
+
+		for _, raw := range []string{"", "US", "USDT", "U$D"} {
+			_, err = models.ParseCurrency(raw)
+			assert.ErrorIs(t, err, models.ErrInvalidCurrency, raw)
+		}
+	})
+
+	t.Run("base currency is not converted", func(t *testing.T) {
+		var rate models.ExchangeRate
+		assert.True(t, rate.IsBase())
+		assert.Equal(t, models.BaseCurrency, rate.Code())
+		assert.Equal(t, models.Rubles(100), rate.Convert(models.Rubles(100)))
+	})
+
+	t.Run("convert rounds to minor units", func(t *testing.T) {
+		usd := mustRate(t, "USD", "92.5")
+		// 1000 / 92.5 = 10.8108… → 10.81
+		converted := usd.Convert(models.Rubles(1000))
+		assert.Equal(t, "10.81", converted.String())
+		assert.Equal(t, models.Currency("USD"), converted.Currency())
+		assert.Equal(t, "0.00", usd.Convert(models.Money{}).String())
+	})
+
+	t.Run("price filter is converted back to base", func(t *testing.T) {
+		usd := mustRate(t, "USD", "92.5")
+		limit, err := models.ParseMoney("10.81")
+		require.NoError(t, err)
+		// 10.81 * 92.5 = 999.925 → 999.93
+		assert.Equal(t, "999.93", usd.ToBase(limit).String())
+	})
+}
+
+func TestCurrencyUsecase_GetRate(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("base currency without lookup", func(t *testing.T) {
+		_, uc := setupTestCurrency(t)
+
+		rate, err := uc.GetRate(ctx, models.BaseCurrency)
+		require.NoError(t, err)
+		assert.True(t, rate.IsBase())
+	})
+
+	t.Run("cached until rate changes", func(t *testing.T) {
+		mockRepo, uc := setupTestCurrency(t)
+		usd := mustRate(t, "USD", "92.5")
+
+		mockRepo.EXPECT().GetRate(gomock.Any(), models.Currency("USD")).Return(usd, nil).Times(2)
+		mockRepo.EXPECT().SaveRates(gomock.Any(), gomock.Any()).Return(nil)
+
+		for i := 0; i < 3; i++ {
+			rate, err := uc.GetRate(ctx, "USD")
+			require.NoError(t, err)
+			assert.Equal(t, usd, rate)
+		}
+
+		require.NoError(t, uc.SetRate(ctx, "usd", dto.SetExchangeRateRequest{Rate: usd.Rate}))
+
+		_, err := uc.GetRate(ctx, "USD")
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown currency", func(t *testing.T) {
+		mockRepo, uc := setupTestCurrency(t)
+		mockRepo.EXPECT().GetRate(gomock.Any(), models.Currency("XYZ")).
+			Return(models.ExchangeRate{}, errs.NewNotFoundError("exchange rate not found"))
+
+		_, err := uc.GetRate(ctx, "XYZ")
+		assert.Error(t, err)
+	})
+}
+
+func TestCurrencyUsecase_GetRates(t *testing.T) {
+	mockRepo, uc := setupTestCurrency(t)
+	usd := mustRate(t, "USD", "92.5")
+	mockRepo.EXPECT().GetRates(gomock.Any()).Return([]models.ExchangeRate{usd}, nil)
+
+	rates, err := uc.GetRates(context.Background())
+	require.NoError(t, err)
+	require.Len(t, rates, 2)
+	assert.Equal(t, models.BaseCurrency, rates[0].Currency)
+	assert.Equal(t, "1", rates[0].Rate.String())
+	assert.Equal(t, usd, rates[1])
+}
+
+func TestCurrencyUsecase_SetRate(t *testing.T) {
+	ctx := context.Background()
+	usd := mustRate(t, "USD", "92.5")
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo, uc := setupTestCurrency(t)
+		mockRepo.EXPECT().SaveRates(gomock.Any(), []models.ExchangeRate{usd}).Return(nil)
+
+		assert.NoError(t, uc.SetRate(ctx, "usd", dto.SetExchangeRateRequest{Rate: usd.Rate}))
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		_, uc := setupTestCurrency(t)
+
+		for code, req := range map[string]dto.SetExchangeRateRequest{
+			"RUB":  {Rate: usd.Rate},
+			"US":   {Rate: usd.Rate},
+			"EUR":  {},
+			"USDT": {Rate: usd.Rate},
+		} {
+			err := uc.SetRate(ctx, code, req)
+			assert.ErrorIs(t, err, errs.ErrBusinessLogic, code)
+		}
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		mockRepo, uc := setupTestCurrency(t)
+		mockRepo.EXPECT().SaveRates(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+
+		assert.Error(t, uc.SetRate(ctx, "USD", dto.SetExchangeRateRequest{Rate: usd.Rate}))
+	})
+}
+
+func TestCurrencyUsecase_ImportRates(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("comma separated with header", func(t *testing.T) {
+		mockRepo, uc := setupTestCurrency(t)
+		mockRepo.EXPECT().SaveRates(gomock.Any(), []models.ExchangeRate{
+			mustRate(t, "USD", "92.5"),
+			mustRate(t, "EUR", "100.25"),
+		}).Return(nil)
+
+		result, err := uc.ImportRates(ctx, []byte("\ufeffcurrency,rate\nusd,92.5\nEUR, 100.25\n"))
+		require.NoError(t, err)
+		assert.Equal(t, dto.ImportRatesResponse{Imported: 2}, result)
+	})
+
+	t.Run("semicolon separated with decimal comma", func(t *testing.T) {
+		mockRepo, uc := setupTestCurrency(t)
+		mockRepo.EXPECT().SaveRates(gomock.Any(), []models.ExchangeRate{mustRate(t, "KZT", "0.18")}).Return(nil)
+
+		result, err := uc.ImportRates(ctx, []byte("KZT;0,18\n"))
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Imported)
+	})
+
+	t.Run("rejected files", func(t *testing.T) {
+		_, uc := setupTestCurrency(t)
+
+		for name, data := range map[string]string{
+			"empty":          "currency,rate\n",
+			"missing rate":   "USD\n",
+			"invalid rate":   "USD,abc\n",
+			"base currency":  "RUB,1\n",
+			"duplicate":      "USD,92\nusd,93\n",
+			"too many rates": "USD,1\nEUR,1\nCNY,1\nKZT,1\n",
+		} {
+			_, err := uc.ImportRates(ctx, []byte(data))
+			assert.ErrorIs(t, err, errs.ErrBusinessLogic, name)
+		}
+	})
+}
@@ -0,0 +1,368 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/mocks"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/delivery"
+	ucmocks "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/mocks"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/order"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/pricing"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// anyDeliveryPlanner планирует доставку без интервала для тестов, которые её не проверяют
+func anyDeliveryPlanner(ctrl *gomock.Controller) *ucmocks.MockIDeliveryPlanner {
+	planner := ucmocks.NewMockIDeliveryPlanner(ctrl)
+	planner.EXPECT().Plan(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(models.DeliveryPlan{ExpectedDeliveryAt: time.Now()}, nil).AnyTimes()
+	return planner
+}
+
+// anyWarehouseRouter направляет любой заказ на склад и считает любого работника прикреплённым к складу
+func anyWarehouseRouter(ctrl *gomock.Controller) *ucmocks.MockIWarehouseRouter {
+	router := ucmocks.NewMockIWarehouseRouter(ctrl)
+	router.EXPECT().RouteOrder(gomock.Any(), gomock.Any()).Return(uuid.New(), nil).AnyTimes()
+	router.EXPECT().StaffWarehouse(gomock.Any()).Return(uuid.New(), nil).AnyTimes()
+	return router
+}
+
+// anyEarningsRecorder принимает начисления по любому доставленному заказу
+func anyEarningsRecorder(ctrl *gomock.Controller) *ucmocks.MockIEarningsRecorder {
+	recorder := ucmocks.NewMockIEarningsRecorder(ctrl)
+	recorder.EXPECT().RecordEarnings(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return recorder
+}
+
+func setupTestDelivery(t *testing.T) (*mocks.MockIDeliveryRepository, *delivery.DeliveryUsecase) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockIDeliveryRepository(ctrl)
+	return mockRepo, delivery.NewDeliveryUsecase(mockRepo, &config.DeliveryConfig{
+		DefaultLeadTime: 5 * 24 * time.Hour,
+		SlotHorizon:     7 * 24 * time.Hour,
+	})
+}
+
+func TestDeliveryUsecase_GetOptions(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	addressID := uuid.New()
+	zone := &models.DeliveryZone{ID: uuid.New(), Name: "Москва", LeadTimeDays: 1}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo, uc := setupTestDelivery(t)
+
+		slot := models.DeliverySlot{
+			ID:       uuid.New(),
+			ZoneID:   zone.ID,
+			StartsAt: time.Now().Add(48 * time.Hour),
+			EndsAt:   time.Now().Add(52 * time.Hour),
+			Capacity: 10,
+			Reserved: 4,
+		}
+		mockRepo.EXPECT().GetAddressZone(gomock.Any(), addressID).Return(zone, nil)
+		mockRepo.EXPECT().GetAvailableSlots(gomock.Any(), zone.ID, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uuid.UUID, from, to time.Time) ([]models.DeliverySlot, error) {
+				assert.WithinDuration(t, time.Now().Add(24*time.Hour), from, time.Minute)
+				assert.Equal(t, 7*24*time.Hour, to.Sub(from))
+				return []models.DeliverySlot{slot}, nil
+			})
+
+		options, err := uc.GetOptions(ctx, addressID)
+		require.NoError(t, err)
+		assert.Equal(t, "Москва", options.Zone)
+		assert.WithinDuration(t, time.Now().Add(24*time.Hour), options.ExpectedDeliveryAt, time.Minute)
+		require.Len(t, options.Slots, 1)
+		assert.Equal(t, 6, options.Slots[0].Free)
+	})
+
+	t.Run("address outside zones", func(t *testing.T) {
+		mockRepo, uc := setupTestDelivery(t)
+
+		mockRepo.EXPECT().GetAddressZone(gomock.Any(), addressID).Return(nil, errs.NewNotFoundError("delivery zone not found"))
+
+		options, err := uc.GetOptions(ctx, addressID)
+		require.NoError(t, err)
+		assert.Empty(t, options.Slots)
+		assert.WithinDuration(t, time.Now().Add(5*24*time.Hour), options.ExpectedDeliveryAt, time.Minute)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		mockRepo, uc := setupTestDelivery(t)
+
+		mockRepo.EXPECT().GetAddressZone(gomock.Any(), addressID).Return(zone, nil)
+		mockRepo.EXPECT().GetAvailableSlots(gomock.Any(), zone.ID, gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+		_, err := uc.GetOptions(ctx, addressID)
+		assert.Error(t, err)
+	})
+}
+
+func TestDeliveryUsecase_Plan(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	addressID := uuid.New()
+	zone := &models.DeliveryZone{ID: uuid.New(), Name: "Москва", LeadTimeDays: 1}
+	slotAt := func(zoneID uuid.UUID, startsIn time.Duration, reserved int) *models.DeliverySlot {
+		return &models.DeliverySlot{
+			ID:       uuid.New(),
+			ZoneID:   zoneID,
+			StartsAt: time.Now().Add(startsIn),
+			EndsAt:   time.Now().Add(startsIn + 4*time.Hour),
+			Capacity: 2,
+			Reserved: reserved,
+		}
+	}
+
+	t.Run("without slot uses zone lead time", func(t *testing.T) {
+		mockRepo, uc := setupTestDelivery(t)
+
+		mockRepo.EXPECT().GetAddressZone(gomock.Any(), addressID).Return(zone, nil)
+
+		plan, err := uc.Plan(ctx, addressID, nil)
+		require.NoError(t, err)
+		assert.False(t, plan.SlotID.Valid)
+		assert.WithinDuration(t, time.Now().Add(24*time.Hour), plan.ExpectedDeliveryAt, time.Minute)
+	})
+
+	t.Run("slot sets expected delivery to slot end", func(t *testing.T) {
+		mockRepo, uc := setupTestDelivery(t)
+		slot := slotAt(zone.ID, 48*time.Hour, 1)
+
+		mockRepo.EXPECT().GetAddressZone(gomock.Any(), addressID).Return(zone, nil)
+		mockRepo.EXPECT().GetSlot(gomock.Any(), slot.ID).Return(slot, nil)
+
+		plan, err := uc.Plan(ctx, addressID, &slot.ID)
+		require.NoError(t, err)
+		assert.Equal(t, uuid.NullUUID{UUID: slot.ID, Valid: true}, plan.SlotID)
+		assert.Equal(t, slot.EndsAt, plan.ExpectedDeliveryAt)
+	})
+
+	rejected := map[string]*models.DeliverySlot{
+		"slot is full":              slotAt(zone.ID, 48*time.Hour, 2),
+		"slot of another zone":      slotAt(uuid.New(), 48*time.Hour, 0),
+		"slot before the lead time": slotAt(zone.ID, time.Hour, 0),
+	}
+	for name, slot := range rejected {
+		slot := slot
+		t.Run(name, func(t *testing.T) {
+			mockRepo, uc := setupTestDelivery(t)
+
+			mockRepo.EXPECT().GetAddressZone(gomock.Any(), addressID).Return(zone, nil)
+			mockRepo.EXPECT().GetSlot(gomock.Any(), slot.ID).Return(slot, nil)
+
+			_, err := uc.Plan(ctx, addressID, &slot.ID)
+			assert.ErrorIs(t, err, errs.ErrSlotUnavailable)
+		})
+	}
+
+	t.Run("address outside zones with slot", func(t *testing.T) {
+		mockRepo, uc := setupTestDelivery(t)
+		slotID := uuid.New()
+
+		mockRepo.EXPECT().GetAddressZone(gomock.Any(), addressID).Return(nil, errs.NewNotFoundError("delivery zone not found"))
+
+		_, err := uc.Plan(ctx, addressID, &slotID)
+		assert.ErrorIs(t, err, errs.ErrSlotUnavailable)
+	})
+}
+
+func TestDeliveryUsecase_CreateSlot(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	startsAt := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo, uc := setupTestDelivery(t)
+		req := dto.CreateDeliverySlotRequest{ZoneID: uuid.New(), StartsAt: startsAt, EndsAt: startsAt.Add(4 * time.Hour), Capacity: 20}
+
+		mockRepo.EXPECT().CreateSlot(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, slot models.DeliverySlot) error {
+				assert.Equal(t, req.ZoneID, slot.ZoneID)
+				assert.Equal(t, 20, slot.Capacity)
+				return nil
+			})
+
+		slot, err := uc.CreateSlot(ctx, req)
+		require.NoError(t, err)
+		assert.Equal(t, 20, slot.Free)
+	})
+
+	t.Run("invalid interval", func(t *testing.T) {
+		_, uc := setupTestDelivery(t)
+
+		_, err := uc.CreateSlot(ctx, dto.CreateDeliverySlotRequest{ZoneID: uuid.New(), StartsAt: startsAt, EndsAt: startsAt, Capacity: 1})
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+	})
+
+	t.Run("invalid capacity", func(t *testing.T) {
+		_, uc := setupTestDelivery(t)
+
+		_, err := uc.CreateSlot(ctx, dto.CreateDeliverySlotRequest{ZoneID: uuid.New(), StartsAt: startsAt, EndsAt: startsAt.Add(time.Hour)})
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+	})
+}
+
+func TestDeliveryUsecase_CreateZone(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	city := " Казань "
+	empty := ""
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo, uc := setupTestDelivery(t)
+
+		mockRepo.EXPECT().CreateZone(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, zone models.DeliveryZone) error {
+				assert.Equal(t, "Казань", zone.City.String)
+				assert.False(t, zone.Region.Valid)
+				return nil
+			})
+
+		_, err := uc.CreateZone(ctx, dto.CreateDeliveryZoneRequest{Name: "Казань", Region: &empty, City: &city, LeadTimeDays: 3})
+		require.NoError(t, err)
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		_, uc := setupTestDelivery(t)
+
+		_, err := uc.CreateZone(ctx, dto.CreateDeliveryZoneRequest{Name: " ", LeadTimeDays: 3})
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+	})
+
+	t.Run("already exists", func(t *testing.T) {
+		mockRepo, uc := setupTestDelivery(t)
+
+		mockRepo.EXPECT().CreateZone(gomock.Any(), gomock.Any()).Return(errs.NewAlreadyExistsError("delivery zone already exists"))
+
+		_, err := uc.CreateZone(ctx, dto.CreateDeliveryZoneRequest{Name: "Казань", City: &city})
+		assert.ErrorIs(t, err, errs.ErrAlreadyExists)
+	})
+}
+
+func setupTestOrderDelivery(t *testing.T) (*mocks.MockIOrderRepository, *mocks.MockINotificationRepository, *ucmocks.MockIDeliveryPlanner, *order.OrderUsecase) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockIOrderRepository(ctrl)
+	mockNotificationRepo := mocks.NewMockINotificationRepository(ctrl)
+	mockPlanner := ucmocks.NewMockIDeliveryPlanner(ctrl)
+	engine := pricing.NewEngine(mockRepo, nil, &config.DeliveryConfig{})
+	return mockRepo, mockNotificationRepo, mockPlanner, order.NewOrderUsecase(mockRepo, engine, mockNotificationRepo, nil, mockPlanner, anyWarehouseRouter(ctrl), anyEarningsRecorder(ctrl))
+}
+
+func TestOrderUsecase_CreateOrderDelivery(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	productID := uuid.New()
+	addressID := uuid.New()
+	slotID := uuid.New()
+	in := dto.CreateOrderDTO{
+		UserID:         uuid.New(),
+		AddressID:      addressID,
+		DeliverySlotID: &slotID,
+		Items:          []dto.CreateOrderItemDTO{{ProductID: productID, Quantity: 1}},
+	}
+	expectProduct := func(mockRepo *mocks.MockIOrderRepository) {
+		mockRepo.EXPECT().ProductPrice(gomock.Any(), productID).
+			Return(&models.Product{Status: models.ProductApproved, Quantity: 10, Price: models.Rubles(100)}, nil)
+		mockRepo.EXPECT().ProductDiscounts(gomock.Any(), productID, uuid.NullUUID{}).Return(nil, errs.NewNotFoundError("no discounts"))
+	}
+
+	t.Run("order keeps slot and expected delivery", func(t *testing.T) {
+		mockRepo, mockNotificationRepo, mockPlanner, uc := setupTestOrderDelivery(t)
+		expected := time.Now().Add(52 * time.Hour)
+
+		expectProduct(mockRepo)
+		mockPlanner.EXPECT().Plan(gomock.Any(), addressID, &slotID).
+			Return(models.DeliveryPlan{SlotID: uuid.NullUUID{UUID: slotID, Valid: true}, ExpectedDeliveryAt: expected}, nil)
+		mockRepo.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req dto.CreateOrderRepoReq) error {
+				assert.Equal(t, uuid.NullUUID{UUID: slotID, Valid: true}, req.Order.DeliverySlotID)
+				require.NotNil(t, req.Order.ExpectedDeliveryAt)
+				assert.Equal(t, expected, *req.Order.ExpectedDeliveryAt)
+				return nil
+			})
+		mockNotificationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+		require.NoError(t, uc.CreateOrder(ctx, in))
+	})
+
+	t.Run("slot filled concurrently", func(t *testing.T) {
+		mockRepo, _, mockPlanner, uc := setupTestOrderDelivery(t)
+
+		expectProduct(mockRepo)
+		mockPlanner.EXPECT().Plan(gomock.Any(), addressID, &slotID).
+			Return(models.DeliveryPlan{SlotID: uuid.NullUUID{UUID: slotID, Valid: true}, ExpectedDeliveryAt: time.Now()}, nil)
+		mockRepo.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).Return(errs.ErrSlotUnavailable)
+
+		err := uc.CreateOrder(ctx, in)
+		assert.ErrorIs(t, err, errs.ErrSlotUnavailable)
+	})
+
+	t.Run("unavailable slot is rejected before saving", func(t *testing.T) {
+		mockRepo, _, mockPlanner, uc := setupTestOrderDelivery(t)
+
+		expectProduct(mockRepo)
+		mockPlanner.EXPECT().Plan(gomock.Any(), addressID, &slotID).Return(models.DeliveryPlan{}, errs.ErrSlotUnavailable)
+
+		err := uc.CreateOrder(ctx, in)
+		assert.ErrorIs(t, err, errs.ErrSlotUnavailable)
+	})
+}
+
+func TestOrderUsecase_UpdateStatus(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	orderID := uuid.New()
+	userID := uuid.New()
+
+	t.Run("in transit by default", func(t *testing.T) {
+		mockRepo, mockNotificationRepo, _, uc := setupTestOrderDelivery(t)
+
+		mockRepo.EXPECT().UpdateStatus(gomock.Any(), orderID, gomock.Any(), models.InTransit).Return(nil)
+		mockRepo.EXPECT().GetUserIDByOrderID(gomock.Any(), orderID).Return(userID, nil)
+		mockNotificationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+		require.NoError(t, uc.UpdateStatus(ctx, dto.UpdateOrderStatusRequest{OrderID: orderID}))
+	})
+
+	t.Run("delivered", func(t *testing.T) {
+		mockRepo, mockNotificationRepo, _, uc := setupTestOrderDelivery(t)
+
+		mockRepo.EXPECT().UpdateStatus(gomock.Any(), orderID, gomock.Any(), models.Delivered).Return(nil)
+		mockRepo.EXPECT().GetUserIDByOrderID(gomock.Any(), orderID).Return(userID, nil)
+		mockNotificationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, notification models.Notification) error {
+				assert.Equal(t, userID, notification.UserID)
+				assert.Equal(t, "Ваш заказ доставлен", notification.Text)
+				return nil
+			})
+
+		require.NoError(t, uc.UpdateStatus(ctx, dto.UpdateOrderStatusRequest{OrderID: orderID, Status: "delivered"}))
+	})
+
+	t.Run("canceled or delivered order is not moved", func(t *testing.T) {
+		for _, status := range []models.OrderStatus{models.InTransit, models.Delivered} {
+			mockRepo, _, _, uc := setupTestOrderDelivery(t)
+
+			// Уведомление покупателю при отказе не отправляется
+			mockRepo.EXPECT().UpdateStatus(gomock.Any(), orderID, gomock.Any(), status).
+				Return(errs.NewBusinessLogicError("order cannot be moved"))
+
+			err := uc.UpdateStatus(ctx, dto.UpdateOrderStatusRequest{OrderID: orderID, Status: status.String()})
+			assert.ErrorIs(t, err, errs.ErrBusinessLogic, status.String())
+		}
+	})
+
+	t.Run("unsupported status", func(t *testing.T) {
+		_, _, _, uc := setupTestOrderDelivery(t)
+
+		err := uc.UpdateStatus(ctx, dto.UpdateOrderStatusRequest{OrderID: orderID, Status: "placed"})
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+	})
+}
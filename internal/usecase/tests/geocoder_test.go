@@ -0,0 +1,232 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/mocks"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	addressus "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/address"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/geocoder"
+	ucmocks "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeQuery(t *testing.T) {
+	assert.Equal(t, "Москва, ул. Ленина, д. 1", geocoder.NormalizeQuery("  Москва ,, ул.   Ленина,д. 1 , "))
+	assert.Equal(t, geocoder.CacheKey("Королёв, ул. Ленина, 1"), geocoder.CacheKey(" королев ,ул.  ЛЕНИНА, 1"))
+	assert.Empty(t, geocoder.CacheKey(" , "))
+}
+
+func TestStubGeocoder(t *testing.T) {
+	ctx := context.Background()
+	stub := geocoder.NewStubGeocoder()
+
+	t.Run("deterministic", func(t *testing.T) {
+		first, err := stub.Geocode(ctx, "Москва, ул. Ленина, д. 1")
+		require.NoError(t, err)
+		second, err := stub.Geocode(ctx, "москва,  ул. ленина, д. 1")
+		require.NoError(t, err)
+		other, err := stub.Geocode(ctx, "Москва, ул. Ленина, д. 2")
+		require.NoError(t, err)
+
+		assert.Equal(t, first.Coordinate, second.Coordinate)
+		assert.NotEqual(t, first.Coordinate, other.Coordinate)
+		assert.True(t, first.Coordinate.Valid())
+		assert.Equal(t, "Москва, ул. Ленина, д. 1", first.AddressString)
+
+		parsed, err := models.ParseCoordinate(first.Coordinate.String())
+		require.NoError(t, err)
+		assert.Equal(t, first.Coordinate, parsed)
+	})
+
+	t.Run("address without house number", func(t *testing.T) {
+		_, err := stub.Geocode(ctx, "Москва, ул. Ленина")
+		assert.ErrorIs(t, err, models.ErrAddressNotGeocoded)
+	})
+}
+
+func TestCachedGeocoder(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	query := " Москва ,ул. Ленина, 1"
+	key := geocoder.CacheKey(query)
+	address := &models.GeocodedAddress{
+		AddressString: "Москва, улица Ленина, 1",
+		Coordinate:    models.Coordinate{Lat: 55.75, Lon: 37.61},
+	}
+
+	t.Run("cache hit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		next := ucmocks.NewMockIGeocoder(ctrl)
+		cache := ucmocks.NewMockIGeocodeCache(ctrl)
+
+		cache.EXPECT().Get(gomock.Any(), key).Return(address, nil)
+
+		res, err := geocoder.NewCachedGeocoder(next, cache).Geocode(ctx, query)
+		require.NoError(t, err)
+		assert.Equal(t, address, res)
+	})
+
+	t.Run("cache miss stores normalized query result", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		next := ucmocks.NewMockIGeocoder(ctrl)
+		cache := ucmocks.NewMockIGeocodeCache(ctrl)
+
+		cache.EXPECT().Get(gomock.Any(), key).Return(nil, nil)
+		next.EXPECT().Geocode(gomock.Any(), "Москва, ул. Ленина, 1").Return(address, nil)
+		cache.EXPECT().Set(gomock.Any(), key, address).Return(nil)
+
+		res, err := geocoder.NewCachedGeocoder(next, cache).Geocode(ctx, query)
+		require.NoError(t, err)
+		assert.Equal(t, address, res)
+	})
+
+	t.Run("cache errors are ignored", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		next := ucmocks.NewMockIGeocoder(ctrl)
+		cache := ucmocks.NewMockIGeocodeCache(ctrl)
+
+		cache.EXPECT().Get(gomock.Any(), key).Return(nil, errors.New("redis down"))
+		next.EXPECT().Geocode(gomock.Any(), gomock.Any()).Return(address, nil)
+		cache.EXPECT().Set(gomock.Any(), key, address).Return(errors.New("redis down"))
+
+		res, err := geocoder.NewCachedGeocoder(next, cache).Geocode(ctx, query)
+		require.NoError(t, err)
+		assert.Equal(t, address, res)
+	})
+
+	t.Run("not found is not cached", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		next := ucmocks.NewMockIGeocoder(ctrl)
+		cache := ucmocks.NewMockIGeocodeCache(ctrl)
+
+		cache.EXPECT().Get(gomock.Any(), key).Return(nil, nil)
+		next.EXPECT().Geocode(gomock.Any(), gomock.Any()).Return(nil, models.ErrAddressNotGeocoded)
+
+		_, err := geocoder.NewCachedGeocoder(next, cache).Geocode(ctx, query)
+		assert.ErrorIs(t, err, models.ErrAddressNotGeocoded)
+	})
+}
+
+func TestGeoapifyGeocoder(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+
+	newGeocoder := func(t *testing.T, status int, body string) *geocoder.GeoapifyGeocoder {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "test-key", r.URL.Query().Get("apiKey"))
+			assert.Equal(t, "Москва, ул. Ленина, 1", r.URL.Query().Get("text"))
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(body))
+		}))
+		t.Cleanup(server.Close)
+
+		return geocoder.NewGeoapifyGeocoder(&config.GeoapifyConfig{
+			APIKey:        "test-key",
+			BaseURL:       server.URL,
+			MinImportance: 0.2,
+		})
+	}
+
+	t.Run("picks the most important building", func(t *testing.T) {
+		g := newGeocoder(t, http.StatusOK, `{"features": [
+			{"properties": {"result_type": "street", "lat": 1, "lon": 1, "rank": {"importance": 0.9}}},
+			{"properties": {"result_type": "building", "formatted": "Ленина, 1, Москва", "state": "Москва", "city": "Москва",
+				"lat": 55.75, "lon": 37.61, "rank": {"importance": 0.5}}},
+			{"properties": {"result_type": "building", "formatted": "Ленина, 1, Химки",
+				"lat": 55.9, "lon": 37.4, "rank": {"importance": 0.3}}}
+		]}`)
+
+		res, err := g.Geocode(ctx, "Москва, ул. Ленина, 1")
+		require.NoError(t, err)
+		assert.Equal(t, "Ленина, 1, Москва", res.AddressString)
+		assert.Equal(t, "Москва", res.City)
+		assert.Equal(t, models.Coordinate{Lat: 55.75, Lon: 37.61}, res.Coordinate)
+	})
+
+	t.Run("no confident building", func(t *testing.T) {
+		g := newGeocoder(t, http.StatusOK, `{"features": [
+			{"properties": {"result_type": "building", "lat": 55.75, "lon": 37.61, "rank": {"importance": 0.1}}}
+		]}`)
+
+		_, err := g.Geocode(ctx, "Москва, ул. Ленина, 1")
+		assert.ErrorIs(t, err, models.ErrAddressNotGeocoded)
+	})
+
+	t.Run("provider error", func(t *testing.T) {
+		g := newGeocoder(t, http.StatusUnauthorized, `{}`)
+
+		_, err := g.Geocode(ctx, "Москва, ул. Ленина, 1")
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, models.ErrAddressNotGeocoded)
+	})
+}
+
+func TestCreateAddress_Geocoding(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	input := dto.AddressDTO{
+		Label:         null.StringFrom("Дом"),
+		City:          null.StringFrom("Москва"),
+		AddressString: null.StringFrom("ул. Ленина, 1"),
+		Coordinate:    null.StringFrom("0,0"),
+	}
+
+	t.Run("stores normalized address and coordinate", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mocks.NewMockIAddressRepository(ctrl)
+		geo := ucmocks.NewMockIGeocoder(ctrl)
+
+		geo.EXPECT().Geocode(gomock.Any(), "Москва, ул. Ленина, 1").Return(&models.GeocodedAddress{
+			Region:        "Москва",
+			AddressString: "улица Ленина, 1, Москва",
+			Coordinate:    models.Coordinate{Lat: 55.75, Lon: 37.61},
+		}, nil)
+		repo.EXPECT().CheckAddressExists(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, addr models.AddressDB) (uuid.UUID, error) {
+				assert.Equal(t, null.StringFrom("Москва"), addr.Region)
+				assert.Equal(t, null.StringFrom("Москва"), addr.City)
+				assert.Equal(t, null.StringFrom("улица Ленина, 1, Москва"), addr.AddressString)
+				assert.Equal(t, null.StringFrom("55.75,37.61"), addr.Coordinate)
+				return uuid.Nil, nil
+			})
+		repo.EXPECT().CreateAddress(gomock.Any(), gomock.Any()).Return(nil)
+		repo.EXPECT().CreateUserAddress(gomock.Any(), gomock.Any()).Return(nil)
+
+		err := addressus.NewAddressUsecase(repo, geo).CreateAddress(ctx, uuid.New(), input)
+		assert.NoError(t, err)
+	})
+
+	t.Run("address not geocoded", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mocks.NewMockIAddressRepository(ctrl)
+		geo := ucmocks.NewMockIGeocoder(ctrl)
+
+		geo.EXPECT().Geocode(gomock.Any(), gomock.Any()).Return(nil, models.ErrAddressNotGeocoded)
+
+		err := addressus.NewAddressUsecase(repo, geo).CreateAddress(ctx, uuid.New(), input)
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+	})
+
+	t.Run("geocoder unavailable", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mocks.NewMockIAddressRepository(ctrl)
+		geo := ucmocks.NewMockIGeocoder(ctrl)
+
+		geo.EXPECT().Geocode(gomock.Any(), gomock.Any()).Return(nil, errors.New("timeout"))
+
+		err := addressus.NewAddressUsecase(repo, geo).CreateAddress(ctx, uuid.New(), input)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, errs.ErrBusinessLogic)
+	})
+}
@@ -0,0 +1,184 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/mocks"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/inventory"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newInventoryUsecase(t *testing.T, batch int) (*inventory.InventoryUsecase, *mocks.MockIInventoryRepository, *mocks.MockINotificationRepository) {
+	ctrl := gomock.NewController(t)
+	repo := mocks.NewMockIInventoryRepository(ctrl)
+	notifications := mocks.NewMockINotificationRepository(ctrl)
+	conf := &config.InventoryConfig{AlertBatch: batch}
+	return inventory.NewInventoryUsecase(repo, notifications, conf), repo, notifications
+}
+
+func TestInventoryUsecase_AdjustStock(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	sellerID := uuid.New()
+	productID := uuid.New()
+
+	t.Run("adjustment by default", func(t *testing.T) {
+		uc, repo, _ := newInventoryUsecase(t, 10)
+		variantID := uuid.New()
+
+		repo.EXPECT().
+			AdjustStock(gomock.Any(), sellerID, productID, uuid.NullUUID{UUID: variantID, Valid: true}, -3, models.StockChange{
+				Kind:    models.StockAdjustment,
+				ActorID: uuid.NullUUID{UUID: sellerID, Valid: true},
+				Reason:  "damaged in warehouse",
+			}).
+			Return(&models.StockMovement{ID: 1, Delta: -3, Kind: models.StockAdjustment}, nil)
+
+		movement, err := uc.AdjustStock(ctx, sellerID, productID, dto.StockAdjustmentRequest{
+			VariantID: &variantID,
+			Delta:     -3,
+			Reason:    "  damaged in warehouse ",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, -3, movement.Delta)
+	})
+
+	t.Run("return", func(t *testing.T) {
+		uc, repo, _ := newInventoryUsecase(t, 10)
+
+		repo.EXPECT().
+			AdjustStock(gomock.Any(), sellerID, productID, uuid.NullUUID{}, 2, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ uuid.UUID, _ uuid.NullUUID, _ int, change models.StockChange) (*models.StockMovement, error) {
+				assert.Equal(t, models.StockReturn, change.Kind)
+				return &models.StockMovement{Kind: change.Kind}, nil
+			})
+
+		_, err := uc.AdjustStock(ctx, sellerID, productID, dto.StockAdjustmentRequest{
+			Delta:  2,
+			Kind:   "return",
+			Reason: "customer return",
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		uc, _, _ := newInventoryUsecase(t, 10)
+
+		for name, req := range map[string]dto.StockAdjustmentRequest{
+			"zero delta":      {Delta: 0, Reason: "count"},
+			"no reason":       {Delta: 1, Reason: "   "},
+			"sale kind":       {Delta: -1, Kind: "sale", Reason: "count"},
+			"negative return": {Delta: -1, Kind: "return", Reason: "count"},
+		} {
+			_, err := uc.AdjustStock(ctx, sellerID, productID, req)
+			assert.ErrorIs(t, err, errs.ErrBusinessLogic, name)
+		}
+	})
+}
+
+func TestInventoryUsecase_SetLowStockThreshold(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	sellerID := uuid.New()
+	productID := uuid.New()
+
+	t.Run("set", func(t *testing.T) {
+		uc, repo, _ := newInventoryUsecase(t, 10)
+		threshold := 5
+
+		repo.EXPECT().
+			SetLowStockThreshold(gomock.Any(), sellerID, productID, sql.NullInt64{Int64: 5, Valid: true}).
+			Return(nil)
+
+		require.NoError(t, uc.SetLowStockThreshold(ctx, sellerID, productID, &threshold))
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		uc, repo, _ := newInventoryUsecase(t, 10)
+
+		repo.EXPECT().
+			SetLowStockThreshold(gomock.Any(), sellerID, productID, sql.NullInt64{}).
+			Return(nil)
+
+		require.NoError(t, uc.SetLowStockThreshold(ctx, sellerID, productID, nil))
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		uc, _, _ := newInventoryUsecase(t, 10)
+		threshold := -1
+
+		err := uc.SetLowStockThreshold(ctx, sellerID, productID, &threshold)
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+	})
+}
+
+func TestInventoryUsecase_SendLowStockAlerts(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	sellerID := uuid.New()
+
+	t.Run("drains batches", func(t *testing.T) {
+		uc, repo, notifications := newInventoryUsecase(t, 2)
+
+		gomock.InOrder(
+			repo.EXPECT().ClaimLowStockAlerts(gomock.Any(), 2).Return([]models.LowStockAlert{
+				{ID: uuid.New(), SellerID: sellerID, ProductName: "Phone", SKU: "PH-BLK", Quantity: 1, Threshold: 2},
+				{ID: uuid.New(), SellerID: sellerID, ProductName: "Case", Quantity: 0, Threshold: 0},
+			}, nil),
+			repo.EXPECT().ClaimLowStockAlerts(gomock.Any(), 2).Return([]models.LowStockAlert{
+				{ID: uuid.New(), SellerID: sellerID, ProductName: "Cable", Quantity: 3, Threshold: 5},
+			}, nil),
+		)
+
+		var texts []string
+		notifications.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, n models.Notification) error {
+				assert.Equal(t, sellerID, n.UserID)
+				assert.Equal(t, "Товар заканчивается", n.Title)
+				texts = append(texts, n.Text)
+				return nil
+			}).Times(3)
+
+		sent, err := uc.SendLowStockAlerts(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 3, sent)
+		assert.Contains(t, texts[0], "«Phone» (артикул PH-BLK)")
+		assert.Contains(t, texts[0], "1 шт., порог — 2 шт.")
+		assert.NotContains(t, texts[1], "артикул")
+	})
+
+	t.Run("failed notification is skipped", func(t *testing.T) {
+		uc, repo, notifications := newInventoryUsecase(t, 10)
+
+		repo.EXPECT().ClaimLowStockAlerts(gomock.Any(), 10).Return([]models.LowStockAlert{
+			{ID: uuid.New(), SellerID: sellerID, ProductName: "Phone"},
+			{ID: uuid.New(), SellerID: sellerID, ProductName: "Case"},
+		}, nil)
+		gomock.InOrder(
+			notifications.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("db error")),
+			notifications.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil),
+		)
+
+		sent, err := uc.SendLowStockAlerts(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, sent)
+	})
+
+	t.Run("claim error", func(t *testing.T) {
+		uc, repo, _ := newInventoryUsecase(t, 10)
+
+		repo.EXPECT().ClaimLowStockAlerts(gomock.Any(), 10).Return(nil, errors.New("db error"))
+
+		_, err := uc.SendLowStockAlerts(ctx)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,189 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/mocks"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/invoice"
+	ucmocks "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/mocks"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/order"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/pricing"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestInvoice(t *testing.T) (*mocks.MockIOrderRepository, *ucmocks.MockIInvoiceRenderer, *order.OrderUsecase) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockIOrderRepository(ctrl)
+	mockRenderer := ucmocks.NewMockIInvoiceRenderer(ctrl)
+	engine := pricing.NewEngine(mockRepo, nil, &config.DeliveryConfig{})
+	return mockRepo, mockRenderer, order.NewOrderUsecase(mockRepo, engine, mocks.NewMockINotificationRepository(ctrl), mockRenderer, nil, nil, nil)
+}
+
+func testOrderDetail(orderID, userID, addressID uuid.UUID) *models.OrderDetail {
+	return &models.OrderDetail{
+		ID:                 orderID,
+		UserID:             userID,
+		AddressID:          addressID,
+		Status:             models.Placed,
+		TotalPrice:         models.Rubles(300),
+		TotalPriceDiscount: models.Rubles(240),
+		DeliveryCost:       models.Rubles(99),
+		PromoCode:          null.StringFrom("SALE10"),
+		PromoDiscount:      models.Rubles(25),
+		Shipments: []models.OrderShipment{{
+			ID:         uuid.New(),
+			OrderID:    orderID,
+			SellerName: "Shop",
+			Status:     models.AwaitingConfirmation,
+			Items: []models.ShipmentItem{
+				{ProductID: uuid.New(), ProductName: "Product", BasePrice: models.Rubles(150), Price: models.Kopecks(13250), Quantity: 2},
+			},
+		}},
+	}
+}
+
+func TestOrderUsecase_GetOrder(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	orderID := uuid.New()
+	userID := uuid.New()
+	addressID := uuid.New()
+
+	t.Run("success computes totals", func(t *testing.T) {
+		mockRepo, _, uc := setupTestInvoice(t)
+
+		mockRepo.EXPECT().GetOrderDetail(gomock.Any(), orderID).Return(testOrderDetail(orderID, userID, addressID), nil)
+		mockRepo.EXPECT().GetOrderAddress(gomock.Any(), addressID).
+			Return(&models.AddressDB{City: null.StringFrom("Москва")}, nil)
+
+		detail, err := uc.GetOrder(ContextWithUserID(ctx, userID), orderID)
+		require.NoError(t, err)
+		assert.Equal(t, models.Rubles(300), detail.Subtotal)
+		assert.Equal(t, models.Rubles(35), detail.ProductDiscount)
+		assert.Equal(t, models.Rubles(25), detail.PromoDiscount)
+		assert.Equal(t, models.Rubles(339), detail.Total)
+		assert.Equal(t, addressID, detail.Address.ID)
+		require.Len(t, detail.Shipments, 1)
+		assert.Equal(t, "Shop", detail.Shipments[0].SellerName)
+		assert.Equal(t, models.Rubles(35), detail.Shipments[0].Products[0].Discount())
+	})
+
+	t.Run("another user's order is not found", func(t *testing.T) {
+		mockRepo, _, uc := setupTestInvoice(t)
+
+		mockRepo.EXPECT().GetOrderDetail(gomock.Any(), orderID).Return(testOrderDetail(orderID, uuid.New(), addressID), nil)
+
+		_, err := uc.GetOrder(ContextWithUserID(ctx, userID), orderID)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		mockRepo, _, uc := setupTestInvoice(t)
+
+		mockRepo.EXPECT().GetOrderDetail(gomock.Any(), orderID).Return(nil, errors.New("db error"))
+
+		_, err := uc.GetOrder(ContextWithUserID(ctx, userID), orderID)
+		assert.Error(t, err)
+	})
+}
+
+func TestOrderUsecase_GetInvoice(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	orderID := uuid.New()
+	ownerID := uuid.New()
+	addressID := uuid.New()
+
+	for _, role := range []string{"admin", "warehouseman"} {
+		t.Run(role+" gets any invoice", func(t *testing.T) {
+			mockRepo, mockRenderer, uc := setupTestInvoice(t)
+
+			mockRepo.EXPECT().GetOrderDetail(gomock.Any(), orderID).Return(testOrderDetail(orderID, ownerID, addressID), nil)
+			mockRepo.EXPECT().GetOrderAddress(gomock.Any(), addressID).Return(&models.AddressDB{}, nil)
+			mockRenderer.EXPECT().Render(gomock.Any()).Return([]byte("%PDF-1.3"), nil)
+
+			roleCtx := context.WithValue(ContextWithUserID(ctx, uuid.New()), domains.RoleKey{}, role)
+			pdf, err := uc.GetInvoice(roleCtx, orderID)
+			require.NoError(t, err)
+			assert.Equal(t, []byte("%PDF-1.3"), pdf)
+		})
+	}
+
+	t.Run("seller cannot get another user's invoice", func(t *testing.T) {
+		mockRepo, _, uc := setupTestInvoice(t)
+
+		mockRepo.EXPECT().GetOrderDetail(gomock.Any(), orderID).Return(testOrderDetail(orderID, ownerID, addressID), nil)
+
+		roleCtx := context.WithValue(ContextWithUserID(ctx, uuid.New()), domains.RoleKey{}, "seller")
+		_, err := uc.GetInvoice(roleCtx, orderID)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+
+	t.Run("render error", func(t *testing.T) {
+		mockRepo, mockRenderer, uc := setupTestInvoice(t)
+
+		mockRepo.EXPECT().GetOrderDetail(gomock.Any(), orderID).Return(testOrderDetail(orderID, ownerID, addressID), nil)
+		mockRepo.EXPECT().GetOrderAddress(gomock.Any(), addressID).Return(nil, errs.NewNotFoundError("address not found"))
+		mockRenderer.EXPECT().Render(gomock.Any()).Return(nil, errors.New("render error"))
+
+		_, err := uc.GetInvoice(ContextWithUserID(ctx, ownerID), orderID)
+		assert.Error(t, err)
+	})
+}
+
+func TestInvoiceGenerator_Render(t *testing.T) {
+	conf := &config.InvoiceConfig{
+		FontPath:     "/usr/share/fonts/truetype/dejavu/DejaVuSans.ttf",
+		BoldFontPath: "/usr/share/fonts/truetype/dejavu/DejaVuSans-Bold.ttf",
+	}
+	for _, path := range []string{conf.FontPath, conf.BoldFontPath} {
+		if _, err := os.Stat(path); err != nil {
+			t.Skipf("font %s is not installed", path)
+		}
+	}
+
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	generator := invoice.NewGenerator(conf)
+
+	pdf, err := generator.Render(dto.OrderDetailDTO{
+		ID:        uuid.New(),
+		Status:    models.Placed,
+		Address:   models.AddressDB{AddressString: null.StringFrom("Москва, ул. Ленина, 1")},
+		CreatedAt: &createdAt,
+		Shipments: []dto.ShipmentPreviewDTO{{
+			SellerName: "Магазин",
+			Products: []dto.ShipmentItemDTO{
+				{ProductName: "Очень длинное название товара, которое не помещается в колонку таблицы", BasePrice: models.Rubles(150), Price: models.Kopecks(13250), Quantity: 2},
+			},
+			TotalDiscountPrice: models.Rubles(265),
+		}},
+		Subtotal:        models.Rubles(300),
+		ProductDiscount: models.Rubles(35),
+		PromoCode:       null.StringFrom("SALE10"),
+		PromoDiscount:   models.Rubles(25),
+		DeliveryCost:    models.Rubles(99),
+		Total:           models.Rubles(339),
+	})
+	require.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(pdf, []byte("%PDF")))
+
+	t.Run("missing font", func(t *testing.T) {
+		generator := invoice.NewGenerator(&config.InvoiceConfig{FontPath: "/nonexistent.ttf"})
+		_, err := generator.Render(dto.OrderDetailDTO{ID: uuid.New()})
+		assert.Error(t, err)
+	})
+}
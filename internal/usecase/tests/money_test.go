@@ -0,0 +1,127 @@
+package tests
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/mailru/easyjson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoney(t *testing.T) {
+	t.Run("parse and format", func(t *testing.T) {
+		for raw, expected := range map[string]string{
+			"1500":    "1500.00",
+			"1500.5":  "1500.50",
+			"0.99":    "0.99",
+			"-12.03":  "-12.03",
+			" 7.10 ":  "7.10",
+			"+100.01": "100.01",
+		} {
+			m, err := models.ParseMoney(raw)
+			require.NoError(t, err, raw)
+			assert.Equal(t, expected, m.String(), raw)
+		}
+	})
+
+	t.Run("rejects inexact amounts", func(t *testing.T) {
+		for _, raw := range []string{"", "1.005", "abc", ".5", "1.-5", "--1"} {
+			_, err := models.ParseMoney(raw)
+			assert.ErrorIs(t, err, models.ErrInvalidMoney, raw)
+		}
+	})
+
+	t.Run("share rounds half away from zero", func(t *testing.T) {
+		assert.Equal(t, models.Kopecks(150), models.Kopecks(1500).Share(1000, 10000))
+		// 0.05 * 10% = 0.005 → 0.01
+		assert.Equal(t, models.Kopecks(1), models.Kopecks(5).Percent(10))
+		assert.Equal(t, models.Kopecks(-1), models.Kopecks(-5).Percent(10))
+		assert.Equal(t, models.Kopecks(0), models.Kopecks(4).Percent(10))
+	})
+
+	t.Run("sums without float drift", func(t *testing.T) {
+		var total models.Money
+		for i := 0; i < 10; i++ {
+			total = total.Add(models.Kopecks(10))
+		}
+		assert.Equal(t, "1.00", total.String())
+		assert.Equal(t, "29.97", models.Kopecks(999).Mul(3).String())
+	})
+
+	t.Run("currencies do not mix", func(t *testing.T) {
+		usd := models.NewMoney(100, "USD")
+		assert.Equal(t, models.Rubles(1), models.NewMoney(100, models.RUB))
+		assert.Panics(t, func() { models.Rubles(1).Add(usd) })
+		_, err := usd.Value()
+		assert.ErrorIs(t, err, models.ErrCurrencyMismatch)
+	})
+
+	t.Run("property: sums are exact", func(t *testing.T) {
+		rnd := rand.New(rand.NewSource(49))
+		for i := 0; i < 1000; i++ {
+			a := models.Kopecks(rnd.Int63n(10_000_000) - 5_000_000)
+			b := models.Kopecks(rnd.Int63n(10_000_000) - 5_000_000)
+			n := rnd.Int63n(100)
+
+			assert.Equal(t, a.Minor()+b.Minor(), a.Add(b).Minor())
+			assert.Equal(t, a, a.Add(b).Sub(b))
+			assert.Equal(t, a.Add(b).Mul(n), a.Mul(n).Add(b.Mul(n)))
+
+			parsed, err := models.ParseMoney(a.String())
+			require.NoError(t, err)
+			assert.Equal(t, a, parsed)
+		}
+	})
+
+	t.Run("property: percent stays within bounds", func(t *testing.T) {
+		rnd := rand.New(rand.NewSource(49))
+		for i := 0; i < 1000; i++ {
+			m := models.Kopecks(rnd.Int63n(10_000_000))
+			p := rnd.Intn(101)
+			share := m.Percent(p)
+
+			assert.False(t, share.IsNegative())
+			assert.False(t, share.GreaterThan(m))
+			// Ошибка округления не превышает половины копейки
+			diff := share.Minor()*100 - m.Minor()*int64(p)
+			assert.LessOrEqual(t, diff, int64(50))
+			assert.GreaterOrEqual(t, diff, int64(-50))
+		}
+	})
+
+	t.Run("scan numeric", func(t *testing.T) {
+		var m models.Money
+		require.NoError(t, m.Scan([]byte("1500.5000")))
+		assert.Equal(t, models.Kopecks(150050), m)
+		require.NoError(t, m.Scan("0.00"))
+		assert.Equal(t, models.Money{}, m)
+		require.NoError(t, m.Scan(int64(3)))
+		assert.Equal(t, models.Rubles(3), m)
+		assert.Error(t, m.Scan(1.5))
+
+		value, err := models.Kopecks(150050).Value()
+		require.NoError(t, err)
+		assert.Equal(t, "1500.50", value)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		balance := dto.SellerBalanceDTO{Held: models.Kopecks(150050), PaidOut: models.Kopecks(1)}
+
+		data, err := easyjson.Marshal(balance)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"held":1500.50,"available":0.00,"pending":0.00,"paid_out":0.01}`, string(data))
+
+		var decoded dto.SellerBalanceDTO
+		require.NoError(t, easyjson.Unmarshal([]byte(`{"held":"1500.5","paid_out":0.01}`), &decoded))
+		assert.Equal(t, balance, decoded)
+
+		var std models.Money
+		require.NoError(t, json.Unmarshal([]byte(`12.3`), &std))
+		assert.Equal(t, models.Kopecks(1230), std)
+		assert.Error(t, easyjson.Unmarshal([]byte(`{"held":1.001}`), &decoded))
+	})
+}
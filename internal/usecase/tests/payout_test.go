@@ -0,0 +1,155 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/mocks"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	ucmocks "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/mocks"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/order"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/payout"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/pricing"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testPayoutConfig = &config.PayoutConfig{
+	ReturnWindow:         14 * 24 * time.Hour,
+	StatementPeriod:      7 * 24 * time.Hour,
+	DefaultCommissionBps: 1000,
+}
+
+func setupTestPayout(t *testing.T) (*mocks.MockIPayoutRepository, *payout.PayoutUsecase) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockIPayoutRepository(ctrl)
+	return mockRepo, payout.NewPayoutUsecase(mockRepo, testPayoutConfig)
+}
+
+func TestPayoutUsecase_RecordEarnings(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	orderID := uuid.New()
+
+	mockRepo, uc := setupTestPayout(t)
+	mockRepo.EXPECT().RecordEarnings(gomock.Any(), uuid.NullUUID{UUID: orderID, Valid: true}, 1000, 14*24*time.Hour).
+		Return(int64(2), nil)
+
+	require.NoError(t, uc.RecordEarnings(ctx, orderID))
+}
+
+func TestPayoutUsecase_SetCategoryCommission(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	categoryID := uuid.New()
+	bps := func(v int) *int { return &v }
+
+	t.Run("set", func(t *testing.T) {
+		mockRepo, uc := setupTestPayout(t)
+		mockRepo.EXPECT().SetCategoryCommission(gomock.Any(), categoryID, sql.NullInt32{Int32: 1500, Valid: true}).Return(nil)
+
+		require.NoError(t, uc.SetCategoryCommission(ctx, categoryID, dto.SetCategoryCommissionRequest{CommissionBps: bps(1500)}))
+	})
+
+	t.Run("inherit from parent", func(t *testing.T) {
+		mockRepo, uc := setupTestPayout(t)
+		mockRepo.EXPECT().SetCategoryCommission(gomock.Any(), categoryID, sql.NullInt32{}).Return(nil)
+
+		require.NoError(t, uc.SetCategoryCommission(ctx, categoryID, dto.SetCategoryCommissionRequest{}))
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		for _, value := range []int{-1, 10001} {
+			_, uc := setupTestPayout(t)
+
+			err := uc.SetCategoryCommission(ctx, categoryID, dto.SetCategoryCommissionRequest{CommissionBps: bps(value)})
+			assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+		}
+	})
+}
+
+func TestPayoutUsecase_GetStatement(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	sellerID := uuid.New()
+	statementID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo, uc := setupTestPayout(t)
+		mockRepo.EXPECT().GetStatement(gomock.Any(), statementID).
+			Return(models.PayoutStatement{ID: statementID, SellerID: sellerID, Net: models.Rubles(900)}, nil)
+		mockRepo.EXPECT().GetStatementEarnings(gomock.Any(), statementID).
+			Return([]models.SellerEarning{{ID: uuid.New(), Gross: models.Rubles(1000), Commission: models.Rubles(100), Net: models.Rubles(900)}}, nil)
+
+		detail, err := uc.GetStatement(ctx, sellerID, statementID)
+		require.NoError(t, err)
+		assert.Equal(t, models.Rubles(900), detail.Statement.Net)
+		assert.Len(t, detail.Earnings, 1)
+	})
+
+	t.Run("another seller", func(t *testing.T) {
+		mockRepo, uc := setupTestPayout(t)
+		mockRepo.EXPECT().GetStatement(gomock.Any(), statementID).
+			Return(models.PayoutStatement{ID: statementID, SellerID: uuid.New()}, nil)
+
+		_, err := uc.GetStatement(ctx, sellerID, statementID)
+		assert.ErrorIs(t, err, errs.ErrForbidden)
+	})
+}
+
+func TestOrderUsecase_UpdateStatus_RecordsEarnings(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	orderID := uuid.New()
+
+	setup := func(t *testing.T) (*mocks.MockIOrderRepository, *ucmocks.MockIEarningsRecorder, *order.OrderUsecase) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockIOrderRepository(ctrl)
+		mockNotificationRepo := mocks.NewMockINotificationRepository(ctrl)
+		mockNotificationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		mockRecorder := ucmocks.NewMockIEarningsRecorder(ctrl)
+		engine := pricing.NewEngine(mockRepo, nil, &config.DeliveryConfig{})
+
+		mockRepo.EXPECT().GetUserIDByOrderID(gomock.Any(), orderID).Return(uuid.New(), nil).AnyTimes()
+		return mockRepo, mockRecorder, order.NewOrderUsecase(mockRepo, engine, mockNotificationRepo, nil, nil,
+			anyWarehouseRouter(ctrl), mockRecorder)
+	}
+
+	t.Run("delivered", func(t *testing.T) {
+		mockRepo, mockRecorder, uc := setup(t)
+		mockRepo.EXPECT().UpdateStatus(gomock.Any(), orderID, gomock.Any(), models.Delivered).Return(nil)
+		mockRecorder.EXPECT().RecordEarnings(gomock.Any(), orderID).Return(nil)
+
+		require.NoError(t, uc.UpdateStatus(ctx, dto.UpdateOrderStatusRequest{OrderID: orderID, Status: "delivered"}))
+	})
+
+	t.Run("recording failure does not fail delivery", func(t *testing.T) {
+		mockRepo, mockRecorder, uc := setup(t)
+		mockRepo.EXPECT().UpdateStatus(gomock.Any(), orderID, gomock.Any(), models.Delivered).Return(nil)
+		mockRecorder.EXPECT().RecordEarnings(gomock.Any(), orderID).Return(errors.New("db is down"))
+
+		require.NoError(t, uc.UpdateStatus(ctx, dto.UpdateOrderStatusRequest{OrderID: orderID, Status: "delivered"}))
+	})
+
+	t.Run("canceled order is not paid out", func(t *testing.T) {
+		mockRepo, _, uc := setup(t)
+		mockRepo.EXPECT().UpdateStatus(gomock.Any(), orderID, gomock.Any(), models.Delivered).
+			Return(errs.NewBusinessLogicError("order cannot be moved to delivered: it is not in_transit"))
+
+		err := uc.UpdateStatus(ctx, dto.UpdateOrderStatusRequest{OrderID: orderID, Status: "delivered"})
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+	})
+
+	t.Run("in transit", func(t *testing.T) {
+		mockRepo, _, uc := setup(t)
+		mockRepo.EXPECT().UpdateStatus(gomock.Any(), orderID, gomock.Any(), models.InTransit).Return(nil)
+
+		require.NoError(t, uc.UpdateStatus(ctx, dto.UpdateOrderStatusRequest{OrderID: orderID}))
+	})
+}
@@ -0,0 +1,240 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/mocks"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/pickup"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestPickup(t *testing.T) (*mocks.MockIPickupRepository, *pickup.PickupUsecase) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockIPickupRepository(ctrl)
+	return mockRepo, pickup.NewPickupUsecase(mockRepo)
+}
+
+func pickupPointAt(name, coordinate string) models.PickupPoint {
+	return models.PickupPoint{
+		ID:      uuid.New(),
+		Name:    name,
+		Address: models.AddressDB{ID: uuid.New(), Coordinate: null.StringFrom(coordinate)},
+	}
+}
+
+func TestCoordinate_DistanceKm(t *testing.T) {
+	moscow, err := models.ParseCoordinate("55.7558, 37.6176")
+	require.NoError(t, err)
+	petersburg, err := models.ParseCoordinate("59.9311,30.3609")
+	require.NoError(t, err)
+
+	assert.InDelta(t, 634, moscow.DistanceKm(petersburg), 5)
+	assert.InDelta(t, moscow.DistanceKm(petersburg), petersburg.DistanceKm(moscow), 1e-9)
+	assert.Zero(t, moscow.DistanceKm(moscow))
+
+	for _, raw := range []string{"", "55.7558", "abc,37.6", "91,0", "0,181"} {
+		_, err = models.ParseCoordinate(raw)
+		assert.ErrorIs(t, err, models.ErrInvalidCoordinate, raw)
+	}
+}
+
+func TestPickupUsecase_GetNearest(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	from := models.Coordinate{Lat: 55.75, Lon: 37.62}
+
+	t.Run("sorted by distance and limited", func(t *testing.T) {
+		mockRepo, uc := setupTestPickup(t)
+
+		mockRepo.EXPECT().GetPickupPoints(gomock.Any()).Return([]models.PickupPoint{
+			pickupPointAt("Казань", "55.7963,49.1088"),
+			pickupPointAt("Без координаты", ""),
+			pickupPointAt("Москва", "55.7558,37.6176"),
+			pickupPointAt("Санкт-Петербург", "59.9311,30.3609"),
+		}, nil)
+
+		res, err := uc.GetNearest(ctx, from, 2)
+		require.NoError(t, err)
+		require.Len(t, res.PickupPoints, 2)
+		assert.Equal(t, "Москва", res.PickupPoints[0].Name)
+		assert.Equal(t, "Санкт-Петербург", res.PickupPoints[1].Name)
+		require.NotNil(t, res.PickupPoints[0].DistanceKm)
+		assert.Less(t, *res.PickupPoints[0].DistanceKm, 1.0)
+	})
+
+	t.Run("invalid coordinate", func(t *testing.T) {
+		_, uc := setupTestPickup(t)
+
+		_, err := uc.GetNearest(ctx, models.Coordinate{Lat: 100}, 0)
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		mockRepo, uc := setupTestPickup(t)
+
+		mockRepo.EXPECT().GetPickupPoints(gomock.Any()).Return(nil, errors.New("db error"))
+
+		_, err := uc.GetNearest(ctx, from, 0)
+		assert.Error(t, err)
+	})
+}
+
+func TestPickupUsecase_Favorites(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	userID, pointID := uuid.New(), uuid.New()
+
+	t.Run("add", func(t *testing.T) {
+		mockRepo, uc := setupTestPickup(t)
+
+		mockRepo.EXPECT().AddFavoritePickupPoint(gomock.Any(), userID, pointID).Return(nil)
+
+		assert.NoError(t, uc.AddFavorite(ContextWithUserID(ctx, userID), pointID))
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		_, uc := setupTestPickup(t)
+
+		err := uc.AddFavorite(ctx, pointID)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		mockRepo, uc := setupTestPickup(t)
+
+		mockRepo.EXPECT().GetFavoritePickupPoints(gomock.Any(), userID).
+			Return([]models.PickupPoint{pickupPointAt("Москва", "55.7558,37.6176")}, nil)
+
+		res, err := uc.GetFavorites(ContextWithUserID(ctx, userID))
+		require.NoError(t, err)
+		require.Len(t, res.PickupPoints, 1)
+		assert.Nil(t, res.PickupPoints[0].DistanceKm)
+	})
+}
+
+func TestPickupUsecase_CreatePickupPoint(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	valid := dto.CreatePickupPointRequest{
+		Name:          " ПВЗ на Ленина ",
+		City:          null.StringFrom("Москва"),
+		AddressString: "ул. Ленина, д. 1",
+		Coordinate:    "55.7558, 37.6176",
+		OpensAt:       "09:00",
+		ClosesAt:      "21:00",
+	}
+
+	t.Run("success normalizes coordinate", func(t *testing.T) {
+		mockRepo, uc := setupTestPickup(t)
+
+		mockRepo.EXPECT().CreatePickupPoint(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, point models.PickupPoint) (models.PickupPoint, error) {
+				assert.Equal(t, "ПВЗ на Ленина", point.Name)
+				assert.Equal(t, "55.7558,37.6176", point.Address.Coordinate.String)
+				return point, nil
+			})
+
+		res, err := uc.CreatePickupPoint(ctx, valid)
+		require.NoError(t, err)
+		assert.Equal(t, "09:00", res.OpensAt)
+	})
+
+	invalid := map[string]func(req *dto.CreatePickupPointRequest){
+		"empty name":         func(req *dto.CreatePickupPointRequest) { req.Name = "" },
+		"empty address":      func(req *dto.CreatePickupPointRequest) { req.AddressString = " " },
+		"invalid coordinate": func(req *dto.CreatePickupPointRequest) { req.Coordinate = "Москва" },
+		"invalid hours":      func(req *dto.CreatePickupPointRequest) { req.OpensAt = "9 утра" },
+		"closes before opens": func(req *dto.CreatePickupPointRequest) {
+			req.OpensAt, req.ClosesAt = "21:00", "09:00"
+		},
+	}
+	for name, mutate := range invalid {
+		mutate := mutate
+		t.Run(name, func(t *testing.T) {
+			_, uc := setupTestPickup(t)
+
+			req := valid
+			mutate(&req)
+			_, err := uc.CreatePickupPoint(ctx, req)
+			assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+		})
+	}
+}
+
+func TestPickupUsecase_UpdatePickupPoint(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	pointID := uuid.New()
+	req := dto.UpdatePickupPointRequest{Name: "ПВЗ", OpensAt: "10:00", ClosesAt: "20:00"}
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo, uc := setupTestPickup(t)
+
+		updated := pickupPointAt("ПВЗ", "55.7558,37.6176")
+		updated.ID = pointID
+		mockRepo.EXPECT().UpdatePickupPoint(gomock.Any(), models.PickupPoint{ID: pointID, Name: "ПВЗ", OpensAt: "10:00", ClosesAt: "20:00"}).Return(nil)
+		mockRepo.EXPECT().GetPickupPoint(gomock.Any(), pointID).Return(&updated, nil)
+
+		res, err := uc.UpdatePickupPoint(ctx, pointID, req)
+		require.NoError(t, err)
+		assert.Equal(t, pointID, res.ID)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepo, uc := setupTestPickup(t)
+
+		mockRepo.EXPECT().UpdatePickupPoint(gomock.Any(), gomock.Any()).Return(errs.NewNotFoundError("pickup point not found"))
+
+		_, err := uc.UpdatePickupPoint(ctx, pointID, req)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+}
+
+func TestOrderUsecase_CreateOrderPickupPoint(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	productID := uuid.New()
+	pointID := uuid.New()
+	pointAddressID := uuid.New()
+	items := []dto.CreateOrderItemDTO{{ProductID: productID, Quantity: 1}}
+
+	t.Run("order is delivered to the pickup point address", func(t *testing.T) {
+		mockRepo, mockNotificationRepo, mockPlanner, uc := setupTestOrderDelivery(t)
+
+		mockRepo.EXPECT().ProductPrice(gomock.Any(), productID).
+			Return(&models.Product{Status: models.ProductApproved, Quantity: 10, Price: models.Rubles(100)}, nil)
+		mockRepo.EXPECT().ProductDiscounts(gomock.Any(), productID, uuid.NullUUID{}).Return(nil, errs.NewNotFoundError("no discounts"))
+		mockRepo.EXPECT().GetPickupPointAddressID(gomock.Any(), pointID).Return(pointAddressID, nil)
+		mockPlanner.EXPECT().Plan(gomock.Any(), pointAddressID, nil).
+			Return(models.DeliveryPlan{ExpectedDeliveryAt: time.Now()}, nil)
+		mockRepo.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req dto.CreateOrderRepoReq) error {
+				assert.Equal(t, pointAddressID, req.Order.AddressID)
+				assert.Equal(t, uuid.NullUUID{UUID: pointID, Valid: true}, req.Order.PickupPointID)
+				return nil
+			})
+		mockNotificationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+		err := uc.CreateOrder(ctx, dto.CreateOrderDTO{UserID: uuid.New(), PickupPointID: &pointID, Items: items})
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown pickup point", func(t *testing.T) {
+		mockRepo, _, _, uc := setupTestOrderDelivery(t)
+
+		mockRepo.EXPECT().ProductPrice(gomock.Any(), productID).
+			Return(&models.Product{Status: models.ProductApproved, Quantity: 10, Price: models.Rubles(100)}, nil)
+		mockRepo.EXPECT().ProductDiscounts(gomock.Any(), productID, uuid.NullUUID{}).Return(nil, errs.NewNotFoundError("no discounts"))
+		mockRepo.EXPECT().GetPickupPointAddressID(gomock.Any(), pointID).Return(uuid.Nil, errs.NewNotFoundError("pickup point not found"))
+
+		err := uc.CreateOrder(ctx, dto.CreateOrderDTO{UserID: uuid.New(), PickupPointID: &pointID, Items: items})
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+}
@@ -0,0 +1,233 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/mocks"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/pricing"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/promo"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestPricing(t *testing.T, delivery *config.DeliveryConfig) (*mocks.MockIPricingRepository, *mocks.MockIPromoRepository, *pricing.Engine) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockIPricingRepository(ctrl)
+	mockPromoRepo := mocks.NewMockIPromoRepository(ctrl)
+	return mockRepo, mockPromoRepo, pricing.NewEngine(mockRepo, mockPromoRepo, delivery)
+}
+
+func TestPricingEngine_Price(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	now := time.Now()
+	delivery := &config.DeliveryConfig{Cost: models.Rubles(300), FreeThreshold: models.Rubles(5000)}
+
+	t.Run("active discount and paid delivery", func(t *testing.T) {
+		mockRepo, _, engine := setupTestPricing(t, delivery)
+		productID := uuid.New()
+
+		mockRepo.EXPECT().ProductPrice(gomock.Any(), productID).
+			Return(&models.Product{ID: productID, Status: models.ProductApproved, Quantity: 10, Price: models.Rubles(1000)}, nil)
+		mockRepo.EXPECT().ProductDiscounts(gomock.Any(), productID, uuid.NullUUID{}).
+			Return([]models.ProductDiscount{
+				{DiscountedPrice: models.Rubles(600), DiscountStartDate: now.Add(-48 * time.Hour), DiscountEndDate: now.Add(-24 * time.Hour)},
+				{DiscountedPrice: models.Rubles(800), DiscountStartDate: now.Add(-time.Hour), DiscountEndDate: now.Add(time.Hour)},
+				{DiscountedPrice: models.Rubles(500), DiscountStartDate: now.Add(time.Hour), DiscountEndDate: now.Add(48 * time.Hour)},
+			}, nil)
+
+		quote, err := engine.Price(ctx, []models.PricingItem{{ProductID: productID, Quantity: 2}})
+		require.NoError(t, err)
+		require.Len(t, quote.Lines, 1)
+
+		line := quote.Lines[0]
+		assert.Equal(t, models.Rubles(800), line.FinalUnitPrice)
+		assert.Equal(t, models.Rubles(1600), line.LineTotal)
+		assert.Equal(t, models.Rubles(400), line.LineDiscount)
+		assert.True(t, line.Available())
+
+		assert.Equal(t, models.Rubles(2000), quote.Subtotal)
+		assert.Equal(t, models.Rubles(400), quote.ProductDiscount)
+		assert.Equal(t, models.Rubles(300), quote.DeliveryCost)
+		assert.Equal(t, models.Rubles(1900), quote.Total)
+	})
+
+	t.Run("free delivery over threshold", func(t *testing.T) {
+		mockRepo, _, engine := setupTestPricing(t, delivery)
+		productID := uuid.New()
+
+		mockRepo.EXPECT().ProductPrice(gomock.Any(), productID).
+			Return(&models.Product{ID: productID, Status: models.ProductApproved, Quantity: 1, Price: models.Rubles(2500)}, nil)
+		mockRepo.EXPECT().ProductDiscounts(gomock.Any(), productID, uuid.NullUUID{}).
+			Return(nil, errs.ErrNotFound)
+
+		quote, err := engine.Price(ctx, []models.PricingItem{{ProductID: productID, Quantity: 2}})
+		require.NoError(t, err)
+
+		assert.Equal(t, models.Money{}, quote.DeliveryCost)
+		assert.Equal(t, models.Rubles(5000), quote.Total)
+		assert.False(t, quote.Lines[0].Available())
+	})
+
+	t.Run("empty basket", func(t *testing.T) {
+		_, _, engine := setupTestPricing(t, delivery)
+
+		quote, err := engine.Price(ctx, nil)
+		require.NoError(t, err)
+		assert.Equal(t, models.Money{}, quote.DeliveryCost)
+		assert.Equal(t, models.Money{}, quote.Total)
+	})
+
+	t.Run("variant price and stock", func(t *testing.T) {
+		mockRepo, _, engine := setupTestPricing(t, delivery)
+		productID := uuid.New()
+		variantID := uuid.NullUUID{UUID: uuid.New(), Valid: true}
+
+		mockRepo.EXPECT().ProductPrice(gomock.Any(), productID).
+			Return(&models.Product{ID: productID, Status: models.ProductApproved, Quantity: 10, Price: models.Rubles(1000), HasVariants: true}, nil)
+		mockRepo.EXPECT().VariantPrice(gomock.Any(), productID, variantID.UUID).
+			Return(&models.ProductVariant{ID: variantID.UUID, ProductID: productID, Price: models.Rubles(1500), Quantity: 1}, nil)
+		mockRepo.EXPECT().ProductDiscounts(gomock.Any(), productID, variantID).
+			Return(nil, errs.ErrNotFound)
+
+		quote, err := engine.Price(ctx, []models.PricingItem{{ProductID: productID, VariantID: variantID, Quantity: 2}})
+		require.NoError(t, err)
+		require.Len(t, quote.Lines, 1)
+
+		line := quote.Lines[0]
+		assert.Equal(t, variantID, line.VariantID)
+		assert.Equal(t, models.Rubles(1500), line.FinalUnitPrice)
+		assert.False(t, line.Available())
+	})
+
+	t.Run("variant required", func(t *testing.T) {
+		mockRepo, _, engine := setupTestPricing(t, delivery)
+		productID := uuid.New()
+
+		mockRepo.EXPECT().ProductPrice(gomock.Any(), productID).
+			Return(&models.Product{ID: productID, Status: models.ProductApproved, Quantity: 10, Price: models.Rubles(1000), HasVariants: true}, nil)
+		mockRepo.EXPECT().ProductDiscounts(gomock.Any(), productID, uuid.NullUUID{}).
+			Return(nil, nil).AnyTimes()
+
+		_, err := engine.Price(ctx, []models.PricingItem{{ProductID: productID, Quantity: 1}})
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		mockRepo, _, engine := setupTestPricing(t, delivery)
+		productID := uuid.New()
+
+		mockRepo.EXPECT().ProductPrice(gomock.Any(), productID).
+			Return(nil, errors.New("db error"))
+		mockRepo.EXPECT().ProductDiscounts(gomock.Any(), productID, uuid.NullUUID{}).
+			Return(nil, nil).AnyTimes()
+
+		_, err := engine.Price(ctx, []models.PricingItem{{ProductID: productID, Quantity: 1}})
+		assert.Error(t, err)
+	})
+}
+
+func TestPricingEngine_ApplyPromo(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	now := time.Now()
+	userID := uuid.New()
+	productID := uuid.New()
+	delivery := &config.DeliveryConfig{Cost: models.Rubles(300), FreeThreshold: models.Rubles(1800)}
+
+	newQuote := func(t *testing.T, engine *pricing.Engine, mockRepo *mocks.MockIPricingRepository) *models.Quote {
+		mockRepo.EXPECT().ProductPrice(gomock.Any(), productID).
+			Return(&models.Product{ID: productID, Status: models.ProductApproved, Quantity: 10, Price: models.Rubles(1000)}, nil)
+		mockRepo.EXPECT().ProductDiscounts(gomock.Any(), productID, uuid.NullUUID{}).
+			Return(nil, errs.ErrNotFound)
+
+		quote, err := engine.Price(ctx, []models.PricingItem{{ProductID: productID, Quantity: 2}})
+		require.NoError(t, err)
+		return quote
+	}
+
+	t.Run("applied", func(t *testing.T) {
+		mockRepo, mockPromoRepo, engine := setupTestPricing(t, delivery)
+		quote := newQuote(t, engine, mockRepo)
+		assert.Equal(t, models.Money{}, quote.DeliveryCost)
+
+		promoCode := &models.PromoCode{
+			ID:           uuid.New(),
+			Code:         "SALE",
+			Percent:      20,
+			DiscountType: models.PromoDiscountPercent,
+			StartDate:    now.Add(-time.Hour),
+			EndDate:      now.Add(time.Hour),
+		}
+		mockPromoRepo.EXPECT().CheckPromoCode(gomock.Any(), "SALE").Return(promoCode, nil)
+		mockPromoRepo.EXPECT().GetPromoUsage(gomock.Any(), promoCode.ID, userID).Return(models.PromoUsage{}, nil)
+
+		err := engine.ApplyPromo(ctx, userID, "SALE", quote)
+		require.NoError(t, err)
+
+		require.NotNil(t, quote.Promo)
+		assert.Equal(t, promoCode.ID, quote.Promo.PromoID)
+		assert.Equal(t, models.Rubles(400), quote.PromoDiscount)
+		// после промокода сумма товаров ниже порога бесплатной доставки
+		assert.Equal(t, models.Rubles(300), quote.DeliveryCost)
+		assert.Equal(t, models.Rubles(1900), quote.Total)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepo, mockPromoRepo, engine := setupTestPricing(t, delivery)
+		quote := newQuote(t, engine, mockRepo)
+
+		mockPromoRepo.EXPECT().CheckPromoCode(gomock.Any(), "NOPE").Return(nil, errs.ErrNotFound)
+
+		err := engine.ApplyPromo(ctx, userID, "NOPE", quote)
+		var rejected *promo.RejectedError
+		require.ErrorAs(t, err, &rejected)
+		assert.Equal(t, promo.ReasonNotFound, rejected.Reason)
+		assert.Nil(t, quote.Promo)
+		assert.Equal(t, models.Rubles(2000), quote.Total)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		mockRepo, mockPromoRepo, engine := setupTestPricing(t, delivery)
+		quote := newQuote(t, engine, mockRepo)
+
+		promoCode := &models.PromoCode{
+			ID:           uuid.New(),
+			Code:         "OLD",
+			Percent:      10,
+			DiscountType: models.PromoDiscountPercent,
+			StartDate:    now.Add(-48 * time.Hour),
+			EndDate:      now.Add(-24 * time.Hour),
+		}
+		mockPromoRepo.EXPECT().CheckPromoCode(gomock.Any(), "OLD").Return(promoCode, nil)
+		mockPromoRepo.EXPECT().GetPromoUsage(gomock.Any(), promoCode.ID, userID).Return(models.PromoUsage{}, nil)
+
+		err := engine.ApplyPromo(ctx, userID, "OLD", quote)
+		var rejected *promo.RejectedError
+		require.ErrorAs(t, err, &rejected)
+		assert.Equal(t, promo.ReasonExpired, rejected.Reason)
+		assert.Nil(t, quote.Promo)
+	})
+}
+
+func TestActiveDiscount(t *testing.T) {
+	now := time.Now()
+
+	_, ok := pricing.ActiveDiscount(nil, now)
+	assert.False(t, ok)
+
+	discount, ok := pricing.ActiveDiscount([]models.ProductDiscount{
+		{DiscountedPrice: models.Rubles(900), DiscountStartDate: now.Add(-48 * time.Hour), DiscountEndDate: now.Add(time.Hour)},
+		{DiscountedPrice: models.Rubles(700), DiscountStartDate: now.Add(-time.Hour), DiscountEndDate: now.Add(time.Hour)},
+	}, now)
+	assert.True(t, ok)
+	assert.Equal(t, models.Rubles(700), discount.DiscountedPrice)
+}
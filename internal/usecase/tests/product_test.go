@@ -99,6 +99,9 @@ func TestProductUsecase_GetProductByID(t *testing.T) {
 				mockRepo.EXPECT().
 					GetProductByID(gomock.Any(), gomock.Any()).
 					Return(expectedProduct, nil)
+				mockRepo.EXPECT().
+					GetProductVariants(gomock.Any(), gomock.Any()).
+					Return([]models.ProductVariant{}, nil)
 			},
 			expected: &models.Product{
 				ID:   uuid.New(),
@@ -134,6 +137,48 @@ func TestProductUsecase_GetProductByID(t *testing.T) {
 	}
 }
 
+func TestProductUsecase_GetProductByID_Variants(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIProductRepository(ctrl)
+	uc := product.NewProductUsecase(mockRepo)
+	productID := uuid.New()
+
+	mockRepo.EXPECT().
+		GetProductByID(gomock.Any(), productID).
+		Return(&models.Product{ID: productID, Name: "Футболка"}, nil)
+	mockRepo.EXPECT().
+		GetProductVariants(gomock.Any(), productID).
+		Return([]models.ProductVariant{
+			{SKU: "TS-M-WHITE", Options: models.VariantOptions{"Размер": "M", "Цвет": "Белый"}},
+			{SKU: "TS-L-WHITE", Options: models.VariantOptions{"Размер": "L", "Цвет": "Белый"}},
+		}, nil)
+
+	result, err := uc.GetProductByID(context.Background(), productID)
+	assert.NoError(t, err)
+	assert.True(t, result.HasVariants)
+	assert.Len(t, result.Variants, 2)
+	assert.Equal(t, []models.VariantOption{
+		{Name: "Размер", Values: []string{"M", "L"}},
+		{Name: "Цвет", Values: []string{"Белый"}},
+	}, result.Options)
+}
+
+func TestVariantOptions(t *testing.T) {
+	options := models.VariantOptions{"Цвет": "Белый", "Размер": "M"}
+
+	assert.Equal(t, []string{"Размер", "Цвет"}, options.Names())
+	assert.Equal(t, "Размер: M, Цвет: Белый", options.String())
+	assert.True(t, options.SameNames(models.VariantOptions{"Размер": "L", "Цвет": "Чёрный"}))
+	assert.False(t, options.SameNames(models.VariantOptions{"Размер": "L"}))
+	assert.False(t, options.SameNames(models.VariantOptions{"Размер": "L", "Материал": "Хлопок"}))
+
+	var scanned models.VariantOptions
+	assert.NoError(t, scanned.Scan([]byte(`{"Размер": "M"}`)))
+	assert.Equal(t, "M", scanned["Размер"])
+}
+
 func TestProductUsecase_GetProductsByCategory(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -145,8 +190,8 @@ func TestProductUsecase_GetProductsByCategory(t *testing.T) {
 		name          string
 		categoryID    uuid.UUID
 		offset        int
-		minPrice      float64
-		maxPrice      float64
+		minPrice      models.Money
+		maxPrice      models.Money
 		minRating     float32
 		sortOption    models.SortOption
 		mockSetup     func()
@@ -157,44 +202,40 @@ func TestProductUsecase_GetProductsByCategory(t *testing.T) {
 			name:       "success with default sort",
 			categoryID: uuid.New(),
 			offset:     0,
-			minPrice:   0,
-			maxPrice:   0,
 			minRating:  0,
 			sortOption: models.SortByDefault,
 			mockSetup: func() {
 				expectedProducts := []*models.Product{
-					{ID: uuid.New(), Name: "Product 1", Price: 1000, Rating: 4},
-					{ID: uuid.New(), Name: "Product 2", Price: 2000, Rating: 5},
+					{ID: uuid.New(), Name: "Product 1", Price: models.Rubles(1000), Rating: 4},
+					{ID: uuid.New(), Name: "Product 2", Price: models.Rubles(2000), Rating: 5},
 				}
 				mockRepo.EXPECT().
-					GetProductsByCategory(gomock.Any(), gomock.Any(), 0, 0.0, 0.0, float32(0), models.SortByDefault).
+					GetProductsByCategory(gomock.Any(), gomock.Any(), false, 0, models.Money{}, models.Money{}, float32(0), models.SortByDefault).
 					Return(expectedProducts, nil)
 			},
 			expected: []*models.Product{
-				{ID: uuid.New(), Name: "Product 1", Price: 1000, Rating: 4},
-				{ID: uuid.New(), Name: "Product 2", Price: 2000, Rating: 5},
+				{ID: uuid.New(), Name: "Product 1", Price: models.Rubles(1000), Rating: 4},
+				{ID: uuid.New(), Name: "Product 2", Price: models.Rubles(2000), Rating: 5},
 			},
 		},
 		{
 			name:       "success with price asc sort",
 			categoryID: uuid.New(),
 			offset:     0,
-			minPrice:   0,
-			maxPrice:   0,
 			minRating:  0,
 			sortOption: models.SortByPriceAsc,
 			mockSetup: func() {
 				expectedProducts := []*models.Product{
-					{ID: uuid.New(), Name: "Product 1", Price: 2000, Rating: 4},
-					{ID: uuid.New(), Name: "Product 2", Price: 1000, Rating: 5},
+					{ID: uuid.New(), Name: "Product 1", Price: models.Rubles(2000), Rating: 4},
+					{ID: uuid.New(), Name: "Product 2", Price: models.Rubles(1000), Rating: 5},
 				}
 				mockRepo.EXPECT().
-					GetProductsByCategory(gomock.Any(), gomock.Any(), 0, 0.0, 0.0, float32(0), models.SortByPriceAsc).
+					GetProductsByCategory(gomock.Any(), gomock.Any(), false, 0, models.Money{}, models.Money{}, float32(0), models.SortByPriceAsc).
 					Return(expectedProducts, nil)
 			},
 			expected: []*models.Product{
-				{ID: uuid.New(), Name: "Product 2", Price: 1000, Rating: 5},
-				{ID: uuid.New(), Name: "Product 1", Price: 2000, Rating: 4},
+				{ID: uuid.New(), Name: "Product 2", Price: models.Rubles(1000), Rating: 5},
+				{ID: uuid.New(), Name: "Product 1", Price: models.Rubles(2000), Rating: 4},
 			},
 		},
 		{
@@ -202,7 +243,7 @@ func TestProductUsecase_GetProductsByCategory(t *testing.T) {
 			categoryID: uuid.New(),
 			mockSetup: func() {
 				mockRepo.EXPECT().
-					GetProductsByCategory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					GetProductsByCategory(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil, errors.New("repository error"))
 			},
 			expectedError: errors.New("ProductUsecase.GetProductsByCategoryWithFilterAndSort: repository error"),
@@ -216,6 +257,7 @@ func TestProductUsecase_GetProductsByCategory(t *testing.T) {
 			products, err := uc.GetProductsByCategory(
 				context.Background(),
 				tt.categoryID,
+				false,
 				tt.offset,
 				tt.minPrice,
 				tt.maxPrice,
@@ -318,7 +360,7 @@ func TestProductUsecase_AddProduct(t *testing.T) {
 			name: "success",
 			product: &models.Product{
 				Name:  "Test Product",
-				Price: 1000,
+				Price: models.Rubles(1000),
 			},
 			categoryID: uuid.New(),
 			mockSetup: func() {
@@ -327,20 +369,20 @@ func TestProductUsecase_AddProduct(t *testing.T) {
 					Return(&models.Product{
 						ID:    uuid.New(),
 						Name:  "Test Product",
-						Price: 1000,
+						Price: models.Rubles(1000),
 					}, nil)
 			},
 			expected: &models.Product{
 				ID:    uuid.New(),
 				Name:  "Test Product",
-				Price: 1000,
+				Price: models.Rubles(1000),
 			},
 		},
 		{
 			name: "empty name",
 			product: &models.Product{
 				Name:  "",
-				Price: 1000,
+				Price: models.Rubles(1000),
 			},
 			expectedError: errs.ErrEmptyProductName,
 		},
@@ -348,7 +390,7 @@ func TestProductUsecase_AddProduct(t *testing.T) {
 			name: "invalid price",
 			product: &models.Product{
 				Name:  "Test",
-				Price: -100,
+				Price: models.Rubles(-100),
 			},
 			expectedError: errs.ErrInvalidProductPrice,
 		},
@@ -3,16 +3,19 @@ package tests
 import (
 	"context"
 	"errors"
+	"math/rand"
 	"testing"
 	"time"
 
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/mocks"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/promo"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/guregu/null"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -225,11 +228,480 @@ func TestCheckPromoCode_NotFound(t *testing.T) {
 
 	mockRepo.EXPECT().
 		CheckPromoCode(ctx, code).
-		Return(nil, errors.New("not found"))
+		Return(nil, errs.NewNotFoundError("promo code not found"))
 
 	result, err := uc.CheckPromoCode(ctx, code)
 
 	require.NoError(t, err)
 	assert.False(t, result.IsValid)
 	assert.Equal(t, 0, result.Percent)
+	assert.Equal(t, string(promo.ReasonNotFound), result.Reason)
+	assert.NotEmpty(t, result.Message)
+}
+
+func TestCreatePromo_InvalidRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIPromoRepository(ctrl)
+	uc := promo.NewPromoUsecase(mockRepo)
+
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	now := time.Now()
+	negative := -1
+
+	tests := []struct {
+		name string
+		req  dto.CreatePromoRequest
+	}{
+		{"percent out of range", dto.CreatePromoRequest{Code: "A", Percent: 0, StartDate: now, EndDate: now.Add(time.Hour)}},
+		{"fixed without amount", dto.CreatePromoRequest{Code: "B", DiscountType: "fixed", StartDate: now, EndDate: now.Add(time.Hour)}},
+		{"unknown type", dto.CreatePromoRequest{Code: "C", DiscountType: "bonus", Percent: 10, StartDate: now, EndDate: now.Add(time.Hour)}},
+		{"end before start", dto.CreatePromoRequest{Code: "D", Percent: 10, StartDate: now, EndDate: now.Add(-time.Hour)}},
+		{"negative usage limit", dto.CreatePromoRequest{Code: "E", Percent: 10, StartDate: now, EndDate: now.Add(time.Hour), UsageLimit: &negative}},
+		{"unknown scope", dto.CreatePromoRequest{Code: "F", Percent: 10, StartDate: now, EndDate: now.Add(time.Hour),
+			Scopes: []dto.PromoScopeDTO{{Type: "brand", ID: uuid.New()}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.CreatePromo(ctx, tt.req)
+			assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+		})
+	}
+}
+
+func TestCreatePromo_FixedWithRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIPromoRepository(ctrl)
+	uc := promo.NewPromoUsecase(mockRepo)
+
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	perUser := 1
+	stack := false
+	sellerID := uuid.New()
+	req := dto.CreatePromoRequest{
+		Code:               "MINUS300",
+		DiscountType:       "fixed",
+		Amount:             models.Rubles(300),
+		MinOrderAmount:     models.Rubles(1500),
+		StartDate:          time.Now(),
+		EndDate:            time.Now().Add(24 * time.Hour),
+		PerUserLimit:       &perUser,
+		StackWithDiscounts: &stack,
+		Scopes:             []dto.PromoScopeDTO{{Type: "seller", ID: sellerID}},
+	}
+
+	mockRepo.EXPECT().
+		Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, p models.PromoCode) error {
+			assert.Equal(t, models.PromoDiscountFixed, p.DiscountType)
+			assert.Equal(t, models.Rubles(300), p.Amount)
+			assert.Equal(t, null.IntFrom(1), p.PerUserLimit)
+			assert.False(t, p.UsageLimit.Valid)
+			assert.False(t, p.StackWithDiscounts)
+			assert.Equal(t, []models.PromoScope{{Type: models.PromoScopeSeller, TargetID: sellerID}}, p.Scopes)
+			return nil
+		})
+
+	result, err := uc.CreatePromo(ctx, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, "fixed", result.DiscountType)
+	require.NotNil(t, result.PerUserLimit)
+	assert.Equal(t, 1, *result.PerUserLimit)
+}
+
+func TestCheckPromoCode_WithUserCart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIPromoRepository(ctrl)
+	uc := promo.NewPromoUsecase(mockRepo)
+
+	userID := uuid.New()
+	ctx := ContextWithUserID(logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New())), userID)
+	now := time.Now()
+	promoCode := &models.PromoCode{
+		ID:                 uuid.New(),
+		Code:               "SALE10",
+		Percent:            10,
+		StartDate:          now.Add(-time.Hour),
+		EndDate:            now.Add(time.Hour),
+		DiscountType:       models.PromoDiscountPercent,
+		MaxDiscount:        models.NullMoneyFrom(models.Rubles(150)),
+		StackWithDiscounts: true,
+	}
+
+	mockRepo.EXPECT().CheckPromoCode(ctx, "SALE10").Return(promoCode, nil)
+	mockRepo.EXPECT().GetPromoUsage(ctx, promoCode.ID, userID).Return(models.PromoUsage{}, nil)
+	mockRepo.EXPECT().GetUserCart(ctx, userID).Return([]models.PromoCartItem{
+		{ProductID: uuid.New(), Price: models.Rubles(2000), FinalPrice: models.Rubles(2000), Quantity: 1},
+	}, nil)
+
+	result, err := uc.CheckPromoCode(ctx, "SALE10")
+
+	require.NoError(t, err)
+	assert.True(t, result.IsValid)
+	assert.Equal(t, 10, result.Percent)
+	assert.Equal(t, models.Rubles(150), result.Discount)
+}
+
+func TestCheckPromoCode_RejectReasons(t *testing.T) {
+	now := time.Now()
+	cart := []models.PromoCartItem{{ProductID: uuid.New(), Price: models.Rubles(500), FinalPrice: models.Rubles(500), Quantity: 1}}
+
+	tests := []struct {
+		name   string
+		promo  models.PromoCode
+		usage  models.PromoUsage
+		reason promo.RejectReason
+	}{
+		{
+			name:   "not started",
+			promo:  models.PromoCode{Percent: 10, StartDate: now.Add(time.Hour), EndDate: now.Add(2 * time.Hour)},
+			reason: promo.ReasonNotStarted,
+		},
+		{
+			name:   "usage limit",
+			promo:  models.PromoCode{Percent: 10, StartDate: now.Add(-time.Hour), EndDate: now.Add(time.Hour), UsageLimit: null.IntFrom(5)},
+			usage:  models.PromoUsage{Total: 5},
+			reason: promo.ReasonUsageLimit,
+		},
+		{
+			name:   "per-user limit",
+			promo:  models.PromoCode{Percent: 10, StartDate: now.Add(-time.Hour), EndDate: now.Add(time.Hour), PerUserLimit: null.IntFrom(1)},
+			usage:  models.PromoUsage{Total: 3, ByUser: 1},
+			reason: promo.ReasonUserLimit,
+		},
+		{
+			name:   "first order only",
+			promo:  models.PromoCode{Percent: 10, StartDate: now.Add(-time.Hour), EndDate: now.Add(time.Hour), FirstOrderOnly: true},
+			usage:  models.PromoUsage{UserOrders: 2},
+			reason: promo.ReasonFirstOrderOnly,
+		},
+		{
+			name:   "minimum order amount",
+			promo:  models.PromoCode{Percent: 10, StartDate: now.Add(-time.Hour), EndDate: now.Add(time.Hour), MinOrderAmount: models.Rubles(1000)},
+			reason: promo.ReasonMinOrderAmount,
+		},
+		{
+			name: "out of scope",
+			promo: models.PromoCode{Percent: 10, StartDate: now.Add(-time.Hour), EndDate: now.Add(time.Hour),
+				Scopes: []models.PromoScope{{Type: models.PromoScopeProduct, TargetID: uuid.New()}}},
+			reason: promo.ReasonNotApplicable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockIPromoRepository(ctrl)
+			uc := promo.NewPromoUsecase(mockRepo)
+
+			userID := uuid.New()
+			ctx := ContextWithUserID(logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New())), userID)
+			promoCode := tt.promo
+			promoCode.ID = uuid.New()
+
+			mockRepo.EXPECT().CheckPromoCode(ctx, "CODE").Return(&promoCode, nil)
+			mockRepo.EXPECT().GetPromoUsage(ctx, promoCode.ID, userID).Return(tt.usage, nil)
+			mockRepo.EXPECT().GetUserCart(ctx, userID).Return(cart, nil)
+
+			result, err := uc.CheckPromoCode(ctx, "CODE")
+
+			require.NoError(t, err)
+			assert.False(t, result.IsValid)
+			assert.Equal(t, string(tt.reason), result.Reason)
+			assert.NotEmpty(t, result.Message)
+		})
+	}
+}
+
+func TestCheckPromoCode_RepoError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIPromoRepository(ctrl)
+	uc := promo.NewPromoUsecase(mockRepo)
+
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+
+	mockRepo.EXPECT().
+		CheckPromoCode(ctx, "CODE").
+		Return(nil, errors.New("database error"))
+
+	_, err := uc.CheckPromoCode(ctx, "CODE")
+
+	require.Error(t, err)
+}
+
+func TestCalculateDiscount(t *testing.T) {
+	sellerID := uuid.New()
+	categoryID := uuid.New()
+
+	regular := models.PromoCartItem{ProductID: uuid.New(), SellerID: sellerID, Price: models.Rubles(1000), FinalPrice: models.Rubles(1000), Quantity: 2}
+	discounted := models.PromoCartItem{ProductID: uuid.New(), CategoryIDs: []uuid.UUID{categoryID}, Price: models.Rubles(1000), FinalPrice: models.Rubles(600), Quantity: 1}
+
+	tests := []struct {
+		name     string
+		promo    models.PromoCode
+		expected models.Money
+	}{
+		{
+			name:     "percent on whole cart",
+			promo:    models.PromoCode{DiscountType: models.PromoDiscountPercent, Percent: 10, StackWithDiscounts: true},
+			expected: models.Rubles(260),
+		},
+		{
+			name:     "percent skips discounted items when not stackable",
+			promo:    models.PromoCode{DiscountType: models.PromoDiscountPercent, Percent: 10},
+			expected: models.Rubles(200),
+		},
+		{
+			name:     "percent capped by max discount",
+			promo:    models.PromoCode{DiscountType: models.PromoDiscountPercent, Percent: 50, StackWithDiscounts: true, MaxDiscount: models.NullMoneyFrom(models.Rubles(300))},
+			expected: models.Rubles(300),
+		},
+		{
+			name:     "fixed amount limited by eligible total",
+			promo:    models.PromoCode{DiscountType: models.PromoDiscountFixed, Amount: models.Rubles(5000), StackWithDiscounts: true},
+			expected: models.Rubles(2600),
+		},
+		{
+			name: "category scope",
+			promo: models.PromoCode{DiscountType: models.PromoDiscountPercent, Percent: 10, StackWithDiscounts: true,
+				Scopes: []models.PromoScope{{Type: models.PromoScopeCategory, TargetID: categoryID}}},
+			expected: models.Rubles(60),
+		},
+		{
+			name: "seller scope",
+			promo: models.PromoCode{DiscountType: models.PromoDiscountFixed, Amount: models.Rubles(150), StackWithDiscounts: true,
+				Scopes: []models.PromoScope{{Type: models.PromoScopeSeller, TargetID: sellerID}}},
+			expected: models.Rubles(150),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			discount, err := promo.CalculateDiscount(&tt.promo, []models.PromoCartItem{regular, discounted})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, discount)
+		})
+	}
+}
+
+func TestCalculateDiscount_Properties(t *testing.T) {
+	rnd := rand.New(rand.NewSource(49))
+
+	for i := 0; i < 500; i++ {
+		var items []models.PromoCartItem
+		var total models.Money
+		for j := 0; j <= rnd.Intn(5); j++ {
+			price := models.Kopecks(rnd.Int63n(1_000_000) + 1)
+			item := models.PromoCartItem{
+				ProductID:  uuid.New(),
+				Price:      price,
+				FinalPrice: price.Sub(price.Percent(rnd.Intn(50))),
+				Quantity:   uint(rnd.Intn(10) + 1),
+			}
+			items = append(items, item)
+			total = total.Add(item.FinalPrice.Mul(int64(item.Quantity)))
+		}
+
+		code := models.PromoCode{
+			DiscountType:       models.PromoDiscountPercent,
+			Percent:            rnd.Intn(100) + 1,
+			StackWithDiscounts: true,
+		}
+		if rnd.Intn(2) == 0 {
+			code.DiscountType = models.PromoDiscountFixed
+			code.Amount = models.Kopecks(rnd.Int63n(2_000_000) + 1)
+		}
+		if rnd.Intn(3) == 0 {
+			code.MaxDiscount = models.NullMoneyFrom(models.Kopecks(rnd.Int63n(100_000) + 1))
+		}
+
+		discount, err := promo.CalculateDiscount(&code, items)
+		require.NoError(t, err)
+
+		assert.False(t, discount.IsNegative())
+		assert.False(t, discount.GreaterThan(total))
+		if code.MaxDiscount.Valid {
+			assert.False(t, discount.GreaterThan(code.MaxDiscount.Money))
+		}
+		if code.DiscountType == models.PromoDiscountPercent && !code.MaxDiscount.Valid {
+			assert.Equal(t, total.Percent(code.Percent), discount)
+		}
+	}
+}
+
+func campaignRequest() dto.CreatePromoCampaignRequest {
+	usageLimit := 100
+	return dto.CreatePromoCampaignRequest{
+		Name:   "Black Friday",
+		Prefix: "BF-",
+		Count:  3,
+		Rules: dto.CreatePromoRequest{
+			Percent:    15,
+			StartDate:  time.Now(),
+			EndDate:    time.Now().Add(24 * time.Hour),
+			UsageLimit: &usageLimit,
+		},
+	}
+}
+
+func TestCreateCampaign_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIPromoRepository(ctrl)
+	uc := promo.NewPromoUsecase(mockRepo)
+
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+
+	mockRepo.EXPECT().
+		CreateCampaign(ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, c models.PromoCampaign, codes []string) error {
+			assert.Equal(t, "ABCDEFGHJKLMNPQRSTUVWXYZ23456789", c.Alphabet)
+			assert.Equal(t, 8, c.CodeLength)
+			assert.Equal(t, null.IntFrom(1), c.Template.UsageLimit)
+			assert.Equal(t, null.IntFrom(1), c.Template.PerUserLimit)
+			assert.Len(t, codes, 3)
+			for _, code := range codes {
+				assert.Regexp(t, "^BF-[A-Z2-9]{8}$", code)
+			}
+			return nil
+		})
+
+	result, err := uc.CreateCampaign(ctx, campaignRequest())
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.CodesCount)
+	assert.True(t, result.IsActive)
+	require.NotNil(t, result.Rules.UsageLimit)
+	assert.Equal(t, 1, *result.Rules.UsageLimit)
+}
+
+func TestCreateCampaign_RetriesOnCollision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIPromoRepository(ctrl)
+	uc := promo.NewPromoUsecase(mockRepo)
+
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+
+	gomock.InOrder(
+		mockRepo.EXPECT().CreateCampaign(ctx, gomock.Any(), gomock.Any()).
+			Return(errs.NewAlreadyExistsError("promo code already exists")),
+		mockRepo.EXPECT().CreateCampaign(ctx, gomock.Any(), gomock.Any()).
+			Return(nil),
+	)
+
+	_, err := uc.CreateCampaign(ctx, campaignRequest())
+
+	require.NoError(t, err)
+}
+
+func TestCreateCampaign_InvalidRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIPromoRepository(ctrl)
+	uc := promo.NewPromoUsecase(mockRepo)
+
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+
+	tests := []struct {
+		name   string
+		modify func(req *dto.CreatePromoCampaignRequest)
+	}{
+		{"empty name", func(req *dto.CreatePromoCampaignRequest) { req.Name = " " }},
+		{"too many codes", func(req *dto.CreatePromoCampaignRequest) { req.Count = 100001 }},
+		{"single symbol alphabet", func(req *dto.CreatePromoCampaignRequest) { req.Alphabet = "AAAA" }},
+		{"code too short", func(req *dto.CreatePromoCampaignRequest) { req.CodeLength = 2 }},
+		{"prefix with space", func(req *dto.CreatePromoCampaignRequest) { req.Prefix = "BLACK FRIDAY" }},
+		{"code space too small", func(req *dto.CreatePromoCampaignRequest) {
+			req.Alphabet = "AB"
+			req.CodeLength = 4
+		}},
+		{"invalid rules", func(req *dto.CreatePromoCampaignRequest) { req.Rules.Percent = 0 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := campaignRequest()
+			tt.modify(&req)
+
+			_, err := uc.CreateCampaign(ctx, req)
+			assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+		})
+	}
+}
+
+func TestGenerateCodes_Unique(t *testing.T) {
+	codes, err := promo.GenerateCodes("X", "AB", 10, 500)
+
+	require.NoError(t, err)
+	require.Len(t, codes, 500)
+
+	seen := make(map[string]struct{}, len(codes))
+	for _, code := range codes {
+		assert.Regexp(t, "^X[AB]{10}$", code)
+		seen[code] = struct{}{}
+	}
+	assert.Len(t, seen, 500)
+}
+
+func TestExportCampaignCodes_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIPromoRepository(ctrl)
+	uc := promo.NewPromoUsecase(mockRepo)
+
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	campaignID := uuid.New()
+
+	mockRepo.EXPECT().
+		GetCampaign(ctx, campaignID).
+		Return(nil, errs.NewNotFoundError("promo campaign not found"))
+
+	_, err := uc.ExportCampaignCodes(ctx, campaignID)
+
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+}
+
+func TestGetCampaignStats_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIPromoRepository(ctrl)
+	uc := promo.NewPromoUsecase(mockRepo)
+
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	campaignID := uuid.New()
+
+	mockRepo.EXPECT().
+		GetCampaignStats(ctx, campaignID).
+		Return(models.PromoCampaignStats{
+			CampaignID:      campaignID,
+			CodesTotal:      3,
+			Redemptions:     1,
+			Revenue:         models.Kopecks(199999),
+			TotalDiscount:   models.Rubles(150),
+			AverageDiscount: models.Rubles(150),
+		}, nil)
+
+	result, err := uc.GetCampaignStats(ctx, campaignID)
+
+	require.NoError(t, err)
+	assert.Equal(t, 0.3333, result.RedemptionRate)
+	assert.Equal(t, models.Kopecks(199999), result.Revenue)
+	assert.Equal(t, models.Rubles(150), result.AverageDiscount)
 }
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
 	recommendationRepo "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/mocks"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	mockProduct "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/mocks"
@@ -21,7 +23,7 @@ func TestGetRecommendations_Success(t *testing.T) {
 	mockRecommendationRepo := recommendationRepo.NewMockIRecommendationRepository(ctrl)
 	mockProductUsecase := mockProduct.NewMockIProductUsecase(ctrl)
 
-	usecase := recommendation.NewRecommendationUsecase(mockProductUsecase, mockRecommendationRepo)
+	usecase := recommendation.NewRecommendationUsecase(mockProductUsecase, mockRecommendationRepo, nil, &config.RecommendationConfig{})
 
 	productID := uuid.New()
 	subcatID1 := uuid.New()
@@ -39,6 +41,10 @@ func TestGetRecommendations_Success(t *testing.T) {
 		{ID: productIDs2[0], Name: "Product 3"},
 	}
 
+	mockRecommendationRepo.EXPECT().
+		GetSimilarProductIDs(gomock.Any(), productID, 10).
+		Return(nil, nil)
+
 	mockRecommendationRepo.EXPECT().
 		GetCategoryIDsByProductID(gomock.Any(), productID).
 		Return([]uuid.UUID{subcatID1, subcatID2}, nil)
@@ -51,6 +57,10 @@ func TestGetRecommendations_Success(t *testing.T) {
 		GetProductIDsBySubcategoryID(gomock.Any(), subcatID2, 10).
 		Return(productIDs2, nil)
 
+	mockRecommendationRepo.EXPECT().
+		GetPopularProductIDs(gomock.Any(), 20).
+		Return(nil, nil)
+
 	mockProductUsecase.EXPECT().
 		GetProductsByIDs(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, ids []uuid.UUID) ([]*models.Product, error) {
@@ -70,10 +80,14 @@ func TestGetRecommendations_GetCategoryIDsError(t *testing.T) {
 	mockRecommendationRepo := recommendationRepo.NewMockIRecommendationRepository(ctrl)
 	mockProductUsecase := mockProduct.NewMockIProductUsecase(ctrl)
 
-	usecase := recommendation.NewRecommendationUsecase(mockProductUsecase, mockRecommendationRepo)
+	usecase := recommendation.NewRecommendationUsecase(mockProductUsecase, mockRecommendationRepo, nil, &config.RecommendationConfig{})
 
 	productID := uuid.New()
 
+	mockRecommendationRepo.EXPECT().
+		GetSimilarProductIDs(gomock.Any(), productID, 10).
+		Return(nil, nil)
+
 	mockRecommendationRepo.EXPECT().
 		GetCategoryIDsByProductID(gomock.Any(), productID).
 		Return(nil, errors.New("db error"))
@@ -90,11 +104,15 @@ func TestGetRecommendations_NoProductsFound(t *testing.T) {
 	mockRecommendationRepo := recommendationRepo.NewMockIRecommendationRepository(ctrl)
 	mockProductUsecase := mockProduct.NewMockIProductUsecase(ctrl)
 
-	usecase := recommendation.NewRecommendationUsecase(mockProductUsecase, mockRecommendationRepo)
+	usecase := recommendation.NewRecommendationUsecase(mockProductUsecase, mockRecommendationRepo, nil, &config.RecommendationConfig{})
 
 	productID := uuid.New()
 	subcatID := uuid.New()
 
+	mockRecommendationRepo.EXPECT().
+		GetSimilarProductIDs(gomock.Any(), productID, 10).
+		Return(nil, nil)
+
 	mockRecommendationRepo.EXPECT().
 		GetCategoryIDsByProductID(gomock.Any(), productID).
 		Return([]uuid.UUID{subcatID}, nil)
@@ -103,6 +121,10 @@ func TestGetRecommendations_NoProductsFound(t *testing.T) {
 		GetProductIDsBySubcategoryID(gomock.Any(), subcatID, 10).
 		Return([]uuid.UUID{}, nil)
 
+	mockRecommendationRepo.EXPECT().
+		GetPopularProductIDs(gomock.Any(), 20).
+		Return(nil, nil)
+
 	products, err := usecase.GetRecommendations(context.Background(), productID)
 	assert.NoError(t, err)
 	assert.Nil(t, products)
@@ -115,12 +137,16 @@ func TestGetRecommendations_ProductUsecaseError(t *testing.T) {
 	mockRecommendationRepo := recommendationRepo.NewMockIRecommendationRepository(ctrl)
 	mockProductUsecase := mockProduct.NewMockIProductUsecase(ctrl)
 
-	usecase := recommendation.NewRecommendationUsecase(mockProductUsecase, mockRecommendationRepo)
+	usecase := recommendation.NewRecommendationUsecase(mockProductUsecase, mockRecommendationRepo, nil, &config.RecommendationConfig{})
 
 	productID := uuid.New()
 	subcatID := uuid.New()
 	productIDs := []uuid.UUID{uuid.New()}
 
+	mockRecommendationRepo.EXPECT().
+		GetSimilarProductIDs(gomock.Any(), productID, 10).
+		Return(nil, nil)
+
 	mockRecommendationRepo.EXPECT().
 		GetCategoryIDsByProductID(gomock.Any(), productID).
 		Return([]uuid.UUID{subcatID}, nil)
@@ -129,6 +155,10 @@ func TestGetRecommendations_ProductUsecaseError(t *testing.T) {
 		GetProductIDsBySubcategoryID(gomock.Any(), subcatID, 10).
 		Return(productIDs, nil)
 
+	mockRecommendationRepo.EXPECT().
+		GetPopularProductIDs(gomock.Any(), 20).
+		Return(nil, nil)
+
 	mockProductUsecase.EXPECT().
 		GetProductsByIDs(gomock.Any(), gomock.Any()).
 		Return(nil, errors.New("usecase error"))
@@ -137,3 +167,163 @@ func TestGetRecommendations_ProductUsecaseError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, products)
 }
+
+func TestGetRecommendations_SimilarFirstThenPopular(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRecommendationRepo := recommendationRepo.NewMockIRecommendationRepository(ctrl)
+	mockProductUsecase := mockProduct.NewMockIProductUsecase(ctrl)
+	mockCache := mockProduct.NewMockIRecommendationCache(ctrl)
+
+	usecase := recommendation.NewRecommendationUsecase(mockProductUsecase, mockRecommendationRepo, mockCache, &config.RecommendationConfig{})
+
+	productID := uuid.New()
+	similarIDs := []uuid.UUID{uuid.New(), uuid.New()}
+	popularIDs := []uuid.UUID{similarIDs[0], productID, uuid.New()}
+	expectedIDs := []uuid.UUID{similarIDs[0], similarIDs[1], popularIDs[2]}
+	cacheKey := "product:" + productID.String()
+
+	mockCache.EXPECT().GetProductIDs(gomock.Any(), cacheKey).Return(nil, nil)
+	mockRecommendationRepo.EXPECT().
+		GetSimilarProductIDs(gomock.Any(), productID, 10).
+		Return(similarIDs, nil)
+	mockRecommendationRepo.EXPECT().
+		GetCategoryIDsByProductID(gomock.Any(), productID).
+		Return(nil, nil)
+	mockRecommendationRepo.EXPECT().
+		GetPopularProductIDs(gomock.Any(), 20).
+		Return(popularIDs, nil)
+	mockCache.EXPECT().SetProductIDs(gomock.Any(), cacheKey, expectedIDs).Return(nil)
+
+	expectedProducts := []*models.Product{{ID: expectedIDs[0]}, {ID: expectedIDs[1]}, {ID: expectedIDs[2]}}
+	mockProductUsecase.EXPECT().
+		GetProductsByIDs(gomock.Any(), expectedIDs).
+		Return(expectedProducts, nil)
+
+	products, err := usecase.GetRecommendations(context.Background(), productID)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedProducts, products)
+}
+
+func TestGetRecommendations_FromCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRecommendationRepo := recommendationRepo.NewMockIRecommendationRepository(ctrl)
+	mockProductUsecase := mockProduct.NewMockIProductUsecase(ctrl)
+	mockCache := mockProduct.NewMockIRecommendationCache(ctrl)
+
+	usecase := recommendation.NewRecommendationUsecase(mockProductUsecase, mockRecommendationRepo, mockCache, &config.RecommendationConfig{})
+
+	productID := uuid.New()
+	cachedIDs := []uuid.UUID{uuid.New()}
+	expectedProducts := []*models.Product{{ID: cachedIDs[0]}}
+
+	mockCache.EXPECT().
+		GetProductIDs(gomock.Any(), "product:"+productID.String()).
+		Return(cachedIDs, nil)
+	mockProductUsecase.EXPECT().
+		GetProductsByIDs(gomock.Any(), cachedIDs).
+		Return(expectedProducts, nil)
+
+	products, err := usecase.GetRecommendations(context.Background(), productID)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedProducts, products)
+}
+
+func TestGetRecommendations_SimilarError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRecommendationRepo := recommendationRepo.NewMockIRecommendationRepository(ctrl)
+	mockProductUsecase := mockProduct.NewMockIProductUsecase(ctrl)
+
+	usecase := recommendation.NewRecommendationUsecase(mockProductUsecase, mockRecommendationRepo, nil, &config.RecommendationConfig{})
+
+	productID := uuid.New()
+
+	mockRecommendationRepo.EXPECT().
+		GetSimilarProductIDs(gomock.Any(), productID, 10).
+		Return(nil, errors.New("db error"))
+
+	products, err := usecase.GetRecommendations(context.Background(), productID)
+	assert.Error(t, err)
+	assert.Nil(t, products)
+}
+
+func TestGetPersonalRecommendations_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRecommendationRepo := recommendationRepo.NewMockIRecommendationRepository(ctrl)
+	mockProductUsecase := mockProduct.NewMockIProductUsecase(ctrl)
+
+	usecase := recommendation.NewRecommendationUsecase(mockProductUsecase, mockRecommendationRepo, nil, &config.RecommendationConfig{})
+
+	userID := uuid.New()
+	personalIDs := []uuid.UUID{uuid.New()}
+	popularIDs := []uuid.UUID{personalIDs[0], uuid.New()}
+	expectedIDs := []uuid.UUID{personalIDs[0], popularIDs[1]}
+	expectedProducts := []*models.Product{{ID: expectedIDs[0]}, {ID: expectedIDs[1]}}
+
+	mockRecommendationRepo.EXPECT().
+		GetPersonalProductIDs(gomock.Any(), userID, 10).
+		Return(personalIDs, nil)
+	mockRecommendationRepo.EXPECT().
+		GetPopularProductIDs(gomock.Any(), 20).
+		Return(popularIDs, nil)
+	mockProductUsecase.EXPECT().
+		GetProductsByIDs(gomock.Any(), expectedIDs).
+		Return(expectedProducts, nil)
+
+	products, err := usecase.GetPersonalRecommendations(ContextWithUserID(context.Background(), userID))
+	assert.NoError(t, err)
+	assert.Equal(t, expectedProducts, products)
+}
+
+func TestGetPersonalRecommendations_NoUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRecommendationRepo := recommendationRepo.NewMockIRecommendationRepository(ctrl)
+	mockProductUsecase := mockProduct.NewMockIProductUsecase(ctrl)
+
+	usecase := recommendation.NewRecommendationUsecase(mockProductUsecase, mockRecommendationRepo, nil, &config.RecommendationConfig{})
+
+	products, err := usecase.GetPersonalRecommendations(context.Background())
+	assert.Error(t, err)
+	assert.Nil(t, products)
+}
+
+func TestRecommendationRunRefresher_StopsOnCancel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRecommendationRepo := recommendationRepo.NewMockIRecommendationRepository(ctrl)
+	mockProductUsecase := mockProduct.NewMockIProductUsecase(ctrl)
+
+	conf := &config.RecommendationConfig{RefreshInterval: time.Hour, SimilarPerProduct: 20}
+	usecase := recommendation.NewRecommendationUsecase(mockProductUsecase, mockRecommendationRepo, nil, conf)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	mockRecommendationRepo.EXPECT().
+		RefreshSimilarity(gomock.Any(), 20).
+		DoAndReturn(func(context.Context, int) (bool, error) {
+			cancel()
+			return true, nil
+		})
+
+	done := make(chan struct{})
+	go func() {
+		usecase.RunRefresher(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("refresher did not stop after context cancel")
+	}
+}
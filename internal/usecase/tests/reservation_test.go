@@ -0,0 +1,160 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/mocks"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/reservation"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReservationUsecase_Hold(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	conf := &config.ReservationConfig{TTL: 15 * time.Minute}
+	userID := uuid.New()
+	productID := uuid.New()
+
+	t.Run("success aggregates duplicate items", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockIReservationRepository(ctrl)
+		uc := reservation.NewReservationUsecase(mockRepo, conf)
+
+		before := time.Now()
+		mockRepo.EXPECT().
+			Hold(gomock.Any(), userID, []models.PricingItem{{ProductID: productID, Quantity: 3}}, gomock.Any()).
+			DoAndReturn(func(_ context.Context, userID uuid.UUID, items []models.PricingItem, expiresAt time.Time) ([]models.StockReservation, error) {
+				assert.WithinDuration(t, before.Add(conf.TTL), expiresAt, time.Minute)
+				return []models.StockReservation{{
+					ID:        uuid.New(),
+					UserID:    userID,
+					ProductID: productID,
+					Quantity:  3,
+					Status:    models.ReservationHeld,
+					ExpiresAt: expiresAt,
+				}}, nil
+			})
+
+		resp, err := uc.Hold(ContextWithUserID(ctx, userID), dto.CheckoutRequest{
+			Items: []dto.CreateOrderItemDTO{
+				{ProductID: productID, Quantity: 1},
+				{ProductID: productID, Quantity: 2},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Items, 1)
+		assert.Equal(t, productID, resp.Items[0].ProductID)
+		assert.Equal(t, uint(3), resp.Items[0].Quantity)
+		assert.False(t, resp.ExpiresAt.IsZero())
+	})
+
+	t.Run("not enough stock", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockIReservationRepository(ctrl)
+		uc := reservation.NewReservationUsecase(mockRepo, conf)
+
+		mockRepo.EXPECT().
+			Hold(gomock.Any(), userID, gomock.Any(), gomock.Any()).
+			Return(nil, errs.ErrNotEnoughStock)
+
+		_, err := uc.Hold(ContextWithUserID(ctx, userID), dto.CheckoutRequest{
+			Items: []dto.CreateOrderItemDTO{{ProductID: productID, Quantity: 1}},
+		})
+		assert.ErrorIs(t, err, errs.ErrNotEnoughStock)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockIReservationRepository(ctrl)
+		uc := reservation.NewReservationUsecase(mockRepo, conf)
+
+		_, err := uc.Hold(ContextWithUserID(ctx, userID), dto.CheckoutRequest{})
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+
+		_, err = uc.Hold(ContextWithUserID(ctx, userID), dto.CheckoutRequest{
+			Items: []dto.CreateOrderItemDTO{{ProductID: productID, Quantity: 0}},
+		})
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+
+		_, err = uc.Hold(ContextWithUserID(ctx, userID), dto.CheckoutRequest{
+			Items: []dto.CreateOrderItemDTO{{ProductID: uuid.Nil, Quantity: 1}},
+		})
+		assert.ErrorIs(t, err, errs.ErrInvalidID)
+
+		_, err = uc.Hold(ctx, dto.CheckoutRequest{
+			Items: []dto.CreateOrderItemDTO{{ProductID: productID, Quantity: 1}},
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestReservationUsecase_ReleaseExpired(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	conf := &config.ReservationConfig{SweepBatch: 2}
+
+	t.Run("drains full batches", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockIReservationRepository(ctrl)
+		uc := reservation.NewReservationUsecase(mockRepo, conf)
+
+		gomock.InOrder(
+			mockRepo.EXPECT().ReleaseExpired(gomock.Any(), 2).Return(int64(2), nil),
+			mockRepo.EXPECT().ReleaseExpired(gomock.Any(), 2).Return(int64(1), nil),
+		)
+
+		released, err := uc.ReleaseExpired(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), released)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockIReservationRepository(ctrl)
+		uc := reservation.NewReservationUsecase(mockRepo, conf)
+
+		mockRepo.EXPECT().ReleaseExpired(gomock.Any(), 2).Return(int64(0), errors.New("db error"))
+
+		_, err := uc.ReleaseExpired(ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestReservationRunSweeper_StopsOnCancel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockIReservationRepository(ctrl)
+
+	conf := &config.ReservationConfig{SweepInterval: time.Hour, SweepBatch: 100}
+	uc := reservation.NewReservationUsecase(mockRepo, conf)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	mockRepo.EXPECT().
+		ReleaseExpired(gomock.Any(), 100).
+		DoAndReturn(func(context.Context, int) (int64, error) {
+			cancel()
+			return 0, nil
+		})
+
+	done := make(chan struct{})
+	go func() {
+		uc.RunSweeper(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("sweeper did not stop after context cancel")
+	}
+}
@@ -4,13 +4,16 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/mocks"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/domains"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	ucmocks "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/mocks"
 	review "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/review"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -23,7 +26,7 @@ func TestAdd_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockIReviewRepository(ctrl)
-	usecase := review.NewReviewUsecase(mockRepo)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{}, review.ScreeningPipeline{}, nil)
 
 	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
 	userID := uuid.New()
@@ -35,6 +38,9 @@ func TestAdd_Success(t *testing.T) {
 		Comment:   "Great product!",
 	}
 
+	mockRepo.EXPECT().
+		HasDeliveredPurchase(ctx, userID, req.ProductID).
+		Return(true, nil)
 	mockRepo.EXPECT().
 		AddReview(ctx, gomock.Any()).
 		Do(func(_ context.Context, review models.ReviewDB) {
@@ -42,12 +48,15 @@ func TestAdd_Success(t *testing.T) {
 			assert.Equal(t, req.ProductID, review.ProductID)
 			assert.Equal(t, req.Rating, review.Rating)
 			assert.Equal(t, req.Comment, review.Comment)
+			assert.True(t, review.VerifiedPurchase)
+			assert.Equal(t, models.ReviewPublished, review.Status)
 		}).
 		Return(nil)
 
-	err := usecase.Add(ctx, req)
+	status, err := usecase.Add(ctx, req)
 
 	assert.NoError(t, err)
+	assert.Equal(t, models.ReviewPublished, status)
 }
 
 func TestAdd_UserIDNotFound(t *testing.T) {
@@ -55,7 +64,7 @@ func TestAdd_UserIDNotFound(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockIReviewRepository(ctrl)
-	usecase := review.NewReviewUsecase(mockRepo)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{}, review.ScreeningPipeline{}, nil)
 
 	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
 
@@ -65,7 +74,7 @@ func TestAdd_UserIDNotFound(t *testing.T) {
 		Comment:   "Great product!",
 	}
 
-	err := usecase.Add(ctx, req)
+	_, err := usecase.Add(ctx, req)
 
 	assert.Error(t, err)
 	assert.True(t, errors.Is(err, errs.ErrNotFound))
@@ -76,7 +85,7 @@ func TestAdd_InvalidUserID(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockIReviewRepository(ctrl)
-	usecase := review.NewReviewUsecase(mockRepo)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{}, review.ScreeningPipeline{}, nil)
 
 	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
 	ctx = context.WithValue(ctx, domains.UserIDKey{}, "invalid-uuid")
@@ -87,7 +96,7 @@ func TestAdd_InvalidUserID(t *testing.T) {
 		Comment:   "Great product!",
 	}
 
-	err := usecase.Add(ctx, req)
+	_, err := usecase.Add(ctx, req)
 
 	assert.Error(t, err)
 	assert.True(t, errors.Is(err, errs.ErrInvalidID))
@@ -98,7 +107,7 @@ func TestAdd_RepositoryError(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockIReviewRepository(ctrl)
-	usecase := review.NewReviewUsecase(mockRepo)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{}, review.ScreeningPipeline{}, nil)
 
 	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
 	userID := uuid.New()
@@ -112,22 +121,369 @@ func TestAdd_RepositoryError(t *testing.T) {
 
 	expectedError := errors.New("repository error")
 
+	mockRepo.EXPECT().
+		HasDeliveredPurchase(ctx, userID, req.ProductID).
+		Return(false, nil)
 	mockRepo.EXPECT().
 		AddReview(ctx, gomock.Any()).
 		Return(expectedError)
 
-	err := usecase.Add(ctx, req)
+	_, err := usecase.Add(ctx, req)
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), expectedError.Error())
 }
 
+func TestAdd_UnverifiedPurchaseStoredWithoutFlag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIReviewRepository(ctrl)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{RequireVerifiedPurchase: false}, review.ScreeningPipeline{}, nil)
+
+	userID := uuid.New()
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	ctx = context.WithValue(ctx, domains.UserIDKey{}, userID.String())
+
+	req := dto.AddReviewRequest{ProductID: uuid.New(), Rating: 4, Comment: "Nice"}
+
+	mockRepo.EXPECT().HasDeliveredPurchase(ctx, userID, req.ProductID).Return(false, nil)
+	mockRepo.EXPECT().
+		AddReview(ctx, gomock.Any()).
+		Do(func(_ context.Context, review models.ReviewDB) {
+			assert.False(t, review.VerifiedPurchase)
+		}).
+		Return(nil)
+
+	_, err := usecase.Add(ctx, req)
+	assert.NoError(t, err)
+}
+
+func TestAdd_UnverifiedPurchaseRejected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIReviewRepository(ctrl)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{RequireVerifiedPurchase: true}, review.ScreeningPipeline{}, nil)
+
+	userID := uuid.New()
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	ctx = context.WithValue(ctx, domains.UserIDKey{}, userID.String())
+
+	req := dto.AddReviewRequest{ProductID: uuid.New(), Rating: 4, Comment: "Nice"}
+
+	mockRepo.EXPECT().HasDeliveredPurchase(ctx, userID, req.ProductID).Return(false, nil)
+
+	_, err := usecase.Add(ctx, req)
+	assert.ErrorIs(t, err, errs.ErrForbidden)
+}
+
+func TestAdd_PurchaseCheckError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIReviewRepository(ctrl)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{RequireVerifiedPurchase: true}, review.ScreeningPipeline{}, nil)
+
+	userID := uuid.New()
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	ctx = context.WithValue(ctx, domains.UserIDKey{}, userID.String())
+
+	req := dto.AddReviewRequest{ProductID: uuid.New(), Rating: 4}
+
+	mockRepo.EXPECT().HasDeliveredPurchase(ctx, userID, req.ProductID).Return(false, errors.New("db down"))
+
+	_, err := usecase.Add(ctx, req)
+	assert.Error(t, err)
+}
+
+func TestAdd_SuspiciousReviewGoesToModeration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIReviewRepository(ctrl)
+	mockScreener := ucmocks.NewMockIReviewScreener(ctrl)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{}, mockScreener, nil)
+
+	userID := uuid.New()
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	ctx = context.WithValue(ctx, domains.UserIDKey{}, userID.String())
+
+	req := dto.AddReviewRequest{ProductID: uuid.New(), Rating: 1, Comment: "Купите у нас дешевле: shop.ru"}
+
+	mockRepo.EXPECT().HasDeliveredPurchase(ctx, userID, req.ProductID).Return(true, nil)
+	mockScreener.EXPECT().Screen(ctx, gomock.Any()).Return("link", nil)
+	mockRepo.EXPECT().
+		AddReview(ctx, gomock.Any()).
+		Do(func(_ context.Context, review models.ReviewDB) {
+			assert.Equal(t, models.ReviewPending, review.Status)
+			assert.Equal(t, "link", review.ModerationReason)
+		}).
+		Return(nil)
+
+	status, err := usecase.Add(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReviewPending, status)
+}
+
+func TestAdd_ScreeningError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIReviewRepository(ctrl)
+	mockScreener := ucmocks.NewMockIReviewScreener(ctrl)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{}, mockScreener, nil)
+
+	userID := uuid.New()
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	ctx = context.WithValue(ctx, domains.UserIDKey{}, userID.String())
+
+	req := dto.AddReviewRequest{ProductID: uuid.New(), Rating: 5}
+
+	mockRepo.EXPECT().HasDeliveredPurchase(ctx, userID, req.ProductID).Return(true, nil)
+	mockScreener.EXPECT().Screen(ctx, gomock.Any()).Return("", errors.New("db down"))
+
+	_, err := usecase.Add(ctx, req)
+	assert.Error(t, err)
+}
+
+func TestScreeningPipeline_Profanity(t *testing.T) {
+	ctx := context.Background()
+	screener := review.NewProfanityScreener([]string{"бляд", "fuck"})
+
+	tests := []struct {
+		comment string
+		reason  string
+	}{
+		{"Отличный товар, рекомендую", ""},
+		{"Это БЛЯДСТВО какое-то", "profanity"},
+		{"What the FUCK is this", "profanity"},
+		{"Great stuff", ""},
+	}
+
+	for _, tt := range tests {
+		reason, err := screener.Screen(ctx, models.ReviewDB{Comment: tt.comment})
+		assert.NoError(t, err)
+		assert.Equal(t, tt.reason, reason, tt.comment)
+	}
+}
+
+func TestScreeningPipeline_Links(t *testing.T) {
+	ctx := context.Background()
+	screener := review.LinkScreener{}
+
+	tests := []struct {
+		comment string
+		reason  string
+	}{
+		{"Подробности на https://example.com/promo", "link"},
+		{"Заходите на www.shop", "link"},
+		{"пишите в t.me/seller", "link"},
+		{"дешевле на магазин.рф!", "link"},
+		{"Хорошо. Рекомендую всем, т.е. всем", ""},
+	}
+
+	for _, tt := range tests {
+		reason, err := screener.Screen(ctx, models.ReviewDB{Comment: tt.comment})
+		assert.NoError(t, err)
+		assert.Equal(t, tt.reason, reason, tt.comment)
+	}
+}
+
+func TestScreeningPipeline_DuplicateAndRate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockRepo := ucmocks.NewMockIScreeningRepository(ctrl)
+	pipeline := review.NewDefaultScreening(mockRepo, 3, time.Hour)
+
+	short := models.ReviewDB{ID: uuid.New(), UserID: uuid.New(), Comment: "Отлично"}
+	mockRepo.EXPECT().CountUserReviewsSince(ctx, short.UserID, short.ID, gomock.Any()).Return(0, nil)
+
+	reason, err := pipeline.Screen(ctx, short)
+	assert.NoError(t, err)
+	assert.Empty(t, reason)
+
+	long := models.ReviewDB{ID: uuid.New(), UserID: uuid.New(), Comment: "Пришёл быстро, упакован хорошо, всё работает"}
+	mockRepo.EXPECT().HasDuplicateReview(ctx, long).Return(true, nil)
+
+	reason, err = pipeline.Screen(ctx, long)
+	assert.NoError(t, err)
+	assert.Equal(t, "duplicate text", reason)
+
+	mockRepo.EXPECT().HasDuplicateReview(ctx, long).Return(false, nil)
+	mockRepo.EXPECT().CountUserReviewsSince(ctx, long.UserID, long.ID, gomock.Any()).Return(3, nil)
+
+	reason, err = pipeline.Screen(ctx, long)
+	assert.NoError(t, err)
+	assert.Equal(t, "too many reviews", reason)
+}
+
+func TestAdd_PublishedReviewInvalidatesSummary(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIReviewRepository(ctrl)
+	mockCache := mocks.NewMockIReviewSummaryCache(ctrl)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{}, review.ScreeningPipeline{}, mockCache)
+
+	userID := uuid.New()
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	ctx = context.WithValue(ctx, domains.UserIDKey{}, userID.String())
+
+	req := dto.AddReviewRequest{ProductID: uuid.New(), Rating: 5, Comment: "Отлично"}
+
+	mockRepo.EXPECT().HasDeliveredPurchase(ctx, userID, req.ProductID).Return(true, nil)
+	mockRepo.EXPECT().AddReview(ctx, gomock.Any()).Return(nil)
+	mockCache.EXPECT().InvalidateSummary(ctx, req.ProductID).Return(errors.New("redis down"))
+
+	status, err := usecase.Add(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, models.ReviewPublished, status)
+}
+
+func TestMutations_InvalidateSummary(t *testing.T) {
+	userID, reviewID, productID := uuid.New(), uuid.New(), uuid.New()
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	ctx = context.WithValue(ctx, domains.UserIDKey{}, userID.String())
+
+	setup := func(t *testing.T) (*mocks.MockIReviewRepository, *mocks.MockIReviewSummaryCache, *review.ReviewUsecase) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockIReviewRepository(ctrl)
+		mockCache := mocks.NewMockIReviewSummaryCache(ctrl)
+		return mockRepo, mockCache, review.NewReviewUsecase(mockRepo, &config.ReviewConfig{}, review.ScreeningPipeline{}, mockCache)
+	}
+
+	t.Run("update", func(t *testing.T) {
+		mockRepo, mockCache, usecase := setup(t)
+		mockRepo.EXPECT().UpdateReview(ctx, gomock.Any()).Return(nil)
+		mockRepo.EXPECT().GetReviewProductID(ctx, reviewID).Return(productID, nil)
+		mockCache.EXPECT().InvalidateSummary(ctx, productID).Return(nil)
+
+		assert.NoError(t, usecase.Update(ctx, dto.UpdateReviewRequest{ReviewID: reviewID, Rating: 1, Comment: "Сломался"}))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		mockRepo, mockCache, usecase := setup(t)
+		gomock.InOrder(
+			mockRepo.EXPECT().GetReviewProductID(ctx, reviewID).Return(productID, nil),
+			mockRepo.EXPECT().DeleteReview(ctx, reviewID, userID).Return(nil),
+			mockCache.EXPECT().InvalidateSummary(ctx, productID).Return(nil),
+		)
+
+		assert.NoError(t, usecase.Delete(ctx, reviewID))
+	})
+
+	t.Run("add photo", func(t *testing.T) {
+		mockRepo, mockCache, usecase := setup(t)
+		mockRepo.EXPECT().GetReviewAuthorID(ctx, reviewID).Return(userID, nil)
+		mockRepo.EXPECT().AddReviewPhoto(ctx, gomock.Any(), models.MaxReviewPhotos).Return(nil)
+		mockRepo.EXPECT().GetReviewProductID(ctx, reviewID).Return(productID, nil)
+		mockCache.EXPECT().InvalidateSummary(ctx, productID).Return(errors.New("redis down"))
+
+		assert.NoError(t, usecase.AddPhoto(ctx, reviewID, "photo.jpg"))
+	})
+
+	t.Run("vote", func(t *testing.T) {
+		mockRepo, mockCache, usecase := setup(t)
+		mockRepo.EXPECT().GetReviewAuthorID(ctx, reviewID).Return(uuid.New(), nil)
+		gomock.InOrder(
+			mockRepo.EXPECT().VoteReview(ctx, gomock.Any()).Return(nil),
+			mockRepo.EXPECT().GetReviewProductID(ctx, reviewID).Return(productID, nil),
+			mockCache.EXPECT().InvalidateSummary(ctx, productID).Return(nil),
+		)
+
+		assert.NoError(t, usecase.Vote(ctx, reviewID, true))
+	})
+
+	t.Run("failed vote keeps summary", func(t *testing.T) {
+		mockRepo, _, usecase := setup(t)
+		mockRepo.EXPECT().GetReviewAuthorID(ctx, reviewID).Return(uuid.New(), nil)
+		mockRepo.EXPECT().VoteReview(ctx, gomock.Any()).Return(errors.New("db error"))
+
+		assert.Error(t, usecase.Vote(ctx, reviewID, false))
+	})
+
+	t.Run("reply", func(t *testing.T) {
+		mockRepo, mockCache, usecase := setup(t)
+		mockRepo.EXPECT().GetReviewSellerID(ctx, reviewID).Return(userID, nil)
+		gomock.InOrder(
+			mockRepo.EXPECT().AddReply(ctx, gomock.Any()).Return(nil),
+			mockRepo.EXPECT().GetReviewProductID(ctx, reviewID).Return(productID, nil),
+			mockCache.EXPECT().InvalidateSummary(ctx, productID).Return(nil),
+		)
+
+		assert.NoError(t, usecase.Reply(ctx, reviewID, "Спасибо за отзыв"))
+	})
+}
+
+func TestSummary_CacheHit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIReviewRepository(ctrl)
+	mockCache := mocks.NewMockIReviewSummaryCache(ctrl)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{SummaryRecentCount: 3}, review.ScreeningPipeline{}, mockCache)
+
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	productID := uuid.New()
+	cached := &models.ReviewSummary{ProductID: productID, Total: 4}
+
+	mockCache.EXPECT().GetSummary(ctx, productID).Return(cached, nil)
+
+	summary, err := usecase.Summary(ctx, productID)
+	assert.NoError(t, err)
+	assert.Equal(t, cached, summary)
+}
+
+func TestSummary_CacheMiss(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIReviewRepository(ctrl)
+	mockCache := mocks.NewMockIReviewSummaryCache(ctrl)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{SummaryRecentCount: 2}, review.ScreeningPipeline{}, mockCache)
+
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	productID := uuid.New()
+	recent := []*models.Review{{ID: uuid.New()}, {ID: uuid.New()}, {ID: uuid.New()}}
+
+	mockCache.EXPECT().GetSummary(ctx, productID).Return(nil, nil)
+	mockRepo.EXPECT().GetReviewSummary(ctx, productID).
+		Return(&models.ReviewSummary{ProductID: productID, Total: 3, StarCounts: [5]int{0, 0, 1, 0, 2}}, nil)
+	mockRepo.EXPECT().GetReview(ctx, productID, 0, models.ReviewSortNewest).Return(recent, nil)
+	mockCache.EXPECT().SetSummary(ctx, gomock.Any()).Return(nil)
+
+	summary, err := usecase.Summary(ctx, productID)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, summary.Total)
+	assert.Len(t, summary.Recent, 2)
+	assert.Equal(t, recent[0].ID, summary.Recent[0].ID)
+}
+
+func TestSummary_WithoutCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIReviewRepository(ctrl)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{SummaryRecentCount: 3}, review.ScreeningPipeline{}, nil)
+
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	productID := uuid.New()
+
+	mockRepo.EXPECT().GetReviewSummary(ctx, productID).Return(nil, errors.New("db down"))
+
+	_, err := usecase.Summary(ctx, productID)
+	assert.Error(t, err)
+}
+
 func TestGet_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockIReviewRepository(ctrl)
-	usecase := review.NewReviewUsecase(mockRepo)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{}, review.ScreeningPipeline{}, nil)
 
 	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
 
@@ -154,7 +510,7 @@ func TestGet_Success(t *testing.T) {
 	}
 
 	mockRepo.EXPECT().
-		GetReview(ctx, productID, offset).
+		GetReview(ctx, productID, offset, models.ReviewSortNewest).
 		Return(expectedReviews, nil)
 
 	reviews, err := usecase.Get(ctx, req)
@@ -168,7 +524,7 @@ func TestGet_RepositoryError(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockIReviewRepository(ctrl)
-	usecase := review.NewReviewUsecase(mockRepo)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{}, review.ScreeningPipeline{}, nil)
 
 	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
 
@@ -182,11 +538,222 @@ func TestGet_RepositoryError(t *testing.T) {
 	expectedError := errors.New("repository error")
 
 	mockRepo.EXPECT().
-		GetReview(ctx, productID, offset).
+		GetReview(ctx, productID, offset, models.ReviewSortNewest).
 		Return(nil, expectedError)
 
 	_, err := usecase.Get(ctx, req)
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), expectedError.Error())
-}
\ No newline at end of file
+}
+
+func TestGet_SortPassedThrough(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIReviewRepository(ctrl)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{}, review.ScreeningPipeline{}, nil)
+
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	productID := uuid.New()
+
+	mockRepo.EXPECT().
+		GetReview(ctx, productID, 0, models.ReviewSortLowest).
+		Return([]*models.Review{}, nil)
+
+	_, err := usecase.Get(ctx, dto.GetReviewRequest{ProductID: productID, Sort: "lowest"})
+	assert.NoError(t, err)
+}
+
+func TestUpdate_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIReviewRepository(ctrl)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{}, review.ScreeningPipeline{}, nil)
+
+	userID := uuid.New()
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	ctx = context.WithValue(ctx, domains.UserIDKey{}, userID.String())
+
+	req := dto.UpdateReviewRequest{
+		ReviewID: uuid.New(),
+		Rating:   3,
+		Comment:  "Changed my mind",
+	}
+
+	mockRepo.EXPECT().
+		UpdateReview(ctx, models.ReviewDB{
+			ID:      req.ReviewID,
+			UserID:  userID,
+			Rating:  req.Rating,
+			Comment: req.Comment,
+			Status:  models.ReviewPublished,
+		}).
+		Return(nil)
+
+	assert.NoError(t, usecase.Update(ctx, req))
+}
+
+func TestDelete_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIReviewRepository(ctrl)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{}, review.ScreeningPipeline{}, nil)
+
+	userID, reviewID := uuid.New(), uuid.New()
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	ctx = context.WithValue(ctx, domains.UserIDKey{}, userID.String())
+
+	mockRepo.EXPECT().DeleteReview(ctx, reviewID, userID).Return(nil)
+
+	assert.NoError(t, usecase.Delete(ctx, reviewID))
+}
+
+func TestDelete_UserIDNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIReviewRepository(ctrl)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{}, review.ScreeningPipeline{}, nil)
+
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+
+	err := usecase.Delete(ctx, uuid.New())
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+}
+
+func TestAddPhoto_NotAuthor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIReviewRepository(ctrl)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{}, review.ScreeningPipeline{}, nil)
+
+	reviewID := uuid.New()
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	ctx = context.WithValue(ctx, domains.UserIDKey{}, uuid.New().String())
+
+	mockRepo.EXPECT().GetReviewAuthorID(ctx, reviewID).Return(uuid.New(), nil)
+
+	err := usecase.AddPhoto(ctx, reviewID, "photo.jpg")
+	assert.ErrorIs(t, err, errs.ErrForbidden)
+}
+
+func TestAddPhoto_TooManyPhotos(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIReviewRepository(ctrl)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{}, review.ScreeningPipeline{}, nil)
+
+	userID, reviewID := uuid.New(), uuid.New()
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	ctx = context.WithValue(ctx, domains.UserIDKey{}, userID.String())
+
+	mockRepo.EXPECT().GetReviewAuthorID(ctx, reviewID).Return(userID, nil)
+	mockRepo.EXPECT().
+		AddReviewPhoto(ctx, gomock.Any(), models.MaxReviewPhotos).
+		Return(errs.NewBusinessLogicError("too many photos"))
+
+	err := usecase.AddPhoto(ctx, reviewID, "photo.jpg")
+	assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+}
+
+func TestAddPhoto_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIReviewRepository(ctrl)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{}, review.ScreeningPipeline{}, nil)
+
+	userID, reviewID := uuid.New(), uuid.New()
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	ctx = context.WithValue(ctx, domains.UserIDKey{}, userID.String())
+
+	mockRepo.EXPECT().GetReviewAuthorID(ctx, reviewID).Return(userID, nil)
+	mockRepo.EXPECT().
+		AddReviewPhoto(ctx, gomock.Any(), models.MaxReviewPhotos).
+		Do(func(_ context.Context, photo models.ReviewPhotoDB, _ int) {
+			assert.Equal(t, reviewID, photo.ReviewID)
+			assert.Equal(t, "photo.jpg", photo.ImageURL)
+		}).
+		Return(nil)
+
+	assert.NoError(t, usecase.AddPhoto(ctx, reviewID, "photo.jpg"))
+}
+
+func TestVote_OwnReview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIReviewRepository(ctrl)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{}, review.ScreeningPipeline{}, nil)
+
+	userID, reviewID := uuid.New(), uuid.New()
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	ctx = context.WithValue(ctx, domains.UserIDKey{}, userID.String())
+
+	mockRepo.EXPECT().GetReviewAuthorID(ctx, reviewID).Return(userID, nil)
+
+	err := usecase.Vote(ctx, reviewID, true)
+	assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+}
+
+func TestVote_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIReviewRepository(ctrl)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{}, review.ScreeningPipeline{}, nil)
+
+	userID, reviewID := uuid.New(), uuid.New()
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	ctx = context.WithValue(ctx, domains.UserIDKey{}, userID.String())
+
+	mockRepo.EXPECT().GetReviewAuthorID(ctx, reviewID).Return(uuid.New(), nil)
+	mockRepo.EXPECT().
+		VoteReview(ctx, gomock.Any()).
+		Do(func(_ context.Context, vote models.ReviewVoteDB) {
+			assert.Equal(t, userID, vote.UserID)
+			assert.False(t, vote.IsHelpful)
+		}).
+		Return(nil)
+
+	assert.NoError(t, usecase.Vote(ctx, reviewID, false))
+}
+
+func TestReply_NotProductSeller(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIReviewRepository(ctrl)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{}, review.ScreeningPipeline{}, nil)
+
+	reviewID := uuid.New()
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	ctx = context.WithValue(ctx, domains.UserIDKey{}, uuid.New().String())
+
+	mockRepo.EXPECT().GetReviewSellerID(ctx, reviewID).Return(uuid.New(), nil)
+
+	err := usecase.Reply(ctx, reviewID, "Спасибо")
+	assert.ErrorIs(t, err, errs.ErrForbidden)
+}
+
+func TestReply_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockIReviewRepository(ctrl)
+	usecase := review.NewReviewUsecase(mockRepo, &config.ReviewConfig{}, review.ScreeningPipeline{}, nil)
+
+	sellerID, reviewID := uuid.New(), uuid.New()
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	ctx = context.WithValue(ctx, domains.UserIDKey{}, sellerID.String())
+
+	mockRepo.EXPECT().GetReviewSellerID(ctx, reviewID).Return(sellerID, nil)
+	mockRepo.EXPECT().AddReply(ctx, gomock.Any()).Return(nil)
+
+	assert.NoError(t, usecase.Reply(ctx, reviewID, "Спасибо"))
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/mocks"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/search"
 	"github.com/golang/mock/gomock"
 	"github.com/guregu/null"
@@ -28,8 +29,8 @@ func TestSearchUsecase_SearchProductsByNameWithFilterAndSort(t *testing.T) {
 	t.Run("success with sorting", func(t *testing.T) {
 		ctx := context.Background()
 		products := []*models.Product{
-			{Name: "Product 1", Price: 100, PriceDiscount: 90, Rating: 4.5},
-			{Name: "Product 2", Price: 200, PriceDiscount: 0, Rating: 3.5},
+			{Name: "Product 1", Price: models.Rubles(100), PriceDiscount: models.Rubles(90), Rating: 4.5},
+			{Name: "Product 2", Price: models.Rubles(200), PriceDiscount: models.Money{}, Rating: 3.5},
 		}
 
 		mockRepo.EXPECT().
@@ -38,9 +39,10 @@ func TestSearchUsecase_SearchProductsByNameWithFilterAndSort(t *testing.T) {
 				"test",
 				null.String{},
 				0,
-				0.0,
-				0.0,
+				models.Money{},
+				models.Money{},
 				float32(0.0),
+				nil,
 				models.SortByPriceAsc,
 			).
 			Return(products, nil)
@@ -50,9 +52,10 @@ func TestSearchUsecase_SearchProductsByNameWithFilterAndSort(t *testing.T) {
 			null.String{},
 			"test",
 			0,
+			models.Money{},
+			models.Money{},
 			0.0,
-			0.0,
-			0.0,
+			nil,
 			models.SortByPriceAsc,
 		)
 
@@ -65,8 +68,8 @@ func TestSearchUsecase_SearchProductsByNameWithFilterAndSort(t *testing.T) {
 	t.Run("success with price desc sorting", func(t *testing.T) {
 		ctx := context.Background()
 		products := []*models.Product{
-			{Name: "Product 1", Price: 100, PriceDiscount: 90, Rating: 4.5},
-			{Name: "Product 2", Price: 200, PriceDiscount: 0, Rating: 3.5},
+			{Name: "Product 1", Price: models.Rubles(100), PriceDiscount: models.Rubles(90), Rating: 4.5},
+			{Name: "Product 2", Price: models.Rubles(200), PriceDiscount: models.Money{}, Rating: 3.5},
 		}
 
 		mockRepo.EXPECT().
@@ -75,9 +78,10 @@ func TestSearchUsecase_SearchProductsByNameWithFilterAndSort(t *testing.T) {
 				"test",
 				null.String{},
 				0,
-				0.0,
-				0.0,
+				models.Money{},
+				models.Money{},
 				float32(0.0),
+				nil,
 				models.SortByPriceDesc,
 			).
 			Return(products, nil)
@@ -87,9 +91,10 @@ func TestSearchUsecase_SearchProductsByNameWithFilterAndSort(t *testing.T) {
 			null.String{},
 			"test",
 			0,
+			models.Money{},
+			models.Money{},
 			0.0,
-			0.0,
-			0.0,
+			nil,
 			models.SortByPriceDesc,
 		)
 
@@ -102,8 +107,8 @@ func TestSearchUsecase_SearchProductsByNameWithFilterAndSort(t *testing.T) {
 	t.Run("success with rating sorting", func(t *testing.T) {
 		ctx := context.Background()
 		products := []*models.Product{
-			{Name: "Product 1", Price: 100, PriceDiscount: 90, Rating: 4.5},
-			{Name: "Product 2", Price: 200, PriceDiscount: 0, Rating: 3.5},
+			{Name: "Product 1", Price: models.Rubles(100), PriceDiscount: models.Rubles(90), Rating: 4.5},
+			{Name: "Product 2", Price: models.Rubles(200), PriceDiscount: models.Money{}, Rating: 3.5},
 		}
 
 		mockRepo.EXPECT().
@@ -112,9 +117,10 @@ func TestSearchUsecase_SearchProductsByNameWithFilterAndSort(t *testing.T) {
 				"test",
 				null.String{},
 				0,
-				0.0,
-				0.0,
+				models.Money{},
+				models.Money{},
 				float32(0.0),
+				nil,
 				models.SortByRatingDesc,
 			).
 			Return(products, nil)
@@ -124,9 +130,10 @@ func TestSearchUsecase_SearchProductsByNameWithFilterAndSort(t *testing.T) {
 			null.String{},
 			"test",
 			0,
+			models.Money{},
+			models.Money{},
 			0.0,
-			0.0,
-			0.0,
+			nil,
 			models.SortByRatingDesc,
 		)
 
@@ -149,6 +156,7 @@ func TestSearchUsecase_SearchProductsByNameWithFilterAndSort(t *testing.T) {
 				gomock.Any(),
 				gomock.Any(),
 				gomock.Any(),
+				gomock.Any(),
 			).
 			Return(nil, errors.New("repository error"))
 
@@ -157,9 +165,10 @@ func TestSearchUsecase_SearchProductsByNameWithFilterAndSort(t *testing.T) {
 			null.String{},
 			"test",
 			0,
+			models.Money{},
+			models.Money{},
 			0.0,
-			0.0,
-			0.0,
+			nil,
 			models.SortByDefault,
 		)
 
@@ -168,6 +177,46 @@ func TestSearchUsecase_SearchProductsByNameWithFilterAndSort(t *testing.T) {
 	})
 }
 
+func TestSearchUsecase_GetAttributeFacets(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockISearchRepository(ctrl)
+	uc := search.NewSearchUsecase(mockRepo)
+
+	t.Run("success", func(t *testing.T) {
+		filters := []models.AttributeFilter{{AttributeID: uuid.New(), Values: []string{"Samsung"}}}
+		facets := []models.AttributeFacet{{
+			AttributeID: filters[0].AttributeID,
+			Name:        "Бренд",
+			Type:        models.AttributeEnum,
+			Values:      []models.FacetValue{{Value: "Samsung", Count: 3}},
+		}}
+
+		mockRepo.EXPECT().
+			GetAttributeFacets(gomock.Any(), "phone", null.String{}, models.Money{}, models.Money{}, float32(0), filters).
+			Return(facets, nil)
+
+		result, err := uc.GetAttributeFacets(context.Background(), null.String{}, "phone", models.Money{}, models.Money{}, 0, filters)
+		require.NoError(t, err)
+		assert.Equal(t, facets, result)
+	})
+
+	t.Run("min greater than max", func(t *testing.T) {
+		filters := []models.AttributeFilter{{
+			AttributeID: uuid.New(),
+			Min:         null.FloatFrom(10),
+			Max:         null.FloatFrom(5),
+		}}
+
+		_, err := uc.GetAttributeFacets(context.Background(), null.String{}, "phone", models.Money{}, models.Money{}, 0, filters)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+	})
+}
+
 func TestSearchUsecase_SearchCategoryByName(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -8,10 +8,12 @@ import (
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/mocks"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
 	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
 	seller "github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/seller"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/guregu/null"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 )
@@ -21,27 +23,32 @@ func TestAddProduct_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockISellerRepository(ctrl)
-	usecase := seller.NewSellerUsecase(mockRepo)
+	mockAttributes := mocks.NewMockIAttributeSchemaRepository(ctrl)
+	usecase := seller.NewSellerUsecase(mockRepo, mockAttributes)
 
 	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
 
 	testProduct := &models.Product{
 		Name:  "Test Product",
-		Price: 100,
+		Price: models.Rubles(100),
 	}
 	categoryID := uuid.New()
 
 	expectedProduct := &models.Product{
 		ID:    uuid.New(),
 		Name:  "Test Product",
-		Price: 100,
+		Price: models.Rubles(100),
 	}
 
+	mockAttributes.EXPECT().
+		GetCategoryAttributes(ctx, categoryID).
+		Return([]models.CategoryAttribute{}, nil)
+
 	mockRepo.EXPECT().
-		AddProduct(ctx, testProduct, categoryID).
+		AddProduct(ctx, testProduct, categoryID, nil).
 		Return(expectedProduct, nil)
 
-	result, err := usecase.AddProduct(ctx, testProduct, categoryID)
+	result, err := usecase.AddProduct(ctx, testProduct, categoryID, nil)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedProduct, result)
@@ -52,17 +59,18 @@ func TestAddProduct_EmptyName(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockISellerRepository(ctrl)
-	usecase := seller.NewSellerUsecase(mockRepo)
+	mockAttributes := mocks.NewMockIAttributeSchemaRepository(ctrl)
+	usecase := seller.NewSellerUsecase(mockRepo, mockAttributes)
 
 	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
 
 	testProduct := &models.Product{
 		Name:  "",
-		Price: 100,
+		Price: models.Rubles(100),
 	}
 	categoryID := uuid.New()
 
-	_, err := usecase.AddProduct(ctx, testProduct, categoryID)
+	_, err := usecase.AddProduct(ctx, testProduct, categoryID, nil)
 
 	assert.Error(t, err)
 	assert.True(t, errors.Is(err, errs.ErrEmptyProductName))
@@ -73,17 +81,18 @@ func TestAddProduct_InvalidPrice(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockISellerRepository(ctrl)
-	usecase := seller.NewSellerUsecase(mockRepo)
+	mockAttributes := mocks.NewMockIAttributeSchemaRepository(ctrl)
+	usecase := seller.NewSellerUsecase(mockRepo, mockAttributes)
 
 	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
 
 	testProduct := &models.Product{
 		Name:  "Test Product",
-		Price: -100,
+		Price: models.Rubles(-100),
 	}
 	categoryID := uuid.New()
 
-	_, err := usecase.AddProduct(ctx, testProduct, categoryID)
+	_, err := usecase.AddProduct(ctx, testProduct, categoryID, nil)
 
 	assert.Error(t, err)
 	assert.True(t, errors.Is(err, errs.ErrInvalidProductPrice))
@@ -94,23 +103,28 @@ func TestAddProduct_RepositoryError(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockISellerRepository(ctrl)
-	usecase := seller.NewSellerUsecase(mockRepo)
+	mockAttributes := mocks.NewMockIAttributeSchemaRepository(ctrl)
+	usecase := seller.NewSellerUsecase(mockRepo, mockAttributes)
 
 	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
 
 	testProduct := &models.Product{
 		Name:  "Test Product",
-		Price: 100,
+		Price: models.Rubles(100),
 	}
 	categoryID := uuid.New()
 
 	expectedError := errors.New("repository error")
 
+	mockAttributes.EXPECT().
+		GetCategoryAttributes(ctx, categoryID).
+		Return([]models.CategoryAttribute{}, nil)
+
 	mockRepo.EXPECT().
-		AddProduct(ctx, testProduct, categoryID).
+		AddProduct(ctx, testProduct, categoryID, nil).
 		Return(nil, expectedError)
 
-	_, err := usecase.AddProduct(ctx, testProduct, categoryID)
+	_, err := usecase.AddProduct(ctx, testProduct, categoryID, nil)
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), expectedError.Error())
@@ -121,7 +135,7 @@ func TestUploadProductImage_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockISellerRepository(ctrl)
-	usecase := seller.NewSellerUsecase(mockRepo)
+	usecase := seller.NewSellerUsecase(mockRepo, nil)
 
 	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
 
@@ -142,7 +156,7 @@ func TestUploadProductImage_RepositoryError(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockISellerRepository(ctrl)
-	usecase := seller.NewSellerUsecase(mockRepo)
+	usecase := seller.NewSellerUsecase(mockRepo, nil)
 
 	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
 
@@ -166,7 +180,7 @@ func TestGetSellerProducts_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockISellerRepository(ctrl)
-	usecase := seller.NewSellerUsecase(mockRepo)
+	usecase := seller.NewSellerUsecase(mockRepo, nil)
 
 	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
 
@@ -177,12 +191,12 @@ func TestGetSellerProducts_Success(t *testing.T) {
 		{
 			ID:    uuid.New(),
 			Name:  "Product 1",
-			Price: 100,
+			Price: models.Rubles(100),
 		},
 		{
 			ID:    uuid.New(),
 			Name:  "Product 2",
-			Price: 200,
+			Price: models.Rubles(200),
 		},
 	}
 
@@ -201,7 +215,7 @@ func TestGetSellerProducts_RepositoryError(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockISellerRepository(ctrl)
-	usecase := seller.NewSellerUsecase(mockRepo)
+	usecase := seller.NewSellerUsecase(mockRepo, nil)
 
 	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
 
@@ -225,7 +239,7 @@ func TestCheckProductBelongs_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockISellerRepository(ctrl)
-	usecase := seller.NewSellerUsecase(mockRepo)
+	usecase := seller.NewSellerUsecase(mockRepo, nil)
 
 	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
 
@@ -249,7 +263,7 @@ func TestCheckProductBelongs_RepositoryError(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockRepo := mocks.NewMockISellerRepository(ctrl)
-	usecase := seller.NewSellerUsecase(mockRepo)
+	usecase := seller.NewSellerUsecase(mockRepo, nil)
 
 	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
 
@@ -266,4 +280,169 @@ func TestCheckProductBelongs_RepositoryError(t *testing.T) {
 
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), expectedError.Error())
-}
\ No newline at end of file
+}
+func TestAddProduct_Attributes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockISellerRepository(ctrl)
+	mockAttributes := mocks.NewMockIAttributeSchemaRepository(ctrl)
+	usecase := seller.NewSellerUsecase(mockRepo, mockAttributes)
+
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+
+	categoryID := uuid.New()
+	brand := models.CategoryAttribute{
+		ID:       uuid.New(),
+		Name:     "Бренд",
+		Type:     models.AttributeEnum,
+		Options:  []string{"Apple", "Samsung"},
+		Required: true,
+	}
+	weight := models.CategoryAttribute{
+		ID:   uuid.New(),
+		Name: "Вес",
+		Type: models.AttributeNumber,
+		Unit: null.StringFrom("г"),
+	}
+	schema := []models.CategoryAttribute{brand, weight}
+
+	tests := []struct {
+		name       string
+		attributes []models.ProductAttribute
+		wantErr    bool
+	}{
+		{
+			name: "valid values",
+			attributes: []models.ProductAttribute{
+				{AttributeID: brand.ID, Text: null.StringFrom("Apple")},
+				{AttributeID: weight.ID, Number: null.FloatFrom(180)},
+			},
+		},
+		{
+			name:       "missing required attribute",
+			attributes: []models.ProductAttribute{{AttributeID: weight.ID, Number: null.FloatFrom(180)}},
+			wantErr:    true,
+		},
+		{
+			name: "unknown attribute",
+			attributes: []models.ProductAttribute{
+				{AttributeID: brand.ID, Text: null.StringFrom("Apple")},
+				{AttributeID: uuid.New(), Text: null.StringFrom("x")},
+			},
+			wantErr: true,
+		},
+		{
+			name:       "option not in enum",
+			attributes: []models.ProductAttribute{{AttributeID: brand.ID, Text: null.StringFrom("Nokia")}},
+			wantErr:    true,
+		},
+		{
+			name: "wrong value type",
+			attributes: []models.ProductAttribute{
+				{AttributeID: brand.ID, Text: null.StringFrom("Apple")},
+				{AttributeID: weight.ID, Text: null.StringFrom("180")},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testProduct := &models.Product{Name: "Test Product", Price: models.Rubles(100)}
+
+			mockAttributes.EXPECT().
+				GetCategoryAttributes(ctx, categoryID).
+				Return(schema, nil)
+			if !tt.wantErr {
+				mockRepo.EXPECT().
+					AddProduct(ctx, testProduct, categoryID, tt.attributes).
+					Return(testProduct, nil)
+			}
+
+			_, err := usecase.AddProduct(ctx, testProduct, categoryID, tt.attributes)
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, errs.ErrBusinessLogic))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAddVariant(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	sellerID := uuid.New()
+	productID := uuid.New()
+
+	newRequest := func() dto.AddVariantRequest {
+		return dto.AddVariantRequest{
+			SKU:      " TS-M-WHITE ",
+			Options:  models.VariantOptions{"Размер": "M", "Цвет": " Белый "},
+			Price:    models.Rubles(1000),
+			Quantity: 3,
+			Images:   []string{"white.png", " "},
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockISellerRepository(ctrl)
+		usecase := seller.NewSellerUsecase(mockRepo, nil)
+
+		mockRepo.EXPECT().CheckProductBelongs(ctx, productID, sellerID).Return(true, nil)
+		mockRepo.EXPECT().GetVariantOptions(ctx, productID).
+			Return([]models.VariantOptions{{"Размер": "L", "Цвет": "Чёрный"}}, nil)
+		mockRepo.EXPECT().AddVariant(ctx, gomock.Any()).Return(nil)
+
+		variant, err := usecase.AddVariant(ctx, sellerID, productID, newRequest())
+		assert.NoError(t, err)
+		assert.Equal(t, "TS-M-WHITE", variant.SKU)
+		assert.Equal(t, "Белый", variant.Options["Цвет"])
+		assert.Equal(t, []string{"white.png"}, variant.Images)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		usecase := seller.NewSellerUsecase(mocks.NewMockISellerRepository(gomock.NewController(t)), nil)
+
+		req := newRequest()
+		req.SKU = " "
+		_, err := usecase.AddVariant(ctx, sellerID, productID, req)
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+
+		req = newRequest()
+		req.Options = nil
+		_, err = usecase.AddVariant(ctx, sellerID, productID, req)
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+
+		req = newRequest()
+		req.Price = models.Money{}
+		_, err = usecase.AddVariant(ctx, sellerID, productID, req)
+		assert.ErrorIs(t, err, errs.ErrInvalidProductPrice)
+	})
+
+	t.Run("foreign product", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockISellerRepository(ctrl)
+		usecase := seller.NewSellerUsecase(mockRepo, nil)
+
+		mockRepo.EXPECT().CheckProductBelongs(ctx, productID, sellerID).Return(false, nil)
+
+		_, err := usecase.AddVariant(ctx, sellerID, productID, newRequest())
+		assert.ErrorIs(t, err, errs.ErrForbidden)
+	})
+
+	t.Run("options mismatch", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockRepo := mocks.NewMockISellerRepository(ctrl)
+		usecase := seller.NewSellerUsecase(mockRepo, nil)
+
+		mockRepo.EXPECT().CheckProductBelongs(ctx, productID, sellerID).Return(true, nil)
+		mockRepo.EXPECT().GetVariantOptions(ctx, productID).
+			Return([]models.VariantOptions{{"Размер": "L"}}, nil)
+
+		_, err := usecase.AddVariant(ctx, sellerID, productID, newRequest())
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+		assert.Contains(t, err.Error(), "Размер")
+	})
+}
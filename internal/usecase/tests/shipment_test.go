@@ -0,0 +1,212 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/config"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/mocks"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/order"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/pricing"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestShipments(t *testing.T) (*mocks.MockIOrderRepository, *mocks.MockINotificationRepository, *order.OrderUsecase) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockIOrderRepository(ctrl)
+	mockNotificationRepo := mocks.NewMockINotificationRepository(ctrl)
+	engine := pricing.NewEngine(mockRepo, nil, &config.DeliveryConfig{})
+	return mockRepo, mockNotificationRepo, order.NewOrderUsecase(mockRepo, engine, mockNotificationRepo, nil, anyDeliveryPlanner(ctrl), anyWarehouseRouter(ctrl), nil)
+}
+
+func TestOrderUsecase_CreateOrderSplitsBySeller(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	mockRepo, mockNotificationRepo, uc := setupTestShipments(t)
+
+	firstSeller, secondSeller := uuid.New(), uuid.New()
+	products := map[uuid.UUID]*models.Product{
+		uuid.New(): {SellerID: firstSeller, Status: models.ProductApproved, Quantity: 10, Price: models.Rubles(100)},
+		uuid.New(): {SellerID: secondSeller, Status: models.ProductApproved, Quantity: 10, Price: models.Rubles(200)},
+		uuid.New(): {SellerID: firstSeller, Status: models.ProductApproved, Quantity: 10, Price: models.Rubles(50)},
+	}
+
+	items := make([]dto.CreateOrderItemDTO, 0, len(products))
+	for productID, product := range products {
+		mockRepo.EXPECT().ProductPrice(gomock.Any(), productID).Return(product, nil)
+		mockRepo.EXPECT().ProductDiscounts(gomock.Any(), productID, uuid.NullUUID{}).Return(nil, errs.NewNotFoundError("no discounts"))
+		items = append(items, dto.CreateOrderItemDTO{ProductID: productID, Quantity: 2})
+	}
+
+	var captured dto.CreateOrderRepoReq
+	mockRepo.EXPECT().CreateOrder(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req dto.CreateOrderRepoReq) error {
+			captured = req
+			return nil
+		})
+	mockNotificationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	err := uc.CreateOrder(ctx, dto.CreateOrderDTO{
+		UserID:    uuid.New(),
+		AddressID: uuid.New(),
+		Items:     items,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, captured.Order.Shipments, 2)
+	shipments := make(map[uuid.UUID]dto.Shipment)
+	for _, shipment := range captured.Order.Shipments {
+		assert.Equal(t, models.AwaitingConfirmation, shipment.Status)
+		shipments[shipment.ID] = shipment
+	}
+
+	totals := make(map[uuid.UUID]models.Money)
+	for _, item := range captured.Order.Items {
+		shipment, ok := shipments[item.ShipmentID]
+		require.True(t, ok, "item must reference one of the order shipments")
+		assert.Equal(t, products[item.ProductID].SellerID, shipment.SellerID)
+		totals[shipment.SellerID] = totals[shipment.SellerID].Add(item.Price.Mul(int64(item.Quantity)))
+	}
+
+	for _, shipment := range captured.Order.Shipments {
+		assert.Equal(t, totals[shipment.SellerID], shipment.TotalPriceDiscount)
+	}
+	assert.Equal(t, captured.Order.TotalPrice, totals[firstSeller].Add(totals[secondSeller]))
+}
+
+func TestOrderUsecase_GetSellerOrders(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	sellerID := uuid.New()
+	addressID := uuid.New()
+
+	t.Run("success loads each address once", func(t *testing.T) {
+		mockRepo, _, uc := setupTestShipments(t)
+
+		shipments := []models.OrderShipment{
+			{ID: uuid.New(), OrderID: uuid.New(), SellerID: sellerID, AddressID: addressID, Status: models.AwaitingConfirmation,
+				Items: []models.ShipmentItem{{ProductID: uuid.New(), ProductName: "Product", Price: models.Rubles(100), Quantity: 1}}},
+			{ID: uuid.New(), OrderID: uuid.New(), SellerID: sellerID, AddressID: addressID, Status: models.BeingPrepared},
+		}
+
+		mockRepo.EXPECT().GetSellerShipments(gomock.Any(), sellerID, 20).Return(shipments, nil)
+		mockRepo.EXPECT().GetOrderAddress(gomock.Any(), addressID).
+			Return(&models.AddressDB{}, nil).Times(1)
+
+		orders, err := uc.GetSellerOrders(ContextWithUserID(ctx, sellerID), 20)
+		require.NoError(t, err)
+		require.Len(t, orders, 2)
+		assert.Equal(t, shipments[0].OrderID, orders[0].OrderID)
+		assert.Equal(t, addressID, orders[0].Address.ID)
+		require.Len(t, orders[0].Products, 1)
+		assert.Equal(t, "Product", orders[0].Products[0].ProductName)
+		assert.Equal(t, models.BeingPrepared, orders[1].Status)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		mockRepo, _, uc := setupTestShipments(t)
+
+		mockRepo.EXPECT().GetSellerShipments(gomock.Any(), sellerID, 0).Return(nil, errors.New("db error"))
+
+		_, err := uc.GetSellerOrders(ContextWithUserID(ctx, sellerID), 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("no seller in context", func(t *testing.T) {
+		_, _, uc := setupTestShipments(t)
+
+		_, err := uc.GetSellerOrders(ctx, 0)
+		assert.Error(t, err)
+	})
+}
+
+func TestOrderUsecase_ConfirmShipment(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	sellerID := uuid.New()
+	buyerID := uuid.New()
+	shipmentID := uuid.New()
+
+	t.Run("success notifies buyer", func(t *testing.T) {
+		mockRepo, mockNotificationRepo, uc := setupTestShipments(t)
+
+		tracking := "TRACK-1"
+		mockRepo.EXPECT().ConfirmShipment(gomock.Any(), dto.ConfirmShipmentRepoReq{
+			ShipmentID:     shipmentID,
+			SellerID:       sellerID,
+			TrackingNumber: &tracking,
+		}).Return(buyerID, nil)
+		mockNotificationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, notification models.Notification) error {
+				assert.Equal(t, buyerID, notification.UserID)
+				return nil
+			})
+
+		err := uc.ConfirmShipment(ContextWithUserID(ctx, sellerID), shipmentID, dto.ConfirmShipmentRequest{
+			TrackingNumber: &tracking,
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("empty tracking number is ignored", func(t *testing.T) {
+		mockRepo, mockNotificationRepo, uc := setupTestShipments(t)
+
+		empty := ""
+		mockRepo.EXPECT().ConfirmShipment(gomock.Any(), dto.ConfirmShipmentRepoReq{
+			ShipmentID: shipmentID,
+			SellerID:   sellerID,
+		}).Return(buyerID, nil)
+		mockNotificationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+
+		err := uc.ConfirmShipment(ContextWithUserID(ctx, sellerID), shipmentID, dto.ConfirmShipmentRequest{
+			TrackingNumber: &empty,
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("wrong status", func(t *testing.T) {
+		mockRepo, _, uc := setupTestShipments(t)
+
+		mockRepo.EXPECT().ConfirmShipment(gomock.Any(), gomock.Any()).
+			Return(uuid.Nil, errs.NewBusinessLogicError("shipment cannot be confirmed"))
+
+		err := uc.ConfirmShipment(ContextWithUserID(ctx, sellerID), shipmentID, dto.ConfirmShipmentRequest{})
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+	})
+}
+
+func TestOrderUsecase_CancelShipment(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	sellerID := uuid.New()
+	shipmentID := uuid.New()
+
+	t.Run("success notifies buyer", func(t *testing.T) {
+		mockRepo, mockNotificationRepo, uc := setupTestShipments(t)
+
+		buyerID := uuid.New()
+		mockRepo.EXPECT().CancelShipment(gomock.Any(), shipmentID, sellerID).Return(buyerID, nil)
+		mockNotificationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, notification models.Notification) error {
+				assert.Equal(t, buyerID, notification.UserID)
+				return nil
+			})
+
+		assert.NoError(t, uc.CancelShipment(ContextWithUserID(ctx, sellerID), shipmentID))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		mockRepo, _, uc := setupTestShipments(t)
+
+		mockRepo.EXPECT().CancelShipment(gomock.Any(), shipmentID, sellerID).
+			Return(uuid.Nil, errs.NewNotFoundError("shipment not found"))
+
+		err := uc.CancelShipment(ContextWithUserID(ctx, sellerID), shipmentID)
+		assert.ErrorIs(t, err, errs.ErrNotFound)
+	})
+}
@@ -0,0 +1,158 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/infrastructure/repository/postgres/mocks"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/domains"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/warehouse"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestWarehouse(t *testing.T) (*mocks.MockIWarehouseRepository, *warehouse.WarehouseUsecase) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mocks.NewMockIWarehouseRepository(ctrl)
+	return mockRepo, warehouse.NewWarehouseUsecase(mockRepo)
+}
+
+func warehousemanContext(userID uuid.UUID) context.Context {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	return context.WithValue(ctx, domains.UserIDKey{}, userID.String())
+}
+
+func TestWarehouseUsecase_RouteOrder(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+	orderID := uuid.New()
+	petersburg := models.RouteCandidate{WarehouseID: uuid.New(), Coordinate: "59.9311,30.3609"}
+	moscow := models.RouteCandidate{WarehouseID: uuid.New(), Coordinate: "55.7900,37.7000"}
+	broken := models.RouteCandidate{WarehouseID: uuid.New(), Coordinate: "нет"}
+
+	t.Run("nearest warehouse", func(t *testing.T) {
+		mockRepo, uc := setupTestWarehouse(t)
+
+		mockRepo.EXPECT().GetRouteCandidates(gomock.Any(), orderID).
+			Return("55.7558,37.6176", []models.RouteCandidate{broken, petersburg, moscow}, nil)
+		mockRepo.EXPECT().AssignOrder(gomock.Any(), orderID, moscow.WarehouseID).Return(nil)
+
+		warehouseID, err := uc.RouteOrder(ctx, orderID)
+		require.NoError(t, err)
+		assert.Equal(t, moscow.WarehouseID, warehouseID)
+	})
+
+	t.Run("falls back when stock is taken", func(t *testing.T) {
+		mockRepo, uc := setupTestWarehouse(t)
+
+		mockRepo.EXPECT().GetRouteCandidates(gomock.Any(), orderID).
+			Return("55.7558,37.6176", []models.RouteCandidate{petersburg, moscow}, nil)
+		gomock.InOrder(
+			mockRepo.EXPECT().AssignOrder(gomock.Any(), orderID, moscow.WarehouseID).
+				Return(fmt.Errorf("wrap: %w", errs.ErrNotEnoughStock)),
+			mockRepo.EXPECT().AssignOrder(gomock.Any(), orderID, petersburg.WarehouseID).Return(nil),
+		)
+
+		warehouseID, err := uc.RouteOrder(ctx, orderID)
+		require.NoError(t, err)
+		assert.Equal(t, petersburg.WarehouseID, warehouseID)
+	})
+
+	t.Run("no warehouse has stock", func(t *testing.T) {
+		mockRepo, uc := setupTestWarehouse(t)
+
+		mockRepo.EXPECT().GetRouteCandidates(gomock.Any(), orderID).Return("55.7558,37.6176", nil, nil)
+
+		_, err := uc.RouteOrder(ctx, orderID)
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+	})
+
+	t.Run("order already routed", func(t *testing.T) {
+		mockRepo, uc := setupTestWarehouse(t)
+
+		mockRepo.EXPECT().GetRouteCandidates(gomock.Any(), orderID).
+			Return("", []models.RouteCandidate{moscow}, nil)
+		mockRepo.EXPECT().AssignOrder(gomock.Any(), orderID, moscow.WarehouseID).
+			Return(errs.NewBusinessLogicError("order is already assigned to a warehouse"))
+
+		_, err := uc.RouteOrder(ctx, orderID)
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+	})
+}
+
+func TestWarehouseUsecase_StaffWarehouse(t *testing.T) {
+	userID := uuid.New()
+	ctx := warehousemanContext(userID)
+
+	t.Run("assigned", func(t *testing.T) {
+		mockRepo, uc := setupTestWarehouse(t)
+		warehouseID := uuid.New()
+
+		mockRepo.EXPECT().GetStaffWarehouse(gomock.Any(), userID).
+			Return(uuid.NullUUID{UUID: warehouseID, Valid: true}, nil)
+
+		got, err := uc.StaffWarehouse(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, warehouseID, got)
+	})
+
+	t.Run("not assigned", func(t *testing.T) {
+		mockRepo, uc := setupTestWarehouse(t)
+
+		mockRepo.EXPECT().GetStaffWarehouse(gomock.Any(), userID).Return(uuid.NullUUID{}, nil)
+
+		_, err := uc.StaffWarehouse(ctx)
+		assert.ErrorIs(t, err, errs.ErrForbidden)
+	})
+}
+
+func TestWarehouseUsecase_SetStock(t *testing.T) {
+	userID := uuid.New()
+	warehouseID := uuid.New()
+	ctx := warehousemanContext(userID)
+
+	t.Run("sku stock", func(t *testing.T) {
+		mockRepo, uc := setupTestWarehouse(t)
+		productID := uuid.New()
+		variantID := uuid.New()
+
+		mockRepo.EXPECT().GetStaffWarehouse(gomock.Any(), userID).
+			Return(uuid.NullUUID{UUID: warehouseID, Valid: true}, nil)
+		mockRepo.EXPECT().SetStock(gomock.Any(), warehouseID, productID, uuid.NullUUID{UUID: variantID, Valid: true}, 4).
+			Return(nil)
+
+		err := uc.SetStock(ctx, dto.SetWarehouseStockRequest{ProductID: productID, VariantID: &variantID, Quantity: 4})
+		assert.NoError(t, err)
+	})
+
+	t.Run("negative quantity", func(t *testing.T) {
+		_, uc := setupTestWarehouse(t)
+
+		err := uc.SetStock(ctx, dto.SetWarehouseStockRequest{ProductID: uuid.New(), Quantity: -1})
+		assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+	})
+}
+
+func TestWarehouseUsecase_CreateWarehouse_Validation(t *testing.T) {
+	ctx := logctx.WithLogger(context.Background(), logrus.NewEntry(logrus.New()))
+
+	for name, req := range map[string]dto.CreateWarehouseRequest{
+		"empty name":         {Name: " ", AddressString: "ул. Складская, 1", Coordinate: "55.75,37.61"},
+		"empty address":      {Name: "Север", Coordinate: "55.75,37.61"},
+		"invalid coordinate": {Name: "Север", AddressString: "ул. Складская, 1", Coordinate: "север"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, uc := setupTestWarehouse(t)
+
+			_, err := uc.CreateWarehouse(ctx, req)
+			assert.ErrorIs(t, err, errs.ErrBusinessLogic)
+		})
+	}
+}
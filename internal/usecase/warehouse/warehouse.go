@@ -0,0 +1,258 @@
+package warehouse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/models/errs"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/dto"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/transport/middleware/logctx"
+	"github.com/go-park-mail-ru/2025_1_ChillGuys/internal/usecase/helpers"
+	"github.com/google/uuid"
+	"github.com/guregu/null"
+)
+
+//go:generate mockgen -source=warehouse.go -destination=../../infrastructure/repository/postgres/mocks/warehouse_repository_mock.go -package=mocks IWarehouseRepository
+type IWarehouseRepository interface {
+	GetWarehouses(ctx context.Context) ([]models.Warehouse, error)
+	CreateWarehouse(ctx context.Context, warehouse models.Warehouse) (models.Warehouse, error)
+	AssignStaff(ctx context.Context, userID uuid.UUID, warehouseID uuid.NullUUID) error
+	GetStaffWarehouse(ctx context.Context, userID uuid.UUID) (uuid.NullUUID, error)
+	SetStock(ctx context.Context, warehouseID, productID uuid.UUID, variantID uuid.NullUUID, quantity int) error
+	GetStock(ctx context.Context, warehouseID uuid.UUID, offset int) ([]models.WarehouseStock, error)
+	GetPickList(ctx context.Context, warehouseID uuid.UUID) ([]models.PickListItem, error)
+	GetRouteCandidates(ctx context.Context, orderID uuid.UUID) (string, []models.RouteCandidate, error)
+	AssignOrder(ctx context.Context, orderID, warehouseID uuid.UUID) error
+}
+
+type WarehouseUsecase struct {
+	repo IWarehouseRepository
+}
+
+func NewWarehouseUsecase(repo IWarehouseRepository) *WarehouseUsecase {
+	return &WarehouseUsecase{
+		repo: repo,
+	}
+}
+
+func (u *WarehouseUsecase) GetWarehouses(ctx context.Context) ([]dto.WarehouseDTO, error) {
+	const op = "WarehouseUsecase.GetWarehouses"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	warehouses, err := u.repo.GetWarehouses(ctx)
+	if err != nil {
+		logger.WithError(err).Error("get warehouses")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	result := make([]dto.WarehouseDTO, 0, len(warehouses))
+	for _, warehouse := range warehouses {
+		result = append(result, dto.ConvertToWarehouseDTO(warehouse))
+	}
+
+	return result, nil
+}
+
+func (u *WarehouseUsecase) CreateWarehouse(ctx context.Context, req dto.CreateWarehouseRequest) (dto.WarehouseDTO, error) {
+	const op = "WarehouseUsecase.CreateWarehouse"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return dto.WarehouseDTO{}, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("warehouse name is required"))
+	}
+	addressString := strings.TrimSpace(req.AddressString)
+	if addressString == "" {
+		return dto.WarehouseDTO{}, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("warehouse address is required"))
+	}
+	coordinate, err := models.ParseCoordinate(req.Coordinate)
+	if err != nil {
+		return dto.WarehouseDTO{}, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("invalid coordinate"))
+	}
+
+	warehouse, err := u.repo.CreateWarehouse(ctx, models.Warehouse{
+		ID:   uuid.New(),
+		Name: name,
+		Address: models.AddressDB{
+			ID:            uuid.New(),
+			Region:        req.Region,
+			City:          req.City,
+			AddressString: null.StringFrom(addressString),
+			Coordinate:    null.StringFrom(coordinate.String()),
+		},
+	})
+	if err != nil {
+		logger.WithError(err).Error("create warehouse")
+		return dto.WarehouseDTO{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return dto.ConvertToWarehouseDTO(warehouse), nil
+}
+
+func (u *WarehouseUsecase) AssignStaff(ctx context.Context, userID uuid.UUID, req dto.AssignWarehouseStaffRequest) error {
+	const op = "WarehouseUsecase.AssignStaff"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("user_id", userID)
+
+	var warehouseID uuid.NullUUID
+	if req.WarehouseID != nil {
+		warehouseID = uuid.NullUUID{UUID: *req.WarehouseID, Valid: true}
+	}
+
+	if err := u.repo.AssignStaff(ctx, userID, warehouseID); err != nil {
+		logger.WithError(err).Warn("assign staff")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// StaffWarehouse возвращает склад текущего работника. Работнику, которого
+// ещё не прикрепили к складу, очередь и остатки недоступны.
+func (u *WarehouseUsecase) StaffWarehouse(ctx context.Context) (uuid.UUID, error) {
+	const op = "WarehouseUsecase.StaffWarehouse"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	userID, err := helpers.GetUserIDFromContext(ctx)
+	if err != nil {
+		logger.WithError(err).Error("get user ID from context")
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	warehouseID, err := u.repo.GetStaffWarehouse(ctx, userID)
+	if err != nil {
+		logger.WithError(err).Error("get staff warehouse")
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !warehouseID.Valid {
+		logger.WithField("user_id", userID).Warn("warehouseman is not assigned to a warehouse")
+		return uuid.Nil, fmt.Errorf("%s: %w", op, errs.ErrForbidden)
+	}
+
+	return warehouseID.UUID, nil
+}
+
+func (u *WarehouseUsecase) SetStock(ctx context.Context, req dto.SetWarehouseStockRequest) error {
+	const op = "WarehouseUsecase.SetStock"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("product_id", req.ProductID)
+
+	if req.Quantity < 0 {
+		return fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("quantity cannot be negative"))
+	}
+
+	warehouseID, err := u.StaffWarehouse(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var variantID uuid.NullUUID
+	if req.VariantID != nil {
+		variantID = uuid.NullUUID{UUID: *req.VariantID, Valid: true}
+	}
+
+	if err = u.repo.SetStock(ctx, warehouseID, req.ProductID, variantID, req.Quantity); err != nil {
+		logger.WithError(err).Warn("set warehouse stock")
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+func (u *WarehouseUsecase) GetStock(ctx context.Context, offset int) ([]models.WarehouseStock, error) {
+	const op = "WarehouseUsecase.GetStock"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	warehouseID, err := u.StaffWarehouse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	stock, err := u.repo.GetStock(ctx, warehouseID, offset)
+	if err != nil {
+		logger.WithError(err).Error("get warehouse stock")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return stock, nil
+}
+
+func (u *WarehouseUsecase) GetPickList(ctx context.Context) ([]models.PickListItem, error) {
+	const op = "WarehouseUsecase.GetPickList"
+	logger := logctx.GetLogger(ctx).WithField("op", op)
+
+	warehouseID, err := u.StaffWarehouse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	items, err := u.repo.GetPickList(ctx, warehouseID)
+	if err != nil {
+		logger.WithError(err).Error("get pick list")
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return items, nil
+}
+
+// RouteOrder направляет заказ на ближайший к адресу доставки склад, где хватает
+// товара для всего заказа. Если ближайший склад успел отдать товар другому
+// заказу, пробует следующий по расстоянию.
+func (u *WarehouseUsecase) RouteOrder(ctx context.Context, orderID uuid.UUID) (uuid.UUID, error) {
+	const op = "WarehouseUsecase.RouteOrder"
+	logger := logctx.GetLogger(ctx).WithField("op", op).WithField("order_id", orderID)
+
+	destination, candidates, err := u.repo.GetRouteCandidates(ctx, orderID)
+	if err != nil {
+		logger.WithError(err).Error("get route candidates")
+		return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, warehouseID := range sortByDistance(destination, candidates) {
+		err = u.repo.AssignOrder(ctx, orderID, warehouseID)
+		if err == nil {
+			return warehouseID, nil
+		}
+		if !errors.Is(err, errs.ErrNotEnoughStock) {
+			logger.WithError(err).Error("assign order")
+			return uuid.Nil, fmt.Errorf("%s: %w", op, err)
+		}
+		logger.WithField("warehouse_id", warehouseID).Info("warehouse stock taken concurrently, trying next")
+	}
+
+	return uuid.Nil, fmt.Errorf("%s: %w", op, errs.NewBusinessLogicError("no warehouse can fulfil the order"))
+}
+
+// sortByDistance упорядочивает склады по расстоянию до адреса доставки.
+// Склады с некорректной координатой идут последними; если координата адреса
+// неизвестна, порядок складов сохраняется.
+func sortByDistance(destination string, candidates []models.RouteCandidate) []uuid.UUID {
+	to, destErr := models.ParseCoordinate(destination)
+
+	distances := make([]float64, len(candidates))
+	order := make([]int, len(candidates))
+	for i, candidate := range candidates {
+		order[i] = i
+		distances[i] = math.Inf(1)
+		if destErr != nil {
+			continue
+		}
+		if from, err := models.ParseCoordinate(candidate.Coordinate); err == nil {
+			distances[i] = to.DistanceKm(from)
+		}
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return distances[order[i]] < distances[order[j]]
+	})
+
+	ids := make([]uuid.UUID, len(order))
+	for i, j := range order {
+		ids[i] = candidates[j].WarehouseID
+	}
+
+	return ids
+}